@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
@@ -333,6 +334,7 @@ func New(
 		keys[zetaObserverModuleTypes.MemStoreKey],
 		app.GetSubspace(zetaObserverModuleTypes.ModuleName),
 		&stakingKeeper,
+		app.MsgServiceRouter(),
 	)
 
 	// register the staking hooks
@@ -347,16 +349,6 @@ func New(
 		app.MsgServiceRouter(),
 		app.AccountKeeper)
 
-	app.EmissionsKeeper = *emissionsModuleKeeper.NewKeeper(
-		appCodec,
-		keys[emissionsModuleTypes.StoreKey],
-		keys[emissionsModuleTypes.MemStoreKey],
-		app.GetSubspace(emissionsModuleTypes.ModuleName),
-		authtypes.FeeCollectorName,
-		app.BankKeeper,
-		app.StakingKeeper,
-		app.ZetaObserverKeeper,
-	)
 	// Create Ethermint keepers
 	tracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
 	feeSs := app.GetSubspace(feemarkettypes.ModuleName)
@@ -395,6 +387,18 @@ func New(
 		app.ZetaObserverKeeper,
 		&app.FungibleKeeper,
 	)
+
+	app.EmissionsKeeper = *emissionsModuleKeeper.NewKeeper(
+		appCodec,
+		keys[emissionsModuleTypes.StoreKey],
+		keys[emissionsModuleTypes.MemStoreKey],
+		app.GetSubspace(emissionsModuleTypes.ModuleName),
+		authtypes.FeeCollectorName,
+		app.BankKeeper,
+		app.StakingKeeper,
+		app.ZetaObserverKeeper,
+		app.ZetaCoreKeeper,
+	)
 	app.GroupKeeper = groupkeeper.NewKeeper(keys[group.StoreKey], appCodec, app.MsgServiceRouter(), app.AccountKeeper, group.Config{
 		MaxExecutionPeriod: 2 * time.Hour, // Two hours.
 		MaxMetadataLen:     255,
@@ -729,6 +733,11 @@ func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	)
 }
 
+// RegisterNodeService implements the Application.RegisterNodeService method.
+func (app *App) RegisterNodeService(clientCtx client.Context) {
+	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 func GetMaccPerms() map[string][]string {
 	dupMaccPerms := make(map[string][]string)
@@ -2,7 +2,6 @@ package main
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/rs/zerolog"
 	"github.com/zeta-chain/node/common"
 	"github.com/zeta-chain/node/common/cosmos"
@@ -30,64 +29,31 @@ func CreateZetaBridge(cfg *config.Config) (*zetaclient.ZetaCoreBridge, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := bridge.SetMinGasPrice(cfg.BroadcastMinGasPrice); err != nil {
+		return nil, err
+	}
 	return bridge, nil
 }
 
+// CreateSignerMap creates the signers of the chains of the chain families registered in zetaclient
 func CreateSignerMap(tss zetaclient.TSSSigner, logger zerolog.Logger, cfg *config.Config, ts *zetaclient.TelemetryServer) (map[common.Chain]zetaclient.ChainSigner, error) {
-	signerMap := make(map[common.Chain]zetaclient.ChainSigner)
-	// EVM signers
-	for _, evmConfig := range cfg.GetAllEVMConfigs() {
-		if evmConfig.Chain.IsZetaChain() {
-			continue
-		}
-		mpiAddress := ethcommon.HexToAddress(evmConfig.CoreParams.ConnectorContractAddress)
-		erc20CustodyAddress := ethcommon.HexToAddress(evmConfig.CoreParams.Erc20CustodyContractAddress)
-		signer, err := zetaclient.NewEVMSigner(evmConfig.Chain, evmConfig.Endpoint, tss, config.GetConnectorABI(), config.GetERC20CustodyABI(), mpiAddress, erc20CustodyAddress, logger, ts)
-		if err != nil {
-			logger.Error().Err(err).Msgf("NewEVMSigner error for chain %s", evmConfig.Chain.String())
-			continue
-		}
-		signerMap[evmConfig.Chain] = signer
-	}
-	// BTC signer
-	btcChain, btcConfig, enabled := cfg.GetBTCConfig()
-	if enabled {
-		signer, err := zetaclient.NewBTCSigner(btcConfig, tss, logger, ts)
-		if err != nil {
-			logger.Error().Err(err).Msgf("NewBTCSigner error for chain %s", btcChain.String())
-		} else {
-			signerMap[btcChain] = signer
-		}
-	}
-
-	return signerMap, nil
+	return zetaclient.CreateSignerMap(zetaclient.ChainFamilyDeps{
+		Tss:       tss,
+		Logger:    logger,
+		Config:    cfg,
+		Telemetry: ts,
+	}), nil
 }
 
+// CreateChainClientMap creates the chain clients of the chains of the chain families registered in zetaclient
 func CreateChainClientMap(bridge *zetaclient.ZetaCoreBridge, tss zetaclient.TSSSigner, dbpath string, metrics *metrics.Metrics, logger zerolog.Logger, cfg *config.Config, ts *zetaclient.TelemetryServer) (map[common.Chain]zetaclient.ChainClient, error) {
-	clientMap := make(map[common.Chain]zetaclient.ChainClient)
-	// EVM clients
-	for _, evmConfig := range cfg.GetAllEVMConfigs() {
-		if evmConfig.Chain.IsZetaChain() {
-			continue
-		}
-		co, err := zetaclient.NewEVMChainClient(bridge, tss, dbpath, metrics, logger, cfg, *evmConfig, ts)
-		if err != nil {
-			logger.Error().Err(err).Msgf("NewEVMChainClient error for chain %s", evmConfig.Chain.String())
-			continue
-		}
-		clientMap[evmConfig.Chain] = co
-	}
-	// BTC client
-	btcChain, btcConfig, enabled := cfg.GetBTCConfig()
-	if enabled {
-		co, err := zetaclient.NewBitcoinClient(btcChain, bridge, tss, dbpath, metrics, logger, btcConfig, ts)
-		if err != nil {
-			logger.Error().Err(err).Msgf("NewBitcoinClient error for chain %s", btcChain.String())
-
-		} else {
-			clientMap[btcChain] = co
-		}
-	}
-
-	return clientMap, nil
+	return zetaclient.CreateChainClientMap(zetaclient.ChainFamilyDeps{
+		Bridge:    bridge,
+		Tss:       tss,
+		DBPath:    dbpath,
+		Metrics:   metrics,
+		Logger:    logger,
+		Config:    cfg,
+		Telemetry: ts,
+	}), nil
 }
@@ -31,6 +31,10 @@ type initArguments struct {
 	p2pDiagnosticTicker uint64
 	TssPath             string
 	TestTssKeysign      bool
+
+	broadcastBatchWindow  uint64
+	broadcastBatchMaxMsgs uint64
+	broadcastMinGasPrice  string
 }
 
 func init() {
@@ -52,6 +56,9 @@ func init() {
 	InitCmd.Flags().Uint64Var(&initArgs.configUpdateTicker, "config-update-ticker", 5, "config update ticker (default: 0 means no ticker)")
 	InitCmd.Flags().StringVar(&initArgs.TssPath, "tss-path", "~/.tss", "path to tss location")
 	InitCmd.Flags().BoolVar(&initArgs.TestTssKeysign, "test-tss", false, "set to to true to run a check for TSS keysign on startup")
+	InitCmd.Flags().Uint64Var(&initArgs.broadcastBatchWindow, "broadcast-batch-window", 500, "window in milliseconds to batch the votes broadcasted to zetacore (0 means no batching)")
+	InitCmd.Flags().Uint64Var(&initArgs.broadcastBatchMaxMsgs, "broadcast-batch-max-msgs", 20, "maximum number of votes broadcasted in a single tx")
+	InitCmd.Flags().StringVar(&initArgs.broadcastMinGasPrice, "broadcast-min-gas-price", "10000000000", "minimum gas price in azeta of the txs broadcasted to zetacore")
 
 }
 
@@ -87,6 +94,9 @@ func Initialize(_ *cobra.Command, _ []string) error {
 	configData.TssPath = initArgs.TssPath
 	configData.P2PDiagnosticTicker = initArgs.p2pDiagnosticTicker
 	configData.ConfigUpdateTicker = initArgs.configUpdateTicker
+	configData.BroadcastBatchWindow = initArgs.broadcastBatchWindow
+	configData.BroadcastBatchMaxMsgs = initArgs.broadcastBatchMaxMsgs
+	configData.BroadcastMinGasPrice = initArgs.broadcastMinGasPrice
 
 	//Save config file
 	return config.Save(&configData, rootArgs.zetaCoreHome)
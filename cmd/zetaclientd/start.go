@@ -94,6 +94,13 @@ func start(_ *cobra.Command, _ []string) error {
 	CreateAuthzSigner(zetaBridge.GetKeys().GetOperatorAddress().String(), zetaBridge.GetKeys().GetAddress())
 	startLogger.Debug().Msgf("CreateAuthzSigner is ready")
 
+	// BatchBroadcaster: votes are broadcasted to zetacore in multi-message txs to reduce the number of txs
+	// the batcher is enabled before any goroutine using the bridge is started
+	if cfg.BroadcastBatchWindow > 0 {
+		// #nosec G701 always in range
+		zetaBridge.EnableBatchBroadcast(time.Duration(cfg.BroadcastBatchWindow)*time.Millisecond, int(cfg.BroadcastBatchMaxMsgs))
+	}
+
 	// Initialize core parameters from zetacore
 	err = zetaBridge.UpdateConfigFromCore(cfg, true)
 	if err != nil {
@@ -148,6 +155,11 @@ func start(_ *cobra.Command, _ []string) error {
 		return err
 	}
 	metrics.Start()
+	err = zetaBridge.RegisterMetrics(metrics)
+	if err != nil {
+		startLogger.Error().Err(err).Msg("zetaBridge.RegisterMetrics")
+		return err
+	}
 
 	var tssHistoricalList []types.TSS
 	tssHistoricalList, err = zetaBridge.GetTssHistory()
@@ -0,0 +1,190 @@
+package bitcoin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+)
+
+const (
+	// witnessProgramLenPubKeyHash is the length of a P2WPKH witness program
+	witnessProgramLenPubKeyHash = 20
+
+	// witnessProgramLenScriptHash is the length of a P2WSH or P2TR witness program
+	witnessProgramLenScriptHash = 32
+
+	// compressedPubKeyLen is the length of a compressed public key
+	compressedPubKeyLen = 33
+
+	// taprootAnnexTag is the first byte of the optional annex in a taproot witness
+	taprootAnnexTag = 0x50
+
+	// taprootLeafMask is the mask applied to the first byte of a taproot control block
+	taprootLeafMask = 0xfe
+
+	// taprootLeafVersion is the leaf version of a tapscript
+	taprootLeafVersion = 0xc0
+
+	// taprootControlBlockBaseLen and taprootControlBlockNodeLen define the length of a taproot control block
+	taprootControlBlockBaseLen = 33
+	taprootControlBlockNodeLen = 32
+)
+
+// DecodeAddress decodes a bitcoin address for the network
+// unlike the legacy btcutil package, taproot (bech32m) addresses are supported
+func DecodeAddress(addr string, params *chaincfg.Params) (btcutil.Address, error) {
+	address, err := btcutil.DecodeAddress(addr, params)
+	if err != nil {
+		// the legacy btcutil package only decodes bech32, fall back to a bech32m taproot address
+		taprootAddress, errTaproot := decodeTaprootAddress(addr, params)
+		if errTaproot != nil {
+			return nil, err
+		}
+		address = taprootAddress
+	}
+	if !address.IsForNet(params) {
+		return nil, fmt.Errorf("address %s is not for network %s", addr, params.Name)
+	}
+	return address, nil
+}
+
+// DecodeReceiverAddress decodes a bitcoin address that can receive a withdrawal
+// the supported address types are P2WPKH, P2WSH, P2TR and P2SH (P2SH-P2WPKH)
+func DecodeReceiverAddress(addr string, params *chaincfg.Params) (btcutil.Address, error) {
+	address, err := DecodeAddress(addr, params)
+	if err != nil {
+		return nil, err
+	}
+	if !IsSupportedReceiverAddress(address) {
+		return nil, fmt.Errorf("address %s type %T not supported", addr, address)
+	}
+	return address, nil
+}
+
+// IsSupportedReceiverAddress returns true if a withdrawal can be sent to the address
+func IsSupportedReceiverAddress(address btcutil.Address) bool {
+	switch address.(type) {
+	case *btcutil.AddressWitnessPubKeyHash,
+		*btcutil.AddressWitnessScriptHash,
+		*AddressTaproot,
+		*btcutil.AddressScriptHash:
+		return true
+	}
+	return false
+}
+
+// PayToAddrScript returns the scriptPubKey paying to a supported receiver address
+func PayToAddrScript(address btcutil.Address) ([]byte, error) {
+	switch addr := address.(type) {
+	case *btcutil.AddressWitnessPubKeyHash:
+		return payToWitnessScript(txscript.OP_0, addr.WitnessProgram())
+	case *btcutil.AddressWitnessScriptHash:
+		return payToWitnessScript(txscript.OP_0, addr.WitnessProgram())
+	case *AddressTaproot:
+		return payToWitnessScript(txscript.OP_1, addr.WitnessProgram())
+	case *btcutil.AddressScriptHash:
+		return txscript.NewScriptBuilder().
+			AddOp(txscript.OP_HASH160).
+			AddData(addr.ScriptAddress()).
+			AddOp(txscript.OP_EQUAL).
+			Script()
+	}
+	return nil, fmt.Errorf("address %s type %T not supported", address.EncodeAddress(), address)
+}
+
+func payToWitnessScript(version byte, witnessProgram []byte) ([]byte, error) {
+	return txscript.NewScriptBuilder().AddOp(version).AddData(witnessProgram).Script()
+}
+
+// AddressFromScript returns the address a standard scriptPubKey pays to
+// the supported scripts are P2WPKH, P2WSH, P2TR, P2SH and P2PKH
+func AddressFromScript(script []byte, params *chaincfg.Params) (btcutil.Address, error) {
+	switch {
+	case len(script) == 2+witnessProgramLenPubKeyHash &&
+		script[0] == txscript.OP_0 && script[1] == txscript.OP_DATA_20:
+		return btcutil.NewAddressWitnessPubKeyHash(script[2:], params)
+	case len(script) == 2+witnessProgramLenScriptHash &&
+		script[0] == txscript.OP_0 && script[1] == txscript.OP_DATA_32:
+		return btcutil.NewAddressWitnessScriptHash(script[2:], params)
+	case len(script) == 2+witnessProgramLenScriptHash &&
+		script[0] == txscript.OP_1 && script[1] == txscript.OP_DATA_32:
+		return NewAddressTaproot(script[2:], params)
+	case len(script) == 23 &&
+		script[0] == txscript.OP_HASH160 && script[1] == txscript.OP_DATA_20 && script[22] == txscript.OP_EQUAL:
+		return btcutil.NewAddressScriptHashFromHash(script[2:22], params)
+	case len(script) == 25 &&
+		script[0] == txscript.OP_DUP && script[1] == txscript.OP_HASH160 && script[2] == txscript.OP_DATA_20 &&
+		script[23] == txscript.OP_EQUALVERIFY && script[24] == txscript.OP_CHECKSIG:
+		return btcutil.NewAddressPubKeyHash(script[3:23], params)
+	}
+	return nil, fmt.Errorf("unsupported script %x", script)
+}
+
+// SenderAddressFromWitness derives the address spent by an input from its witness and signature script
+// P2WPKH, P2SH-P2WPKH, P2WSH and P2SH-P2WSH inputs are supported
+// nil is returned if the address can't be derived from the input, this is the case for taproot inputs that only
+// reveal the tweaked key through the previous output
+func SenderAddressFromWitness(witness [][]byte, sigScript []byte, params *chaincfg.Params) (btcutil.Address, error) {
+	if len(witness) == 0 || isTaprootWitness(witness) {
+		return nil, nil
+	}
+
+	// the witness program is pushed in the signature script for nested segwit inputs
+	if len(sigScript) > 0 {
+		redeemScript, ok := nestedWitnessProgram(sigScript)
+		if !ok {
+			return nil, nil
+		}
+		return btcutil.NewAddressScriptHash(redeemScript, params)
+	}
+
+	last := witness[len(witness)-1]
+	if len(witness) == 2 && len(last) == compressedPubKeyLen && (last[0] == 0x02 || last[0] == 0x03) {
+		return btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(last), params)
+	}
+	scriptHash := sha256.Sum256(last)
+	return btcutil.NewAddressWitnessScriptHash(scriptHash[:], params)
+}
+
+// nestedWitnessProgram returns the redeem script of a P2SH-P2WPKH or P2SH-P2WSH signature script
+func nestedWitnessProgram(sigScript []byte) ([]byte, bool) {
+	if len(sigScript) < 2 || int(sigScript[0]) != len(sigScript)-1 {
+		return nil, false
+	}
+	redeemScript := sigScript[1:]
+	switch {
+	case len(redeemScript) == 2+witnessProgramLenPubKeyHash &&
+		bytes.Equal(redeemScript[:2], []byte{txscript.OP_0, txscript.OP_DATA_20}):
+		return redeemScript, true
+	case len(redeemScript) == 2+witnessProgramLenScriptHash &&
+		bytes.Equal(redeemScript[:2], []byte{txscript.OP_0, txscript.OP_DATA_32}):
+		return redeemScript, true
+	}
+	return nil, false
+}
+
+// isTaprootWitness returns true if the witness spends a taproot output, through the key path or the script path
+func isTaprootWitness(witness [][]byte) bool {
+	// remove the annex if any
+	if len(witness) >= 2 {
+		last := witness[len(witness)-1]
+		if len(last) > 0 && last[0] == taprootAnnexTag {
+			witness = witness[:len(witness)-1]
+		}
+	}
+
+	// key path spend: a single schnorr signature
+	if len(witness) == 1 {
+		return len(witness[0]) == 64 || len(witness[0]) == 65
+	}
+
+	// script path spend: the last element is the control block
+	controlBlock := witness[len(witness)-1]
+	return len(controlBlock) >= taprootControlBlockBaseLen &&
+		(len(controlBlock)-taprootControlBlockBaseLen)%taprootControlBlockNodeLen == 0 &&
+		controlBlock[0]&taprootLeafMask == taprootLeafVersion
+}
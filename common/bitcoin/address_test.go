@@ -0,0 +1,166 @@
+package bitcoin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// compressed public key used to build the test addresses
+const testPubKey = "02d0fd59f5e5ca3bdbb0e8e0d43d8da21acd0178e3a3cfadfe0c5a5dccd1bf2c6e"
+
+func testAddresses(t *testing.T, net *chaincfg.Params) (p2wpkh, p2wsh, p2tr, p2sh, p2pkh btcutil.Address) {
+	pubKey, err := hex.DecodeString(testPubKey)
+	require.NoError(t, err)
+
+	p2wpkh, err = btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(pubKey), net)
+	require.NoError(t, err)
+	scriptHash := sha256.Sum256(pubKey)
+	p2wsh, err = btcutil.NewAddressWitnessScriptHash(scriptHash[:], net)
+	require.NoError(t, err)
+	p2tr, err = NewAddressTaproot(pubKey[1:], net)
+	require.NoError(t, err)
+	p2sh, err = btcutil.NewAddressScriptHash(append([]byte{0x00, 0x14}, btcutil.Hash160(pubKey)...), net)
+	require.NoError(t, err)
+	p2pkh, err = btcutil.NewAddressPubKeyHash(btcutil.Hash160(pubKey), net)
+	require.NoError(t, err)
+	return
+}
+
+func TestDecodeReceiverAddress(t *testing.T) {
+	net := &chaincfg.TestNet3Params
+	p2wpkh, p2wsh, p2tr, p2sh, p2pkh := testAddresses(t, net)
+
+	t.Run("supported receivers", func(t *testing.T) {
+		for _, addr := range []btcutil.Address{p2wpkh, p2wsh, p2tr, p2sh} {
+			decoded, err := DecodeReceiverAddress(addr.EncodeAddress(), net)
+			require.NoError(t, err)
+			require.Equal(t, addr.EncodeAddress(), decoded.EncodeAddress())
+		}
+	})
+
+	t.Run("legacy address not supported as receiver", func(t *testing.T) {
+		_, err := DecodeReceiverAddress(p2pkh.EncodeAddress(), net)
+		require.Error(t, err)
+
+		// but can be decoded
+		_, err = DecodeAddress(p2pkh.EncodeAddress(), net)
+		require.NoError(t, err)
+	})
+
+	t.Run("address for another network", func(t *testing.T) {
+		_, err := DecodeReceiverAddress(p2tr.EncodeAddress(), &chaincfg.MainNetParams)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		_, err := DecodeReceiverAddress("tb1pinvalid", net)
+		require.Error(t, err)
+	})
+
+	t.Run("taproot address from BIP-350 test vectors", func(t *testing.T) {
+		addr, err := DecodeReceiverAddress("bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		require.IsType(t, &AddressTaproot{}, addr)
+		require.Equal(t, "79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", hex.EncodeToString(addr.ScriptAddress()))
+		require.Equal(t, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", addr.EncodeAddress())
+
+		// witness v1 encoded with the bech32 checksum is rejected
+		_, err = DecodeReceiverAddress("bc1pw508d6qejxtdg4y5r3zarqfsj6c3", &chaincfg.MainNetParams)
+		require.Error(t, err)
+	})
+}
+
+func TestPayToAddrScript(t *testing.T) {
+	net := &chaincfg.TestNet3Params
+	p2wpkh, p2wsh, p2tr, p2sh, p2pkh := testAddresses(t, net)
+
+	t.Run("script can be decoded back to the address", func(t *testing.T) {
+		for _, addr := range []btcutil.Address{p2wpkh, p2wsh, p2tr, p2sh} {
+			script, err := PayToAddrScript(addr)
+			require.NoError(t, err)
+			decoded, err := AddressFromScript(script, net)
+			require.NoError(t, err)
+			require.Equal(t, addr.EncodeAddress(), decoded.EncodeAddress())
+		}
+	})
+
+	t.Run("taproot script", func(t *testing.T) {
+		script, err := PayToAddrScript(p2tr)
+		require.NoError(t, err)
+		require.Len(t, script, 34)
+		require.Equal(t, byte(0x51), script[0])
+		require.Equal(t, byte(0x20), script[1])
+	})
+
+	t.Run("legacy address not supported", func(t *testing.T) {
+		_, err := PayToAddrScript(p2pkh)
+		require.Error(t, err)
+	})
+
+	t.Run("legacy script can be decoded", func(t *testing.T) {
+		script := append([]byte{0x76, 0xa9, 0x14}, p2pkh.ScriptAddress()...)
+		script = append(script, 0x88, 0xac)
+		decoded, err := AddressFromScript(script, net)
+		require.NoError(t, err)
+		require.Equal(t, p2pkh.EncodeAddress(), decoded.EncodeAddress())
+	})
+
+	t.Run("non-standard script", func(t *testing.T) {
+		_, err := AddressFromScript([]byte{0x6a, 0x01, 0x00}, net)
+		require.Error(t, err)
+	})
+}
+
+func TestSenderAddressFromWitness(t *testing.T) {
+	net := &chaincfg.TestNet3Params
+	p2wpkh, p2wsh, _, p2sh, _ := testAddresses(t, net)
+	pubKey, err := hex.DecodeString(testPubKey)
+	require.NoError(t, err)
+	sig := bytes.Repeat([]byte{0x30}, 71)
+
+	t.Run("P2WPKH", func(t *testing.T) {
+		addr, err := SenderAddressFromWitness([][]byte{sig, pubKey}, nil, net)
+		require.NoError(t, err)
+		require.Equal(t, p2wpkh.EncodeAddress(), addr.EncodeAddress())
+	})
+
+	t.Run("P2SH-P2WPKH", func(t *testing.T) {
+		redeemScript := append([]byte{0x00, 0x14}, btcutil.Hash160(pubKey)...)
+		sigScript := append([]byte{byte(len(redeemScript))}, redeemScript...)
+		addr, err := SenderAddressFromWitness([][]byte{sig, pubKey}, sigScript, net)
+		require.NoError(t, err)
+		require.Equal(t, p2sh.EncodeAddress(), addr.EncodeAddress())
+	})
+
+	t.Run("P2WSH", func(t *testing.T) {
+		// the witness script used to build the test P2WSH address is the public key itself
+		addr, err := SenderAddressFromWitness([][]byte{{}, sig, sig, pubKey[:32], pubKey}, nil, net)
+		require.NoError(t, err)
+		require.Equal(t, p2wsh.EncodeAddress(), addr.EncodeAddress())
+	})
+
+	t.Run("taproot key path spend can't be derived", func(t *testing.T) {
+		addr, err := SenderAddressFromWitness([][]byte{bytes.Repeat([]byte{0x01}, 64)}, nil, net)
+		require.NoError(t, err)
+		require.Nil(t, addr)
+	})
+
+	t.Run("taproot script path spend can't be derived", func(t *testing.T) {
+		controlBlock := append([]byte{0xc0}, pubKey[1:]...)
+		addr, err := SenderAddressFromWitness([][]byte{sig[:64], {0x51}, controlBlock}, nil, net)
+		require.NoError(t, err)
+		require.Nil(t, addr)
+	})
+
+	t.Run("non segwit input can't be derived", func(t *testing.T) {
+		addr, err := SenderAddressFromWitness(nil, []byte{0x01, 0x02}, net)
+		require.NoError(t, err)
+		require.Nil(t, addr)
+	})
+}
@@ -0,0 +1,165 @@
+package bitcoin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+const (
+	// bech32mConst is the constant of the bech32m checksum (BIP-350), the checksum of bech32 uses 1
+	bech32mConst = 0x2bc830a3
+
+	// bech32Charset is the character set of the bech32 encoding
+	bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	// taprootWitnessVersion is the witness version of a P2TR output
+	taprootWitnessVersion = 1
+)
+
+// AddressTaproot is a pay-to-taproot (P2TR) address, the legacy btcutil package doesn't support segwit v1 addresses
+type AddressTaproot struct {
+	hrp            string
+	witnessProgram [witnessProgramLenScriptHash]byte
+}
+
+var _ btcutil.Address = &AddressTaproot{}
+
+// NewAddressTaproot returns a new P2TR address for the 32-byte output key
+func NewAddressTaproot(witnessProgram []byte, params *chaincfg.Params) (*AddressTaproot, error) {
+	if len(witnessProgram) != witnessProgramLenScriptHash {
+		return nil, fmt.Errorf("witness program must be %d bytes for p2tr", witnessProgramLenScriptHash)
+	}
+	addr := &AddressTaproot{hrp: strings.ToLower(params.Bech32HRPSegwit)}
+	copy(addr.witnessProgram[:], witnessProgram)
+	return addr, nil
+}
+
+// EncodeAddress returns the bech32m encoding of the address
+func (a *AddressTaproot) EncodeAddress() string {
+	program, err := bech32.ConvertBits(a.witnessProgram[:], 8, 5, true)
+	if err != nil {
+		return ""
+	}
+	return encodeBech32m(a.hrp, append([]byte{taprootWitnessVersion}, program...))
+}
+
+// ScriptAddress returns the witness program of the address
+func (a *AddressTaproot) ScriptAddress() []byte {
+	return a.witnessProgram[:]
+}
+
+// IsForNet returns true if the address is for the network
+func (a *AddressTaproot) IsForNet(params *chaincfg.Params) bool {
+	return a.hrp == strings.ToLower(params.Bech32HRPSegwit)
+}
+
+// String returns the bech32m encoding of the address
+func (a *AddressTaproot) String() string {
+	return a.EncodeAddress()
+}
+
+// WitnessProgram returns the witness program of the address
+func (a *AddressTaproot) WitnessProgram() []byte {
+	return a.witnessProgram[:]
+}
+
+// decodeTaprootAddress decodes a bech32m encoded P2TR address for the network
+func decodeTaprootAddress(addr string, params *chaincfg.Params) (*AddressTaproot, error) {
+	hrp, data, err := decodeBech32m(addr)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != strings.ToLower(params.Bech32HRPSegwit) {
+		return nil, fmt.Errorf("address %s is not for network %s", addr, params.Name)
+	}
+	if len(data) < 1 || data[0] != taprootWitnessVersion {
+		return nil, fmt.Errorf("address %s is not a segwit v1 address", addr)
+	}
+	program, err := bech32.ConvertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+	return NewAddressTaproot(program, params)
+}
+
+// bech32mPolymod computes the BCH checksum of the bech32 encoding
+func bech32mPolymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HrpExpand(hrp string) []byte {
+	expanded := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	return expanded
+}
+
+// encodeBech32m encodes the 5-bit groups of data with the bech32m checksum
+func encodeBech32m(hrp string, data []byte) string {
+	values := append(bech32HrpExpand(hrp), data...)
+	polymod := bech32mPolymod(append(values, 0, 0, 0, 0, 0, 0)) ^ bech32mConst
+
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, b := range data {
+		sb.WriteByte(bech32Charset[b])
+	}
+	for i := 0; i < 6; i++ {
+		sb.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+	return sb.String()
+}
+
+// decodeBech32m decodes a bech32m string into its human readable part and 5-bit groups of data
+func decodeBech32m(s string) (string, []byte, error) {
+	if len(s) < 8 || len(s) > 90 {
+		return "", nil, fmt.Errorf("invalid bech32m string length %d", len(s))
+	}
+	if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+		return "", nil, fmt.Errorf("bech32m string %s has mixed case", s)
+	}
+	s = strings.ToLower(s)
+	sep := strings.LastIndexByte(s, '1')
+	if sep < 1 || sep+7 > len(s) {
+		return "", nil, fmt.Errorf("invalid separator index %d", sep)
+	}
+	hrp := s[:sep]
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", nil, fmt.Errorf("invalid character in human readable part %s", hrp)
+		}
+	}
+	data := make([]byte, 0, len(s)-sep-1)
+	for i := sep + 1; i < len(s); i++ {
+		idx := strings.IndexByte(bech32Charset, s[i])
+		if idx < 0 {
+			return "", nil, fmt.Errorf("invalid character %c in bech32m string", s[i])
+		}
+		data = append(data, byte(idx))
+	}
+	if bech32mPolymod(append(bech32HrpExpand(hrp), data...)) != bech32mConst {
+		return "", nil, fmt.Errorf("invalid bech32m checksum of %s", s)
+	}
+	return hrp, data[:len(data)-6], nil
+}
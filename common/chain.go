@@ -5,8 +5,7 @@ import (
 	"strings"
 
 	"github.com/btcsuite/btcd/chaincfg"
-	"github.com/btcsuite/btcutil"
-	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/node/common/bitcoin"
 )
 
 var (
@@ -44,53 +43,19 @@ func (chain Chain) IsExternalChain() bool {
 	return !chain.IsEqual(ZetaChain())
 }
 
-// bytes representations of address
-// on EVM chain, it is 20Bytes
-// on Bitcoin chain, it is P2WPKH address, []byte(bech32 encoded string)
-func (chain Chain) EncodeAddress(b []byte) (string, error) {
-	if IsEVMChain(chain.ChainId) {
-		addr := ethcommon.BytesToAddress(b)
-		if addr == (ethcommon.Address{}) {
-			return "", fmt.Errorf("invalid EVM address")
-		}
-		return addr.Hex(), nil
-	} else if IsBitcoinChain(chain.ChainId) {
-		addrStr := string(b)
-		chainParams, err := GetBTCChainParams(chain.ChainId)
-		if err != nil {
-			return "", err
-		}
-		_, err = btcutil.DecodeAddress(addrStr, chainParams)
-		if err != nil {
-			return "", err
-		}
-		return addrStr, nil
-	}
-	return "", fmt.Errorf("chain (%d) not supported", chain.ChainId)
-}
-
-func (chain Chain) BTCAddressFromWitnessProgram(witnessProgram []byte) (string, error) {
+// BTCAddressFromScript returns the address a bitcoin scriptPubKey pays to
+func (chain Chain) BTCAddressFromScript(script []byte) (string, error) {
 	chainParams, err := GetBTCChainParams(chain.ChainId)
 	if err != nil {
 		return "", err
 	}
-	address, err := btcutil.NewAddressWitnessPubKeyHash(witnessProgram, chainParams)
+	address, err := bitcoin.AddressFromScript(script, chainParams)
 	if err != nil {
 		return "", err
 	}
 	return address.EncodeAddress(), nil
 }
 
-// DecodeAddress decode the address string to bytes
-func (chain Chain) DecodeAddress(addr string) ([]byte, error) {
-	if IsEVMChain(chain.ChainId) {
-		return ethcommon.HexToAddress(addr).Bytes(), nil
-	} else if IsBitcoinChain(chain.ChainId) {
-		return []byte(addr), nil
-	}
-	return nil, fmt.Errorf("chain (%d) not supported", chain.ChainId)
-}
-
 func IsEVMChain(chainID int64) bool {
 	return chainID == 5 || // Goerli
 		chainID == 80001 || // Polygon mumbai
@@ -0,0 +1,177 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/node/common/bitcoin"
+)
+
+// DefaultChainInfoList returns the registry entries for the default chains of the build
+func DefaultChainInfoList() []ChainInfo {
+	chains := DefaultChainsList()
+	infos := make([]ChainInfo, len(chains))
+	for i, chain := range chains {
+		infos[i] = defaultChainInfo(*chain)
+	}
+	return infos
+}
+
+// defaultChainInfo returns the registry entry of the chains known at build time, it is only used to build the default
+// registry, the chains are resolved from the chain registry stored in the observer module
+func defaultChainInfo(chain Chain) ChainInfo {
+	switch chain.ChainId {
+	case 1, 56, 137:
+		return evmChainInfo(chain, NetworkType_mainnet, 14)
+	case 5, 97:
+		return evmChainInfo(chain, NetworkType_testnet, 6)
+	case 80001, 1001:
+		return evmChainInfo(chain, NetworkType_testnet, 12)
+	case 1337:
+		return evmChainInfo(chain, NetworkType_privnet, 2)
+	case 8332:
+		return bitcoinChainInfo(chain, NetworkType_mainnet)
+	case 18332:
+		return bitcoinChainInfo(chain, NetworkType_testnet)
+	case 18444:
+		return bitcoinChainInfo(chain, NetworkType_privnet)
+	case 7000:
+		return zetaChainInfo(chain, NetworkType_mainnet)
+	case 7001:
+		return zetaChainInfo(chain, NetworkType_testnet)
+	case 101:
+		return zetaChainInfo(chain, NetworkType_privnet)
+	}
+	return ChainInfo{Chain: chain}
+}
+
+func evmChainInfo(chain Chain, network NetworkType, confirmationCount uint64) ChainInfo {
+	return ChainInfo{
+		Chain:                    chain,
+		VmFamily:                 VmFamily_evm,
+		NetworkType:              network,
+		AddressCodec:             AddressCodec_hex,
+		DefaultConfirmationCount: confirmationCount,
+	}
+}
+
+func bitcoinChainInfo(chain Chain, network NetworkType) ChainInfo {
+	return ChainInfo{
+		Chain:                    chain,
+		VmFamily:                 VmFamily_bitcoin,
+		NetworkType:              network,
+		AddressCodec:             AddressCodec_bech32_btc,
+		DefaultConfirmationCount: 2,
+	}
+}
+
+func zetaChainInfo(chain Chain, network NetworkType) ChainInfo {
+	return ChainInfo{
+		Chain:        chain,
+		VmFamily:     VmFamily_zeta_core,
+		NetworkType:  network,
+		AddressCodec: AddressCodec_bech32_cosmos,
+	}
+}
+
+// Validate performs basic checks on a chain registry entry
+func (info ChainInfo) Validate() error {
+	if info.Chain.ChainId <= 0 {
+		return fmt.Errorf("invalid chain id %d", info.Chain.ChainId)
+	}
+	if _, ok := VmFamily_name[int32(info.VmFamily)]; !ok || info.VmFamily == VmFamily_no_vm {
+		return fmt.Errorf("invalid vm family %d", info.VmFamily)
+	}
+	if _, ok := NetworkType_name[int32(info.NetworkType)]; !ok || info.NetworkType == NetworkType_undefined_network {
+		return fmt.Errorf("invalid network type %d", info.NetworkType)
+	}
+	if _, ok := AddressCodec_name[int32(info.AddressCodec)]; !ok || info.AddressCodec == AddressCodec_no_codec {
+		return fmt.Errorf("invalid address codec %d", info.AddressCodec)
+	}
+	switch info.VmFamily {
+	case VmFamily_evm:
+		if info.AddressCodec != AddressCodec_hex {
+			return fmt.Errorf("address codec %s not supported for evm chains", info.AddressCodec)
+		}
+	case VmFamily_bitcoin:
+		if info.AddressCodec != AddressCodec_bech32_btc {
+			return fmt.Errorf("address codec %s not supported for bitcoin chains", info.AddressCodec)
+		}
+	}
+	if info.VmFamily != VmFamily_zeta_core && info.DefaultConfirmationCount == 0 {
+		return fmt.Errorf("default confirmation count must be greater than 0")
+	}
+	return nil
+}
+
+// IsEVMChain returns true if the chain is an EVM chain
+func (info ChainInfo) IsEVMChain() bool {
+	return info.VmFamily == VmFamily_evm
+}
+
+// IsBitcoinChain returns true if the chain is a Bitcoin chain
+func (info ChainInfo) IsBitcoinChain() bool {
+	return info.VmFamily == VmFamily_bitcoin
+}
+
+// BTCChainParams returns the bitcoin network parameters of the chain, selected from its network type
+func (info ChainInfo) BTCChainParams() (*chaincfg.Params, error) {
+	if !info.IsBitcoinChain() {
+		return nil, fmt.Errorf("error chainID %d is not a Bitcoin chain", info.Chain.ChainId)
+	}
+	switch info.NetworkType {
+	case NetworkType_privnet:
+		return &chaincfg.RegressionNetParams, nil
+	case NetworkType_testnet:
+		return &chaincfg.TestNet3Params, nil
+	case NetworkType_mainnet:
+		return &chaincfg.MainNetParams, nil
+	default:
+		return nil, fmt.Errorf("error chainID %d has no Bitcoin network", info.Chain.ChainId)
+	}
+}
+
+// EncodeAddress returns the string representation of the address bytes of the chain
+// on EVM chain, it is 20Bytes
+// on Bitcoin chain, it is P2WPKH address, []byte(bech32 encoded string)
+func (info ChainInfo) EncodeAddress(b []byte) (string, error) {
+	switch info.AddressCodec {
+	case AddressCodec_hex:
+		addr := ethcommon.BytesToAddress(b)
+		if addr == (ethcommon.Address{}) {
+			return "", fmt.Errorf("invalid EVM address")
+		}
+		return addr.Hex(), nil
+	case AddressCodec_bech32_btc:
+		addrStr := string(b)
+		chainParams, err := info.BTCChainParams()
+		if err != nil {
+			return "", err
+		}
+		_, err = bitcoin.DecodeReceiverAddress(addrStr, chainParams)
+		if err != nil {
+			return "", err
+		}
+		return addrStr, nil
+	}
+	return "", fmt.Errorf("chain (%d) not supported", info.Chain.ChainId)
+}
+
+// DecodeAddress decode the address string of the chain to bytes
+func (info ChainInfo) DecodeAddress(addr string) ([]byte, error) {
+	switch info.AddressCodec {
+	case AddressCodec_hex:
+		return ethcommon.HexToAddress(addr).Bytes(), nil
+	case AddressCodec_bech32_btc:
+		chainParams, err := info.BTCChainParams()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := bitcoin.DecodeAddress(addr, chainParams); err != nil {
+			return nil, err
+		}
+		return []byte(addr), nil
+	}
+	return nil, fmt.Errorf("chain (%d) not supported", info.Chain.ChainId)
+}
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultChainInfoList(t *testing.T) {
+	infos := DefaultChainInfoList()
+	require.Len(t, infos, len(DefaultChainsList()))
+	for i, chain := range DefaultChainsList() {
+		require.Equal(t, *chain, infos[i].Chain)
+		require.Equal(t, IsEVMChain(chain.ChainId), infos[i].IsEVMChain())
+		require.Equal(t, IsBitcoinChain(chain.ChainId), infos[i].IsBitcoinChain())
+	}
+}
+
+func TestChainInfo(t *testing.T) {
+	// a chain unknown at build time is resolved from its registry entry
+	newChain := Chain{ChainId: 424242}
+	require.False(t, IsEVMChain(newChain.ChainId))
+	require.Nil(t, GetChainFromChainID(newChain.ChainId))
+	info := ChainInfo{
+		Chain:                    newChain,
+		VmFamily:                 VmFamily_evm,
+		NetworkType:              NetworkType_testnet,
+		AddressCodec:             AddressCodec_hex,
+		DefaultConfirmationCount: 10,
+	}
+	require.True(t, info.IsEVMChain())
+	require.False(t, info.IsBitcoinChain())
+	_, err := info.BTCChainParams()
+	require.Error(t, err)
+
+	addr, err := info.EncodeAddress(DeadAddress.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, DeadAddress.Hex(), addr)
+	b, err := info.DecodeAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, DeadAddress.Bytes(), b)
+
+	btcInfo := ChainInfo{
+		Chain:                    Chain{ChainId: 424243},
+		VmFamily:                 VmFamily_bitcoin,
+		NetworkType:              NetworkType_privnet,
+		AddressCodec:             AddressCodec_bech32_btc,
+		DefaultConfirmationCount: 2,
+	}
+	params, err := btcInfo.BTCChainParams()
+	require.NoError(t, err)
+	require.Equal(t, &chaincfg.RegressionNetParams, params)
+}
+
+func TestChainInfo_Validate(t *testing.T) {
+	for _, info := range DefaultChainInfoList() {
+		require.NoError(t, info.Validate())
+	}
+
+	valid := ChainInfo{
+		Chain:                    Chain{ChainId: 424242},
+		VmFamily:                 VmFamily_bitcoin,
+		NetworkType:              NetworkType_mainnet,
+		AddressCodec:             AddressCodec_bech32_btc,
+		DefaultConfirmationCount: 1,
+	}
+	require.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.Chain.ChainId = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.VmFamily = VmFamily_no_vm
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.NetworkType = NetworkType_undefined_network
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.AddressCodec = AddressCodec_hex
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.DefaultConfirmationCount = 0
+	require.Error(t, invalid.Validate())
+}
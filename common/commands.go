@@ -4,4 +4,10 @@ package common
 
 const (
 	CmdWhitelistERC20 = "cmd_whitelist_erc20"
+
+	// CmdMigrateTssFunds migrates the funds of the current TSS address to the new TSS address
+	CmdMigrateTssFunds = "cmd_migrate_tss_funds"
+
+	// CmdMigrateERC20CustodyFunds updates the TSS address of the ERC20 custody contract to the new TSS address
+	CmdMigrateERC20CustodyFunds = "cmd_migrate_erc20_custody_funds"
 )
@@ -152,6 +152,103 @@ func (ChainName) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8f954d82c0b891f6, []int{2}
 }
 
+// VmFamily defines the execution environment of a chain, it determines how
+// zetaclient observes the chain and how addresses are encoded
+type VmFamily int32
+
+const (
+	VmFamily_no_vm     VmFamily = 0
+	VmFamily_evm       VmFamily = 1
+	VmFamily_bitcoin   VmFamily = 2
+	VmFamily_zeta_core VmFamily = 3
+)
+
+var VmFamily_name = map[int32]string{
+	0: "no_vm",
+	1: "evm",
+	2: "bitcoin",
+	3: "zeta_core",
+}
+
+var VmFamily_value = map[string]int32{
+	"no_vm":     0,
+	"evm":       1,
+	"bitcoin":   2,
+	"zeta_core": 3,
+}
+
+func (x VmFamily) String() string {
+	return proto.EnumName(VmFamily_name, int32(x))
+}
+
+func (VmFamily) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f954d82c0b891f6, []int{3}
+}
+
+// NetworkType defines the network a chain belongs to
+type NetworkType int32
+
+const (
+	NetworkType_undefined_network NetworkType = 0
+	NetworkType_mainnet           NetworkType = 1
+	NetworkType_testnet           NetworkType = 2
+	NetworkType_privnet           NetworkType = 3
+)
+
+var NetworkType_name = map[int32]string{
+	0: "undefined_network",
+	1: "mainnet",
+	2: "testnet",
+	3: "privnet",
+}
+
+var NetworkType_value = map[string]int32{
+	"undefined_network": 0,
+	"mainnet":           1,
+	"testnet":           2,
+	"privnet":           3,
+}
+
+func (x NetworkType) String() string {
+	return proto.EnumName(NetworkType_name, int32(x))
+}
+
+func (NetworkType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f954d82c0b891f6, []int{4}
+}
+
+// AddressCodec defines how addresses of a chain are encoded and decoded
+type AddressCodec int32
+
+const (
+	AddressCodec_no_codec      AddressCodec = 0
+	AddressCodec_hex           AddressCodec = 1
+	AddressCodec_bech32_btc    AddressCodec = 2
+	AddressCodec_bech32_cosmos AddressCodec = 3
+)
+
+var AddressCodec_name = map[int32]string{
+	0: "no_codec",
+	1: "hex",
+	2: "bech32_btc",
+	3: "bech32_cosmos",
+}
+
+var AddressCodec_value = map[string]int32{
+	"no_codec":      0,
+	"hex":           1,
+	"bech32_btc":    2,
+	"bech32_cosmos": 3,
+}
+
+func (x AddressCodec) String() string {
+	return proto.EnumName(AddressCodec_name, int32(x))
+}
+
+func (AddressCodec) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f954d82c0b891f6, []int{5}
+}
+
 // PubKeySet contains two pub keys , secp256k1 and ed25519
 type PubKeySet struct {
 	Secp256k1 PubKey `protobuf:"bytes,1,opt,name=secp256k1,proto3,casttype=PubKey" json:"secp256k1,omitempty"`
@@ -257,6 +354,84 @@ func (m *Chain) GetChainId() int64 {
 	return 0
 }
 
+// ChainInfo is an entry of the chain registry, it contains the information
+// required by zetacore and zetaclient to support a chain
+type ChainInfo struct {
+	Chain                    Chain        `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain"`
+	VmFamily                 VmFamily     `protobuf:"varint,2,opt,name=vm_family,json=vmFamily,proto3,enum=common.VmFamily" json:"vm_family,omitempty"`
+	NetworkType              NetworkType  `protobuf:"varint,3,opt,name=network_type,json=networkType,proto3,enum=common.NetworkType" json:"network_type,omitempty"`
+	AddressCodec             AddressCodec `protobuf:"varint,4,opt,name=address_codec,json=addressCodec,proto3,enum=common.AddressCodec" json:"address_codec,omitempty"`
+	DefaultConfirmationCount uint64       `protobuf:"varint,5,opt,name=default_confirmation_count,json=defaultConfirmationCount,proto3" json:"default_confirmation_count,omitempty"`
+}
+
+func (m *ChainInfo) Reset()         { *m = ChainInfo{} }
+func (m *ChainInfo) String() string { return proto.CompactTextString(m) }
+func (*ChainInfo) ProtoMessage()    {}
+func (*ChainInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f954d82c0b891f6, []int{2}
+}
+func (m *ChainInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainInfo.Merge(m, src)
+}
+func (m *ChainInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainInfo proto.InternalMessageInfo
+
+func (m *ChainInfo) GetChain() Chain {
+	if m != nil {
+		return m.Chain
+	}
+	return Chain{}
+}
+
+func (m *ChainInfo) GetVmFamily() VmFamily {
+	if m != nil {
+		return m.VmFamily
+	}
+	return VmFamily_no_vm
+}
+
+func (m *ChainInfo) GetNetworkType() NetworkType {
+	if m != nil {
+		return m.NetworkType
+	}
+	return NetworkType_undefined_network
+}
+
+func (m *ChainInfo) GetAddressCodec() AddressCodec {
+	if m != nil {
+		return m.AddressCodec
+	}
+	return AddressCodec_no_codec
+}
+
+func (m *ChainInfo) GetDefaultConfirmationCount() uint64 {
+	if m != nil {
+		return m.DefaultConfirmationCount
+	}
+	return 0
+}
+
 type BlockHeader struct {
 	Height     int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Hash       []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -270,7 +445,7 @@ func (m *BlockHeader) Reset()         { *m = BlockHeader{} }
 func (m *BlockHeader) String() string { return proto.CompactTextString(m) }
 func (*BlockHeader) ProtoMessage()    {}
 func (*BlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{2}
+	return fileDescriptor_8f954d82c0b891f6, []int{3}
 }
 func (m *BlockHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +521,7 @@ func (m *HeaderData) Reset()         { *m = HeaderData{} }
 func (m *HeaderData) String() string { return proto.CompactTextString(m) }
 func (*HeaderData) ProtoMessage()    {}
 func (*HeaderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{3}
+	return fileDescriptor_8f954d82c0b891f6, []int{4}
 }
 func (m *HeaderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,7 +607,7 @@ func (m *Proof) Reset()         { *m = Proof{} }
 func (m *Proof) String() string { return proto.CompactTextString(m) }
 func (*Proof) ProtoMessage()    {}
 func (*Proof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{4}
+	return fileDescriptor_8f954d82c0b891f6, []int{5}
 }
 func (m *Proof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,8 +685,12 @@ func init() {
 	proto.RegisterEnum("common.ReceiveStatus", ReceiveStatus_name, ReceiveStatus_value)
 	proto.RegisterEnum("common.CoinType", CoinType_name, CoinType_value)
 	proto.RegisterEnum("common.ChainName", ChainName_name, ChainName_value)
+	proto.RegisterEnum("common.VmFamily", VmFamily_name, VmFamily_value)
+	proto.RegisterEnum("common.NetworkType", NetworkType_name, NetworkType_value)
+	proto.RegisterEnum("common.AddressCodec", AddressCodec_name, AddressCodec_value)
 	proto.RegisterType((*PubKeySet)(nil), "common.PubKeySet")
 	proto.RegisterType((*Chain)(nil), "common.Chain")
+	proto.RegisterType((*ChainInfo)(nil), "common.ChainInfo")
 	proto.RegisterType((*BlockHeader)(nil), "common.BlockHeader")
 	proto.RegisterType((*HeaderData)(nil), "common.HeaderData")
 	proto.RegisterType((*Proof)(nil), "common.Proof")
@@ -520,50 +699,66 @@ func init() {
 func init() { proto.RegisterFile("common/common.proto", fileDescriptor_8f954d82c0b891f6) }
 
 var fileDescriptor_8f954d82c0b891f6 = []byte{
-	// 682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x94, 0xcd, 0x6a, 0xdb, 0x4a,
-	0x14, 0x80, 0x25, 0xff, 0xeb, 0xd8, 0xb1, 0x75, 0x27, 0x97, 0x7b, 0x73, 0xc3, 0x45, 0x0e, 0xa6,
-	0xa5, 0x69, 0xa0, 0x4e, 0xe2, 0xe2, 0xfe, 0xd0, 0x45, 0xc1, 0xee, 0x4f, 0x4a, 0xa1, 0x04, 0x39,
-	0xab, 0x6c, 0xcc, 0x48, 0x3a, 0x95, 0x44, 0x2c, 0x8d, 0x91, 0xc7, 0x05, 0x77, 0xd7, 0x37, 0xe8,
-	0x2b, 0x14, 0x0a, 0xed, 0xa3, 0x64, 0x99, 0x65, 0x57, 0xa1, 0x38, 0x6f, 0xd1, 0x55, 0x99, 0xd1,
-	0x8c, 0x9c, 0xae, 0x74, 0xe6, 0x3b, 0xdf, 0x39, 0x67, 0x46, 0x1a, 0x04, 0xdb, 0x3e, 0x4b, 0x12,
-	0x96, 0x1e, 0xe6, 0x8f, 0xfe, 0x3c, 0x63, 0x9c, 0x91, 0x5a, 0xbe, 0xda, 0xfd, 0x5f, 0x25, 0xbd,
-	0x98, 0xfb, 0x2c, 0x2e, 0x9e, 0xb9, 0xb5, 0xeb, 0xa8, 0x2c, 0xf2, 0x08, 0x33, 0x5c, 0x26, 0x45,
-	0xa0, 0xf2, 0x7f, 0x87, 0x2c, 0x64, 0x32, 0x3c, 0x14, 0x51, 0x4e, 0x7b, 0x11, 0x58, 0xa7, 0x4b,
-	0xef, 0x2d, 0xae, 0x26, 0xc8, 0xc9, 0x10, 0xac, 0x05, 0xfa, 0xf3, 0xc1, 0xf0, 0xd1, 0xc5, 0xf1,
-	0x8e, 0xb9, 0x67, 0xee, 0x5b, 0xa3, 0x7f, 0xd7, 0xd7, 0x5d, 0x6b, 0xa2, 0xe1, 0xaf, 0xeb, 0x6e,
-	0x2d, 0xd7, 0xdd, 0x8d, 0x49, 0xee, 0x40, 0x1d, 0x83, 0xc1, 0x70, 0x78, 0xfc, 0x74, 0xa7, 0x24,
-	0x8b, 0xe0, 0x96, 0xa7, 0x53, 0xbd, 0x33, 0xa8, 0x8e, 0x23, 0x1a, 0xa7, 0xe4, 0x08, 0xc0, 0x17,
-	0xc1, 0x34, 0xa5, 0x09, 0xca, 0x31, 0xed, 0xc1, 0x5f, 0x7d, 0x75, 0x62, 0xa9, 0xbc, 0xa3, 0x09,
-	0xba, 0x96, 0xaf, 0x43, 0xf2, 0x1f, 0x34, 0xf2, 0x8a, 0x38, 0x90, 0x13, 0xca, 0x6e, 0x5d, 0xae,
-	0xdf, 0x04, 0xbd, 0x6f, 0x26, 0x34, 0x47, 0x33, 0xe6, 0x5f, 0x9c, 0x20, 0x0d, 0x30, 0x23, 0xff,
-	0x40, 0x2d, 0xc2, 0x38, 0x8c, 0xb8, 0x6c, 0x5c, 0x76, 0xd5, 0x8a, 0x10, 0xa8, 0x44, 0x74, 0x11,
-	0xc9, 0xf2, 0x96, 0x2b, 0x63, 0xd2, 0x85, 0xe6, 0x9c, 0x66, 0x98, 0xf2, 0xa9, 0x4c, 0x95, 0x65,
-	0x0a, 0x72, 0x74, 0x22, 0x84, 0xdb, 0x73, 0x2b, 0x7f, 0xcc, 0x25, 0x47, 0x62, 0x8e, 0x98, 0xb8,
-	0x53, 0xdd, 0x33, 0xf7, 0x9b, 0x03, 0xa2, 0x0f, 0x90, 0xef, 0xe3, 0x05, 0xe5, 0x74, 0x54, 0xb9,
-	0xbc, 0xee, 0x1a, 0xae, 0xf2, 0x7a, 0x11, 0xc0, 0x26, 0x47, 0xee, 0x43, 0x47, 0x7f, 0x9f, 0xa9,
-	0x6a, 0x24, 0x36, 0xdc, 0x3a, 0x31, 0xdc, 0xb6, 0x4e, 0xa8, 0x23, 0xdd, 0x83, 0xb6, 0xfa, 0xd2,
-	0xda, 0x2c, 0x29, 0x73, 0x4b, 0xf1, 0x5c, 0x1c, 0xd5, 0xa0, 0x12, 0x50, 0x4e, 0x7b, 0x9f, 0x4c,
-	0xa8, 0x9e, 0x66, 0x8c, 0xbd, 0x27, 0x4f, 0xa0, 0x68, 0x36, 0x9d, 0x0b, 0x22, 0x87, 0x34, 0x07,
-	0x9d, 0x7e, 0x71, 0x39, 0xa4, 0x28, 0x7a, 0x69, 0x92, 0x57, 0x0e, 0x41, 0x37, 0x57, 0x85, 0x25,
-	0x59, 0xd8, 0xee, 0xeb, 0x4b, 0xa7, 0xeb, 0x5a, 0x0a, 0xc8, 0xf5, 0xa8, 0x0e, 0x55, 0xa9, 0x1f,
-	0x3c, 0x83, 0x2d, 0x17, 0x7d, 0x8c, 0x3f, 0xe0, 0x84, 0x53, 0xbe, 0x5c, 0x90, 0x26, 0xd4, 0xc7,
-	0x19, 0x52, 0x8e, 0x81, 0x6d, 0x88, 0xc5, 0x64, 0xe9, 0xfb, 0xb8, 0x58, 0xd8, 0x26, 0x01, 0xa8,
-	0xbd, 0xa2, 0xf1, 0x0c, 0x03, 0xbb, 0xb4, 0x5b, 0xf9, 0xfe, 0xd5, 0x31, 0x0f, 0x1e, 0x43, 0x63,
-	0xcc, 0xe2, 0xf4, 0x6c, 0x35, 0x47, 0xd2, 0x80, 0xca, 0x39, 0x72, 0x6a, 0x1b, 0xa4, 0x0e, 0xe5,
-	0xd7, 0x54, 0x14, 0x58, 0x50, 0x7d, 0xe9, 0x8e, 0x07, 0x47, 0x76, 0x49, 0xb0, 0x71, 0x12, 0xd8,
-	0x65, 0x55, 0xf8, 0xa5, 0x04, 0x56, 0x71, 0x83, 0x84, 0x87, 0xc9, 0x9c, 0xaf, 0x6c, 0x83, 0x74,
-	0xa0, 0x89, 0x3c, 0x9a, 0x26, 0x34, 0x4e, 0x53, 0xe4, 0xb6, 0x49, 0x6c, 0x68, 0x7d, 0x44, 0x4e,
-	0x0b, 0x52, 0x12, 0x8a, 0xc7, 0xfd, 0x02, 0x94, 0xc9, 0x36, 0x74, 0xe6, 0x6c, 0xb6, 0x0a, 0x59,
-	0x5a, 0xc0, 0x8a, 0xb4, 0x16, 0x1b, 0xab, 0x4a, 0x08, 0xb4, 0x43, 0x86, 0xd9, 0x2c, 0x9e, 0x72,
-	0x5c, 0x70, 0xc1, 0x6a, 0x82, 0x25, 0xcb, 0xc4, 0xa3, 0x1b, 0x56, 0x17, 0xdd, 0x42, 0x9a, 0x52,
-	0x3f, 0xc2, 0x02, 0x36, 0x84, 0xe8, 0x51, 0xe6, 0x51, 0xaf, 0x60, 0x96, 0x9e, 0xa0, 0x01, 0x14,
-	0x5b, 0xd5, 0xa4, 0xa9, 0xb7, 0xaa, 0x41, 0x4b, 0x36, 0xcf, 0x37, 0x31, 0x63, 0x3e, 0x9d, 0x09,
-	0xd8, 0xd6, 0x56, 0x86, 0xa1, 0x10, 0xed, 0x4e, 0xfe, 0x8e, 0x46, 0xcf, 0x2f, 0xd7, 0x8e, 0x79,
-	0xb5, 0x76, 0xcc, 0x9f, 0x6b, 0xc7, 0xfc, 0x7c, 0xe3, 0x18, 0x57, 0x37, 0x8e, 0xf1, 0xe3, 0xc6,
-	0x31, 0xce, 0xef, 0x86, 0x31, 0x8f, 0x96, 0x9e, 0xb8, 0xc9, 0x87, 0x62, 0xe2, 0x03, 0x79, 0xd9,
-	0x65, 0xe8, 0xb3, 0x0c, 0xd5, 0x4f, 0xc9, 0xab, 0xc9, 0x3f, 0xc7, 0xc3, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x61, 0x31, 0x96, 0x1f, 0xac, 0x04, 0x00, 0x00,
+	// 933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x55, 0xcd, 0x8e, 0x1b, 0x45,
+	0x10, 0xf6, 0xf8, 0x7f, 0xca, 0x3f, 0x3b, 0xdb, 0x1b, 0xc0, 0xac, 0x90, 0x37, 0x58, 0x48, 0x24,
+	0x91, 0xb2, 0xbb, 0x71, 0xb4, 0x81, 0x28, 0x1c, 0xc0, 0x86, 0xb0, 0x11, 0xd2, 0x2a, 0xcc, 0x46,
+	0x1c, 0x72, 0x19, 0xf5, 0xf4, 0x94, 0x3d, 0xa3, 0xf5, 0x74, 0x5b, 0x33, 0x6d, 0x83, 0xb9, 0xf1,
+	0x06, 0x9c, 0xb8, 0x23, 0x21, 0xc1, 0xa3, 0xe4, 0x98, 0x23, 0xa7, 0x15, 0xda, 0x7d, 0x0b, 0x4e,
+	0x51, 0xf7, 0x74, 0x8f, 0x9d, 0xd3, 0x54, 0x7d, 0xf5, 0x7d, 0xd5, 0xd5, 0xd5, 0x5d, 0x3d, 0x70,
+	0xc0, 0x44, 0x9a, 0x0a, 0x7e, 0x52, 0x7c, 0x8e, 0x97, 0x99, 0x90, 0x82, 0x34, 0x0b, 0xef, 0xf0,
+	0x13, 0x13, 0x0c, 0x13, 0xc9, 0x44, 0x52, 0x7e, 0x0b, 0xd6, 0xe1, 0xd0, 0x44, 0x51, 0xc6, 0x98,
+	0xe1, 0x2a, 0x2d, 0x0d, 0x13, 0xbf, 0x33, 0x17, 0x73, 0xa1, 0xcd, 0x13, 0x65, 0x15, 0xe8, 0x28,
+	0x06, 0xf7, 0xe5, 0x2a, 0xfc, 0x01, 0x37, 0x97, 0x28, 0xc9, 0x19, 0xb8, 0x39, 0xb2, 0xe5, 0xf8,
+	0xec, 0xc9, 0xd5, 0xa3, 0x81, 0x73, 0xd7, 0xb9, 0xe7, 0x4e, 0x3e, 0xba, 0xb9, 0x3e, 0x72, 0x2f,
+	0x2d, 0xf8, 0xff, 0xf5, 0x51, 0xb3, 0xa0, 0xfb, 0x5b, 0x26, 0xf9, 0x0c, 0x5a, 0x18, 0x8d, 0xcf,
+	0xce, 0x1e, 0x3d, 0x1d, 0x54, 0xb5, 0x08, 0x76, 0x78, 0x36, 0x34, 0x7a, 0x05, 0x8d, 0x69, 0x4c,
+	0x13, 0x4e, 0x4e, 0x01, 0x98, 0x32, 0x02, 0x4e, 0x53, 0xd4, 0xcb, 0xf4, 0xc7, 0xfb, 0xc7, 0x66,
+	0xc7, 0x9a, 0x72, 0x41, 0x53, 0xf4, 0x5d, 0x66, 0x4d, 0xf2, 0x31, 0xb4, 0x0b, 0x45, 0x12, 0xe9,
+	0x15, 0x6a, 0x7e, 0x4b, 0xfb, 0x2f, 0xa2, 0xd1, 0x1f, 0x55, 0x70, 0xb5, 0xe6, 0x05, 0x9f, 0x09,
+	0x72, 0x1f, 0x1a, 0x3a, 0xa0, 0xb3, 0x76, 0xc6, 0xbd, 0xf7, 0xb2, 0x4e, 0xea, 0x6f, 0xae, 0x8f,
+	0x2a, 0x7e, 0xc1, 0x20, 0x0f, 0xc1, 0x5d, 0xa7, 0xc1, 0x8c, 0xa6, 0xc9, 0x62, 0xa3, 0x93, 0xf6,
+	0xc7, 0x9e, 0xa5, 0xff, 0x94, 0x3e, 0xd7, 0xb8, 0xdf, 0x5e, 0x1b, 0x8b, 0x3c, 0x81, 0x2e, 0x47,
+	0xf9, 0xb3, 0xc8, 0xae, 0x02, 0xb9, 0x59, 0xe2, 0xa0, 0xa6, 0x15, 0x07, 0x56, 0x71, 0x51, 0xc4,
+	0x5e, 0x6d, 0x96, 0xe8, 0x77, 0xf8, 0xd6, 0x21, 0x4f, 0xa1, 0x47, 0xa3, 0x28, 0xc3, 0x3c, 0x0f,
+	0x98, 0x88, 0x90, 0x0d, 0xea, 0x5a, 0x78, 0xc7, 0x0a, 0xbf, 0x29, 0x82, 0x53, 0x15, 0xf3, 0xbb,
+	0x74, 0xc7, 0x23, 0x5f, 0xc1, 0x61, 0x84, 0x33, 0xba, 0x5a, 0xc8, 0x80, 0x09, 0x3e, 0x4b, 0xb2,
+	0x94, 0xca, 0x44, 0xf0, 0x80, 0x89, 0x15, 0x97, 0x83, 0xc6, 0x5d, 0xe7, 0x5e, 0xdd, 0x1f, 0x18,
+	0xc6, 0x74, 0x87, 0x30, 0x55, 0xf1, 0xd1, 0xdf, 0x0e, 0x74, 0x26, 0x0b, 0xc1, 0xae, 0xce, 0x91,
+	0x46, 0x98, 0x91, 0x0f, 0xa1, 0x19, 0x63, 0x32, 0x8f, 0xa5, 0xee, 0x4d, 0xcd, 0x37, 0x1e, 0x21,
+	0x50, 0x8f, 0x69, 0x1e, 0xeb, 0x16, 0x74, 0x7d, 0x6d, 0x93, 0x23, 0xe8, 0x2c, 0x69, 0x86, 0x5c,
+	0x06, 0x3a, 0x54, 0xd3, 0x21, 0x28, 0xa0, 0x73, 0x45, 0xd8, 0x3d, 0x90, 0xfa, 0x7b, 0x07, 0x42,
+	0x4e, 0xd5, 0x3a, 0x6a, 0x45, 0x5d, 0x61, 0x67, 0x4c, 0xec, 0x4e, 0x8b, 0x3a, 0xbe, 0xa5, 0x92,
+	0x9a, 0x83, 0x30, 0xbc, 0x51, 0x0c, 0xb0, 0x8d, 0x91, 0xfb, 0xb0, 0x67, 0x2f, 0x6e, 0x60, 0x12,
+	0xa9, 0x82, 0xbb, 0xe7, 0x15, 0xbf, 0x6f, 0x03, 0x66, 0x4b, 0x9f, 0x43, 0xdf, 0x8c, 0x80, 0x65,
+	0x56, 0x0d, 0xb3, 0x67, 0xf0, 0x82, 0x38, 0x69, 0x42, 0x3d, 0xa2, 0x92, 0x8e, 0x7e, 0x73, 0xa0,
+	0xf1, 0x32, 0x13, 0x62, 0x46, 0xbe, 0x84, 0x32, 0x59, 0xb0, 0x54, 0x88, 0xb9, 0x31, 0x7b, 0xc7,
+	0xe5, 0xd4, 0x68, 0xa2, 0xca, 0x65, 0x91, 0x42, 0x79, 0x06, 0x36, 0xb9, 0x11, 0x56, 0xb5, 0xb0,
+	0x7f, 0x6c, 0xa7, 0xd1, 0xea, 0xba, 0x06, 0xd0, 0xfe, 0xa4, 0x05, 0x0d, 0x4d, 0x7f, 0xf0, 0x0c,
+	0x7a, 0x3e, 0x32, 0x4c, 0xd6, 0x78, 0x29, 0xa9, 0x5c, 0xe5, 0xa4, 0x03, 0xad, 0x69, 0x86, 0x54,
+	0x62, 0xe4, 0x55, 0x94, 0x73, 0xb9, 0x62, 0x0c, 0xf3, 0xdc, 0x73, 0x08, 0x40, 0xf3, 0x39, 0x4d,
+	0x16, 0x18, 0x79, 0xd5, 0xc3, 0xfa, 0x3f, 0x7f, 0x0d, 0x9d, 0x07, 0x5f, 0x40, 0x7b, 0x2a, 0x12,
+	0xae, 0x6f, 0x56, 0x1b, 0xea, 0xaf, 0x51, 0x52, 0xaf, 0x42, 0x5a, 0x50, 0xfb, 0x9e, 0x2a, 0x81,
+	0x0b, 0x8d, 0xef, 0xfc, 0xe9, 0xf8, 0xd4, 0xab, 0x2a, 0x6c, 0x9a, 0x46, 0x5e, 0xcd, 0x08, 0xff,
+	0xb4, 0x63, 0xa2, 0xe7, 0xc9, 0x85, 0x06, 0xa6, 0x4b, 0xb9, 0xf1, 0x2a, 0x64, 0x0f, 0x3a, 0x28,
+	0xe3, 0x20, 0xa5, 0x09, 0xe7, 0x28, 0x3d, 0x87, 0x78, 0xd0, 0xfd, 0x15, 0x25, 0x2d, 0x91, 0xaa,
+	0xa2, 0x84, 0x92, 0x95, 0x40, 0x8d, 0x1c, 0xc0, 0xde, 0x52, 0x2c, 0x36, 0x73, 0xc1, 0x4b, 0xb0,
+	0xae, 0x59, 0xf9, 0x96, 0xd5, 0x20, 0x04, 0xfa, 0x73, 0x81, 0xd9, 0x22, 0x09, 0x24, 0xe6, 0x52,
+	0x61, 0x4d, 0x85, 0xa5, 0xab, 0x34, 0xa4, 0x5b, 0xac, 0xa5, 0xb2, 0xcd, 0x29, 0xa7, 0x2c, 0xc6,
+	0x12, 0x6c, 0x2b, 0x62, 0x48, 0x45, 0x48, 0xc3, 0x12, 0x73, 0xed, 0x0a, 0x16, 0x80, 0xb2, 0x54,
+	0x8b, 0x74, 0x6c, 0xa9, 0x16, 0xe8, 0xea, 0xe4, 0x45, 0x11, 0x0b, 0xc1, 0xe8, 0x42, 0x81, 0x7d,
+	0xcb, 0xca, 0x70, 0xae, 0x88, 0xde, 0x9e, 0xe9, 0xd1, 0xd7, 0xd0, 0xb6, 0x83, 0xaf, 0x3a, 0xc4,
+	0x45, 0xb0, 0x4e, 0x8b, 0xee, 0xe2, 0x3a, 0xf5, 0x1c, 0x75, 0x36, 0xe6, 0x48, 0xbd, 0x2a, 0xe9,
+	0x81, 0xab, 0xd7, 0x66, 0x22, 0xc3, 0xb2, 0xcb, 0x3f, 0x42, 0x67, 0xe7, 0x21, 0x20, 0x1f, 0xc0,
+	0xfe, 0x8a, 0x47, 0x38, 0x4b, 0x38, 0x46, 0x81, 0x79, 0x14, 0x8a, 0x33, 0xde, 0xb6, 0xbb, 0x03,
+	0x2d, 0x5b, 0x6d, 0x55, 0x39, 0xcb, 0x2c, 0x59, 0xeb, 0x2e, 0x9b, 0x94, 0x17, 0xd0, 0xdd, 0x7d,
+	0x22, 0x48, 0x17, 0xda, 0x5c, 0x14, 0x4f, 0x49, 0x51, 0x5b, 0x8c, 0xbf, 0x78, 0x0e, 0xe9, 0x03,
+	0x84, 0xc8, 0xe2, 0xc7, 0xe3, 0x20, 0x94, 0xcc, 0xab, 0x92, 0x7d, 0xe8, 0x19, 0x9f, 0x89, 0x3c,
+	0x15, 0xb9, 0xcd, 0x37, 0x79, 0xf6, 0xe6, 0x66, 0xe8, 0xbc, 0xbd, 0x19, 0x3a, 0xff, 0xdd, 0x0c,
+	0x9d, 0xdf, 0x6f, 0x87, 0x95, 0xb7, 0xb7, 0xc3, 0xca, 0xbf, 0xb7, 0xc3, 0xca, 0xeb, 0x4f, 0xe7,
+	0x89, 0x8c, 0x57, 0xa1, 0x1a, 0xd7, 0x13, 0xb5, 0xb5, 0x87, 0x7a, 0xa2, 0x4f, 0xb8, 0x88, 0xd0,
+	0xfc, 0x8e, 0xc2, 0xa6, 0xfe, 0x67, 0x3c, 0x7e, 0x17, 0x00, 0x00, 0xff, 0xff, 0x65, 0x3d, 0x25,
+	0x7b, 0xa6, 0x06, 0x00, 0x00,
 }
 
 func (m *PubKeySet) Marshal() (dAtA []byte, err error) {
@@ -636,6 +831,59 @@ func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DefaultConfirmationCount != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.DefaultConfirmationCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AddressCodec != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.AddressCodec))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NetworkType != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.NetworkType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VmFamily != 0 {
+		i = encodeVarintCommon(dAtA, i, uint64(m.VmFamily))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCommon(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -874,6 +1122,29 @@ func (m *Chain) Size() (n int) {
 	return n
 }
 
+func (m *ChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chain.Size()
+	n += 1 + l + sovCommon(uint64(l))
+	if m.VmFamily != 0 {
+		n += 1 + sovCommon(uint64(m.VmFamily))
+	}
+	if m.NetworkType != 0 {
+		n += 1 + sovCommon(uint64(m.NetworkType))
+	}
+	if m.AddressCodec != 0 {
+		n += 1 + sovCommon(uint64(m.AddressCodec))
+	}
+	if m.DefaultConfirmationCount != 0 {
+		n += 1 + sovCommon(uint64(m.DefaultConfirmationCount))
+	}
+	return n
+}
+
 func (m *BlockHeader) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1180,6 +1451,165 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChainInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmFamily", wireType)
+			}
+			m.VmFamily = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VmFamily |= VmFamily(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkType", wireType)
+			}
+			m.NetworkType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkType |= NetworkType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressCodec", wireType)
+			}
+			m.AddressCodec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressCodec |= AddressCodec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultConfirmationCount", wireType)
+			}
+			m.DefaultConfirmationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultConfirmationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,9 +5,11 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -86,13 +88,20 @@ func (h HeaderData) ValidateTimestamp(zetaTime time.Time) error {
 	}
 }
 
-// Validate performs a basic validation of the HeaderData
-func (h HeaderData) Validate(blockHash []byte, chainID int64, height int64) error {
+// Validate performs a basic validation of the HeaderData, chainInfo is the registry entry of the chain of the header
+func (h HeaderData) Validate(blockHash []byte, chainInfo ChainInfo, height int64) error {
 	switch data := h.Data.(type) {
 	case *HeaderData_EthereumHeader:
+		if !chainInfo.IsEVMChain() {
+			return fmt.Errorf("chain id (%d) is not an evm chain", chainInfo.Chain.ChainId)
+		}
 		return validateEthereumHeader(data.EthereumHeader, blockHash, height)
 	case *HeaderData_BitcoinHeader:
-		return ValidateBitcoinHeader(data.BitcoinHeader, blockHash, chainID)
+		chainParams, err := chainInfo.BTCChainParams()
+		if err != nil {
+			return err
+		}
+		return ValidateBitcoinHeader(data.BitcoinHeader, blockHash, chainParams)
 	default:
 		return errors.New("unrecognized header type")
 	}
@@ -122,7 +131,8 @@ func validateEthereumHeader(headerBytes []byte, blockHash []byte, height int64)
 	return nil
 }
 
-func ValidateBitcoinHeader(headerBytes []byte, blockHash []byte, chainID int64) error {
+// ValidateBitcoinHeader performs a basic validation of a Bitcoin header of the network of chainParams
+func ValidateBitcoinHeader(headerBytes []byte, blockHash []byte, chainParams *chaincfg.Params) error {
 	// Deserialize the 80-byte block header
 	if len(headerBytes) != bitcoin.BitcoinBlockHeaderLen {
 		return fmt.Errorf("header length mismatch (%d)", len(headerBytes))
@@ -148,10 +158,6 @@ func ValidateBitcoinHeader(headerBytes []byte, blockHash []byte, chainID int64)
 	}
 
 	// Timestamp must be not earlier than genesis block
-	chainParams, err := GetBTCChainParams(chainID)
-	if err != nil {
-		return fmt.Errorf("cannot get chain params (%s) for chain id (%d)", err, chainID)
-	}
 	if chainParams.GenesisBlock.Header.Timestamp.After(header.Timestamp) {
 		return fmt.Errorf("block timestamp %v is before genesis block", header.Timestamp)
 	}
@@ -165,3 +171,161 @@ func ValidateBitcoinHeader(headerBytes []byte, blockHash []byte, chainID int64)
 
 	return nil
 }
+
+// ValidateDifficulty checks the difficulty of the header against its parent
+// windowStart is the header at the start of the difficulty retarget window and lastRegular is the last ancestor of the
+// header not mined at min-difficulty, they are only used for Bitcoin and can be nil if the header is not available,
+// in which case the difficulty of a header requiring them can't be checked and is rejected
+// chainParams are the network params of a Bitcoin header, no check is performed for Ethereum headers
+func (h HeaderData) ValidateDifficulty(parent HeaderData, windowStart, lastRegular *HeaderData, height int64, chainParams *chaincfg.Params) error {
+	switch data := h.Data.(type) {
+	case *HeaderData_EthereumHeader:
+		return nil
+	case *HeaderData_BitcoinHeader:
+		header, err := deserializeBitcoinHeader(data.BitcoinHeader)
+		if err != nil {
+			return err
+		}
+		parentHeader, err := parent.bitcoinHeader()
+		if err != nil {
+			return err
+		}
+		var windowStartHeader, lastRegularHeader *wire.BlockHeader
+		if windowStart != nil {
+			if windowStartHeader, err = windowStart.bitcoinHeader(); err != nil {
+				return err
+			}
+		}
+		if lastRegular != nil {
+			if lastRegularHeader, err = lastRegular.bitcoinHeader(); err != nil {
+				return err
+			}
+		}
+		if chainParams == nil {
+			return errors.New("no Bitcoin network params for the header")
+		}
+		return ValidateBitcoinDifficulty(header, parentHeader, windowStartHeader, lastRegularHeader, height, chainParams)
+	default:
+		return errors.New("cannot validate difficulty for unrecognized header type")
+	}
+}
+
+// IsBitcoinMinDifficulty returns true if the header is a Bitcoin header mined at the min-difficulty of a network allowing
+// min-difficulty blocks, chainParams are the network params of the header
+func (h HeaderData) IsBitcoinMinDifficulty(chainParams *chaincfg.Params) (bool, error) {
+	header, err := h.bitcoinHeader()
+	if err != nil {
+		return false, err
+	}
+	return chainParams.ReduceMinDifficulty && header.Bits == blockchain.BigToCompact(chainParams.PowLimit), nil
+}
+
+// Work returns the work done to mine the header, the chain with the most cumulative work is the canonical chain
+// Ethereum headers are not mined since the merge, each header adds a work of one so the canonical chain is the longest
+func (h HeaderData) Work() (*big.Int, error) {
+	switch h.Data.(type) {
+	case *HeaderData_EthereumHeader:
+		return big.NewInt(1), nil
+	case *HeaderData_BitcoinHeader:
+		header, err := h.bitcoinHeader()
+		if err != nil {
+			return nil, err
+		}
+		return blockchain.CalcWork(header.Bits), nil
+	default:
+		return nil, errors.New("cannot compute work for unrecognized header type")
+	}
+}
+
+func (h HeaderData) bitcoinHeader() (*wire.BlockHeader, error) {
+	data, ok := h.Data.(*HeaderData_BitcoinHeader)
+	if !ok {
+		return nil, errors.New("not a Bitcoin header")
+	}
+	return deserializeBitcoinHeader(data.BitcoinHeader)
+}
+
+// BitcoinRetargetInterval returns the number of blocks between two difficulty retargets
+func BitcoinRetargetInterval(params *chaincfg.Params) int64 {
+	return int64(params.TargetTimespan / params.TargetTimePerBlock)
+}
+
+// ValidateBitcoinDifficulty checks the difficulty bits of a Bitcoin header at the given height against its parent
+// the rules are borrowed from btcd/blockchain/difficulty.go which requires the full block index
+// windowStart is required for a retarget block and lastRegular for a block following a min-difficulty block, the
+// validation fails if they are missing
+func ValidateBitcoinDifficulty(header, parent, windowStart, lastRegular *wire.BlockHeader, height int64, params *chaincfg.Params) error {
+	// the difficulty is never adjusted on regtest
+	if params.Net == chaincfg.RegressionNetParams.Net {
+		if header.Bits != parent.Bits {
+			return fmt.Errorf("difficulty bits %08x don't match parent bits %08x", header.Bits, parent.Bits)
+		}
+		return nil
+	}
+
+	// retarget block: the difficulty is adjusted from the duration of the previous window
+	if height%BitcoinRetargetInterval(params) == 0 {
+		if windowStart == nil {
+			return errors.New("retarget window start header is missing")
+		}
+		expected := nextBitcoinDifficulty(parent, windowStart, params)
+		if header.Bits != expected {
+			return fmt.Errorf("difficulty bits %08x don't match retarget bits %08x", header.Bits, expected)
+		}
+		return nil
+	}
+
+	// networks allowing min-difficulty blocks if no block has been mined for twice the target block time
+	if params.ReduceMinDifficulty {
+		powLimitBits := blockchain.BigToCompact(params.PowLimit)
+		minDifficultyTime := parent.Timestamp.Add(params.MinDiffReductionTime)
+		if header.Bits == powLimitBits && header.Timestamp.After(minDifficultyTime) {
+			return nil
+		}
+		// the difficulty to use after a min-difficulty block is the one of the last regular block
+		if parent.Bits == powLimitBits {
+			if lastRegular == nil {
+				return errors.New("last regular difficulty header is missing")
+			}
+			if header.Bits != lastRegular.Bits {
+				return fmt.Errorf("difficulty bits %08x don't match last regular bits %08x", header.Bits, lastRegular.Bits)
+			}
+			return nil
+		}
+	}
+
+	if header.Bits != parent.Bits {
+		return fmt.Errorf("difficulty bits %08x don't match parent bits %08x", header.Bits, parent.Bits)
+	}
+	return nil
+}
+
+// nextBitcoinDifficulty returns the difficulty bits of the block following a retarget window
+func nextBitcoinDifficulty(last, first *wire.BlockHeader, params *chaincfg.Params) uint32 {
+	targetTimespan := int64(params.TargetTimespan / time.Second)
+	minTimespan := targetTimespan / params.RetargetAdjustmentFactor
+	maxTimespan := targetTimespan * params.RetargetAdjustmentFactor
+
+	actualTimespan := last.Timestamp.Unix() - first.Timestamp.Unix()
+	if actualTimespan < minTimespan {
+		actualTimespan = minTimespan
+	} else if actualTimespan > maxTimespan {
+		actualTimespan = maxTimespan
+	}
+
+	newTarget := blockchain.CompactToBig(last.Bits)
+	newTarget.Mul(newTarget, big.NewInt(actualTimespan))
+	newTarget.Div(newTarget, big.NewInt(targetTimespan))
+	if newTarget.Cmp(params.PowLimit) > 0 {
+		newTarget.Set(params.PowLimit)
+	}
+	return blockchain.BigToCompact(newTarget)
+}
+
+func deserializeBitcoinHeader(headerBytes []byte) (*wire.BlockHeader, error) {
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(headerBytes)); err != nil {
+		return nil, fmt.Errorf("cannot deserialize Bitcoin header (%s)", err)
+	}
+	return &header, nil
+}
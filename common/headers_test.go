@@ -123,7 +123,7 @@ func validateTrueBitcoinHeader(t *testing.T, header *wire.BlockHeader, headerByt
 	blockHash := header.BlockHash()
 
 	// Ture Bitcoin header should pass validation
-	err := common.ValidateBitcoinHeader(headerBytes, blockHash[:], 18332)
+	err := common.ValidateBitcoinHeader(headerBytes, blockHash[:], &chaincfg.TestNet3Params)
 	require.NoError(t, err)
 
 	// True Bitcoin header should pass timestamp validation
@@ -135,7 +135,7 @@ func validateFakeBitcoinHeader(t *testing.T, header *wire.BlockHeader, headerByt
 	blockHash := header.BlockHash()
 
 	// Incorrect header length should fail validation
-	err := common.ValidateBitcoinHeader(headerBytes[:79], blockHash[:], 18332)
+	err := common.ValidateBitcoinHeader(headerBytes[:79], blockHash[:], &chaincfg.TestNet3Params)
 	if err == nil {
 		t.Error("Incorrect header length should fail validation")
 	}
@@ -145,7 +145,7 @@ func validateFakeBitcoinHeader(t *testing.T, header *wire.BlockHeader, headerByt
 	fakeHeader.Version = 0
 	fakeBytes := marshalHeader(fakeHeader)
 	fakeHash := fakeHeader.BlockHash()
-	err = common.ValidateBitcoinHeader(fakeBytes, fakeHash[:], 18332)
+	err = common.ValidateBitcoinHeader(fakeBytes, fakeHash[:], &chaincfg.TestNet3Params)
 	if err == nil {
 		t.Error("Incorrect version should fail validation")
 	}
@@ -156,7 +156,7 @@ func validateFakeBitcoinHeader(t *testing.T, header *wire.BlockHeader, headerByt
 	fakeHeader.Timestamp = chaincfg.TestNet3Params.GenesisBlock.Header.Timestamp.Add(-time.Second)
 	fakeBytes = marshalHeader(fakeHeader)
 	fakeHash = fakeHeader.BlockHash()
-	err = common.ValidateBitcoinHeader(fakeBytes, fakeHash[:], 18332)
+	err = common.ValidateBitcoinHeader(fakeBytes, fakeHash[:], &chaincfg.TestNet3Params)
 	if err == nil {
 		t.Error("Timestamp before genesis should fail validation")
 	}
@@ -173,15 +173,77 @@ func validateFakeBitcoinHeader(t *testing.T, header *wire.BlockHeader, headerByt
 	fakeHeader = copyHeader(header)
 	header.Nonce = 0
 	fakeBytes = marshalHeader(header)
-	err = common.ValidateBitcoinHeader(fakeBytes, blockHash[:], 18332)
+	err = common.ValidateBitcoinHeader(fakeBytes, blockHash[:], &chaincfg.TestNet3Params)
 	if err == nil {
 		t.Error("Incorrect block hash should fail validation")
 	}
 
 	// PoW not satisfied should fail validation
 	fakeHash = fakeHeader.BlockHash()
-	err = common.ValidateBitcoinHeader(fakeBytes, fakeHash[:], 18332)
+	err = common.ValidateBitcoinHeader(fakeBytes, fakeHash[:], &chaincfg.TestNet3Params)
 	if err == nil {
 		t.Error("PoW not satisfied should fail validation")
 	}
 }
+
+func TestValidateBitcoinDifficulty(t *testing.T) {
+	params := &chaincfg.MainNetParams
+	interval := common.BitcoinRetargetInterval(params)
+	start := time.Unix(1600000000, 0)
+	parent := &wire.BlockHeader{Bits: 0x1d00ffff, Timestamp: start.Add(params.TargetTimespan)}
+
+	t.Run("bits must match the parent outside of a retarget", func(t *testing.T) {
+		header := &wire.BlockHeader{Bits: 0x1d00ffff, Timestamp: parent.Timestamp.Add(time.Minute)}
+		require.NoError(t, common.ValidateBitcoinDifficulty(header, parent, nil, nil, interval+1, params))
+
+		header.Bits = 0x1c7fff80
+		require.Error(t, common.ValidateBitcoinDifficulty(header, parent, nil, nil, interval+1, params))
+	})
+
+	t.Run("bits are adjusted on retarget", func(t *testing.T) {
+		// the window was mined in half of the target timespan, the difficulty doubles
+		windowStart := &wire.BlockHeader{Bits: 0x1d00ffff, Timestamp: start.Add(params.TargetTimespan / 2)}
+		header := &wire.BlockHeader{Bits: 0x1c7fff80, Timestamp: parent.Timestamp.Add(time.Minute)}
+		require.NoError(t, common.ValidateBitcoinDifficulty(header, parent, windowStart, nil, interval*2, params))
+
+		header.Bits = 0x1d00ffff
+		require.Error(t, common.ValidateBitcoinDifficulty(header, parent, windowStart, nil, interval*2, params))
+
+		// fail without the window start
+		header.Bits = 0x1c7fff80
+		require.Error(t, common.ValidateBitcoinDifficulty(header, parent, nil, nil, interval*2, params))
+	})
+
+	t.Run("min-difficulty blocks on testnet", func(t *testing.T) {
+		testnet := &chaincfg.TestNet3Params
+		powLimitBits := blockchain.BigToCompact(testnet.PowLimit)
+		parent := &wire.BlockHeader{Bits: 0x1c7fff80, Timestamp: start}
+		header := &wire.BlockHeader{Bits: powLimitBits, Timestamp: parent.Timestamp.Add(testnet.MinDiffReductionTime + time.Second)}
+		require.NoError(t, common.ValidateBitcoinDifficulty(header, parent, nil, nil, interval+1, testnet))
+
+		header.Timestamp = parent.Timestamp.Add(time.Minute)
+		require.Error(t, common.ValidateBitcoinDifficulty(header, parent, nil, nil, interval+1, testnet))
+	})
+
+	t.Run("the last regular difficulty is used after a min-difficulty block on testnet", func(t *testing.T) {
+		testnet := &chaincfg.TestNet3Params
+		powLimitBits := blockchain.BigToCompact(testnet.PowLimit)
+		lastRegular := &wire.BlockHeader{Bits: 0x1c7fff80, Timestamp: start}
+		parent := &wire.BlockHeader{Bits: powLimitBits, Timestamp: start.Add(testnet.MinDiffReductionTime + time.Second)}
+		header := &wire.BlockHeader{Bits: 0x1c7fff80, Timestamp: parent.Timestamp.Add(time.Minute)}
+		require.NoError(t, common.ValidateBitcoinDifficulty(header, parent, nil, lastRegular, interval+2, testnet))
+
+		header.Bits = powLimitBits
+		require.Error(t, common.ValidateBitcoinDifficulty(header, parent, nil, lastRegular, interval+2, testnet))
+
+		// fail without the last regular header
+		header.Bits = 0x1c7fff80
+		require.Error(t, common.ValidateBitcoinDifficulty(header, parent, nil, nil, interval+2, testnet))
+	})
+
+	t.Run("no retarget on regtest", func(t *testing.T) {
+		regtest := &chaincfg.RegressionNetParams
+		header := &wire.BlockHeader{Bits: 0x1c7fff80, Timestamp: parent.Timestamp.Add(time.Minute)}
+		require.Error(t, common.ValidateBitcoinDifficulty(header, parent, nil, nil, interval, regtest))
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math/big"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/node/common"
@@ -22,6 +23,10 @@ import (
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/zeta-chain/node/common/ethereum"
 )
 
 const numBlocksToTest = 100
@@ -58,6 +63,45 @@ func Test_IsErrorInvalidProof(t *testing.T) {
 	require.True(t, common.IsErrorInvalidProof(common.NewErrInvalidProof(errors.New("foo"))))
 }
 
+func TestEthereumReceiptProof(t *testing.T) {
+	receipts := ethtypes.Receipts{
+		{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 21000},
+		{Type: ethtypes.DynamicFeeTxType, Status: ethtypes.ReceiptStatusFailed, CumulativeGasUsed: 42000},
+	}
+	header := ethtypes.Header{
+		Number:      big.NewInt(1),
+		Difficulty:  big.NewInt(1),
+		ReceiptHash: ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)),
+	}
+	headerRLP, err := rlp.EncodeToBytes(&header)
+	require.NoError(t, err)
+	headerData := common.NewEthereumHeader(headerRLP)
+
+	receiptTrie := ethereum.NewTrie(receipts)
+	for i, expected := range receipts {
+		proof, err := receiptTrie.GenerateProof(i)
+		require.NoError(t, err)
+
+		receiptBytes, err := common.NewEthereumProof(proof).VerifyReceipt(headerData, i)
+		require.NoError(t, err)
+		var receipt ethtypes.Receipt
+		require.NoError(t, receipt.UnmarshalBinary(receiptBytes))
+		require.Equal(t, expected.Status, receipt.Status)
+		require.Equal(t, expected.CumulativeGasUsed, receipt.CumulativeGasUsed)
+
+		// the proof doesn't prove another receipt
+		_, err = common.NewEthereumProof(proof).VerifyReceipt(headerData, 1-i)
+		require.True(t, common.IsErrorInvalidProof(err))
+	}
+
+	// receipt proofs can only be verified against ethereum headers
+	proof, err := receiptTrie.GenerateProof(0)
+	require.NoError(t, err)
+	_, err = common.NewEthereumProof(proof).VerifyReceipt(common.NewBitcoinHeader(make([]byte, 80)), 0)
+	require.Error(t, err)
+	require.False(t, common.IsErrorInvalidProof(err))
+}
+
 func TestBitcoinMerkleProof(t *testing.T) {
 	blocks := LoadTestBlocks(t)
 
@@ -10,6 +10,9 @@ import (
 
 const (
 	DustUTXOOffset = 2000
+
+	// DonationMessage is the message of an inbound donating funds to the TSS, such an inbound is not processed
+	DonationMessage = "I am rich!"
 )
 
 // A very special value to mark current nonce in UTXO
@@ -18,6 +21,30 @@ func NonceMarkAmount(nonce uint64) int64 {
 	return int64(nonce) + DustUTXOOffset // +2000 to avoid being a dust rejection
 }
 
+// OutTxNonceRange returns the range of nonces paid by a bitcoin outTx of the TSS
+// the outputs of the outTx are [nonce-mark of last nonce, payment of first nonce, ..., payment of last nonce, change to TSS (optional)]
+// hasChange tells whether the last output pays the TSS, it's only taken as the change if the outTx has at least two payments
+func OutTxNonceRange(nonceMark int64, numOutputs int, hasChange bool) (uint64, uint64, error) {
+	if nonceMark < DustUTXOOffset {
+		return 0, 0, fmt.Errorf("invalid nonce-mark amount %d", nonceMark)
+	}
+	// #nosec G701 always positive
+	last := uint64(nonceMark - DustUTXOOffset)
+	payments := numOutputs - 1
+	if hasChange && payments > 1 {
+		payments--
+	}
+	if payments < 1 {
+		return 0, 0, fmt.Errorf("outTx has no payment")
+	}
+	// #nosec G701 always positive
+	if uint64(payments-1) > last {
+		return 0, 0, fmt.Errorf("outTx has %d payments for last nonce %d", payments, last)
+	}
+	// #nosec G701 always positive
+	return last - uint64(payments) + 1, last, nil
+}
+
 // HashToString convert hash bytes to string
 func HashToString(chainID int64, blockHash []byte) (string, error) {
 	if IsEVMChain(chainID) {
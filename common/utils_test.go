@@ -0,0 +1,41 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutTxNonceRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		nonceMark  int64
+		numOutputs int
+		hasChange  bool
+		first      uint64
+		last       uint64
+		fail       bool
+	}{
+		{"single payment", NonceMarkAmount(5), 2, false, 5, 5, false},
+		{"single payment with change", NonceMarkAmount(5), 3, true, 5, 5, false},
+		{"single payment to TSS", NonceMarkAmount(5), 2, true, 5, 5, false},
+		{"batch of three payments", NonceMarkAmount(7), 4, false, 5, 7, false},
+		{"batch of three payments with change", NonceMarkAmount(7), 5, true, 5, 7, false},
+		{"batch starting at nonce 0", NonceMarkAmount(2), 5, true, 0, 2, false},
+		{"batch starting before nonce 0", NonceMarkAmount(1), 5, true, 0, 0, true},
+		{"no payment", NonceMarkAmount(5), 1, false, 0, 0, true},
+		{"invalid nonce-mark", DustUTXOOffset - 1, 3, true, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, last, err := OutTxNonceRange(tt.nonceMark, tt.numOutputs, tt.hasChange)
+			if tt.fail {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.first, first)
+			require.Equal(t, tt.last, last)
+		})
+	}
+}
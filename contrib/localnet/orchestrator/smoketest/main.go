@@ -221,6 +221,7 @@ func LocalSmokeTest(_ *cobra.Command, _ []string) {
 
 	// test the block of increaseAllowance & decreaseAllowance
 
+	smokeTest.SetupBlockHeaderChains()
 	smokeTest.TestBitcoinSetup()
 	smokeTest.TestSetupZetaTokenAndConnectorAndZEVMContracts()
 	smokeTest.TestDepositEtherIntoZRC20()
@@ -592,7 +592,10 @@ func SendToTSSFromDeployerWithMemo(to btcutil.Address, amount float64, inputUTXO
 		panic(err)
 	}
 
-	events := zetaclient.FilterAndParseIncomingTx([]btcjson.TxRawResult{*rawtx}, 0, BTCTSSAddress.EncodeAddress(), &log.Logger)
+	events, err := zetaclient.FilterAndParseIncomingTx(btc, []btcjson.TxRawResult{*rawtx}, 0, BTCTSSAddress.EncodeAddress(), &log.Logger)
+	if err != nil {
+		panic(err)
+	}
 	fmt.Printf("bitcoin intx events:\n")
 	for _, event := range events {
 		fmt.Printf("  TxHash: %s\n", event.TxHash)
@@ -22,8 +22,10 @@ import (
 	zrc20 "github.com/zeta-chain/protocol-contracts/pkg/contracts/zevm/zrc20.sol"
 	uniswapv2factory "github.com/zeta-chain/protocol-contracts/pkg/uniswap/v2-core/contracts/uniswapv2factory.sol"
 	uniswapv2router "github.com/zeta-chain/protocol-contracts/pkg/uniswap/v2-periphery/contracts/uniswapv2router02.sol"
+	"github.com/zeta-chain/node/common"
 	"github.com/zeta-chain/node/contrib/localnet/orchestrator/smoketest/contracts/erc20"
 	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
 )
 
 const (
@@ -305,6 +307,39 @@ func (sm *SmokeTest) setupTestDapp(auth *bind.TransactOpts) {
 	sm.TestDAppAddr = receipt.ContractAddress
 }
 
+// SetupBlockHeaderChains sets the bootstrap height of the block header chains of the local networks
+// the next block of each network is used so the header chains start from a header that is not yet observed
+func (sm *SmokeTest) SetupBlockHeaderChains() {
+	LoudPrintf("Setup block header chains\n")
+	goerliHeight, err := sm.goerliClient.BlockNumber(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	btcHeight, err := sm.btcRPCClient.GetBlockCount()
+	if err != nil {
+		panic(err)
+	}
+	for chainID, height := range map[int64]int64{
+		common.GoerliChain().ChainId:     int64(goerliHeight) + 1,
+		common.BtcRegtestChain().ChainId: btcHeight + 1,
+	} {
+		res, err := sm.observerClient.GetCoreParamsForChain(context.Background(), &observertypes.QueryGetCoreParamsForChainRequest{
+			ChainId: chainID,
+		})
+		if err != nil {
+			panic(err)
+		}
+		coreParams := res.CoreParams
+		coreParams.BlockHeaderBootstrapHeight = height
+		msg := observertypes.NewMsgUpdateCoreParams(FungibleAdminAddress, coreParams)
+		txRes, err := sm.zetaTxServer.BroadcastTx(FungibleAdminName, msg)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("chain %d block header bootstrap height %d set, tx hash: %s\n", chainID, height, txRes.TxHash)
+	}
+}
+
 func (sm *SmokeTest) getDeployerAuth() *bind.TransactOpts {
 	chainid, err := sm.goerliClient.ChainID(context.Background())
 	if err != nil {
@@ -242,7 +242,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			continue
 		}
 
-		// check if method == eth_subscribe or eth_unsubscribe
+		// check if method == eth_subscribe, zeta_subscribe, eth_unsubscribe or zeta_unsubscribe
 		method, ok := msg["method"].(string)
 		if !ok {
 			// otherwise, call the usual rpc server to respond
@@ -263,14 +263,19 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		}
 
 		switch method {
-		case "eth_subscribe":
+		case "eth_subscribe", "zeta_subscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
 				continue
 			}
 
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			var unsubFn pubsub.UnsubscribeFunc
+			if method == "zeta_subscribe" {
+				unsubFn, err = s.api.subscribeZeta(wsConn, subID, params)
+			} else {
+				unsubFn, err = s.api.subscribe(wsConn, subID, params)
+			}
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
@@ -286,7 +291,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			if err := wsConn.WriteJSON(res); err != nil {
 				break
 			}
-		case "eth_unsubscribe":
+		case "eth_unsubscribe", "zeta_unsubscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
 				continue
@@ -0,0 +1,257 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/zeta-chain/node/rpc/ethereum/pubsub"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+const (
+	// crosschainEventPrefix is the prefix of the type of the typed events of the crosschain module
+	crosschainEventPrefix = "zetachain.zetacore.crosschain."
+
+	// cctxIndexAttribute is the attribute of the crosschain events containing the index of the cctx
+	cctxIndexAttribute = "cctx_index"
+)
+
+// CctxNotification is the result pushed to the subscribers of the cctx subscription
+// it contains the cctx after the transaction that created or updated it
+type CctxNotification struct {
+	Height int64           `json:"height"`
+	TxHash string          `json:"txHash"`
+	Events []string        `json:"events"`
+	Cctx   json.RawMessage `json:"cctx"`
+}
+
+// cctxCriteria filters the cctxs of a cctx subscription, an empty criteria matches all the cctxs
+type cctxCriteria struct {
+	index   string
+	sender  string
+	chainID *int64
+}
+
+// parseCctxCriteria parses the criteria of a cctx subscription
+// the supported fields are index, sender and chainId, the chain id matches the sender chain or a receiver chain
+func parseCctxCriteria(extra interface{}) (cctxCriteria, error) {
+	crit := cctxCriteria{}
+	if extra == nil {
+		return crit, nil
+	}
+
+	params, ok := extra.(map[string]interface{})
+	if !ok {
+		return crit, errors.New("invalid criteria")
+	}
+	for key, value := range params {
+		switch key {
+		case "index":
+			index, ok := value.(string)
+			if !ok {
+				return crit, errors.Errorf("invalid index: %v", value)
+			}
+			crit.index = index
+		case "sender":
+			sender, ok := value.(string)
+			if !ok {
+				return crit, errors.Errorf("invalid sender: %v", value)
+			}
+			crit.sender = sender
+		case "chainId":
+			var chainID int64
+			switch v := value.(type) {
+			case float64:
+				chainID = int64(v)
+				if float64(chainID) != v {
+					return crit, errors.Errorf("invalid chain id: %v", value)
+				}
+			case string:
+				parsed, err := strconv.ParseInt(v, 10, 64)
+				if err != nil {
+					return crit, errors.Errorf("invalid chain id: %v", value)
+				}
+				chainID = parsed
+			default:
+				return crit, errors.Errorf("invalid chain id: %v", value)
+			}
+			crit.chainID = &chainID
+		default:
+			return crit, errors.Errorf("unsupported criteria %s", key)
+		}
+	}
+	return crit, nil
+}
+
+// matches returns true if the cctx matches the criteria
+func (crit cctxCriteria) matches(cctx crosschaintypes.CrossChainTx) bool {
+	if crit.index != "" && !strings.EqualFold(crit.index, cctx.Index) {
+		return false
+	}
+	if crit.sender != "" {
+		inbound := cctx.InboundTxParams
+		if inbound == nil || (!strings.EqualFold(crit.sender, inbound.Sender) && !strings.EqualFold(crit.sender, inbound.TxOrigin)) {
+			return false
+		}
+	}
+	if crit.chainID != nil {
+		if cctx.InboundTxParams != nil && cctx.InboundTxParams.SenderChainId == *crit.chainID {
+			return true
+		}
+		for _, outbound := range cctx.OutboundTxParams {
+			if outbound != nil && outbound.ReceiverChainId == *crit.chainID {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// cctxEvents returns the indexes of the cctxs created or updated by the events of a transaction
+// the types of the crosschain events of each cctx are returned in the order of emission
+func cctxEvents(events []abci.Event) (indexes []string, eventTypes map[string][]string) {
+	eventTypes = make(map[string][]string)
+	for _, event := range events {
+		if !strings.HasPrefix(event.Type, crosschainEventPrefix) {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) != cctxIndexAttribute {
+				continue
+			}
+			// the attributes of the typed events are JSON encoded
+			var index string
+			if err := json.Unmarshal(attr.Value, &index); err != nil || index == "" {
+				continue
+			}
+			if _, found := eventTypes[index]; !found {
+				indexes = append(indexes, index)
+			}
+			eventTypes[index] = append(eventTypes[index], event.Type)
+		}
+	}
+	return indexes, eventTypes
+}
+
+// subscribeZeta subscribes to the zeta_ prefixed subscriptions
+func (api *pubSubAPI) subscribeZeta(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+	method, ok := params[0].(string)
+	if !ok {
+		return nil, errors.New("invalid parameters")
+	}
+
+	switch method {
+	case "cctx":
+		if len(params) > 1 {
+			return api.subscribeCctx(wsConn, subID, params[1])
+		}
+		return api.subscribeCctx(wsConn, subID, nil)
+	default:
+		return nil, errors.Errorf("unsupported method %s", method)
+	}
+}
+
+// subscribeCctx pushes the cctxs created or updated by the transactions of new blocks
+// the cctxs are read from the state of the block of the transaction and filtered with the criteria
+func (api *pubSubAPI) subscribeCctx(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+	crit, err := parseCctxCriteria(extra)
+	if err != nil {
+		api.logger.Debug("invalid cctx criteria", "type", fmt.Sprintf("%T", extra), "error", err.Error())
+		return nil, err
+	}
+
+	sub, unsubFn, err := api.events.SubscribePendingTxs()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating cctx filter")
+	}
+
+	go func() {
+		txsCh := sub.Event()
+		errCh := sub.Err()
+		for {
+			select {
+			case ev, ok := <-txsCh:
+				if !ok {
+					return
+				}
+
+				data, ok := ev.Data.(tmtypes.EventDataTx)
+				if !ok {
+					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+					continue
+				}
+
+				indexes, eventTypes := cctxEvents(data.Result.Events)
+				if len(indexes) == 0 {
+					continue
+				}
+				queryClient := crosschaintypes.NewQueryClient(api.clientCtx.WithHeight(data.Height))
+				txHash := fmt.Sprintf("%X", tmtypes.Tx(data.Tx).Hash())
+
+				for _, index := range indexes {
+					if crit.index != "" && !strings.EqualFold(crit.index, index) {
+						continue
+					}
+					res, err := queryClient.Cctx(context.Background(), &crosschaintypes.QueryGetCctxRequest{Index: index})
+					if err != nil {
+						api.logger.Debug("failed to query cctx", "index", index, "error", err.Error())
+						continue
+					}
+					if res.CrossChainTx == nil || !crit.matches(*res.CrossChainTx) {
+						continue
+					}
+					cctx, err := api.clientCtx.Codec.MarshalJSON(res.CrossChainTx)
+					if err != nil {
+						api.logger.Error("failed to marshal cctx", "index", index, "error", err.Error())
+						continue
+					}
+
+					// write to ws conn
+					notification := &SubscriptionNotification{
+						Jsonrpc: "2.0",
+						Method:  "zeta_subscription",
+						Params: &SubscriptionResult{
+							Subscription: subID,
+							Result: &CctxNotification{
+								Height: data.Height,
+								TxHash: txHash,
+								Events: eventTypes[index],
+								Cctx:   cctx,
+							},
+						},
+					}
+
+					err = wsConn.WriteJSON(notification)
+					if err != nil {
+						api.logger.Debug("error writing cctx, will drop peer", "error", err.Error())
+
+						try(func() {
+							if !errors.Is(err, websocket.ErrCloseSent) {
+								err = wsConn.Close()
+								if err != nil {
+									api.logger.Debug("error closing websocket peer", "error", err.Error())
+								}
+							}
+						}, api.logger, "closing websocket peer sub")
+					}
+				}
+			case err, ok := <-errCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping Cctx WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			}
+		}
+	}()
+
+	return unsubFn, nil
+}
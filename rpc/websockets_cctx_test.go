@@ -0,0 +1,93 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestParseCctxCriteria(t *testing.T) {
+	crit, err := parseCctxCriteria(nil)
+	require.NoError(t, err)
+	require.Equal(t, cctxCriteria{}, crit)
+
+	crit, err = parseCctxCriteria(map[string]interface{}{
+		"index":   "0x123",
+		"sender":  "0xabc",
+		"chainId": float64(5),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0x123", crit.index)
+	require.Equal(t, "0xabc", crit.sender)
+	require.Equal(t, int64(5), *crit.chainID)
+
+	crit, err = parseCctxCriteria(map[string]interface{}{"chainId": "18332"})
+	require.NoError(t, err)
+	require.Equal(t, int64(18332), *crit.chainID)
+
+	_, err = parseCctxCriteria("0x123")
+	require.Error(t, err)
+	_, err = parseCctxCriteria(map[string]interface{}{"chainId": 1.5})
+	require.Error(t, err)
+	_, err = parseCctxCriteria(map[string]interface{}{"index": 1})
+	require.Error(t, err)
+	_, err = parseCctxCriteria(map[string]interface{}{"receiver": "0xabc"})
+	require.Error(t, err)
+}
+
+func TestCctxCriteria_Matches(t *testing.T) {
+	chainID := int64(5)
+	otherChainID := int64(7000)
+	cctx := crosschaintypes.CrossChainTx{
+		Index: "0xABC",
+		InboundTxParams: &crosschaintypes.InboundTxParams{
+			Sender:        "0xSender",
+			TxOrigin:      "0xOrigin",
+			SenderChainId: 1,
+		},
+		OutboundTxParams: []*crosschaintypes.OutboundTxParams{{ReceiverChainId: chainID}},
+	}
+
+	require.True(t, cctxCriteria{}.matches(cctx))
+	require.True(t, cctxCriteria{index: "0xabc"}.matches(cctx))
+	require.False(t, cctxCriteria{index: "0xdef"}.matches(cctx))
+	require.True(t, cctxCriteria{sender: "0xsender"}.matches(cctx))
+	require.True(t, cctxCriteria{sender: "0xorigin"}.matches(cctx))
+	require.False(t, cctxCriteria{sender: "0xreceiver"}.matches(cctx))
+	require.True(t, cctxCriteria{chainID: &chainID}.matches(cctx))
+	require.False(t, cctxCriteria{chainID: &otherChainID}.matches(cctx))
+	require.False(t, cctxCriteria{index: "0xabc", chainID: &otherChainID}.matches(cctx))
+}
+
+func TestCctxEvents(t *testing.T) {
+	indexes, eventTypes := cctxEvents([]abci.Event{
+		{
+			Type:       "zetachain.zetacore.crosschain.EventInboundFinalized",
+			Attributes: []abci.EventAttribute{{Key: []byte("cctx_index"), Value: []byte(`"0x1"`)}},
+		},
+		{
+			Type:       "message",
+			Attributes: []abci.EventAttribute{{Key: []byte("cctx_index"), Value: []byte(`"0x2"`)}},
+		},
+		{
+			Type:       "zetachain.zetacore.crosschain.EventZrcWithdrawCreated",
+			Attributes: []abci.EventAttribute{{Key: []byte("cctx_index"), Value: []byte(`"0x3"`)}},
+		},
+		{
+			Type:       "zetachain.zetacore.crosschain.EventCctxStatusChanged",
+			Attributes: []abci.EventAttribute{{Key: []byte("cctx_index"), Value: []byte(`"0x1"`)}},
+		},
+		{
+			Type:       "zetachain.zetacore.crosschain.EventRateLimitUpdated",
+			Attributes: []abci.EventAttribute{{Key: []byte("chain_id"), Value: []byte(`"1"`)}},
+		},
+	})
+	require.Equal(t, []string{"0x1", "0x3"}, indexes)
+	require.Equal(t, []string{
+		"zetachain.zetacore.crosschain.EventInboundFinalized",
+		"zetachain.zetacore.crosschain.EventCctxStatusChanged",
+	}, eventTypes["0x1"])
+	require.Equal(t, []string{"zetachain.zetacore.crosschain.EventZrcWithdrawCreated"}, eventTypes["0x3"])
+}
@@ -196,6 +196,54 @@ func (_m *CrosschainObserverKeeper) GetBlockHeader(ctx types.Context, hash []byt
 	return r0, r1
 }
 
+// GetChainInfo provides a mock function with given fields: ctx, chainID
+func (_m *CrosschainObserverKeeper) GetChainInfo(ctx types.Context, chainID int64) (common.ChainInfo, bool) {
+	ret := _m.Called(ctx, chainID)
+
+	var r0 common.ChainInfo
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) (common.ChainInfo, bool)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, int64) common.ChainInfo); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(common.ChainInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, int64) bool); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GetChainState provides a mock function with given fields: ctx, chainID
+func (_m *CrosschainObserverKeeper) GetChainState(ctx types.Context, chainID int64) (observertypes.ChainState, bool) {
+	ret := _m.Called(ctx, chainID)
+
+	var r0 observertypes.ChainState
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) (observertypes.ChainState, bool)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, int64) observertypes.ChainState); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(observertypes.ChainState)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, int64) bool); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetCoreParamsByChainID provides a mock function with given fields: ctx, chainID
 func (_m *CrosschainObserverKeeper) GetCoreParamsByChainID(ctx types.Context, chainID int64) (*observertypes.CoreParams, bool) {
 	ret := _m.Called(ctx, chainID)
@@ -332,6 +380,22 @@ func (_m *CrosschainObserverKeeper) GetParams(ctx types.Context) observertypes.P
 	return r0
 }
 
+// GetRegisteredChains provides a mock function with given fields: ctx
+func (_m *CrosschainObserverKeeper) GetRegisteredChains(ctx types.Context) []*common.Chain {
+	ret := _m.Called(ctx)
+
+	var r0 []*common.Chain
+	if rf, ok := ret.Get(0).(func(types.Context) []*common.Chain); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*common.Chain)
+		}
+	}
+
+	return r0
+}
+
 // IsAuthorized provides a mock function with given fields: ctx, address, chain
 func (_m *CrosschainObserverKeeper) IsAuthorized(ctx types.Context, address string, chain *common.Chain) bool {
 	ret := _m.Called(ctx, address, chain)
@@ -346,6 +410,76 @@ func (_m *CrosschainObserverKeeper) IsAuthorized(ctx types.Context, address stri
 	return r0
 }
 
+// IsBitcoinChain provides a mock function with given fields: ctx, chainID
+func (_m *CrosschainObserverKeeper) IsBitcoinChain(ctx types.Context, chainID int64) bool {
+	ret := _m.Called(ctx, chainID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) bool); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsCanonicalBlockHeader provides a mock function with given fields: ctx, header
+func (_m *CrosschainObserverKeeper) IsCanonicalBlockHeader(ctx types.Context, header common.BlockHeader) bool {
+	ret := _m.Called(ctx, header)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, common.BlockHeader) bool); ok {
+		r0 = rf(ctx, header)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsChainInboundEnabled provides a mock function with given fields: ctx, chainID
+func (_m *CrosschainObserverKeeper) IsChainInboundEnabled(ctx types.Context, chainID int64) bool {
+	ret := _m.Called(ctx, chainID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) bool); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsChainOutboundEnabled provides a mock function with given fields: ctx, chainID
+func (_m *CrosschainObserverKeeper) IsChainOutboundEnabled(ctx types.Context, chainID int64) bool {
+	ret := _m.Called(ctx, chainID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) bool); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsEVMChain provides a mock function with given fields: ctx, chainID
+func (_m *CrosschainObserverKeeper) IsEVMChain(ctx types.Context, chainID int64) bool {
+	ret := _m.Called(ctx, chainID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) bool); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsInboundEnabled provides a mock function with given fields: ctx
 func (_m *CrosschainObserverKeeper) IsInboundEnabled(ctx types.Context) bool {
 	ret := _m.Called(ctx)
@@ -98,6 +98,30 @@ func (_m *FungibleObserverKeeper) GetCoreParamsByChainID(ctx types.Context, chai
 	return r0, r1
 }
 
+// GetChainInfo provides a mock function with given fields: ctx, chainID
+func (_m *FungibleObserverKeeper) GetChainInfo(ctx types.Context, chainID int64) (common.ChainInfo, bool) {
+	ret := _m.Called(ctx, chainID)
+
+	var r0 common.ChainInfo
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64) (common.ChainInfo, bool)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, int64) common.ChainInfo); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(common.ChainInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, int64) bool); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetObserverMapper provides a mock function with given fields: ctx, chain
 func (_m *FungibleObserverKeeper) GetObserverMapper(ctx types.Context, chain *common.Chain) (observertypes.ObserverMapper, bool) {
 	ret := _m.Called(ctx, chain)
@@ -136,6 +160,20 @@ func (_m *FungibleObserverKeeper) GetParams(ctx types.Context) observertypes.Par
 	return r0
 }
 
+// IsEmergencyAdmin provides a mock function with given fields: ctx, address
+func (_m *FungibleObserverKeeper) IsEmergencyAdmin(ctx types.Context, address string) bool {
+	ret := _m.Called(ctx, address)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, string) bool); ok {
+		r0 = rf(ctx, address)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // SetBallot provides a mock function with given fields: ctx, ballot
 func (_m *FungibleObserverKeeper) SetBallot(ctx types.Context, ballot *observertypes.Ballot) {
 	_m.Called(ctx, ballot)
@@ -3,11 +3,13 @@ package keeper
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/stretchr/testify/require"
 	tmdb "github.com/tendermint/tm-db"
@@ -16,7 +18,7 @@ import (
 )
 
 func initObserverKeeper(
-	cdc codec.Codec,
+	cdc *codec.ProtoCodec,
 	db *tmdb.MemDB,
 	ss store.CommitMultiStore,
 	stakingKeeper stakingkeeper.Keeper,
@@ -27,7 +29,7 @@ func initObserverKeeper(
 	ss.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	ss.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, db)
 
-	return keeper.NewKeeper(
+	return newObserverKeeperWithMsgRouter(
 		cdc,
 		storeKey,
 		memKey,
@@ -36,6 +38,31 @@ func initObserverKeeper(
 	)
 }
 
+// newObserverKeeperWithMsgRouter instantiates an observer keeper with a message router handling the observer messages
+// to execute the admin proposals
+func newObserverKeeperWithMsgRouter(
+	cdc *codec.ProtoCodec,
+	storeKey,
+	memKey storetypes.StoreKey,
+	subspace paramstypes.Subspace,
+	stakingKeeper types.StakingKeeper,
+) *keeper.Keeper {
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(cdc.InterfaceRegistry())
+
+	k := keeper.NewKeeper(
+		cdc,
+		storeKey,
+		memKey,
+		subspace,
+		stakingKeeper,
+		msgRouter,
+	)
+	types.RegisterMsgServer(msgRouter, keeper.NewMsgServerImpl(*k))
+
+	return k
+}
+
 // ObserverKeeper instantiates an observer keeper for testing purposes
 func ObserverKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
@@ -62,7 +89,7 @@ func ObserverKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	// Add a proposer to the context
 	ctx = sdkKeepers.InitBlockProposer(t, ctx)
 
-	k := keeper.NewKeeper(
+	k := newObserverKeeperWithMsgRouter(
 		cdc,
 		storeKey,
 		memStoreKey,
@@ -130,6 +130,7 @@ func AddCrosschainData(t *testing.T, n int, genesisState map[string]json.RawMess
 				Status:              types.CctxStatus_PendingInbound,
 				StatusMessage:       "",
 				LastUpdateTimestamp: 0,
+				Transitions:         []*types.StatusTransition{},
 			},
 			InboundTxParams:  &types.InboundTxParams{InboundTxObservedHash: fmt.Sprintf("Hash-%d", i), Amount: math.OneUint()},
 			OutboundTxParams: []*types.OutboundTxParams{},
@@ -140,7 +141,7 @@ func AddCrosschainData(t *testing.T, n int, genesisState map[string]json.RawMess
 		state.ChainNoncesList = append(state.ChainNoncesList, &types.ChainNonces{Creator: "ANY", Index: strconv.Itoa(i), Signers: []string{}})
 	}
 	for i := 0; i < n; i++ {
-		state.GasPriceList = append(state.GasPriceList, &types.GasPrice{Creator: "ANY", ChainId: int64(i), Index: strconv.Itoa(i), Prices: []uint64{}, BlockNums: []uint64{}, Signers: []string{}})
+		state.GasPriceList = append(state.GasPriceList, &types.GasPrice{Creator: "ANY", ChainId: int64(i), Index: strconv.Itoa(i), Prices: []uint64{}, PriorityFees: []uint64{}, VoteHeights: []int64{}, BlockNums: []uint64{}, Signers: []string{}})
 	}
 	for i := 0; i < n; i++ {
 		state.LastBlockHeightList = append(state.LastBlockHeightList, &types.LastBlockHeight{Creator: "ANY", Index: strconv.Itoa(i)})
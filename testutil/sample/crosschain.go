@@ -79,6 +79,7 @@ func OutboundTxParams(r *rand.Rand) *types.OutboundTxParams {
 		OutboundTxTssNonce:               r.Uint64(),
 		OutboundTxGasLimit:               r.Uint64(),
 		OutboundTxGasPrice:               math.NewUint(uint64(r.Int63())).String(),
+		OutboundTxGasPriorityFee:         math.NewUint(uint64(r.Int63())).String(),
 		OutboundTxHash:                   StringRandom(r, 32),
 		OutboundTxBallotIndex:            StringRandom(r, 32),
 		OutboundTxObservedExternalHeight: r.Uint64(),
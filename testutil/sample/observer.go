@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/node/common"
 	"github.com/zeta-chain/node/x/observer/types"
 )
@@ -24,6 +25,19 @@ func Ballot(t *testing.T, index string) *types.Ballot {
 	}
 }
 
+func BallotSummary(t *testing.T, index string) types.BallotSummary {
+	r := newRandFromStringSeed(t, index)
+
+	return types.BallotSummary{
+		BallotIdentifier:     index + StringRandom(r, 32),
+		BallotStatus:         types.BallotStatus_BallotFinalized_SuccessObservation,
+		ObservationType:      types.ObservationType_InBoundTx,
+		BallotCreationHeight: r.Int63(),
+		VoterList:            []string{AccAddress(), AccAddress()},
+		VoterBitmap:          []byte{0x02},
+	}
+}
+
 func ObserverMapper(t *testing.T, index string) *types.ObserverMapper {
 	r := newRandFromStringSeed(t, index)
 
@@ -98,3 +112,29 @@ func CoreParamsList() (cpl types.CoreParamsList) {
 	}
 	return
 }
+
+func AdminSignerSet(policyType types.Policy_Type) types.AdminSignerSet {
+	return types.AdminSignerSet{
+		PolicyType: policyType,
+		Signers:    []string{AccAddress(), AccAddress(), AccAddress()},
+		Threshold:  2,
+		Timelock:   10,
+	}
+}
+
+// AdminProposal returns a pending admin proposal disabling the crosschain transactions
+func AdminProposal(t *testing.T, id uint64, policyType types.Policy_Type) types.AdminProposal {
+	proposal := types.AdminProposal{
+		Id:           id,
+		PolicyType:   policyType,
+		Proposer:     AccAddress(),
+		Approvals:    []string{AccAddress()},
+		SubmitHeight: 42,
+		Status:       types.AdminProposalStatus_AdminProposalPending,
+	}
+	err := proposal.SetMsgs([]sdk.Msg{
+		types.NewMsgUpdateCrosschainFlags(types.AdminProposalAccount(policyType).String(), false, false),
+	})
+	require.NoError(t, err)
+	return proposal
+}
@@ -96,6 +96,11 @@ func EthAddress() ethcommon.Address {
 	return ethcommon.BytesToAddress(sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).Bytes())
 }
 
+// Hash returns a sample hash
+func Hash() ethcommon.Hash {
+	return ethcommon.BytesToHash(ed25519.GenPrivKey().PubKey().Bytes())
+}
+
 // Bytes returns a sample byte array
 func Bytes() []byte {
 	return []byte("sample")
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// CmdRefundAbortedCCTX refunds the amount of an aborted CCTX on ZetaChain, the message must be broadcasted by the admin policy
+func CmdRefundAbortedCCTX() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-aborted-cctx [cctx-index]",
+		Short: "Refund the amount of an aborted CCTX to the zEVM address of its sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefundAbortedCCTX(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdRetryAbortedCCTX schedules again the outbound of an aborted CCTX, the message must be broadcasted by the admin policy
+func CmdRetryAbortedCCTX() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-aborted-cctx [cctx-index]",
+		Short: "Retry the outbound of an aborted CCTX with a new nonce and the current gas price",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetryAbortedCCTX(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
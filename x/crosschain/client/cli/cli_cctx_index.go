@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func CmdListCctxBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-cctx-by-sender [sender]",
+		Short: "list the CCTXs sent by an address, the address is the sender or the origin of the inbound",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllCctxBySenderRequest{
+				Sender:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.CctxAllBySender(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListCctxByReceiver() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-cctx-by-receiver [receiver]",
+		Short: "list the CCTXs received by an address on the receiver chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllCctxByReceiverRequest{
+				Receiver:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.CctxAllByReceiver(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListCctxByStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-cctx-by-status [status]",
+		Short: "list the CCTXs with a status, e.g. PendingOutbound",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			cctxStatus, ok := types.CctxStatus_value[args[0]]
+			if !ok {
+				return fmt.Errorf("invalid status %s", args[0])
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllCctxByStatusRequest{
+				Status:     types.CctxStatus(cctxStatus),
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.CctxAllByStatus(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListCctxBySenderChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-cctx-by-sender-chain [chain-id]",
+		Short: "list the CCTXs sent from a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllCctxBySenderChainRequest{
+				SenderChainId: chainID,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.CctxAllBySenderChain(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListCctxByHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-cctx-by-height [start-height] [end-height]",
+		Short: "list the CCTXs created on ZetaChain between two heights included",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			startHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			endHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllCctxByHeightRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Pagination:  pageReq,
+			}
+
+			res, err := queryClient.CctxAllByHeight(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
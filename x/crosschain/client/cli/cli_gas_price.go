@@ -72,13 +72,52 @@ func CmdShowGasPrice() *cobra.Command {
 	return cmd
 }
 
+func CmdGasPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-price-history [chain-id]",
+		Short: "list the history of the gas price of a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGasPriceHistoryRequest{
+				ChainId:    chainID,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GasPriceHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // Transaction CLI /////////////////////////
 
 func CmdGasPriceVoter() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "gas-price-voter [chain] [price] [supply] [blockNumber]",
+		Use:   "gas-price-voter [chain] [price] [priorityFee] [supply] [blockNumber]",
 		Short: "Broadcast message gasPriceVoter",
-		Args:  cobra.ExactArgs(4),
+		Args:  cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			argsChain, err := strconv.ParseInt(args[0], 10, 64)
 			if err != nil {
@@ -88,9 +127,13 @@ func CmdGasPriceVoter() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			argsSupply := args[2]
+			argsPriorityFee, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsSupply := args[3]
 
-			argsBlockNumber, err := strconv.ParseUint(args[3], 10, 64)
+			argsBlockNumber, err := strconv.ParseUint(args[4], 10, 64)
 			if err != nil {
 				return err
 			}
@@ -99,7 +142,7 @@ func CmdGasPriceVoter() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgGasPriceVoter(clientCtx.GetFromAddress().String(), argsChain, argsPrice, argsSupply, argsBlockNumber)
+			msg := types.NewMsgGasPriceVoter(clientCtx.GetFromAddress().String(), argsChain, argsPrice, argsPriorityFee, argsSupply, argsBlockNumber)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
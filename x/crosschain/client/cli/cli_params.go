@@ -3,8 +3,10 @@ package cli
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/spf13/cobra"
 	"github.com/zeta-chain/node/x/crosschain/types"
 )
@@ -32,3 +34,44 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// CmdUpdateParams updates the fee parameters of the module, the message must be broadcasted by the admin policy
+func CmdUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [gas-price-multiplier] [zeta-gas-price-multiplier] [protocol-fee]",
+		Short: "Update the multipliers of the gas price of the outbound txs and the protocol fee in azeta",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			protocolFee, err := math.ParseUint(args[2])
+			if err != nil {
+				return err
+			}
+
+			// the parameters not updated by the command keep their current value
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			params := res.Params
+			params.GasPriceMultiplier = args[0]
+			params.ZetaGasPriceMultiplier = args[1]
+			params.ProtocolFee = protocolFee
+
+			msg := types.NewMsgUpdateParams(clientCtx.GetFromAddress().String(), params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// CmdProveInboundTx broadcasts a proof-based inbound
+// the proof of the transaction and the proof of the receipt are provided as JSON files
+func CmdProveInboundTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prove-inbound-tx [chain] [tx-hash] [block-hash] [tx-index] [proof-file] [receipt-proof-file]",
+		Short: "Create the cctx of an inbound from the proofs of its transaction and receipt",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argChain, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argTxHash := args[1]
+			argBlockHash := args[2]
+			argTxIndex, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proof, err := readProofFile(clientCtx, args[4])
+			if err != nil {
+				return err
+			}
+			receiptProof, err := readProofFile(clientCtx, args[5])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProveInboundTx(
+				clientCtx.GetFromAddress().String(),
+				argChain,
+				argTxHash,
+				argBlockHash,
+				argTxIndex,
+				proof,
+				receiptProof,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readProofFile reads a JSON encoded proof from a file
+func readProofFile(clientCtx client.Context, path string) (*common.Proof, error) {
+	// #nosec G304 path is provided by the user
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var proof common.Proof
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &proof); err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// CmdProveOutboundTx broadcasts a proof-based outbound
+// the proof of the transaction and the proof of the receipt are provided as JSON files, the receipt proof is only
+// required for EVM chains
+func CmdProveOutboundTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prove-outbound-tx [chain] [nonce] [tx-hash] [block-hash] [tx-index] [proof-file] [receipt-proof-file]",
+		Short: "Finalize a pending outbound from the proofs of its transaction and receipt",
+		Args:  cobra.RangeArgs(6, 7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argChain, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argTxHash := args[2]
+			argBlockHash := args[3]
+			argTxIndex, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proof, err := readProofFile(clientCtx, args[5])
+			if err != nil {
+				return err
+			}
+			var receiptProof *common.Proof
+			if len(args) == 7 {
+				receiptProof, err = readProofFile(clientCtx, args[6])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgProveOutboundTx(
+				clientCtx.GetFromAddress().String(),
+				argChain,
+				argNonce,
+				argTxHash,
+				argBlockHash,
+				argTxIndex,
+				proof,
+				receiptProof,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
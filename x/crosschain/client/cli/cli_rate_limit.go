@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+const flagZRC20 = "zrc20"
+
+func CmdListRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-rate-limits",
+		Short: "list the rate limits and their current windows",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimits(context.Background(), &types.QueryRateLimitsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListCctxPendingReview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-cctx-pending-review",
+		Short: "list the CCTXs held for review because they exceeded a rate limit",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CctxAllPendingReview(context.Background(), &types.QueryAllCctxPendingReviewRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdUpdateRateLimit sets the rate limit of a chain or of one of its foreign coins, the message must be broadcasted by the admin policy
+func CmdUpdateRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-rate-limit [chain-id] [window] [max-value] [max-cctx-count]",
+		Short: "Set the rate limit of a chain, or of a foreign coin of the chain with --zrc20, a rate limit without maximum is removed",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			window, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			maxValue, err := math.ParseUint(args[2])
+			if err != nil {
+				return err
+			}
+			maxCctxCount, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			zrc20Address, err := cmd.Flags().GetString(flagZRC20)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRateLimit(clientCtx.GetFromAddress().String(), types.RateLimit{
+				ChainId:      chainID,
+				Zrc20Address: zrc20Address,
+				Window:       window,
+				MaxValue:     maxValue,
+				MaxCctxCount: maxCctxCount,
+			})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagZRC20, "", "address of the ZRC20 of the foreign coin of the chain to rate limit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdReleaseCCTX releases a CCTX held for review, the message must be broadcasted by the admin policy
+func CmdReleaseCCTX() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-cctx [cctx-index]",
+		Short: "Release a CCTX held for review because it exceeded a rate limit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseCCTX(clientCtx.GetFromAddress().String(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -67,6 +67,32 @@ func CmdListTssHistory() *cobra.Command {
 	return cmd
 }
 
+func CmdShowTssMigration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-tss-migration",
+		Short: "show the migration of the TSS funds to a new TSS",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryTssMigrationRequest{}
+
+			res, err := queryClient.TssMigration(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // Transaction CLI /////////////////////////
 
 func CmdCreateTSSVoter() *cobra.Command {
@@ -137,3 +163,31 @@ func CmdUpdateTss() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdMigrateTssFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-tss-funds [pubkey]",
+		Short: "Migrate the funds of the current TSS to a generated TSS",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			argsPubkey, err := cast.ToStringE(args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateTssFunds(clientCtx.GetFromAddress().String(), argsPubkey)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
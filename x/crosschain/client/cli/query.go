@@ -31,10 +31,19 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdShowTSS(),
 		CmdListGasPrice(),
 		CmdShowGasPrice(),
+		CmdGasPriceHistory(),
 		CmdListChainNonces(),
 		CmdShowChainNonces(),
 		CmdListSend(),
 		CmdShowSend(),
+		CmdShowCctxStatusTransitions(),
+		CmdListCctxBySender(),
+		CmdListCctxByReceiver(),
+		CmdListCctxByStatus(),
+		CmdListCctxBySenderChain(),
+		CmdListCctxByHeight(),
+		CmdListCctxPendingReview(),
+		CmdListRateLimits(),
 		CmdLastZetaHeight(),
 		CmdInTxHashToCctxData(),
 		CmdListInTxHashToCctx(),
@@ -42,6 +51,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdQueryParams(),
 		CmdGetTssAddress(),
 		CmdListTssHistory(),
+		CmdShowTssMigration(),
 	)
 
 	return cmd
@@ -29,6 +29,14 @@ func GetTxCmd() *cobra.Command {
 		CmdCCTXInboundVoter(),
 		CmdRemoveFromWatchList(),
 		CmdUpdateTss(),
+		CmdMigrateTssFunds(),
+		CmdProveInboundTx(),
+		CmdProveOutboundTx(),
+		CmdRefundAbortedCCTX(),
+		CmdRetryAbortedCCTX(),
+		CmdUpdateRateLimit(),
+		CmdReleaseCCTX(),
+		CmdUpdateParams(),
 	)
 
 	return cmd
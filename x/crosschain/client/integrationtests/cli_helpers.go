@@ -80,6 +80,7 @@ func BuildSignedGasPriceVote(t testing.TB, val *network.Validator, denom string,
 	inboundVoterArgs := []string{
 		strconv.FormatInt(common.GoerliChain().ChainId, 10),
 		"10000000000",
+		"0",
 		"100",
 		"100",
 	}
@@ -143,6 +144,8 @@ func BuildSignedOutboundVote(t testing.TB, val *network.Validator, denom string,
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10))).String()),
+		// the finalizing vote updates the cctx and its indexes
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 400000),
 	}
 	args := append(outboundVoterArgs, txArgs...)
 	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
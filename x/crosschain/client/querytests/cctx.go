@@ -119,3 +119,48 @@ func (s *CliTestSuite) TestShowSend() {
 		})
 	}
 }
+
+func (s *CliTestSuite) TestShowCctxStatusTransitions() {
+	ctx := s.network.Validators[0].ClientCtx
+	objs := s.crosschainState.CrossChainTxs
+	common := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	for _, tc := range []struct {
+		desc string
+		id   string
+		args []string
+		err  error
+		obj  []*types.StatusTransition
+	}{
+		{
+			desc: "found",
+			id:   objs[0].Index,
+			args: common,
+			obj:  objs[0].CctxStatus.Transitions,
+		},
+		{
+			desc: "not found",
+			id:   "not_found",
+			args: common,
+			err:  status.Error(codes.InvalidArgument, "not found"),
+		},
+	} {
+		tc := tc
+		s.Run(tc.desc, func() {
+			args := []string{tc.id}
+			args = append(args, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowCctxStatusTransitions(), args)
+			if tc.err != nil {
+				stat, ok := status.FromError(tc.err)
+				s.Require().True(ok)
+				s.Require().ErrorIs(stat.Err(), tc.err)
+			} else {
+				s.Require().NoError(err)
+				var resp types.QueryCctxStatusTransitionsResponse
+				s.Require().NoError(s.network.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				s.Require().Equal(tc.obj, resp.Transitions)
+			}
+		})
+	}
+}
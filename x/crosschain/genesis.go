@@ -44,6 +44,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	// Set all the rate limits
+	for _, elem := range genState.RateLimits {
+		k.SetRateLimit(ctx, elem)
+	}
+
 	// Set all the cross-chain txs
 	for _, elem := range genState.CrossChainTxs {
 		if elem != nil {
@@ -67,6 +72,14 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			k.SetTSSHistory(ctx, elem)
 		}
 	}
+
+	// Set the migration of the tss funds in progress
+	if genState.PendingMigrationTss != nil {
+		k.SetPendingMigrationTss(ctx, *genState.PendingMigrationTss)
+	}
+	for _, elem := range genState.TssFundMigrators {
+		k.SetTssFundMigrator(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the crosschain module's exported genesis.
@@ -112,6 +125,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	genesis.TssHistory = k.GetAllTSS(ctx)
+	genesis.RateLimits = k.GetAllRateLimit(ctx)
+
+	// Get the migration of the tss funds in progress
+	pendingMigrationTss, found := k.GetPendingMigrationTss(ctx)
+	if found {
+		genesis.PendingMigrationTss = &pendingMigrationTss
+	}
+	genesis.TssFundMigrators = k.GetAllTssFundMigrators(ctx)
 
 	return &genesis
 }
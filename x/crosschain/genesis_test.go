@@ -3,6 +3,7 @@ package crosschain_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/nullify"
@@ -45,6 +46,32 @@ func TestGenesis(t *testing.T) {
 			sample.InTxHashToCctx(t, "0x1"),
 			sample.InTxHashToCctx(t, "0x2"),
 		},
+		RateLimits: []types.RateLimit{
+			{
+				ChainId:      1,
+				Window:       10,
+				MaxValue:     math.ZeroUint(),
+				MaxCctxCount: 100,
+			},
+			{
+				ChainId:      1,
+				Zrc20Address: sample.EthAddress().Hex(),
+				Window:       20,
+				MaxValue:     math.NewUint(1000),
+				MaxCctxCount: 0,
+			},
+		},
+		PendingMigrationTss: sample.Tss(),
+		TssFundMigrators: []types.TssFundMigratorInfo{
+			{
+				ChainId:            1,
+				MigrationCctxIndex: "0",
+			},
+			{
+				ChainId:            2,
+				MigrationCctxIndex: "1",
+			},
+		},
 	}
 
 	// Init and export
@@ -8,7 +8,6 @@ import (
 	cosmoserrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/zeta-chain/node/common"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 )
@@ -33,8 +32,11 @@ func (k Keeper) IterateAndUpdateCctxGasPrice(ctx sdk.Context) error {
 	}
 
 	// iterate all chains' pending cctx
-	chains := common.DefaultChainsList()
+	chains := k.zetaObserverKeeper.GetRegisteredChains(ctx)
 	for _, chain := range chains {
+		if chain.IsZetaChain() {
+			continue
+		}
 		res, err := k.CctxAllPending(sdk.UnwrapSDKContext(ctx), &types.QueryAllCctxPendingRequest{
 			ChainId: chain.ChainId,
 		})
@@ -110,6 +112,15 @@ func (k Keeper) IncreaseCctxGasPrice(ctx sdk.Context, cctx types.CrossChainTx, g
 
 	// increase gas price and set last update timestamp
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = math.NewUint(currentGasPrice).Add(gasPriceIncrease).String()
+
+	// the priority fee must also be increased for a dynamic fee tx to be replaced
+	if cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee != "" {
+		currentPriorityFee, err := strconv.ParseUint(cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee, 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to parse cctx priority fee %s: %s", cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee, err.Error())
+		}
+		cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = math.NewUint(currentPriorityFee).Add(gasPriceIncrease).String()
+	}
 	cctx.CctxStatus.LastUpdateTimestamp = ctx.BlockHeader().Time.Unix()
 	k.SetCrossChainTx(ctx, cctx)
 
@@ -212,6 +212,8 @@ func TestKeeper_IncreaseCctxGasPrice(t *testing.T) {
 		cctx := *sample.CrossChainTx(t, "foo")
 		previousGasPrice, ok := math.NewIntFromString(cctx.GetCurrentOutTxParam().OutboundTxGasPrice)
 		require.True(t, ok)
+		previousPriorityFee, ok := math.NewIntFromString(cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee)
+		require.True(t, ok)
 
 		// increase gas price
 		err := k.IncreaseCctxGasPrice(ctx, cctx, math.NewUint(42))
@@ -225,6 +227,22 @@ func TestKeeper_IncreaseCctxGasPrice(t *testing.T) {
 		currentGasPrice, ok := math.NewIntFromString(cctx.GetCurrentOutTxParam().OutboundTxGasPrice)
 		require.True(t, ok)
 		require.True(t, currentGasPrice.Equal(previousGasPrice.Add(math.NewInt(42))))
+
+		// priority fee increased
+		currentPriorityFee, ok := math.NewIntFromString(cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee)
+		require.True(t, ok)
+		require.True(t, currentPriorityFee.Equal(previousPriorityFee.Add(math.NewInt(42))))
+	})
+
+	t.Run("don't set priority fee if not set", func(t *testing.T) {
+		cctx := *sample.CrossChainTx(t, "bar")
+		cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = ""
+		err := k.IncreaseCctxGasPrice(ctx, cctx, math.NewUint(42))
+		require.NoError(t, err)
+
+		cctx, found := k.GetCrossChainTx(ctx, "bar")
+		require.True(t, found)
+		require.Empty(t, cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee)
 	})
 
 	t.Run("fail if invalid cctx", func(t *testing.T) {
@@ -234,4 +252,11 @@ func TestKeeper_IncreaseCctxGasPrice(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("fail if invalid priority fee", func(t *testing.T) {
+		cctx := *sample.CrossChainTx(t, "foo")
+		cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = "invalid"
+		err := k.IncreaseCctxGasPrice(ctx, cctx, math.NewUint(42))
+		require.Error(t, err)
+	})
+
 }
@@ -21,6 +21,10 @@ func (k Keeper) UpdateNonce(ctx sdk.Context, receiveChainID int64, cctx *types.C
 	if chain == nil {
 		return zetaObserverTypes.ErrSupportedChains
 	}
+	// only the cctxs migrating the funds of the current TSS can be scheduled while a migration is in progress
+	if k.IsTssMigrationInProgress(ctx) && !k.IsTssFundMigrator(ctx, cctx.Index) {
+		return cosmoserrors.Wrap(types.ErrTssMigrationInProgress, fmt.Sprintf("Chain(%s) | Identifiers : %s ", chain.ChainName.String(), cctx.LogIdentifierForCCTX()))
+	}
 
 	nonce, found := k.GetChainNonces(ctx, chain.ChainName.String())
 	if !found {
@@ -58,7 +62,7 @@ func (k Keeper) RefundAmountOnZetaChain(ctx sdk.Context, cctx types.CrossChainTx
 	if cctx.InboundTxParams.CoinType != common.CoinType_ERC20 {
 		return errors.New("unsupported coin type for refund on ZetaChain")
 	}
-	if !common.IsEVMChain(cctx.InboundTxParams.SenderChainId) {
+	if !k.zetaObserverKeeper.IsEVMChain(ctx, cctx.InboundTxParams.SenderChainId) {
 		return errors.New("only EVM chains are supported for refund on ZetaChain")
 	}
 	sender := ethcommon.HexToAddress(cctx.InboundTxParams.Sender)
@@ -86,3 +90,10 @@ func (k Keeper) RefundAmountOnZetaChain(ctx sdk.Context, cctx types.CrossChainTx
 
 	return nil
 }
+
+// ChangeCctxStatus changes the status of the CCTX, the transition is appended to the status transitions of the CCTX
+// and emitted as an event
+func ChangeCctxStatus(ctx sdk.Context, cctx *types.CrossChainTx, newStatus types.CctxStatus, msg string, trigger types.StatusTrigger) {
+	transition := cctx.CctxStatus.TransitionStatus(ctx, newStatus, msg, trigger)
+	EmitEventCctxStatusChanged(ctx, *cctx, transition)
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	zrc20 "github.com/zeta-chain/protocol-contracts/pkg/contracts/zevm/zrc20.sol"
+)
+
+func TestKeeper_VoteOnObservedInboundTxChainPaused(t *testing.T) {
+	k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	zk.ObserverKeeper.SetParams(ctx, observertypes.DefaultParams())
+	zk.ObserverKeeper.SetCrosschainFlags(ctx, observertypes.CrosschainFlags{IsInboundEnabled: true})
+	chain := getValidEthChain(t)
+	zk.ObserverKeeper.SetChainCrosschainFlags(ctx, observertypes.ChainCrosschainFlags{
+		ChainId:           chain.ChainId,
+		IsInboundEnabled:  false,
+		IsOutboundEnabled: true,
+	})
+
+	_, err := msgServer.VoteOnObservedInboundTx(sdk.WrapSDKContext(ctx), zetaDepositMsg(chain.ChainId, sample.EthAddress(), 42))
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+}
+
+func TestKeeper_ProcessZRC20WithdrawalEventChainPaused(t *testing.T) {
+	k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+	k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+	zk.ObserverKeeper.SetCrosschainFlags(ctx, observertypes.CrosschainFlags{IsInboundEnabled: true})
+	chainID := getValidEthChainID(t)
+	k.SetTssAndUpdateNonce(ctx, *sample.Tss())
+	k.SetGasPrice(ctx, types.GasPrice{ChainId: chainID, MedianIndex: 0, Prices: []uint64{10}})
+	deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+	gasZRC20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+	zk.ObserverKeeper.SetChainCrosschainFlags(ctx, observertypes.ChainCrosschainFlags{
+		ChainId:           chainID,
+		IsInboundEnabled:  true,
+		IsOutboundEnabled: false,
+	})
+
+	err := k.ProcessZRC20WithdrawalEvent(ctx, &zrc20.ZRC20Withdrawal{
+		From:  sample.EthAddress(),
+		To:    sample.EthAddress().Bytes(),
+		Value: big.NewInt(42),
+		Raw: ethtypes.Log{
+			Address:     gasZRC20,
+			TxHash:      sample.Hash(),
+			BlockNumber: 10,
+		},
+	}, sample.EthAddress(), sample.EthAddress().Hex())
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	require.Empty(t, k.GetAllCrossChainTx(ctx))
+}
@@ -3,25 +3,27 @@ package keeper
 import (
 	"strconv"
 
-	"github.com/zeta-chain/node/common"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zeta-chain/node/x/crosschain/types"
 )
 
-func EmitEventInboundFinalized(ctx sdk.Context, cctx *types.CrossChainTx) {
+// EmitEventInboundFinalized emits the event of a finalized inbound, senderChain and receiverChain are the names of the
+// sender chain and of the current receiver chain of the cctx
+func EmitEventInboundFinalized(ctx sdk.Context, cctx *types.CrossChainTx, senderChain string, receiverChain string) {
 	currentOutParam := cctx.GetCurrentOutTxParam()
 	err := ctx.EventManager().EmitTypedEvents(&types.EventInboundFinalized{
 		MsgTypeUrl:     sdk.MsgTypeURL(&types.MsgVoteOnObservedInboundTx{}),
 		CctxIndex:      cctx.Index,
 		Sender:         cctx.InboundTxParams.Sender,
-		SenderChain:    common.GetChainFromChainID(cctx.InboundTxParams.SenderChainId).ChainName.String(),
+		SenderChain:    senderChain,
 		TxOrgin:        cctx.InboundTxParams.TxOrigin,
 		Asset:          cctx.InboundTxParams.Asset,
 		InTxHash:       cctx.InboundTxParams.InboundTxObservedHash,
 		InBlockHeight:  strconv.FormatUint(cctx.InboundTxParams.InboundTxObservedExternalHeight, 10),
 		Receiver:       currentOutParam.Receiver,
-		ReceiverChain:  common.GetChainFromChainID(currentOutParam.ReceiverChainId).ChainName.String(),
+		ReceiverChain:  receiverChain,
 		Amount:         cctx.InboundTxParams.Amount.String(),
 		RelayedMessage: cctx.RelayedMessage,
 		NewStatus:      cctx.CctxStatus.Status.String(),
@@ -85,3 +87,125 @@ func EmitOutboundFailure(ctx sdk.Context, msg *types.MsgVoteOnObservedOutboundTx
 		ctx.Logger().Error("Error emitting MsgVoteOnObservedOutboundTx :", err)
 	}
 }
+
+func EmitEventCctxStatusChanged(ctx sdk.Context, cctx types.CrossChainTx, transition types.StatusTransition) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventCctxStatusChanged{
+		MsgTypeUrl:    transition.MsgTypeUrl,
+		CctxIndex:     cctx.Index,
+		OldStatus:     transition.OldStatus.String(),
+		NewStatus:     transition.NewStatus.String(),
+		StatusMessage: transition.Message,
+		BallotIndex:   transition.BallotIndex,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventCctxStatusChanged :", err)
+	}
+}
+
+func EmitEventAbortedCctxRefunded(ctx sdk.Context, msg *types.MsgRefundAbortedCCTX, cctx types.CrossChainTx, refundAddress string, amount math.Uint) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventAbortedCctxRefunded{
+		MsgTypeUrl:    sdk.MsgTypeURL(msg),
+		CctxIndex:     cctx.Index,
+		Creator:       msg.Creator,
+		RefundAddress: refundAddress,
+		CoinType:      cctx.InboundTxParams.CoinType.String(),
+		Asset:         cctx.InboundTxParams.Asset,
+		Amount:        amount.String(),
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventAbortedCctxRefunded :", err)
+	}
+}
+
+func EmitEventAbortedCctxRetried(ctx sdk.Context, msg *types.MsgRetryAbortedCCTX, cctx types.CrossChainTx) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventAbortedCctxRetried{
+		MsgTypeUrl:         sdk.MsgTypeURL(msg),
+		CctxIndex:          cctx.Index,
+		Creator:            msg.Creator,
+		NewStatus:          cctx.CctxStatus.Status.String(),
+		ReceiverChain:      strconv.FormatInt(cctx.GetCurrentOutTxParam().ReceiverChainId, 10),
+		OutboundTxTssNonce: strconv.FormatUint(cctx.GetCurrentOutTxParam().OutboundTxTssNonce, 10),
+		OutboundTxGasPrice: cctx.GetCurrentOutTxParam().OutboundTxGasPrice,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventAbortedCctxRetried :", err)
+	}
+}
+
+func EmitEventRateLimitUpdated(ctx sdk.Context, msg *types.MsgUpdateRateLimit) {
+	maxValue := math.ZeroUint()
+	if !msg.RateLimit.MaxValue.IsNil() {
+		maxValue = msg.RateLimit.MaxValue
+	}
+	err := ctx.EventManager().EmitTypedEvent(&types.EventRateLimitUpdated{
+		MsgTypeUrl:   sdk.MsgTypeURL(msg),
+		ChainId:      strconv.FormatInt(msg.RateLimit.ChainId, 10),
+		Zrc20Address: msg.RateLimit.Zrc20Address,
+		Window:       strconv.FormatInt(msg.RateLimit.Window, 10),
+		MaxValue:     maxValue.String(),
+		MaxCctxCount: strconv.FormatUint(msg.RateLimit.MaxCctxCount, 10),
+		Signer:       msg.Creator,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventRateLimitUpdated :", err)
+	}
+}
+
+func EmitEventParamsUpdated(ctx sdk.Context, msg *types.MsgUpdateParams) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventParamsUpdated{
+		MsgTypeUrl:             sdk.MsgTypeURL(msg),
+		Enabled:                msg.Params.Enabled,
+		GasPriceMultiplier:     msg.Params.GasPriceMultiplier,
+		ZetaGasPriceMultiplier: msg.Params.ZetaGasPriceMultiplier,
+		ProtocolFee:            msg.Params.ProtocolFee.String(),
+		Signer:                 msg.Creator,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventParamsUpdated :", err)
+	}
+}
+
+func EmitEventCctxReleased(ctx sdk.Context, msg *types.MsgReleaseCCTX, cctx types.CrossChainTx) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventCctxReleased{
+		MsgTypeUrl: sdk.MsgTypeURL(msg),
+		CctxIndex:  cctx.Index,
+		Creator:    msg.Creator,
+		NewStatus:  cctx.CctxStatus.Status.String(),
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventCctxReleased :", err)
+	}
+}
+
+func EmitEventTssMigrationStarted(ctx sdk.Context, msgTypeURL string, currentTss types.TSS, newTss types.TSS, migrationCctxIndexes []string) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventTssMigrationStarted{
+		MsgTypeUrl:           msgTypeURL,
+		CurrentTssPubkey:     currentTss.TssPubkey,
+		NewTssPubkey:         newTss.TssPubkey,
+		MigrationCctxIndexes: migrationCctxIndexes,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventTssMigrationStarted :", err)
+	}
+}
+
+func EmitEventTssMigrationCompleted(ctx sdk.Context, msgTypeURL string, previousTss types.TSS, newTss types.TSS, releasedCctxCount int) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventTssMigrationCompleted{
+		MsgTypeUrl:        msgTypeURL,
+		PreviousTssPubkey: previousTss.TssPubkey,
+		NewTssPubkey:      newTss.TssPubkey,
+		ReleasedCctxCount: strconv.Itoa(releasedCctxCount),
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventTssMigrationCompleted :", err)
+	}
+}
+
+// getChainName returns the name of a chain in the chain registry, the chain ID is returned if the chain is not registered
+func (k Keeper) getChainName(ctx sdk.Context, chainID int64) string {
+	chainInfo, found := k.zetaObserverKeeper.GetChainInfo(ctx, chainID)
+	if !found {
+		return strconv.FormatInt(chainID, 10)
+	}
+	return chainInfo.Chain.ChainName.String()
+}
@@ -51,7 +51,11 @@ func (k Keeper) HandleEVMDeposit(
 			to = parsedAddress
 		}
 
-		from, err := senderChain.DecodeAddress(msg.Sender)
+		senderChainInfo, found := k.zetaObserverKeeper.GetChainInfo(ctx, senderChain.ChainId)
+		if !found {
+			return false, fmt.Errorf("HandleEVMDeposit: chain %d not found in the chain registry", senderChain.ChainId)
+		}
+		from, err := senderChainInfo.DecodeAddress(msg.Sender)
 		if err != nil {
 			return false, fmt.Errorf("HandleEVMDeposit: unable to decode address: %s", err.Error())
 		}
@@ -9,7 +9,6 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
-	"github.com/btcsuite/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -21,6 +20,7 @@ import (
 	zrc20 "github.com/zeta-chain/protocol-contracts/pkg/contracts/zevm/zrc20.sol"
 	"github.com/zeta-chain/node/cmd/zetacored/config"
 	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/common/bitcoin"
 
 	"github.com/zeta-chain/node/x/crosschain/types"
 	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
@@ -122,10 +122,17 @@ func (k Keeper) ProcessZRC20WithdrawalEvent(ctx sdk.Context, event *zrc20.ZRC20W
 	if !found {
 		return fmt.Errorf("cannot find foreign coin with emittingContract address %s", event.Raw.Address.Hex())
 	}
+	if !k.zetaObserverKeeper.IsChainOutboundEnabled(ctx, foreignCoin.ForeignChainId) {
+		return errorsmod.Wrapf(types.ErrNotEnoughPermissions, "outbounds of chain %d are paused", foreignCoin.ForeignChainId)
+	}
 
 	receiverChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(foreignCoin.ForeignChainId)
+	receiverChainInfo, found := k.zetaObserverKeeper.GetChainInfo(ctx, foreignCoin.ForeignChainId)
+	if !found {
+		return fmt.Errorf("chain %d not found in the chain registry", foreignCoin.ForeignChainId)
+	}
 	senderChain := common.ZetaChain()
-	toAddr, err := receiverChain.EncodeAddress(event.To)
+	toAddr, err := receiverChainInfo.EncodeAddress(event.To)
 	if err != nil {
 		return fmt.Errorf("cannot encode address %s: %s", event.To, err.Error())
 	}
@@ -156,15 +163,25 @@ func (k Keeper) ProcessZRC20WithdrawalEvent(ctx sdk.Context, event *zrc20.ZRC20W
 	cctx := k.CreateNewCCTX(ctx, msg, sendHash, tss.TssPubkey, types.CctxStatus_PendingOutbound, &senderChain, receiverChain)
 
 	// Get gas price and amount
-	gasprice, found := k.GetGasPrice(ctx, receiverChain.ChainId)
+	gasprice, found := k.GetMedianGasPriceInUint(ctx, receiverChain.ChainId)
 	if !found {
 		fmt.Printf("gasprice not found for %s\n", receiverChain)
 		return fmt.Errorf("gasprice not found for %s", receiverChain)
 	}
-	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = fmt.Sprintf("%d", gasprice.Prices[gasprice.MedianIndex])
+	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = gasprice.String()
+	cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = k.GetOutboundPriorityFee(
+		ctx,
+		receiverChain.ChainId,
+		gasprice,
+	).String()
 	cctx.GetCurrentOutTxParam().Amount = cctx.InboundTxParams.Amount
 
 	EmitZRCWithdrawCreated(ctx, cctx)
+
+	// the CCTX is held for review if it exceeds a rate limit of the receiver chain
+	if exceeded, reason := k.CheckWithdrawalRateLimits(ctx, foreignCoin, cctx.InboundTxParams.Amount); exceeded {
+		ChangeCctxStatus(ctx, &cctx, types.CctxStatus_PendingReview, reason, types.StatusTrigger{})
+	}
 	return k.ProcessCCTX(ctx, cctx, receiverChain)
 }
 
@@ -179,6 +196,10 @@ func (k Keeper) ProcessZetaSentEvent(ctx sdk.Context, event *connectorzevm.ZetaC
 		event.DestinationChainId,
 	))
 
+	if !k.zetaObserverKeeper.IsChainOutboundEnabled(ctx, event.DestinationChainId.Int64()) {
+		return errorsmod.Wrapf(types.ErrNotEnoughPermissions, "outbounds of chain %d are paused", event.DestinationChainId.Int64())
+	}
+
 	tss, found := k.GetTSS(ctx)
 	if !found {
 		return errorsmod.Wrap(types.ErrCannotFindTSSKeys, "ProcessZetaSentEvent: cannot be processed without TSS keys")
@@ -249,8 +270,16 @@ func (k Keeper) ProcessCCTX(ctx sdk.Context, cctx types.CrossChainTx, receiverCh
 		cctx.InboundTxParams.InboundTxObservedHash = inCctxIndex
 	}
 
-	if err := k.UpdateNonce(ctx, receiverChain.ChainId, &cctx); err != nil {
-		return fmt.Errorf("ProcessWithdrawalEvent: update nonce failed: %s", err.Error())
+	// the CCTX is held for review until the migration of the TSS funds is completed
+	if cctx.CctxStatus.Status != types.CctxStatus_PendingReview {
+		k.HoldCctxForTssMigration(ctx, &cctx, types.StatusTrigger{})
+	}
+
+	// the nonce of a CCTX held for review is assigned when it is released
+	if cctx.CctxStatus.Status != types.CctxStatus_PendingReview {
+		if err := k.UpdateNonce(ctx, receiverChain.ChainId, &cctx); err != nil {
+			return fmt.Errorf("ProcessWithdrawalEvent: update nonce failed: %s", err.Error())
+		}
 	}
 
 	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
@@ -275,23 +304,18 @@ func (k Keeper) ParseZRC20WithdrawalEvent(ctx sdk.Context, log ethtypes.Log) (*z
 	if !found {
 		return nil, fmt.Errorf("ParseZRC20WithdrawalEvent: cannot find foreign coin with contract address %s", event.Raw.Address.Hex())
 	}
-	chainID := coin.ForeignChainId
-	if common.IsBitcoinChain(chainID) {
+	chainInfo, found := k.zetaObserverKeeper.GetChainInfo(ctx, coin.ForeignChainId)
+	if found && chainInfo.IsBitcoinChain() {
 		if event.Value.Cmp(big.NewInt(0)) <= 0 {
 			return nil, fmt.Errorf("ParseZRC20WithdrawalEvent: invalid amount %s", event.Value.String())
 		}
-		btcChainParams, err := common.GetBTCChainParams(chainID)
+		btcChainParams, err := chainInfo.BTCChainParams()
 		if err != nil {
 			return nil, err
 		}
-		addr, err := btcutil.DecodeAddress(string(event.To), btcChainParams)
-		if err != nil {
+		if _, err := bitcoin.DecodeReceiverAddress(string(event.To), btcChainParams); err != nil {
 			return nil, fmt.Errorf("ParseZRC20WithdrawalEvent: invalid address %s: %s", event.To, err)
 		}
-		_, ok := addr.(*btcutil.AddressWitnessPubKeyHash)
-		if !ok {
-			return nil, fmt.Errorf("ParseZRC20WithdrawalEvent: invalid address %s (not P2WPKH address)", event.To)
-		}
 	}
 	return event, nil
 }
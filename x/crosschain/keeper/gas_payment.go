@@ -80,6 +80,19 @@ func (k Keeper) ChainGasParams(
 	return
 }
 
+// GetOutboundPriorityFee returns the priority fee to use for an outbound tx on the chain
+// the priority fee is capped by the gas price that is used as the max fee per gas of the tx
+func (k Keeper) GetOutboundPriorityFee(ctx sdk.Context, chainID int64, gasPrice math.Uint) math.Uint {
+	priorityFee, isFound := k.GetMedianPriorityFeeInUint(ctx, chainID)
+	if !isFound {
+		return math.ZeroUint()
+	}
+	if priorityFee.GT(gasPrice) {
+		return gasPrice
+	}
+	return priorityFee
+}
+
 // PayGasNativeAndUpdateCctx updates the outbound tx with the new amount subtracting the gas fee
 // **Caller should feed temporary ctx into this function**
 func (k Keeper) PayGasNativeAndUpdateCctx(
@@ -101,6 +114,7 @@ func (k Keeper) PayGasNativeAndUpdateCctx(
 	if err != nil {
 		return cosmoserrors.Wrap(types.ErrCannotFindGasParams, err.Error())
 	}
+	gasPrice = k.GetParams(ctx).OutboundGasPrice(gasPrice, cctx.InboundTxParams.CoinType)
 
 	// calculate the final gas fee
 	outTxGasFee := gasLimit.Mul(gasPrice).Add(protocolFlatFee)
@@ -120,6 +134,7 @@ func (k Keeper) PayGasNativeAndUpdateCctx(
 	cctx.GetCurrentOutTxParam().Amount = newAmount
 	cctx.GetCurrentOutTxParam().OutboundTxGasLimit = gasLimit.Uint64()
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = gasPrice.String()
+	cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = k.GetOutboundPriorityFee(ctx, chainID, gasPrice).String()
 
 	return nil
 }
@@ -148,6 +163,7 @@ func (k Keeper) PayGasInERC20AndUpdateCctx(
 	if err != nil {
 		return cosmoserrors.Wrap(types.ErrCannotFindGasParams, err.Error())
 	}
+	gasPrice = k.GetParams(ctx).OutboundGasPrice(gasPrice, cctx.InboundTxParams.CoinType)
 	outTxGasFee := gasLimit.Mul(gasPrice).Add(protocolFlatFee)
 
 	// get address of the zrc20
@@ -243,6 +259,7 @@ func (k Keeper) PayGasInERC20AndUpdateCctx(
 	cctx.GetCurrentOutTxParam().Amount = newAmount
 	cctx.GetCurrentOutTxParam().OutboundTxGasLimit = gasLimit.Uint64()
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = gasPrice.String()
+	cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = k.GetOutboundPriorityFee(ctx, chainID, gasPrice).String()
 
 	return nil
 }
@@ -280,7 +297,8 @@ func (k Keeper) PayGasInZetaAndUpdateCctx(
 			cctx.LogIdentifierForCCTX()),
 		)
 	}
-	gasPrice = gasPrice.MulUint64(2) // overpays gas price by 2x
+	params := k.GetParams(ctx)
+	gasPrice = params.OutboundGasPrice(gasPrice, cctx.InboundTxParams.CoinType)
 
 	// get the gas fee in gas token
 	gasLimit := sdk.NewUint(cctx.GetCurrentOutTxParam().OutboundTxGasLimit)
@@ -291,7 +309,7 @@ func (k Keeper) PayGasInZetaAndUpdateCctx(
 	if err != nil {
 		return cosmoserrors.Wrap(err, "PayGasInZetaAndUpdateCctx: unable to QueryUniswapv2RouterGetAmountsIn")
 	}
-	feeInZeta := types.GetProtocolFee().Add(math.NewUintFromBigInt(outTxGasFeeInZeta))
+	feeInZeta := params.ProtocolFee.Add(math.NewUintFromBigInt(outTxGasFeeInZeta))
 
 	// reduce the amount of the outbound tx
 	if feeInZeta.GT(zetaBurnt) {
@@ -341,6 +359,7 @@ func (k Keeper) PayGasInZetaAndUpdateCctx(
 
 	// Update the cctx
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = gasPrice.String()
+	cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = k.GetOutboundPriorityFee(ctx, chainID, gasPrice).String()
 	cctx.GetCurrentOutTxParam().Amount = newAmount
 	if cctx.ZetaFees.IsNil() {
 		cctx.ZetaFees = feeInZeta
@@ -266,6 +266,48 @@ func TestKeeper_PayGasNativeAndUpdateCctx(t *testing.T) {
 		require.Equal(t, "2", cctx.GetCurrentOutTxParam().OutboundTxGasPrice)
 	})
 
+	t.Run("applies the gas price multiplier of the params", func(t *testing.T) {
+		k, ctx, sdkk, zk := testkeeper.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		params := k.GetParams(ctx)
+		params.GasPriceMultiplier = "1.5"
+		k.SetParams(ctx, params)
+
+		// deploy gas coin and set fee params
+		chainID := getValidEthChainID(t)
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+		_, err := zk.FungibleKeeper.UpdateZRC20WithdrawFee(
+			sdk.UnwrapSDKContext(ctx),
+			fungibletypes.NewMsgUpdateZRC20WithdrawFee(admin, zrc20.String(), sdk.NewUint(withdrawFee), math.Uint{}),
+		)
+		require.NoError(t, err)
+		k.SetGasPrice(ctx, types.GasPrice{
+			ChainId:     chainID,
+			MedianIndex: 0,
+			Prices:      []uint64{gasPrice},
+		})
+
+		cctx := types.CrossChainTx{
+			InboundTxParams: &types.InboundTxParams{
+				CoinType: zetacommon.CoinType_Gas,
+			},
+			OutboundTxParams: []*types.OutboundTxParams{
+				{
+					ReceiverChainId: chainID,
+				},
+			},
+		}
+
+		// total fees must be 21000*3+1000=64000
+		err = k.PayGasNativeAndUpdateCctx(ctx, chainID, &cctx, math.NewUint(inputAmount))
+		require.NoError(t, err)
+		require.Equal(t, uint64(36000), cctx.GetCurrentOutTxParam().Amount.Uint64())
+		require.Equal(t, "3", cctx.GetCurrentOutTxParam().OutboundTxGasPrice)
+	})
+
 	t.Run("should fail if not coin type gas", func(t *testing.T) {
 		k, ctx, _, _ := testkeeper.CrosschainKeeper(t)
 		chainID := getValidEthChainID(t)
@@ -687,7 +729,7 @@ func TestKeeper_PayGasInZetaAndUpdateCctx(t *testing.T) {
 		require.NoError(t, err)
 
 		// the output amount must be input amount - (out tx fee in zeta + protocol flat fee)
-		expectedFeeInZeta := types.GetProtocolFee().Add(math.NewUintFromBigInt(expectedOutTxGasFeeInZeta))
+		expectedFeeInZeta := k.GetParams(ctx).ProtocolFee.Add(math.NewUintFromBigInt(expectedOutTxGasFeeInZeta))
 		inputAmount := expectedFeeInZeta.Add(math.NewUint(100000))
 		err = k.PayGasInZetaAndUpdateCctx(ctx, chainID, &cctx, inputAmount, false)
 		require.NoError(t, err)
@@ -709,7 +751,7 @@ func TestKeeper_PayGasInZetaAndUpdateCctx(t *testing.T) {
 		}
 		expectedOutTxGasFeeInZeta, err = zk.FungibleKeeper.QueryUniswapV2RouterGetZetaAmountsIn(ctx, big.NewInt(4000), zrc20)
 		require.NoError(t, err)
-		expectedFeeInZeta = types.GetProtocolFee().Add(math.NewUintFromBigInt(expectedOutTxGasFeeInZeta))
+		expectedFeeInZeta = k.GetParams(ctx).ProtocolFee.Add(math.NewUintFromBigInt(expectedOutTxGasFeeInZeta))
 		inputAmount = expectedFeeInZeta.Add(math.NewUint(100000))
 		err = k.PayGasInZetaAndUpdateCctx(ctx, chainID, &cctx, inputAmount, false)
 		require.NoError(t, err)
@@ -802,7 +844,7 @@ func TestKeeper_PayGasInZetaAndUpdateCctx(t *testing.T) {
 		}
 		expectedOutTxGasFeeInZeta, err := zk.FungibleKeeper.QueryUniswapV2RouterGetZetaAmountsIn(ctx, big.NewInt(4000), zrc20)
 		require.NoError(t, err)
-		expectedFeeInZeta := types.GetProtocolFee().Add(math.NewUintFromBigInt(expectedOutTxGasFeeInZeta))
+		expectedFeeInZeta := k.GetParams(ctx).ProtocolFee.Add(math.NewUintFromBigInt(expectedOutTxGasFeeInZeta))
 
 		// set input amount lower than total zeta fee
 		inputAmount := expectedFeeInZeta.Sub(math.NewUint(1))
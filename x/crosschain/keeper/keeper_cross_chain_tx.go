@@ -17,11 +17,19 @@ import (
 // SetCctxAndNonceToCctxAndInTxHashToCctx set a specific send in the store from its index
 func (k Keeper) SetCctxAndNonceToCctxAndInTxHashToCctx(ctx sdk.Context, send types.CrossChainTx) {
 
+	previous, previousFound := k.GetCrossChainTx(ctx, send.Index)
 	p := types.KeyPrefix(fmt.Sprintf("%s", types.SendKey))
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), p)
 	b := k.cdc.MustMarshal(&send)
 	store.Set(types.KeyPrefix(send.Index), b)
 
+	// set the secondary indexes of the cctx
+	if previousFound {
+		k.updateCctxIndexes(ctx, &previous, send)
+	} else {
+		k.updateCctxIndexes(ctx, nil, send)
+	}
+
 	// set mapping inTxHash -> cctxIndex
 	in, _ := k.GetInTxHashToCctx(ctx, send.InboundTxParams.InboundTxObservedHash)
 	in.InTxHash = send.InboundTxParams.InboundTxObservedHash
@@ -37,6 +45,14 @@ func (k Keeper) SetCctxAndNonceToCctxAndInTxHashToCctx(ctx sdk.Context, send typ
 	}
 	k.SetInTxHashToCctx(ctx, in)
 
+	// set mapping of the cctxs pending review
+	pendingReviewStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingReviewCctxKeyPrefix))
+	if send.GetCctxStatus().GetStatus() == types.CctxStatus_PendingReview {
+		pendingReviewStore.Set(types.KeyPrefix(send.Index), []byte(send.Index))
+	} else {
+		pendingReviewStore.Delete(types.KeyPrefix(send.Index))
+	}
+
 	tss, found := k.GetTSS(ctx)
 	if !found {
 		return
@@ -141,6 +157,20 @@ func (k Keeper) Cctx(c context.Context, req *types.QueryGetCctxRequest) (*types.
 	return &types.QueryGetCctxResponse{CrossChainTx: &val}, nil
 }
 
+func (k Keeper) CctxStatusTransitions(c context.Context, req *types.QueryCctxStatusTransitionsRequest) (*types.QueryCctxStatusTransitionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetCrossChainTx(ctx, req.Index)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "not found")
+	}
+
+	return &types.QueryCctxStatusTransitionsResponse{Transitions: val.CctxStatus.Transitions}, nil
+}
+
 func (k Keeper) CctxByNonce(c context.Context, req *types.QueryGetCctxByNonceRequest) (*types.QueryGetCctxResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -215,6 +245,37 @@ func (k Keeper) CctxAllPending(c context.Context, req *types.QueryAllCctxPending
 	return &types.QueryAllCctxPendingResponse{CrossChainTx: sends}, nil
 }
 
+// GetAllCctxPendingReview returns the cctxs held for review because they exceeded a rate limit
+func (k Keeper) GetAllCctxPendingReview(ctx sdk.Context) (list []types.CrossChainTx) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingReviewCctxKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		cctx, found := k.GetCrossChainTx(ctx, string(iterator.Value()))
+		if found {
+			list = append(list, cctx)
+		}
+	}
+
+	return
+}
+
+func (k Keeper) CctxAllPendingReview(c context.Context, req *types.QueryAllCctxPendingReviewRequest) (*types.QueryAllCctxPendingReviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sends := make([]*types.CrossChainTx, 0)
+	for _, cctx := range k.GetAllCctxPendingReview(ctx) {
+		cctx := cctx
+		sends = append(sends, &cctx)
+	}
+
+	return &types.QueryAllCctxPendingReviewResponse{CrossChainTx: sends}, nil
+}
+
 func (k Keeper) CreateNewCCTX(ctx sdk.Context, msg *types.MsgVoteOnObservedInboundTx, index string, tssPubkey string, s types.CctxStatus, senderChain, receiverChain *common.Chain) types.CrossChainTx {
 	if msg.TxOrigin == "" {
 		msg.TxOrigin = msg.Sender
@@ -228,7 +289,7 @@ func (k Keeper) CreateNewCCTX(ctx sdk.Context, msg *types.MsgVoteOnObservedInbou
 		CoinType:                        msg.CoinType,
 		InboundTxObservedHash:           msg.InTxHash,
 		InboundTxObservedExternalHeight: msg.InBlockHeight,
-		InboundTxFinalizedZetaHeight:    0,
+		InboundTxFinalizedZetaHeight:    uint64(ctx.BlockHeight()), // #nosec G701 always positive
 		InboundTxBallotIndex:            index,
 	}
 
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// cctxIndexKeys returns the keys of a cctx in the secondary indexes of the cctxs
+// a cctx is indexed by the sender and the origin of its inbound, the receiver of its first outbound, its status,
+// its sender chain and the ZetaChain height at which it has been created
+func cctxIndexKeys(cctx types.CrossChainTx) [][]byte {
+	var keys [][]byte
+	addKey := func(indexPrefix string, key []byte) {
+		keys = append(keys, append(types.KeyPrefix(indexPrefix), key...))
+	}
+
+	if inbound := cctx.InboundTxParams; inbound != nil {
+		sender := types.CctxIndexAddress(inbound.Sender)
+		if sender != "" {
+			addKey(types.CctxBySenderKeyPrefix, types.CctxIndexKey(sender, cctx.Index))
+		}
+		if origin := types.CctxIndexAddress(inbound.TxOrigin); origin != "" && origin != sender {
+			addKey(types.CctxBySenderKeyPrefix, types.CctxIndexKey(origin, cctx.Index))
+		}
+		addKey(types.CctxBySenderChainKeyPrefix, types.CctxIndexKey(fmt.Sprintf("%d", inbound.SenderChainId), cctx.Index))
+		addKey(types.CctxByHeightKeyPrefix, types.CctxHeightIndexKey(inbound.InboundTxFinalizedZetaHeight, cctx.Index))
+	}
+	if len(cctx.OutboundTxParams) > 0 && cctx.OutboundTxParams[0] != nil {
+		if receiver := types.CctxIndexAddress(cctx.OutboundTxParams[0].Receiver); receiver != "" {
+			addKey(types.CctxByReceiverKeyPrefix, types.CctxIndexKey(receiver, cctx.Index))
+		}
+	}
+	if cctx.CctxStatus != nil {
+		addKey(types.CctxByStatusKeyPrefix, types.CctxIndexKey(cctx.CctxStatus.Status.String(), cctx.Index))
+	}
+	return keys
+}
+
+// updateCctxIndexes sets the keys of a cctx in the secondary indexes
+// only the keys that differ from the previous value of the cctx are written to limit the gas consumed by updates
+func (k Keeper) updateCctxIndexes(ctx sdk.Context, previous *types.CrossChainTx, cctx types.CrossChainTx) {
+	store := ctx.KVStore(k.storeKey)
+	keys := cctxIndexKeys(cctx)
+
+	existing := make(map[string]bool)
+	if previous != nil {
+		current := make(map[string]bool, len(keys))
+		for _, key := range keys {
+			current[string(key)] = true
+		}
+		for _, key := range cctxIndexKeys(*previous) {
+			if current[string(key)] {
+				existing[string(key)] = true
+			} else {
+				store.Delete(key)
+			}
+		}
+	}
+	for _, key := range keys {
+		if !existing[string(key)] {
+			store.Set(key, []byte(cctx.Index))
+		}
+	}
+}
+
+// IndexCrossChainTx sets the keys of a stored cctx in the secondary indexes
+// it is used to index the cctxs stored before the secondary indexes were introduced
+func (k Keeper) IndexCrossChainTx(ctx sdk.Context, cctx types.CrossChainTx) {
+	k.updateCctxIndexes(ctx, nil, cctx)
+}
+
+// paginateCctxIndex returns a page of the cctxs with the value in a secondary index
+func (k Keeper) paginateCctxIndex(ctx sdk.Context, indexPrefix string, value string, pageReq *query.PageRequest) (*types.QueryAllCctxResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(indexPrefix), types.CctxIndexPrefix(value)...))
+
+	var sends []*types.CrossChainTx
+	pageRes, err := query.Paginate(store, pageReq, func(_ []byte, value []byte) error {
+		send, found := k.GetCrossChainTx(ctx, string(value))
+		if !found {
+			return fmt.Errorf("cctx %s not found", string(value))
+		}
+		sends = append(sends, &send)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllCctxResponse{CrossChainTx: sends, Pagination: pageRes}, nil
+}
+
+// Queries
+
+func (k Keeper) CctxAllBySender(c context.Context, req *types.QueryAllCctxBySenderRequest) (*types.QueryAllCctxResponse, error) {
+	if req == nil || req.Sender == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return k.paginateCctxIndex(ctx, types.CctxBySenderKeyPrefix, types.CctxIndexAddress(req.Sender), req.Pagination)
+}
+
+func (k Keeper) CctxAllByReceiver(c context.Context, req *types.QueryAllCctxByReceiverRequest) (*types.QueryAllCctxResponse, error) {
+	if req == nil || req.Receiver == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return k.paginateCctxIndex(ctx, types.CctxByReceiverKeyPrefix, types.CctxIndexAddress(req.Receiver), req.Pagination)
+}
+
+func (k Keeper) CctxAllByStatus(c context.Context, req *types.QueryAllCctxByStatusRequest) (*types.QueryAllCctxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, ok := types.CctxStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid status %d", req.Status))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return k.paginateCctxIndex(ctx, types.CctxByStatusKeyPrefix, req.Status.String(), req.Pagination)
+}
+
+func (k Keeper) CctxAllBySenderChain(c context.Context, req *types.QueryAllCctxBySenderChainRequest) (*types.QueryAllCctxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return k.paginateCctxIndex(ctx, types.CctxBySenderChainKeyPrefix, fmt.Sprintf("%d", req.SenderChainId), req.Pagination)
+}
+
+// CctxAllByHeight returns the cctxs created on ZetaChain between the start and the end height included
+// the cctxs are ordered by height, the next key of the page can be used as key of the following page
+func (k Keeper) CctxAllByHeight(c context.Context, req *types.QueryAllCctxByHeightRequest) (*types.QueryAllCctxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.EndHeight < req.StartHeight {
+		return nil, status.Error(codes.InvalidArgument, "end height must be greater than or equal to start height")
+	}
+	if req.Pagination.GetOffset() > 0 || req.Pagination.GetReverse() {
+		return nil, status.Error(codes.InvalidArgument, "only the key and the limit of the pagination are supported")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxByHeightKeyPrefix))
+
+	start := sdk.Uint64ToBigEndian(req.StartHeight)
+	if key := req.Pagination.GetKey(); len(key) > 0 {
+		if bytes.Compare(key, start) < 0 {
+			return nil, status.Error(codes.InvalidArgument, "pagination key is lower than start height")
+		}
+		start = key
+	}
+	var end []byte
+	if req.EndHeight < math.MaxUint64 {
+		end = sdk.Uint64ToBigEndian(req.EndHeight + 1)
+	}
+	limit := req.Pagination.GetLimit()
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	sends := make([]*types.CrossChainTx, 0)
+	pageRes := &query.PageResponse{}
+	for ; iterator.Valid(); iterator.Next() {
+		if uint64(len(sends)) == limit {
+			pageRes.NextKey = iterator.Key()
+			break
+		}
+		send, found := k.GetCrossChainTx(ctx, string(iterator.Value()))
+		if !found {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("cctx %s not found", string(iterator.Value())))
+		}
+		sends = append(sends, &send)
+	}
+
+	return &types.QueryAllCctxResponse{CrossChainTx: sends, Pagination: pageRes}, nil
+}
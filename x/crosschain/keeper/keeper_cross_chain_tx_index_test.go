@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// indexedCctx returns a cctx with the fields used by the secondary indexes
+func indexedCctx(index, sender, receiver string, senderChainID int64, height uint64, status types.CctxStatus) types.CrossChainTx {
+	return types.CrossChainTx{
+		Index:      index,
+		CctxStatus: &types.Status{Status: status},
+		InboundTxParams: &types.InboundTxParams{
+			Sender:                       sender,
+			TxOrigin:                     sender,
+			SenderChainId:                senderChainID,
+			InboundTxObservedHash:        index,
+			InboundTxFinalizedZetaHeight: height,
+		},
+		OutboundTxParams: []*types.OutboundTxParams{{Receiver: receiver}},
+	}
+}
+
+// cctxIndexes returns the indexes of the cctxs
+func cctxIndexes(res *types.QueryAllCctxResponse) []string {
+	indexes := make([]string, len(res.CrossChainTx))
+	for i, cctx := range res.CrossChainTx {
+		indexes[i] = cctx.Index
+	}
+	return indexes
+}
+
+func TestKeeper_CctxIndexes(t *testing.T) {
+	t.Run("can query the cctxs by sender, receiver, status and sender chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		alice, bob := sample.EthAddress().Hex(), sample.EthAddress().Hex()
+
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, indexedCctx("0", alice, bob, 1, 10, types.CctxStatus_PendingOutbound))
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, indexedCctx("1", alice, alice, 2, 11, types.CctxStatus_OutboundMined))
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, indexedCctx("2", bob, alice, 1, 12, types.CctxStatus_PendingOutbound))
+
+		// hex addresses are case-insensitive
+		res, err := k.CctxAllBySender(wctx, &types.QueryAllCctxBySenderRequest{Sender: strings.ToLower(alice)})
+		require.NoError(t, err)
+		require.Equal(t, []string{"0", "1"}, cctxIndexes(res))
+		res, err = k.CctxAllByReceiver(wctx, &types.QueryAllCctxByReceiverRequest{Receiver: "0x" + strings.ToUpper(alice[2:])})
+		require.NoError(t, err)
+		require.Equal(t, []string{"1", "2"}, cctxIndexes(res))
+		res, err = k.CctxAllByStatus(wctx, &types.QueryAllCctxByStatusRequest{Status: types.CctxStatus_PendingOutbound})
+		require.NoError(t, err)
+		require.Equal(t, []string{"0", "2"}, cctxIndexes(res))
+		res, err = k.CctxAllBySenderChain(wctx, &types.QueryAllCctxBySenderChainRequest{SenderChainId: 1})
+		require.NoError(t, err)
+		require.Equal(t, []string{"0", "2"}, cctxIndexes(res))
+
+		// the queries are paginated
+		res, err = k.CctxAllBySender(wctx, &types.QueryAllCctxBySenderRequest{
+			Sender:     alice,
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"0"}, cctxIndexes(res))
+		require.Equal(t, uint64(2), res.Pagination.Total)
+		res, err = k.CctxAllBySender(wctx, &types.QueryAllCctxBySenderRequest{
+			Sender:     alice,
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"1"}, cctxIndexes(res))
+	})
+
+	t.Run("updates the indexes when the cctx changes", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		cctx := indexedCctx("0", sample.EthAddress().Hex(), sample.EthAddress().Hex(), 1, 10, types.CctxStatus_PendingOutbound)
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+
+		cctx.CctxStatus.Status = types.CctxStatus_OutboundMined
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+
+		res, err := k.CctxAllByStatus(wctx, &types.QueryAllCctxByStatusRequest{Status: types.CctxStatus_PendingOutbound})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+		res, err = k.CctxAllByStatus(wctx, &types.QueryAllCctxByStatusRequest{Status: types.CctxStatus_OutboundMined})
+		require.NoError(t, err)
+		require.Equal(t, []string{"0"}, cctxIndexes(res))
+	})
+
+	t.Run("indexes the origin of an inbound", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		cctx := indexedCctx("0", sample.EthAddress().Hex(), sample.EthAddress().Hex(), 1, 10, types.CctxStatus_PendingOutbound)
+		origin := sample.EthAddress().Hex()
+		cctx.InboundTxParams.TxOrigin = origin
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+
+		res, err := k.CctxAllBySender(wctx, &types.QueryAllCctxBySenderRequest{Sender: origin})
+		require.NoError(t, err)
+		require.Equal(t, []string{"0"}, cctxIndexes(res))
+		res, err = k.CctxAllBySender(wctx, &types.QueryAllCctxBySenderRequest{Sender: cctx.InboundTxParams.Sender})
+		require.NoError(t, err)
+		require.Equal(t, []string{"0"}, cctxIndexes(res))
+	})
+
+	t.Run("can query the cctxs by height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		for i, height := range []uint64{5, 10, 10, 11, 300} {
+			index := string(rune('a' + i))
+			k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, indexedCctx(index, sample.EthAddress().Hex(), "", 1, height, types.CctxStatus_OutboundMined))
+		}
+
+		res, err := k.CctxAllByHeight(wctx, &types.QueryAllCctxByHeightRequest{StartHeight: 10, EndHeight: 11})
+		require.NoError(t, err)
+		require.Equal(t, []string{"b", "c", "d"}, cctxIndexes(res))
+		require.Empty(t, res.Pagination.NextKey)
+
+		res, err = k.CctxAllByHeight(wctx, &types.QueryAllCctxByHeightRequest{
+			StartHeight: 10,
+			EndHeight:   1000,
+			Pagination:  &query.PageRequest{Limit: 2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"b", "c"}, cctxIndexes(res))
+		res, err = k.CctxAllByHeight(wctx, &types.QueryAllCctxByHeightRequest{
+			StartHeight: 10,
+			EndHeight:   1000,
+			Pagination:  &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"d", "e"}, cctxIndexes(res))
+		require.Empty(t, res.Pagination.NextKey)
+
+		_, err = k.CctxAllByHeight(wctx, &types.QueryAllCctxByHeightRequest{StartHeight: 11, EndHeight: 10})
+		require.Error(t, err)
+		_, err = k.CctxAllByHeight(wctx, &types.QueryAllCctxByHeightRequest{
+			StartHeight: 10,
+			EndHeight:   11,
+			Pagination:  &query.PageRequest{Offset: 1},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("should fail for invalid requests", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		_, err := k.CctxAllBySender(wctx, nil)
+		require.Error(t, err)
+		_, err = k.CctxAllBySender(wctx, &types.QueryAllCctxBySenderRequest{})
+		require.Error(t, err)
+		_, err = k.CctxAllByReceiver(wctx, nil)
+		require.Error(t, err)
+		_, err = k.CctxAllByStatus(wctx, &types.QueryAllCctxByStatusRequest{Status: types.CctxStatus(100)})
+		require.Error(t, err)
+		_, err = k.CctxAllBySenderChain(wctx, nil)
+		require.Error(t, err)
+		_, err = k.CctxAllByHeight(wctx, nil)
+		require.Error(t, err)
+	})
+}
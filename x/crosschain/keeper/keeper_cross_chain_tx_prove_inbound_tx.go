@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	cosmoserrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	erc20custody "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
+	zetaconnector "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.non-eth.sol"
+
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+const (
+	// ERC20DepositGasLimit is the gas limit used for the inbound of an ERC20 deposit
+	ERC20DepositGasLimit = 1_500_000
+
+	// GasDepositGasLimit is the gas limit used for the inbound of a gas token deposit
+	GasDepositGasLimit = 90_000
+)
+
+// ProveInboundTx creates the CCTXs of an inbound transaction from a proof of inclusion of the transaction and of its
+// receipt in a block header stored on ZetaChain. Unlike `VoteOnObservedInboundTx`, the inbound doesn't rely on the
+// votes of the observers, the inbound is decoded from the transaction and its receipt. Therefore, any account is
+// authorized to broadcast this message.
+//
+// The block header must be in the canonical chain and have at least the number of confirmations defined in the core
+// params of the chain. The inbounds are decoded the same way the observers do:
+// - `ZetaSent` events emitted by the connector contract
+// - `Deposited` events emitted by the ERC20 custody contract
+// - a transfer of gas tokens to the TSS address
+//
+// The CCTXs of the decoded inbounds are processed as the inbounds finalized by the observers. Inbounds that have already
+// been finalized are skipped, an error is returned if the transaction contains no new inbound.
+func (k msgServer) ProveInboundTx(goCtx context.Context, msg *types.MsgProveInboundTx) (*types.MsgProveInboundTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.zetaObserverKeeper.IsInboundEnabled(ctx) {
+		return nil, types.ErrNotEnoughPermissions
+	}
+	observationChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(msg.ChainId)
+	if observationChain == nil || !k.zetaObserverKeeper.IsEVMChain(ctx, msg.ChainId) {
+		return nil, cosmoserrors.Wrap(types.ErrUnsupportedChain, fmt.Sprintf("ChainID %d", msg.ChainId))
+	}
+	if !k.zetaObserverKeeper.IsChainInboundEnabled(ctx, msg.ChainId) {
+		return nil, cosmoserrors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("inbounds of chain %d are paused", msg.ChainId))
+	}
+	coreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, msg.ChainId)
+	if !found {
+		return nil, types.ErrNotFoundCoreParams
+	}
+
+	// verify the transaction and its receipt
+	header, txBytes, err := k.VerifyProvenTx(ctx, msg.ChainId, msg.BlockHash, msg.TxIndex, msg.Proof)
+	if err != nil {
+		return nil, err
+	}
+	receiptBytes, err := msg.ReceiptProof.VerifyReceipt(header.Header, int(msg.TxIndex))
+	if err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("receipt proof: %s", err))
+	}
+	var tx ethtypes.Transaction
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("failed to unmarshal evm transaction: %s", err))
+	}
+	if tx.Hash().Hex() != msg.TxHash {
+		return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("tx hash mismatch: %s != %s", tx.Hash().Hex(), msg.TxHash))
+	}
+	var receipt ethtypes.Receipt
+	if err := receipt.UnmarshalBinary(receiptBytes); err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("failed to unmarshal evm receipt: %s", err))
+	}
+
+	tss, found := k.GetTSS(ctx)
+	if !found {
+		return nil, types.ErrCannotFindTSSKeys
+	}
+	tssAddress, err := k.GetTssAddress(ctx, &types.QueryGetTssAddressRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec G701 always positive
+	inbounds, err := ParseEVMInbounds(msg.Creator, msg.ChainId, uint64(header.Height), tx, receipt, *coreParams, tssAddress.Eth)
+	if err != nil {
+		return nil, err
+	}
+
+	processed := 0
+	for _, inbound := range inbounds {
+		// the observers skip the events sent to a chain that is not in the chain registry
+		if _, found := k.zetaObserverKeeper.GetChainInfo(ctx, inbound.ReceiverChain); !found {
+			continue
+		}
+		receiverChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(inbound.ReceiverChain)
+		if receiverChain == nil {
+			return nil, cosmoserrors.Wrap(types.ErrUnsupportedChain, fmt.Sprintf("ChainID %d", inbound.ReceiverChain))
+		}
+
+		// sending ZETA to the ZETA token contract is rejected by the observers, such inbound is skipped
+		if receiverChain.IsExternalChain() && inbound.CoinType == common.CoinType_Zeta {
+			receiverCoreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, receiverChain.ChainId)
+			if found && strings.EqualFold(inbound.Receiver, receiverCoreParams.ZetaTokenContractAddress) {
+				continue
+			}
+		}
+
+		// the inbound might have already been finalized by the observers
+		index := inbound.Digest()
+		if _, found := k.GetCrossChainTx(ctx, index); found {
+			continue
+		}
+		if err := k.ProcessInbound(ctx, inbound, index, tss.TssPubkey, observationChain, receiverChain, types.StatusTrigger{MsgTypeURL: sdk.MsgTypeURL(msg)}); err != nil {
+			return nil, err
+		}
+		processed++
+	}
+	if processed == 0 {
+		return nil, cosmoserrors.Wrap(types.ErrNoInboundEvent, fmt.Sprintf("no new inbound in tx %s", msg.TxHash))
+	}
+
+	return &types.MsgProveInboundTxResponse{}, nil
+}
+
+// ParseEVMInbounds decodes the inbounds of a transaction of an EVM chain from the transaction and its receipt
+// the inbounds are returned as the votes the observers would have broadcasted for the transaction
+// Note: the transaction and the receipt must have been verified against a block header
+func ParseEVMInbounds(
+	creator string,
+	chainID int64,
+	blockHeight uint64,
+	tx ethtypes.Transaction,
+	receipt ethtypes.Receipt,
+	coreParams observertypes.CoreParams,
+	tssEth string,
+) ([]*types.MsgVoteOnObservedInboundTx, error) {
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil, cosmoserrors.Wrap(types.ErrNoInboundEvent, fmt.Sprintf("tx %s failed", tx.Hash().Hex()))
+	}
+	signer := ethtypes.NewLondonSigner(big.NewInt(chainID))
+	txHash := tx.Hash().Hex()
+
+	connectorAddress := ethcommon.HexToAddress(coreParams.ConnectorContractAddress)
+	connector, err := zetaconnector.NewZetaConnectorNonEthFilterer(connectorAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	custodyAddress := ethcommon.HexToAddress(coreParams.Erc20CustodyContractAddress)
+	custody, err := erc20custody.NewERC20CustodyFilterer(custodyAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var inbounds []*types.MsgVoteOnObservedInboundTx
+	for _, log := range receipt.Logs {
+		if log == nil || len(log.Topics) == 0 {
+			continue
+		}
+		switch {
+		case coreParams.ConnectorContractAddress != "" && log.Address == connectorAddress:
+			event, err := connector.ParseZetaSent(*log)
+			if err != nil {
+				// not a ZetaSent event
+				continue
+			}
+			inbounds = append(inbounds, types.NewMsgVoteOnObservedInboundTx(
+				creator,
+				event.ZetaTxSenderAddress.Hex(),
+				chainID,
+				event.SourceTxOriginAddress.Hex(),
+				"0x"+hex.EncodeToString(event.DestinationAddress),
+				event.DestinationChainId.Int64(),
+				math.NewUintFromBigInt(event.ZetaValueAndGas),
+				base64.StdEncoding.EncodeToString(event.Message),
+				txHash,
+				blockHeight,
+				event.DestinationGasLimit.Uint64(),
+				common.CoinType_Zeta,
+				"",
+			))
+		case coreParams.Erc20CustodyContractAddress != "" && log.Address == custodyAddress:
+			event, err := custody.ParseDeposited(*log)
+			if err != nil {
+				// not a Deposited event
+				continue
+			}
+			if bytes.Equal(event.Message, []byte(common.DonationMessage)) {
+				continue
+			}
+			sender, err := signer.Sender(&tx)
+			if err != nil {
+				return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("can't recover the sender of tx %s: %s", txHash, err))
+			}
+			inbounds = append(inbounds, types.NewMsgVoteOnObservedInboundTx(
+				creator,
+				sender.Hex(),
+				chainID,
+				"",
+				"0x"+hex.EncodeToString(event.Recipient),
+				common.ZetaChain().ChainId,
+				math.NewUintFromBigInt(event.Amount),
+				hex.EncodeToString(event.Message),
+				txHash,
+				blockHeight,
+				ERC20DepositGasLimit,
+				common.CoinType_ERC20,
+				event.Asset.String(),
+			))
+		}
+	}
+
+	// gas token deposit
+	if tssEth != "" && tx.To() != nil && *tx.To() == ethcommon.HexToAddress(tssEth) &&
+		!bytes.Equal(tx.Data(), []byte(common.DonationMessage)) {
+		sender, err := signer.Sender(&tx)
+		if err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("can't recover the sender of tx %s: %s", txHash, err))
+		}
+		message := ""
+		if len(tx.Data()) != 0 {
+			message = hex.EncodeToString(tx.Data())
+		}
+		inbounds = append(inbounds, types.NewMsgVoteOnObservedInboundTx(
+			creator,
+			sender.Hex(),
+			chainID,
+			sender.Hex(),
+			sender.Hex(),
+			common.ZetaChain().ChainId,
+			math.NewUintFromBigInt(tx.Value()),
+			message,
+			txHash,
+			blockHeight,
+			GasDepositGasLimit,
+			common.CoinType_Gas,
+			"",
+		))
+	}
+
+	return inbounds, nil
+}
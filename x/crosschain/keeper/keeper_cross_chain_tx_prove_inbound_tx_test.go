@@ -0,0 +1,363 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	erc20custody "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
+	zetaconnector "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.non-eth.sol"
+
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/common/cosmos"
+	"github.com/zeta-chain/node/common/ethereum"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// signedEVMTx returns a signed dynamic fee transaction and its sender
+func signedEVMTx(t *testing.T, chainID int64, to ethcommon.Address, value *big.Int, data []byte) (*ethtypes.Transaction, ethcommon.Address) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   big.NewInt(chainID),
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(100),
+		Gas:       100_000,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	})
+	signedTx, err := ethtypes.SignTx(tx, ethtypes.NewLondonSigner(big.NewInt(chainID)), privKey)
+	require.NoError(t, err)
+	return signedTx, crypto.PubkeyToAddress(privKey.PublicKey)
+}
+
+func zetaSentLog(
+	t *testing.T,
+	connector ethcommon.Address,
+	origin ethcommon.Address,
+	sender ethcommon.Address,
+	destChainID int64,
+	destAddress []byte,
+	amount *big.Int,
+	message []byte,
+) *ethtypes.Log {
+	connectorABI, err := zetaconnector.ZetaConnectorNonEthMetaData.GetAbi()
+	require.NoError(t, err)
+	event := connectorABI.Events["ZetaSent"]
+	data, err := event.Inputs.NonIndexed().Pack(origin, destAddress, amount, big.NewInt(250_000), message, []byte{})
+	require.NoError(t, err)
+	return &ethtypes.Log{
+		Address: connector,
+		Topics:  []ethcommon.Hash{event.ID, ethcommon.BytesToHash(sender.Bytes()), ethcommon.BigToHash(big.NewInt(destChainID))},
+		Data:    data,
+	}
+}
+
+func depositedLog(
+	t *testing.T,
+	custody ethcommon.Address,
+	asset ethcommon.Address,
+	recipient []byte,
+	amount *big.Int,
+	message []byte,
+) *ethtypes.Log {
+	custodyABI, err := erc20custody.ERC20CustodyMetaData.GetAbi()
+	require.NoError(t, err)
+	event := custodyABI.Events["Deposited"]
+	data, err := event.Inputs.NonIndexed().Pack(recipient, amount, message)
+	require.NoError(t, err)
+	return &ethtypes.Log{
+		Address: custody,
+		Topics:  []ethcommon.Hash{event.ID, ethcommon.BytesToHash(asset.Bytes())},
+		Data:    data,
+	}
+}
+
+func successfulReceipt(logs ...*ethtypes.Log) ethtypes.Receipt {
+	return ethtypes.Receipt{
+		Type:   ethtypes.DynamicFeeTxType,
+		Status: ethtypes.ReceiptStatusSuccessful,
+		Logs:   logs,
+	}
+}
+
+func TestParseEVMInbounds(t *testing.T) {
+	chainID := common.GoerliChain().ChainId
+	coreParams := *sample.CoreParams(chainID)
+	connector := ethcommon.HexToAddress(coreParams.ConnectorContractAddress)
+	custody := ethcommon.HexToAddress(coreParams.Erc20CustodyContractAddress)
+	tssAddress := sample.EthAddress()
+	creator := sample.AccAddress()
+
+	t.Run("should parse ZetaSent event", func(t *testing.T) {
+		tx, origin := signedEVMTx(t, chainID, connector, big.NewInt(0), []byte("send"))
+		sender := sample.EthAddress()
+		destAddress := sample.EthAddress().Bytes()
+		receipt := successfulReceipt(zetaSentLog(t, connector, origin, sender, common.ZetaChain().ChainId, destAddress, big.NewInt(42), []byte("hello")))
+
+		inbounds, err := keeper.ParseEVMInbounds(creator, chainID, 10, *tx, receipt, coreParams, tssAddress.Hex())
+		require.NoError(t, err)
+		require.Len(t, inbounds, 1)
+		require.Equal(t, types.NewMsgVoteOnObservedInboundTx(
+			creator,
+			sender.Hex(),
+			chainID,
+			origin.Hex(),
+			"0x"+hex.EncodeToString(destAddress),
+			common.ZetaChain().ChainId,
+			math.NewUint(42),
+			base64.StdEncoding.EncodeToString([]byte("hello")),
+			tx.Hash().Hex(),
+			10,
+			250_000,
+			common.CoinType_Zeta,
+			"",
+		), inbounds[0])
+	})
+
+	t.Run("should parse Deposited event", func(t *testing.T) {
+		tx, sender := signedEVMTx(t, chainID, custody, big.NewInt(0), []byte("deposit"))
+		asset := sample.EthAddress()
+		recipient := sample.EthAddress().Bytes()
+		receipt := successfulReceipt(depositedLog(t, custody, asset, recipient, big.NewInt(42), []byte("hello")))
+
+		inbounds, err := keeper.ParseEVMInbounds(creator, chainID, 10, *tx, receipt, coreParams, tssAddress.Hex())
+		require.NoError(t, err)
+		require.Len(t, inbounds, 1)
+		require.Equal(t, types.NewMsgVoteOnObservedInboundTx(
+			creator,
+			sender.Hex(),
+			chainID,
+			"",
+			"0x"+hex.EncodeToString(recipient),
+			common.ZetaChain().ChainId,
+			math.NewUint(42),
+			hex.EncodeToString([]byte("hello")),
+			tx.Hash().Hex(),
+			10,
+			keeper.ERC20DepositGasLimit,
+			common.CoinType_ERC20,
+			asset.String(),
+		), inbounds[0])
+	})
+
+	t.Run("should parse gas token transfer to TSS", func(t *testing.T) {
+		tx, sender := signedEVMTx(t, chainID, tssAddress, big.NewInt(42), nil)
+
+		inbounds, err := keeper.ParseEVMInbounds(creator, chainID, 10, *tx, successfulReceipt(), coreParams, tssAddress.Hex())
+		require.NoError(t, err)
+		require.Len(t, inbounds, 1)
+		require.Equal(t, types.NewMsgVoteOnObservedInboundTx(
+			creator,
+			sender.Hex(),
+			chainID,
+			sender.Hex(),
+			sender.Hex(),
+			common.ZetaChain().ChainId,
+			math.NewUint(42),
+			"",
+			tx.Hash().Hex(),
+			10,
+			keeper.GasDepositGasLimit,
+			common.CoinType_Gas,
+			"",
+		), inbounds[0])
+	})
+
+	t.Run("should skip donations and events from other contracts", func(t *testing.T) {
+		tx, _ := signedEVMTx(t, chainID, tssAddress, big.NewInt(42), []byte(common.DonationMessage))
+		receipt := successfulReceipt(
+			depositedLog(t, custody, sample.EthAddress(), sample.EthAddress().Bytes(), big.NewInt(42), []byte(common.DonationMessage)),
+			depositedLog(t, sample.EthAddress(), sample.EthAddress(), sample.EthAddress().Bytes(), big.NewInt(42), []byte("hello")),
+			zetaSentLog(t, sample.EthAddress(), sample.EthAddress(), sample.EthAddress(), common.ZetaChain().ChainId, sample.EthAddress().Bytes(), big.NewInt(42), []byte("hello")),
+		)
+
+		inbounds, err := keeper.ParseEVMInbounds(creator, chainID, 10, *tx, receipt, coreParams, tssAddress.Hex())
+		require.NoError(t, err)
+		require.Empty(t, inbounds)
+	})
+
+	t.Run("should fail if the transaction failed", func(t *testing.T) {
+		tx, _ := signedEVMTx(t, chainID, tssAddress, big.NewInt(42), nil)
+		receipt := successfulReceipt()
+		receipt.Status = ethtypes.ReceiptStatusFailed
+
+		_, err := keeper.ParseEVMInbounds(creator, chainID, 10, *tx, receipt, coreParams, tssAddress.Hex())
+		require.ErrorIs(t, err, types.ErrNoInboundEvent)
+	})
+}
+
+// setTSSKey sets a TSS with a known private key
+func setTSSKey(t *testing.T, k *keeper.Keeper, ctx sdk.Context) (*ecdsa.PrivateKey, types.QueryGetTssAddressResponse) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubKey := &secp256k1.PubKey{Key: crypto.CompressPubkey(&privKey.PublicKey)}
+	tssPubKey, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, pubKey)
+	require.NoError(t, err)
+	k.SetTSS(ctx, types.TSS{TssPubkey: tssPubKey})
+	tssAddress, err := k.GetTssAddress(ctx, &types.QueryGetTssAddressRequest{})
+	require.NoError(t, err)
+	return privKey, *tssAddress
+}
+
+// setProvenEVMBlock stores a finalized block header including the transaction with the given receipt
+// returns the block hash, the proof of the transaction and the proof of the receipt
+func setProvenEVMBlock(
+	t *testing.T,
+	ctx sdk.Context,
+	zk keepertest.ZetaKeepers,
+	chainID int64,
+	tx *ethtypes.Transaction,
+	receipt ethtypes.Receipt,
+	confirmations int64,
+) (string, *common.Proof, *common.Proof) {
+	coreParams := sample.CoreParams(chainID)
+	coreParams.ConfirmationCount = 2
+	zk.ObserverKeeper.SetCoreParams(ctx, observertypes.CoreParamsList{CoreParams: []*observertypes.CoreParams{coreParams}})
+
+	txs := ethtypes.Transactions{tx}
+	receipts := ethtypes.Receipts{&receipt}
+	header := ethtypes.Header{
+		Number:      big.NewInt(10),
+		Difficulty:  big.NewInt(1),
+		TxHash:      ethtypes.DeriveSha(txs, trie.NewStackTrie(nil)),
+		ReceiptHash: ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)),
+	}
+	headerRLP, err := rlp.EncodeToBytes(&header)
+	require.NoError(t, err)
+	zk.ObserverKeeper.SetBlockHeader(ctx, common.BlockHeader{
+		Height:  10,
+		Hash:    header.Hash().Bytes(),
+		ChainId: chainID,
+		Header:  common.NewEthereumHeader(headerRLP),
+	})
+	zk.ObserverKeeper.SetBlockHeaderHashByHeight(ctx, chainID, 10, header.Hash().Bytes())
+	zk.ObserverKeeper.SetChainState(ctx, observertypes.ChainState{
+		ChainId:         chainID,
+		EarliestHeight:  10,
+		LatestHeight:    10 + confirmations,
+		LatestBlockHash: header.Hash().Bytes(),
+	})
+
+	txTrie := ethereum.NewTrie(txs)
+	txProof, err := txTrie.GenerateProof(0)
+	require.NoError(t, err)
+	receiptTrie := ethereum.NewTrie(receipts)
+	receiptProof, err := receiptTrie.GenerateProof(0)
+	require.NoError(t, err)
+
+	return header.Hash().Hex(), common.NewEthereumProof(txProof), common.NewEthereumProof(receiptProof)
+}
+
+// setupProveInboundTx stores a block header including a gas token transfer to the TSS and returns the message proving it
+func setupProveInboundTx(t *testing.T, k *keeper.Keeper, ctx sdk.Context, zk keepertest.ZetaKeepers, confirmations int64) *types.MsgProveInboundTx {
+	chainID := common.GoerliChain().ChainId
+	_, tssAddress := setTSSKey(t, k, ctx)
+	zk.ObserverKeeper.SetParams(ctx, observertypes.DefaultParams())
+	zk.ObserverKeeper.SetCrosschainFlags(ctx, observertypes.CrosschainFlags{IsInboundEnabled: true})
+
+	tx, _ := signedEVMTx(t, chainID, ethcommon.HexToAddress(tssAddress.Eth), big.NewInt(42), nil)
+	blockHash, txProof, receiptProof := setProvenEVMBlock(t, ctx, zk, chainID, tx, successfulReceipt(), confirmations)
+
+	return types.NewMsgProveInboundTx(
+		sample.AccAddress(),
+		chainID,
+		tx.Hash().Hex(),
+		blockHash,
+		0,
+		txProof,
+		receiptProof,
+	)
+}
+
+func TestKeeper_ProveInboundTx(t *testing.T) {
+	t.Run("should create the cctx of a proven inbound", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg := setupProveInboundTx(t, k, ctx, zk, 2)
+
+		_, err := msgServer.ProveInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		inTxHashToCctx, found := k.GetInTxHashToCctx(ctx, msg.TxHash)
+		require.True(t, found)
+		require.Len(t, inTxHashToCctx.CctxIndex, 1)
+		cctx, found := k.GetCrossChainTx(ctx, inTxHashToCctx.CctxIndex[0])
+		require.True(t, found)
+		require.Equal(t, common.CoinType_Gas, cctx.GetCurrentOutTxParam().CoinType)
+		require.Equal(t, math.NewUint(42), cctx.InboundTxParams.Amount)
+
+		// the inbound can't be proven twice
+		_, err = msgServer.ProveInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrNoInboundEvent)
+	})
+
+	t.Run("should fail if the chain is not an evm chain", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg := setupProveInboundTx(t, k, ctx, zk, 2)
+		msg.ChainId = common.BtcChainID()
+
+		_, err := msgServer.ProveInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrUnsupportedChain)
+	})
+
+	t.Run("should fail if the inbounds of the chain are paused", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg := setupProveInboundTx(t, k, ctx, zk, 2)
+		zk.ObserverKeeper.SetChainCrosschainFlags(ctx, observertypes.ChainCrosschainFlags{
+			ChainId:           msg.ChainId,
+			IsInboundEnabled:  false,
+			IsOutboundEnabled: true,
+		})
+
+		_, err := msgServer.ProveInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	})
+
+	t.Run("should fail if the block header doesn't have enough confirmations", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg := setupProveInboundTx(t, k, ctx, zk, 1)
+
+		_, err := msgServer.ProveInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrBlockHeaderNotFinalized)
+	})
+
+	t.Run("should fail if the block header is not found", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg := setupProveInboundTx(t, k, ctx, zk, 2)
+		msg.BlockHash = ethcommon.BytesToHash(sample.Bytes()).Hex()
+
+		_, err := msgServer.ProveInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, observertypes.ErrBlockHeaderNotFound)
+	})
+
+	t.Run("should fail if the proof doesn't match the tx hash", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg := setupProveInboundTx(t, k, ctx, zk, 2)
+		msg.TxHash = ethcommon.BytesToHash(sample.Bytes()).Hex()
+
+		_, err := msgServer.ProveInboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+}
@@ -0,0 +1,291 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	erc20custody "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
+	zetaconnector "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.non-eth.sol"
+
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/common/bitcoin"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/config"
+)
+
+// ProveOutboundTx finalizes the pending outbound of a CCTX from a proof of inclusion of the outbound transaction in a
+// block header stored on ZetaChain. Unlike `VoteOnObservedOutboundTx`, the outbound doesn't rely on a quorum of
+// observers on the outbound ballot. Therefore, any account is authorized to broadcast this message.
+//
+// The block header must be in the canonical chain and have at least the number of confirmations defined in the core
+// params of the chain. The outbound transaction must be signed by the TSS with the nonce of the pending outbound.
+//
+// For EVM chains, the proof of the receipt of the transaction is also required: the status of the outbound and the
+// value received are decoded from the receipt the same way the observers do. The gas used is not part of the receipt
+// proven by the block header, the gas stability pool is therefore not funded with the remaining fees of a proven
+// outbound.
+//
+// For Bitcoin, the transaction must pay the amount of the outbound to the receiver.
+//
+// The outbound is then processed as an outbound finalized by the observers, the outbound tracker of the nonce is
+// removed.
+func (k msgServer) ProveOutboundTx(goCtx context.Context, msg *types.MsgProveOutboundTx) (*types.MsgProveOutboundTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	chain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(msg.ChainId)
+	if chain == nil {
+		return nil, observertypes.ErrSupportedChains
+	}
+	tss, found := k.GetTSS(ctx)
+	if !found {
+		return nil, types.ErrCannotFindTSSKeys
+	}
+
+	// get the cctx of the pending outbound
+	// #nosec G701 always in range
+	nonceToCctx, found := k.GetNonceToCctx(ctx, tss.TssPubkey, msg.ChainId, int64(msg.Nonce))
+	if !found {
+		return nil, cosmoserrors.Wrap(types.ErrCannotFindCctx, fmt.Sprintf("chain %d nonce %d", msg.ChainId, msg.Nonce))
+	}
+	cctx, found := k.GetCrossChainTx(ctx, nonceToCctx.CctxIndex)
+	if !found {
+		return nil, cosmoserrors.Wrap(types.ErrCannotFindCctx, nonceToCctx.CctxIndex)
+	}
+	if !isPendingOutbound(cctx) {
+		return nil, cosmoserrors.Wrap(types.ErrStatusNotPending, fmt.Sprintf("cctx %s status %s", cctx.Index, cctx.CctxStatus.Status))
+	}
+	outTxParams := cctx.GetCurrentOutTxParam()
+	if outTxParams.ReceiverChainId != msg.ChainId || outTxParams.OutboundTxTssNonce != msg.Nonce {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf(
+			"outbound of cctx %s is chain %d nonce %d",
+			cctx.Index,
+			outTxParams.ReceiverChainId,
+			outTxParams.OutboundTxTssNonce,
+		))
+	}
+
+	header, txBytes, err := k.VerifyProvenTx(ctx, msg.ChainId, msg.BlockHash, msg.TxIndex, msg.Proof)
+	if err != nil {
+		return nil, err
+	}
+	tssAddress, err := k.GetTssAddress(ctx, &types.QueryGetTssAddressRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	// the outbound transaction body is validated the same way as for the outbound tracker
+	trackerMsg := &types.MsgAddToOutTxTracker{
+		ChainId: msg.ChainId,
+		Nonce:   msg.Nonce,
+		TxHash:  msg.TxHash,
+	}
+	var outbound *types.MsgVoteOnObservedOutboundTx
+	// #nosec G701 always positive
+	blockHeight := uint64(header.Height)
+	if k.zetaObserverKeeper.IsEVMChain(ctx, msg.ChainId) {
+		if err := ValidateEVMOutTxBody(trackerMsg, txBytes, tssAddress.Eth); err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, err.Error())
+		}
+		if msg.ReceiptProof == nil {
+			return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, "receipt proof is empty")
+		}
+		receiptBytes, err := msg.ReceiptProof.VerifyReceipt(header.Header, int(msg.TxIndex))
+		if err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("receipt proof: %s", err))
+		}
+		var tx ethtypes.Transaction
+		if err := tx.UnmarshalBinary(txBytes); err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("failed to unmarshal evm transaction: %s", err))
+		}
+		var receipt ethtypes.Receipt
+		if err := receipt.UnmarshalBinary(receiptBytes); err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("failed to unmarshal evm receipt: %s", err))
+		}
+		coreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, msg.ChainId)
+		if !found {
+			return nil, types.ErrNotFoundCoreParams
+		}
+		outbound, err = ParseEVMOutbound(msg.Creator, cctx, blockHeight, tx, receipt, *coreParams)
+		if err != nil {
+			return nil, err
+		}
+	} else if k.zetaObserverKeeper.IsBitcoinChain(ctx, msg.ChainId) {
+		if err := ValidateBTCOutTxBody(trackerMsg, txBytes, tssAddress.Btc); err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, err.Error())
+		}
+		outbound, err = ParseBTCOutbound(msg.Creator, cctx, blockHeight, txBytes, tssAddress.Btc)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, cosmoserrors.Wrap(types.ErrUnsupportedChain, fmt.Sprintf("ChainID %d", msg.ChainId))
+	}
+
+	ballotStatus := observertypes.BallotStatus_BallotFinalized_SuccessObservation
+	if outbound.Status == common.ReceiveStatus_Failed {
+		ballotStatus = observertypes.BallotStatus_BallotFinalized_FailureObservation
+	}
+	if err := k.ProcessOutbound(ctx, cctx, outbound, ballotStatus, outbound.Digest(), types.StatusTrigger{MsgTypeURL: sdk.MsgTypeURL(msg)}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProveOutboundTxResponse{}, nil
+}
+
+// ParseEVMOutbound decodes the outbound of a CCTX from the outbound transaction and its receipt
+// the outbound is returned as the vote the observers would have broadcasted for the transaction
+// Note: the transaction and the receipt must have been verified against a block header
+func ParseEVMOutbound(
+	creator string,
+	cctx types.CrossChainTx,
+	blockHeight uint64,
+	tx ethtypes.Transaction,
+	receipt ethtypes.Receipt,
+	coreParams observertypes.CoreParams,
+) (*types.MsgVoteOnObservedOutboundTx, error) {
+	outTxParams := cctx.GetCurrentOutTxParam()
+	status := common.ReceiveStatus_Failed
+	valueReceived := math.ZeroUint()
+
+	if receipt.Status == ethtypes.ReceiptStatusSuccessful {
+		status = common.ReceiveStatus_Success
+		switch outTxParams.CoinType {
+		case common.CoinType_Gas, common.CoinType_Cmd:
+			valueReceived = math.NewUintFromBigInt(tx.Value())
+		case common.CoinType_Zeta:
+			value, err := parseZetaOutbound(cctx.Index, receipt, coreParams)
+			if err != nil {
+				return nil, err
+			}
+			valueReceived = value
+		case common.CoinType_ERC20:
+			value, err := parseERC20Outbound(receipt, coreParams)
+			if err != nil {
+				return nil, err
+			}
+			valueReceived = value
+		default:
+			return nil, cosmoserrors.Wrap(types.ErrInvalidCoinType, outTxParams.CoinType.String())
+		}
+	}
+
+	return types.NewMsgVoteOnObservedOutboundTx(
+		creator,
+		cctx.Index,
+		tx.Hash().Hex(),
+		blockHeight,
+		0, // gas used is not proven by the receipt
+		math.NewIntFromBigInt(tx.GasPrice()),
+		tx.Gas(),
+		valueReceived,
+		status,
+		outTxParams.ReceiverChainId,
+		outTxParams.OutboundTxTssNonce,
+		outTxParams.CoinType,
+	), nil
+}
+
+// parseZetaOutbound returns the value of the ZetaReceived or ZetaReverted event of the cctx emitted by the connector
+func parseZetaOutbound(cctxIndex string, receipt ethtypes.Receipt, coreParams observertypes.CoreParams) (math.Uint, error) {
+	connectorAddress := ethcommon.HexToAddress(coreParams.ConnectorContractAddress)
+	connector, err := zetaconnector.NewZetaConnectorNonEthFilterer(connectorAddress, nil)
+	if err != nil {
+		return math.Uint{}, err
+	}
+	for _, log := range receipt.Logs {
+		if log == nil || len(log.Topics) == 0 || log.Address != connectorAddress {
+			continue
+		}
+		if received, err := connector.ParseZetaReceived(*log); err == nil {
+			if len(log.Topics) == 4 && log.Topics[3].Hex() == cctxIndex {
+				return math.NewUintFromBigInt(received.ZetaValue), nil
+			}
+			continue
+		}
+		if reverted, err := connector.ParseZetaReverted(*log); err == nil {
+			if len(log.Topics) == 3 && log.Topics[2].Hex() == cctxIndex {
+				return math.NewUintFromBigInt(reverted.RemainingZetaValue), nil
+			}
+		}
+	}
+	return math.Uint{}, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("no ZetaReceived or ZetaReverted event for cctx %s", cctxIndex))
+}
+
+// parseERC20Outbound returns the amount of the Withdrawn event emitted by the ERC20 custody
+func parseERC20Outbound(receipt ethtypes.Receipt, coreParams observertypes.CoreParams) (math.Uint, error) {
+	custodyAddress := ethcommon.HexToAddress(coreParams.Erc20CustodyContractAddress)
+	custody, err := erc20custody.NewERC20CustodyFilterer(custodyAddress, nil)
+	if err != nil {
+		return math.Uint{}, err
+	}
+	for _, log := range receipt.Logs {
+		if log == nil || len(log.Topics) == 0 || log.Address != custodyAddress {
+			continue
+		}
+		if withdrawn, err := custody.ParseWithdrawn(*log); err == nil {
+			return math.NewUintFromBigInt(withdrawn.Amount), nil
+		}
+	}
+	return math.Uint{}, cosmoserrors.Wrap(types.ErrProofVerificationFail, "no Withdrawn event")
+}
+
+// ParseBTCOutbound decodes the outbound of a CCTX from the outbound transaction
+// the output paying the nonce of the outbound must pay the amount of the outbound to the receiver
+// Note: the transaction must have been verified against a block header
+func ParseBTCOutbound(
+	creator string,
+	cctx types.CrossChainTx,
+	blockHeight uint64,
+	txBytes []byte,
+	tssBtc string,
+) (*types.MsgVoteOnObservedOutboundTx, error) {
+	tx, err := btcutil.NewTxFromBytes(txBytes)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("failed to unmarshal btc transaction: %s", err))
+	}
+	outTxParams := cctx.GetCurrentOutTxParam()
+
+	// the outTx might pay a batch of nonces, the payment of each nonce follows the nonce-mark in ascending order
+	first, last, err := BTCOutTxNonceRange(tx.MsgTx(), tssBtc)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, err.Error())
+	}
+	if outTxParams.OutboundTxTssNonce < first || outTxParams.OutboundTxTssNonce > last {
+		return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("nonce %d not paid by outTx paying nonces [%d, %d]", outTxParams.OutboundTxTssNonce, first, last))
+	}
+	payment := tx.MsgTx().TxOut[1+outTxParams.OutboundTxTssNonce-first]
+	receiver, err := bitcoin.AddressFromScript(payment.PkScript, config.BitconNetParams)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("failed to decode receiver: %s", err))
+	}
+	if receiver.EncodeAddress() != outTxParams.Receiver {
+		return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("want receiver %s, got %s", outTxParams.Receiver, receiver.EncodeAddress()))
+	}
+	// #nosec G701 always positive
+	if !outTxParams.Amount.Equal(math.NewUint(uint64(payment.Value))) {
+		return nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("want amount %s, got %d", outTxParams.Amount, payment.Value))
+	}
+
+	return types.NewMsgVoteOnObservedOutboundTx(
+		creator,
+		cctx.Index,
+		tx.MsgTx().TxHash().String(),
+		blockHeight,
+		0,              // gas used not used with Bitcoin
+		math.ZeroInt(), // gas price not used with Bitcoin
+		0,              // gas limit not used with Bitcoin
+		outTxParams.Amount,
+		common.ReceiveStatus_Success,
+		outTxParams.ReceiverChainId,
+		outTxParams.OutboundTxTssNonce,
+		outTxParams.CoinType,
+	), nil
+}
@@ -0,0 +1,356 @@
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	erc20custody "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
+	zetaconnector "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.non-eth.sol"
+
+	"github.com/zeta-chain/node/common"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/config"
+)
+
+// tssSignedEVMTx returns a legacy transaction signed by the TSS
+func tssSignedEVMTx(t *testing.T, tssKey *ecdsa.PrivateKey, chainID int64, nonce uint64, to ethcommon.Address, value *big.Int) *ethtypes.Transaction {
+	tx := ethtypes.NewTransaction(nonce, to, value, 21_000, big.NewInt(100), nil)
+	signedTx, err := ethtypes.SignTx(tx, ethtypes.NewLondonSigner(big.NewInt(chainID)), tssKey)
+	require.NoError(t, err)
+	return signedTx
+}
+
+// pendingOutboundCctx returns a cctx with a pending outbound of the given coin type
+func pendingOutboundCctx(t *testing.T, chainID int64, nonce uint64, coinType common.CoinType, amount math.Uint) types.CrossChainTx {
+	cctx := *sample.CrossChainTx(t, "cctx")
+	cctx.Index = ethcommon.BytesToHash(sample.Bytes()).Hex()
+	cctx.CctxStatus = &types.Status{Status: types.CctxStatus_PendingOutbound}
+	cctx.InboundTxParams.SenderChainId = common.ZetaChain().ChainId
+	cctx.OutboundTxParams = []*types.OutboundTxParams{{
+		Receiver:           sample.EthAddress().Hex(),
+		ReceiverChainId:    chainID,
+		CoinType:           coinType,
+		Amount:             amount,
+		OutboundTxTssNonce: nonce,
+		OutboundTxGasLimit: 21_000,
+	}}
+	return cctx
+}
+
+func TestParseEVMOutbound(t *testing.T) {
+	chainID := common.GoerliChain().ChainId
+	coreParams := *sample.CoreParams(chainID)
+	connector := ethcommon.HexToAddress(coreParams.ConnectorContractAddress)
+	custody := ethcommon.HexToAddress(coreParams.Erc20CustodyContractAddress)
+	tssKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	creator := sample.AccAddress()
+
+	t.Run("should parse successful gas outbound", func(t *testing.T) {
+		cctx := pendingOutboundCctx(t, chainID, 5, common.CoinType_Gas, math.NewUint(42))
+		tx := tssSignedEVMTx(t, tssKey, chainID, 5, sample.EthAddress(), big.NewInt(42))
+
+		outbound, err := keeper.ParseEVMOutbound(creator, cctx, 10, *tx, successfulReceipt(), coreParams)
+		require.NoError(t, err)
+		require.Equal(t, types.NewMsgVoteOnObservedOutboundTx(
+			creator,
+			cctx.Index,
+			tx.Hash().Hex(),
+			10,
+			0,
+			math.NewInt(100),
+			21_000,
+			math.NewUint(42),
+			common.ReceiveStatus_Success,
+			chainID,
+			5,
+			common.CoinType_Gas,
+		), outbound)
+	})
+
+	t.Run("should parse failed outbound", func(t *testing.T) {
+		cctx := pendingOutboundCctx(t, chainID, 5, common.CoinType_Zeta, math.NewUint(42))
+		tx := tssSignedEVMTx(t, tssKey, chainID, 5, connector, big.NewInt(0))
+		receipt := successfulReceipt()
+		receipt.Status = ethtypes.ReceiptStatusFailed
+
+		outbound, err := keeper.ParseEVMOutbound(creator, cctx, 10, *tx, receipt, coreParams)
+		require.NoError(t, err)
+		require.Equal(t, common.ReceiveStatus_Failed, outbound.Status)
+		require.True(t, outbound.ValueReceived.IsZero())
+	})
+
+	t.Run("should parse ZetaReceived and ZetaReverted events of the cctx", func(t *testing.T) {
+		connectorABI, err := zetaconnector.ZetaConnectorNonEthMetaData.GetAbi()
+		require.NoError(t, err)
+		cctx := pendingOutboundCctx(t, chainID, 5, common.CoinType_Zeta, math.NewUint(42))
+		tx := tssSignedEVMTx(t, tssKey, chainID, 5, connector, big.NewInt(0))
+
+		received := connectorABI.Events["ZetaReceived"]
+		data, err := received.Inputs.NonIndexed().Pack(sample.EthAddress().Bytes(), big.NewInt(42), []byte{})
+		require.NoError(t, err)
+		receivedLog := &ethtypes.Log{
+			Address: connector,
+			Topics: []ethcommon.Hash{
+				received.ID,
+				ethcommon.BigToHash(big.NewInt(common.ZetaChain().ChainId)),
+				ethcommon.BytesToHash(sample.EthAddress().Bytes()),
+				ethcommon.HexToHash(cctx.Index),
+			},
+			Data: data,
+		}
+		outbound, err := keeper.ParseEVMOutbound(creator, cctx, 10, *tx, successfulReceipt(receivedLog), coreParams)
+		require.NoError(t, err)
+		require.Equal(t, math.NewUint(42), outbound.ValueReceived)
+
+		reverted := connectorABI.Events["ZetaReverted"]
+		data, err = reverted.Inputs.NonIndexed().Pack(sample.EthAddress(), big.NewInt(chainID), sample.EthAddress().Bytes(), big.NewInt(40), []byte{})
+		require.NoError(t, err)
+		revertedLog := &ethtypes.Log{
+			Address: connector,
+			Topics: []ethcommon.Hash{
+				reverted.ID,
+				ethcommon.BigToHash(big.NewInt(common.ZetaChain().ChainId)),
+				ethcommon.HexToHash(cctx.Index),
+			},
+			Data: data,
+		}
+		outbound, err = keeper.ParseEVMOutbound(creator, cctx, 10, *tx, successfulReceipt(revertedLog), coreParams)
+		require.NoError(t, err)
+		require.Equal(t, math.NewUint(40), outbound.ValueReceived)
+
+		// the event of another cctx is not accepted
+		otherCctx := cctx
+		otherCctx.Index = ethcommon.BytesToHash([]byte("other")).Hex()
+		_, err = keeper.ParseEVMOutbound(creator, otherCctx, 10, *tx, successfulReceipt(receivedLog, revertedLog), coreParams)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+
+	t.Run("should parse Withdrawn event", func(t *testing.T) {
+		custodyABI, err := erc20custody.ERC20CustodyMetaData.GetAbi()
+		require.NoError(t, err)
+		cctx := pendingOutboundCctx(t, chainID, 5, common.CoinType_ERC20, math.NewUint(42))
+		tx := tssSignedEVMTx(t, tssKey, chainID, 5, custody, big.NewInt(0))
+
+		withdrawn := custodyABI.Events["Withdrawn"]
+		data, err := withdrawn.Inputs.NonIndexed().Pack(big.NewInt(42))
+		require.NoError(t, err)
+		withdrawnLog := &ethtypes.Log{
+			Address: custody,
+			Topics: []ethcommon.Hash{
+				withdrawn.ID,
+				ethcommon.BytesToHash(sample.EthAddress().Bytes()),
+				ethcommon.BytesToHash(sample.EthAddress().Bytes()),
+			},
+			Data: data,
+		}
+		outbound, err := keeper.ParseEVMOutbound(creator, cctx, 10, *tx, successfulReceipt(withdrawnLog), coreParams)
+		require.NoError(t, err)
+		require.Equal(t, math.NewUint(42), outbound.ValueReceived)
+
+		// the event must be emitted by the custody contract
+		withdrawnLog.Address = sample.EthAddress()
+		_, err = keeper.ParseEVMOutbound(creator, cctx, 10, *tx, successfulReceipt(withdrawnLog), coreParams)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+}
+
+func TestParseBTCOutbound(t *testing.T) {
+	receiver, err := btcutil.NewAddressWitnessPubKeyHash(sample.EthAddress().Bytes(), config.BitconNetParams)
+	require.NoError(t, err)
+	receiverScript, err := txscript.PayToAddrScript(receiver)
+	require.NoError(t, err)
+	tss, err := btcutil.NewAddressWitnessPubKeyHash(sample.EthAddress().Bytes(), config.BitconNetParams)
+	require.NoError(t, err)
+	tssScript, err := txscript.PayToAddrScript(tss)
+	require.NoError(t, err)
+
+	cctx := pendingOutboundCctx(t, common.BtcChainID(), 6, common.CoinType_Gas, math.NewUint(10_000))
+	cctx.GetCurrentOutTxParam().Receiver = receiver.EncodeAddress()
+	serializedTx := func(lastNonce uint64, outputs ...*wire.TxOut) []byte {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+		tx.AddTxOut(wire.NewTxOut(common.NonceMarkAmount(lastNonce), tssScript))
+		for _, output := range outputs {
+			tx.AddTxOut(output)
+		}
+		var buf bytes.Buffer
+		require.NoError(t, tx.Serialize(&buf))
+		return buf.Bytes()
+	}
+
+	t.Run("should parse the payment to the receiver", func(t *testing.T) {
+		txBytes := serializedTx(6, wire.NewTxOut(10_000, receiverScript), wire.NewTxOut(50_000, tssScript))
+		outbound, err := keeper.ParseBTCOutbound(sample.AccAddress(), cctx, 10, txBytes, tss.EncodeAddress())
+		require.NoError(t, err)
+		require.Equal(t, common.ReceiveStatus_Success, outbound.Status)
+		require.Equal(t, math.NewUint(10_000), outbound.ValueReceived)
+		require.EqualValues(t, 10, outbound.ObservedOutTxBlockHeight)
+	})
+
+	t.Run("should parse the payment of a batched outTx", func(t *testing.T) {
+		txBytes := serializedTx(
+			7,
+			wire.NewTxOut(20_000, tssScript),
+			wire.NewTxOut(10_000, receiverScript),
+			wire.NewTxOut(30_000, tssScript),
+			wire.NewTxOut(50_000, tssScript),
+		)
+		outbound, err := keeper.ParseBTCOutbound(sample.AccAddress(), cctx, 10, txBytes, tss.EncodeAddress())
+		require.NoError(t, err)
+		require.Equal(t, math.NewUint(10_000), outbound.ValueReceived)
+	})
+
+	t.Run("should fail if the nonce is not paid by the outTx", func(t *testing.T) {
+		txBytes := serializedTx(5, wire.NewTxOut(10_000, receiverScript), wire.NewTxOut(50_000, tssScript))
+		_, err := keeper.ParseBTCOutbound(sample.AccAddress(), cctx, 10, txBytes, tss.EncodeAddress())
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+
+	t.Run("should fail if the payment is not to the receiver", func(t *testing.T) {
+		txBytes := serializedTx(6, wire.NewTxOut(10_000, tssScript))
+		_, err := keeper.ParseBTCOutbound(sample.AccAddress(), cctx, 10, txBytes, tss.EncodeAddress())
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+
+	t.Run("should fail if the amount doesn't match", func(t *testing.T) {
+		txBytes := serializedTx(6, wire.NewTxOut(9_000, receiverScript))
+		_, err := keeper.ParseBTCOutbound(sample.AccAddress(), cctx, 10, txBytes, tss.EncodeAddress())
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+}
+
+func TestKeeper_ProveOutboundTx(t *testing.T) {
+	chainID := common.GoerliChain().ChainId
+
+	// setupProveOutboundTx stores a pending gas outbound and a block header including its outbound transaction
+	setupProveOutboundTx := func(
+		t *testing.T,
+		k *keeper.Keeper,
+		ctx sdk.Context,
+		zk keepertest.ZetaKeepers,
+		receiptStatus uint64,
+	) (*types.MsgProveOutboundTx, types.CrossChainTx) {
+		tssKey, _ := setTSSKey(t, k, ctx)
+		tss, found := k.GetTSS(ctx)
+		require.True(t, found)
+		zk.ObserverKeeper.SetParams(ctx, observertypes.DefaultParams())
+
+		cctx := pendingOutboundCctx(t, chainID, 5, common.CoinType_Gas, math.NewUint(42))
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+		k.SetNonceToCctx(ctx, types.NonceToCctx{
+			ChainId:   chainID,
+			Nonce:     5,
+			CctxIndex: cctx.Index,
+			Tss:       tss.TssPubkey,
+		})
+
+		tx := tssSignedEVMTx(t, tssKey, chainID, 5, ethcommon.HexToAddress(cctx.GetCurrentOutTxParam().Receiver), big.NewInt(42))
+		receipt := successfulReceipt()
+		receipt.Status = receiptStatus
+		blockHash, txProof, receiptProof := setProvenEVMBlock(t, ctx, zk, chainID, tx, receipt, 2)
+
+		return types.NewMsgProveOutboundTx(
+			sample.AccAddress(),
+			chainID,
+			5,
+			tx.Hash().Hex(),
+			blockHash,
+			0,
+			txProof,
+			receiptProof,
+		), cctx
+	}
+
+	t.Run("should finalize a proven outbound", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, cctx := setupProveOutboundTx(t, k, ctx, zk, ethtypes.ReceiptStatusSuccessful)
+		k.SetOutTxTracker(ctx, types.OutTxTracker{ChainId: chainID, Nonce: 5})
+
+		_, err := msgServer.ProveOutboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_OutboundMined, cctx.CctxStatus.Status)
+		require.Equal(t, msg.TxHash, cctx.GetCurrentOutTxParam().OutboundTxHash)
+		require.EqualValues(t, 10, cctx.GetCurrentOutTxParam().OutboundTxObservedExternalHeight)
+		_, found = k.GetOutTxTracker(ctx, chainID, 5)
+		require.False(t, found)
+
+		// the outbound can't be proven twice
+		_, err = msgServer.ProveOutboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrStatusNotPending)
+	})
+
+	t.Run("should process a proven failed outbound", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, cctx := setupProveOutboundTx(t, k, ctx, zk, ethtypes.ReceiptStatusFailed)
+
+		_, err := msgServer.ProveOutboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		// the cctx is from ZetaChain and can't be reverted
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+	})
+
+	t.Run("should fail if the transaction is not signed by the TSS", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, cctx := setupProveOutboundTx(t, k, ctx, zk, ethtypes.ReceiptStatusSuccessful)
+
+		// another TSS is set
+		setTSSKey(t, k, ctx)
+		tss, found := k.GetTSS(ctx)
+		require.True(t, found)
+		k.SetNonceToCctx(ctx, types.NonceToCctx{
+			ChainId:   chainID,
+			Nonce:     5,
+			CctxIndex: cctx.Index,
+			Tss:       tss.TssPubkey,
+		})
+
+		_, err := msgServer.ProveOutboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+
+	t.Run("should fail if the receipt proof of an evm outbound is missing", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, _ := setupProveOutboundTx(t, k, ctx, zk, ethtypes.ReceiptStatusSuccessful)
+		msg.ReceiptProof = nil
+
+		_, err := msgServer.ProveOutboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrProofVerificationFail)
+	})
+
+	t.Run("should fail if the nonce is not pending", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		msg, _ := setupProveOutboundTx(t, k, ctx, zk, ethtypes.ReceiptStatusSuccessful)
+		msg.Nonce = 6
+
+		_, err := msgServer.ProveOutboundTx(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrCannotFindCctx)
+	})
+}
@@ -236,3 +236,52 @@ func TestSendQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestCctxStatusTransitions(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(42)
+	cctx := createNCctxWithStatus(keeper, ctx, 1, types.CctxStatus_PendingOutbound)[0]
+	trigger := types.StatusTrigger{
+		MsgTypeURL:  sdk.MsgTypeURL(&types.MsgVoteOnObservedOutboundTx{}),
+		BallotIndex: "ballot",
+	}
+	ChangeCctxStatus(ctx, &cctx, types.CctxStatus_PendingRevert, "Outbound failed, start revert", trigger)
+	ChangeCctxStatus(ctx, &cctx, types.CctxStatus_Reverted, "", trigger)
+	keeper.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+
+	// an event is emitted for each transition
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "zetachain.zetacore.crosschain.EventCctxStatusChanged" {
+			events = append(events, event)
+		}
+	}
+	require.Len(t, events, 2)
+
+	res, err := keeper.CctxStatusTransitions(sdk.WrapSDKContext(ctx), &types.QueryCctxStatusTransitionsRequest{Index: cctx.Index})
+	require.NoError(t, err)
+	require.Equal(t, []*types.StatusTransition{
+		{
+			OldStatus:   types.CctxStatus_PendingOutbound,
+			NewStatus:   types.CctxStatus_PendingRevert,
+			Message:     "Outbound failed, start revert",
+			ZetaHeight:  42,
+			BlockTime:   ctx.BlockTime().Unix(),
+			MsgTypeUrl:  trigger.MsgTypeURL,
+			BallotIndex: "ballot",
+		},
+		{
+			OldStatus:   types.CctxStatus_PendingRevert,
+			NewStatus:   types.CctxStatus_Reverted,
+			ZetaHeight:  42,
+			BlockTime:   ctx.BlockTime().Unix(),
+			MsgTypeUrl:  trigger.MsgTypeURL,
+			BallotIndex: "ballot",
+		},
+	}, res.Transitions)
+
+	_, err = keeper.CctxStatusTransitions(sdk.WrapSDKContext(ctx), &types.QueryCctxStatusTransitionsRequest{Index: "missing"})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "not found"))
+	_, err = keeper.CctxStatusTransitions(sdk.WrapSDKContext(ctx), nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
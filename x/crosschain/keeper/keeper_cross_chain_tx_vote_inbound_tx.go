@@ -37,6 +37,10 @@ import (
 // then burned. The nonce is updated. If everything is successful, the CCTX
 // status is changed to `PendingOutbound`.
 //
+// If the CCTX exceeds a rate limit of the sender chain, it is not processed and
+// its status is changed to `PendingReview` until it is released by the admin
+// policy.
+//
 // ```mermaid
 // stateDiagram-v2
 //
@@ -51,6 +55,7 @@ import (
 //	PendingInbound --> finalize_inbound: Receiver is connected chain
 //	finalize_inbound --> Aborted: Finalize inbound error
 //	finalize_inbound --> PendingOutbound: Finalize inbound success
+//	PendingInbound --> PendingReview: Rate limit exceeded
 //
 // ```
 //
@@ -67,6 +72,9 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 	if observationChain == nil {
 		return nil, sdkerrors.Wrap(types.ErrUnsupportedChain, fmt.Sprintf("ChainID %d, Observation %s", msg.SenderChainId, observationType.String()))
 	}
+	if !k.zetaObserverKeeper.IsChainInboundEnabled(ctx, msg.SenderChainId) {
+		return nil, sdkerrors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("inbounds of chain %d are paused", msg.SenderChainId))
+	}
 	receiverChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(msg.ReceiverChain)
 	if receiverChain == nil {
 		return nil, sdkerrors.Wrap(types.ErrUnsupportedChain, fmt.Sprintf("ChainID %d, Observation %s", msg.ReceiverChain, observationType.String()))
@@ -89,6 +97,10 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 	if isNew {
+		// a new ballot for an existing cctx means the ballot has been finalized and pruned
+		if _, found := k.GetCrossChainTx(ctx, index); found {
+			return nil, sdkerrors.Wrap(types.ErrObservedTxAlreadyFinalized, fmt.Sprintf("cctx %s", index))
+		}
 		observerKeeper.EmitEventBallotCreated(ctx, ballot, msg.InTxHash, observationChain.String())
 	}
 	// AddVoteToBallot adds a vote and sets the ballot
@@ -103,44 +115,96 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 		return &types.MsgVoteOnObservedInboundTxResponse{}, nil
 	}
 
+	// ******************************************************************************
+	// below only happens when ballot is finalized: exactly when threshold vote is in
+	// ******************************************************************************
+
+	// the inbound might have already been finalized through a proof-based inbound
+	if _, found := k.GetCrossChainTx(ctx, index); found {
+		return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+	}
+
+	if err := k.ProcessInbound(ctx, msg, index, tssPub, observationChain, receiverChain, types.StatusTrigger{MsgTypeURL: sdk.MsgTypeURL(msg), BallotIndex: index}); err != nil {
+		return nil, err
+	}
+	return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+}
+
+// ProcessInbound creates the CCTX of a finalized inbound and processes it: the inbound is either deposited on ZetaChain
+// or prepared to be sent as an outbound to the receiver chain. The CCTX is held for review instead if it exceeds a rate
+// limit of the sender chain or if its outbound would be signed by a TSS whose funds are being migrated. The CCTX is saved
+// with its resulting status
+// an error is returned only if the CCTX can't be created, trigger is recorded in the status transitions of the CCTX
+func (k Keeper) ProcessInbound(
+	ctx sdk.Context,
+	msg *types.MsgVoteOnObservedInboundTx,
+	index string,
+	tssPub string,
+	observationChain *common.Chain,
+	receiverChain *common.Chain,
+	trigger types.StatusTrigger,
+) error {
 	// Validation if we want to send ZETA to external chain, but there is no ZETA token.
 	if receiverChain.IsExternalChain() {
 		coreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, receiverChain.ChainId)
 		if !found {
-			return nil, types.ErrNotFoundCoreParams
+			return types.ErrNotFoundCoreParams
 		}
 		if coreParams.ZetaTokenContractAddress == "" && msg.CoinType == common.CoinType_Zeta {
-			return nil, types.ErrUnableToSendCoinType
+			return types.ErrUnableToSendCoinType
 		}
 	}
 
-	// ******************************************************************************
-	// below only happens when ballot is finalized: exactly when threshold vote is in
-	// ******************************************************************************
-
-	// Inbound Ballot has been finalized , Create CCTX
+	// Inbound has been finalized , Create CCTX
 	cctx := k.CreateNewCCTX(ctx, msg, index, tssPub, types.CctxStatus_PendingInbound, observationChain, receiverChain)
 	defer func() {
-		EmitEventInboundFinalized(ctx, &cctx)
-		// #nosec G701 always positive
-		cctx.InboundTxParams.InboundTxFinalizedZetaHeight = uint64(ctx.BlockHeight())
+		EmitEventInboundFinalized(
+			ctx,
+			&cctx,
+			k.getChainName(ctx, cctx.InboundTxParams.SenderChainId),
+			k.getChainName(ctx, cctx.GetCurrentOutTxParam().ReceiverChainId),
+		)
 		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
 	}()
+	// the CCTX is held for review if it exceeds a rate limit of the sender chain
+	if exceeded, reason := k.CheckInboundRateLimits(ctx, msg); exceeded {
+		ChangeCctxStatus(ctx, &cctx, types.CctxStatus_PendingReview, reason, trigger)
+		return nil
+	}
+	// the CCTX is held for review until the migration of the TSS funds is completed
+	if k.HoldCctxForTssMigration(ctx, &cctx, trigger) {
+		return nil
+	}
+	k.processInboundCctx(ctx, &cctx, msg, observationChain, receiverChain, trigger)
+	return nil
+}
+
+// processInboundCctx processes the CCTX of a finalized inbound pending for its inbound: the inbound is either
+// deposited on ZetaChain or prepared to be sent as an outbound to the receiver chain, the status of the CCTX is updated
+// accordingly but the CCTX is not saved
+func (k Keeper) processInboundCctx(
+	ctx sdk.Context,
+	cctx *types.CrossChainTx,
+	msg *types.MsgVoteOnObservedInboundTx,
+	observationChain *common.Chain,
+	receiverChain *common.Chain,
+	trigger types.StatusTrigger,
+) {
 	// FinalizeInbound updates CCTX Prices and Nonce
 	// Aborts is any of the updates fail
 	if receiverChain.IsZetaChain() {
 		tmpCtx, commit := ctx.CacheContext()
-		isContractReverted, err := k.HandleEVMDeposit(tmpCtx, &cctx, *msg, observationChain)
+		isContractReverted, err := k.HandleEVMDeposit(tmpCtx, cctx, *msg, observationChain)
 
 		if err != nil && !isContractReverted { // exceptional case; internal error; should abort CCTX
-			cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, err.Error())
-			return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+			ChangeCctxStatus(ctx, cctx, types.CctxStatus_Aborted, err.Error(), trigger)
+			return
 		} else if err != nil && isContractReverted { // contract call reverted; should refund
 			revertMessage := err.Error()
 			chain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(cctx.InboundTxParams.SenderChainId)
 			if chain == nil {
-				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, "invalid sender chain")
-				return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+				ChangeCctxStatus(ctx, cctx, types.CctxStatus_Aborted, "invalid sender chain", trigger)
+				return
 			}
 			// create new OutboundTxParams for the revert
 			cctx.OutboundTxParams = append(cctx.OutboundTxParams, &types.OutboundTxParams{
@@ -159,18 +223,18 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 				err := k.PayGasAndUpdateCctx(
 					tmpCtx,
 					chain.ChainId,
-					&cctx,
+					cctx,
 					cctx.InboundTxParams.Amount,
 					false,
 				)
 				if err != nil {
 					return err
 				}
-				err = k.UpdateNonce(tmpCtx, chain.ChainId, &cctx)
-				if err != nil {
-					return err
+				// the nonce of a revert held for the migration of the TSS funds is assigned when it is released
+				if k.IsOutboundHeldForTssMigration(tmpCtx, chain.ChainId) {
+					return nil
 				}
-				return nil
+				return k.UpdateNonce(tmpCtx, chain.ChainId, cctx)
 			}()
 			if err != nil {
 				// do not commit anything here as the CCTX should be aborted
@@ -179,42 +243,46 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 				// in this gas we should refund the sender on ZetaChain
 				if cctx.InboundTxParams.CoinType == common.CoinType_ERC20 {
 
-					if err := k.RefundAmountOnZetaChain(ctx, cctx, cctx.InboundTxParams.Amount); err != nil {
+					if err := k.RefundAmountOnZetaChain(ctx, *cctx, cctx.InboundTxParams.Amount); err != nil {
 						// log the error
 						k.Logger(ctx).Error("failed to refund amount of aborted cctx on ZetaChain",
 							"error", err,
 							"sender", cctx.InboundTxParams.Sender,
 							"amount", cctx.InboundTxParams.Amount.String(),
 						)
+					} else {
+						// the aborted cctx can't be refunded nor retried by the admin policy anymore
+						cctx.IsAbortRefunded = true
 					}
 				}
 
-				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, err.Error())
-				return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+				ChangeCctxStatus(ctx, cctx, types.CctxStatus_Aborted, err.Error(), trigger)
+				return
 			}
 			commit()
-			cctx.CctxStatus.ChangeStatus(types.CctxStatus_PendingRevert, revertMessage)
-			return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+			ChangeCctxStatus(ctx, cctx, types.CctxStatus_PendingRevert, revertMessage, trigger)
+			k.HoldCctxForTssMigration(ctx, cctx, trigger)
+			return
 
 		} else { // successful HandleEVMDeposit;
 			commit()
-			cctx.CctxStatus.ChangeStatus(types.CctxStatus_OutboundMined, "Remote omnichain contract call completed")
-			return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+			ChangeCctxStatus(ctx, cctx, types.CctxStatus_OutboundMined, "Remote omnichain contract call completed", trigger)
+			return
 		}
 	} else { // Cross Chain SWAP
 		tmpCtx, commit := ctx.CacheContext()
-		err = func() error {
+		err := func() error {
 			err := k.PayGasAndUpdateCctx(
 				tmpCtx,
 				receiverChain.ChainId,
-				&cctx,
+				cctx,
 				cctx.InboundTxParams.Amount,
 				false,
 			)
 			if err != nil {
 				return err
 			}
-			err = k.UpdateNonce(tmpCtx, receiverChain.ChainId, &cctx)
+			err = k.UpdateNonce(tmpCtx, receiverChain.ChainId, cctx)
 			if err != nil {
 				return err
 			}
@@ -222,11 +290,11 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 		}()
 		if err != nil {
 			// do not commit anything here as the CCTX should be aborted
-			cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, err.Error())
-			return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+			ChangeCctxStatus(ctx, cctx, types.CctxStatus_Aborted, err.Error(), trigger)
+			return
 		}
 		commit()
-		cctx.CctxStatus.ChangeStatus(types.CctxStatus_PendingOutbound, "")
-		return &types.MsgVoteOnObservedInboundTxResponse{}, nil
+		ChangeCctxStatus(ctx, cctx, types.CctxStatus_PendingOutbound, "", trigger)
+		return
 	}
 }
@@ -111,12 +111,39 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 		// Return nil here to add vote to ballot and commit state
 		return &types.MsgVoteOnObservedOutboundTxResponse{}, nil
 	}
-	if ballot.BallotStatus != observerTypes.BallotStatus_BallotFinalized_FailureObservation {
+	// the outbound might have already been finalized through a proof-based outbound
+	if !isPendingOutbound(cctx) {
+		return &types.MsgVoteOnObservedOutboundTxResponse{}, nil
+	}
+
+	if err := k.ProcessOutbound(ctx, cctx, msg, ballot.BallotStatus, ballotIndex, types.StatusTrigger{MsgTypeURL: sdk.MsgTypeURL(msg), BallotIndex: ballotIndex}); err != nil {
+		return nil, err
+	}
+	return &types.MsgVoteOnObservedOutboundTxResponse{}, nil
+}
+
+// ProcessOutbound processes a finalized outbound of a CCTX: the CCTX is updated with the observed outbound and its status
+// is changed depending on the status of the observation, a revert is created if the outbound failed
+// the CCTX is saved and the outbound tracker of the nonce is removed, trigger is recorded in the status transitions of the CCTX
+func (k Keeper) ProcessOutbound(
+	ctx sdk.Context,
+	cctx types.CrossChainTx,
+	msg *types.MsgVoteOnObservedOutboundTx,
+	ballotStatus observerTypes.BallotStatus,
+	ballotIndex string,
+	trigger types.StatusTrigger,
+) error {
+	// the amount migrated to the new TSS is only known once the outbound is observed
+	isTssFundMigrator := k.IsTssFundMigrator(ctx, cctx.Index)
+	if isTssFundMigrator {
+		cctx.GetCurrentOutTxParam().Amount = msg.ValueReceived
+	}
+	if ballotStatus != observerTypes.BallotStatus_BallotFinalized_FailureObservation {
 		if !msg.ValueReceived.Equal(cctx.GetCurrentOutTxParam().Amount) {
-			log.Error().Msgf("VoteOnObservedOutboundTx: Mint mismatch: %s value received vs %s cctx amount",
+			log.Error().Msgf("ProcessOutbound: Mint mismatch: %s value received vs %s cctx amount",
 				msg.ValueReceived,
 				cctx.GetCurrentOutTxParam().Amount)
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("ValueReceived %s does not match sent value %s", msg.ValueReceived, cctx.GetCurrentOutTxParam().Amount))
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("ValueReceived %s does not match sent value %s", msg.ValueReceived, cctx.GetCurrentOutTxParam().Amount))
 		}
 	}
 
@@ -130,33 +157,38 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 	// Fund the gas stability pool with the remaining funds
 	if err := k.FundGasStabilityPoolFromRemainingFees(ctx, *cctx.GetCurrentOutTxParam(), msg.OutTxChain); err != nil {
 		log.Error().Msgf(
-			"VoteOnObservedOutboundTx: CCTX: %s Can't fund the gas stability pool with remaining fees %s", cctx.Index, err.Error(),
+			"ProcessOutbound: CCTX: %s Can't fund the gas stability pool with remaining fees %s", cctx.Index, err.Error(),
 		)
 	}
 
-	tss, _ := k.GetTSS(ctx)
+	// the pending nonces are tracked for the TSS that signed the outbound, which might no longer be the current TSS
+	tssPubkey := cctx.GetCurrentOutTxParam().TssPubkey
+	if tssPubkey == "" {
+		tss, _ := k.GetTSS(ctx)
+		tssPubkey = tss.TssPubkey
+	}
 
 	// FinalizeOutbound sets final status for a successful vote
 	// FinalizeOutbound updates CCTX Prices and Nonce for a revert
 
 	tmpCtx, commit := ctx.CacheContext()
-	err = func() error { //err = FinalizeOutbound(k, ctx, &cctx, msg, ballot.BallotStatus)
+	err := func() error { //err = FinalizeOutbound(k, ctx, &cctx, msg, ballot.BallotStatus)
 		cctx.GetCurrentOutTxParam().OutboundTxObservedExternalHeight = msg.ObservedOutTxBlockHeight
 		oldStatus := cctx.CctxStatus.Status
-		switch ballot.BallotStatus {
+		switch ballotStatus {
 		case observerTypes.BallotStatus_BallotFinalized_SuccessObservation:
 			switch oldStatus {
 			case types.CctxStatus_PendingRevert:
-				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Reverted, "")
+				ChangeCctxStatus(ctx, &cctx, types.CctxStatus_Reverted, "", trigger)
 			case types.CctxStatus_PendingOutbound:
-				cctx.CctxStatus.ChangeStatus(types.CctxStatus_OutboundMined, "")
+				ChangeCctxStatus(ctx, &cctx, types.CctxStatus_OutboundMined, "", trigger)
 			}
 			newStatus := cctx.CctxStatus.Status.String()
 			EmitOutboundSuccess(tmpCtx, msg, oldStatus.String(), newStatus, cctx)
 		case observerTypes.BallotStatus_BallotFinalized_FailureObservation:
 			if msg.CoinType == common.CoinType_Cmd || cctx.InboundTxParams.SenderChainId == common.ZetaChain().ChainId {
 				// if the cctx is of coin type cmd or the sender chain is zeta chain, then we do not revert, the cctx is aborted
-				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, "")
+				ChangeCctxStatus(ctx, &cctx, types.CctxStatus_Aborted, "", trigger)
 			} else {
 				switch oldStatus {
 				case types.CctxStatus_PendingOutbound:
@@ -180,13 +212,17 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 					if err != nil {
 						return err
 					}
-					err = k.UpdateNonce(tmpCtx, cctx.InboundTxParams.SenderChainId, &cctx)
-					if err != nil {
-						return err
+					// the nonce of a revert held for the migration of the TSS funds is assigned when it is released
+					if !k.IsOutboundHeldForTssMigration(tmpCtx, cctx.InboundTxParams.SenderChainId) {
+						err = k.UpdateNonce(tmpCtx, cctx.InboundTxParams.SenderChainId, &cctx)
+						if err != nil {
+							return err
+						}
 					}
-					cctx.CctxStatus.ChangeStatus(types.CctxStatus_PendingRevert, "Outbound failed, start revert")
+					ChangeCctxStatus(ctx, &cctx, types.CctxStatus_PendingRevert, "Outbound failed, start revert", trigger)
+					k.HoldCctxForTssMigration(tmpCtx, &cctx, trigger)
 				case types.CctxStatus_PendingRevert:
-					cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, "Outbound failed: revert failed; abort TX")
+					ChangeCctxStatus(ctx, &cctx, types.CctxStatus_Aborted, "Outbound failed: revert failed; abort TX", trigger)
 				}
 			}
 			newStatus := cctx.CctxStatus.Status.String()
@@ -196,22 +232,30 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 	}()
 	if err != nil {
 		// do not commit tmpCtx
-		cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, err.Error())
+		ChangeCctxStatus(ctx, &cctx, types.CctxStatus_Aborted, err.Error(), trigger)
 		ctx.Logger().Error(err.Error())
 		// #nosec G701 always in range
-		k.RemoveFromPendingNonces(ctx, tss.TssPubkey, msg.OutTxChain, int64(msg.OutTxTssNonce))
+		k.RemoveFromPendingNonces(ctx, tssPubkey, msg.OutTxChain, int64(msg.OutTxTssNonce))
 		k.RemoveOutTxTracker(ctx, msg.OutTxChain, msg.OutTxTssNonce)
 		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
-		return &types.MsgVoteOnObservedOutboundTxResponse{}, nil
+		return nil
 	}
 	commit()
 	// Set the ballot index to the finalized ballot
 	cctx.GetCurrentOutTxParam().OutboundTxBallotIndex = ballotIndex
 	// #nosec G701 always in range
-	k.RemoveFromPendingNonces(ctx, tss.TssPubkey, msg.OutTxChain, int64(msg.OutTxTssNonce))
+	k.RemoveFromPendingNonces(ctx, tssPubkey, msg.OutTxChain, int64(msg.OutTxTssNonce))
 	k.RemoveOutTxTracker(ctx, msg.OutTxChain, msg.OutTxTssNonce)
 	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
-	return &types.MsgVoteOnObservedOutboundTxResponse{}, nil
+	if isTssFundMigrator {
+		k.completeTssFundMigration(ctx, cctx, trigger.MsgTypeURL)
+	}
+	return nil
+}
+
+// isPendingOutbound returns true if the CCTX is waiting for its current outbound to be finalized
+func isPendingOutbound(cctx types.CrossChainTx) bool {
+	return cctx.CctxStatus.Status == types.CctxStatus_PendingOutbound || cctx.CctxStatus.Status == types.CctxStatus_PendingRevert
 }
 
 func percentOf(n *big.Int, percent int64) *big.Int {
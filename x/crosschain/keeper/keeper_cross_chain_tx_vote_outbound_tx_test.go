@@ -7,10 +7,15 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
 	testkeeper "github.com/zeta-chain/node/testutil/keeper"
 	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
 )
 
 func TestKeeper_FundGasStabilityPoolFromRemainingFees(t *testing.T) {
@@ -104,3 +109,65 @@ func TestKeeper_FundGasStabilityPoolFromRemainingFees(t *testing.T) {
 		})
 	}
 }
+
+// postReceiveConfirmationGasLimit is the gas limit of the outbound votes broadcasted by zetaclient, it must be kept in
+// sync with zetaclient.PostReceiveConfirmationGasLimit that can't be imported here
+const postReceiveConfirmationGasLimit uint64 = 400_000
+
+func TestKeeper_VoteOnObservedOutboundTxGas(t *testing.T) {
+	k, ctx, sdkk, zk := testkeeper.CrosschainKeeper(t)
+	k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	// the remaining fees of the outbound fund the gas stability pool of the gas coin
+	chain := getValidEthChain(t)
+	deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+	setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chain.ChainId, "foobar", "foobar")
+
+	// a single observer finalizes the outbound with its vote
+	observer := sample.AccAddress()
+	params := zk.ObserverKeeper.GetParams(ctx)
+	params.ObserverParams = []*observertypes.ObserverParams{{
+		Chain:           chain,
+		IsSupported:     true,
+		BallotThreshold: sdk.MustNewDecFromStr("0.66"),
+	}}
+	zk.ObserverKeeper.SetParams(ctx, params)
+	zk.ObserverKeeper.SetObserverMapper(ctx, &observertypes.ObserverMapper{
+		ObserverChain: chain,
+		ObserverList:  []string{observer},
+	})
+	tss := generatedTss(t, 100)
+	k.SetTssAndUpdateNonce(ctx, tss)
+
+	cctx := pendingOutboundCctx(t, chain.ChainId, 0, common.CoinType_Gas, math.NewUint(42))
+	cctx.GetCurrentOutTxParam().TssPubkey = tss.TssPubkey
+	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+	k.SetPendingNonces(ctx, types.PendingNonces{Tss: tss.TssPubkey, ChainId: chain.ChainId, NonceLow: 0, NonceHigh: 1})
+	k.SetOutTxTracker(ctx, types.OutTxTracker{ChainId: chain.ChainId, Nonce: 0})
+
+	msg := &types.MsgVoteOnObservedOutboundTx{
+		Creator:                        observer,
+		CctxHash:                       cctx.Index,
+		ObservedOutTxHash:              sample.Hash().Hex(),
+		ObservedOutTxBlockHeight:       10,
+		ObservedOutTxGasUsed:           20_000,
+		ObservedOutTxEffectiveGasPrice: math.NewInt(100),
+		ObservedOutTxEffectiveGasLimit: 21_000,
+		ValueReceived:                  math.NewUint(42),
+		Status:                         common.ReceiveStatus_Success,
+		OutTxChain:                     chain.ChainId,
+		OutTxTssNonce:                  0,
+		CoinType:                       common.CoinType_Gas,
+	}
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err := msgServer.VoteOnObservedOutboundTx(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+	require.True(t, found)
+	require.Equal(t, types.CctxStatus_OutboundMined, cctx.CctxStatus.Status)
+
+	// the vote finalizing the outbound writes the cctx and its secondary indexes and funds the gas stability pool, it
+	// must fit in the gas limit of the votes broadcasted by zetaclient with room left for the ante handler and authz
+	require.Less(t, ctx.GasMeter().GasConsumed(), postReceiveConfirmationGasLimit*3/4)
+}
@@ -37,13 +37,41 @@ func (k Keeper) GetGasPrice(ctx sdk.Context, chainID int64) (val types.GasPrice,
 	return val, true
 }
 
+// GetMedianGasPriceInUint returns the gas price of the chain: the median of the recent votes bounded by the maximum
+// change of the gas price in an epoch
 func (k Keeper) GetMedianGasPriceInUint(ctx sdk.Context, chainID int64) (sdk.Uint, bool) {
 	gasPrice, isFound := k.GetGasPrice(ctx, chainID)
 	if !isFound {
 		return math.ZeroUint(), isFound
 	}
-	mi := gasPrice.MedianIndex
-	return sdk.NewUint(gasPrice.Prices[mi]), true
+	// the gas price is not set if no vote happened since the gas price is bounded
+	if gasPrice.Price == 0 {
+		return sdk.NewUint(gasPrice.MedianPrice()), true
+	}
+	return sdk.NewUint(gasPrice.Price), true
+}
+
+// getGasPriceOracleFlags returns the gas price oracle flags or the default flags if not defined
+func (k Keeper) getGasPriceOracleFlags(ctx sdk.Context) observertypes.GasPriceOracleFlags {
+	crosschainFlags, found := k.zetaObserverKeeper.GetCrosschainFlags(ctx)
+	if found && crosschainFlags.GasPriceOracleFlags != nil {
+		return *crosschainFlags.GasPriceOracleFlags
+	}
+	return observertypes.DefaultGasPriceOracleFlags
+}
+
+// GetMedianPriorityFeeInUint returns the median of the priority fees observed for the chain
+// the priority fee is zero if the chain doesn't support EIP-1559
+func (k Keeper) GetMedianPriorityFeeInUint(ctx sdk.Context, chainID int64) (sdk.Uint, bool) {
+	gasPrice, isFound := k.GetGasPrice(ctx, chainID)
+	if !isFound {
+		return math.ZeroUint(), isFound
+	}
+	if len(gasPrice.PriorityFees) == 0 {
+		return math.ZeroUint(), true
+	}
+	mi := medianOfArray(gasPrice.PriorityFees)
+	return sdk.NewUint(gasPrice.PriorityFees[mi]), true
 }
 
 // RemoveGasPrice removes a gasPrice from the store
@@ -121,6 +149,10 @@ func (k Keeper) GasPrice(c context.Context, req *types.QueryGetGasPriceRequest)
 // height. Gas price submitted by each validator is recorded separately and a
 // median index is updated.
 //
+// The votes older than the maximum vote age of the gas price oracle flags are
+// discarded. The gas price of the chain is the median of the votes, its change
+// in an epoch is bounded by the maximum change of the gas price oracle flags.
+//
 // Only observer validators are authorized to broadcast this message.
 func (k msgServer) GasPriceVoter(goCtx context.Context, msg *types.MsgGasPriceVoter) (*types.MsgGasPriceVoterResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -137,23 +169,40 @@ func (k msgServer) GasPriceVoter(goCtx context.Context, msg *types.MsgGasPriceVo
 	}
 
 	gasPrice, isFound := k.GetGasPrice(ctx, chain.ChainId)
+	var previousPrice, previousMedianPrice uint64
+	if isFound {
+		previousPrice, previousMedianPrice = gasPrice.Price, gasPrice.MedianPrice()
+	}
 	if !isFound {
 		gasPrice = types.GasPrice{
-			Creator:     msg.Creator,
-			Index:       strconv.FormatInt(chain.ChainId, 10), // TODO : Not needed index set at keeper
-			ChainId:     chain.ChainId,
-			Prices:      []uint64{msg.Price},
-			BlockNums:   []uint64{msg.BlockNumber},
-			Signers:     []string{msg.Creator},
-			MedianIndex: 0,
+			Creator:      msg.Creator,
+			Index:        strconv.FormatInt(chain.ChainId, 10), // TODO : Not needed index set at keeper
+			ChainId:      chain.ChainId,
+			Prices:       []uint64{msg.Price},
+			PriorityFees: []uint64{msg.PriorityFee},
+			BlockNums:    []uint64{msg.BlockNumber},
+			Signers:      []string{msg.Creator},
+			VoteHeights:  []int64{ctx.BlockHeight()},
+			MedianIndex:  0,
 		}
 	} else {
+		// gas prices voted before the priority fee was introduced have no priority fee
+		for len(gasPrice.PriorityFees) < len(gasPrice.Prices) {
+			gasPrice.PriorityFees = append(gasPrice.PriorityFees, 0)
+		}
+		// gas prices voted before the vote heights were tracked are considered as recent votes
+		for len(gasPrice.VoteHeights) < len(gasPrice.Prices) {
+			gasPrice.VoteHeights = append(gasPrice.VoteHeights, ctx.BlockHeight())
+		}
+
 		signers := gasPrice.Signers
 		exist := false
 		for i, s := range signers {
 			if s == msg.Creator { // update existing entry
 				gasPrice.BlockNums[i] = msg.BlockNumber
 				gasPrice.Prices[i] = msg.Price
+				gasPrice.PriorityFees[i] = msg.PriorityFee
+				gasPrice.VoteHeights[i] = ctx.BlockHeight()
 				exist = true
 				break
 			}
@@ -162,19 +211,37 @@ func (k msgServer) GasPriceVoter(goCtx context.Context, msg *types.MsgGasPriceVo
 			gasPrice.Signers = append(gasPrice.Signers, msg.Creator)
 			gasPrice.BlockNums = append(gasPrice.BlockNums, msg.BlockNumber)
 			gasPrice.Prices = append(gasPrice.Prices, msg.Price)
+			gasPrice.PriorityFees = append(gasPrice.PriorityFees, msg.PriorityFee)
+			gasPrice.VoteHeights = append(gasPrice.VoteHeights, ctx.BlockHeight())
 		}
-		// recompute the median gas price
-		mi := medianOfArray(gasPrice.Prices)
-		// #nosec G701 always positive
-		gasPrice.MedianIndex = uint64(mi)
 	}
+
+	// discard the stale votes and recompute the median gas price
+	oracleFlags := k.getGasPriceOracleFlags(ctx)
+	if oracleFlags.MaxVoteAge > 0 {
+		gasPrice.RemoveVotesBefore(ctx.BlockHeight() - oracleFlags.MaxVoteAge)
+	}
+	mi := medianOfArray(gasPrice.Prices)
+	// #nosec G701 always positive
+	gasPrice.MedianIndex = uint64(mi)
+
+	// update the gas price of the chain and record its changes in the history
+	gasPrice.UpdatePrice(ctx.BlockHeight(), oracleFlags.EpochLength, oracleFlags.MaxChangePercent)
 	k.SetGasPrice(ctx, gasPrice)
+	if !isFound || gasPrice.Price != previousPrice || gasPrice.MedianPrice() != previousMedianPrice {
+		k.SetGasPriceHistoryEntry(ctx, types.GasPriceHistoryEntry{
+			ChainId:     chain.ChainId,
+			Height:      ctx.BlockHeight(),
+			Price:       gasPrice.Price,
+			MedianPrice: gasPrice.MedianPrice(),
+		})
+	}
 	chainIDBigINT := big.NewInt(chain.ChainId)
 
 	gasUsed, err := k.fungibleKeeper.SetGasPrice(
 		ctx,
 		chainIDBigINT,
-		math.NewUint(gasPrice.Prices[gasPrice.MedianIndex]).BigInt(),
+		math.NewUint(gasPrice.Price).BigInt(),
 	)
 	if err != nil {
 		return nil, err
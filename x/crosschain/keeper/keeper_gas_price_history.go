@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// GasPriceHistoryLength is the number of blocks for which the changes of the gas prices are kept in the history
+	GasPriceHistoryLength = 14400
+)
+
+// SetGasPriceHistoryEntry sets the gas price of a chain at a height in the gas price history
+// the entries older than GasPriceHistoryLength blocks are removed
+func (k Keeper) SetGasPriceHistoryEntry(ctx sdk.Context, entry types.GasPriceHistoryEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GasPriceHistoryKey))
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(types.GasPriceHistoryEntryKey(entry.ChainId, entry.Height), b)
+
+	if entry.Height <= GasPriceHistoryLength {
+		return
+	}
+	chainStore := prefix.NewStore(store, types.GasPriceHistoryPrefix(entry.ChainId))
+	// #nosec G701 always positive
+	iterator := chainStore.Iterator(nil, sdk.Uint64ToBigEndian(uint64(entry.Height-GasPriceHistoryLength)))
+	defer iterator.Close()
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Key())
+	}
+	for _, key := range expired {
+		chainStore.Delete(key)
+	}
+}
+
+// GetGasPriceHistory returns the gas price history of a chain ordered by height
+func (k Keeper) GetGasPriceHistory(ctx sdk.Context, chainID int64) (list []types.GasPriceHistoryEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.GasPriceHistoryKey), types.GasPriceHistoryPrefix(chainID)...))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GasPriceHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// Queries
+
+func (k Keeper) GasPriceHistory(c context.Context, req *types.QueryGasPriceHistoryRequest) (*types.QueryGasPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.GasPriceHistoryKey), types.GasPriceHistoryPrefix(req.ChainId)...))
+
+	var history []types.GasPriceHistoryEntry
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var entry types.GasPriceHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		history = append(history, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGasPriceHistoryResponse{History: history, Pagination: pageRes}, nil
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestKeeper_GasPriceHistory(t *testing.T) {
+	t.Run("removes the expired entries", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetGasPriceHistoryEntry(ctx, types.GasPriceHistoryEntry{ChainId: 1, Height: 10, Price: 1})
+		k.SetGasPriceHistoryEntry(ctx, types.GasPriceHistoryEntry{ChainId: 1, Height: 20, Price: 2})
+		k.SetGasPriceHistoryEntry(ctx, types.GasPriceHistoryEntry{ChainId: 2, Height: 10, Price: 3})
+		k.SetGasPriceHistoryEntry(ctx, types.GasPriceHistoryEntry{ChainId: 1, Height: keeper.GasPriceHistoryLength + 15, Price: 4})
+
+		require.Equal(t, []types.GasPriceHistoryEntry{
+			{ChainId: 1, Height: 20, Price: 2},
+			{ChainId: 1, Height: keeper.GasPriceHistoryLength + 15, Price: 4},
+		}, k.GetGasPriceHistory(ctx, 1))
+		require.Equal(t, []types.GasPriceHistoryEntry{{ChainId: 2, Height: 10, Price: 3}}, k.GetGasPriceHistory(ctx, 2))
+	})
+
+	t.Run("can query the history of a chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		for _, height := range []int64{1, 2, 300} {
+			k.SetGasPriceHistoryEntry(ctx, types.GasPriceHistoryEntry{ChainId: 1, Height: height})
+		}
+		k.SetGasPriceHistoryEntry(ctx, types.GasPriceHistoryEntry{ChainId: 2, Height: 3})
+
+		res, err := k.GasPriceHistory(wctx, &types.QueryGasPriceHistoryRequest{
+			ChainId:    1,
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.History, 2)
+		require.Equal(t, int64(2), res.History[1].Height)
+		require.Equal(t, uint64(3), res.Pagination.Total)
+
+		_, err = k.GasPriceHistory(wctx, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestKeeper_GasPriceVoter(t *testing.T) {
+	// setupGasPriceVoter returns a msg server with the chain supported and the gas price oracle flags set
+	setupGasPriceVoter := func(t *testing.T, flags observertypes.GasPriceOracleFlags) (*keeper.Keeper, sdk.Context, int64) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseObserverMock: true,
+			UseFungibleMock: true,
+		})
+		chain := getValidEthChain(t)
+
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		observerMock.On("GetParams", mock.Anything).Return(observertypes.Params{
+			ObserverParams: []*observertypes.ObserverParams{{Chain: chain, IsSupported: true}},
+		})
+		observerMock.On("IsAuthorized", mock.Anything, mock.Anything, chain).Return(true)
+		observerMock.On("GetCrosschainFlags", mock.Anything).Return(observertypes.CrosschainFlags{
+			GasPriceOracleFlags: &flags,
+		}, true)
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		fungibleMock.On("SetGasPrice", mock.Anything, big.NewInt(chain.ChainId), mock.Anything).Return(uint64(0), nil)
+
+		return k, ctx, chain.ChainId
+	}
+
+	t.Run("discards the stale votes", func(t *testing.T) {
+		k, ctx, chainID := setupGasPriceVoter(t, observertypes.GasPriceOracleFlags{MaxVoteAge: 10, EpochLength: 100})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		alice, bob := sample.AccAddress(), sample.AccAddress()
+
+		_, err := msgServer.GasPriceVoter(ctx, types.NewMsgGasPriceVoter(alice, chainID, 1000, 0, "", 1))
+		require.NoError(t, err)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 11)
+		_, err = msgServer.GasPriceVoter(ctx, types.NewMsgGasPriceVoter(bob, chainID, 10, 0, "", 2))
+		require.NoError(t, err)
+
+		gasPrice, found := k.GetGasPrice(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, []string{bob}, gasPrice.Signers)
+		require.Equal(t, uint64(10), gasPrice.Price)
+		require.Len(t, k.GetGasPriceHistory(ctx, chainID), 2)
+	})
+
+	t.Run("bounds the change of the gas price", func(t *testing.T) {
+		k, ctx, chainID := setupGasPriceVoter(t, observertypes.GasPriceOracleFlags{EpochLength: 100, MaxChangePercent: 50})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		alice, bob, charlie := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+
+		_, err := msgServer.GasPriceVoter(ctx, types.NewMsgGasPriceVoter(alice, chainID, 100, 0, "", 1))
+		require.NoError(t, err)
+		_, err = msgServer.GasPriceVoter(ctx, types.NewMsgGasPriceVoter(bob, chainID, 1000, 0, "", 1))
+		require.NoError(t, err)
+		price, found := k.GetMedianGasPriceInUint(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, uint64(150), price.Uint64())
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		_, err = msgServer.GasPriceVoter(ctx, types.NewMsgGasPriceVoter(charlie, chainID, 100, 0, "", 1))
+		require.NoError(t, err)
+		price, _ = k.GetMedianGasPriceInUint(ctx, chainID)
+		require.Equal(t, uint64(100), price.Uint64())
+		require.Len(t, k.GetGasPriceHistory(ctx, chainID), 2)
+
+		// the history is not updated if the gas price doesn't change
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		_, err = msgServer.GasPriceVoter(ctx, types.NewMsgGasPriceVoter(charlie, chainID, 100, 0, "", 2))
+		require.NoError(t, err)
+		require.Len(t, k.GetGasPriceHistory(ctx, chainID), 2)
+	})
+}
@@ -52,6 +52,36 @@ func TestGasPriceGetAll(t *testing.T) {
 	assert.Equal(t, items, keeper.GetAllGasPrice(ctx))
 }
 
+func TestGasPriceGetMedianPriorityFee(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	_, found := keeper.GetMedianPriorityFeeInUint(ctx, 1)
+	assert.False(t, found)
+
+	// no priority fee voted
+	keeper.SetGasPrice(ctx, types.GasPrice{
+		ChainId: 1,
+		Prices:  []uint64{10, 20, 30},
+	})
+	priorityFee, found := keeper.GetMedianPriorityFeeInUint(ctx, 1)
+	assert.True(t, found)
+	assert.True(t, priorityFee.IsZero())
+
+	keeper.SetGasPrice(ctx, types.GasPrice{
+		ChainId:      1,
+		Prices:       []uint64{10, 20, 30},
+		PriorityFees: []uint64{3, 1, 2},
+	})
+	priorityFee, found = keeper.GetMedianPriorityFeeInUint(ctx, 1)
+	assert.True(t, found)
+	assert.Equal(t, uint64(2), priorityFee.Uint64())
+
+	// the priority fee is capped by the gas price
+	assert.Equal(t, uint64(2), keeper.GetOutboundPriorityFee(ctx, 1, sdk.NewUint(10)).Uint64())
+	assert.Equal(t, uint64(1), keeper.GetOutboundPriorityFee(ctx, 1, sdk.NewUint(1)).Uint64())
+	assert.True(t, keeper.GetOutboundPriorityFee(ctx, 2, sdk.NewUint(10)).IsZero())
+}
+
 // Querier Tests
 
 func TestGasPriceQuerySingle(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 	cosmoserrors "cosmossdk.io/errors"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +17,7 @@ import (
 	eth "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/common/bitcoin"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/config"
@@ -205,9 +207,9 @@ func (k msgServer) AddToOutTxTracker(goCtx context.Context, msg *types.MsgAddToO
 				return nil, err
 			}
 			// verify outTx transaction body
-			if common.IsEVMChain(msg.ChainId) {
+			if k.zetaObserverKeeper.IsEVMChain(ctx, msg.ChainId) {
 				err = ValidateEVMOutTxBody(msg, txBytes, tss.Eth)
-			} else if common.IsBitcoinChain(msg.ChainId) {
+			} else if k.zetaObserverKeeper.IsBitcoinChain(ctx, msg.ChainId) {
 				err = ValidateBTCOutTxBody(msg, txBytes, tss.Btc)
 			} else {
 				return nil, fmt.Errorf("unsupported chain id %d", msg.ChainId)
@@ -215,6 +217,7 @@ func (k msgServer) AddToOutTxTracker(goCtx context.Context, msg *types.MsgAddToO
 			if err != nil {
 				return nil, err
 			}
+			proven = true
 		}
 
 		if !proven {
@@ -321,11 +324,13 @@ func ValidateBTCOutTxBody(msg *types.MsgAddToOutTxTracker, txBytes []byte, tssBt
 	if common.BtcChainID() != msg.ChainId {
 		return fmt.Errorf("want btc chain id %d, got %d", common.BtcChainID(), msg.ChainId)
 	}
-	if len(tx.MsgTx().TxOut) < 1 {
-		return fmt.Errorf("outTx should have at least one output")
+	// a batched outTx pays several nonces, the nonce-mark is the one of the last nonce
+	first, last, err := BTCOutTxNonceRange(tx.MsgTx(), tssBtc)
+	if err != nil {
+		return err
 	}
-	if tx.MsgTx().TxOut[0].Value != common.NonceMarkAmount(msg.Nonce) {
-		return fmt.Errorf("want nonce mark %d, got %d", tx.MsgTx().TxOut[0].Value, common.NonceMarkAmount(msg.Nonce))
+	if msg.Nonce < first || msg.Nonce > last {
+		return fmt.Errorf("nonce %d not paid by outTx paying nonces [%d, %d]", msg.Nonce, first, last)
 	}
 	if tx.MsgTx().TxHash().String() != msg.TxHash {
 		return fmt.Errorf("want tx hash %s, got %s", tx.MsgTx().TxHash(), msg.TxHash)
@@ -333,6 +338,19 @@ func ValidateBTCOutTxBody(msg *types.MsgAddToOutTxTracker, txBytes []byte, tssBt
 	return nil
 }
 
+// BTCOutTxNonceRange returns the range of nonces paid by a bitcoin outTx of the TSS
+func BTCOutTxNonceRange(tx *wire.MsgTx, tssBtc string) (uint64, uint64, error) {
+	if len(tx.TxOut) < 2 {
+		return 0, 0, fmt.Errorf("outTx should have at least two outputs")
+	}
+	hasChange := false
+	change, err := bitcoin.AddressFromScript(tx.TxOut[len(tx.TxOut)-1].PkScript, config.BitconNetParams)
+	if err == nil && change.EncodeAddress() == tssBtc {
+		hasChange = true
+	}
+	return common.OutTxNonceRange(tx.TxOut[0].Value, len(tx.TxOut), hasChange)
+}
+
 // RemoveFromOutTxTracker removes a record from the outbound transaction tracker by chain ID and nonce.
 // only the admin policy account is authorized to broadcast this message.
 func (k msgServer) RemoveFromOutTxTracker(goCtx context.Context, msg *types.MsgRemoveFromOutTxTracker) (*types.MsgRemoveFromOutTxTrackerResponse, error) {
@@ -10,8 +10,11 @@ import (
 )
 
 // GetParams get all parameters as types.Params
-func (k Keeper) GetParams(_ sdk.Context) types.Params {
-	return types.NewParams()
+// the parameters not set in the store have their default value
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramstore.GetParamSetIfExists(ctx, &params)
+	return params
 }
 
 // SetParams set the params
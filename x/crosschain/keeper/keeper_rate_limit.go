@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetRateLimit sets the rate limit of a chain or of a foreign coin of the chain
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+	b := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.RateLimitKey(rateLimit.ChainId, rateLimit.Zrc20Address), b)
+}
+
+// GetRateLimit returns the rate limit of a chain if the ZRC20 address is empty, or of a foreign coin of the chain
+func (k Keeper) GetRateLimit(ctx sdk.Context, chainID int64, zrc20Address string) (val types.RateLimit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+
+	b := store.Get(types.RateLimitKey(chainID, zrc20Address))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) GetAllRateLimit(ctx sdk.Context) (list []types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveRateLimit removes the rate limit and its current window
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, chainID int64, zrc20Address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+	store.Delete(types.RateLimitKey(chainID, zrc20Address))
+
+	windowStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitWindowKeyPrefix))
+	windowStore.Delete(types.RateLimitKey(chainID, zrc20Address))
+}
+
+// SetRateLimitWindow sets the current window of a rate limit
+func (k Keeper) SetRateLimitWindow(ctx sdk.Context, window types.RateLimitWindow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitWindowKeyPrefix))
+	b := k.cdc.MustMarshal(&window)
+	store.Set(types.RateLimitKey(window.ChainId, window.Zrc20Address), b)
+}
+
+// GetRateLimitWindow returns the current window of a rate limit
+func (k Keeper) GetRateLimitWindow(ctx sdk.Context, chainID int64, zrc20Address string) (val types.RateLimitWindow, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitWindowKeyPrefix))
+
+	b := store.Get(types.RateLimitKey(chainID, zrc20Address))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) GetAllRateLimitWindow(ctx sdk.Context) (list []types.RateLimitWindow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitWindowKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RateLimitWindow
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// CheckRateLimits checks if a new CCTX of the chain transferring the amount of the ZRC20 exceeds the rate limit of the
+// chain or the rate limit of the foreign coin, the ZRC20 address is empty if the CCTX doesn't transfer a foreign coin
+// If no rate limit is exceeded, the CCTX is counted in the current window of the rate limits
+// otherwise the windows are not updated and the reason of the excess is returned
+func (k Keeper) CheckRateLimits(ctx sdk.Context, chainID int64, zrc20Address string, amount math.Uint) (bool, string) {
+	rateLimits := make([]types.RateLimit, 0, 2)
+	if rateLimit, found := k.GetRateLimit(ctx, chainID, ""); found {
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	if zrc20Address != "" {
+		if rateLimit, found := k.GetRateLimit(ctx, chainID, zrc20Address); found {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+	}
+	if amount.IsNil() {
+		amount = math.ZeroUint()
+	}
+
+	windows := make([]types.RateLimitWindow, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		window, found := k.GetRateLimitWindow(ctx, rateLimit.ChainId, rateLimit.Zrc20Address)
+		if !found || ctx.BlockHeight() >= window.StartHeight+rateLimit.Window {
+			window = types.RateLimitWindow{
+				ChainId:      rateLimit.ChainId,
+				Zrc20Address: rateLimit.Zrc20Address,
+				StartHeight:  ctx.BlockHeight(),
+				Value:        math.ZeroUint(),
+				CctxCount:    0,
+			}
+		}
+		if window.Value.IsNil() {
+			window.Value = math.ZeroUint()
+		}
+
+		window.CctxCount++
+		if rateLimit.MaxCctxCount > 0 && window.CctxCount > rateLimit.MaxCctxCount {
+			return true, fmt.Sprintf(
+				"rate limit exceeded: more than %d cctxs of chain %d %s in %d blocks",
+				rateLimit.MaxCctxCount,
+				rateLimit.ChainId,
+				rateLimit.Zrc20Address,
+				rateLimit.Window,
+			)
+		}
+		if rateLimit.Zrc20Address != "" {
+			window.Value = window.Value.Add(amount)
+			if !rateLimit.MaxValue.IsNil() && !rateLimit.MaxValue.IsZero() && window.Value.GT(rateLimit.MaxValue) {
+				return true, fmt.Sprintf(
+					"rate limit exceeded: more than %s of zrc20 %s of chain %d in %d blocks",
+					rateLimit.MaxValue.String(),
+					rateLimit.Zrc20Address,
+					rateLimit.ChainId,
+					rateLimit.Window,
+				)
+			}
+		}
+		windows = append(windows, window)
+	}
+
+	for _, window := range windows {
+		k.SetRateLimitWindow(ctx, window)
+	}
+	return false, ""
+}
+
+// CheckInboundRateLimits checks the rate limits of the sender chain of an inbound
+// gas and ERC20 inbounds are also checked against the rate limit of their foreign coin
+func (k Keeper) CheckInboundRateLimits(ctx sdk.Context, msg *types.MsgVoteOnObservedInboundTx) (bool, string) {
+	zrc20Address := ""
+	switch msg.CoinType {
+	case common.CoinType_Gas:
+		for _, fc := range k.fungibleKeeper.GetAllForeignCoinsForChain(ctx, msg.SenderChainId) {
+			if fc.CoinType == common.CoinType_Gas {
+				zrc20Address = fc.Zrc20ContractAddress
+				break
+			}
+		}
+	case common.CoinType_ERC20:
+		fc, found := k.fungibleKeeper.GetForeignCoinFromAsset(ctx, msg.Asset, msg.SenderChainId)
+		if found {
+			zrc20Address = fc.Zrc20ContractAddress
+		}
+	}
+	return k.CheckRateLimits(ctx, msg.SenderChainId, zrc20Address, msg.Amount)
+}
+
+// CheckWithdrawalRateLimits checks the rate limits of the receiver chain of a ZRC20 withdrawal
+func (k Keeper) CheckWithdrawalRateLimits(ctx sdk.Context, foreignCoin fungibletypes.ForeignCoins, amount math.Uint) (bool, string) {
+	return k.CheckRateLimits(ctx, foreignCoin.ForeignChainId, foreignCoin.Zrc20ContractAddress, amount)
+}
+
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: k.GetAllRateLimit(ctx),
+		Windows:    k.GetAllRateLimitWindow(ctx),
+	}, nil
+}
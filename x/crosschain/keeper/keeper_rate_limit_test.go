@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	zrc20 "github.com/zeta-chain/protocol-contracts/pkg/contracts/zevm/zrc20.sol"
+)
+
+// zetaDepositMsg returns the vote of an inbound depositing ZETA from the chain to the receiver on ZetaChain
+func zetaDepositMsg(chainID int64, receiver ethcommon.Address, amount uint64) *types.MsgVoteOnObservedInboundTx {
+	sender := sample.EthAddress().Hex()
+	return types.NewMsgVoteOnObservedInboundTx(
+		sample.AccAddress(),
+		sender,
+		chainID,
+		sender,
+		receiver.Hex(),
+		common.ZetaChain().ChainId,
+		math.NewUint(amount),
+		"",
+		sample.Hash().Hex(),
+		10,
+		90000,
+		common.CoinType_Zeta,
+		"",
+	)
+}
+
+func TestKeeper_CheckRateLimits(t *testing.T) {
+	zrc20Address := sample.EthAddress().Hex()
+
+	t.Run("no rate limit is never exceeded", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		for i := 0; i < 10; i++ {
+			exceeded, _ := k.CheckRateLimits(ctx, 1, zrc20Address, math.NewUint(1000))
+			require.False(t, exceeded)
+		}
+		require.Empty(t, k.GetAllRateLimitWindow(ctx))
+	})
+
+	t.Run("limits the number of cctxs of a chain in a window", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		k.SetRateLimit(ctx, types.RateLimit{ChainId: 1, Window: 10, MaxCctxCount: 2})
+
+		exceeded, _ := k.CheckRateLimits(ctx, 1, "", math.NewUint(1000))
+		require.False(t, exceeded)
+		exceeded, _ = k.CheckRateLimits(ctx.WithBlockHeight(105), 1, zrc20Address, math.NewUint(1000))
+		require.False(t, exceeded)
+		exceeded, reason := k.CheckRateLimits(ctx.WithBlockHeight(109), 1, "", math.NewUint(1000))
+		require.True(t, exceeded)
+		require.Contains(t, reason, "rate limit exceeded")
+
+		// other chains are not limited
+		exceeded, _ = k.CheckRateLimits(ctx, 2, "", math.NewUint(1000))
+		require.False(t, exceeded)
+
+		// a new window starts
+		exceeded, _ = k.CheckRateLimits(ctx.WithBlockHeight(110), 1, "", math.NewUint(1000))
+		require.False(t, exceeded)
+		window, found := k.GetRateLimitWindow(ctx, 1, "")
+		require.True(t, found)
+		require.Equal(t, int64(110), window.StartHeight)
+		require.Equal(t, uint64(1), window.CctxCount)
+	})
+
+	t.Run("limits the value of a foreign coin in a window", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetRateLimit(ctx, types.RateLimit{ChainId: 1, Zrc20Address: zrc20Address, Window: 10, MaxValue: math.NewUint(1000)})
+
+		exceeded, _ := k.CheckRateLimits(ctx, 1, zrc20Address, math.NewUint(600))
+		require.False(t, exceeded)
+		exceeded, _ = k.CheckRateLimits(ctx, 1, zrc20Address, math.NewUint(500))
+		require.True(t, exceeded)
+		exceeded, _ = k.CheckRateLimits(ctx, 1, zrc20Address, math.NewUint(400))
+		require.False(t, exceeded)
+
+		// other foreign coins are not limited
+		exceeded, _ = k.CheckRateLimits(ctx, 1, sample.EthAddress().Hex(), math.NewUint(2000))
+		require.False(t, exceeded)
+
+		window, found := k.GetRateLimitWindow(ctx, 1, zrc20Address)
+		require.True(t, found)
+		require.Equal(t, math.NewUint(1000), window.Value)
+		require.Equal(t, uint64(2), window.CctxCount)
+	})
+
+	t.Run("windows are not updated if a rate limit is exceeded", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		k.SetRateLimit(ctx, types.RateLimit{ChainId: 1, Window: 10, MaxCctxCount: 10})
+		k.SetRateLimit(ctx, types.RateLimit{ChainId: 1, Zrc20Address: zrc20Address, Window: 10, MaxValue: math.NewUint(1000)})
+
+		exceeded, _ := k.CheckRateLimits(ctx, 1, zrc20Address, math.NewUint(2000))
+		require.True(t, exceeded)
+		require.Empty(t, k.GetAllRateLimitWindow(ctx))
+	})
+}
+
+func TestKeeper_ProcessInboundRateLimit(t *testing.T) {
+	t.Run("should hold an inbound exceeding a rate limit for review", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		chain := getValidEthChain(t)
+		zetaChain := common.ZetaChain()
+		receiver := sample.EthAddress()
+		k.SetRateLimit(ctx, types.RateLimit{ChainId: chain.ChainId, Window: 10, MaxCctxCount: 1})
+
+		msg := zetaDepositMsg(chain.ChainId, receiver, 42)
+		err := k.ProcessInbound(ctx, msg, msg.Digest(), "", chain, &zetaChain, types.StatusTrigger{})
+		require.NoError(t, err)
+		cctx, found := k.GetCrossChainTx(ctx, msg.Digest())
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_OutboundMined, cctx.CctxStatus.Status)
+
+		msg = zetaDepositMsg(chain.ChainId, receiver, 100)
+		err = k.ProcessInbound(ctx, msg, msg.Digest(), "", chain, &zetaChain, types.StatusTrigger{})
+		require.NoError(t, err)
+		cctx, found = k.GetCrossChainTx(ctx, msg.Digest())
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingReview, cctx.CctxStatus.Status)
+		require.Equal(t, types.CctxStatus_PendingInbound, keeper.GetCctxReviewedStatus(cctx))
+
+		// the held inbound is not deposited
+		balance := sdkk.BankKeeper.GetBalance(ctx, sdk.AccAddress(receiver.Bytes()), common.ZETADenom)
+		require.Equal(t, int64(42), balance.Amount.Int64())
+		pendingReview := k.GetAllCctxPendingReview(ctx)
+		require.Len(t, pendingReview, 1)
+		require.Equal(t, msg.Digest(), pendingReview[0].Index)
+	})
+}
+
+func TestKeeper_ProcessZRC20WithdrawalEventRateLimit(t *testing.T) {
+	t.Run("should hold a withdrawal exceeding a rate limit for review", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, observertypes.CrosschainFlags{IsInboundEnabled: true})
+		chainID := getValidEthChainID(t)
+		tss := sample.Tss()
+		k.SetTssAndUpdateNonce(ctx, *tss)
+		k.SetGasPrice(ctx, types.GasPrice{ChainId: chainID, MedianIndex: 0, Prices: []uint64{10}})
+
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		gasZRC20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+		k.SetRateLimit(ctx, types.RateLimit{
+			ChainId:      chainID,
+			Zrc20Address: gasZRC20.Hex(),
+			Window:       10,
+			MaxValue:     math.NewUint(1000),
+		})
+
+		withdrawal := func(value int64, index uint) *zrc20.ZRC20Withdrawal {
+			return &zrc20.ZRC20Withdrawal{
+				From:  sample.EthAddress(),
+				To:    sample.EthAddress().Bytes(),
+				Value: big.NewInt(value),
+				Raw: ethtypes.Log{
+					Address:     gasZRC20,
+					TxHash:      sample.Hash(),
+					BlockNumber: 10,
+					Index:       index,
+				},
+			}
+		}
+
+		err := k.ProcessZRC20WithdrawalEvent(ctx, withdrawal(800, 0), sample.EthAddress(), sample.EthAddress().Hex())
+		require.NoError(t, err)
+		err = k.ProcessZRC20WithdrawalEvent(ctx, withdrawal(800, 1), sample.EthAddress(), sample.EthAddress().Hex())
+		require.NoError(t, err)
+
+		// only the first withdrawal is assigned a nonce
+		chainNonces, found := k.GetChainNonces(ctx, getValidEthChain(t).ChainName.String())
+		require.True(t, found)
+		require.Equal(t, uint64(1), chainNonces.Nonce)
+		pendingReview := k.GetAllCctxPendingReview(ctx)
+		require.Len(t, pendingReview, 1)
+		cctx := pendingReview[0]
+		require.Equal(t, types.CctxStatus_PendingReview, cctx.CctxStatus.Status)
+		require.Equal(t, types.CctxStatus_PendingOutbound, keeper.GetCctxReviewedStatus(cctx))
+		require.Equal(t, math.NewUint(800), cctx.GetCurrentOutTxParam().Amount)
+	})
+}
+
+func TestKeeper_RateLimits(t *testing.T) {
+	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	rateLimit := types.RateLimit{ChainId: 1, Window: 10, MaxValue: math.ZeroUint(), MaxCctxCount: 1}
+	k.SetRateLimit(ctx, rateLimit)
+	exceeded, _ := k.CheckRateLimits(ctx, 1, "", math.NewUint(1000))
+	require.False(t, exceeded)
+
+	res, err := k.RateLimits(sdk.WrapSDKContext(ctx), &types.QueryRateLimitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.RateLimit{rateLimit}, res.RateLimits)
+	require.Len(t, res.Windows, 1)
+	require.Equal(t, uint64(1), res.Windows[0].CctxCount)
+
+	_, err = k.RateLimits(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,448 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// tssMigrationGasLimit is the gas limit of the cctx transferring the native gas tokens of an EVM chain to the new TSS
+	tssMigrationGasLimit = 21_000
+
+	// erc20CustodyMigrationGasLimit is the gas limit of the cctx updating the TSS address of the ERC20 custody contract
+	erc20CustodyMigrationGasLimit = 100_000
+
+	// maxTssMigrationRetries is the number of times an aborted migration cctx is re-created
+	maxTssMigrationRetries = 3
+
+	// tssMigrationFailedMessage is the status message of a migration cctx aborted after the maximum number of retries
+	tssMigrationFailedMessage = "TSS migration cctx aborted after the maximum number of retries, the migration must be ended by updating the TSS address"
+)
+
+// SetPendingMigrationTss sets the TSS the funds of the current TSS are being migrated to
+func (k Keeper) SetPendingMigrationTss(ctx sdk.Context, tss types.TSS) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingMigrationTSSKey))
+	b := k.cdc.MustMarshal(&tss)
+	store.Set([]byte{0}, b)
+}
+
+// GetPendingMigrationTss returns the TSS the funds of the current TSS are being migrated to
+func (k Keeper) GetPendingMigrationTss(ctx sdk.Context) (val types.TSS, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingMigrationTSSKey))
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingMigrationTss removes the TSS the funds of the current TSS are being migrated to
+func (k Keeper) RemovePendingMigrationTss(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingMigrationTSSKey))
+	store.Delete([]byte{0})
+}
+
+// IsTssMigrationInProgress returns true if the funds of the current TSS are being migrated to a new TSS
+func (k Keeper) IsTssMigrationInProgress(ctx sdk.Context) bool {
+	_, found := k.GetPendingMigrationTss(ctx)
+	return found
+}
+
+// SetTssFundMigrator sets the information of a cctx migrating the funds of the current TSS
+func (k Keeper) SetTssFundMigrator(ctx sdk.Context, migrator types.TssFundMigratorInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TssFundMigratorKeyPrefix))
+	b := k.cdc.MustMarshal(&migrator)
+	store.Set([]byte(migrator.MigrationCctxIndex), b)
+}
+
+// GetTssFundMigrator returns the information of a cctx migrating the funds of the current TSS
+func (k Keeper) GetTssFundMigrator(ctx sdk.Context, cctxIndex string) (val types.TssFundMigratorInfo, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TssFundMigratorKeyPrefix))
+	b := store.Get([]byte(cctxIndex))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveTssFundMigrator removes the information of a cctx migrating the funds of the current TSS
+func (k Keeper) RemoveTssFundMigrator(ctx sdk.Context, cctxIndex string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TssFundMigratorKeyPrefix))
+	store.Delete([]byte(cctxIndex))
+}
+
+// GetAllTssFundMigrators returns the information of all the cctxs migrating the funds of the current TSS
+func (k Keeper) GetAllTssFundMigrators(ctx sdk.Context) (list []types.TssFundMigratorInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TssFundMigratorKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TssFundMigratorInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// IsTssFundMigrator returns true if the cctx migrates the funds of the current TSS to the new TSS
+func (k Keeper) IsTssFundMigrator(ctx sdk.Context, cctxIndex string) bool {
+	_, found := k.GetTssFundMigrator(ctx, cctxIndex)
+	return found
+}
+
+// InitiateTssMigration starts the migration of the funds of the current TSS to newTss: a cmd cctx is created for each
+// supported external chain to transfer the funds held by the current TSS, and for each EVM chain with an ERC20 custody
+// contract to update the TSS address of the contract. The new TSS becomes the current TSS once all these cctxs are
+// mined, it becomes the current TSS immediately if no cctx is needed
+// the indexes of the created cctxs are returned
+func (k Keeper) InitiateTssMigration(ctx sdk.Context, creator string, msgTypeURL string, newTss types.TSS) ([]string, error) {
+	currentTss, found := k.GetTSS(ctx)
+	if !found {
+		return nil, types.ErrCannotFindTSSKeys
+	}
+	if k.IsTssMigrationInProgress(ctx) {
+		return nil, types.ErrTssMigrationInProgress
+	}
+	if currentTss.TssPubkey == newTss.TssPubkey {
+		return nil, errorsmod.Wrap(types.ErrCannotMigrateTss, "new tss is the current tss")
+	}
+
+	var migrators []types.TssFundMigratorInfo
+	for _, chain := range k.zetaObserverKeeper.GetParams(ctx).GetSupportedChains() {
+		if !chain.IsExternalChain() {
+			continue
+		}
+		var cctxs []types.CrossChainTx
+		switch {
+		case k.zetaObserverKeeper.IsEVMChain(ctx, chain.ChainId):
+			newTssAddress, err := getTssAddrEVM(newTss.TssPubkey)
+			if err != nil {
+				return nil, errorsmod.Wrap(types.ErrCannotMigrateTss, err.Error())
+			}
+
+			// the TSS address of the ERC20 custody contract is updated first as the gas of the update is paid by the
+			// current TSS before the native gas tokens are transferred
+			coreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, chain.ChainId)
+			if found && coreParams.Erc20CustodyContractAddress != "" {
+				cctx, err := k.newTssMigrationCctx(
+					ctx,
+					creator,
+					currentTss,
+					newTss,
+					chain.ChainId,
+					common.CmdMigrateERC20CustodyFunds,
+					newTssAddress.Hex(),
+					coreParams.Erc20CustodyContractAddress,
+					erc20CustodyMigrationGasLimit,
+				)
+				if err != nil {
+					return nil, err
+				}
+				cctxs = append(cctxs, cctx)
+			}
+
+			cctx, err := k.newTssMigrationCctx(
+				ctx,
+				creator,
+				currentTss,
+				newTss,
+				chain.ChainId,
+				common.CmdMigrateTssFunds,
+				newTssAddress.Hex(),
+				newTssAddress.Hex(),
+				tssMigrationGasLimit,
+			)
+			if err != nil {
+				return nil, err
+			}
+			cctxs = append(cctxs, cctx)
+		case k.zetaObserverKeeper.IsBitcoinChain(ctx, chain.ChainId):
+			newTssAddress, err := getTssAddrBTC(newTss.TssPubkey)
+			if err != nil {
+				return nil, errorsmod.Wrap(types.ErrCannotMigrateTss, err.Error())
+			}
+			cctx, err := k.newTssMigrationCctx(
+				ctx,
+				creator,
+				currentTss,
+				newTss,
+				chain.ChainId,
+				common.CmdMigrateTssFunds,
+				newTssAddress,
+				newTssAddress,
+				0,
+			)
+			if err != nil {
+				return nil, err
+			}
+			cctxs = append(cctxs, cctx)
+		}
+
+		for _, cctx := range cctxs {
+			cctx := cctx
+			if err := k.UpdateNonce(ctx, chain.ChainId, &cctx); err != nil {
+				return nil, err
+			}
+			k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+			migrators = append(migrators, types.TssFundMigratorInfo{
+				ChainId:            chain.ChainId,
+				MigrationCctxIndex: cctx.Index,
+			})
+		}
+	}
+
+	indexes := make([]string, 0, len(migrators))
+	for _, migrator := range migrators {
+		k.SetTssFundMigrator(ctx, migrator)
+		indexes = append(indexes, migrator.MigrationCctxIndex)
+	}
+	EmitEventTssMigrationStarted(ctx, msgTypeURL, currentTss, newTss, indexes)
+
+	if len(migrators) == 0 {
+		k.SwitchTss(ctx, newTss, msgTypeURL)
+		return indexes, nil
+	}
+	k.SetPendingMigrationTss(ctx, newTss)
+	return indexes, nil
+}
+
+// newTssMigrationCctx returns a cmd cctx for the outbound of the current TSS migrating its funds to the new TSS
+// the nonce of the outbound is assigned by the caller
+func (k Keeper) newTssMigrationCctx(
+	ctx sdk.Context,
+	creator string,
+	currentTss types.TSS,
+	newTss types.TSS,
+	chainID int64,
+	cmd string,
+	newTssAddress string,
+	receiver string,
+	gasLimit uint64,
+) (types.CrossChainTx, error) {
+	medianGasPrice, found := k.GetMedianGasPriceInUint(ctx, chainID)
+	if !found {
+		return types.CrossChainTx{}, errorsmod.Wrapf(types.ErrUnableToGetGasPrice, "median gas price not found for chain id (%d)", chainID)
+	}
+	medianGasPrice = k.GetParams(ctx).OutboundGasPrice(medianGasPrice, common.CoinType_Cmd)
+
+	// the index is unique for each command of a migration between two TSS on a chain
+	hash := crypto.Keccak256Hash(
+		[]byte(currentTss.TssPubkey),
+		[]byte(newTss.TssPubkey),
+		[]byte(fmt.Sprintf("%d:%d", chainID, ctx.BlockHeight())),
+		[]byte(cmd),
+	)
+
+	cctx := types.CrossChainTx{
+		Creator:        creator,
+		Index:          hash.Hex(),
+		ZetaFees:       sdk.NewUint(0),
+		RelayedMessage: fmt.Sprintf("%s:%s", cmd, newTssAddress),
+		CctxStatus: &types.Status{
+			Status:              types.CctxStatus_PendingOutbound,
+			StatusMessage:       "",
+			LastUpdateTimestamp: ctx.BlockHeader().Time.Unix(),
+		},
+		InboundTxParams: &types.InboundTxParams{
+			Sender:                          "",
+			SenderChainId:                   0,
+			TxOrigin:                        "",
+			CoinType:                        common.CoinType_Cmd,
+			Asset:                           "",
+			Amount:                          math.Uint{},
+			InboundTxObservedHash:           hash.String(),
+			InboundTxObservedExternalHeight: 0,
+			InboundTxBallotIndex:            "",
+			InboundTxFinalizedZetaHeight:    uint64(ctx.BlockHeight()), // #nosec G701 always positive
+		},
+		OutboundTxParams: []*types.OutboundTxParams{
+			{
+				Receiver:                         receiver,
+				ReceiverChainId:                  chainID,
+				CoinType:                         common.CoinType_Cmd,
+				Amount:                           math.NewUint(0),
+				OutboundTxTssNonce:               0,
+				OutboundTxGasLimit:               gasLimit,
+				OutboundTxGasPrice:               medianGasPrice.String(),
+				OutboundTxGasPriorityFee:         k.GetOutboundPriorityFee(ctx, chainID, medianGasPrice).String(),
+				OutboundTxHash:                   "",
+				OutboundTxBallotIndex:            "",
+				OutboundTxObservedExternalHeight: 0,
+				TssPubkey:                        currentTss.TssPubkey,
+			},
+		},
+	}
+	return cctx, nil
+}
+
+// HoldCctxForTssMigration holds the cctx for review if its outbound to an external chain would be signed by the TSS
+// whose funds are being migrated, returns true if the cctx has been held
+// the cctx is released when the migration is completed
+func (k Keeper) HoldCctxForTssMigration(ctx sdk.Context, cctx *types.CrossChainTx, trigger types.StatusTrigger) bool {
+	if !k.IsOutboundHeldForTssMigration(ctx, cctx.GetCurrentOutTxParam().ReceiverChainId) {
+		return false
+	}
+	ChangeCctxStatus(ctx, cctx, types.CctxStatus_PendingReview, types.TssMigrationHoldMessage, trigger)
+	return true
+}
+
+// IsOutboundHeldForTssMigration returns true if an outbound to the chain would be signed by the TSS whose funds are
+// being migrated, such an outbound is held instead of being assigned a nonce
+func (k Keeper) IsOutboundHeldForTssMigration(ctx sdk.Context, receiverChainID int64) bool {
+	if !k.IsTssMigrationInProgress(ctx) {
+		return false
+	}
+	receiverChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(receiverChainID)
+	return receiverChain != nil && receiverChain.IsExternalChain()
+}
+
+// completeTssFundMigration records that the migration cctx has been mined, the pending TSS becomes the current TSS
+// once all the migration cctxs have been mined
+// an aborted migration cctx is re-created with a new nonce of the current TSS up to maxTssMigrationRetries times, if it
+// can't be re-created the migration stays in progress until it is ended by updating the TSS address
+func (k Keeper) completeTssFundMigration(ctx sdk.Context, cctx types.CrossChainTx, msgTypeURL string) {
+	migrator, found := k.GetTssFundMigrator(ctx, cctx.Index)
+	if !found {
+		return
+	}
+	switch cctx.CctxStatus.Status {
+	case types.CctxStatus_OutboundMined:
+	case types.CctxStatus_Aborted:
+		if migrator.RetryCount >= maxTssMigrationRetries {
+			// the failure is left to the admin policy, the aborted cctx stays the migrator of the chain
+			cctx.CctxStatus.StatusMessage = tssMigrationFailedMessage
+			k.SetCrossChainTx(ctx, cctx)
+			ctx.Logger().Error(tssMigrationFailedMessage, "cctx", cctx.Index, "chain", migrator.ChainId, "retries", migrator.RetryCount)
+			return
+		}
+		tmpCtx, commit := ctx.CacheContext()
+		retryIndex, err := k.retryTssFundMigration(tmpCtx, cctx, migrator.RetryCount+1)
+		if err != nil {
+			ctx.Logger().Error("failed to re-create the aborted TSS migration cctx", "cctx", cctx.Index, "error", err)
+			return
+		}
+		commit()
+		ctx.Logger().Info("aborted TSS migration cctx re-created", "cctx", cctx.Index, "retry", retryIndex)
+		return
+	default:
+		return
+	}
+	k.RemoveTssFundMigrator(ctx, cctx.Index)
+	if len(k.GetAllTssFundMigrators(ctx)) > 0 {
+		return
+	}
+	newTss, found := k.GetPendingMigrationTss(ctx)
+	if !found {
+		return
+	}
+	k.SwitchTss(ctx, newTss, msgTypeURL)
+}
+
+// retryTssFundMigration replaces the aborted migration cctx with a cctx running the same command with the next nonce of
+// the current TSS, returns the index of the new cctx
+func (k Keeper) retryTssFundMigration(ctx sdk.Context, cctx types.CrossChainTx, retryCount uint64) (string, error) {
+	currentTss, found := k.GetTSS(ctx)
+	if !found {
+		return "", types.ErrCannotFindTSSKeys
+	}
+	newTss, found := k.GetPendingMigrationTss(ctx)
+	if !found {
+		return "", types.ErrCannotMigrateTss
+	}
+	cmd := strings.SplitN(cctx.RelayedMessage, ":", 2)
+	if len(cmd) != 2 {
+		return "", errorsmod.Wrapf(types.ErrCannotMigrateTss, "invalid migration command %s", cctx.RelayedMessage)
+	}
+
+	outTxParams := cctx.GetCurrentOutTxParam()
+	retry, err := k.newTssMigrationCctx(
+		ctx,
+		cctx.Creator,
+		currentTss,
+		newTss,
+		outTxParams.ReceiverChainId,
+		cmd[0],
+		cmd[1],
+		outTxParams.Receiver,
+		outTxParams.OutboundTxGasLimit,
+	)
+	if err != nil {
+		return "", err
+	}
+	if _, found := k.GetCrossChainTx(ctx, retry.Index); found {
+		return "", errorsmod.Wrapf(types.ErrCannotMigrateTss, "cctx %s already exists", retry.Index)
+	}
+
+	// the new cctx must be a migrator to be assigned a nonce while the migration is in progress
+	k.RemoveTssFundMigrator(ctx, cctx.Index)
+	k.SetTssFundMigrator(ctx, types.TssFundMigratorInfo{
+		ChainId:            outTxParams.ReceiverChainId,
+		MigrationCctxIndex: retry.Index,
+		RetryCount:         retryCount,
+	})
+	if err := k.UpdateNonce(ctx, outTxParams.ReceiverChainId, &retry); err != nil {
+		return "", err
+	}
+	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, retry)
+	return retry.Index, nil
+}
+
+// SwitchTss sets tss as the current TSS and resets the nonces of the supported chains, any migration in progress is
+// ended and the cctxs held until its completion are released
+func (k Keeper) SwitchTss(ctx sdk.Context, tss types.TSS, msgTypeURL string) {
+	previousTss, _ := k.GetTSS(ctx)
+	k.SetTssAndUpdateNonce(ctx, tss)
+	k.RemovePendingMigrationTss(ctx)
+	for _, migrator := range k.GetAllTssFundMigrators(ctx) {
+		k.RemoveTssFundMigrator(ctx, migrator.MigrationCctxIndex)
+	}
+
+	trigger := types.StatusTrigger{MsgTypeURL: msgTypeURL}
+	released := 0
+	for _, cctx := range k.GetAllCctxPendingReview(ctx) {
+		cctx := cctx
+		if !cctx.CctxStatus.IsHeldForTssMigration() {
+			continue
+		}
+		tmpCtx, commit := ctx.CacheContext()
+		if err := k.releaseCctx(tmpCtx, &cctx, "cctx released after the migration of the TSS funds", trigger); err != nil {
+			ctx.Logger().Error("failed to release cctx held for the TSS migration", "cctx", cctx.Index, "error", err)
+			continue
+		}
+		commit()
+		released++
+	}
+	EmitEventTssMigrationCompleted(ctx, msgTypeURL, previousTss, tss, released)
+}
+
+// Queries
+
+// TssMigration queries the migration of the funds of the current TSS to a new TSS
+func (k Keeper) TssMigration(c context.Context, req *types.QueryTssMigrationRequest) (*types.QueryTssMigrationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingTss, found := k.GetPendingMigrationTss(ctx)
+	return &types.QueryTssMigrationResponse{
+		InProgress:       found,
+		PendingTss:       pendingTss,
+		TssFundMigrators: k.GetAllTssFundMigrators(ctx),
+	}, nil
+}
@@ -0,0 +1,360 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/common/cosmos"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// generatedTss returns a TSS with a valid secp256k1 pubkey
+func generatedTss(t *testing.T, finalizedZetaHeight int64) types.TSS {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubKey := &secp256k1.PubKey{Key: crypto.CompressPubkey(&privKey.PublicKey)}
+	tssPubKey, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, pubKey)
+	require.NoError(t, err)
+	return types.TSS{TssPubkey: tssPubKey, FinalizedZetaHeight: finalizedZetaHeight}
+}
+
+// setupTssMigration sets the eth chain as the only supported chain with an ERC20 custody contract, a current TSS and a
+// new TSS generated by a keygen, returns the current TSS, the new TSS and the chain
+func setupTssMigration(t *testing.T, ctx sdk.Context, k *keeper.Keeper, zk keepertest.ZetaKeepers, admin string) (types.TSS, types.TSS, *common.Chain) {
+	chain := getValidEthChain(t)
+	setAdminPolicies(ctx, zk, admin)
+	params := zk.ObserverKeeper.GetParams(ctx)
+	params.ObserverParams = []*observertypes.ObserverParams{{
+		Chain:           chain,
+		IsSupported:     true,
+		BallotThreshold: sdk.MustNewDecFromStr("0.66"),
+	}}
+	zk.ObserverKeeper.SetParams(ctx, params)
+	zk.ObserverKeeper.SetCoreParams(ctx, observertypes.CoreParamsList{
+		CoreParams: []*observertypes.CoreParams{
+			{
+				ChainId:                     chain.ChainId,
+				Erc20CustodyContractAddress: sample.EthAddress().Hex(),
+			},
+		},
+	})
+	k.SetGasPrice(ctx, types.GasPrice{ChainId: chain.ChainId, MedianIndex: 0, Prices: []uint64{10}})
+
+	currentTss := generatedTss(t, 100)
+	newTss := generatedTss(t, 200)
+	k.SetTssAndUpdateNonce(ctx, currentTss)
+	k.SetTSSHistory(ctx, currentTss)
+	k.SetTSSHistory(ctx, newTss)
+	return currentTss, newTss, chain
+}
+
+// mineMigrationCctx processes the successful outbound of the migration cctx
+func mineMigrationCctx(t *testing.T, ctx sdk.Context, k *keeper.Keeper, index string) {
+	cctx, found := k.GetCrossChainTx(ctx, index)
+	require.True(t, found)
+	msg := &types.MsgVoteOnObservedOutboundTx{
+		CctxHash:                       index,
+		ObservedOutTxHash:              sample.Hash().Hex(),
+		ObservedOutTxBlockHeight:       10,
+		ObservedOutTxEffectiveGasPrice: math.ZeroInt(),
+		ValueReceived:                  math.NewUint(1000),
+		Status:                         common.ReceiveStatus_Success,
+		OutTxChain:                     cctx.GetCurrentOutTxParam().ReceiverChainId,
+		OutTxTssNonce:                  cctx.GetCurrentOutTxParam().OutboundTxTssNonce,
+		CoinType:                       common.CoinType_Cmd,
+	}
+	err := k.ProcessOutbound(
+		ctx,
+		cctx,
+		msg,
+		observertypes.BallotStatus_BallotFinalized_SuccessObservation,
+		"ballot",
+		types.StatusTrigger{MsgTypeURL: sdk.MsgTypeURL(msg)},
+	)
+	require.NoError(t, err)
+}
+
+// failMigrationCctx processes the failed outbound of the migration cctx and returns the cctx
+func failMigrationCctx(t *testing.T, ctx sdk.Context, k *keeper.Keeper, index string) types.CrossChainTx {
+	cctx, found := k.GetCrossChainTx(ctx, index)
+	require.True(t, found)
+	msg := &types.MsgVoteOnObservedOutboundTx{
+		CctxHash:                       cctx.Index,
+		ObservedOutTxEffectiveGasPrice: math.ZeroInt(),
+		ValueReceived:                  math.ZeroUint(),
+		Status:                         common.ReceiveStatus_Failed,
+		OutTxChain:                     cctx.GetCurrentOutTxParam().ReceiverChainId,
+		OutTxTssNonce:                  cctx.GetCurrentOutTxParam().OutboundTxTssNonce,
+		CoinType:                       common.CoinType_Cmd,
+	}
+	err := k.ProcessOutbound(ctx, cctx, msg, observertypes.BallotStatus_BallotFinalized_FailureObservation, "ballot", types.StatusTrigger{})
+	require.NoError(t, err)
+
+	cctx, found = k.GetCrossChainTx(ctx, index)
+	require.True(t, found)
+	return cctx
+}
+
+func TestKeeper_MigrateTssFunds(t *testing.T) {
+	t.Run("can start the migration of the tss funds", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		currentTss, newTss, chain := setupTssMigration(t, ctx, k, zk, admin)
+
+		res, err := k.MigrateTssFunds(ctx, types.NewMsgMigrateTssFunds(admin, newTss.TssPubkey))
+		require.NoError(t, err)
+		require.Len(t, res.MigrationCctxIndexes, 2)
+
+		// the TSS address of the custody contract is updated before the gas tokens are transferred
+		for i, cmd := range []string{common.CmdMigrateERC20CustodyFunds, common.CmdMigrateTssFunds} {
+			cctx, found := k.GetCrossChainTx(ctx, res.MigrationCctxIndexes[i])
+			require.True(t, found)
+			require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+			require.Contains(t, cctx.RelayedMessage, fmt.Sprintf("%s:", cmd))
+			require.Equal(t, chain.ChainId, cctx.GetCurrentOutTxParam().ReceiverChainId)
+			require.Equal(t, currentTss.TssPubkey, cctx.GetCurrentOutTxParam().TssPubkey)
+			require.EqualValues(t, i, cctx.GetCurrentOutTxParam().OutboundTxTssNonce)
+		}
+
+		// the current TSS is unchanged until the migration is completed
+		tss, found := k.GetTSS(ctx)
+		require.True(t, found)
+		require.Equal(t, currentTss.TssPubkey, tss.TssPubkey)
+		migration, err := k.TssMigration(ctx, &types.QueryTssMigrationRequest{})
+		require.NoError(t, err)
+		require.True(t, migration.InProgress)
+		require.Equal(t, newTss.TssPubkey, migration.PendingTss.TssPubkey)
+		require.Len(t, migration.TssFundMigrators, 2)
+
+		// a single migration can be in progress
+		_, err = k.MigrateTssFunds(ctx, types.NewMsgMigrateTssFunds(admin, newTss.TssPubkey))
+		require.ErrorIs(t, err, types.ErrTssMigrationInProgress)
+	})
+
+	t.Run("should fail if not authorized", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		_, newTss, _ := setupTssMigration(t, ctx, k, zk, admin)
+
+		_, err := k.MigrateTssFunds(ctx, types.NewMsgMigrateTssFunds(sample.AccAddress(), newTss.TssPubkey))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("should fail if the tss has not been generated or is the current tss", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		currentTss, _, _ := setupTssMigration(t, ctx, k, zk, admin)
+
+		_, err := k.MigrateTssFunds(ctx, types.NewMsgMigrateTssFunds(admin, generatedTss(t, 300).TssPubkey))
+		require.ErrorIs(t, err, types.ErrCannotMigrateTss)
+		_, err = k.MigrateTssFunds(ctx, types.NewMsgMigrateTssFunds(admin, currentTss.TssPubkey))
+		require.ErrorIs(t, err, types.ErrCannotMigrateTss)
+		require.False(t, k.IsTssMigrationInProgress(ctx))
+	})
+}
+
+func TestKeeper_TssMigration(t *testing.T) {
+	t.Run("outbounds are held until the migration is completed", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		_, newTss, chain := setupTssMigration(t, ctx, k, zk, admin)
+		res, err := k.MigrateTssFunds(ctx, types.NewMsgMigrateTssFunds(admin, newTss.TssPubkey))
+		require.NoError(t, err)
+
+		// a new outbound is held
+		cctx := pendingReviewCctx("withdrawal", chain.ChainId)
+		cctx.CctxStatus = &types.Status{Status: types.CctxStatus_PendingOutbound}
+		require.True(t, k.HoldCctxForTssMigration(ctx, &cctx, types.StatusTrigger{}))
+		require.True(t, cctx.CctxStatus.IsHeldForTssMigration())
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+
+		// no other outbound can be scheduled with the TSS being migrated
+		err = k.UpdateNonce(ctx, chain.ChainId, &cctx)
+		require.ErrorIs(t, err, types.ErrTssMigrationInProgress)
+		_, err = k.ReleaseCCTX(ctx, types.NewMsgReleaseCCTX(admin, "withdrawal"))
+		require.ErrorIs(t, err, types.ErrTssMigrationInProgress)
+
+		// the migration is completed once all the migration cctxs are mined
+		mineMigrationCctx(t, ctx, k, res.MigrationCctxIndexes[0])
+		require.True(t, k.IsTssMigrationInProgress(ctx))
+		mineMigrationCctx(t, ctx, k, res.MigrationCctxIndexes[1])
+		require.False(t, k.IsTssMigrationInProgress(ctx))
+		require.Empty(t, k.GetAllTssFundMigrators(ctx))
+
+		migrated, found := k.GetCrossChainTx(ctx, res.MigrationCctxIndexes[1])
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_OutboundMined, migrated.CctxStatus.Status)
+		require.Equal(t, math.NewUint(1000), migrated.GetCurrentOutTxParam().Amount)
+
+		// the new TSS is the current TSS and the held outbound is scheduled with it
+		tss, found := k.GetTSS(ctx)
+		require.True(t, found)
+		require.Equal(t, newTss.TssPubkey, tss.TssPubkey)
+		released, found := k.GetCrossChainTx(ctx, "withdrawal")
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, released.CctxStatus.Status)
+		require.Equal(t, newTss.TssPubkey, released.GetCurrentOutTxParam().TssPubkey)
+		require.EqualValues(t, 0, released.GetCurrentOutTxParam().OutboundTxTssNonce)
+		require.Empty(t, k.GetAllCctxPendingReview(ctx))
+	})
+
+	t.Run("reverts are held until the migration is completed", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		_, newTss, chain := setupTssMigration(t, ctx, k, zk, admin)
+		res, err := k.MigrateTssFunds(ctx, types.NewMsgMigrateTssFunds(admin, newTss.TssPubkey))
+		require.NoError(t, err)
+
+		// the revert of a failed outbound is held instead of being assigned a nonce of the TSS being migrated
+		cctx := pendingReviewCctx("revert", chain.ChainId)
+		cctx.CctxStatus = &types.Status{Status: types.CctxStatus_PendingOutbound}
+		require.True(t, k.IsOutboundHeldForTssMigration(ctx, chain.ChainId))
+		keeper.ChangeCctxStatus(ctx, &cctx, types.CctxStatus_PendingRevert, "Outbound failed, start revert", types.StatusTrigger{})
+		require.True(t, k.HoldCctxForTssMigration(ctx, &cctx, types.StatusTrigger{}))
+		require.True(t, cctx.CctxStatus.IsHeldForTssMigration())
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+
+		// the revert is released back to pending revert with a nonce of the new TSS
+		mineMigrationCctx(t, ctx, k, res.MigrationCctxIndexes[0])
+		mineMigrationCctx(t, ctx, k, res.MigrationCctxIndexes[1])
+		require.False(t, k.IsTssMigrationInProgress(ctx))
+		require.False(t, k.IsOutboundHeldForTssMigration(ctx, chain.ChainId))
+		released, found := k.GetCrossChainTx(ctx, "revert")
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingRevert, released.CctxStatus.Status)
+		require.Equal(t, newTss.TssPubkey, released.GetCurrentOutTxParam().TssPubkey)
+		require.EqualValues(t, 0, released.GetCurrentOutTxParam().OutboundTxTssNonce)
+	})
+
+	t.Run("an aborted migration cctx is re-created with a new nonce", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		currentTss, newTss, chain := setupTssMigration(t, ctx, k, zk, admin)
+		res, err := k.MigrateTssFunds(ctx, types.NewMsgMigrateTssFunds(admin, newTss.TssPubkey))
+		require.NoError(t, err)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+		cctx := failMigrationCctx(t, ctx, k, res.MigrationCctxIndexes[0])
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		require.False(t, k.IsTssFundMigrator(ctx, cctx.Index))
+
+		// the command is scheduled again with the next nonce of the current TSS
+		migrators := k.GetAllTssFundMigrators(ctx)
+		require.Len(t, migrators, 2)
+		var retryIndex string
+		for _, migrator := range migrators {
+			if migrator.MigrationCctxIndex != res.MigrationCctxIndexes[1] {
+				retryIndex = migrator.MigrationCctxIndex
+			}
+		}
+		retry, found := k.GetCrossChainTx(ctx, retryIndex)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, retry.CctxStatus.Status)
+		require.Equal(t, cctx.RelayedMessage, retry.RelayedMessage)
+		require.Equal(t, chain.ChainId, retry.GetCurrentOutTxParam().ReceiverChainId)
+		require.Equal(t, cctx.GetCurrentOutTxParam().Receiver, retry.GetCurrentOutTxParam().Receiver)
+		require.Equal(t, cctx.GetCurrentOutTxParam().OutboundTxGasLimit, retry.GetCurrentOutTxParam().OutboundTxGasLimit)
+		require.Equal(t, currentTss.TssPubkey, retry.GetCurrentOutTxParam().TssPubkey)
+		require.EqualValues(t, 2, retry.GetCurrentOutTxParam().OutboundTxTssNonce)
+		migrator, found := k.GetTssFundMigrator(ctx, retryIndex)
+		require.True(t, found)
+		require.EqualValues(t, 1, migrator.RetryCount)
+
+		// the migration is completed once the re-created cctx is mined
+		mineMigrationCctx(t, ctx, k, res.MigrationCctxIndexes[1])
+		require.True(t, k.IsTssMigrationInProgress(ctx))
+		mineMigrationCctx(t, ctx, k, retryIndex)
+		require.False(t, k.IsTssMigrationInProgress(ctx))
+		tss, found := k.GetTSS(ctx)
+		require.True(t, found)
+		require.Equal(t, newTss.TssPubkey, tss.TssPubkey)
+	})
+
+	t.Run("an aborted migration cctx that can't be re-created keeps the migration in progress", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		currentTss, newTss, chain := setupTssMigration(t, ctx, k, zk, admin)
+		res, err := k.MigrateTssFunds(ctx, types.NewMsgMigrateTssFunds(admin, newTss.TssPubkey))
+		require.NoError(t, err)
+
+		// no gas price to schedule the command again
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+		k.RemoveGasPrice(ctx, fmt.Sprint(chain.ChainId))
+		cctx := failMigrationCctx(t, ctx, k, res.MigrationCctxIndexes[0])
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		require.True(t, k.IsTssMigrationInProgress(ctx))
+		require.True(t, k.IsTssFundMigrator(ctx, cctx.Index))
+		require.Len(t, k.GetAllTssFundMigrators(ctx), 2)
+		tss, found := k.GetTSS(ctx)
+		require.True(t, found)
+		require.Equal(t, currentTss.TssPubkey, tss.TssPubkey)
+	})
+
+	t.Run("an aborted migration cctx is not re-created after the maximum number of retries", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		_, newTss, _ := setupTssMigration(t, ctx, k, zk, admin)
+		res, err := k.MigrateTssFunds(ctx, types.NewMsgMigrateTssFunds(admin, newTss.TssPubkey))
+		require.NoError(t, err)
+
+		// the erc20 custody update fails at each attempt
+		index := res.MigrationCctxIndexes[0]
+		for retry := 1; retry <= 3; retry++ {
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+			failMigrationCctx(t, ctx, k, index)
+			require.False(t, k.IsTssFundMigrator(ctx, index))
+			for _, migrator := range k.GetAllTssFundMigrators(ctx) {
+				if migrator.MigrationCctxIndex != res.MigrationCctxIndexes[1] {
+					index = migrator.MigrationCctxIndex
+				}
+			}
+			migrator, found := k.GetTssFundMigrator(ctx, index)
+			require.True(t, found)
+			require.EqualValues(t, retry, migrator.RetryCount)
+		}
+
+		// the last aborted cctx stays the migrator and is left to the admin policy
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+		cctx := failMigrationCctx(t, ctx, k, index)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		require.Contains(t, cctx.CctxStatus.StatusMessage, "maximum number of retries")
+		require.True(t, k.IsTssFundMigrator(ctx, index))
+		require.Len(t, k.GetAllTssFundMigrators(ctx), 2)
+		require.True(t, k.IsTssMigrationInProgress(ctx))
+
+		// the admin policy ends the migration and the held cctxs are released
+		msgServer := keeper.NewMsgServerImpl(*k)
+		_, err = msgServer.UpdateTssAddress(ctx, &types.MsgUpdateTssAddress{Creator: admin, TssPubkey: newTss.TssPubkey})
+		require.NoError(t, err)
+		require.False(t, k.IsTssMigrationInProgress(ctx))
+	})
+
+	t.Run("updating the tss address ends the migration", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		_, newTss, _ := setupTssMigration(t, ctx, k, zk, admin)
+		_, err := k.MigrateTssFunds(ctx, types.NewMsgMigrateTssFunds(admin, newTss.TssPubkey))
+		require.NoError(t, err)
+
+		msgServer := keeper.NewMsgServerImpl(*k)
+		_, err = msgServer.UpdateTssAddress(ctx, &types.MsgUpdateTssAddress{Creator: admin, TssPubkey: newTss.TssPubkey})
+		require.NoError(t, err)
+
+		require.False(t, k.IsTssMigrationInProgress(ctx))
+		require.Empty(t, k.GetAllTssFundMigrators(ctx))
+		tss, found := k.GetTSS(ctx)
+		require.True(t, found)
+		require.Equal(t, newTss.TssPubkey, tss.TssPubkey)
+	})
+}
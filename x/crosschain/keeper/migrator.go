@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v2 "github.com/zeta-chain/node/x/crosschain/migrations/v2"
 	v3 "github.com/zeta-chain/node/x/crosschain/migrations/v3"
+	v4 "github.com/zeta-chain/node/x/crosschain/migrations/v4"
+	v5 "github.com/zeta-chain/node/x/crosschain/migrations/v5"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -26,3 +28,13 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.crossChainKeeper.storeKey, m.crossChainKeeper.cdc)
 }
+
+// Migrate3to4 migrates the store from consensus version 3 to 4
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.crossChainKeeper)
+}
+
+// Migrate4to5 migrates the store from consensus version 4 to 5
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.crossChainKeeper)
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// MigrateTssFunds starts the migration of the funds of the current TSS to a TSS generated by a keygen
+// a cmd CCTX is created for each supported external chain to transfer the funds to the new TSS, the new TSS becomes
+// the current TSS once all these CCTXs are mined. The outbounds of new CCTXs to external chains are held until then
+// an aborted CCTX is created again with a new nonce of the current TSS
+// Only the admin policy account is authorized to broadcast this message
+func (k Keeper) MigrateTssFunds(goCtx context.Context, msg *types.MsgMigrateTssFunds) (*types.MsgMigrateTssFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group2) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "MigrateTssFunds can only be executed by the correct policy account")
+	}
+
+	tss, found := k.CheckIfTssPubkeyHasBeenGenerated(ctx, msg.TssPubkey)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrCannotMigrateTss, "tss pubkey has not been generated")
+	}
+
+	tmpCtx, commit := ctx.CacheContext()
+	indexes, err := k.InitiateTssMigration(tmpCtx, msg.Creator, sdk.MsgTypeURL(msg), tss)
+	if err != nil {
+		return nil, err
+	}
+	commit()
+
+	return &types.MsgMigrateTssFundsResponse{MigrationCctxIndexes: indexes}, nil
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// RefundAbortedCCTX refunds the amount of an aborted CCTX on ZetaChain to the zEVM address of its sender
+// the amount is deposited as ZETA or as the ZRC20 of the asset of the CCTX, the CCTX is then flagged as refunded and
+// can't be refunded nor retried anymore
+// Only the admin policy account is authorized to broadcast this message
+func (k Keeper) RefundAbortedCCTX(goCtx context.Context, msg *types.MsgRefundAbortedCCTX) (*types.MsgRefundAbortedCCTXResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group1) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "RefundAbortedCCTX can only be executed by the correct policy account")
+	}
+
+	cctx, found := k.GetCrossChainTx(ctx, msg.CctxIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCannotFindCctx, "cctx %s not found", msg.CctxIndex)
+	}
+	if err := checkRemediableCctx(cctx); err != nil {
+		return nil, err
+	}
+
+	refundAddress, err := k.GetAbortedCctxRefundAddress(ctx, cctx)
+	if err != nil {
+		return nil, err
+	}
+	amount := GetAbortedCctxAmount(cctx)
+	if amount.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrCannotRefundCctx, "no amount to refund")
+	}
+
+	switch cctx.InboundTxParams.CoinType {
+	case common.CoinType_Zeta:
+		if err := k.fungibleKeeper.DepositCoinZeta(ctx, refundAddress, amount.BigInt()); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrCannotRefundCctx, "failed to deposit zeta on ZetaChain: %s", err.Error())
+		}
+	case common.CoinType_Gas, common.CoinType_ERC20:
+		zrc20, err := k.getAbortedCctxZRC20(ctx, cctx)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := k.fungibleKeeper.DepositZRC20(ctx, zrc20, refundAddress, amount.BigInt()); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrCannotRefundCctx, "failed to deposit zrc20 on ZetaChain: %s", err.Error())
+		}
+	default:
+		return nil, errorsmod.Wrapf(types.ErrCannotRefundCctx, "coin type %s can't be refunded", cctx.InboundTxParams.CoinType.String())
+	}
+
+	cctx.IsAbortRefunded = true
+	cctx.CctxStatus.LastUpdateTimestamp = ctx.BlockHeader().Time.Unix()
+	k.SetCrossChainTx(ctx, cctx)
+	EmitEventAbortedCctxRefunded(ctx, msg, cctx, refundAddress.Hex(), amount)
+
+	return &types.MsgRefundAbortedCCTXResponse{
+		RefundAddress: refundAddress.Hex(),
+		Amount:        amount,
+	}, nil
+}
+
+// checkRemediableCctx returns an error if the CCTX is not aborted or if its amount has already been refunded
+func checkRemediableCctx(cctx types.CrossChainTx) error {
+	if cctx.CctxStatus.Status != types.CctxStatus_Aborted {
+		return errorsmod.Wrapf(types.ErrCctxNotAborted, "cctx %s has status %s", cctx.Index, cctx.CctxStatus.Status.String())
+	}
+	if cctx.IsAbortRefunded {
+		return errorsmod.Wrapf(types.ErrAbortedCctxRefunded, "cctx %s", cctx.Index)
+	}
+	return nil
+}
+
+// GetAbortedCctxAmount returns the amount of an aborted CCTX that has not been delivered
+// it is the amount of the current outbound, or the inbound amount if no outbound amount has been set because the CCTX
+// was aborted before paying the gas of its outbound
+func GetAbortedCctxAmount(cctx types.CrossChainTx) math.Uint {
+	amount := cctx.GetCurrentOutTxParam().Amount
+	if amount.IsNil() || amount.IsZero() {
+		amount = cctx.InboundTxParams.Amount
+	}
+	if amount.IsNil() {
+		return math.ZeroUint()
+	}
+	return amount
+}
+
+// GetAbortedCctxRefundAddress returns the zEVM address the amount of an aborted CCTX is refunded to
+// it is the sender of a CCTX from an EVM chain and the origin of the transaction of a CCTX from ZetaChain since the
+// sender is then the contract emitting the withdrawal
+func (k Keeper) GetAbortedCctxRefundAddress(ctx sdk.Context, cctx types.CrossChainTx) (ethcommon.Address, error) {
+	var address string
+	switch {
+	case cctx.InboundTxParams.SenderChainId == common.ZetaChain().ChainId:
+		address = cctx.InboundTxParams.TxOrigin
+	case k.zetaObserverKeeper.IsEVMChain(ctx, cctx.InboundTxParams.SenderChainId):
+		address = cctx.InboundTxParams.Sender
+	default:
+		return ethcommon.Address{}, errorsmod.Wrapf(
+			types.ErrCannotRefundCctx,
+			"sender of chain %d has no zEVM address",
+			cctx.InboundTxParams.SenderChainId,
+		)
+	}
+	if !ethcommon.IsHexAddress(address) || ethcommon.HexToAddress(address) == (ethcommon.Address{}) {
+		return ethcommon.Address{}, errorsmod.Wrapf(types.ErrCannotRefundCctx, "invalid refund address %s", address)
+	}
+	return ethcommon.HexToAddress(address), nil
+}
+
+// getAbortedCctxZRC20 returns the ZRC20 of the asset of an aborted CCTX
+// the asset of a CCTX from ZetaChain is the asset of the chain it is withdrawn to
+func (k Keeper) getAbortedCctxZRC20(ctx sdk.Context, cctx types.CrossChainTx) (ethcommon.Address, error) {
+	chainID := cctx.InboundTxParams.SenderChainId
+	if chainID == common.ZetaChain().ChainId {
+		chainID = cctx.OutboundTxParams[0].ReceiverChainId
+	}
+
+	if cctx.InboundTxParams.CoinType == common.CoinType_Gas {
+		zrc20, err := k.fungibleKeeper.QuerySystemContractGasCoinZRC20(ctx, big.NewInt(chainID))
+		if err != nil {
+			return ethcommon.Address{}, errorsmod.Wrapf(types.ErrCannotRefundCctx, "gas zrc20 of chain %d not found: %s", chainID, err.Error())
+		}
+		return zrc20, nil
+	}
+
+	fc, found := k.fungibleKeeper.GetForeignCoinFromAsset(ctx, cctx.InboundTxParams.Asset, chainID)
+	if !found {
+		return ethcommon.Address{}, errorsmod.Wrapf(types.ErrCannotRefundCctx, "zrc20 of asset %s of chain %d not found", cctx.InboundTxParams.Asset, chainID)
+	}
+	zrc20 := ethcommon.HexToAddress(fc.Zrc20ContractAddress)
+	if zrc20 == (ethcommon.Address{}) {
+		return ethcommon.Address{}, errorsmod.Wrapf(types.ErrCannotRefundCctx, "invalid zrc20 address of asset %s", cctx.InboundTxParams.Asset)
+	}
+	return zrc20, nil
+}
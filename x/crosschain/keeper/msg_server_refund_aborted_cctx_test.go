@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+// abortedCctx returns an aborted cctx whose outbound to the receiver chain failed
+func abortedCctx(index string, inbound types.InboundTxParams, receiverChainID int64, outboundAmount math.Uint) types.CrossChainTx {
+	return types.CrossChainTx{
+		Index:           index,
+		CctxStatus:      &types.Status{Status: types.CctxStatus_Aborted},
+		InboundTxParams: &inbound,
+		OutboundTxParams: []*types.OutboundTxParams{
+			{
+				ReceiverChainId:             receiverChainID,
+				CoinType:                    inbound.CoinType,
+				Amount:                      outboundAmount,
+				OutboundTxGasPrice:          "1",
+				OutboundTxHash:              "0x123",
+				OutboundTxBallotIndex:       "ballot",
+				OutboundTxEffectiveGasPrice: math.NewInt(1),
+			},
+		},
+	}
+}
+
+func TestKeeper_RefundAbortedCCTX(t *testing.T) {
+	t.Run("can refund the erc20 of an aborted cctx", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		chainID := getValidEthChainID(t)
+		asset := sample.EthAddress().Hex()
+		sender := sample.EthAddress()
+
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		zrc20 := deployZRC20(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "bar", asset, "bar")
+		k.SetCrossChainTx(ctx, abortedCctx("aborted", types.InboundTxParams{
+			Sender:        sender.Hex(),
+			SenderChainId: chainID,
+			CoinType:      common.CoinType_ERC20,
+			Asset:         asset,
+			Amount:        math.NewUint(100),
+		}, chainID, math.NewUint(42)))
+
+		res, err := k.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, "aborted"))
+		require.NoError(t, err)
+		require.Equal(t, sender.Hex(), res.RefundAddress)
+		require.Equal(t, math.NewUint(42), res.Amount)
+
+		balance, err := zk.FungibleKeeper.BalanceOfZRC4(ctx, zrc20, sender)
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), balance.Uint64())
+		cctx, found := k.GetCrossChainTx(ctx, "aborted")
+		require.True(t, found)
+		require.True(t, cctx.IsAbortRefunded)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+
+		// can't be refunded nor retried twice
+		_, err = k.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, "aborted"))
+		require.ErrorIs(t, err, types.ErrAbortedCctxRefunded)
+		_, err = k.RetryAbortedCCTX(ctx, types.NewMsgRetryAbortedCCTX(admin, "aborted"))
+		require.ErrorIs(t, err, types.ErrAbortedCctxRefunded)
+		balance, err = zk.FungibleKeeper.BalanceOfZRC4(ctx, zrc20, sender)
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), balance.Uint64())
+	})
+
+	t.Run("can refund the zeta of an aborted cctx", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		chainID := getValidEthChainID(t)
+		sender := sample.EthAddress()
+
+		k.SetCrossChainTx(ctx, abortedCctx("aborted", types.InboundTxParams{
+			Sender:        sender.Hex(),
+			SenderChainId: chainID,
+			CoinType:      common.CoinType_Zeta,
+			Amount:        math.NewUint(100),
+		}, chainID, math.NewUint(42)))
+
+		_, err := k.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, "aborted"))
+		require.NoError(t, err)
+		balance := sdkk.BankKeeper.GetBalance(ctx, sdk.AccAddress(sender.Bytes()), common.ZETADenom)
+		require.Equal(t, int64(42), balance.Amount.Int64())
+	})
+
+	t.Run("can refund the gas zrc20 of a withdrawal from ZetaChain aborted before paying gas", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		chainID := getValidEthChainID(t)
+		txOrigin := sample.EthAddress()
+
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+		k.SetCrossChainTx(ctx, abortedCctx("aborted", types.InboundTxParams{
+			Sender:        zrc20.Hex(),
+			SenderChainId: common.ZetaChain().ChainId,
+			TxOrigin:      txOrigin.Hex(),
+			CoinType:      common.CoinType_Gas,
+			Amount:        math.NewUint(100),
+		}, chainID, math.ZeroUint()))
+
+		res, err := k.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, "aborted"))
+		require.NoError(t, err)
+		require.Equal(t, txOrigin.Hex(), res.RefundAddress)
+		balance, err := zk.FungibleKeeper.BalanceOfZRC4(ctx, zrc20, txOrigin)
+		require.NoError(t, err)
+		require.Equal(t, uint64(100), balance.Uint64())
+	})
+
+	t.Run("should fail if not authorized", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setAdminPolicies(ctx, zk, sample.AccAddress())
+
+		_, err := k.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(sample.AccAddress(), "aborted"))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("should fail if the cctx is not found or not aborted", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		cctx := abortedCctx("pending", types.InboundTxParams{
+			Sender:        sample.EthAddress().Hex(),
+			SenderChainId: getValidEthChainID(t),
+			CoinType:      common.CoinType_Zeta,
+			Amount:        math.NewUint(100),
+		}, getValidEthChainID(t), math.NewUint(42))
+		cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+		k.SetCrossChainTx(ctx, cctx)
+
+		_, err := k.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, "not_found"))
+		require.ErrorIs(t, err, types.ErrCannotFindCctx)
+		_, err = k.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, "pending"))
+		require.ErrorIs(t, err, types.ErrCctxNotAborted)
+	})
+
+	t.Run("should fail if the sender has no zEVM address", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		k.SetCrossChainTx(ctx, abortedCctx("aborted", types.InboundTxParams{
+			Sender:        "bc1qysd4sp9q8my59ul9wsf5rvs9p387hf8vfwatzu",
+			SenderChainId: common.BtcChainID(),
+			CoinType:      common.CoinType_Gas,
+			Amount:        math.NewUint(100),
+		}, common.BtcChainID(), math.NewUint(42)))
+
+		_, err := k.RefundAbortedCCTX(ctx, types.NewMsgRefundAbortedCCTX(admin, "aborted"))
+		require.ErrorIs(t, err, types.ErrCannotRefundCctx)
+	})
+}
+
+func TestGetAbortedCctxAmount(t *testing.T) {
+	cctx := abortedCctx("aborted", types.InboundTxParams{Amount: math.NewUint(100)}, 1, math.NewUint(42))
+	require.Equal(t, math.NewUint(42), keeper.GetAbortedCctxAmount(cctx))
+
+	cctx.GetCurrentOutTxParam().Amount = math.ZeroUint()
+	require.Equal(t, math.NewUint(100), keeper.GetAbortedCctxAmount(cctx))
+
+	cctx.GetCurrentOutTxParam().Amount = math.Uint{}
+	cctx.InboundTxParams.Amount = math.Uint{}
+	require.True(t, keeper.GetAbortedCctxAmount(cctx).IsZero())
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// ReleaseCCTX releases a CCTX held for review because it exceeded a rate limit
+// a CCTX held when its inbound was finalized is processed like a finalized inbound, a CCTX held when it was created
+// from a withdrawal is scheduled for its outbound with a new nonce and the current gas price of the receiver chain
+// a CCTX with an outbound to an external chain can't be released while the funds of the TSS are being migrated
+// Only the admin policy account is authorized to broadcast this message
+func (k Keeper) ReleaseCCTX(goCtx context.Context, msg *types.MsgReleaseCCTX) (*types.MsgReleaseCCTXResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group2) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "ReleaseCCTX can only be executed by the correct policy account")
+	}
+
+	cctx, found := k.GetCrossChainTx(ctx, msg.CctxIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCannotFindCctx, "cctx %s not found", msg.CctxIndex)
+	}
+	if cctx.CctxStatus.Status != types.CctxStatus_PendingReview {
+		return nil, errorsmod.Wrapf(types.ErrCctxNotPendingReview, "cctx %s has status %s", cctx.Index, cctx.CctxStatus.Status.String())
+	}
+
+	// an outbound to an external chain can't be scheduled until the migration of the TSS funds is completed
+	if k.IsOutboundHeldForTssMigration(ctx, cctx.GetCurrentOutTxParam().ReceiverChainId) {
+		return nil, errorsmod.Wrapf(types.ErrTssMigrationInProgress, "cctx %s can't be released", cctx.Index)
+	}
+
+	trigger := types.StatusTrigger{MsgTypeURL: sdk.MsgTypeURL(msg)}
+	if err := k.releaseCctx(ctx, &cctx, "cctx released by admin policy", trigger); err != nil {
+		return nil, err
+	}
+	EmitEventCctxReleased(ctx, msg, cctx)
+
+	return &types.MsgReleaseCCTXResponse{}, nil
+}
+
+// releaseCctx releases a CCTX held for review to the status it was held from and saves it
+func (k Keeper) releaseCctx(ctx sdk.Context, cctx *types.CrossChainTx, message string, trigger types.StatusTrigger) error {
+	var err error
+	if GetCctxReviewedStatus(*cctx) == types.CctxStatus_PendingInbound {
+		err = k.releaseInboundCctx(ctx, cctx, message, trigger)
+	} else {
+		err = k.releaseOutboundCctx(ctx, cctx, message, trigger)
+	}
+	if err != nil {
+		return err
+	}
+
+	cctx.CctxStatus.LastUpdateTimestamp = ctx.BlockHeader().Time.Unix()
+	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, *cctx)
+	return nil
+}
+
+// GetCctxReviewedStatus returns the status a CCTX pending review was held from
+// if the transition is not recorded, a CCTX from ZetaChain is held from its outbound and other CCTXs from their inbound
+func GetCctxReviewedStatus(cctx types.CrossChainTx) types.CctxStatus {
+	transitions := cctx.CctxStatus.Transitions
+	for i := len(transitions) - 1; i >= 0; i-- {
+		if transitions[i].NewStatus == types.CctxStatus_PendingReview {
+			return transitions[i].OldStatus
+		}
+	}
+	if cctx.InboundTxParams.SenderChainId == common.ZetaChain().ChainId {
+		return types.CctxStatus_PendingOutbound
+	}
+	return types.CctxStatus_PendingInbound
+}
+
+// releaseInboundCctx processes the finalized inbound of a CCTX held for review
+func (k Keeper) releaseInboundCctx(ctx sdk.Context, cctx *types.CrossChainTx, message string, trigger types.StatusTrigger) error {
+	observationChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(cctx.InboundTxParams.SenderChainId)
+	if observationChain == nil {
+		return errorsmod.Wrapf(types.ErrUnsupportedChain, "sender chain %d", cctx.InboundTxParams.SenderChainId)
+	}
+	outTxParams := cctx.GetCurrentOutTxParam()
+	receiverChain := k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(outTxParams.ReceiverChainId)
+	if receiverChain == nil {
+		return errorsmod.Wrapf(types.ErrUnsupportedChain, "receiver chain %d", outTxParams.ReceiverChainId)
+	}
+
+	// the inbound is processed from the vote that finalized it
+	msg := types.NewMsgVoteOnObservedInboundTx(
+		cctx.Creator,
+		cctx.InboundTxParams.Sender,
+		cctx.InboundTxParams.SenderChainId,
+		cctx.InboundTxParams.TxOrigin,
+		outTxParams.Receiver,
+		outTxParams.ReceiverChainId,
+		cctx.InboundTxParams.Amount,
+		cctx.RelayedMessage,
+		cctx.InboundTxParams.InboundTxObservedHash,
+		cctx.InboundTxParams.InboundTxObservedExternalHeight,
+		outTxParams.OutboundTxGasLimit,
+		cctx.InboundTxParams.CoinType,
+		cctx.InboundTxParams.Asset,
+	)
+
+	ChangeCctxStatus(ctx, cctx, types.CctxStatus_PendingInbound, message, trigger)
+	k.processInboundCctx(ctx, cctx, msg, observationChain, receiverChain, trigger)
+	return nil
+}
+
+// releaseOutboundCctx schedules the outbound of a CCTX held for review
+func (k Keeper) releaseOutboundCctx(ctx sdk.Context, cctx *types.CrossChainTx, message string, trigger types.StatusTrigger) error {
+	outTxParams := cctx.GetCurrentOutTxParam()
+	receiverChainID := outTxParams.ReceiverChainId
+	tss, found := k.GetTSS(ctx)
+	if !found {
+		return types.ErrCannotFindTSSKeys
+	}
+	gasPrice, found := k.GetMedianGasPriceInUint(ctx, receiverChainID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnableToGetGasPrice, "chain %d", receiverChainID)
+	}
+
+	outTxParams.TssPubkey = tss.TssPubkey
+	outTxParams.OutboundTxGasPrice = gasPrice.String()
+	outTxParams.OutboundTxGasPriorityFee = k.GetOutboundPriorityFee(ctx, receiverChainID, gasPrice).String()
+	if err := k.UpdateNonce(ctx, receiverChainID, cctx); err != nil {
+		return err
+	}
+
+	// a revert is released back to pending revert
+	ChangeCctxStatus(ctx, cctx, GetCctxReviewedStatus(*cctx), message, trigger)
+	return nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+// pendingReviewCctx returns a withdrawal from ZetaChain held for review when it was created
+func pendingReviewCctx(index string, receiverChainID int64) types.CrossChainTx {
+	return types.CrossChainTx{
+		Index: index,
+		CctxStatus: &types.Status{
+			Status: types.CctxStatus_PendingReview,
+			Transitions: []*types.StatusTransition{
+				{
+					OldStatus: types.CctxStatus_PendingOutbound,
+					NewStatus: types.CctxStatus_PendingReview,
+				},
+			},
+		},
+		InboundTxParams: &types.InboundTxParams{
+			Sender:        sample.EthAddress().Hex(),
+			SenderChainId: common.ZetaChain().ChainId,
+			CoinType:      common.CoinType_Gas,
+			Amount:        math.NewUint(100),
+		},
+		OutboundTxParams: []*types.OutboundTxParams{
+			{
+				Receiver:        sample.EthAddress().Hex(),
+				ReceiverChainId: receiverChainID,
+				CoinType:        common.CoinType_Gas,
+				Amount:          math.NewUint(100),
+			},
+		},
+	}
+}
+
+func TestKeeper_ReleaseCCTX(t *testing.T) {
+	t.Run("can release a withdrawal held for review", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		chain := getValidEthChain(t)
+		tss := sample.Tss()
+		k.SetTssAndUpdateNonce(ctx, *tss)
+		k.SetGasPrice(ctx, types.GasPrice{ChainId: chain.ChainId, MedianIndex: 0, Prices: []uint64{10}})
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, pendingReviewCctx("held", chain.ChainId))
+		require.Len(t, k.GetAllCctxPendingReview(ctx), 1)
+
+		_, err := k.ReleaseCCTX(ctx, types.NewMsgReleaseCCTX(admin, "held"))
+		require.NoError(t, err)
+
+		cctx, found := k.GetCrossChainTx(ctx, "held")
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.Len(t, cctx.CctxStatus.Transitions, 2)
+		require.Equal(t, "/zetachain.zetacore.crosschain.MsgReleaseCCTX", cctx.CctxStatus.Transitions[1].MsgTypeUrl)
+		require.Equal(t, "10", cctx.GetCurrentOutTxParam().OutboundTxGasPrice)
+		require.Equal(t, tss.TssPubkey, cctx.GetCurrentOutTxParam().TssPubkey)
+		nonceToCctx, found := k.GetNonceToCctx(ctx, tss.TssPubkey, chain.ChainId, 0)
+		require.True(t, found)
+		require.Equal(t, "held", nonceToCctx.CctxIndex)
+		require.Empty(t, k.GetAllCctxPendingReview(ctx))
+
+		// the cctx is no longer pending review
+		_, err = k.ReleaseCCTX(ctx, types.NewMsgReleaseCCTX(admin, "held"))
+		require.ErrorIs(t, err, types.ErrCctxNotPendingReview)
+	})
+
+	t.Run("can release an inbound held for review", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		chain := getValidEthChain(t)
+		zetaChain := common.ZetaChain()
+		receiver := sample.EthAddress()
+		k.SetRateLimit(ctx, types.RateLimit{ChainId: chain.ChainId, Window: 10, MaxCctxCount: 1})
+		exceeded, _ := k.CheckRateLimits(ctx, chain.ChainId, "", math.ZeroUint())
+		require.False(t, exceeded)
+
+		msg := zetaDepositMsg(chain.ChainId, receiver, 42)
+		require.NoError(t, k.ProcessInbound(ctx, msg, msg.Digest(), "", chain, &zetaChain, types.StatusTrigger{}))
+		cctx, found := k.GetCrossChainTx(ctx, msg.Digest())
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingReview, cctx.CctxStatus.Status)
+
+		_, err := k.ReleaseCCTX(ctx, types.NewMsgReleaseCCTX(admin, msg.Digest()))
+		require.NoError(t, err)
+		cctx, found = k.GetCrossChainTx(ctx, msg.Digest())
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_OutboundMined, cctx.CctxStatus.Status)
+		require.Empty(t, k.GetAllCctxPendingReview(ctx))
+		balance := sdkk.BankKeeper.GetBalance(ctx, sdk.AccAddress(receiver.Bytes()), common.ZETADenom)
+		require.Equal(t, int64(42), balance.Amount.Int64())
+	})
+
+	t.Run("should fail if not authorized", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setAdminPolicies(ctx, zk, sample.AccAddress())
+
+		_, err := k.ReleaseCCTX(ctx, types.NewMsgReleaseCCTX(sample.AccAddress(), "held"))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("should fail if the cctx is not found", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+
+		_, err := k.ReleaseCCTX(ctx, types.NewMsgReleaseCCTX(admin, "held"))
+		require.ErrorIs(t, err, types.ErrCannotFindCctx)
+	})
+
+	t.Run("should fail if the gas price of the chain is not found", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		k.SetTssAndUpdateNonce(ctx, *sample.Tss())
+		k.SetCrossChainTx(ctx, pendingReviewCctx("held", getValidEthChainID(t)))
+
+		_, err := k.ReleaseCCTX(ctx, types.NewMsgReleaseCCTX(admin, "held"))
+		require.ErrorIs(t, err, types.ErrUnableToGetGasPrice)
+	})
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// RetryAbortedCCTX schedules again the outbound of an aborted CCTX with a new nonce and the current gas price of the
+// receiver chain, the CCTX is pending again for its outbound or for its revert depending on the aborted outbound
+// The gas of the new outbound is paid from the amount of the aborted CCTX, whether or not the gas of the aborted
+// outbound was paid, since a CCTX can be aborted after its gas payment was discarded
+// Only the admin policy account is authorized to broadcast this message
+func (k Keeper) RetryAbortedCCTX(goCtx context.Context, msg *types.MsgRetryAbortedCCTX) (*types.MsgRetryAbortedCCTXResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group1) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "RetryAbortedCCTX can only be executed by the correct policy account")
+	}
+
+	cctx, found := k.GetCrossChainTx(ctx, msg.CctxIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrCannotFindCctx, "cctx %s not found", msg.CctxIndex)
+	}
+	if err := checkRemediableCctx(cctx); err != nil {
+		return nil, err
+	}
+
+	outTxParams := cctx.GetCurrentOutTxParam()
+	receiverChainID := outTxParams.ReceiverChainId
+	if receiverChainID == common.ZetaChain().ChainId {
+		return nil, errorsmod.Wrapf(types.ErrCannotRetryCctx, "outbound of cctx %s is on ZetaChain", cctx.Index)
+	}
+	tss, found := k.GetTSS(ctx)
+	if !found {
+		return nil, types.ErrCannotFindTSSKeys
+	}
+	amount := GetAbortedCctxAmount(cctx)
+	if amount.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrCannotRetryCctx, "no amount to pay the gas of cctx %s", cctx.Index)
+	}
+
+	// reset the observation of the aborted outbound
+	outTxParams.OutboundTxHash = ""
+	outTxParams.OutboundTxBallotIndex = ""
+	outTxParams.OutboundTxObservedExternalHeight = 0
+	outTxParams.OutboundTxGasUsed = 0
+	outTxParams.OutboundTxEffectiveGasPrice = math.ZeroInt()
+	outTxParams.OutboundTxEffectiveGasLimit = 0
+
+	// pay the gas of the new outbound and schedule it
+	if err := k.PayGasAndUpdateCctx(ctx, receiverChainID, &cctx, amount, false); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrCannotRetryCctx, "failed to pay gas of cctx %s: %s", cctx.Index, err.Error())
+	}
+	outTxParams.TssPubkey = tss.TssPubkey
+	if err := k.UpdateNonce(ctx, receiverChainID, &cctx); err != nil {
+		return nil, err
+	}
+
+	newStatus := types.CctxStatus_PendingOutbound
+	if len(cctx.OutboundTxParams) > 1 {
+		newStatus = types.CctxStatus_PendingRevert
+	}
+	ChangeCctxStatus(ctx, &cctx, newStatus, "aborted cctx retried by admin policy", types.StatusTrigger{MsgTypeURL: sdk.MsgTypeURL(msg)})
+	cctx.CctxStatus.LastUpdateTimestamp = ctx.BlockHeader().Time.Unix()
+	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+	EmitEventAbortedCctxRetried(ctx, msg, cctx)
+
+	return &types.MsgRetryAbortedCCTXResponse{Nonce: outTxParams.OutboundTxTssNonce}, nil
+}
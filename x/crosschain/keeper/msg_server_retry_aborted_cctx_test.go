@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+// setupRetryGasPayment deploys the gas coin of the chain and sets its withdraw fee and gas price so the gas of a
+// retried outbound can be paid
+func setupRetryGasPayment(t *testing.T, ctx sdk.Context, k *keeper.Keeper, sdkk keepertest.SDKKeepers, zk keepertest.ZetaKeepers, admin string, chainID int64) {
+	k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+	deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+	zrc20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+	_, err := zk.FungibleKeeper.UpdateZRC20WithdrawFee(
+		sdk.UnwrapSDKContext(ctx),
+		fungibletypes.NewMsgUpdateZRC20WithdrawFee(admin, zrc20.String(), sdk.NewUint(withdrawFee), math.Uint{}),
+	)
+	require.NoError(t, err)
+	k.SetGasPrice(ctx, types.GasPrice{ChainId: chainID, MedianIndex: 0, Prices: []uint64{gasPrice}})
+}
+
+func TestKeeper_RetryAbortedCCTX(t *testing.T) {
+	inbound := func(t *testing.T) types.InboundTxParams {
+		return types.InboundTxParams{
+			Sender:        sample.EthAddress().Hex(),
+			SenderChainId: getValidEthChainID(t),
+			CoinType:      common.CoinType_Gas,
+			Amount:        math.NewUint(inputAmount),
+		}
+	}
+
+	t.Run("can retry the outbound of an aborted cctx", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		chain := getValidEthChain(t)
+		tss := sample.Tss()
+		k.SetTssAndUpdateNonce(ctx, *tss)
+		setupRetryGasPayment(t, ctx, k, sdkk, zk, admin, chain.ChainId)
+		k.SetCrossChainTx(ctx, abortedCctx("aborted", inbound(t), chain.ChainId, math.NewUint(inputAmount)))
+
+		res, err := k.RetryAbortedCCTX(ctx, types.NewMsgRetryAbortedCCTX(admin, "aborted"))
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), res.Nonce)
+
+		cctx, found := k.GetCrossChainTx(ctx, "aborted")
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.Len(t, cctx.CctxStatus.Transitions, 1)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Transitions[0].OldStatus)
+		require.Equal(t, "/zetachain.zetacore.crosschain.MsgRetryAbortedCCTX", cctx.CctxStatus.Transitions[0].MsgTypeUrl)
+		outTxParams := cctx.GetCurrentOutTxParam()
+		require.Equal(t, "2", outTxParams.OutboundTxGasPrice)
+		require.Equal(t, uint64(21_000), outTxParams.OutboundTxGasLimit)
+		require.Equal(t, tss.TssPubkey, outTxParams.TssPubkey)
+		require.Empty(t, outTxParams.OutboundTxHash)
+		require.Empty(t, outTxParams.OutboundTxBallotIndex)
+		require.True(t, outTxParams.OutboundTxEffectiveGasPrice.IsZero())
+
+		// the gas is paid again from the amount of the aborted outbound: 100000-(21000*2+1000)=57000
+		require.Equal(t, math.NewUint(57000), outTxParams.Amount)
+
+		// the nonce is assigned to the cctx
+		nonceToCctx, found := k.GetNonceToCctx(ctx, tss.TssPubkey, chain.ChainId, 0)
+		require.True(t, found)
+		require.Equal(t, "aborted", nonceToCctx.CctxIndex)
+		chainNonces, found := k.GetChainNonces(ctx, chain.ChainName.String())
+		require.True(t, found)
+		require.Equal(t, uint64(1), chainNonces.Nonce)
+		pendingNonces, found := k.GetPendingNonces(ctx, tss.TssPubkey, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, int64(1), pendingNonces.NonceHigh)
+
+		// the cctx is no longer aborted
+		_, err = k.RetryAbortedCCTX(ctx, types.NewMsgRetryAbortedCCTX(admin, "aborted"))
+		require.ErrorIs(t, err, types.ErrCctxNotAborted)
+	})
+
+	t.Run("can retry the revert of an aborted cctx", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		chainID := getValidEthChainID(t)
+		k.SetTssAndUpdateNonce(ctx, *sample.Tss())
+		setupRetryGasPayment(t, ctx, k, sdkk, zk, admin, chainID)
+		cctx := abortedCctx("aborted", inbound(t), chainID, math.NewUint(inputAmount))
+		cctx.OutboundTxParams = append(cctx.OutboundTxParams, &types.OutboundTxParams{
+			ReceiverChainId:    chainID,
+			Amount:             math.NewUint(inputAmount - 1),
+			OutboundTxGasPrice: "1",
+		})
+		k.SetCrossChainTx(ctx, cctx)
+
+		_, err := k.RetryAbortedCCTX(ctx, types.NewMsgRetryAbortedCCTX(admin, "aborted"))
+		require.NoError(t, err)
+		cctx, found := k.GetCrossChainTx(ctx, "aborted")
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingRevert, cctx.CctxStatus.Status)
+		require.Equal(t, "2", cctx.GetCurrentOutTxParam().OutboundTxGasPrice)
+		require.Equal(t, math.NewUint(inputAmount-1-43000), cctx.GetCurrentOutTxParam().Amount)
+		require.Equal(t, "1", cctx.OutboundTxParams[0].OutboundTxGasPrice)
+	})
+
+	t.Run("can retry a cctx aborted before its gas payment was committed", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		chainID := getValidEthChainID(t)
+		k.SetTssAndUpdateNonce(ctx, *sample.Tss())
+		setupRetryGasPayment(t, ctx, k, sdkk, zk, admin, chainID)
+
+		// the gas price is set on the outbound although the gas payment was discarded
+		cctx := abortedCctx("aborted", inbound(t), chainID, math.ZeroUint())
+		k.SetCrossChainTx(ctx, cctx)
+
+		_, err := k.RetryAbortedCCTX(ctx, types.NewMsgRetryAbortedCCTX(admin, "aborted"))
+		require.NoError(t, err)
+		cctx, found := k.GetCrossChainTx(ctx, "aborted")
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.Equal(t, math.NewUint(57000), cctx.GetCurrentOutTxParam().Amount)
+	})
+
+	t.Run("should fail if not authorized", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setAdminPolicies(ctx, zk, sample.AccAddress())
+
+		_, err := k.RetryAbortedCCTX(ctx, types.NewMsgRetryAbortedCCTX(sample.AccAddress(), "aborted"))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("should fail if the cctx has been refunded", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		cctx := abortedCctx("aborted", inbound(t), getValidEthChainID(t), math.NewUint(42))
+		cctx.IsAbortRefunded = true
+		k.SetCrossChainTx(ctx, cctx)
+
+		_, err := k.RetryAbortedCCTX(ctx, types.NewMsgRetryAbortedCCTX(admin, "aborted"))
+		require.ErrorIs(t, err, types.ErrAbortedCctxRefunded)
+	})
+
+	t.Run("should fail if the outbound is on ZetaChain", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		k.SetCrossChainTx(ctx, abortedCctx("aborted", inbound(t), common.ZetaChain().ChainId, math.NewUint(42)))
+
+		_, err := k.RetryAbortedCCTX(ctx, types.NewMsgRetryAbortedCCTX(admin, "aborted"))
+		require.ErrorIs(t, err, types.ErrCannotRetryCctx)
+	})
+
+	t.Run("should fail if the gas can't be paid", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		k.SetTssAndUpdateNonce(ctx, *sample.Tss())
+		k.SetCrossChainTx(ctx, abortedCctx("aborted", inbound(t), getValidEthChainID(t), math.NewUint(42)))
+
+		_, err := k.RetryAbortedCCTX(ctx, types.NewMsgRetryAbortedCCTX(admin, "aborted"))
+		require.ErrorIs(t, err, types.ErrCannotRetryCctx)
+		cctx, found := k.GetCrossChainTx(ctx, "aborted")
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+	})
+}
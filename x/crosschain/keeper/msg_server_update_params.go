@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// UpdateParams updates the parameters of the crosschain module: the multipliers of the median gas price used for the
+// outbound txs and the protocol fee charged for the outbound txs paying gas with ZETA
+// Only the admin policy account is authorized to broadcast this message
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group2) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "UpdateParams can only be executed by the correct policy account")
+	}
+
+	k.SetParams(ctx, msg.Params)
+	EmitEventParamsUpdated(ctx, msg)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
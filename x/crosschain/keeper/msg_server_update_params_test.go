@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestKeeper_UpdateParams(t *testing.T) {
+	t.Run("can update the params", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+
+		// the default params are used before any update
+		require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+		params := types.DefaultParams()
+		params.GasPriceMultiplier = "1.1"
+		params.ZetaGasPriceMultiplier = "3"
+		params.ProtocolFee = math.NewUint(42)
+		_, err := k.UpdateParams(ctx, types.NewMsgUpdateParams(admin, params))
+		require.NoError(t, err)
+		require.Equal(t, params, k.GetParams(ctx))
+
+		res, err := k.ProtocolFee(sdk.WrapSDKContext(ctx), &types.QueryMessagePassingProtocolFeeRequest{})
+		require.NoError(t, err)
+		require.Equal(t, "42", res.FeeInZeta)
+	})
+
+	t.Run("should fail if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+		_, err := k.UpdateParams(ctx, types.NewMsgUpdateParams(sample.AccAddress(), types.DefaultParams()))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+}
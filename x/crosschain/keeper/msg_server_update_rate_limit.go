@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// UpdateRateLimit sets the rate limit of a chain, or of a foreign coin of the chain if a ZRC20 address is provided
+// CCTXs exceeding the maximum value or the maximum number of CCTXs of a rate limit in its window of blocks are held
+// for review until released by the admin policy. A rate limit without maximum is removed
+// Only the admin policy account is authorized to broadcast this message
+func (k Keeper) UpdateRateLimit(goCtx context.Context, msg *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.zetaObserverKeeper.GetParams(ctx).GetAdminPolicyAccount(observertypes.Policy_Type_group2) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "UpdateRateLimit can only be executed by the correct policy account")
+	}
+
+	rateLimit := msg.RateLimit
+	if k.zetaObserverKeeper.GetParams(ctx).GetChainFromChainID(rateLimit.ChainId) == nil {
+		return nil, errorsmod.Wrapf(types.ErrUnsupportedChain, "chain %d", rateLimit.ChainId)
+	}
+	if rateLimit.Zrc20Address != "" {
+		rateLimit.Zrc20Address = ethcommon.HexToAddress(rateLimit.Zrc20Address).Hex()
+		fc, found := k.fungibleKeeper.GetForeignCoins(ctx, rateLimit.Zrc20Address)
+		if !found || fc.ForeignChainId != rateLimit.ChainId {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidRateLimit,
+				"zrc20 %s is not a foreign coin of chain %d",
+				rateLimit.Zrc20Address,
+				rateLimit.ChainId,
+			)
+		}
+	}
+
+	if rateLimit.HasMaximum() {
+		k.SetRateLimit(ctx, rateLimit)
+	} else {
+		k.RemoveRateLimit(ctx, rateLimit.ChainId, rateLimit.Zrc20Address)
+	}
+	EmitEventRateLimitUpdated(ctx, msg)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
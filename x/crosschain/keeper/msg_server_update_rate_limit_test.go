@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+func TestKeeper_UpdateRateLimit(t *testing.T) {
+	t.Run("can set and remove the rate limit of a foreign coin", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		chainID := getValidEthChainID(t)
+		zrc20 := sample.EthAddress().Hex()
+		zk.FungibleKeeper.SetForeignCoins(ctx, fungibletypes.ForeignCoins{
+			Zrc20ContractAddress: zrc20,
+			ForeignChainId:       chainID,
+		})
+
+		// the zrc20 address is stored checksummed
+		rateLimit := types.RateLimit{
+			ChainId:      chainID,
+			Zrc20Address: strings.ToLower(zrc20),
+			Window:       10,
+			MaxValue:     math.NewUint(1000),
+		}
+		_, err := k.UpdateRateLimit(ctx, types.NewMsgUpdateRateLimit(admin, rateLimit))
+		require.NoError(t, err)
+		stored, found := k.GetRateLimit(ctx, chainID, zrc20)
+		require.True(t, found)
+		require.Equal(t, zrc20, stored.Zrc20Address)
+		require.Equal(t, math.NewUint(1000), stored.MaxValue)
+
+		exceeded, _ := k.CheckRateLimits(ctx, chainID, zrc20, math.NewUint(10))
+		require.False(t, exceeded)
+		_, found = k.GetRateLimitWindow(ctx, chainID, zrc20)
+		require.True(t, found)
+
+		// a rate limit without maximum is removed with its window
+		_, err = k.UpdateRateLimit(ctx, types.NewMsgUpdateRateLimit(admin, types.RateLimit{
+			ChainId:      chainID,
+			Zrc20Address: zrc20,
+		}))
+		require.NoError(t, err)
+		_, found = k.GetRateLimit(ctx, chainID, zrc20)
+		require.False(t, found)
+		_, found = k.GetRateLimitWindow(ctx, chainID, zrc20)
+		require.False(t, found)
+	})
+
+	t.Run("can set the rate limit of a chain", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		chainID := getValidEthChainID(t)
+
+		_, err := k.UpdateRateLimit(ctx, types.NewMsgUpdateRateLimit(admin, types.RateLimit{
+			ChainId:      chainID,
+			Window:       10,
+			MaxCctxCount: 5,
+		}))
+		require.NoError(t, err)
+		stored, found := k.GetRateLimit(ctx, chainID, "")
+		require.True(t, found)
+		require.Equal(t, uint64(5), stored.MaxCctxCount)
+	})
+
+	t.Run("should fail if not authorized", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setAdminPolicies(ctx, zk, sample.AccAddress())
+
+		_, err := k.UpdateRateLimit(ctx, types.NewMsgUpdateRateLimit(sample.AccAddress(), types.RateLimit{
+			ChainId:      getValidEthChainID(t),
+			Window:       10,
+			MaxCctxCount: 5,
+		}))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("should fail if the chain is not supported", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+
+		_, err := k.UpdateRateLimit(ctx, types.NewMsgUpdateRateLimit(admin, types.RateLimit{
+			ChainId:      999,
+			Window:       10,
+			MaxCctxCount: 5,
+		}))
+		require.ErrorIs(t, err, types.ErrUnsupportedChain)
+	})
+
+	t.Run("should fail if the zrc20 is not a foreign coin of the chain", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		admin := sample.AccAddress()
+		setAdminPolicies(ctx, zk, admin)
+		zrc20 := sample.EthAddress().Hex()
+		zk.FungibleKeeper.SetForeignCoins(ctx, fungibletypes.ForeignCoins{
+			Zrc20ContractAddress: zrc20,
+			ForeignChainId:       getValidEthChainID(t) + 1,
+		})
+
+		_, err := k.UpdateRateLimit(ctx, types.NewMsgUpdateRateLimit(admin, types.RateLimit{
+			ChainId:      getValidEthChainID(t),
+			Zrc20Address: zrc20,
+			Window:       10,
+			MaxValue:     math.NewUint(1000),
+		}))
+		require.ErrorIs(t, err, types.ErrInvalidRateLimit)
+		_, err = k.UpdateRateLimit(ctx, types.NewMsgUpdateRateLimit(admin, types.RateLimit{
+			ChainId:      getValidEthChainID(t),
+			Zrc20Address: sample.EthAddress().Hex(),
+			Window:       10,
+			MaxValue:     math.NewUint(1000),
+		}))
+		require.ErrorIs(t, err, types.ErrInvalidRateLimit)
+	})
+}
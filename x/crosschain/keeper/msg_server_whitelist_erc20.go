@@ -96,7 +96,7 @@ func (k Keeper) WhitelistERC20(goCtx context.Context, msg *types.MsgWhitelistERC
 	if !isFound {
 		return nil, errorsmod.Wrapf(types.ErrUnableToGetGasPrice, "median gas price not found for chain id (%d)", msg.ChainId)
 	}
-	medianGasPrice = medianGasPrice.MulUint64(2) // overpays gas price by 2x
+	medianGasPrice = k.GetParams(ctx).OutboundGasPrice(medianGasPrice, common.CoinType_Cmd)
 
 	// calculate the cctx index
 	// we use the deployed zrc20 contract address to generate a unique index
@@ -125,7 +125,7 @@ func (k Keeper) WhitelistERC20(goCtx context.Context, msg *types.MsgWhitelistERC
 			InboundTxObservedHash:           hash.String(), // all Upper case Cosmos TX HEX, with no 0x prefix
 			InboundTxObservedExternalHeight: 0,
 			InboundTxBallotIndex:            "",
-			InboundTxFinalizedZetaHeight:    0,
+			InboundTxFinalizedZetaHeight:    uint64(ctx.BlockHeight()), // #nosec G701 always positive
 		},
 		OutboundTxParams: []*types.OutboundTxParams{
 			{
@@ -136,6 +136,7 @@ func (k Keeper) WhitelistERC20(goCtx context.Context, msg *types.MsgWhitelistERC
 				OutboundTxTssNonce:               0,
 				OutboundTxGasLimit:               100_000,
 				OutboundTxGasPrice:               medianGasPrice.String(),
+				OutboundTxGasPriorityFee:         k.GetOutboundPriorityFee(ctx, msg.ChainId, medianGasPrice).String(),
 				OutboundTxHash:                   "",
 				OutboundTxBallotIndex:            "",
 				OutboundTxObservedExternalHeight: 0,
@@ -95,7 +95,8 @@ func (k msgServer) CreateTSSVoter(goCtx context.Context, msg *types.MsgCreateTSS
 			FinalizedZetaHeight: ctx.BlockHeight(),
 			KeyGenZetaHeight:    msg.KeyGenZetaHeight,
 		}
-		// Set TSS history only, current TSS is updated via admin transaction
+		// Set TSS history and start migrating the funds of the current TSS, the new TSS becomes the current TSS once the
+		// migration is completed. If the migration can't be started, the current TSS is updated via admin transaction
 		// In Case this is the first TSS address update both current and history
 
 		tssList := k.GetAllTSS(ctx)
@@ -103,6 +104,14 @@ func (k msgServer) CreateTSSVoter(goCtx context.Context, msg *types.MsgCreateTSS
 			k.SetTssAndUpdateNonce(ctx, tss)
 		}
 		k.SetTSSHistory(ctx, tss)
+		if len(tssList) > 0 {
+			tmpCtx, commit := ctx.CacheContext()
+			if _, err := k.InitiateTssMigration(tmpCtx, msg.Creator, sdk.MsgTypeURL(msg), tss); err != nil {
+				ctx.Logger().Error("failed to initiate the migration of the tss funds", "tss", tss.TssPubkey, "error", err)
+			} else {
+				commit()
+			}
+		}
 		keygen.Status = observerTypes.KeygenStatus_KeyGenSuccess
 		keygen.BlockNumber = ctx.BlockHeight()
 
@@ -124,7 +133,8 @@ func (k msgServer) UpdateTssAddress(goCtx context.Context, msg *types.MsgUpdateT
 	if !ok {
 		return nil, errorsmod.Wrap(types.ErrUnableToUpdateTss, "tss pubkey has not been generated")
 	}
-	k.SetTssAndUpdateNonce(ctx, tss)
+	// any migration in progress is ended and the cctxs held until its completion are released
+	k.SwitchTss(ctx, tss, sdk.MsgTypeURL(msg))
 
 	return &types.MsgUpdateTssAddressResponse{}, nil
 }
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// VerifyProvenTx verifies the proof of inclusion of a transaction of an external chain in a block header
+// the block header must be in the canonical chain and have at least the number of confirmations defined in the core
+// params of the chain
+// returns the block header and the proven transaction in bytes
+func (k Keeper) VerifyProvenTx(
+	ctx sdk.Context,
+	chainID int64,
+	blockHash string,
+	txIndex int64,
+	proof *common.Proof,
+) (common.BlockHeader, []byte, error) {
+	coreParams, found := k.zetaObserverKeeper.GetCoreParamsByChainID(ctx, chainID)
+	if !found {
+		return common.BlockHeader{}, nil, types.ErrNotFoundCoreParams
+	}
+
+	hash, err := common.StringToHash(chainID, blockHash)
+	if err != nil {
+		return common.BlockHeader{}, nil, cosmoserrors.Wrap(err, "block hash conversion failed")
+	}
+	header, found := k.zetaObserverKeeper.GetBlockHeader(ctx, hash)
+	if !found {
+		return common.BlockHeader{}, nil, cosmoserrors.Wrap(observertypes.ErrBlockHeaderNotFound, fmt.Sprintf("block header not found %s", blockHash))
+	}
+	if !k.zetaObserverKeeper.IsCanonicalBlockHeader(ctx, header) {
+		return common.BlockHeader{}, nil, cosmoserrors.Wrap(types.ErrBlockHeaderNotFinalized, fmt.Sprintf("block header %s not in the canonical chain", blockHash))
+	}
+	chainState, found := k.zetaObserverKeeper.GetChainState(ctx, chainID)
+	// #nosec G701 always in range
+	if !found || chainState.LatestHeight-header.Height < int64(coreParams.ConfirmationCount) {
+		return common.BlockHeader{}, nil, cosmoserrors.Wrap(types.ErrBlockHeaderNotFinalized, fmt.Sprintf(
+			"block header %s doesn't have %d confirmations",
+			blockHash,
+			coreParams.ConfirmationCount,
+		))
+	}
+
+	txBytes, err := proof.Verify(header.Header, int(txIndex))
+	if err != nil {
+		return common.BlockHeader{}, nil, cosmoserrors.Wrap(types.ErrProofVerificationFail, fmt.Sprintf("tx proof: %s", err))
+	}
+	return header, txBytes, nil
+}
@@ -15,17 +15,18 @@ import (
 
 func (k Keeper) ConvertGasToZeta(context context.Context, request *types.QueryConvertGasToZetaRequest) (*types.QueryConvertGasToZetaResponse, error) {
 	ctx := sdk.UnwrapSDKContext(context)
-	chain := common.GetChainFromChainID(request.ChainId)
-
-	if chain == nil {
+	chainInfo, found := k.zetaObserverKeeper.GetChainInfo(ctx, request.ChainId)
+	if !found {
 		return nil, zetaObserverTypes.ErrSupportedChains
 	}
+	chain := chainInfo.Chain
 	medianGasPrice, isFound := k.GetMedianGasPriceInUint(ctx, chain.ChainId)
 	if !isFound {
 		return nil, status.Error(codes.InvalidArgument, "invalid request: param chain")
 	}
+	params := k.GetParams(ctx)
 	gasLimit := math.NewUintFromString(request.GasLimit)
-	outTxGasFee := medianGasPrice.Mul(gasLimit).MulUint64(2) //FIXME: parameterize this to sync with where this fee is charged
+	outTxGasFee := params.OutboundGasPrice(medianGasPrice, common.CoinType_Zeta).Mul(gasLimit)
 	zrc20, err := k.fungibleKeeper.QuerySystemContractGasCoinZRC20(ctx, big.NewInt(chain.ChainId))
 	if err != nil {
 		return nil, status.Error(codes.NotFound, "zrc20 not found")
@@ -36,14 +37,15 @@ func (k Keeper) ConvertGasToZeta(context context.Context, request *types.QueryCo
 	}
 	return &types.QueryConvertGasToZetaResponse{
 		OutboundGasInZeta: outTxGasFeeInZeta.String(),
-		ProtocolFeeInZeta: types.GetProtocolFee().String(),
+		ProtocolFeeInZeta: params.ProtocolFee.String(),
 		// #nosec G701 always positive
 		ZetaBlockHeight: uint64(ctx.BlockHeight()),
 	}, nil
 }
 
-func (k Keeper) ProtocolFee(_ context.Context, _ *types.QueryMessagePassingProtocolFeeRequest) (*types.QueryMessagePassingProtocolFeeResponse, error) {
+func (k Keeper) ProtocolFee(c context.Context, _ *types.QueryMessagePassingProtocolFeeRequest) (*types.QueryMessagePassingProtocolFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
 	return &types.QueryMessagePassingProtocolFeeResponse{
-		FeeInZeta: types.GetProtocolFee().String(),
+		FeeInZeta: k.GetParams(ctx).ProtocolFee.String(),
 	}, nil
 }
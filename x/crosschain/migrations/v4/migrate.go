@@ -0,0 +1,20 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+type CrosschainKeeper interface {
+	GetAllCrossChainTx(ctx sdk.Context) (list []types.CrossChainTx)
+	IndexCrossChainTx(ctx sdk.Context, cctx types.CrossChainTx)
+}
+
+// MigrateStore migrates the x/crosschain module state from the consensus version 3 to 4
+// This migration sets the existing cctxs in the secondary indexes by sender, receiver, status, sender chain and height
+func MigrateStore(ctx sdk.Context, k CrosschainKeeper) error {
+	for _, cctx := range k.GetAllCrossChainTx(ctx) {
+		k.IndexCrossChainTx(ctx, cctx)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package v4_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	v4 "github.com/zeta-chain/node/x/crosschain/migrations/v4"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	cctx := sample.CrossChainTx(t, "foo")
+	cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+	k.SetCrossChainTx(ctx, *cctx)
+
+	// the cctx is not indexed before the migration
+	res, err := k.CctxAllBySender(sdk.WrapSDKContext(ctx), &types.QueryAllCctxBySenderRequest{Sender: cctx.InboundTxParams.Sender})
+	require.NoError(t, err)
+	require.Empty(t, res.CrossChainTx)
+
+	err = v4.MigrateStore(ctx, k)
+	require.NoError(t, err)
+
+	res, err = k.CctxAllBySender(sdk.WrapSDKContext(ctx), &types.QueryAllCctxBySenderRequest{Sender: cctx.InboundTxParams.Sender})
+	require.NoError(t, err)
+	require.Len(t, res.CrossChainTx, 1)
+	require.Equal(t, "foo", res.CrossChainTx[0].Index)
+	res, err = k.CctxAllByStatus(sdk.WrapSDKContext(ctx), &types.QueryAllCctxByStatusRequest{Status: types.CctxStatus_PendingOutbound})
+	require.NoError(t, err)
+	require.Len(t, res.CrossChainTx, 1)
+}
@@ -0,0 +1,23 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+type CrosschainKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+}
+
+// MigrateStore migrates the x/crosschain module state from the consensus version 4 to 5
+// This migration stores the gas price multipliers and the protocol fee in the params with the values previously
+// hard-coded: a multiplier of 2 for the outbound txs paying gas with ZETA and a protocol fee of 2 ZETA
+func MigrateStore(ctx sdk.Context, k CrosschainKeeper) error {
+	params := k.GetParams(ctx)
+	params.GasPriceMultiplier = types.DefaultGasPriceMultiplier
+	params.ZetaGasPriceMultiplier = types.DefaultZetaGasPriceMultiplier
+	params.ProtocolFee = sdk.NewUint(types.DefaultProtocolFee)
+	k.SetParams(ctx, params)
+	return nil
+}
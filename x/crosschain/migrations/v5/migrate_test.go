@@ -0,0 +1,30 @@
+package v5_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	v5 "github.com/zeta-chain/node/x/crosschain/migrations/v5"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, sdkk, _ := keepertest.CrosschainKeeper(t)
+	subspace, found := sdkk.ParamsKeeper.GetSubspace(types.ModuleName)
+	require.True(t, found)
+
+	// the fee params are not stored before the migration
+	require.False(t, subspace.Has(ctx, types.KeyPrefix(types.ParamProtocolFee)))
+
+	err := v5.MigrateStore(ctx, k)
+	require.NoError(t, err)
+
+	require.True(t, subspace.Has(ctx, types.KeyPrefix(types.ParamProtocolFee)))
+	params := k.GetParams(ctx)
+	require.True(t, params.Enabled)
+	require.Equal(t, "1", params.GasPriceMultiplier)
+	require.Equal(t, "2", params.ZetaGasPriceMultiplier)
+	require.Equal(t, sdk.NewUint(2000000000000000000), params.ProtocolFee)
+}
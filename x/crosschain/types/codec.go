@@ -16,6 +16,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgVoteOnObservedOutboundTxResponse{}, "crosschain/ReceiveConfirmation", nil)
 	cdc.RegisterConcrete(&MsgVoteOnObservedInboundTx{}, "crosschain/SendVoter", nil)
 	cdc.RegisterConcrete(&MsgSetNodeKeys{}, "crosschain/SetNodeKeys", nil)
+	cdc.RegisterConcrete(&MsgProveInboundTx{}, "crosschain/ProveInboundTx", nil)
+	cdc.RegisterConcrete(&MsgProveOutboundTx{}, "crosschain/ProveOutboundTx", nil)
+	cdc.RegisterConcrete(&MsgRefundAbortedCCTX{}, "crosschain/RefundAbortedCCTX", nil)
+	cdc.RegisterConcrete(&MsgRetryAbortedCCTX{}, "crosschain/RetryAbortedCCTX", nil)
+	cdc.RegisterConcrete(&MsgUpdateRateLimit{}, "crosschain/UpdateRateLimit", nil)
+	cdc.RegisterConcrete(&MsgReleaseCCTX{}, "crosschain/ReleaseCCTX", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "crosschain/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgMigrateTssFunds{}, "crosschain/MigrateTssFunds", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -28,6 +36,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgVoteOnObservedOutboundTx{},
 		&MsgVoteOnObservedInboundTx{},
 		&MsgSetNodeKeys{},
+		&MsgProveInboundTx{},
+		&MsgProveOutboundTx{},
+		&MsgRefundAbortedCCTX{},
+		&MsgRetryAbortedCCTX{},
+		&MsgUpdateRateLimit{},
+		&MsgReleaseCCTX{},
+		&MsgUpdateParams{},
+		&MsgMigrateTssFunds{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
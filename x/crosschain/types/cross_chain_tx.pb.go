@@ -35,6 +35,7 @@ const (
 	CctxStatus_PendingRevert   CctxStatus = 4
 	CctxStatus_Reverted        CctxStatus = 5
 	CctxStatus_Aborted         CctxStatus = 6
+	CctxStatus_PendingReview   CctxStatus = 7
 )
 
 var CctxStatus_name = map[int32]string{
@@ -44,6 +45,7 @@ var CctxStatus_name = map[int32]string{
 	4: "PendingRevert",
 	5: "Reverted",
 	6: "Aborted",
+	7: "PendingReview",
 }
 
 var CctxStatus_value = map[string]int32{
@@ -53,6 +55,7 @@ var CctxStatus_value = map[string]int32{
 	"PendingRevert":   4,
 	"Reverted":        5,
 	"Aborted":         6,
+	"PendingReview":   7,
 }
 
 func (x CctxStatus) String() string {
@@ -180,6 +183,9 @@ type OutboundTxParams struct {
 	OutboundTxTssNonce uint64                                  `protobuf:"varint,5,opt,name=outbound_tx_tss_nonce,json=outboundTxTssNonce,proto3" json:"outbound_tx_tss_nonce,omitempty"`
 	OutboundTxGasLimit uint64                                  `protobuf:"varint,6,opt,name=outbound_tx_gas_limit,json=outboundTxGasLimit,proto3" json:"outbound_tx_gas_limit,omitempty"`
 	OutboundTxGasPrice string                                  `protobuf:"bytes,7,opt,name=outbound_tx_gas_price,json=outboundTxGasPrice,proto3" json:"outbound_tx_gas_price,omitempty"`
+	// outbound_tx_gas_priority_fee is the priority fee for chains supporting EIP-1559
+	// outbound_tx_gas_price is then used as the max fee per gas of the tx
+	OutboundTxGasPriorityFee string `protobuf:"bytes,23,opt,name=outbound_tx_gas_priority_fee,json=outboundTxGasPriorityFee,proto3" json:"outbound_tx_gas_priority_fee,omitempty"`
 	// the above are commands for zetaclients
 	// the following fields are used when the outbound tx is mined
 	OutboundTxHash                   string                                 `protobuf:"bytes,8,opt,name=outbound_tx_hash,json=outboundTxHash,proto3" json:"outbound_tx_hash,omitempty"`
@@ -266,6 +272,13 @@ func (m *OutboundTxParams) GetOutboundTxGasPrice() string {
 	return ""
 }
 
+func (m *OutboundTxParams) GetOutboundTxGasPriorityFee() string {
+	if m != nil {
+		return m.OutboundTxGasPriorityFee
+	}
+	return ""
+}
+
 func (m *OutboundTxParams) GetOutboundTxHash() string {
 	if m != nil {
 		return m.OutboundTxHash
@@ -308,17 +321,111 @@ func (m *OutboundTxParams) GetTssPubkey() string {
 	return ""
 }
 
+// StatusTransition is a change of the status of a cctx
+type StatusTransition struct {
+	OldStatus   CctxStatus `protobuf:"varint,1,opt,name=old_status,json=oldStatus,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"old_status,omitempty"`
+	NewStatus   CctxStatus `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"new_status,omitempty"`
+	Message     string     `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ZetaHeight  int64      `protobuf:"varint,4,opt,name=zeta_height,json=zetaHeight,proto3" json:"zeta_height,omitempty"`
+	BlockTime   int64      `protobuf:"varint,5,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	MsgTypeUrl  string     `protobuf:"bytes,6,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	BallotIndex string     `protobuf:"bytes,7,opt,name=ballot_index,json=ballotIndex,proto3" json:"ballot_index,omitempty"`
+}
+
+func (m *StatusTransition) Reset()         { *m = StatusTransition{} }
+func (m *StatusTransition) String() string { return proto.CompactTextString(m) }
+func (*StatusTransition) ProtoMessage()    {}
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3a0ad055343c21, []int{2}
+}
+func (m *StatusTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusTransition.Merge(m, src)
+}
+func (m *StatusTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusTransition proto.InternalMessageInfo
+
+func (m *StatusTransition) GetOldStatus() CctxStatus {
+	if m != nil {
+		return m.OldStatus
+	}
+	return CctxStatus_PendingInbound
+}
+
+func (m *StatusTransition) GetNewStatus() CctxStatus {
+	if m != nil {
+		return m.NewStatus
+	}
+	return CctxStatus_PendingInbound
+}
+
+func (m *StatusTransition) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *StatusTransition) GetZetaHeight() int64 {
+	if m != nil {
+		return m.ZetaHeight
+	}
+	return 0
+}
+
+func (m *StatusTransition) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *StatusTransition) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *StatusTransition) GetBallotIndex() string {
+	if m != nil {
+		return m.BallotIndex
+	}
+	return ""
+}
+
 type Status struct {
-	Status              CctxStatus `protobuf:"varint,1,opt,name=status,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"status,omitempty"`
-	StatusMessage       string     `protobuf:"bytes,2,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
-	LastUpdateTimestamp int64      `protobuf:"varint,3,opt,name=lastUpdate_timestamp,json=lastUpdateTimestamp,proto3" json:"lastUpdate_timestamp,omitempty"`
+	Status              CctxStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"status,omitempty"`
+	StatusMessage       string              `protobuf:"bytes,2,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	LastUpdateTimestamp int64               `protobuf:"varint,3,opt,name=lastUpdate_timestamp,json=lastUpdateTimestamp,proto3" json:"lastUpdate_timestamp,omitempty"`
+	Transitions         []*StatusTransition `protobuf:"bytes,4,rep,name=transitions,proto3" json:"transitions,omitempty"`
 }
 
 func (m *Status) Reset()         { *m = Status{} }
 func (m *Status) String() string { return proto.CompactTextString(m) }
 func (*Status) ProtoMessage()    {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3a0ad055343c21, []int{2}
+	return fileDescriptor_af3a0ad055343c21, []int{3}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,6 +475,13 @@ func (m *Status) GetLastUpdateTimestamp() int64 {
 	return 0
 }
 
+func (m *Status) GetTransitions() []*StatusTransition {
+	if m != nil {
+		return m.Transitions
+	}
+	return nil
+}
+
 type CrossChainTx struct {
 	Creator          string                                  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Index            string                                  `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -376,13 +490,14 @@ type CrossChainTx struct {
 	CctxStatus       *Status                                 `protobuf:"bytes,8,opt,name=cctx_status,json=cctxStatus,proto3" json:"cctx_status,omitempty"`
 	InboundTxParams  *InboundTxParams                        `protobuf:"bytes,9,opt,name=inbound_tx_params,json=inboundTxParams,proto3" json:"inbound_tx_params,omitempty"`
 	OutboundTxParams []*OutboundTxParams                     `protobuf:"bytes,10,rep,name=outbound_tx_params,json=outboundTxParams,proto3" json:"outbound_tx_params,omitempty"`
+	IsAbortRefunded  bool                                    `protobuf:"varint,11,opt,name=is_abort_refunded,json=isAbortRefunded,proto3" json:"is_abort_refunded,omitempty"`
 }
 
 func (m *CrossChainTx) Reset()         { *m = CrossChainTx{} }
 func (m *CrossChainTx) String() string { return proto.CompactTextString(m) }
 func (*CrossChainTx) ProtoMessage()    {}
 func (*CrossChainTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3a0ad055343c21, []int{3}
+	return fileDescriptor_af3a0ad055343c21, []int{4}
 }
 func (m *CrossChainTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,10 +568,18 @@ func (m *CrossChainTx) GetOutboundTxParams() []*OutboundTxParams {
 	return nil
 }
 
+func (m *CrossChainTx) GetIsAbortRefunded() bool {
+	if m != nil {
+		return m.IsAbortRefunded
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("zetachain.zetacore.crosschain.CctxStatus", CctxStatus_name, CctxStatus_value)
 	proto.RegisterType((*InboundTxParams)(nil), "zetachain.zetacore.crosschain.InboundTxParams")
 	proto.RegisterType((*OutboundTxParams)(nil), "zetachain.zetacore.crosschain.OutboundTxParams")
+	proto.RegisterType((*StatusTransition)(nil), "zetachain.zetacore.crosschain.StatusTransition")
 	proto.RegisterType((*Status)(nil), "zetachain.zetacore.crosschain.Status")
 	proto.RegisterType((*CrossChainTx)(nil), "zetachain.zetacore.crosschain.CrossChainTx")
 }
@@ -464,70 +587,81 @@ func init() {
 func init() { proto.RegisterFile("crosschain/cross_chain_tx.proto", fileDescriptor_af3a0ad055343c21) }
 
 var fileDescriptor_af3a0ad055343c21 = []byte{
-	// 1003 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x4e, 0x1b, 0xc7,
-	0x17, 0x67, 0xff, 0x36, 0xc6, 0x3e, 0x0e, 0x78, 0x19, 0x4c, 0xfe, 0x2b, 0xd2, 0xd8, 0x96, 0xdb,
-	0x24, 0x4e, 0x25, 0x6c, 0x41, 0x55, 0x45, 0xea, 0x5d, 0xa0, 0x21, 0x41, 0x4d, 0x02, 0xda, 0xc2,
-	0x0d, 0x52, 0xb5, 0x1d, 0xef, 0x1e, 0xec, 0x51, 0xec, 0x1d, 0x6b, 0x67, 0x8c, 0x96, 0xa8, 0x57,
-	0x7d, 0x82, 0x3e, 0x44, 0x2b, 0xb5, 0x6f, 0x92, 0x8b, 0x5e, 0xe4, 0xb2, 0xea, 0x05, 0xaa, 0xe0,
-	0x0d, 0xfa, 0x04, 0xd5, 0xcc, 0xec, 0xda, 0x6b, 0x17, 0x42, 0xab, 0x5e, 0xf9, 0x7c, 0xcc, 0xef,
-	0xcc, 0xf9, 0xf8, 0x9d, 0xf1, 0x42, 0xdd, 0x8f, 0xb8, 0x10, 0x7e, 0x9f, 0xb2, 0xb0, 0xa3, 0x45,
-	0x4f, 0xcb, 0x9e, 0x8c, 0xdb, 0xa3, 0x88, 0x4b, 0x4e, 0xee, 0xbf, 0x45, 0x49, 0xb5, 0xad, 0xad,
-	0x25, 0x1e, 0x61, 0x7b, 0x8a, 0xd9, 0x58, 0xf3, 0xf9, 0x70, 0xc8, 0xc3, 0x8e, 0xf9, 0x31, 0x98,
-	0x8d, 0x6a, 0x8f, 0xf7, 0xb8, 0x16, 0x3b, 0x4a, 0x32, 0xd6, 0xe6, 0xf7, 0x79, 0xa8, 0xec, 0x87,
-	0x5d, 0x3e, 0x0e, 0x83, 0xa3, 0xf8, 0x90, 0x46, 0x74, 0x28, 0xc8, 0x5d, 0x28, 0x08, 0x0c, 0x03,
-	0x8c, 0x1c, 0xab, 0x61, 0xb5, 0x4a, 0x6e, 0xa2, 0x91, 0x87, 0x50, 0x31, 0x52, 0x92, 0x0e, 0x0b,
-	0x9c, 0xff, 0x35, 0xac, 0x56, 0xce, 0x5d, 0x36, 0xe6, 0x5d, 0x65, 0xdd, 0x0f, 0xc8, 0x3d, 0x28,
-	0xc9, 0xd8, 0xe3, 0x11, 0xeb, 0xb1, 0xd0, 0xc9, 0xe9, 0x10, 0x45, 0x19, 0x1f, 0x68, 0x9d, 0x6c,
-	0x42, 0xc9, 0xe7, 0xaa, 0x96, 0xf3, 0x11, 0x3a, 0xf9, 0x86, 0xd5, 0x5a, 0xd9, 0xb6, 0xdb, 0x49,
-	0xa2, 0xbb, 0x9c, 0x85, 0x47, 0xe7, 0x23, 0x74, 0x8b, 0x7e, 0x22, 0x91, 0x2a, 0x2c, 0x52, 0x21,
-	0x50, 0x3a, 0x8b, 0x3a, 0x8e, 0x51, 0xc8, 0x73, 0x28, 0xd0, 0x21, 0x1f, 0x87, 0xd2, 0x29, 0x28,
-	0xf3, 0x4e, 0xe7, 0xdd, 0x45, 0x7d, 0xe1, 0xf7, 0x8b, 0xfa, 0xa3, 0x1e, 0x93, 0xfd, 0x71, 0x57,
-	0xc5, 0xeb, 0xf8, 0x5c, 0x0c, 0xb9, 0x48, 0x7e, 0x36, 0x45, 0xf0, 0xa6, 0xa3, 0xae, 0x14, 0xed,
-	0x63, 0x16, 0x4a, 0x37, 0x81, 0x93, 0x27, 0xe0, 0x30, 0x53, 0xbd, 0xa7, 0x52, 0xee, 0x0a, 0x8c,
-	0xce, 0x30, 0xf0, 0xfa, 0x54, 0xf4, 0x9d, 0x25, 0x7d, 0xe3, 0x3a, 0x4b, 0xbb, 0x73, 0x90, 0x78,
-	0x5f, 0x50, 0xd1, 0x27, 0x2f, 0xe1, 0xe3, 0xeb, 0x80, 0x18, 0x4b, 0x8c, 0x42, 0x3a, 0xf0, 0xfa,
-	0xc8, 0x7a, 0x7d, 0xe9, 0x14, 0x1b, 0x56, 0x2b, 0xef, 0xd6, 0xff, 0x16, 0xe3, 0x59, 0x72, 0xee,
-	0x85, 0x3e, 0x46, 0x3e, 0x87, 0xff, 0x67, 0xa2, 0x75, 0xe9, 0x60, 0xc0, 0xa5, 0xc7, 0xc2, 0x00,
-	0x63, 0xa7, 0xa4, 0xb3, 0xa8, 0x4e, 0x22, 0xec, 0x68, 0xe7, 0xbe, 0xf2, 0x91, 0x3d, 0x68, 0x64,
-	0x60, 0xa7, 0x2c, 0xa4, 0x03, 0xf6, 0x16, 0x03, 0x4f, 0x71, 0x22, 0xcd, 0x00, 0x74, 0x06, 0x1f,
-	0x4d, 0xf0, 0x7b, 0xe9, 0xa9, 0x13, 0x94, 0xd4, 0x5c, 0xdf, 0xfc, 0xa5, 0x00, 0xf6, 0xc1, 0x58,
-	0xce, 0xb2, 0x60, 0x03, 0x8a, 0x11, 0xfa, 0xc8, 0xce, 0x26, 0x3c, 0x98, 0xe8, 0xe4, 0x31, 0xd8,
-	0xa9, 0x6c, 0xb8, 0xb0, 0x9f, 0x52, 0xa1, 0x92, 0xda, 0x53, 0x32, 0xcc, 0xcc, 0x3b, 0x77, 0xeb,
-	0xbc, 0xa7, 0x93, 0xcd, 0xff, 0xb7, 0xc9, 0x6e, 0xc1, 0x3a, 0x4f, 0x4a, 0x52, 0xcd, 0x91, 0x42,
-	0x78, 0x21, 0x0f, 0x7d, 0xd4, 0x44, 0xca, 0xbb, 0x84, 0x4f, 0xea, 0x3d, 0x12, 0xe2, 0xb5, 0xf2,
-	0xcc, 0x43, 0x7a, 0x54, 0x78, 0x03, 0x36, 0x64, 0x86, 0x64, 0x33, 0x90, 0xe7, 0x54, 0xbc, 0x54,
-	0x9e, 0xeb, 0x20, 0xa3, 0x88, 0xf9, 0x98, 0x90, 0x67, 0x16, 0x72, 0xa8, 0x3c, 0xa4, 0x05, 0x76,
-	0x16, 0xa2, 0xa9, 0x56, 0xd4, 0xa7, 0x57, 0xa6, 0xa7, 0x35, 0xc7, 0x9e, 0x80, 0x93, 0x3d, 0x79,
-	0x0d, 0x2d, 0xd6, 0xa7, 0x88, 0x2c, 0x2f, 0x5e, 0xc3, 0x27, 0x59, 0xe0, 0x8d, 0xec, 0x34, 0xdc,
-	0x68, 0x4c, 0x83, 0xdc, 0x40, 0xcf, 0x0e, 0x54, 0xe7, 0xab, 0x1c, 0x0b, 0x0c, 0x9c, 0xaa, 0xc6,
-	0xaf, 0xce, 0x14, 0x79, 0x2c, 0x30, 0x20, 0x12, 0xea, 0x59, 0x00, 0x9e, 0x9e, 0xa2, 0x2f, 0xd9,
-	0x19, 0x66, 0x1a, 0xb4, 0xae, 0xc7, 0xdb, 0x4e, 0xc6, 0xfb, 0xf0, 0x1f, 0x8c, 0x77, 0x3f, 0x94,
-	0xee, 0xbd, 0xe9, 0x5d, 0xcf, 0xd2, 0xa0, 0x93, 0xce, 0x7e, 0xf9, 0xa1, 0x5b, 0xcd, 0x24, 0xef,
-	0xea, 0x8c, 0x6f, 0x88, 0x62, 0x46, 0x7a, 0x1f, 0x40, 0x91, 0x65, 0x34, 0xee, 0xbe, 0xc1, 0x73,
-	0xa7, 0xac, 0xfb, 0x5c, 0x92, 0x42, 0x1c, 0x6a, 0x43, 0xf3, 0x27, 0x0b, 0x0a, 0x5f, 0x4b, 0x2a,
-	0xc7, 0x82, 0x3c, 0x85, 0x82, 0xd0, 0x92, 0xde, 0x8f, 0x95, 0xed, 0xc7, 0xed, 0x0f, 0x3e, 0xcb,
-	0xed, 0x5d, 0x5f, 0xc6, 0x06, 0xea, 0x26, 0x40, 0xf2, 0x00, 0x56, 0x8c, 0xe4, 0x0d, 0x51, 0x08,
-	0xda, 0x43, 0xbd, 0x46, 0x25, 0x77, 0xd9, 0x58, 0x5f, 0x19, 0x23, 0xd9, 0x82, 0xea, 0x80, 0x0a,
-	0x79, 0x3c, 0x0a, 0xa8, 0x44, 0x4f, 0xb2, 0x21, 0x0a, 0x49, 0x87, 0x23, 0xbd, 0x4f, 0x39, 0x77,
-	0x6d, 0xea, 0x3b, 0x4a, 0x5d, 0xcd, 0x5f, 0x73, 0x70, 0x67, 0x57, 0xdd, 0xad, 0x17, 0xf1, 0x28,
-	0x26, 0x0e, 0x2c, 0xf9, 0x11, 0x52, 0xc9, 0xd3, 0x75, 0x4e, 0x55, 0xf5, 0xc6, 0x1a, 0x52, 0x99,
-	0xbb, 0x8d, 0x42, 0xbe, 0x85, 0x92, 0x7e, 0x47, 0x4e, 0x11, 0x85, 0x79, 0x7d, 0x77, 0x76, 0xff,
-	0xe5, 0x32, 0xfe, 0x79, 0x51, 0xb7, 0xcf, 0xe9, 0x70, 0xf0, 0x45, 0x73, 0x12, 0xa9, 0xe9, 0x16,
-	0x95, 0xbc, 0x87, 0x28, 0xc8, 0x23, 0xa8, 0x44, 0x38, 0xa0, 0xe7, 0x18, 0x4c, 0xaa, 0x2f, 0x98,
-	0x45, 0x48, 0xcc, 0x69, 0xf9, 0x7b, 0x50, 0xf6, 0x7d, 0x19, 0x7b, 0x49, 0xb7, 0xd5, 0xb6, 0x94,
-	0xb7, 0x1f, 0xdc, 0xd2, 0xed, 0xa4, 0xd3, 0xe0, 0x4f, 0xba, 0x4e, 0x4e, 0x60, 0x35, 0xf3, 0x5e,
-	0x8e, 0xf4, 0x3b, 0xa7, 0x37, 0xa9, 0xbc, 0xdd, 0xbe, 0x25, 0xda, 0xdc, 0x7f, 0xa4, 0x5b, 0x61,
-	0x73, 0x7f, 0x9a, 0xdf, 0x00, 0xc9, 0x92, 0x2f, 0x09, 0x0e, 0x8d, 0x5c, 0xab, 0xbc, 0xdd, 0xb9,
-	0x25, 0xf8, 0xfc, 0xdb, 0xeb, 0xda, 0x7c, 0xce, 0xf2, 0xe9, 0x77, 0x00, 0x53, 0xfa, 0x10, 0x02,
-	0x2b, 0x87, 0x18, 0x06, 0x2c, 0xec, 0x25, 0x79, 0xd9, 0x0b, 0x64, 0x0d, 0x2a, 0x89, 0x2d, 0x0d,
-	0x67, 0x5b, 0x64, 0x15, 0x96, 0x53, 0xed, 0x15, 0x0b, 0x31, 0xb0, 0x73, 0xca, 0x94, 0x9c, 0x73,
-	0xf1, 0x0c, 0x23, 0x69, 0xe7, 0xc9, 0x1d, 0x28, 0x1a, 0x19, 0x03, 0x7b, 0x91, 0x94, 0x61, 0xe9,
-	0x69, 0x97, 0x6b, 0xa5, 0xb0, 0x91, 0xff, 0xf9, 0xc7, 0x9a, 0xb5, 0xf3, 0xd5, 0xbb, 0xcb, 0x9a,
-	0xf5, 0xfe, 0xb2, 0x66, 0xfd, 0x71, 0x59, 0xb3, 0x7e, 0xb8, 0xaa, 0x2d, 0xbc, 0xbf, 0xaa, 0x2d,
-	0xfc, 0x76, 0x55, 0x5b, 0x38, 0xd9, 0xca, 0x50, 0x41, 0x95, 0xb6, 0x69, 0xbe, 0x5a, 0xd2, 0x2a,
-	0x3b, 0x71, 0x27, 0xf3, 0x2d, 0xa3, 0x99, 0xd1, 0x2d, 0xe8, 0x2f, 0x8f, 0xcf, 0xfe, 0x0a, 0x00,
-	0x00, 0xff, 0xff, 0x46, 0x27, 0x16, 0x29, 0xe6, 0x08, 0x00, 0x00,
+	// 1183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0x8f, 0x63, 0xe3, 0xd8, 0xcf, 0x21, 0xde, 0x0c, 0x09, 0xac, 0x02, 0xd8, 0xfe, 0xfa, 0x5b,
+	0x20, 0x20, 0xc5, 0x16, 0xa9, 0x2a, 0xa4, 0x1e, 0x2a, 0x91, 0x94, 0x40, 0x24, 0x7e, 0xa4, 0x5b,
+	0xe7, 0x82, 0x54, 0x6d, 0xd7, 0xbb, 0x2f, 0xf6, 0x88, 0xdd, 0x19, 0x6b, 0x67, 0x1c, 0x1c, 0x8e,
+	0xfd, 0x07, 0xda, 0x3f, 0xa2, 0x87, 0xfe, 0x23, 0x95, 0x38, 0x72, 0xac, 0x7a, 0x40, 0x15, 0x9c,
+	0x7a, 0xed, 0x89, 0x63, 0x35, 0x33, 0xbb, 0xeb, 0xb5, 0x1b, 0x20, 0xb4, 0xa7, 0x7d, 0xf3, 0x66,
+	0x3e, 0x9f, 0x79, 0x3f, 0x3e, 0xfb, 0x76, 0xa1, 0xe9, 0xc7, 0x5c, 0x08, 0x7f, 0xe8, 0x51, 0xd6,
+	0xd5, 0xa6, 0xab, 0x6d, 0x57, 0x4e, 0x3a, 0xa3, 0x98, 0x4b, 0x4e, 0xae, 0xbe, 0x40, 0xe9, 0x69,
+	0x5f, 0x47, 0x5b, 0x3c, 0xc6, 0xce, 0x14, 0xb3, 0x71, 0xc1, 0xe7, 0x51, 0xc4, 0x59, 0xd7, 0x3c,
+	0x0c, 0x66, 0x63, 0x6d, 0xc0, 0x07, 0x5c, 0x9b, 0x5d, 0x65, 0x19, 0x6f, 0xfb, 0x87, 0x12, 0xd4,
+	0xf7, 0x59, 0x9f, 0x8f, 0x59, 0xd0, 0x9b, 0x1c, 0x78, 0xb1, 0x17, 0x09, 0x72, 0x11, 0xca, 0x02,
+	0x59, 0x80, 0xb1, 0x5d, 0x68, 0x15, 0x36, 0xab, 0x4e, 0xb2, 0x22, 0xd7, 0xa1, 0x6e, 0xac, 0x24,
+	0x1c, 0x1a, 0xd8, 0x8b, 0xad, 0xc2, 0x66, 0xd1, 0x39, 0x6f, 0xdc, 0xbb, 0xca, 0xbb, 0x1f, 0x90,
+	0xcb, 0x50, 0x95, 0x13, 0x97, 0xc7, 0x74, 0x40, 0x99, 0x5d, 0xd4, 0x14, 0x15, 0x39, 0x79, 0xa2,
+	0xd7, 0x64, 0x0b, 0xaa, 0x3e, 0x57, 0xb9, 0x9c, 0x8c, 0xd0, 0x2e, 0xb5, 0x0a, 0x9b, 0x2b, 0xdb,
+	0x56, 0x27, 0x09, 0x74, 0x97, 0x53, 0xd6, 0x3b, 0x19, 0xa1, 0x53, 0xf1, 0x13, 0x8b, 0xac, 0xc1,
+	0x39, 0x4f, 0x08, 0x94, 0xf6, 0x39, 0xcd, 0x63, 0x16, 0xe4, 0x3e, 0x94, 0xbd, 0x88, 0x8f, 0x99,
+	0xb4, 0xcb, 0xca, 0xbd, 0xd3, 0x7d, 0xf9, 0xba, 0xb9, 0xf0, 0xfb, 0xeb, 0xe6, 0x8d, 0x01, 0x95,
+	0xc3, 0x71, 0x5f, 0xf1, 0x75, 0x7d, 0x2e, 0x22, 0x2e, 0x92, 0xc7, 0x96, 0x08, 0x9e, 0x75, 0xd5,
+	0x95, 0xa2, 0x73, 0x48, 0x99, 0x74, 0x12, 0x38, 0xb9, 0x03, 0x36, 0x35, 0xd9, 0xbb, 0x2a, 0xe4,
+	0xbe, 0xc0, 0xf8, 0x18, 0x03, 0x77, 0xe8, 0x89, 0xa1, 0xbd, 0xa4, 0x6f, 0x5c, 0xa7, 0x69, 0x75,
+	0x9e, 0x24, 0xbb, 0x0f, 0x3c, 0x31, 0x24, 0x0f, 0xe1, 0xff, 0xa7, 0x01, 0x71, 0x22, 0x31, 0x66,
+	0x5e, 0xe8, 0x0e, 0x91, 0x0e, 0x86, 0xd2, 0xae, 0xb4, 0x0a, 0x9b, 0x25, 0xa7, 0xf9, 0x0f, 0x8e,
+	0x7b, 0xc9, 0xb9, 0x07, 0xfa, 0x18, 0xf9, 0x02, 0x2e, 0xe5, 0xd8, 0xfa, 0x5e, 0x18, 0x72, 0xe9,
+	0x52, 0x16, 0xe0, 0xc4, 0xae, 0xea, 0x28, 0xd6, 0x32, 0x86, 0x1d, 0xbd, 0xb9, 0xaf, 0xf6, 0xc8,
+	0x1e, 0xb4, 0x72, 0xb0, 0x23, 0xca, 0xbc, 0x90, 0xbe, 0xc0, 0xc0, 0x55, 0x9a, 0x48, 0x23, 0x00,
+	0x1d, 0xc1, 0x95, 0x0c, 0xbf, 0x97, 0x9e, 0x7a, 0x8a, 0xd2, 0x33, 0xd7, 0xb7, 0xff, 0x2c, 0x83,
+	0xf5, 0x64, 0x2c, 0x67, 0x55, 0xb0, 0x01, 0x95, 0x18, 0x7d, 0xa4, 0xc7, 0x99, 0x0e, 0xb2, 0x35,
+	0xb9, 0x09, 0x56, 0x6a, 0x1b, 0x2d, 0xec, 0xa7, 0x52, 0xa8, 0xa7, 0xfe, 0x54, 0x0c, 0x33, 0xfd,
+	0x2e, 0x7e, 0xb4, 0xdf, 0xd3, 0xce, 0x96, 0xfe, 0x5b, 0x67, 0x6f, 0xc3, 0x3a, 0x1f, 0xcb, 0xac,
+	0x38, 0x52, 0x08, 0x97, 0x71, 0xe6, 0xa3, 0x16, 0x52, 0xc9, 0x21, 0x3c, 0xcb, 0xb7, 0x27, 0xc4,
+	0x63, 0xb5, 0x33, 0x0f, 0x19, 0x78, 0xc2, 0x0d, 0x69, 0x44, 0x8d, 0xc8, 0x66, 0x20, 0xf7, 0x3d,
+	0xf1, 0x50, 0xed, 0x9c, 0x06, 0x19, 0xc5, 0xd4, 0xc7, 0x44, 0x3c, 0xb3, 0x90, 0x03, 0xb5, 0x43,
+	0xbe, 0x82, 0x2b, 0xa7, 0x40, 0x78, 0x4c, 0xe5, 0x89, 0x7b, 0x84, 0x68, 0x5f, 0xd2, 0x48, 0x7b,
+	0x1e, 0xa9, 0x0f, 0xec, 0x21, 0x92, 0x4d, 0xb0, 0xf2, 0x78, 0x2d, 0xd5, 0x8a, 0xc6, 0xac, 0x4c,
+	0x31, 0x5a, 0xa3, 0x77, 0xc0, 0xce, 0x9f, 0x3c, 0x45, 0x56, 0xeb, 0x53, 0x44, 0x5e, 0x57, 0x8f,
+	0xe1, 0xb3, 0x3c, 0xf0, 0xbd, 0xea, 0x36, 0xda, 0x6a, 0x4d, 0x49, 0xde, 0x23, 0xef, 0x2e, 0xac,
+	0xcd, 0xa7, 0x3c, 0x16, 0x18, 0xd8, 0x6b, 0x1a, 0xbf, 0x3a, 0x93, 0xea, 0xa1, 0xc0, 0x80, 0x48,
+	0x68, 0xe6, 0x01, 0x78, 0x74, 0x84, 0xbe, 0xa4, 0xc7, 0x98, 0x2b, 0xf0, 0xba, 0x96, 0x47, 0x27,
+	0x91, 0xc7, 0xf5, 0x33, 0xc8, 0x63, 0x9f, 0x49, 0xe7, 0xf2, 0xf4, 0xae, 0x7b, 0x29, 0x69, 0xd6,
+	0x99, 0xaf, 0x3f, 0x74, 0xab, 0x51, 0xc2, 0x45, 0x1d, 0xf1, 0x7b, 0x58, 0x8c, 0x24, 0xae, 0x02,
+	0x28, 0xb1, 0x8d, 0xc6, 0xfd, 0x67, 0x78, 0x62, 0xd7, 0x74, 0x9d, 0xab, 0x52, 0x88, 0x03, 0xed,
+	0x68, 0xff, 0xba, 0x08, 0xd6, 0xb7, 0xd2, 0x93, 0x63, 0xd1, 0x8b, 0x3d, 0x26, 0xa8, 0xa4, 0x9c,
+	0x91, 0x07, 0x00, 0x3c, 0x0c, 0x5c, 0xa1, 0xfd, 0xfa, 0x6d, 0x5b, 0xd9, 0xbe, 0xd9, 0xf9, 0xe0,
+	0x90, 0xef, 0xec, 0xfa, 0x72, 0x62, 0x88, 0x9c, 0x2a, 0x0f, 0x03, 0x63, 0x2a, 0x26, 0x86, 0xcf,
+	0x53, 0xa6, 0xc5, 0x4f, 0x66, 0x62, 0xf8, 0x3c, 0x61, 0xb2, 0x61, 0x29, 0x42, 0x21, 0xbc, 0x01,
+	0x26, 0x33, 0x3c, 0x5d, 0x92, 0x26, 0xd4, 0xf2, 0x13, 0xa6, 0xa4, 0x5f, 0x7c, 0x78, 0x91, 0xcd,
+	0x13, 0x55, 0x82, 0x7e, 0xc8, 0xfd, 0x67, 0xae, 0xa4, 0x91, 0x79, 0xe1, 0x8a, 0x4e, 0x55, 0x7b,
+	0x7a, 0x34, 0x42, 0xd2, 0x82, 0xe5, 0x48, 0x0c, 0xf4, 0x44, 0x70, 0xc7, 0x71, 0x68, 0x66, 0xb8,
+	0x03, 0x91, 0x18, 0xa8, 0x11, 0x70, 0x18, 0x87, 0xe4, 0x7f, 0xb0, 0x3c, 0xa3, 0x56, 0xf3, 0x36,
+	0xd5, 0xfa, 0x53, 0x8d, 0xb6, 0xdf, 0x15, 0xa0, 0x9c, 0x44, 0x7a, 0x17, 0xca, 0xff, 0xb6, 0x72,
+	0x09, 0x90, 0x5c, 0x83, 0x15, 0x63, 0xb9, 0x69, 0xce, 0x8b, 0xfa, 0xca, 0xf3, 0xc6, 0xfb, 0x28,
+	0xc9, 0xfc, 0x36, 0xac, 0x85, 0x9e, 0x90, 0x87, 0xa3, 0xc0, 0x93, 0xa8, 0xb3, 0x13, 0xd2, 0x8b,
+	0x46, 0xba, 0x40, 0x45, 0xe7, 0xc2, 0x74, 0xaf, 0x97, 0x6e, 0x91, 0x6f, 0xa0, 0x26, 0xb3, 0x46,
+	0x0b, 0xbb, 0xd4, 0x2a, 0x6e, 0xd6, 0xb6, 0xbb, 0x1f, 0x89, 0x70, 0x5e, 0x20, 0x4e, 0x9e, 0xa3,
+	0xfd, 0xae, 0x08, 0xcb, 0xbb, 0xea, 0xb0, 0x9e, 0xb1, 0xbd, 0x89, 0x6a, 0x95, 0x1f, 0xa3, 0x27,
+	0x79, 0x3a, 0xa9, 0xd3, 0xa5, 0xfa, 0x7c, 0x9a, 0x0a, 0x9a, 0x74, 0xcc, 0x82, 0x7c, 0x0f, 0x55,
+	0xdd, 0xc0, 0x23, 0x44, 0x61, 0x3e, 0xac, 0x3b, 0xbb, 0x9f, 0x38, 0x67, 0xff, 0x7a, 0xdd, 0xb4,
+	0x4e, 0xbc, 0x28, 0xfc, 0xb2, 0x9d, 0x31, 0xb5, 0x9d, 0x8a, 0xb2, 0xf7, 0x10, 0x05, 0xb9, 0x01,
+	0xf5, 0x18, 0x43, 0xef, 0x04, 0x83, 0xac, 0xa0, 0xa6, 0xcb, 0x2b, 0x89, 0x3b, 0xad, 0xe8, 0x1e,
+	0xd4, 0x7c, 0x5f, 0x4e, 0x52, 0xc1, 0xaa, 0x41, 0x56, 0xdb, 0xbe, 0x76, 0xa6, 0xf2, 0x38, 0xe0,
+	0x67, 0x8d, 0x24, 0x4f, 0x61, 0x35, 0xf7, 0x29, 0x1c, 0xe9, 0x4f, 0x98, 0x1e, 0x72, 0xb5, 0xed,
+	0xce, 0x47, 0xd8, 0xe6, 0x7e, 0x7f, 0x9c, 0x3a, 0x9d, 0x75, 0x90, 0xef, 0x80, 0xe4, 0xe7, 0x42,
+	0x42, 0x0e, 0x67, 0xea, 0xe4, 0xfc, 0x67, 0xd5, 0xb1, 0xf8, 0x9c, 0x87, 0xdc, 0x82, 0x55, 0x2a,
+	0x5c, 0xaf, 0xcf, 0x63, 0xe9, 0xc6, 0x78, 0x34, 0x66, 0x01, 0x06, 0x7a, 0x6e, 0x54, 0x9c, 0x3a,
+	0x15, 0x77, 0x95, 0xdf, 0x49, 0xdc, 0xb7, 0x7e, 0x2c, 0x00, 0x4c, 0xe5, 0x4b, 0x08, 0xac, 0x1c,
+	0x20, 0x0b, 0x28, 0x1b, 0x24, 0x49, 0x58, 0x0b, 0xe4, 0x02, 0xd4, 0x13, 0x5f, 0x7a, 0xb7, 0x55,
+	0x20, 0xab, 0x70, 0x3e, 0x5d, 0x3d, 0xa2, 0x0c, 0x03, 0xab, 0xa8, 0x5c, 0xc9, 0x39, 0x07, 0x8f,
+	0x31, 0x96, 0x56, 0x89, 0x2c, 0x43, 0xc5, 0xd8, 0x18, 0x58, 0xe7, 0x48, 0x0d, 0x96, 0xf4, 0xe5,
+	0x18, 0x58, 0xe5, 0xd9, 0xd3, 0x14, 0x9f, 0x5b, 0x4b, 0x1b, 0xa5, 0x5f, 0x7e, 0x6e, 0x14, 0x76,
+	0xee, 0xbf, 0x7c, 0xd3, 0x28, 0xbc, 0x7a, 0xd3, 0x28, 0xfc, 0xf1, 0xa6, 0x51, 0xf8, 0xe9, 0x6d,
+	0x63, 0xe1, 0xd5, 0xdb, 0xc6, 0xc2, 0x6f, 0x6f, 0x1b, 0x0b, 0x4f, 0xb7, 0x72, 0x52, 0x52, 0xa5,
+	0xd9, 0x32, 0x3f, 0xb4, 0x8c, 0x07, 0xd8, 0x9d, 0x74, 0x73, 0xbf, 0xb8, 0x5a, 0x55, 0xfd, 0xb2,
+	0xfe, 0x21, 0xfd, 0xfc, 0xef, 0x01, 0x00, 0x18, 0xd2, 0x88, 0x73, 0xfd, 0x0a, 0x00, 0x00,
 }
 
 func (m *InboundTxParams) Marshal() (dAtA []byte, err error) {
@@ -638,6 +772,15 @@ func (m *OutboundTxParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OutboundTxGasPriorityFee) > 0 {
+		i -= len(m.OutboundTxGasPriorityFee)
+		copy(dAtA[i:], m.OutboundTxGasPriorityFee)
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(len(m.OutboundTxGasPriorityFee)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.OutboundTxEffectiveGasLimit != 0 {
 		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.OutboundTxEffectiveGasLimit))
 		i--
@@ -737,6 +880,70 @@ func (m *OutboundTxParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StatusTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BallotIndex) > 0 {
+		i -= len(m.BallotIndex)
+		copy(dAtA[i:], m.BallotIndex)
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(len(m.BallotIndex)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockTime != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ZetaHeight != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.ZetaHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewStatus != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.NewStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldStatus != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.OldStatus))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Status) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -757,6 +964,20 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Transitions) > 0 {
+		for iNdEx := len(m.Transitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrossChainTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.LastUpdateTimestamp != 0 {
 		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.LastUpdateTimestamp))
 		i--
@@ -797,6 +1018,16 @@ func (m *CrossChainTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsAbortRefunded {
+		i--
+		if m.IsAbortRefunded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.OutboundTxParams) > 0 {
 		for iNdEx := len(m.OutboundTxParams) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -974,6 +1205,43 @@ func (m *OutboundTxParams) Size() (n int) {
 	if m.OutboundTxEffectiveGasLimit != 0 {
 		n += 2 + sovCrossChainTx(uint64(m.OutboundTxEffectiveGasLimit))
 	}
+	l = len(m.OutboundTxGasPriorityFee)
+	if l > 0 {
+		n += 2 + l + sovCrossChainTx(uint64(l))
+	}
+	return n
+}
+
+func (m *StatusTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldStatus != 0 {
+		n += 1 + sovCrossChainTx(uint64(m.OldStatus))
+	}
+	if m.NewStatus != 0 {
+		n += 1 + sovCrossChainTx(uint64(m.NewStatus))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCrossChainTx(uint64(l))
+	}
+	if m.ZetaHeight != 0 {
+		n += 1 + sovCrossChainTx(uint64(m.ZetaHeight))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovCrossChainTx(uint64(m.BlockTime))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovCrossChainTx(uint64(l))
+	}
+	l = len(m.BallotIndex)
+	if l > 0 {
+		n += 1 + l + sovCrossChainTx(uint64(l))
+	}
 	return n
 }
 
@@ -993,6 +1261,12 @@ func (m *Status) Size() (n int) {
 	if m.LastUpdateTimestamp != 0 {
 		n += 1 + sovCrossChainTx(uint64(m.LastUpdateTimestamp))
 	}
+	if len(m.Transitions) > 0 {
+		for _, e := range m.Transitions {
+			l = e.Size()
+			n += 1 + l + sovCrossChainTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1030,6 +1304,9 @@ func (m *CrossChainTx) Size() (n int) {
 			n += 1 + l + sovCrossChainTx(uint64(l))
 		}
 	}
+	if m.IsAbortRefunded {
+		n += 2
+	}
 	return n
 }
 
@@ -1749,6 +2026,260 @@ func (m *OutboundTxParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxGasPriorityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundTxGasPriorityFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrossChainTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+			}
+			m.OldStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldStatus |= CctxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			m.NewStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewStatus |= CctxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaHeight", wireType)
+			}
+			m.ZetaHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZetaHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
@@ -1869,6 +2400,40 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transitions = append(m.Transitions, &StatusTransition{})
+			if err := m.Transitions[len(m.Transitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
@@ -2155,6 +2720,26 @@ func (m *CrossChainTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAbortRefunded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAbortRefunded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
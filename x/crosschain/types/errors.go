@@ -38,4 +38,19 @@ var (
 	ErrInvalidGasAmount    = errorsmod.Register(ModuleName, 1137, "invalid gas amount")
 	ErrNoLiquidityPool     = errorsmod.Register(ModuleName, 1138, "no liquidity pool")
 	ErrInvalidCoinType     = errorsmod.Register(ModuleName, 1139, "invalid coin type")
+
+	ErrObservedTxAlreadyFinalized = errorsmod.Register(ModuleName, 1140, "observed tx already finalized")
+	ErrBlockHeaderNotFinalized    = errorsmod.Register(ModuleName, 1141, "block header not finalized")
+	ErrNoInboundEvent             = errorsmod.Register(ModuleName, 1142, "no inbound found in transaction")
+
+	ErrCctxNotAborted      = errorsmod.Register(ModuleName, 1143, "cctx not aborted")
+	ErrAbortedCctxRefunded = errorsmod.Register(ModuleName, 1144, "aborted cctx already refunded")
+	ErrCannotRefundCctx    = errorsmod.Register(ModuleName, 1145, "cannot refund cctx")
+	ErrCannotRetryCctx     = errorsmod.Register(ModuleName, 1146, "cannot retry cctx")
+
+	ErrInvalidRateLimit     = errorsmod.Register(ModuleName, 1147, "invalid rate limit")
+	ErrCctxNotPendingReview = errorsmod.Register(ModuleName, 1148, "cctx not pending review")
+
+	ErrTssMigrationInProgress = errorsmod.Register(ModuleName, 1149, "tss migration in progress")
+	ErrCannotMigrateTss       = errorsmod.Register(ModuleName, 1150, "cannot migrate tss")
 )
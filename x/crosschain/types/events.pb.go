@@ -509,418 +509,846 @@ func (m *EventOutboundSuccess) GetValueReceived() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventInboundFinalized)(nil), "zetachain.zetacore.crosschain.EventInboundFinalized")
-	proto.RegisterType((*EventZrcWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZrcWithdrawCreated")
-	proto.RegisterType((*EventZetaWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZetaWithdrawCreated")
-	proto.RegisterType((*EventOutboundFailure)(nil), "zetachain.zetacore.crosschain.EventOutboundFailure")
-	proto.RegisterType((*EventOutboundSuccess)(nil), "zetachain.zetacore.crosschain.EventOutboundSuccess")
+type EventCctxStatusChanged struct {
+	MsgTypeUrl    string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	CctxIndex     string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	OldStatus     string `protobuf:"bytes,3,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	NewStatus     string `protobuf:"bytes,4,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	StatusMessage string `protobuf:"bytes,5,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	BallotIndex   string `protobuf:"bytes,6,opt,name=ballot_index,json=ballotIndex,proto3" json:"ballot_index,omitempty"`
 }
 
-func init() { proto.RegisterFile("crosschain/events.proto", fileDescriptor_7398db8b12b87b9e) }
+func (m *EventCctxStatusChanged) Reset()         { *m = EventCctxStatusChanged{} }
+func (m *EventCctxStatusChanged) String() string { return proto.CompactTextString(m) }
+func (*EventCctxStatusChanged) ProtoMessage()    {}
+func (*EventCctxStatusChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{5}
+}
+func (m *EventCctxStatusChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCctxStatusChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCctxStatusChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCctxStatusChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCctxStatusChanged.Merge(m, src)
+}
+func (m *EventCctxStatusChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCctxStatusChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCctxStatusChanged.DiscardUnknown(m)
+}
 
-var fileDescriptor_7398db8b12b87b9e = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xdd, 0x6a, 0xd4, 0x40,
-	0x14, 0x6e, 0xda, 0xdd, 0xed, 0xee, 0xf4, 0x0f, 0x62, 0xb5, 0x63, 0xb1, 0xa1, 0x2e, 0xf8, 0x73,
-	0xe3, 0x06, 0xf1, 0x0d, 0x5a, 0x94, 0x16, 0x91, 0x42, 0x5b, 0x11, 0x7a, 0x33, 0xcc, 0x26, 0x87,
-	0x64, 0x30, 0x99, 0x59, 0x66, 0x26, 0xbb, 0xd9, 0x3e, 0x85, 0x2f, 0x22, 0xf8, 0x00, 0x3e, 0x80,
-	0x97, 0xbd, 0xf0, 0xc2, 0x4b, 0xd9, 0x7d, 0x11, 0x99, 0x99, 0x44, 0xbb, 0xa9, 0xe8, 0x85, 0x28,
-	0x78, 0x95, 0x73, 0xbe, 0x73, 0x72, 0xf2, 0xcd, 0xf7, 0x4d, 0x0e, 0xda, 0x89, 0xa4, 0x50, 0x2a,
-	0x4a, 0x29, 0xe3, 0x21, 0x8c, 0x81, 0x6b, 0x35, 0x18, 0x49, 0xa1, 0x85, 0xbf, 0x77, 0x09, 0x9a,
-	0x5a, 0x7c, 0x60, 0x23, 0x21, 0x61, 0xf0, 0xa3, 0x77, 0xf7, 0x56, 0x24, 0xf2, 0x5c, 0xf0, 0xd0,
-	0x3d, 0xdc, 0x3b, 0xbb, 0xdb, 0x89, 0x48, 0x84, 0x0d, 0x43, 0x13, 0x39, 0xb4, 0xff, 0x79, 0x05,
-	0xdd, 0x7e, 0x6e, 0x46, 0x1f, 0xf3, 0xa1, 0x28, 0x78, 0xfc, 0x82, 0x71, 0x9a, 0xb1, 0x4b, 0x88,
-	0xfd, 0x7d, 0xb4, 0x9e, 0xab, 0x84, 0xe8, 0xe9, 0x08, 0x48, 0x21, 0x33, 0xec, 0xed, 0x7b, 0x8f,
-	0x7b, 0xa7, 0x28, 0x57, 0xc9, 0xf9, 0x74, 0x04, 0xaf, 0x65, 0xe6, 0xef, 0x21, 0x14, 0x45, 0xba,
-	0x24, 0x8c, 0xc7, 0x50, 0xe2, 0x65, 0x5b, 0xef, 0x19, 0xe4, 0xd8, 0x00, 0xfe, 0x1d, 0xd4, 0x51,
-	0xc0, 0x63, 0x90, 0x78, 0xc5, 0x96, 0xaa, 0xcc, 0xbf, 0x8b, 0xba, 0xba, 0x24, 0x42, 0x26, 0x8c,
-	0xe3, 0x96, 0xad, 0xac, 0xea, 0xf2, 0xc4, 0xa4, 0xfe, 0x36, 0x6a, 0x53, 0xa5, 0x40, 0xe3, 0xb6,
-	0xc5, 0x5d, 0xe2, 0xdf, 0x43, 0x88, 0x71, 0xa2, 0x4b, 0x92, 0x52, 0x95, 0xe2, 0x8e, 0x2d, 0x75,
-	0x19, 0x3f, 0x2f, 0x8f, 0xa8, 0x4a, 0xfd, 0x87, 0x68, 0x8b, 0x71, 0x32, 0xcc, 0x44, 0xf4, 0x96,
-	0xa4, 0xc0, 0x92, 0x54, 0xe3, 0x55, 0xdb, 0xb2, 0xc1, 0xf8, 0x81, 0x41, 0x8f, 0x2c, 0xe8, 0xef,
-	0xa2, 0xae, 0x84, 0x08, 0xd8, 0x18, 0x24, 0xee, 0xba, 0x19, 0x75, 0xee, 0x3f, 0x40, 0x9b, 0x75,
-	0x4c, 0xac, 0x84, 0xb8, 0xe7, 0x46, 0xd4, 0xe8, 0xa1, 0x01, 0xcd, 0x89, 0x68, 0x2e, 0x0a, 0xae,
-	0x31, 0x72, 0x27, 0x72, 0x99, 0xff, 0x08, 0x6d, 0x49, 0xc8, 0xe8, 0x14, 0x62, 0x92, 0x83, 0x52,
-	0x34, 0x01, 0xbc, 0x66, 0x1b, 0x36, 0x2b, 0xf8, 0x95, 0x43, 0x8d, 0x62, 0x1c, 0x26, 0x44, 0x69,
-	0xaa, 0x0b, 0x85, 0xd7, 0x9d, 0x62, 0x1c, 0x26, 0x67, 0x16, 0x30, 0x34, 0x5c, 0xe9, 0xfb, 0x98,
-	0x0d, 0x47, 0xc3, 0xa1, 0xf5, 0x94, 0xfb, 0x68, 0xdd, 0x49, 0x59, 0x71, 0xdd, 0xb4, 0x4d, 0x6b,
-	0x0e, 0xb3, 0x4c, 0xfb, 0xef, 0x97, 0xd1, 0x8e, 0xb5, 0xf5, 0x42, 0x46, 0x6f, 0x98, 0x4e, 0x63,
-	0x49, 0x27, 0x87, 0x12, 0xa8, 0xfe, 0x9b, 0xc6, 0x36, 0x79, 0xb5, 0x6e, 0xf0, 0x6a, 0x58, 0xd9,
-	0x6e, 0x58, 0x79, 0xdd, 0xa2, 0xce, 0x6f, 0x2d, 0x5a, 0xfd, 0xb5, 0x45, 0xdd, 0x05, 0x8b, 0x16,
-	0x95, 0xef, 0x35, 0x94, 0xef, 0x7f, 0xf0, 0x10, 0x76, 0x7a, 0x81, 0xa6, 0xff, 0x4c, 0xb0, 0x45,
-	0x35, 0x5a, 0x0d, 0x35, 0x16, 0x29, 0xb7, 0x9b, 0x94, 0x3f, 0x7a, 0x68, 0xdb, 0x52, 0x3e, 0x29,
-	0xb4, 0xfb, 0x75, 0x29, 0xcb, 0x0a, 0x09, 0x7f, 0x4e, 0x77, 0x0f, 0x21, 0x91, 0xc5, 0xf5, 0x87,
-	0x1d, 0xe5, 0x9e, 0xc8, 0xe2, 0xea, 0x96, 0x2e, 0xf2, 0x6a, 0xfd, 0xe4, 0x12, 0x8f, 0x69, 0x56,
-	0x00, 0xa9, 0x8c, 0x89, 0x2b, 0xea, 0x1b, 0x16, 0x3d, 0xad, 0xc0, 0x9b, 0xf4, 0xcf, 0x8a, 0x28,
-	0x02, 0xa5, 0xfe, 0x0f, 0xfa, 0x07, 0x2f, 0x3f, 0xcd, 0x02, 0xef, 0x6a, 0x16, 0x78, 0x5f, 0x67,
-	0x81, 0xf7, 0x6e, 0x1e, 0x2c, 0x5d, 0xcd, 0x83, 0xa5, 0x2f, 0xf3, 0x60, 0xe9, 0xe2, 0x69, 0xc2,
-	0x74, 0x5a, 0x0c, 0x07, 0x91, 0xc8, 0x43, 0xb3, 0x9c, 0x9f, 0xb8, 0xfd, 0x5d, 0xef, 0xe9, 0xb0,
-	0x0c, 0xaf, 0x6d, 0x75, 0x73, 0x4a, 0x35, 0xec, 0xd8, 0x5d, 0xfc, 0xec, 0x5b, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xee, 0x0c, 0x96, 0x59, 0xf0, 0x05, 0x00, 0x00,
+var xxx_messageInfo_EventCctxStatusChanged proto.InternalMessageInfo
+
+func (m *EventCctxStatusChanged) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
 }
 
-func (m *EventInboundFinalized) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCctxStatusChanged) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventInboundFinalized) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventCctxStatusChanged) GetOldStatus() string {
+	if m != nil {
+		return m.OldStatus
+	}
+	return ""
 }
 
-func (m *EventInboundFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SenderChain) > 0 {
-		i -= len(m.SenderChain)
-		copy(dAtA[i:], m.SenderChain)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SenderChain)))
-		i--
-		dAtA[i] = 0x72
+func (m *EventCctxStatusChanged) GetNewStatus() string {
+	if m != nil {
+		return m.NewStatus
 	}
-	if len(m.StatusMessage) > 0 {
-		i -= len(m.StatusMessage)
-		copy(dAtA[i:], m.StatusMessage)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StatusMessage)))
-		i--
-		dAtA[i] = 0x6a
+	return ""
+}
+
+func (m *EventCctxStatusChanged) GetStatusMessage() string {
+	if m != nil {
+		return m.StatusMessage
 	}
-	if len(m.NewStatus) > 0 {
-		i -= len(m.NewStatus)
-		copy(dAtA[i:], m.NewStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
-		i--
-		dAtA[i] = 0x62
+	return ""
+}
+
+func (m *EventCctxStatusChanged) GetBallotIndex() string {
+	if m != nil {
+		return m.BallotIndex
 	}
-	if len(m.RelayedMessage) > 0 {
-		i -= len(m.RelayedMessage)
-		copy(dAtA[i:], m.RelayedMessage)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RelayedMessage)))
-		i--
-		dAtA[i] = 0x5a
+	return ""
+}
+
+type EventAbortedCctxRefunded struct {
+	MsgTypeUrl    string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	CctxIndex     string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	Creator       string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	RefundAddress string `protobuf:"bytes,4,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	CoinType      string `protobuf:"bytes,5,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Asset         string `protobuf:"bytes,6,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventAbortedCctxRefunded) Reset()         { *m = EventAbortedCctxRefunded{} }
+func (m *EventAbortedCctxRefunded) String() string { return proto.CompactTextString(m) }
+func (*EventAbortedCctxRefunded) ProtoMessage()    {}
+func (*EventAbortedCctxRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{6}
+}
+func (m *EventAbortedCctxRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAbortedCctxRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAbortedCctxRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x52
+}
+func (m *EventAbortedCctxRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAbortedCctxRefunded.Merge(m, src)
+}
+func (m *EventAbortedCctxRefunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAbortedCctxRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAbortedCctxRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAbortedCctxRefunded proto.InternalMessageInfo
+
+func (m *EventAbortedCctxRefunded) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	if len(m.ReceiverChain) > 0 {
-		i -= len(m.ReceiverChain)
-		copy(dAtA[i:], m.ReceiverChain)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReceiverChain)))
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *EventAbortedCctxRefunded) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *EventAbortedCctxRefunded) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.InBlockHeight) > 0 {
-		i -= len(m.InBlockHeight)
-		copy(dAtA[i:], m.InBlockHeight)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.InBlockHeight)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *EventAbortedCctxRefunded) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
 	}
-	if len(m.InTxHash) > 0 {
-		i -= len(m.InTxHash)
-		copy(dAtA[i:], m.InTxHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.InTxHash)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *EventAbortedCctxRefunded) GetCoinType() string {
+	if m != nil {
+		return m.CoinType
 	}
-	if len(m.Asset) > 0 {
-		i -= len(m.Asset)
-		copy(dAtA[i:], m.Asset)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Asset)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *EventAbortedCctxRefunded) GetAsset() string {
+	if m != nil {
+		return m.Asset
 	}
-	if len(m.TxOrgin) > 0 {
-		i -= len(m.TxOrgin)
-		copy(dAtA[i:], m.TxOrgin)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxOrgin)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *EventAbortedCctxRefunded) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+type EventAbortedCctxRetried struct {
+	MsgTypeUrl         string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	CctxIndex          string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	Creator            string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	NewStatus          string `protobuf:"bytes,4,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	ReceiverChain      string `protobuf:"bytes,5,opt,name=receiver_chain,json=receiverChain,proto3" json:"receiver_chain,omitempty"`
+	OutboundTxTssNonce string `protobuf:"bytes,6,opt,name=outbound_tx_tss_nonce,json=outboundTxTssNonce,proto3" json:"outbound_tx_tss_nonce,omitempty"`
+	OutboundTxGasPrice string `protobuf:"bytes,7,opt,name=outbound_tx_gas_price,json=outboundTxGasPrice,proto3" json:"outbound_tx_gas_price,omitempty"`
+}
+
+func (m *EventAbortedCctxRetried) Reset()         { *m = EventAbortedCctxRetried{} }
+func (m *EventAbortedCctxRetried) String() string { return proto.CompactTextString(m) }
+func (*EventAbortedCctxRetried) ProtoMessage()    {}
+func (*EventAbortedCctxRetried) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{7}
+}
+func (m *EventAbortedCctxRetried) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAbortedCctxRetried) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAbortedCctxRetried.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.CctxIndex) > 0 {
-		i -= len(m.CctxIndex)
-		copy(dAtA[i:], m.CctxIndex)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventAbortedCctxRetried) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAbortedCctxRetried.Merge(m, src)
+}
+func (m *EventAbortedCctxRetried) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAbortedCctxRetried) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAbortedCctxRetried.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAbortedCctxRetried proto.InternalMessageInfo
+
+func (m *EventAbortedCctxRetried) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventAbortedCctxRetried) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventZrcWithdrawCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAbortedCctxRetried) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventZrcWithdrawCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventAbortedCctxRetried) GetNewStatus() string {
+	if m != nil {
+		return m.NewStatus
+	}
+	return ""
 }
 
-func (m *EventZrcWithdrawCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewStatus) > 0 {
-		i -= len(m.NewStatus)
-		copy(dAtA[i:], m.NewStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ReceiverChain) > 0 {
-		i -= len(m.ReceiverChain)
-		copy(dAtA[i:], m.ReceiverChain)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReceiverChain)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.InTxHash) > 0 {
-		i -= len(m.InTxHash)
-		copy(dAtA[i:], m.InTxHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.InTxHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SenderChain) > 0 {
-		i -= len(m.SenderChain)
-		copy(dAtA[i:], m.SenderChain)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SenderChain)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CctxIndex) > 0 {
-		i -= len(m.CctxIndex)
-		copy(dAtA[i:], m.CctxIndex)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventAbortedCctxRetried) GetReceiverChain() string {
+	if m != nil {
+		return m.ReceiverChain
 	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventAbortedCctxRetried) GetOutboundTxTssNonce() string {
+	if m != nil {
+		return m.OutboundTxTssNonce
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventZetaWithdrawCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAbortedCctxRetried) GetOutboundTxGasPrice() string {
+	if m != nil {
+		return m.OutboundTxGasPrice
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventZetaWithdrawCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventRateLimitUpdated struct {
+	MsgTypeUrl   string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ChainId      string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Zrc20Address string `protobuf:"bytes,3,opt,name=zrc20_address,json=zrc20Address,proto3" json:"zrc20_address,omitempty"`
+	Window       string `protobuf:"bytes,4,opt,name=window,proto3" json:"window,omitempty"`
+	MaxValue     string `protobuf:"bytes,5,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	MaxCctxCount string `protobuf:"bytes,6,opt,name=max_cctx_count,json=maxCctxCount,proto3" json:"max_cctx_count,omitempty"`
+	Signer       string `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *EventZetaWithdrawCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewStatus) > 0 {
-		i -= len(m.NewStatus)
-		copy(dAtA[i:], m.NewStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EventRateLimitUpdated) Reset()         { *m = EventRateLimitUpdated{} }
+func (m *EventRateLimitUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventRateLimitUpdated) ProtoMessage()    {}
+func (*EventRateLimitUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{8}
+}
+func (m *EventRateLimitUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRateLimitUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRateLimitUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.InTxHash) > 0 {
-		i -= len(m.InTxHash)
-		copy(dAtA[i:], m.InTxHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.InTxHash)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventRateLimitUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRateLimitUpdated.Merge(m, src)
+}
+func (m *EventRateLimitUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRateLimitUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRateLimitUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRateLimitUpdated proto.InternalMessageInfo
+
+func (m *EventRateLimitUpdated) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventRateLimitUpdated) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.CctxIndex) > 0 {
-		i -= len(m.CctxIndex)
-		copy(dAtA[i:], m.CctxIndex)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventRateLimitUpdated) GetZrc20Address() string {
+	if m != nil {
+		return m.Zrc20Address
 	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventRateLimitUpdated) GetWindow() string {
+	if m != nil {
+		return m.Window
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventOutboundFailure) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRateLimitUpdated) GetMaxValue() string {
+	if m != nil {
+		return m.MaxValue
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOutboundFailure) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRateLimitUpdated) GetMaxCctxCount() string {
+	if m != nil {
+		return m.MaxCctxCount
+	}
+	return ""
 }
 
-func (m *EventOutboundFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValueReceived) > 0 {
-		i -= len(m.ValueReceived)
-		copy(dAtA[i:], m.ValueReceived)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValueReceived)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EventRateLimitUpdated) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.NewStatus) > 0 {
-		i -= len(m.NewStatus)
-		copy(dAtA[i:], m.NewStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+type EventCctxReleased struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	CctxIndex  string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	Creator    string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	NewStatus  string `protobuf:"bytes,4,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+}
+
+func (m *EventCctxReleased) Reset()         { *m = EventCctxReleased{} }
+func (m *EventCctxReleased) String() string { return proto.CompactTextString(m) }
+func (*EventCctxReleased) ProtoMessage()    {}
+func (*EventCctxReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{9}
+}
+func (m *EventCctxReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCctxReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCctxReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OldStatus) > 0 {
-		i -= len(m.OldStatus)
-		copy(dAtA[i:], m.OldStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldStatus)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventCctxReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCctxReleased.Merge(m, src)
+}
+func (m *EventCctxReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCctxReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCctxReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCctxReleased proto.InternalMessageInfo
+
+func (m *EventCctxReleased) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	if len(m.CctxIndex) > 0 {
-		i -= len(m.CctxIndex)
-		copy(dAtA[i:], m.CctxIndex)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventCctxReleased) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
 	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventCctxReleased) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventOutboundSuccess) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCctxReleased) GetNewStatus() string {
+	if m != nil {
+		return m.NewStatus
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOutboundSuccess) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventParamsUpdated struct {
+	MsgTypeUrl             string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Enabled                bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	GasPriceMultiplier     string `protobuf:"bytes,3,opt,name=gas_price_multiplier,json=gasPriceMultiplier,proto3" json:"gas_price_multiplier,omitempty"`
+	ZetaGasPriceMultiplier string `protobuf:"bytes,4,opt,name=zeta_gas_price_multiplier,json=zetaGasPriceMultiplier,proto3" json:"zeta_gas_price_multiplier,omitempty"`
+	ProtocolFee            string `protobuf:"bytes,5,opt,name=protocol_fee,json=protocolFee,proto3" json:"protocol_fee,omitempty"`
+	Signer                 string `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *EventOutboundSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func (m *EventParamsUpdated) Reset()         { *m = EventParamsUpdated{} }
+func (m *EventParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventParamsUpdated) ProtoMessage()    {}
+func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{10}
+}
+func (m *EventParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventParamsUpdated.Merge(m, src)
+}
+func (m *EventParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventParamsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventParamsUpdated proto.InternalMessageInfo
+
+func (m *EventParamsUpdated) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventParamsUpdated) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *EventParamsUpdated) GetGasPriceMultiplier() string {
+	if m != nil {
+		return m.GasPriceMultiplier
+	}
+	return ""
+}
+
+func (m *EventParamsUpdated) GetZetaGasPriceMultiplier() string {
+	if m != nil {
+		return m.ZetaGasPriceMultiplier
+	}
+	return ""
+}
+
+func (m *EventParamsUpdated) GetProtocolFee() string {
+	if m != nil {
+		return m.ProtocolFee
+	}
+	return ""
+}
+
+func (m *EventParamsUpdated) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type EventTssMigrationStarted struct {
+	MsgTypeUrl           string   `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	CurrentTssPubkey     string   `protobuf:"bytes,2,opt,name=current_tss_pubkey,json=currentTssPubkey,proto3" json:"current_tss_pubkey,omitempty"`
+	NewTssPubkey         string   `protobuf:"bytes,3,opt,name=new_tss_pubkey,json=newTssPubkey,proto3" json:"new_tss_pubkey,omitempty"`
+	MigrationCctxIndexes []string `protobuf:"bytes,4,rep,name=migration_cctx_indexes,json=migrationCctxIndexes,proto3" json:"migration_cctx_indexes,omitempty"`
+}
+
+func (m *EventTssMigrationStarted) Reset()         { *m = EventTssMigrationStarted{} }
+func (m *EventTssMigrationStarted) String() string { return proto.CompactTextString(m) }
+func (*EventTssMigrationStarted) ProtoMessage()    {}
+func (*EventTssMigrationStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{11}
+}
+func (m *EventTssMigrationStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTssMigrationStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTssMigrationStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTssMigrationStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTssMigrationStarted.Merge(m, src)
+}
+func (m *EventTssMigrationStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTssMigrationStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTssMigrationStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTssMigrationStarted proto.InternalMessageInfo
+
+func (m *EventTssMigrationStarted) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventTssMigrationStarted) GetCurrentTssPubkey() string {
+	if m != nil {
+		return m.CurrentTssPubkey
+	}
+	return ""
+}
+
+func (m *EventTssMigrationStarted) GetNewTssPubkey() string {
+	if m != nil {
+		return m.NewTssPubkey
+	}
+	return ""
+}
+
+func (m *EventTssMigrationStarted) GetMigrationCctxIndexes() []string {
+	if m != nil {
+		return m.MigrationCctxIndexes
+	}
+	return nil
+}
+
+type EventTssMigrationCompleted struct {
+	MsgTypeUrl        string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	PreviousTssPubkey string `protobuf:"bytes,2,opt,name=previous_tss_pubkey,json=previousTssPubkey,proto3" json:"previous_tss_pubkey,omitempty"`
+	NewTssPubkey      string `protobuf:"bytes,3,opt,name=new_tss_pubkey,json=newTssPubkey,proto3" json:"new_tss_pubkey,omitempty"`
+	ReleasedCctxCount string `protobuf:"bytes,4,opt,name=released_cctx_count,json=releasedCctxCount,proto3" json:"released_cctx_count,omitempty"`
+}
+
+func (m *EventTssMigrationCompleted) Reset()         { *m = EventTssMigrationCompleted{} }
+func (m *EventTssMigrationCompleted) String() string { return proto.CompactTextString(m) }
+func (*EventTssMigrationCompleted) ProtoMessage()    {}
+func (*EventTssMigrationCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{12}
+}
+func (m *EventTssMigrationCompleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTssMigrationCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTssMigrationCompleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTssMigrationCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTssMigrationCompleted.Merge(m, src)
+}
+func (m *EventTssMigrationCompleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTssMigrationCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTssMigrationCompleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTssMigrationCompleted proto.InternalMessageInfo
+
+func (m *EventTssMigrationCompleted) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventTssMigrationCompleted) GetPreviousTssPubkey() string {
+	if m != nil {
+		return m.PreviousTssPubkey
+	}
+	return ""
+}
+
+func (m *EventTssMigrationCompleted) GetNewTssPubkey() string {
+	if m != nil {
+		return m.NewTssPubkey
+	}
+	return ""
+}
+
+func (m *EventTssMigrationCompleted) GetReleasedCctxCount() string {
+	if m != nil {
+		return m.ReleasedCctxCount
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventInboundFinalized)(nil), "zetachain.zetacore.crosschain.EventInboundFinalized")
+	proto.RegisterType((*EventZrcWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZrcWithdrawCreated")
+	proto.RegisterType((*EventZetaWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZetaWithdrawCreated")
+	proto.RegisterType((*EventOutboundFailure)(nil), "zetachain.zetacore.crosschain.EventOutboundFailure")
+	proto.RegisterType((*EventOutboundSuccess)(nil), "zetachain.zetacore.crosschain.EventOutboundSuccess")
+	proto.RegisterType((*EventCctxStatusChanged)(nil), "zetachain.zetacore.crosschain.EventCctxStatusChanged")
+	proto.RegisterType((*EventAbortedCctxRefunded)(nil), "zetachain.zetacore.crosschain.EventAbortedCctxRefunded")
+	proto.RegisterType((*EventAbortedCctxRetried)(nil), "zetachain.zetacore.crosschain.EventAbortedCctxRetried")
+	proto.RegisterType((*EventRateLimitUpdated)(nil), "zetachain.zetacore.crosschain.EventRateLimitUpdated")
+	proto.RegisterType((*EventCctxReleased)(nil), "zetachain.zetacore.crosschain.EventCctxReleased")
+	proto.RegisterType((*EventParamsUpdated)(nil), "zetachain.zetacore.crosschain.EventParamsUpdated")
+	proto.RegisterType((*EventTssMigrationStarted)(nil), "zetachain.zetacore.crosschain.EventTssMigrationStarted")
+	proto.RegisterType((*EventTssMigrationCompleted)(nil), "zetachain.zetacore.crosschain.EventTssMigrationCompleted")
+}
+
+func init() { proto.RegisterFile("crosschain/events.proto", fileDescriptor_7398db8b12b87b9e) }
+
+var fileDescriptor_7398db8b12b87b9e = []byte{
+	// 1092 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6e, 0x1c, 0x45,
+	0x10, 0xf6, 0xc4, 0xde, 0xbf, 0xf6, 0xda, 0xc1, 0x63, 0xc7, 0x19, 0x1b, 0xbc, 0x4a, 0x96, 0xbf,
+	0x1c, 0xc8, 0x6e, 0xf8, 0xb9, 0x70, 0x4c, 0x56, 0xc4, 0xb1, 0x84, 0x89, 0xb5, 0xde, 0x80, 0x94,
+	0x4b, 0xab, 0x77, 0xa6, 0x32, 0xdb, 0xca, 0x4c, 0xf7, 0xaa, 0xbb, 0xc7, 0x3b, 0xf6, 0x3b, 0x20,
+	0xf1, 0x08, 0xbc, 0x00, 0x12, 0x0f, 0xc0, 0x11, 0x21, 0x8e, 0x39, 0x70, 0xe0, 0x08, 0xf6, 0x81,
+	0x0b, 0x0f, 0xc0, 0x11, 0x75, 0xf7, 0xf4, 0x7a, 0x7f, 0x0c, 0x31, 0x82, 0x00, 0x39, 0x6d, 0xd7,
+	0x57, 0xdd, 0x3d, 0x5f, 0xd5, 0x57, 0x55, 0x33, 0x8b, 0xae, 0x87, 0x82, 0x4b, 0x19, 0x0e, 0x08,
+	0x65, 0x6d, 0x38, 0x02, 0xa6, 0x64, 0x6b, 0x28, 0xb8, 0xe2, 0xfe, 0xce, 0x09, 0x28, 0x62, 0xf0,
+	0x96, 0x59, 0x71, 0x01, 0xad, 0xf3, 0xbd, 0xdb, 0xeb, 0x21, 0x4f, 0x53, 0xce, 0xda, 0xf6, 0xc7,
+	0x9e, 0xd9, 0xde, 0x88, 0x79, 0xcc, 0xcd, 0xb2, 0xad, 0x57, 0x16, 0x6d, 0xfe, 0xb0, 0x88, 0xae,
+	0x7d, 0xa4, 0xaf, 0xde, 0x63, 0x7d, 0x9e, 0xb1, 0xe8, 0x3e, 0x65, 0x24, 0xa1, 0x27, 0x10, 0xf9,
+	0x37, 0x50, 0x3d, 0x95, 0x31, 0x56, 0xc7, 0x43, 0xc0, 0x99, 0x48, 0x02, 0xef, 0x86, 0x77, 0xab,
+	0xd6, 0x45, 0xa9, 0x8c, 0x7b, 0xc7, 0x43, 0x78, 0x24, 0x12, 0x7f, 0x07, 0xa1, 0x30, 0x54, 0x39,
+	0xa6, 0x2c, 0x82, 0x3c, 0xb8, 0x62, 0xfc, 0x35, 0x8d, 0xec, 0x69, 0xc0, 0xdf, 0x44, 0x65, 0x09,
+	0x2c, 0x02, 0x11, 0x2c, 0x1a, 0x57, 0x61, 0xf9, 0x5b, 0xa8, 0xaa, 0x72, 0xcc, 0x45, 0x4c, 0x59,
+	0xb0, 0x64, 0x3c, 0x15, 0x95, 0x3f, 0xd4, 0xa6, 0xbf, 0x81, 0x4a, 0x44, 0x4a, 0x50, 0x41, 0xc9,
+	0xe0, 0xd6, 0xf0, 0x5f, 0x43, 0x88, 0x32, 0xac, 0x72, 0x3c, 0x20, 0x72, 0x10, 0x94, 0x8d, 0xab,
+	0x4a, 0x59, 0x2f, 0x7f, 0x40, 0xe4, 0xc0, 0x7f, 0x0b, 0x5d, 0xa5, 0x0c, 0xf7, 0x13, 0x1e, 0x3e,
+	0xc5, 0x03, 0xa0, 0xf1, 0x40, 0x05, 0x15, 0xb3, 0x65, 0x85, 0xb2, 0x7b, 0x1a, 0x7d, 0x60, 0x40,
+	0x7f, 0x1b, 0x55, 0x05, 0x84, 0x40, 0x8f, 0x40, 0x04, 0x55, 0x7b, 0x87, 0xb3, 0xfd, 0x37, 0xd1,
+	0xaa, 0x5b, 0x63, 0x93, 0xc2, 0xa0, 0x66, 0xaf, 0x70, 0x68, 0x47, 0x83, 0x3a, 0x22, 0x92, 0xf2,
+	0x8c, 0xa9, 0x00, 0xd9, 0x88, 0xac, 0xe5, 0xbf, 0x8d, 0xae, 0x0a, 0x48, 0xc8, 0x31, 0x44, 0x38,
+	0x05, 0x29, 0x49, 0x0c, 0xc1, 0xb2, 0xd9, 0xb0, 0x5a, 0xc0, 0xfb, 0x16, 0xd5, 0x19, 0x63, 0x30,
+	0xc2, 0x52, 0x11, 0x95, 0xc9, 0xa0, 0x6e, 0x33, 0xc6, 0x60, 0x74, 0x68, 0x00, 0x4d, 0xc3, 0xba,
+	0xc6, 0xd7, 0xac, 0x58, 0x1a, 0x16, 0x75, 0xb7, 0xdc, 0x44, 0x75, 0x9b, 0xca, 0x82, 0xeb, 0xaa,
+	0xd9, 0xb4, 0x6c, 0x31, 0xc3, 0xb4, 0xf9, 0xd5, 0x15, 0x74, 0xdd, 0xc8, 0xfa, 0x58, 0x84, 0x9f,
+	0x51, 0x35, 0x88, 0x04, 0x19, 0x75, 0x04, 0x10, 0xf5, 0x22, 0x85, 0x9d, 0xe5, 0xb5, 0x34, 0xc7,
+	0x6b, 0x46, 0xca, 0xd2, 0x8c, 0x94, 0x93, 0x12, 0x95, 0x9f, 0x2b, 0x51, 0xe5, 0xcf, 0x25, 0xaa,
+	0x4e, 0x49, 0x34, 0x9d, 0xf9, 0xda, 0x4c, 0xe6, 0x9b, 0x5f, 0x7b, 0x28, 0xb0, 0xf9, 0x02, 0x45,
+	0xfe, 0xb5, 0x84, 0x4d, 0x67, 0x63, 0x69, 0x26, 0x1b, 0xd3, 0x94, 0x4b, 0xb3, 0x94, 0xbf, 0xf1,
+	0xd0, 0x86, 0xa1, 0xfc, 0x30, 0x53, 0xb6, 0x75, 0x09, 0x4d, 0x32, 0x01, 0x7f, 0x9f, 0xee, 0x0e,
+	0x42, 0x3c, 0x89, 0xdc, 0x83, 0x2d, 0xe5, 0x1a, 0x4f, 0xa2, 0xa2, 0x4a, 0xa7, 0x79, 0x2d, 0x5d,
+	0x50, 0xc4, 0x47, 0x24, 0xc9, 0x00, 0x17, 0xc2, 0x44, 0x05, 0xf5, 0x15, 0x83, 0x76, 0x0b, 0x70,
+	0x9e, 0xfe, 0x61, 0x16, 0x86, 0x20, 0xe5, 0x4b, 0x42, 0xff, 0x67, 0x0f, 0x6d, 0x1a, 0xfa, 0x9d,
+	0x50, 0xe5, 0xf6, 0x68, 0x67, 0x40, 0x58, 0x0c, 0xd1, 0xff, 0x20, 0x80, 0x99, 0x21, 0x52, 0xfa,
+	0x83, 0x21, 0xd2, 0x27, 0x49, 0xc2, 0x55, 0xc1, 0xc2, 0xf6, 0xdb, 0xb2, 0xc5, 0x0c, 0x8f, 0xe6,
+	0x2f, 0xae, 0x29, 0xee, 0xf6, 0xb9, 0x50, 0x10, 0xe9, 0x50, 0xbb, 0xf0, 0x24, 0x63, 0xd1, 0x3f,
+	0x11, 0x65, 0x80, 0x2a, 0xa1, 0x6e, 0x30, 0xee, 0xba, 0xc2, 0x99, 0xb6, 0xd5, 0xf5, 0x63, 0x30,
+	0x89, 0x22, 0x01, 0xd2, 0x05, 0xb9, 0x62, 0xd1, 0xbb, 0x16, 0xf4, 0x5f, 0x45, 0xb5, 0x90, 0xeb,
+	0xfe, 0x39, 0x1e, 0xba, 0x18, 0xab, 0x1a, 0xd0, 0xcf, 0x3f, 0x7f, 0x93, 0x94, 0x27, 0xdf, 0x24,
+	0xe7, 0xd3, 0xa1, 0x32, 0x39, 0x1d, 0x9a, 0x5f, 0xba, 0x71, 0x39, 0x15, 0xa9, 0x12, 0xf4, 0xc5,
+	0x06, 0xfa, 0x7c, 0x25, 0x67, 0x46, 0x5e, 0xe9, 0xa2, 0x91, 0xf7, 0x2e, 0xba, 0xc6, 0x8b, 0x1e,
+	0xd2, 0xb3, 0x44, 0x49, 0x89, 0x19, 0x67, 0x21, 0x14, 0xa1, 0xfb, 0xce, 0xd9, 0xcb, 0x7b, 0x52,
+	0x7e, 0xa2, 0x3d, 0xb3, 0x47, 0x62, 0x22, 0xf1, 0x50, 0xd0, 0x10, 0x82, 0xca, 0xec, 0x91, 0x5d,
+	0x22, 0x0f, 0xb4, 0xa7, 0xf9, 0xab, 0x57, 0x7c, 0x28, 0x74, 0x89, 0x82, 0x8f, 0x69, 0x4a, 0xd5,
+	0xa3, 0x61, 0x74, 0xc9, 0xf1, 0xb8, 0x85, 0xaa, 0x86, 0x3f, 0xa6, 0x51, 0x91, 0x9e, 0x8a, 0xb1,
+	0xf7, 0x22, 0xff, 0x75, 0xb4, 0x72, 0x22, 0xc2, 0xf7, 0xee, 0x8c, 0xa5, 0xb6, 0x29, 0xaa, 0x1b,
+	0xd0, 0x29, 0xbd, 0x89, 0xca, 0x23, 0xca, 0x22, 0x3e, 0x2a, 0x72, 0x54, 0x58, 0xba, 0x02, 0x52,
+	0x92, 0x63, 0xd3, 0x99, 0xae, 0x02, 0x52, 0x92, 0x7f, 0xaa, 0x6d, 0xff, 0x0d, 0xb4, 0xaa, 0x9d,
+	0x46, 0x99, 0xd0, 0x68, 0x6e, 0xf3, 0x51, 0x4f, 0x49, 0xae, 0xf5, 0xed, 0x68, 0x4c, 0x5f, 0x2d,
+	0x69, 0xcc, 0x40, 0xb8, 0x8a, 0xb0, 0x56, 0xf3, 0x73, 0x0f, 0xad, 0x8d, 0xfb, 0xbb, 0x0b, 0x09,
+	0x10, 0xf9, 0x5f, 0xd6, 0x42, 0xf3, 0x37, 0x0f, 0xf9, 0x86, 0xcf, 0x01, 0x11, 0x24, 0x95, 0x97,
+	0xcf, 0x7d, 0x80, 0x2a, 0xc0, 0x48, 0x3f, 0x01, 0x9b, 0xfa, 0x6a, 0xd7, 0x99, 0xfe, 0x1d, 0xb4,
+	0x31, 0x16, 0x1e, 0xa7, 0x59, 0xa2, 0xe8, 0x30, 0xa1, 0xe3, 0x77, 0x94, 0x1f, 0x17, 0xca, 0xef,
+	0x8f, 0x3d, 0xfe, 0x87, 0x68, 0x4b, 0x7f, 0x6e, 0xe2, 0x0b, 0x8f, 0x59, 0xca, 0x9b, 0x7a, 0xc3,
+	0xee, 0xfc, 0xd1, 0x9b, 0xa8, 0x6e, 0x3e, 0x38, 0x43, 0x9e, 0xe0, 0x27, 0xe0, 0xd4, 0x5a, 0x76,
+	0xd8, 0x7d, 0x80, 0x09, 0x29, 0xca, 0x53, 0x52, 0x7c, 0xe7, 0xc6, 0x50, 0x4f, 0xca, 0x7d, 0x1a,
+	0x0b, 0xa2, 0x28, 0x67, 0x87, 0x8a, 0x88, 0xcb, 0x25, 0xe0, 0x1d, 0xe4, 0x87, 0x99, 0x10, 0xc0,
+	0x94, 0x69, 0x8d, 0x61, 0xd6, 0x7f, 0x0a, 0xc7, 0x85, 0x32, 0xaf, 0x14, 0x9e, 0x9e, 0x94, 0x07,
+	0x06, 0xd7, 0x55, 0xa3, 0x65, 0x98, 0xd8, 0x59, 0x14, 0x24, 0x83, 0xd1, 0xf9, 0xae, 0x0f, 0xd0,
+	0x66, 0xea, 0x98, 0xe0, 0x73, 0xbd, 0x41, 0x0b, 0xb7, 0x78, 0xab, 0xd6, 0xdd, 0x18, 0x7b, 0x3b,
+	0x4e, 0x7a, 0x90, 0xcd, 0x6f, 0x3d, 0xb4, 0x3d, 0x17, 0x48, 0x87, 0xa7, 0xc3, 0x04, 0x2e, 0x17,
+	0x4a, 0x0b, 0xad, 0x0f, 0x05, 0x1c, 0x51, 0x9e, 0xc9, 0xf9, 0x58, 0xd6, 0x9c, 0xeb, 0xaf, 0x06,
+	0xd3, 0x42, 0xeb, 0xa2, 0x28, 0xf0, 0xc9, 0x6e, 0xb1, 0x7a, 0xae, 0x39, 0xd7, 0xb8, 0x65, 0xee,
+	0xed, 0x7e, 0x7f, 0xda, 0xf0, 0x9e, 0x9d, 0x36, 0xbc, 0x9f, 0x4e, 0x1b, 0xde, 0x17, 0x67, 0x8d,
+	0x85, 0x67, 0x67, 0x8d, 0x85, 0x1f, 0xcf, 0x1a, 0x0b, 0x8f, 0x6f, 0xc7, 0x54, 0x0d, 0xb2, 0x7e,
+	0x2b, 0xe4, 0x69, 0x5b, 0xd7, 0xc1, 0x6d, 0xfb, 0xd7, 0x85, 0xf1, 0x08, 0xda, 0x79, 0x7b, 0xe2,
+	0xcf, 0x8c, 0x8e, 0x51, 0xf6, 0xcb, 0x46, 0xfd, 0xf7, 0x7f, 0x1f, 0x00, 0x97, 0xd1, 0xfc, 0x0b,
+	0xe7, 0x0c, 0x00, 0x00,
+}
+
+func (m *EventInboundFinalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInboundFinalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInboundFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValueReceived) > 0 {
-		i -= len(m.ValueReceived)
-		copy(dAtA[i:], m.ValueReceived)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValueReceived)))
+	if len(m.SenderChain) > 0 {
+		i -= len(m.SenderChain)
+		copy(dAtA[i:], m.SenderChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SenderChain)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x72
+	}
+	if len(m.StatusMessage) > 0 {
+		i -= len(m.StatusMessage)
+		copy(dAtA[i:], m.StatusMessage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StatusMessage)))
+		i--
+		dAtA[i] = 0x6a
 	}
 	if len(m.NewStatus) > 0 {
 		i -= len(m.NewStatus)
 		copy(dAtA[i:], m.NewStatus)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
 		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.RelayedMessage) > 0 {
+		i -= len(m.RelayedMessage)
+		copy(dAtA[i:], m.RelayedMessage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RelayedMessage)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ReceiverChain) > 0 {
+		i -= len(m.ReceiverChain)
+		copy(dAtA[i:], m.ReceiverChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReceiverChain)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.InBlockHeight) > 0 {
+		i -= len(m.InBlockHeight)
+		copy(dAtA[i:], m.InBlockHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InBlockHeight)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.InTxHash) > 0 {
+		i -= len(m.InTxHash)
+		copy(dAtA[i:], m.InTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InTxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TxOrgin) > 0 {
+		i -= len(m.TxOrgin)
+		copy(dAtA[i:], m.TxOrgin)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TxOrgin)))
+		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.OldStatus) > 0 {
-		i -= len(m.OldStatus)
-		copy(dAtA[i:], m.OldStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldStatus)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -941,221 +1369,2946 @@ func (m *EventOutboundSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *EventInboundFinalized) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventZrcWithdrawCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventZrcWithdrawCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventZrcWithdrawCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.CctxIndex)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.TxOrgin)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.InTxHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.InBlockHeight)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ReceiverChain) > 0 {
+		i -= len(m.ReceiverChain)
+		copy(dAtA[i:], m.ReceiverChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReceiverChain)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.ReceiverChain)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.InTxHash) > 0 {
+		i -= len(m.InTxHash)
+		copy(dAtA[i:], m.InTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InTxHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.RelayedMessage)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SenderChain) > 0 {
+		i -= len(m.SenderChain)
+		copy(dAtA[i:], m.SenderChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SenderChain)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.NewStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.StatusMessage)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.SenderChain)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventZrcWithdrawCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventZetaWithdrawCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventZetaWithdrawCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventZetaWithdrawCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.CctxIndex)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SenderChain)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.InTxHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.InTxHash) > 0 {
+		i -= len(m.InTxHash)
+		copy(dAtA[i:], m.InTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InTxHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ReceiverChain)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.NewStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventZetaWithdrawCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventOutboundFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOutboundFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutboundFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.CctxIndex)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ValueReceived) > 0 {
+		i -= len(m.ValueReceived)
+		copy(dAtA[i:], m.ValueReceived)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValueReceived)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.InTxHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OldStatus) > 0 {
+		i -= len(m.OldStatus)
+		copy(dAtA[i:], m.OldStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldStatus)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.NewStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOutboundFailure) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventOutboundSuccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOutboundSuccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutboundSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ValueReceived) > 0 {
+		i -= len(m.ValueReceived)
+		copy(dAtA[i:], m.ValueReceived)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValueReceived)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.CctxIndex)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OldStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OldStatus) > 0 {
+		i -= len(m.OldStatus)
+		copy(dAtA[i:], m.OldStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldStatus)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.NewStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ValueReceived)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCctxStatusChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCctxStatusChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCctxStatusChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BallotIndex) > 0 {
+		i -= len(m.BallotIndex)
+		copy(dAtA[i:], m.BallotIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotIndex)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StatusMessage) > 0 {
+		i -= len(m.StatusMessage)
+		copy(dAtA[i:], m.StatusMessage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StatusMessage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldStatus) > 0 {
+		i -= len(m.OldStatus)
+		copy(dAtA[i:], m.OldStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldStatus)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAbortedCctxRefunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAbortedCctxRefunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAbortedCctxRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CoinType) > 0 {
+		i -= len(m.CoinType)
+		copy(dAtA[i:], m.CoinType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CoinType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAbortedCctxRetried) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAbortedCctxRetried) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAbortedCctxRetried) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutboundTxGasPrice) > 0 {
+		i -= len(m.OutboundTxGasPrice)
+		copy(dAtA[i:], m.OutboundTxGasPrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundTxGasPrice)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OutboundTxTssNonce) > 0 {
+		i -= len(m.OutboundTxTssNonce)
+		copy(dAtA[i:], m.OutboundTxTssNonce)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OutboundTxTssNonce)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ReceiverChain) > 0 {
+		i -= len(m.ReceiverChain)
+		copy(dAtA[i:], m.ReceiverChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReceiverChain)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRateLimitUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRateLimitUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRateLimitUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MaxCctxCount) > 0 {
+		i -= len(m.MaxCctxCount)
+		copy(dAtA[i:], m.MaxCctxCount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaxCctxCount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MaxValue) > 0 {
+		i -= len(m.MaxValue)
+		copy(dAtA[i:], m.MaxValue)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaxValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Window) > 0 {
+		i -= len(m.Window)
+		copy(dAtA[i:], m.Window)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Window)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Zrc20Address) > 0 {
+		i -= len(m.Zrc20Address)
+		copy(dAtA[i:], m.Zrc20Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Zrc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCctxReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCctxReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCctxReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewStatus) > 0 {
+		i -= len(m.NewStatus)
+		copy(dAtA[i:], m.NewStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStatus)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ProtocolFee) > 0 {
+		i -= len(m.ProtocolFee)
+		copy(dAtA[i:], m.ProtocolFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProtocolFee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ZetaGasPriceMultiplier) > 0 {
+		i -= len(m.ZetaGasPriceMultiplier)
+		copy(dAtA[i:], m.ZetaGasPriceMultiplier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ZetaGasPriceMultiplier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GasPriceMultiplier) > 0 {
+		i -= len(m.GasPriceMultiplier)
+		copy(dAtA[i:], m.GasPriceMultiplier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GasPriceMultiplier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTssMigrationStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTssMigrationStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTssMigrationStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MigrationCctxIndexes) > 0 {
+		for iNdEx := len(m.MigrationCctxIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MigrationCctxIndexes[iNdEx])
+			copy(dAtA[i:], m.MigrationCctxIndexes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.MigrationCctxIndexes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NewTssPubkey) > 0 {
+		i -= len(m.NewTssPubkey)
+		copy(dAtA[i:], m.NewTssPubkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewTssPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurrentTssPubkey) > 0 {
+		i -= len(m.CurrentTssPubkey)
+		copy(dAtA[i:], m.CurrentTssPubkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentTssPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTssMigrationCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTssMigrationCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTssMigrationCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReleasedCctxCount) > 0 {
+		i -= len(m.ReleasedCctxCount)
+		copy(dAtA[i:], m.ReleasedCctxCount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReleasedCctxCount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewTssPubkey) > 0 {
+		i -= len(m.NewTssPubkey)
+		copy(dAtA[i:], m.NewTssPubkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewTssPubkey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PreviousTssPubkey) > 0 {
+		i -= len(m.PreviousTssPubkey)
+		copy(dAtA[i:], m.PreviousTssPubkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousTssPubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventInboundFinalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TxOrgin)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InBlockHeight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReceiverChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RelayedMessage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StatusMessage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SenderChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventZrcWithdrawCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SenderChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReceiverChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventZetaWithdrawCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOutboundFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValueReceived)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOutboundSuccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValueReceived)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCctxStatusChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StatusMessage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAbortedCctxRefunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CoinType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAbortedCctxRetried) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReceiverChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OutboundTxTssNonce)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OutboundTxGasPrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRateLimitUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Zrc20Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Window)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MaxValue)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MaxCctxCount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCctxReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.GasPriceMultiplier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ZetaGasPriceMultiplier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ProtocolFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTssMigrationStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CurrentTssPubkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewTssPubkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.MigrationCctxIndexes) > 0 {
+		for _, s := range m.MigrationCctxIndexes {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventTssMigrationCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreviousTssPubkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewTssPubkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReleasedCctxCount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventInboundFinalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventInboundFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxOrgin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxOrgin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBlockHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InBlockHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayedMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayedMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventZrcWithdrawCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventZrcWithdrawCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventZetaWithdrawCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventZetaWithdrawCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventZetaWithdrawCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutboundFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutboundFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueReceived", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueReceived = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutboundSuccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutboundSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueReceived", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueReceived = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCctxStatusChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCctxStatusChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCctxStatusChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventOutboundSuccess) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.CctxIndex)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.OldStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.NewStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ValueReceived)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
+func (m *EventAbortedCctxRefunded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1178,10 +4331,10 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventInboundFinalized: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAbortedCctxRefunded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventInboundFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAbortedCctxRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1250,7 +4403,7 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1278,11 +4431,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxOrgin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1310,11 +4463,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxOrgin = string(dAtA[iNdEx:postIndex])
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1342,11 +4495,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
+			m.CoinType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1374,11 +4527,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			m.Asset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1406,11 +4559,61 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InBlockHeight = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAbortedCctxRetried) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAbortedCctxRetried: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAbortedCctxRetried: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1438,11 +4641,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1470,11 +4673,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverChain = string(dAtA[iNdEx:postIndex])
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1502,11 +4705,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayedMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1534,11 +4737,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelayedMessage = string(dAtA[iNdEx:postIndex])
+			m.NewStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1566,11 +4769,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			m.ReceiverChain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxTssNonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1598,11 +4801,11 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatusMessage = string(dAtA[iNdEx:postIndex])
+			m.OutboundTxTssNonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxGasPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1630,7 +4833,7 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderChain = string(dAtA[iNdEx:postIndex])
+			m.OutboundTxGasPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1653,7 +4856,7 @@ func (m *EventInboundFinalized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
+func (m *EventRateLimitUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1676,10 +4879,10 @@ func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventZrcWithdrawCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRateLimitUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventZrcWithdrawCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRateLimitUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1716,7 +4919,7 @@ func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1744,11 +4947,11 @@ func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1776,11 +4979,11 @@ func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Zrc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1808,11 +5011,11 @@ func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderChain = string(dAtA[iNdEx:postIndex])
+			m.Window = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1840,11 +5043,11 @@ func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			m.MaxValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCctxCount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1872,11 +5075,11 @@ func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.MaxCctxCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1904,11 +5107,61 @@ func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverChain = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCctxReleased) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCctxReleased: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCctxReleased: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1936,9 +5189,73 @@ func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
 			}
@@ -1991,7 +5308,7 @@ func (m *EventZrcWithdrawCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventZetaWithdrawCreated) Unmarshal(dAtA []byte) error {
+func (m *EventParamsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2014,10 +5331,10 @@ func (m *EventZetaWithdrawCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventZetaWithdrawCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventParamsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventZetaWithdrawCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2053,8 +5370,28 @@ func (m *EventZetaWithdrawCreated) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2082,11 +5419,11 @@ func (m *EventZetaWithdrawCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			m.GasPriceMultiplier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaGasPriceMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2114,11 +5451,11 @@ func (m *EventZetaWithdrawCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ZetaGasPriceMultiplier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2146,11 +5483,11 @@ func (m *EventZetaWithdrawCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			m.ProtocolFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2178,7 +5515,7 @@ func (m *EventZetaWithdrawCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewStatus = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2201,7 +5538,7 @@ func (m *EventZetaWithdrawCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
+func (m *EventTssMigrationStarted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2224,10 +5561,10 @@ func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOutboundFailure: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTssMigrationStarted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOutboundFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTssMigrationStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2264,7 +5601,7 @@ func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTssPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2292,11 +5629,11 @@ func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			m.CurrentTssPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTssPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2324,43 +5661,11 @@ func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldStatus = string(dAtA[iNdEx:postIndex])
+			m.NewTssPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueReceived", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationCctxIndexes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2388,7 +5693,7 @@ func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueReceived = string(dAtA[iNdEx:postIndex])
+			m.MigrationCctxIndexes = append(m.MigrationCctxIndexes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2411,7 +5716,7 @@ func (m *EventOutboundFailure) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
+func (m *EventTssMigrationCompleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2434,10 +5739,10 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOutboundSuccess: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTssMigrationCompleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOutboundSuccess: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTssMigrationCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2474,7 +5779,7 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTssPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2502,11 +5807,11 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			m.PreviousTssPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTssPubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2534,43 +5839,11 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldStatus = string(dAtA[iNdEx:postIndex])
+			m.NewTssPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueReceived", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedCctxCount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2598,7 +5871,7 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueReceived = string(dAtA[iNdEx:postIndex])
+			m.ReleasedCctxCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -56,6 +56,8 @@ type ZetaObserverKeeper interface {
 	GetAllNodeAccount(ctx sdk.Context) (nodeAccounts []zetaObserverTypes.NodeAccount)
 	SetNodeAccount(ctx sdk.Context, nodeAccount zetaObserverTypes.NodeAccount)
 	IsInboundEnabled(ctx sdk.Context) (found bool)
+	IsChainInboundEnabled(ctx sdk.Context, chainID int64) bool
+	IsChainOutboundEnabled(ctx sdk.Context, chainID int64) bool
 	GetCrosschainFlags(ctx sdk.Context) (val zetaObserverTypes.CrosschainFlags, found bool)
 	GetKeygen(ctx sdk.Context) (val zetaObserverTypes.Keygen, found bool)
 	SetKeygen(ctx sdk.Context, keygen zetaObserverTypes.Keygen)
@@ -67,6 +69,12 @@ type ZetaObserverKeeper interface {
 	FindBallot(ctx sdk.Context, index string, chain *common.Chain, observationType zetaObserverTypes.ObservationType) (ballot zetaObserverTypes.Ballot, isNew bool, err error)
 	AddBallotToList(ctx sdk.Context, ballot zetaObserverTypes.Ballot)
 	GetBlockHeader(ctx sdk.Context, hash []byte) (val common.BlockHeader, found bool)
+	IsCanonicalBlockHeader(ctx sdk.Context, header common.BlockHeader) bool
+	GetChainState(ctx sdk.Context, chainID int64) (val zetaObserverTypes.ChainState, found bool)
+	GetRegisteredChains(ctx sdk.Context) []*common.Chain
+	GetChainInfo(ctx sdk.Context, chainID int64) (val common.ChainInfo, found bool)
+	IsEVMChain(ctx sdk.Context, chainID int64) bool
+	IsBitcoinChain(ctx sdk.Context, chainID int64) bool
 }
 
 type FungibleKeeper interface {
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// RemoveVotesBefore removes the votes of the signers who didn't vote since a ZetaChain height
+// the votes must have a vote height
+func (m *GasPrice) RemoveVotesBefore(height int64) {
+	var (
+		signers      []string
+		blockNums    []uint64
+		prices       []uint64
+		priorityFees []uint64
+		voteHeights  []int64
+	)
+	for i, voteHeight := range m.VoteHeights {
+		if voteHeight < height {
+			continue
+		}
+		signers = append(signers, m.Signers[i])
+		blockNums = append(blockNums, m.BlockNums[i])
+		prices = append(prices, m.Prices[i])
+		priorityFees = append(priorityFees, m.PriorityFees[i])
+		voteHeights = append(voteHeights, voteHeight)
+	}
+	m.Signers = signers
+	m.BlockNums = blockNums
+	m.Prices = prices
+	m.PriorityFees = priorityFees
+	m.VoteHeights = voteHeights
+}
+
+// MedianPrice returns the median of the gas prices voted by the signers
+func (m GasPrice) MedianPrice() uint64 {
+	return m.Prices[m.MedianIndex]
+}
+
+// UpdatePrice sets the gas price of the chain to the median of the votes
+// the change of the gas price in an epoch is bounded by maxChangePercent of the gas price at the start of the epoch
+func (m *GasPrice) UpdatePrice(height int64, epochLength int64, maxChangePercent uint32) {
+	median := m.MedianPrice()
+
+	// the gas price is not bounded if the chain has no gas price yet
+	if m.Price == 0 {
+		m.Price = median
+		m.EpochPrice = median
+		m.EpochHeight = height
+		return
+	}
+
+	// start a new epoch from the current gas price
+	if height >= m.EpochHeight+epochLength {
+		m.EpochPrice = m.Price
+		m.EpochHeight = height
+	}
+
+	m.Price = median
+	if maxChangePercent == 0 {
+		return
+	}
+	epochPrice := math.NewUint(m.EpochPrice)
+	maxChange := epochPrice.MulUint64(uint64(maxChangePercent)).QuoUint64(100)
+	if upperBound := epochPrice.Add(maxChange); math.NewUint(median).GT(upperBound) {
+		m.Price = upperBound.Uint64()
+	} else if maxChange.LT(epochPrice) {
+		if lowerBound := epochPrice.Sub(maxChange); math.NewUint(median).LT(lowerBound) {
+			m.Price = lowerBound.Uint64()
+		}
+	}
+}
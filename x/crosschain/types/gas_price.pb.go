@@ -31,6 +31,16 @@ type GasPrice struct {
 	BlockNums   []uint64 `protobuf:"varint,5,rep,packed,name=block_nums,json=blockNums,proto3" json:"block_nums,omitempty"`
 	Prices      []uint64 `protobuf:"varint,6,rep,packed,name=prices,proto3" json:"prices,omitempty"`
 	MedianIndex uint64   `protobuf:"varint,7,opt,name=median_index,json=medianIndex,proto3" json:"median_index,omitempty"`
+	// priority_fees are the priority fees observed by the signers for chains supporting EIP-1559
+	PriorityFees []uint64 `protobuf:"varint,8,rep,packed,name=priority_fees,json=priorityFees,proto3" json:"priority_fees,omitempty"`
+	// vote_heights are the ZetaChain heights at which the signers voted
+	VoteHeights []int64 `protobuf:"varint,9,rep,packed,name=vote_heights,json=voteHeights,proto3" json:"vote_heights,omitempty"`
+	// price is the gas price of the chain: the median of the votes bounded by the maximum change in an epoch
+	Price uint64 `protobuf:"varint,10,opt,name=price,proto3" json:"price,omitempty"`
+	// epoch_price is the gas price of the chain at the start of the current epoch
+	EpochPrice uint64 `protobuf:"varint,11,opt,name=epoch_price,json=epochPrice,proto3" json:"epoch_price,omitempty"`
+	// epoch_height is the ZetaChain height at which the current epoch started
+	EpochHeight int64 `protobuf:"varint,12,opt,name=epoch_height,json=epochHeight,proto3" json:"epoch_height,omitempty"`
 }
 
 func (m *GasPrice) Reset()         { *m = GasPrice{} }
@@ -115,32 +125,146 @@ func (m *GasPrice) GetMedianIndex() uint64 {
 	return 0
 }
 
+func (m *GasPrice) GetPriorityFees() []uint64 {
+	if m != nil {
+		return m.PriorityFees
+	}
+	return nil
+}
+
+func (m *GasPrice) GetVoteHeights() []int64 {
+	if m != nil {
+		return m.VoteHeights
+	}
+	return nil
+}
+
+func (m *GasPrice) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *GasPrice) GetEpochPrice() uint64 {
+	if m != nil {
+		return m.EpochPrice
+	}
+	return 0
+}
+
+func (m *GasPrice) GetEpochHeight() int64 {
+	if m != nil {
+		return m.EpochHeight
+	}
+	return 0
+}
+
+// GasPriceHistoryEntry is the gas price of a chain after a ZetaChain block in which it changed
+type GasPriceHistoryEntry struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height  int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// price is the gas price of the chain used by the protocol
+	Price uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	// median_price is the median of the votes before the maximum change in an epoch is applied
+	MedianPrice uint64 `protobuf:"varint,4,opt,name=median_price,json=medianPrice,proto3" json:"median_price,omitempty"`
+}
+
+func (m *GasPriceHistoryEntry) Reset()         { *m = GasPriceHistoryEntry{} }
+func (m *GasPriceHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*GasPriceHistoryEntry) ProtoMessage()    {}
+func (*GasPriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9c78c67aa323583, []int{1}
+}
+func (m *GasPriceHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceHistoryEntry.Merge(m, src)
+}
+func (m *GasPriceHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceHistoryEntry proto.InternalMessageInfo
+
+func (m *GasPriceHistoryEntry) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *GasPriceHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GasPriceHistoryEntry) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *GasPriceHistoryEntry) GetMedianPrice() uint64 {
+	if m != nil {
+		return m.MedianPrice
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GasPrice)(nil), "zetachain.zetacore.crosschain.GasPrice")
+	proto.RegisterType((*GasPriceHistoryEntry)(nil), "zetachain.zetacore.crosschain.GasPriceHistoryEntry")
 }
 
 func init() { proto.RegisterFile("crosschain/gas_price.proto", fileDescriptor_a9c78c67aa323583) }
 
 var fileDescriptor_a9c78c67aa323583 = []byte{
-	// 276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x41, 0x4a, 0xc4, 0x30,
-	0x14, 0x86, 0x27, 0xb6, 0xd3, 0xce, 0x44, 0x57, 0x41, 0x24, 0x0a, 0x13, 0xaa, 0xab, 0x6e, 0x6c,
-	0x11, 0x6f, 0xe0, 0x46, 0x06, 0x41, 0xa4, 0x4b, 0x37, 0x25, 0x4d, 0x43, 0x27, 0x68, 0x9b, 0x92,
-	0xd7, 0xc2, 0xe8, 0x29, 0x3c, 0x96, 0xee, 0x66, 0xe9, 0x52, 0xda, 0x8b, 0x48, 0xd2, 0x19, 0x74,
-	0x97, 0xef, 0x7f, 0x79, 0x3f, 0x8f, 0x0f, 0x5f, 0x08, 0xa3, 0x01, 0xc4, 0x86, 0xab, 0x26, 0xad,
-	0x38, 0xe4, 0xad, 0x51, 0x42, 0x26, 0xad, 0xd1, 0x9d, 0x26, 0xab, 0x77, 0xd9, 0x71, 0x37, 0x4a,
-	0xdc, 0x4b, 0x1b, 0x99, 0xfc, 0x7d, 0xbf, 0xfa, 0x42, 0x78, 0x71, 0xcf, 0xe1, 0xc9, 0x6e, 0x10,
-	0x8a, 0x43, 0x61, 0x24, 0xef, 0xb4, 0xa1, 0x28, 0x42, 0xf1, 0x32, 0x3b, 0x20, 0x39, 0xc5, 0x73,
-	0xd5, 0x94, 0x72, 0x4b, 0x8f, 0x5c, 0x3e, 0x01, 0x39, 0xc7, 0x0b, 0xd7, 0x92, 0xab, 0x92, 0x7a,
-	0x11, 0x8a, 0xbd, 0x2c, 0x74, 0xbc, 0x2e, 0x6d, 0x15, 0xa8, 0xaa, 0x91, 0x06, 0xa8, 0x1f, 0x79,
-	0xb6, 0x6a, 0x8f, 0x64, 0x85, 0x71, 0xf1, 0xaa, 0xc5, 0x4b, 0xde, 0xf4, 0x35, 0xd0, 0x79, 0xe4,
-	0xc5, 0x7e, 0xb6, 0x74, 0xc9, 0x63, 0x5f, 0x03, 0x39, 0xc3, 0x81, 0x3b, 0x1f, 0x68, 0xe0, 0x46,
-	0x7b, 0x22, 0x97, 0xf8, 0xa4, 0x96, 0xa5, 0xe2, 0x4d, 0x3e, 0x1d, 0x12, 0x46, 0x28, 0xf6, 0xb3,
-	0xe3, 0x29, 0x5b, 0xdb, 0xe8, 0xee, 0xe1, 0x73, 0x60, 0x68, 0x37, 0x30, 0xf4, 0x33, 0x30, 0xf4,
-	0x31, 0xb2, 0xd9, 0x6e, 0x64, 0xb3, 0xef, 0x91, 0xcd, 0x9e, 0x6f, 0x2a, 0xd5, 0x6d, 0xfa, 0x22,
-	0x11, 0xba, 0x4e, 0xad, 0x85, 0xeb, 0xc9, 0xd5, 0x41, 0x48, 0xba, 0x4d, 0xff, 0x19, 0xec, 0xde,
-	0x5a, 0x09, 0x45, 0xe0, 0xf4, 0xdd, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x63, 0xdc, 0xbf, 0x3d,
-	0x5c, 0x01, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0xb1, 0x8e, 0x9b, 0x40,
+	0x14, 0xf4, 0x1a, 0x0e, 0xdb, 0x0f, 0xd2, 0xac, 0x4e, 0xa7, 0x4d, 0xa4, 0x23, 0xdc, 0xa5, 0xa1,
+	0x39, 0x28, 0xf2, 0x07, 0x91, 0x92, 0xbb, 0x6b, 0xa2, 0x88, 0x32, 0x0d, 0xc2, 0xb0, 0x81, 0x55,
+	0x02, 0x8b, 0x76, 0xd7, 0x91, 0x49, 0x99, 0x2f, 0xc8, 0x67, 0xa5, 0x74, 0x99, 0x32, 0xb2, 0xcb,
+	0xfc, 0x44, 0xc4, 0x5b, 0x2c, 0xdb, 0x1d, 0x33, 0xb3, 0x6f, 0xde, 0x30, 0x7a, 0xf0, 0xaa, 0x54,
+	0x52, 0xeb, 0xb2, 0x29, 0x44, 0x97, 0xd6, 0x85, 0xce, 0x7b, 0x25, 0x4a, 0x9e, 0xf4, 0x4a, 0x1a,
+	0x49, 0x6f, 0x7f, 0x70, 0x53, 0xa0, 0x94, 0xe0, 0x97, 0x54, 0x3c, 0x39, 0x3d, 0xbf, 0xff, 0x37,
+	0x87, 0xe5, 0x63, 0xa1, 0x3f, 0x8d, 0x13, 0x94, 0xc1, 0xa2, 0x54, 0xbc, 0x30, 0x52, 0x31, 0x12,
+	0x91, 0x78, 0x95, 0x1d, 0x21, 0xbd, 0x86, 0x2b, 0xd1, 0x55, 0x7c, 0xcb, 0xe6, 0xc8, 0x5b, 0x40,
+	0x5f, 0xc2, 0x12, 0x5d, 0x72, 0x51, 0x31, 0x27, 0x22, 0xb1, 0x93, 0x2d, 0x10, 0x3f, 0x57, 0xa3,
+	0x95, 0x16, 0x75, 0xc7, 0x95, 0x66, 0x6e, 0xe4, 0x8c, 0x56, 0x13, 0xa4, 0xb7, 0x00, 0xeb, 0x6f,
+	0xb2, 0xfc, 0x9a, 0x77, 0x9b, 0x56, 0xb3, 0xab, 0xc8, 0x89, 0xdd, 0x6c, 0x85, 0xcc, 0xc7, 0x4d,
+	0xab, 0xe9, 0x0d, 0x78, 0x18, 0x5f, 0x33, 0x0f, 0xa5, 0x09, 0xd1, 0x3b, 0x08, 0x5a, 0x5e, 0x89,
+	0xa2, 0xcb, 0x6d, 0x90, 0x45, 0x44, 0x62, 0x37, 0xf3, 0x2d, 0xf7, 0x8c, 0x71, 0xde, 0xc0, 0x8b,
+	0x5e, 0x09, 0xa9, 0x84, 0x19, 0xf2, 0x2f, 0x9c, 0x6b, 0xb6, 0x44, 0x87, 0xe0, 0x48, 0x7e, 0xe0,
+	0xd6, 0xe7, 0xbb, 0x34, 0x3c, 0x6f, 0xb8, 0xa8, 0x1b, 0xa3, 0xd9, 0x2a, 0x72, 0x62, 0x27, 0xf3,
+	0x47, 0xee, 0xc9, 0x52, 0xe3, 0xcf, 0xe2, 0x52, 0x06, 0xb8, 0xc3, 0x02, 0xfa, 0x1a, 0x7c, 0xde,
+	0xcb, 0xb2, 0xb1, 0xed, 0x32, 0x1f, 0x35, 0x40, 0xca, 0xb6, 0x77, 0x07, 0x81, 0x7d, 0x60, 0xad,
+	0x59, 0x80, 0x8d, 0xd8, 0x21, 0x6b, 0x7d, 0xff, 0x93, 0xc0, 0xf5, 0xb1, 0xed, 0x27, 0xa1, 0x8d,
+	0x54, 0xc3, 0xfb, 0xce, 0xa8, 0xe1, 0xa2, 0x49, 0x72, 0xd9, 0xe4, 0x0d, 0x78, 0x93, 0xe1, 0x1c,
+	0x85, 0x09, 0x9d, 0x52, 0x3a, 0xe7, 0x29, 0x4f, 0x35, 0x59, 0xd1, 0x3d, 0xaf, 0x09, 0xf7, 0xbe,
+	0x7b, 0xfc, 0xbd, 0x0f, 0xc9, 0x6e, 0x1f, 0x92, 0xbf, 0xfb, 0x90, 0xfc, 0x3a, 0x84, 0xb3, 0xdd,
+	0x21, 0x9c, 0xfd, 0x39, 0x84, 0xb3, 0xcf, 0x0f, 0xb5, 0x30, 0xcd, 0x66, 0x9d, 0x94, 0xb2, 0x4d,
+	0xc7, 0x63, 0x79, 0xb0, 0x27, 0xd5, 0xc9, 0x8a, 0xa7, 0xdb, 0xf4, 0xec, 0xc8, 0xcc, 0xd0, 0x73,
+	0xbd, 0xf6, 0xf0, 0xc2, 0xde, 0xfe, 0x1f, 0x00, 0xba, 0xff, 0x8f, 0xfd, 0x7f, 0x02, 0x00, 0x00,
 }
 
 func (m *GasPrice) Marshal() (dAtA []byte, err error) {
@@ -163,15 +287,26 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MedianIndex != 0 {
-		i = encodeVarintGasPrice(dAtA, i, uint64(m.MedianIndex))
+	if m.EpochHeight != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.EpochHeight))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x60
 	}
-	if len(m.Prices) > 0 {
-		dAtA2 := make([]byte, len(m.Prices)*10)
+	if m.EpochPrice != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.EpochPrice))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Price != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.VoteHeights) > 0 {
+		dAtA2 := make([]byte, len(m.VoteHeights)*10)
 		var j1 int
-		for _, num := range m.Prices {
+		for _, num1 := range m.VoteHeights {
+			num := uint64(num1)
 			for num >= 1<<7 {
 				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -184,12 +319,12 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA2[:j1])
 		i = encodeVarintGasPrice(dAtA, i, uint64(j1))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x4a
 	}
-	if len(m.BlockNums) > 0 {
-		dAtA4 := make([]byte, len(m.BlockNums)*10)
+	if len(m.PriorityFees) > 0 {
+		dAtA4 := make([]byte, len(m.PriorityFees)*10)
 		var j3 int
-		for _, num := range m.BlockNums {
+		for _, num := range m.PriorityFees {
 			for num >= 1<<7 {
 				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -202,6 +337,47 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA4[:j3])
 		i = encodeVarintGasPrice(dAtA, i, uint64(j3))
 		i--
+		dAtA[i] = 0x42
+	}
+	if m.MedianIndex != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.MedianIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Prices) > 0 {
+		dAtA6 := make([]byte, len(m.Prices)*10)
+		var j5 int
+		for _, num := range m.Prices {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGasPrice(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BlockNums) > 0 {
+		dAtA8 := make([]byte, len(m.BlockNums)*10)
+		var j7 int
+		for _, num := range m.BlockNums {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintGasPrice(dAtA, i, uint64(j7))
+		i--
 		dAtA[i] = 0x2a
 	}
 	if len(m.Signers) > 0 {
@@ -235,6 +411,49 @@ func (m *GasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasPriceHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MedianPrice != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.MedianPrice))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Price != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.Price))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintGasPrice(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGasPrice(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGasPrice(v)
 	base := offset
@@ -286,6 +505,50 @@ func (m *GasPrice) Size() (n int) {
 	if m.MedianIndex != 0 {
 		n += 1 + sovGasPrice(uint64(m.MedianIndex))
 	}
+	if len(m.PriorityFees) > 0 {
+		l = 0
+		for _, e := range m.PriorityFees {
+			l += sovGasPrice(uint64(e))
+		}
+		n += 1 + sovGasPrice(uint64(l)) + l
+	}
+	if len(m.VoteHeights) > 0 {
+		l = 0
+		for _, e := range m.VoteHeights {
+			l += sovGasPrice(uint64(e))
+		}
+		n += 1 + sovGasPrice(uint64(l)) + l
+	}
+	if m.Price != 0 {
+		n += 1 + sovGasPrice(uint64(m.Price))
+	}
+	if m.EpochPrice != 0 {
+		n += 1 + sovGasPrice(uint64(m.EpochPrice))
+	}
+	if m.EpochHeight != 0 {
+		n += 1 + sovGasPrice(uint64(m.EpochHeight))
+	}
+	return n
+}
+
+func (m *GasPriceHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovGasPrice(uint64(m.ChainId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGasPrice(uint64(m.Height))
+	}
+	if m.Price != 0 {
+		n += 1 + sovGasPrice(uint64(m.Price))
+	}
+	if m.MedianPrice != 0 {
+		n += 1 + sovGasPrice(uint64(m.MedianPrice))
+	}
 	return n
 }
 
@@ -610,6 +873,341 @@ func (m *GasPrice) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PriorityFees = append(m.PriorityFees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PriorityFees) == 0 {
+					m.PriorityFees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasPrice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PriorityFees = append(m.PriorityFees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFees", wireType)
+			}
+		case 9:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.VoteHeights = append(m.VoteHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasPrice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasPrice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.VoteHeights) == 0 {
+					m.VoteHeights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasPrice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.VoteHeights = append(m.VoteHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteHeights", wireType)
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochPrice", wireType)
+			}
+			m.EpochPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochHeight", wireType)
+			}
+			m.EpochHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasPrice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasPrice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasPrice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianPrice", wireType)
+			}
+			m.MedianPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MedianPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasPrice(dAtA[iNdEx:])
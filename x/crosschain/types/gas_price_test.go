@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestGasPrice_RemoveVotesBefore(t *testing.T) {
+	gasPrice := types.GasPrice{
+		Signers:      []string{"a", "b", "c"},
+		BlockNums:    []uint64{1, 2, 3},
+		Prices:       []uint64{10, 20, 30},
+		PriorityFees: []uint64{1, 2, 3},
+		VoteHeights:  []int64{100, 50, 150},
+	}
+	gasPrice.RemoveVotesBefore(100)
+	require.Equal(t, []string{"a", "c"}, gasPrice.Signers)
+	require.Equal(t, []uint64{1, 3}, gasPrice.BlockNums)
+	require.Equal(t, []uint64{10, 30}, gasPrice.Prices)
+	require.Equal(t, []uint64{1, 3}, gasPrice.PriorityFees)
+	require.Equal(t, []int64{100, 150}, gasPrice.VoteHeights)
+}
+
+func TestGasPrice_UpdatePrice(t *testing.T) {
+	t.Run("sets the median if the chain has no gas price", func(t *testing.T) {
+		gasPrice := types.GasPrice{Prices: []uint64{10, 1000}, MedianIndex: 1}
+		gasPrice.UpdatePrice(5, 100, 10)
+		require.Equal(t, uint64(1000), gasPrice.Price)
+		require.Equal(t, uint64(1000), gasPrice.EpochPrice)
+		require.Equal(t, int64(5), gasPrice.EpochHeight)
+	})
+
+	t.Run("bounds the change of the gas price in an epoch", func(t *testing.T) {
+		gasPrice := types.GasPrice{Prices: []uint64{1000}, Price: 100, EpochPrice: 100, EpochHeight: 5}
+		gasPrice.UpdatePrice(10, 100, 10)
+		require.Equal(t, uint64(110), gasPrice.Price)
+		require.Equal(t, uint64(100), gasPrice.EpochPrice)
+
+		gasPrice.Prices = []uint64{1}
+		gasPrice.UpdatePrice(11, 100, 10)
+		require.Equal(t, uint64(90), gasPrice.Price)
+
+		gasPrice.Prices = []uint64{95}
+		gasPrice.UpdatePrice(12, 100, 10)
+		require.Equal(t, uint64(95), gasPrice.Price)
+
+		// a decrease of more than 100% is bounded at zero
+		gasPrice.Prices = []uint64{1}
+		gasPrice.UpdatePrice(13, 100, 200)
+		require.Equal(t, uint64(1), gasPrice.Price)
+	})
+
+	t.Run("starts a new epoch from the current gas price", func(t *testing.T) {
+		gasPrice := types.GasPrice{Prices: []uint64{1000}, Price: 110, EpochPrice: 100, EpochHeight: 5}
+		gasPrice.UpdatePrice(105, 100, 10)
+		require.Equal(t, uint64(121), gasPrice.Price)
+		require.Equal(t, uint64(110), gasPrice.EpochPrice)
+		require.Equal(t, int64(105), gasPrice.EpochHeight)
+	})
+
+	t.Run("doesn't bound the gas price without maximum change", func(t *testing.T) {
+		gasPrice := types.GasPrice{Prices: []uint64{1000}, Price: 100, EpochPrice: 100, EpochHeight: 5}
+		gasPrice.UpdatePrice(10, 100, 0)
+		require.Equal(t, uint64(1000), gasPrice.Price)
+	})
+}
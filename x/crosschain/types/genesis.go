@@ -13,6 +13,7 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default crosschain genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		Params:             DefaultParams(),
 		OutTxTrackerList:   []OutTxTracker{},
 		InTxHashToCctxList: []InTxHashToCctx{},
 		GasPriceList:       []*GasPrice{},
@@ -66,6 +67,33 @@ func (gs GenesisState) Validate() error {
 		chainNoncesIndexMap[elem.Index] = true
 	}
 
+	// Check for duplicated and invalid rate limits
+	rateLimitIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.RateLimits {
+		index := string(RateLimitKey(elem.ChainId, elem.Zrc20Address))
+		if _, ok := rateLimitIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for rateLimit")
+		}
+		rateLimitIndexMap[index] = true
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated tss fund migrators, they are only defined for a migration in progress
+	tssFundMigratorIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.TssFundMigrators {
+		if _, ok := tssFundMigratorIndexMap[elem.MigrationCctxIndex]; ok {
+			return fmt.Errorf("duplicated index for tssFundMigrator")
+		}
+		tssFundMigratorIndexMap[elem.MigrationCctxIndex] = true
+	}
+	if len(gs.TssFundMigrators) > 0 && gs.PendingMigrationTss == nil {
+		return fmt.Errorf("tss fund migrators defined without a pending migration tss")
+	}
+
 	// Check for duplicated index in send
 	//sendIndexMap := make(map[string]bool)
 
@@ -26,15 +26,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the metacore module's genesis state.
 type GenesisState struct {
-	Params              Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	OutTxTrackerList    []OutTxTracker     `protobuf:"bytes,2,rep,name=outTxTrackerList,proto3" json:"outTxTrackerList"`
-	Tss                 *TSS               `protobuf:"bytes,4,opt,name=tss,proto3" json:"tss,omitempty"`
-	GasPriceList        []*GasPrice        `protobuf:"bytes,5,rep,name=gasPriceList,proto3" json:"gasPriceList,omitempty"`
-	ChainNoncesList     []*ChainNonces     `protobuf:"bytes,6,rep,name=chainNoncesList,proto3" json:"chainNoncesList,omitempty"`
-	CrossChainTxs       []*CrossChainTx    `protobuf:"bytes,7,rep,name=CrossChainTxs,proto3" json:"CrossChainTxs,omitempty"`
-	LastBlockHeightList []*LastBlockHeight `protobuf:"bytes,8,rep,name=lastBlockHeightList,proto3" json:"lastBlockHeightList,omitempty"`
-	InTxHashToCctxList  []InTxHashToCctx   `protobuf:"bytes,9,rep,name=inTxHashToCctxList,proto3" json:"inTxHashToCctxList"`
-	TssHistory          []TSS              `protobuf:"bytes,10,rep,name=tss_history,json=tssHistory,proto3" json:"tss_history"`
+	Params              Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	OutTxTrackerList    []OutTxTracker        `protobuf:"bytes,2,rep,name=outTxTrackerList,proto3" json:"outTxTrackerList"`
+	Tss                 *TSS                  `protobuf:"bytes,4,opt,name=tss,proto3" json:"tss,omitempty"`
+	GasPriceList        []*GasPrice           `protobuf:"bytes,5,rep,name=gasPriceList,proto3" json:"gasPriceList,omitempty"`
+	ChainNoncesList     []*ChainNonces        `protobuf:"bytes,6,rep,name=chainNoncesList,proto3" json:"chainNoncesList,omitempty"`
+	CrossChainTxs       []*CrossChainTx       `protobuf:"bytes,7,rep,name=CrossChainTxs,proto3" json:"CrossChainTxs,omitempty"`
+	LastBlockHeightList []*LastBlockHeight    `protobuf:"bytes,8,rep,name=lastBlockHeightList,proto3" json:"lastBlockHeightList,omitempty"`
+	InTxHashToCctxList  []InTxHashToCctx      `protobuf:"bytes,9,rep,name=inTxHashToCctxList,proto3" json:"inTxHashToCctxList"`
+	TssHistory          []TSS                 `protobuf:"bytes,10,rep,name=tss_history,json=tssHistory,proto3" json:"tss_history"`
+	RateLimits          []RateLimit           `protobuf:"bytes,11,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	PendingMigrationTss *TSS                  `protobuf:"bytes,12,opt,name=pending_migration_tss,json=pendingMigrationTss,proto3" json:"pending_migration_tss,omitempty"`
+	TssFundMigrators    []TssFundMigratorInfo `protobuf:"bytes,13,rep,name=tss_fund_migrators,json=tssFundMigrators,proto3" json:"tss_fund_migrators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -133,6 +136,27 @@ func (m *GenesisState) GetTssHistory() []TSS {
 	return nil
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingMigrationTss() *TSS {
+	if m != nil {
+		return m.PendingMigrationTss
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTssFundMigrators() []TssFundMigratorInfo {
+	if m != nil {
+		return m.TssFundMigrators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.crosschain.GenesisState")
 }
@@ -140,38 +164,44 @@ func init() {
 func init() { proto.RegisterFile("crosschain/genesis.proto", fileDescriptor_dd51403692d571f4) }
 
 var fileDescriptor_dd51403692d571f4 = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xd1, 0x6a, 0x13, 0x41,
-	0x14, 0x86, 0xb3, 0xb6, 0x8d, 0x3a, 0xa9, 0x28, 0xa3, 0xe0, 0x12, 0xe8, 0xb6, 0x44, 0xc4, 0xa2,
-	0x74, 0x17, 0xab, 0x4f, 0x90, 0x5c, 0x34, 0xa5, 0x45, 0xeb, 0x66, 0xaf, 0x04, 0x59, 0x27, 0xc3,
-	0xb0, 0xbb, 0xb4, 0xcd, 0x84, 0x3d, 0x27, 0xb0, 0xf5, 0x29, 0x7c, 0xac, 0x5e, 0xf6, 0xd2, 0x2b,
-	0x91, 0xe4, 0x05, 0x7c, 0x04, 0x99, 0xb3, 0xd3, 0x76, 0xa2, 0xc1, 0xed, 0xcd, 0x72, 0xd8, 0xf3,
-	0xff, 0xdf, 0xf9, 0x99, 0x39, 0xc3, 0x7c, 0x59, 0x6a, 0x00, 0x99, 0x8b, 0x62, 0x12, 0x65, 0x6a,
-	0xa2, 0xa0, 0x80, 0x70, 0x5a, 0x6a, 0xd4, 0x7c, 0xeb, 0x9b, 0x42, 0x41, 0x8d, 0x90, 0x2a, 0x5d,
-	0xaa, 0xf0, 0x56, 0xdc, 0xdd, 0x72, 0x8c, 0xf4, 0x4d, 0x27, 0x7a, 0x22, 0x95, 0x75, 0x77, 0xb7,
-	0xdd, 0xb6, 0x29, 0xd3, 0x5a, 0x84, 0x95, 0x15, 0x74, 0xdd, 0xc1, 0x02, 0xd2, 0x69, 0x59, 0x48,
-	0x65, 0x7b, 0x2f, 0x9c, 0x1e, 0x79, 0xd2, 0x5c, 0x40, 0x9e, 0xa2, 0x4e, 0xa5, 0xbc, 0x01, 0xf4,
-	0x1c, 0xd1, 0x99, 0x00, 0x4c, 0xc7, 0x67, 0x5a, 0x9e, 0xa6, 0xb9, 0x2a, 0xb2, 0x1c, 0x57, 0xa4,
-	0xd0, 0x33, 0x34, 0x24, 0x2c, 0x85, 0x3c, 0x55, 0xa5, 0x15, 0x3c, 0x77, 0x04, 0x53, 0x51, 0x8a,
-	0xf3, 0xeb, 0xfc, 0xcf, 0x9c, 0x06, 0xc2, 0xcd, 0xdf, 0x4c, 0x67, 0x9a, 0xca, 0xc8, 0x54, 0xf5,
-	0xdf, 0xde, 0xef, 0x0d, 0xb6, 0x79, 0x50, 0x9f, 0xdd, 0x08, 0x05, 0x2a, 0x3e, 0x60, 0xed, 0x1a,
-	0xe6, 0x7b, 0x3b, 0xde, 0x6e, 0x67, 0xff, 0x65, 0xf8, 0xdf, 0xb3, 0x0c, 0x4f, 0x48, 0xdc, 0x5f,
-	0xbf, 0xfc, 0xb9, 0xdd, 0x8a, 0xad, 0x95, 0x7f, 0x61, 0x4f, 0xf4, 0x0c, 0x93, 0x2a, 0xa9, 0x03,
-	0x1f, 0x17, 0x80, 0xfe, 0xbd, 0x9d, 0xb5, 0xdd, 0xce, 0xfe, 0x9b, 0x06, 0xdc, 0x47, 0xc7, 0x66,
-	0xa1, 0xff, 0xa0, 0xf8, 0x7b, 0xb6, 0x86, 0x00, 0xfe, 0x3a, 0x05, 0xec, 0x35, 0x10, 0x93, 0xd1,
-	0x28, 0x36, 0x72, 0x7e, 0xc4, 0x36, 0x33, 0x01, 0x27, 0xe6, 0xae, 0x28, 0xd0, 0x06, 0x05, 0x7a,
-	0xd5, 0x60, 0x3f, 0xb0, 0x96, 0x78, 0xc9, 0xcc, 0x13, 0xf6, 0x98, 0xfa, 0x1f, 0x68, 0x71, 0x88,
-	0xd7, 0x26, 0xde, 0xeb, 0x06, 0xde, 0xe0, 0xd6, 0x15, 0xff, 0x8d, 0xe0, 0x9f, 0xd8, 0xa3, 0x81,
-	0x91, 0x92, 0x28, 0xa9, 0xc0, 0xbf, 0x7f, 0xa7, 0x43, 0x73, 0x3d, 0xf1, 0x32, 0x81, 0x7f, 0x65,
-	0x4f, 0xcd, 0x86, 0xf5, 0xcd, 0x82, 0x0d, 0x69, 0xbf, 0x28, 0xec, 0x03, 0x02, 0x87, 0x0d, 0xe0,
-	0xe3, 0x65, 0x67, 0xbc, 0x0a, 0xc5, 0x25, 0xe3, 0x66, 0xd4, 0x50, 0x40, 0x9e, 0xe8, 0x81, 0xc4,
-	0x8a, 0x06, 0x3c, 0xa4, 0x01, 0x7b, 0x0d, 0x03, 0x0e, 0x97, 0x8c, 0xf6, 0xc2, 0x57, 0xe0, 0xf8,
-	0x21, 0xeb, 0x20, 0x40, 0x9a, 0x17, 0x80, 0xba, 0xbc, 0xf0, 0x19, 0xd1, 0xef, 0x70, 0xf5, 0x16,
-	0xc9, 0x10, 0x60, 0x58, 0x7b, 0xfb, 0x47, 0x97, 0xf3, 0xc0, 0xbb, 0x9a, 0x07, 0xde, 0xaf, 0x79,
-	0xe0, 0x7d, 0x5f, 0x04, 0xad, 0xab, 0x45, 0xd0, 0xfa, 0xb1, 0x08, 0x5a, 0x9f, 0xdf, 0x66, 0x05,
-	0xe6, 0xb3, 0x71, 0x28, 0xf5, 0x79, 0x64, 0x78, 0x7b, 0xf5, 0x1b, 0xba, 0x46, 0x47, 0x55, 0xe4,
-	0xbe, 0xac, 0x8b, 0xa9, 0x82, 0x71, 0x9b, 0x9e, 0xd1, 0xbb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xe0, 0xed, 0xc4, 0xaf, 0x8c, 0x04, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x6e, 0xd3, 0x3e,
+	0x14, 0xc6, 0xdb, 0xff, 0xf6, 0x1f, 0xc3, 0xdd, 0x04, 0xf2, 0x86, 0x88, 0x86, 0x96, 0x4d, 0x43,
+	0x88, 0x09, 0xd4, 0x54, 0x2a, 0x3c, 0x41, 0x2b, 0xd1, 0x56, 0x74, 0x6c, 0xa4, 0x11, 0x17, 0x48,
+	0xc8, 0xb8, 0xa9, 0x9b, 0x58, 0x6b, 0xe3, 0xca, 0xc7, 0x95, 0x3a, 0xee, 0x78, 0x03, 0x1e, 0x6b,
+	0x97, 0xbb, 0xe4, 0x0a, 0xa1, 0xf6, 0x45, 0x90, 0x1d, 0x87, 0xa6, 0x50, 0x91, 0xde, 0x44, 0x56,
+	0xbe, 0xef, 0xfb, 0x9d, 0x13, 0xfb, 0xc4, 0xc8, 0x09, 0xa5, 0x00, 0x08, 0x63, 0xca, 0x93, 0x5a,
+	0xc4, 0x12, 0x06, 0x1c, 0xbc, 0x89, 0x14, 0x4a, 0xe0, 0xe3, 0x2f, 0x4c, 0x51, 0x23, 0x78, 0x66,
+	0x25, 0x24, 0xf3, 0x96, 0xe6, 0xa3, 0xe3, 0x5c, 0xd0, 0x3c, 0x49, 0x22, 0x92, 0x90, 0xd9, 0xf4,
+	0xd1, 0x49, 0x5e, 0xd6, 0x4b, 0x92, 0x9a, 0xd4, 0xcc, 0x1a, 0x8e, 0xf2, 0x85, 0x29, 0x90, 0x89,
+	0xe4, 0x21, 0xb3, 0xda, 0xd3, 0x9c, 0x66, 0x32, 0x24, 0xa6, 0x10, 0x13, 0x25, 0x48, 0x18, 0xfe,
+	0x06, 0x9c, 0xe5, 0x4c, 0x23, 0x0a, 0x8a, 0xf4, 0x47, 0x22, 0xbc, 0x26, 0x31, 0xe3, 0x51, 0xac,
+	0xd6, 0x74, 0x21, 0xa6, 0x4a, 0x93, 0x94, 0xa4, 0xe1, 0x35, 0x93, 0xd6, 0xf0, 0x38, 0x67, 0x98,
+	0x50, 0x49, 0xc7, 0x59, 0xff, 0x4f, 0x72, 0x82, 0xa4, 0x8a, 0x91, 0x11, 0x1f, 0xf3, 0x0c, 0x7b,
+	0x98, 0x13, 0x15, 0x64, 0x91, 0xc3, 0x48, 0x44, 0xc2, 0x2c, 0x6b, 0x7a, 0x95, 0xbe, 0x3d, 0xfb,
+	0xba, 0x8b, 0xf6, 0x5a, 0xe9, 0xc6, 0xf6, 0x14, 0x55, 0x0c, 0x37, 0xd1, 0x4e, 0x5a, 0xc9, 0x29,
+	0x9f, 0x96, 0xcf, 0x2b, 0xf5, 0x67, 0xde, 0x3f, 0x37, 0xda, 0xbb, 0x32, 0xe6, 0xc6, 0xf6, 0xed,
+	0x8f, 0x93, 0x92, 0x6f, 0xa3, 0xf8, 0x13, 0x7a, 0x28, 0xa6, 0x2a, 0x98, 0x05, 0xe9, 0xd7, 0x74,
+	0x39, 0x28, 0xe7, 0xbf, 0xd3, 0xad, 0xf3, 0x4a, 0xfd, 0x65, 0x01, 0xee, 0x32, 0x17, 0xb3, 0xd0,
+	0xbf, 0x50, 0xf8, 0x35, 0xda, 0x52, 0x00, 0xce, 0xb6, 0x69, 0xf0, 0xac, 0x80, 0x18, 0xf4, 0x7a,
+	0xbe, 0xb6, 0xe3, 0xb7, 0x68, 0x2f, 0xa2, 0x70, 0xa5, 0x0f, 0xd2, 0x34, 0xf4, 0xbf, 0x69, 0xe8,
+	0x79, 0x41, 0xbc, 0x65, 0x23, 0xfe, 0x4a, 0x18, 0x07, 0xe8, 0x81, 0xd1, 0xdf, 0x99, 0xa9, 0x32,
+	0xbc, 0x1d, 0xc3, 0x7b, 0x51, 0xc0, 0x6b, 0x2e, 0x53, 0xfe, 0x9f, 0x08, 0xfc, 0x1e, 0xed, 0x37,
+	0xb5, 0xd5, 0x98, 0x82, 0x19, 0x38, 0xf7, 0x36, 0xda, 0xb4, 0x7c, 0xc6, 0x5f, 0x25, 0xe0, 0xcf,
+	0xe8, 0x40, 0x8f, 0x5f, 0x43, 0x4f, 0x5f, 0xdb, 0x0c, 0x9f, 0x69, 0x76, 0xd7, 0x80, 0xbd, 0x02,
+	0x70, 0x77, 0x35, 0xe9, 0xaf, 0x43, 0xe1, 0x10, 0x61, 0x5d, 0xaa, 0x4d, 0x21, 0x0e, 0x44, 0x33,
+	0x54, 0x33, 0x53, 0xe0, 0xbe, 0x29, 0x50, 0x2d, 0x28, 0xd0, 0x59, 0x09, 0xda, 0x03, 0x5f, 0x83,
+	0xc3, 0x1d, 0x54, 0x51, 0x00, 0x24, 0xe6, 0xa0, 0x84, 0xbc, 0x71, 0xd0, 0xe9, 0xd6, 0x66, 0x47,
+	0x6f, 0x91, 0x48, 0x01, 0xb4, 0xd3, 0x2c, 0xbe, 0x44, 0x95, 0xe5, 0x2f, 0x03, 0x4e, 0xc5, 0xa0,
+	0xce, 0x0b, 0x50, 0x3e, 0x55, 0xac, 0xab, 0x03, 0x19, 0x50, 0x66, 0x2f, 0x00, 0x7f, 0x40, 0x8f,
+	0x26, 0x2c, 0x19, 0xf0, 0x24, 0x22, 0x63, 0x1e, 0x49, 0xaa, 0xb8, 0x48, 0x88, 0x1e, 0xd0, 0xbd,
+	0x8d, 0x07, 0xf4, 0xc0, 0x02, 0x2e, 0xb2, 0x7c, 0x00, 0x80, 0x87, 0x08, 0xeb, 0x6f, 0x1e, 0x4e,
+	0x93, 0x81, 0x05, 0x0b, 0x09, 0xce, 0xbe, 0xe9, 0xb7, 0x5e, 0x04, 0x05, 0x78, 0x33, 0x4d, 0x06,
+	0x17, 0x36, 0xd6, 0x49, 0x86, 0x22, 0xfb, 0x9d, 0xd4, 0xaa, 0x04, 0x8d, 0xd6, 0xed, 0xdc, 0x2d,
+	0xdf, 0xcd, 0xdd, 0xf2, 0xcf, 0xb9, 0x5b, 0xfe, 0xb6, 0x70, 0x4b, 0x77, 0x0b, 0xb7, 0xf4, 0x7d,
+	0xe1, 0x96, 0x3e, 0x56, 0x23, 0xae, 0xe2, 0x69, 0xdf, 0x0b, 0xc5, 0xb8, 0xa6, 0xab, 0x54, 0xd3,
+	0x4b, 0x25, 0x11, 0x03, 0x56, 0x9b, 0xd5, 0xf2, 0xd7, 0xcc, 0xcd, 0x84, 0x41, 0x7f, 0xc7, 0xdc,
+	0x29, 0xaf, 0x7e, 0x0d, 0x00, 0x7c, 0x06, 0x77, 0x46, 0xb6, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +224,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TssFundMigrators) > 0 {
+		for iNdEx := len(m.TssFundMigrators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TssFundMigrators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.PendingMigrationTss != nil {
+		{
+			size, err := m.PendingMigrationTss.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.TssHistory) > 0 {
 		for iNdEx := len(m.TssHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -382,6 +452,22 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingMigrationTss != nil {
+		l = m.PendingMigrationTss.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TssFundMigrators) > 0 {
+		for _, e := range m.TssFundMigrators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -727,6 +813,110 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMigrationTss", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingMigrationTss == nil {
+				m.PendingMigrationTss = &TSS{}
+			}
+			if err := m.PendingMigrationTss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssFundMigrators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssFundMigrators = append(m.TssFundMigrators, TssFundMigratorInfo{})
+			if err := m.TssFundMigrators[len(m.TssFundMigrators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -78,6 +78,65 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated rateLimit",
+			genState: &types.GenesisState{
+				RateLimits: []types.RateLimit{
+					{
+						ChainId:      1,
+						Window:       10,
+						MaxCctxCount: 10,
+					},
+					{
+						ChainId:      1,
+						Window:       20,
+						MaxCctxCount: 20,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated tssFundMigrator",
+			genState: &types.GenesisState{
+				PendingMigrationTss: &types.TSS{TssPubkey: "pubkey"},
+				TssFundMigrators: []types.TssFundMigratorInfo{
+					{
+						ChainId:            1,
+						MigrationCctxIndex: "0",
+					},
+					{
+						ChainId:            2,
+						MigrationCctxIndex: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "tssFundMigrator without pending migration tss",
+			genState: &types.GenesisState{
+				TssFundMigrators: []types.TssFundMigratorInfo{
+					{
+						ChainId:            1,
+						MigrationCctxIndex: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid rateLimit",
+			genState: &types.GenesisState{
+				RateLimits: []types.RateLimit{
+					{
+						ChainId:      1,
+						MaxCctxCount: 10,
+					},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
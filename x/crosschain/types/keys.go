@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -23,13 +24,14 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_metacore"
-
-	ProtocolFee = 2000000000000000000
 )
 
-func GetProtocolFee() sdk.Uint {
-	return sdk.NewUint(ProtocolFee)
-}
+const (
+	ParamEnabled                = "Enabled"
+	ParamGasPriceMultiplier     = "GasPriceMultiplier"
+	ParamZetaGasPriceMultiplier = "ZetaGasPriceMultiplier"
+	ParamProtocolFee            = "ProtocolFee"
+)
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
@@ -48,15 +50,31 @@ const (
 	LastBlockHeightKey   = "LastBlockHeight-value-"
 	ChainNoncesKey       = "ChainNonces-value-"
 	GasPriceKey          = "GasPrice-value-"
+	GasPriceHistoryKey   = "GasPriceHistory-value-"
 
 	GasBalanceKey = "GasBalance-value-"
 	TSSKey        = "TSS-value-"
 	TSSHistoryKey = "TSS-History-value-"
 
+	// the TSS pending migration becomes the current TSS once the cctxs migrating the funds of the current TSS are mined
+	PendingMigrationTSSKey   = "PendingMigrationTSS-value-"
+	TssFundMigratorKeyPrefix = "TssFundMigrator-value-"
+
 	OutTxTrackerKeyPrefix = "OutTxTracker-value-"
 
 	NonceToCctxKeyPrefix   = "NonceToCctx-value-"
 	PendingNoncesKeyPrefix = "PendingNonces-value-"
+
+	RateLimitKeyPrefix         = "RateLimit-value-"
+	RateLimitWindowKeyPrefix   = "RateLimitWindow-value-"
+	PendingReviewCctxKeyPrefix = "PendingReviewCctx-value-"
+
+	// the secondary indexes of the cctxs map the index key of a cctx to its index
+	CctxBySenderKeyPrefix      = "CctxBySender-value-"
+	CctxByReceiverKeyPrefix    = "CctxByReceiver-value-"
+	CctxByStatusKeyPrefix      = "CctxByStatus-value-"
+	CctxBySenderChainKeyPrefix = "CctxBySenderChain-value-"
+	CctxByHeightKeyPrefix      = "CctxByHeight-value-"
 )
 
 // OutTxTrackerKey returns the store key to retrieve a OutTxTracker from the index fields
@@ -72,6 +90,43 @@ func OutTxTrackerKey(
 	return key
 }
 
+// GasPriceHistoryPrefix returns the prefix of the keys of the gas price history of a chain
+func GasPriceHistoryPrefix(chainID int64) []byte {
+	return []byte(fmt.Sprintf("%d/", chainID))
+}
+
+// GasPriceHistoryEntryKey returns the key of the gas price of a chain at a height in the gas price history
+// the height is big endian encoded so the keys are ordered by height
+func GasPriceHistoryEntryKey(chainID int64, height int64) []byte {
+	// #nosec G701 always positive
+	return append(GasPriceHistoryPrefix(chainID), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// CctxIndexPrefix returns the prefix of the keys of the cctxs with the value in a secondary index
+func CctxIndexPrefix(value string) []byte {
+	return []byte(fmt.Sprintf("%s/", value))
+}
+
+// CctxIndexKey returns the key of a cctx with the value in a secondary index
+func CctxIndexKey(value string, cctxIndex string) []byte {
+	return append(CctxIndexPrefix(value), []byte(cctxIndex)...)
+}
+
+// CctxHeightIndexKey returns the key of a cctx created at the height in the height index
+// the height is big endian encoded so the keys are ordered by height
+func CctxHeightIndexKey(height uint64, cctxIndex string) []byte {
+	return append(sdk.Uint64ToBigEndian(height), []byte(cctxIndex)...)
+}
+
+// CctxIndexAddress returns the address used as value in the address indexes of the cctxs
+// hex addresses are case-insensitive and are lowercased, other addresses are unchanged
+func CctxIndexAddress(address string) string {
+	if common.IsHexAddress(address) {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
 // TODO: what's the purpose of this log identifier?
 func (m CrossChainTx) LogIdentifierForCCTX() string {
 	if len(m.OutboundTxParams) == 0 {
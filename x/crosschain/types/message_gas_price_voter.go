@@ -8,11 +8,12 @@ import (
 
 var _ sdk.Msg = &MsgGasPriceVoter{}
 
-func NewMsgGasPriceVoter(creator string, chain int64, price uint64, supply string, blockNumber uint64) *MsgGasPriceVoter {
+func NewMsgGasPriceVoter(creator string, chain int64, price uint64, priorityFee uint64, supply string, blockNumber uint64) *MsgGasPriceVoter {
 	return &MsgGasPriceVoter{
 		Creator:     creator,
 		ChainId:     chain,
 		Price:       price,
+		PriorityFee: priorityFee,
 		BlockNumber: blockNumber,
 		Supply:      supply,
 	}
@@ -47,5 +48,8 @@ func (msg *MsgGasPriceVoter) ValidateBasic() error {
 	if msg.ChainId < 0 {
 		return sdkerrors.Wrapf(ErrInvalidChainID, "chain id (%d)", msg.ChainId)
 	}
+	if msg.PriorityFee > msg.Price {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "priority fee (%d) greater than gas price (%d)", msg.PriorityFee, msg.Price)
+	}
 	return nil
 }
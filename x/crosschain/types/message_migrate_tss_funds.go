@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/node/common/cosmos"
+)
+
+const TypeMsgMigrateTssFunds = "MigrateTssFunds"
+
+var _ sdk.Msg = &MsgMigrateTssFunds{}
+
+func NewMsgMigrateTssFunds(creator string, tssPubkey string) *MsgMigrateTssFunds {
+	return &MsgMigrateTssFunds{
+		Creator:   creator,
+		TssPubkey: tssPubkey,
+	}
+}
+
+func (msg *MsgMigrateTssFunds) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMigrateTssFunds) Type() string {
+	return TypeMsgMigrateTssFunds
+}
+
+func (msg *MsgMigrateTssFunds) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgMigrateTssFunds) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMigrateTssFunds) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, msg.TssPubkey)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid tss pubkey (%s)", err)
+	}
+	return nil
+}
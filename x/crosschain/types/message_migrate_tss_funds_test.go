@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observerTypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgMigrateTssFunds_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgMigrateTssFunds
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgMigrateTssFunds("invalid_address", "zetapub1addwnpepq28c57cvcs0a2htsem5zxr6qnlvq9mzhmm76z3jncsnzz32rclangr2g35p"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid pubkey",
+			msg:  types.NewMsgMigrateTssFunds("zeta15ruj2tc76pnj9xtw64utktee7cc7w6vzaes73z", "zetapub1addwnpepq28c57cvcs0a2htsem5zxr6qnlvq9mzhmm"),
+			err:  sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name: "valid message",
+			msg:  types.NewMsgMigrateTssFunds("zeta15ruj2tc76pnj9xtw64utktee7cc7w6vzaes73z", "zetapub1addwnpepq28c57cvcs0a2htsem5zxr6qnlvq9mzhmm76z3jncsnzz32rclangr2g35p"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			observerTypes.SetConfig(false)
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
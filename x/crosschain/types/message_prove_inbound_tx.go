@@ -0,0 +1,80 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/node/common"
+)
+
+const TypeMsgProveInboundTx = "ProveInboundTx"
+
+var _ sdk.Msg = &MsgProveInboundTx{}
+
+func NewMsgProveInboundTx(
+	creator string,
+	chain int64,
+	txHash string,
+	blockHash string,
+	txIndex int64,
+	proof *common.Proof,
+	receiptProof *common.Proof,
+) *MsgProveInboundTx {
+	return &MsgProveInboundTx{
+		Creator:      creator,
+		ChainId:      chain,
+		TxHash:       txHash,
+		BlockHash:    blockHash,
+		TxIndex:      txIndex,
+		Proof:        proof,
+		ReceiptProof: receiptProof,
+	}
+}
+
+func (msg *MsgProveInboundTx) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgProveInboundTx) Type() string {
+	return TypeMsgProveInboundTx
+}
+
+func (msg *MsgProveInboundTx) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgProveInboundTx) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgProveInboundTx) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// only inbounds from EVM chains can be proven, the chain type is checked against the chain registry when the message
+	// is processed
+	if msg.ChainId <= 0 {
+		return sdkerrors.Wrapf(ErrUnsupportedChain, "invalid chain id (%d)", msg.ChainId)
+	}
+	if msg.TxHash == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tx hash is empty")
+	}
+	if msg.BlockHash == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "block hash is empty")
+	}
+	if msg.TxIndex < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid tx index (%d)", msg.TxIndex)
+	}
+	if msg.Proof == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tx proof is empty")
+	}
+	if msg.ReceiptProof == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "receipt proof is empty")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/common/ethereum"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgProveInboundTx_ValidateBasic(t *testing.T) {
+	proof := common.NewEthereumProof(ethereum.NewProof())
+
+	tests := []struct {
+		name string
+		msg  *types.MsgProveInboundTx
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgProveInboundTx("invalid_address", common.GoerliChain().ChainId, "0x1", "0x2", 0, proof, proof),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain id",
+			msg:  types.NewMsgProveInboundTx(sample.AccAddress(), 0, "0x1", "0x2", 0, proof, proof),
+			err:  types.ErrUnsupportedChain,
+		},
+		{
+			name: "empty tx hash",
+			msg:  types.NewMsgProveInboundTx(sample.AccAddress(), common.GoerliChain().ChainId, "", "0x2", 0, proof, proof),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "negative tx index",
+			msg:  types.NewMsgProveInboundTx(sample.AccAddress(), common.GoerliChain().ChainId, "0x1", "0x2", -1, proof, proof),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "missing receipt proof",
+			msg:  types.NewMsgProveInboundTx(sample.AccAddress(), common.GoerliChain().ChainId, "0x1", "0x2", 0, proof, nil),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid message",
+			msg:  types.NewMsgProveInboundTx(sample.AccAddress(), common.GoerliChain().ChainId, "0x1", "0x2", 0, proof, proof),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
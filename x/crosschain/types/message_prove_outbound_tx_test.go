@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/common/ethereum"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgProveOutboundTx_ValidateBasic(t *testing.T) {
+	proof := common.NewEthereumProof(ethereum.NewProof())
+
+	tests := []struct {
+		name string
+		msg  *types.MsgProveOutboundTx
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgProveOutboundTx("invalid_address", common.GoerliChain().ChainId, 1, "0x1", "0x2", 0, proof, proof),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain id",
+			msg:  types.NewMsgProveOutboundTx(sample.AccAddress(), 0, 1, "0x1", "0x2", 0, proof, proof),
+			err:  types.ErrUnsupportedChain,
+		},
+		{
+			name: "empty block hash",
+			msg:  types.NewMsgProveOutboundTx(sample.AccAddress(), common.GoerliChain().ChainId, 1, "0x1", "", 0, proof, proof),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "missing tx proof",
+			msg:  types.NewMsgProveOutboundTx(sample.AccAddress(), common.GoerliChain().ChainId, 1, "0x1", "0x2", 0, nil, proof),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid evm message",
+			msg:  types.NewMsgProveOutboundTx(sample.AccAddress(), common.GoerliChain().ChainId, 1, "0x1", "0x2", 0, proof, proof),
+		},
+		{
+			name: "valid bitcoin message without receipt proof",
+			msg:  types.NewMsgProveOutboundTx(sample.AccAddress(), common.BtcChainID(), 1, "0x1", "0x2", 0, proof, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRefundAbortedCCTX = "RefundAbortedCCTX"
+
+var _ sdk.Msg = &MsgRefundAbortedCCTX{}
+
+func NewMsgRefundAbortedCCTX(creator string, cctxIndex string) *MsgRefundAbortedCCTX {
+	return &MsgRefundAbortedCCTX{
+		Creator:   creator,
+		CctxIndex: cctxIndex,
+	}
+}
+
+func (msg *MsgRefundAbortedCCTX) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRefundAbortedCCTX) Type() string {
+	return TypeMsgRefundAbortedCCTX
+}
+
+func (msg *MsgRefundAbortedCCTX) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRefundAbortedCCTX) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRefundAbortedCCTX) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.CctxIndex == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cctx index cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgReleaseCCTX = "ReleaseCCTX"
+
+var _ sdk.Msg = &MsgReleaseCCTX{}
+
+func NewMsgReleaseCCTX(creator string, cctxIndex string) *MsgReleaseCCTX {
+	return &MsgReleaseCCTX{
+		Creator:   creator,
+		CctxIndex: cctxIndex,
+	}
+}
+
+func (msg *MsgReleaseCCTX) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReleaseCCTX) Type() string {
+	return TypeMsgReleaseCCTX
+}
+
+func (msg *MsgReleaseCCTX) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReleaseCCTX) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReleaseCCTX) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.CctxIndex == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cctx index cannot be empty")
+	}
+	return nil
+}
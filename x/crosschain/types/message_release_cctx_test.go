@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgReleaseCCTX_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgReleaseCCTX
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgReleaseCCTX("invalid_address", "index"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty cctx index",
+			msg:  types.NewMsgReleaseCCTX(sample.AccAddress(), ""),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid message",
+			msg:  types.NewMsgReleaseCCTX(sample.AccAddress(), "index"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRetryAbortedCCTX = "RetryAbortedCCTX"
+
+var _ sdk.Msg = &MsgRetryAbortedCCTX{}
+
+func NewMsgRetryAbortedCCTX(creator string, cctxIndex string) *MsgRetryAbortedCCTX {
+	return &MsgRetryAbortedCCTX{
+		Creator:   creator,
+		CctxIndex: cctxIndex,
+	}
+}
+
+func (msg *MsgRetryAbortedCCTX) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRetryAbortedCCTX) Type() string {
+	return TypeMsgRetryAbortedCCTX
+}
+
+func (msg *MsgRetryAbortedCCTX) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRetryAbortedCCTX) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRetryAbortedCCTX) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.CctxIndex == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cctx index cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	invalidParams := types.DefaultParams()
+	invalidParams.GasPriceMultiplier = "-1"
+
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateParams
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateParams("invalid_address", types.DefaultParams()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid params",
+			msg:  types.NewMsgUpdateParams(sample.AccAddress(), invalidParams),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdateParams(sample.AccAddress(), types.DefaultParams()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateRateLimit = "UpdateRateLimit"
+
+var _ sdk.Msg = &MsgUpdateRateLimit{}
+
+func NewMsgUpdateRateLimit(creator string, rateLimit RateLimit) *MsgUpdateRateLimit {
+	return &MsgUpdateRateLimit{
+		Creator:   creator,
+		RateLimit: rateLimit,
+	}
+}
+
+func (msg *MsgUpdateRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateRateLimit) Type() string {
+	return TypeMsgUpdateRateLimit
+}
+
+func (msg *MsgUpdateRateLimit) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateRateLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return msg.RateLimit.Validate()
+}
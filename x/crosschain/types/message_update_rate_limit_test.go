@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgUpdateRateLimit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateRateLimit
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateRateLimit("invalid_address", types.RateLimit{ChainId: 1}),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid zrc20 address",
+			msg: types.NewMsgUpdateRateLimit(sample.AccAddress(), types.RateLimit{
+				ChainId:      1,
+				Zrc20Address: "invalid",
+				Window:       10,
+				MaxCctxCount: 10,
+			}),
+			err: types.ErrInvalidRateLimit,
+		},
+		{
+			name: "invalid window",
+			msg: types.NewMsgUpdateRateLimit(sample.AccAddress(), types.RateLimit{
+				ChainId:      1,
+				MaxCctxCount: 10,
+			}),
+			err: types.ErrInvalidRateLimit,
+		},
+		{
+			name: "max value without zrc20",
+			msg: types.NewMsgUpdateRateLimit(sample.AccAddress(), types.RateLimit{
+				ChainId:  1,
+				Window:   10,
+				MaxValue: math.NewUint(1000),
+			}),
+			err: types.ErrInvalidRateLimit,
+		},
+		{
+			name: "valid chain rate limit",
+			msg: types.NewMsgUpdateRateLimit(sample.AccAddress(), types.RateLimit{
+				ChainId:      1,
+				Window:       10,
+				MaxCctxCount: 10,
+			}),
+		},
+		{
+			name: "valid foreign coin rate limit",
+			msg: types.NewMsgUpdateRateLimit(sample.AccAddress(), types.RateLimit{
+				ChainId:      1,
+				Zrc20Address: sample.EthAddress().Hex(),
+				Window:       10,
+				MaxValue:     math.NewUint(1000),
+			}),
+		},
+		{
+			name: "valid rate limit removal",
+			msg:  types.NewMsgUpdateRateLimit(sample.AccAddress(), types.RateLimit{ChainId: 1}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
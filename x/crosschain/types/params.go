@@ -1,10 +1,28 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/zeta-chain/node/common"
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// DefaultGasPriceMultiplier is the default multiplier of the gas price for the outbound txs paying gas with the gas
+	// token or an ERC20
+	DefaultGasPriceMultiplier = "1"
+
+	// DefaultZetaGasPriceMultiplier is the default multiplier of the gas price for the outbound txs paying gas with ZETA
+	DefaultZetaGasPriceMultiplier = "2"
+
+	// DefaultProtocolFee is the default protocol fee in azeta for the outbound txs paying gas with ZETA
+	DefaultProtocolFee = 2000000000000000000
+)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -15,7 +33,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams() Params {
 	return Params{
-		Enabled: true,
+		Enabled:                true,
+		GasPriceMultiplier:     DefaultGasPriceMultiplier,
+		ZetaGasPriceMultiplier: DefaultZetaGasPriceMultiplier,
+		ProtocolFee:            math.NewUint(DefaultProtocolFee),
 	}
 }
 
@@ -26,12 +47,34 @@ func DefaultParams() Params {
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPrefix(ParamEnabled), &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyPrefix(ParamGasPriceMultiplier), &p.GasPriceMultiplier, validateGasPriceMultiplier),
+		paramtypes.NewParamSetPair(KeyPrefix(ParamZetaGasPriceMultiplier), &p.ZetaGasPriceMultiplier, validateGasPriceMultiplier),
+		paramtypes.NewParamSetPair(KeyPrefix(ParamProtocolFee), &p.ProtocolFee, validateProtocolFee),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateGasPriceMultiplier(p.GasPriceMultiplier); err != nil {
+		return err
+	}
+	if err := validateGasPriceMultiplier(p.ZetaGasPriceMultiplier); err != nil {
+		return err
+	}
+	return validateProtocolFee(p.ProtocolFee)
+}
+
+// OutboundGasPrice returns the gas price of an outbound tx paying gas with the coin type
+// the median gas price of the chain is multiplied by the gas price multiplier of the coin type
+func (p Params) OutboundGasPrice(medianGasPrice math.Uint, coinType common.CoinType) math.Uint {
+	multiplier := p.ZetaGasPriceMultiplier
+	if coinType == common.CoinType_Gas || coinType == common.CoinType_ERC20 {
+		multiplier = p.GasPriceMultiplier
+	}
+	gasPrice := sdk.MustNewDecFromStr(multiplier).MulInt(sdk.NewIntFromBigInt(medianGasPrice.BigInt())).TruncateInt()
+	return math.NewUintFromBigInt(gasPrice.BigInt())
 }
 
 // String implements the Stringer interface.
@@ -42,3 +85,37 @@ func (p Params) String() string {
 	}
 	return string(out)
 }
+
+func validateEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateGasPriceMultiplier(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	multiplier, err := sdk.NewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid gas price multiplier %s: %s", v, err.Error())
+	}
+	if !multiplier.IsPositive() {
+		return errors.New("gas price multiplier must be positive")
+	}
+	return nil
+}
+
+func validateProtocolFee(i interface{}) error {
+	v, ok := i.(math.Uint)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return errors.New("protocol fee must be set")
+	}
+	return nil
+}
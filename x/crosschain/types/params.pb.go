@@ -9,6 +9,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 )
@@ -27,6 +28,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// gas_price_multiplier is the decimal multiplier applied to the median gas price of the chain for the outbound txs
+	// paying gas with the gas token or an ERC20
+	GasPriceMultiplier string `protobuf:"bytes,2,opt,name=gas_price_multiplier,json=gasPriceMultiplier,proto3" json:"gas_price_multiplier,omitempty"`
+	// zeta_gas_price_multiplier is the decimal multiplier applied to the median gas price of the chain for the outbound
+	// txs paying gas with ZETA or by the protocol
+	ZetaGasPriceMultiplier string `protobuf:"bytes,3,opt,name=zeta_gas_price_multiplier,json=zetaGasPriceMultiplier,proto3" json:"zeta_gas_price_multiplier,omitempty"`
+	// protocol_fee is the fee in azeta charged in addition to the gas fee for the outbound txs paying gas with ZETA
+	ProtocolFee github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=protocol_fee,json=protocolFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"protocol_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -68,6 +77,20 @@ func (m *Params) GetEnabled() bool {
 	return false
 }
 
+func (m *Params) GetGasPriceMultiplier() string {
+	if m != nil {
+		return m.GasPriceMultiplier
+	}
+	return ""
+}
+
+func (m *Params) GetZetaGasPriceMultiplier() string {
+	if m != nil {
+		return m.ZetaGasPriceMultiplier
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "zetachain.zetacore.crosschain.Params")
 }
@@ -75,18 +98,26 @@ func init() {
 func init() { proto.RegisterFile("crosschain/params.proto", fileDescriptor_cd6915e32c251e53) }
 
 var fileDescriptor_cd6915e32c251e53 = []byte{
-	// 175 bytes of a gzipped FileDescriptorProto
+	// 291 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x2e, 0xca, 0x2f,
 	0x2e, 0x4e, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28,
 	0xca, 0x2f, 0xc9, 0x17, 0x92, 0xad, 0x4a, 0x2d, 0x49, 0x04, 0x8b, 0xeb, 0x81, 0x59, 0xf9, 0x45,
 	0xa9, 0x7a, 0x08, 0xb5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x95, 0xfa, 0x20, 0x16, 0x44,
-	0x93, 0x92, 0x06, 0x17, 0x5b, 0x00, 0xd8, 0x10, 0x21, 0x09, 0x2e, 0xf6, 0xd4, 0xbc, 0xc4, 0xa4,
-	0x9c, 0xd4, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x18, 0xd7, 0x8a, 0x65, 0xc6, 0x02,
-	0x79, 0x06, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
-	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c,
-	0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0xd9, 0xac, 0x0b, 0x71, 0x1c,
-	0xcc, 0x11, 0xfa, 0x15, 0xfa, 0x48, 0x4e, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xdb,
-	0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x96, 0x4e, 0x4b, 0x16, 0xcd, 0x00, 0x00, 0x00,
+	0x93, 0xd2, 0x4b, 0x46, 0x2e, 0xb6, 0x00, 0xb0, 0x29, 0x42, 0x12, 0x5c, 0xec, 0xa9, 0x79, 0x89,
+	0x49, 0x39, 0xa9, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x1c, 0x41, 0x30, 0xae, 0x90, 0x01, 0x97,
+	0x48, 0x7a, 0x62, 0x71, 0x7c, 0x41, 0x51, 0x66, 0x72, 0x6a, 0x7c, 0x6e, 0x69, 0x4e, 0x49, 0x66,
+	0x41, 0x4e, 0x66, 0x6a, 0x91, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x67, 0x90, 0x50, 0x7a, 0x62, 0x71,
+	0x00, 0x48, 0xca, 0x17, 0x2e, 0x23, 0x64, 0xc9, 0x25, 0x09, 0x72, 0x43, 0x3c, 0x56, 0x6d, 0xcc,
+	0x60, 0x6d, 0x62, 0x20, 0x05, 0xee, 0x98, 0x5a, 0x83, 0xb8, 0x78, 0xc0, 0x4e, 0x4b, 0xce, 0xcf,
+	0x89, 0x4f, 0x4b, 0x4d, 0x95, 0x60, 0x01, 0xa9, 0x76, 0xd2, 0x3f, 0x71, 0x4f, 0x9e, 0xe1, 0xd6,
+	0x3d, 0x79, 0xf5, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xfc,
+	0xe2, 0xdc, 0xfc, 0x62, 0x28, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac,
+	0x17, 0x9a, 0x99, 0x57, 0x12, 0xc4, 0x0d, 0x33, 0xc4, 0x2d, 0x35, 0xd5, 0x8a, 0x65, 0xc6, 0x02,
+	0x79, 0x06, 0x27, 0xf7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
+	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x45,
+	0x32, 0x15, 0xe4, 0x2c, 0x5d, 0x48, 0xf0, 0xe6, 0xe5, 0xa7, 0xa4, 0xea, 0x57, 0xe8, 0x23, 0x05,
+	0x38, 0xd8, 0x82, 0x24, 0x36, 0xb0, 0xd9, 0xc6, 0x80, 0x01, 0x00, 0xea, 0x8d, 0x09, 0x20, 0x8b,
+	0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -109,6 +140,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ProtocolFee.Size()
+		i -= size
+		if _, err := m.ProtocolFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ZetaGasPriceMultiplier) > 0 {
+		i -= len(m.ZetaGasPriceMultiplier)
+		copy(dAtA[i:], m.ZetaGasPriceMultiplier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ZetaGasPriceMultiplier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GasPriceMultiplier) > 0 {
+		i -= len(m.GasPriceMultiplier)
+		copy(dAtA[i:], m.GasPriceMultiplier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.GasPriceMultiplier)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Enabled {
 		i--
 		if m.Enabled {
@@ -142,6 +197,16 @@ func (m *Params) Size() (n int) {
 	if m.Enabled {
 		n += 2
 	}
+	l = len(m.GasPriceMultiplier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.ZetaGasPriceMultiplier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.ProtocolFee.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -200,6 +265,104 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPriceMultiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaGasPriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZetaGasPriceMultiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
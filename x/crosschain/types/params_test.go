@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.GasPriceMultiplier = "foo"
+	require.ErrorContains(t, params.Validate(), "invalid gas price multiplier")
+
+	params = types.DefaultParams()
+	params.ZetaGasPriceMultiplier = "0"
+	require.ErrorContains(t, params.Validate(), "gas price multiplier must be positive")
+
+	params = types.DefaultParams()
+	params.ProtocolFee = math.Uint{}
+	require.ErrorContains(t, params.Validate(), "protocol fee must be set")
+
+	params = types.DefaultParams()
+	params.ProtocolFee = math.ZeroUint()
+	require.NoError(t, params.Validate())
+}
+
+func TestParams_OutboundGasPrice(t *testing.T) {
+	params := types.DefaultParams()
+	params.GasPriceMultiplier = "1.5"
+	params.ZetaGasPriceMultiplier = "2.25"
+
+	require.Equal(t, math.NewUint(150), params.OutboundGasPrice(math.NewUint(100), common.CoinType_Gas))
+	require.Equal(t, math.NewUint(150), params.OutboundGasPrice(math.NewUint(100), common.CoinType_ERC20))
+	require.Equal(t, math.NewUint(225), params.OutboundGasPrice(math.NewUint(100), common.CoinType_Zeta))
+	require.Equal(t, math.NewUint(225), params.OutboundGasPrice(math.NewUint(100), common.CoinType_Cmd))
+
+	// the gas price is truncated
+	require.Equal(t, math.NewUint(4), params.OutboundGasPrice(math.NewUint(3), common.CoinType_Gas))
+}
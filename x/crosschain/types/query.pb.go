@@ -111,6 +111,102 @@ func (m *QueryTssHistoryResponse) GetTssList() []TSS {
 	return nil
 }
 
+type QueryTssMigrationRequest struct {
+}
+
+func (m *QueryTssMigrationRequest) Reset()         { *m = QueryTssMigrationRequest{} }
+func (m *QueryTssMigrationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTssMigrationRequest) ProtoMessage()    {}
+func (*QueryTssMigrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{2}
+}
+func (m *QueryTssMigrationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTssMigrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTssMigrationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTssMigrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTssMigrationRequest.Merge(m, src)
+}
+func (m *QueryTssMigrationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTssMigrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTssMigrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTssMigrationRequest proto.InternalMessageInfo
+
+type QueryTssMigrationResponse struct {
+	InProgress       bool                  `protobuf:"varint,1,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	PendingTss       TSS                   `protobuf:"bytes,2,opt,name=pending_tss,json=pendingTss,proto3" json:"pending_tss"`
+	TssFundMigrators []TssFundMigratorInfo `protobuf:"bytes,3,rep,name=tss_fund_migrators,json=tssFundMigrators,proto3" json:"tss_fund_migrators"`
+}
+
+func (m *QueryTssMigrationResponse) Reset()         { *m = QueryTssMigrationResponse{} }
+func (m *QueryTssMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTssMigrationResponse) ProtoMessage()    {}
+func (*QueryTssMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{3}
+}
+func (m *QueryTssMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTssMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTssMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTssMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTssMigrationResponse.Merge(m, src)
+}
+func (m *QueryTssMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTssMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTssMigrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTssMigrationResponse proto.InternalMessageInfo
+
+func (m *QueryTssMigrationResponse) GetInProgress() bool {
+	if m != nil {
+		return m.InProgress
+	}
+	return false
+}
+
+func (m *QueryTssMigrationResponse) GetPendingTss() TSS {
+	if m != nil {
+		return m.PendingTss
+	}
+	return TSS{}
+}
+
+func (m *QueryTssMigrationResponse) GetTssFundMigrators() []TssFundMigratorInfo {
+	if m != nil {
+		return m.TssFundMigrators
+	}
+	return nil
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -119,7 +215,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{2}
+	return fileDescriptor_65a992045e92a606, []int{4}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +254,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{3}
+	return fileDescriptor_65a992045e92a606, []int{5}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -203,7 +299,7 @@ func (m *QueryGetOutTxTrackerRequest) Reset()         { *m = QueryGetOutTxTracke
 func (m *QueryGetOutTxTrackerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOutTxTrackerRequest) ProtoMessage()    {}
 func (*QueryGetOutTxTrackerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{4}
+	return fileDescriptor_65a992045e92a606, []int{6}
 }
 func (m *QueryGetOutTxTrackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +350,7 @@ func (m *QueryGetOutTxTrackerResponse) Reset()         { *m = QueryGetOutTxTrack
 func (m *QueryGetOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetOutTxTrackerResponse) ProtoMessage()    {}
 func (*QueryGetOutTxTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{5}
+	return fileDescriptor_65a992045e92a606, []int{7}
 }
 func (m *QueryGetOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,7 +394,7 @@ func (m *QueryAllOutTxTrackerRequest) Reset()         { *m = QueryAllOutTxTracke
 func (m *QueryAllOutTxTrackerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllOutTxTrackerRequest) ProtoMessage()    {}
 func (*QueryAllOutTxTrackerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{6}
+	return fileDescriptor_65a992045e92a606, []int{8}
 }
 func (m *QueryAllOutTxTrackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +439,7 @@ func (m *QueryAllOutTxTrackerResponse) Reset()         { *m = QueryAllOutTxTrack
 func (m *QueryAllOutTxTrackerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllOutTxTrackerResponse) ProtoMessage()    {}
 func (*QueryAllOutTxTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{7}
+	return fileDescriptor_65a992045e92a606, []int{9}
 }
 func (m *QueryAllOutTxTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +491,7 @@ func (m *QueryAllOutTxTrackerByChainRequest) Reset()         { *m = QueryAllOutT
 func (m *QueryAllOutTxTrackerByChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllOutTxTrackerByChainRequest) ProtoMessage()    {}
 func (*QueryAllOutTxTrackerByChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{8}
+	return fileDescriptor_65a992045e92a606, []int{10}
 }
 func (m *QueryAllOutTxTrackerByChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,7 +543,7 @@ func (m *QueryAllOutTxTrackerByChainResponse) Reset()         { *m = QueryAllOut
 func (m *QueryAllOutTxTrackerByChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllOutTxTrackerByChainResponse) ProtoMessage()    {}
 func (*QueryAllOutTxTrackerByChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{9}
+	return fileDescriptor_65a992045e92a606, []int{11}
 }
 func (m *QueryAllOutTxTrackerByChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,7 +594,7 @@ func (m *QueryGetInTxHashToCctxRequest) Reset()         { *m = QueryGetInTxHashT
 func (m *QueryGetInTxHashToCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetInTxHashToCctxRequest) ProtoMessage()    {}
 func (*QueryGetInTxHashToCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{10}
+	return fileDescriptor_65a992045e92a606, []int{12}
 }
 func (m *QueryGetInTxHashToCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,7 +638,7 @@ func (m *QueryGetInTxHashToCctxResponse) Reset()         { *m = QueryGetInTxHash
 func (m *QueryGetInTxHashToCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetInTxHashToCctxResponse) ProtoMessage()    {}
 func (*QueryGetInTxHashToCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{11}
+	return fileDescriptor_65a992045e92a606, []int{13}
 }
 func (m *QueryGetInTxHashToCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +682,7 @@ func (m *QueryInTxHashToCctxDataRequest) Reset()         { *m = QueryInTxHashToC
 func (m *QueryInTxHashToCctxDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInTxHashToCctxDataRequest) ProtoMessage()    {}
 func (*QueryInTxHashToCctxDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{12}
+	return fileDescriptor_65a992045e92a606, []int{14}
 }
 func (m *QueryInTxHashToCctxDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +726,7 @@ func (m *QueryInTxHashToCctxDataResponse) Reset()         { *m = QueryInTxHashTo
 func (m *QueryInTxHashToCctxDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInTxHashToCctxDataResponse) ProtoMessage()    {}
 func (*QueryInTxHashToCctxDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{13}
+	return fileDescriptor_65a992045e92a606, []int{15}
 }
 func (m *QueryInTxHashToCctxDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +770,7 @@ func (m *QueryAllInTxHashToCctxRequest) Reset()         { *m = QueryAllInTxHashT
 func (m *QueryAllInTxHashToCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInTxHashToCctxRequest) ProtoMessage()    {}
 func (*QueryAllInTxHashToCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{14}
+	return fileDescriptor_65a992045e92a606, []int{16}
 }
 func (m *QueryAllInTxHashToCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +815,7 @@ func (m *QueryAllInTxHashToCctxResponse) Reset()         { *m = QueryAllInTxHash
 func (m *QueryAllInTxHashToCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllInTxHashToCctxResponse) ProtoMessage()    {}
 func (*QueryAllInTxHashToCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{15}
+	return fileDescriptor_65a992045e92a606, []int{17}
 }
 func (m *QueryAllInTxHashToCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,7 +865,7 @@ func (m *QueryGetTssAddressRequest) Reset()         { *m = QueryGetTssAddressReq
 func (m *QueryGetTssAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTssAddressRequest) ProtoMessage()    {}
 func (*QueryGetTssAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{16}
+	return fileDescriptor_65a992045e92a606, []int{18}
 }
 func (m *QueryGetTssAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +903,7 @@ func (m *QueryGetTssAddressResponse) Reset()         { *m = QueryGetTssAddressRe
 func (m *QueryGetTssAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTssAddressResponse) ProtoMessage()    {}
 func (*QueryGetTssAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{17}
+	return fileDescriptor_65a992045e92a606, []int{19}
 }
 func (m *QueryGetTssAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -857,7 +953,7 @@ func (m *QueryGetTSSRequest) Reset()         { *m = QueryGetTSSRequest{} }
 func (m *QueryGetTSSRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTSSRequest) ProtoMessage()    {}
 func (*QueryGetTSSRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{18}
+	return fileDescriptor_65a992045e92a606, []int{20}
 }
 func (m *QueryGetTSSRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,7 +990,7 @@ func (m *QueryGetTSSResponse) Reset()         { *m = QueryGetTSSResponse{} }
 func (m *QueryGetTSSResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTSSResponse) ProtoMessage()    {}
 func (*QueryGetTSSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{19}
+	return fileDescriptor_65a992045e92a606, []int{21}
 }
 func (m *QueryGetTSSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -938,7 +1034,7 @@ func (m *QueryGetGasPriceRequest) Reset()         { *m = QueryGetGasPriceRequest
 func (m *QueryGetGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetGasPriceRequest) ProtoMessage()    {}
 func (*QueryGetGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{20}
+	return fileDescriptor_65a992045e92a606, []int{22}
 }
 func (m *QueryGetGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,7 +1078,7 @@ func (m *QueryGetGasPriceResponse) Reset()         { *m = QueryGetGasPriceRespon
 func (m *QueryGetGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetGasPriceResponse) ProtoMessage()    {}
 func (*QueryGetGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{21}
+	return fileDescriptor_65a992045e92a606, []int{23}
 }
 func (m *QueryGetGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1122,7 @@ func (m *QueryAllGasPriceRequest) Reset()         { *m = QueryAllGasPriceRequest
 func (m *QueryAllGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllGasPriceRequest) ProtoMessage()    {}
 func (*QueryAllGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{22}
+	return fileDescriptor_65a992045e92a606, []int{24}
 }
 func (m *QueryAllGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1071,7 +1167,7 @@ func (m *QueryAllGasPriceResponse) Reset()         { *m = QueryAllGasPriceRespon
 func (m *QueryAllGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllGasPriceResponse) ProtoMessage()    {}
 func (*QueryAllGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{23}
+	return fileDescriptor_65a992045e92a606, []int{25}
 }
 func (m *QueryAllGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,6 +1210,110 @@ func (m *QueryAllGasPriceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryGasPriceHistoryRequest struct {
+	ChainId    int64              `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGasPriceHistoryRequest) Reset()         { *m = QueryGasPriceHistoryRequest{} }
+func (m *QueryGasPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryGasPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{26}
+}
+func (m *QueryGasPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceHistoryRequest.Merge(m, src)
+}
+func (m *QueryGasPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryGasPriceHistoryRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryGasPriceHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGasPriceHistoryResponse struct {
+	History    []GasPriceHistoryEntry `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGasPriceHistoryResponse) Reset()         { *m = QueryGasPriceHistoryResponse{} }
+func (m *QueryGasPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryGasPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{27}
+}
+func (m *QueryGasPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceHistoryResponse.Merge(m, src)
+}
+func (m *QueryGasPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryGasPriceHistoryResponse) GetHistory() []GasPriceHistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryGasPriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetChainNoncesRequest struct {
 	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
@@ -1122,7 +1322,7 @@ func (m *QueryGetChainNoncesRequest) Reset()         { *m = QueryGetChainNoncesR
 func (m *QueryGetChainNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainNoncesRequest) ProtoMessage()    {}
 func (*QueryGetChainNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{24}
+	return fileDescriptor_65a992045e92a606, []int{28}
 }
 func (m *QueryGetChainNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1166,7 +1366,7 @@ func (m *QueryGetChainNoncesResponse) Reset()         { *m = QueryGetChainNonces
 func (m *QueryGetChainNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainNoncesResponse) ProtoMessage()    {}
 func (*QueryGetChainNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{25}
+	return fileDescriptor_65a992045e92a606, []int{29}
 }
 func (m *QueryGetChainNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1210,7 +1410,7 @@ func (m *QueryAllChainNoncesRequest) Reset()         { *m = QueryAllChainNoncesR
 func (m *QueryAllChainNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllChainNoncesRequest) ProtoMessage()    {}
 func (*QueryAllChainNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{26}
+	return fileDescriptor_65a992045e92a606, []int{30}
 }
 func (m *QueryAllChainNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,7 +1455,7 @@ func (m *QueryAllChainNoncesResponse) Reset()         { *m = QueryAllChainNonces
 func (m *QueryAllChainNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllChainNoncesResponse) ProtoMessage()    {}
 func (*QueryAllChainNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{27}
+	return fileDescriptor_65a992045e92a606, []int{31}
 }
 func (m *QueryAllChainNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1305,7 +1505,7 @@ func (m *QueryAllPendingNoncesRequest) Reset()         { *m = QueryAllPendingNon
 func (m *QueryAllPendingNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPendingNoncesRequest) ProtoMessage()    {}
 func (*QueryAllPendingNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{28}
+	return fileDescriptor_65a992045e92a606, []int{32}
 }
 func (m *QueryAllPendingNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1342,7 +1542,7 @@ func (m *QueryAllPendingNoncesResponse) Reset()         { *m = QueryAllPendingNo
 func (m *QueryAllPendingNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPendingNoncesResponse) ProtoMessage()    {}
 func (*QueryAllPendingNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{29}
+	return fileDescriptor_65a992045e92a606, []int{33}
 }
 func (m *QueryAllPendingNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1386,7 +1586,7 @@ func (m *QueryPendingNoncesByChainRequest) Reset()         { *m = QueryPendingNo
 func (m *QueryPendingNoncesByChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingNoncesByChainRequest) ProtoMessage()    {}
 func (*QueryPendingNoncesByChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{30}
+	return fileDescriptor_65a992045e92a606, []int{34}
 }
 func (m *QueryPendingNoncesByChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1430,7 +1630,7 @@ func (m *QueryPendingNoncesByChainResponse) Reset()         { *m = QueryPendingN
 func (m *QueryPendingNoncesByChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingNoncesByChainResponse) ProtoMessage()    {}
 func (*QueryPendingNoncesByChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{31}
+	return fileDescriptor_65a992045e92a606, []int{35}
 }
 func (m *QueryPendingNoncesByChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1474,7 +1674,7 @@ func (m *QueryGetLastBlockHeightRequest) Reset()         { *m = QueryGetLastBloc
 func (m *QueryGetLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetLastBlockHeightRequest) ProtoMessage()    {}
 func (*QueryGetLastBlockHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{32}
+	return fileDescriptor_65a992045e92a606, []int{36}
 }
 func (m *QueryGetLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1518,7 +1718,7 @@ func (m *QueryGetLastBlockHeightResponse) Reset()         { *m = QueryGetLastBlo
 func (m *QueryGetLastBlockHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetLastBlockHeightResponse) ProtoMessage()    {}
 func (*QueryGetLastBlockHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{33}
+	return fileDescriptor_65a992045e92a606, []int{37}
 }
 func (m *QueryGetLastBlockHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1562,7 +1762,7 @@ func (m *QueryAllLastBlockHeightRequest) Reset()         { *m = QueryAllLastBloc
 func (m *QueryAllLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllLastBlockHeightRequest) ProtoMessage()    {}
 func (*QueryAllLastBlockHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{34}
+	return fileDescriptor_65a992045e92a606, []int{38}
 }
 func (m *QueryAllLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1807,7 @@ func (m *QueryAllLastBlockHeightResponse) Reset()         { *m = QueryAllLastBlo
 func (m *QueryAllLastBlockHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllLastBlockHeightResponse) ProtoMessage()    {}
 func (*QueryAllLastBlockHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{35}
+	return fileDescriptor_65a992045e92a606, []int{39}
 }
 func (m *QueryAllLastBlockHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1658,7 +1858,7 @@ func (m *QueryGetCctxRequest) Reset()         { *m = QueryGetCctxRequest{} }
 func (m *QueryGetCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCctxRequest) ProtoMessage()    {}
 func (*QueryGetCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{36}
+	return fileDescriptor_65a992045e92a606, []int{40}
 }
 func (m *QueryGetCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1694,6 +1894,94 @@ func (m *QueryGetCctxRequest) GetIndex() string {
 	return ""
 }
 
+type QueryCctxStatusTransitionsRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryCctxStatusTransitionsRequest) Reset()         { *m = QueryCctxStatusTransitionsRequest{} }
+func (m *QueryCctxStatusTransitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxStatusTransitionsRequest) ProtoMessage()    {}
+func (*QueryCctxStatusTransitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{41}
+}
+func (m *QueryCctxStatusTransitionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCctxStatusTransitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxStatusTransitionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCctxStatusTransitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxStatusTransitionsRequest.Merge(m, src)
+}
+func (m *QueryCctxStatusTransitionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCctxStatusTransitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxStatusTransitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCctxStatusTransitionsRequest proto.InternalMessageInfo
+
+func (m *QueryCctxStatusTransitionsRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+type QueryCctxStatusTransitionsResponse struct {
+	Transitions []*StatusTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+}
+
+func (m *QueryCctxStatusTransitionsResponse) Reset()         { *m = QueryCctxStatusTransitionsResponse{} }
+func (m *QueryCctxStatusTransitionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxStatusTransitionsResponse) ProtoMessage()    {}
+func (*QueryCctxStatusTransitionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{42}
+}
+func (m *QueryCctxStatusTransitionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCctxStatusTransitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxStatusTransitionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCctxStatusTransitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxStatusTransitionsResponse.Merge(m, src)
+}
+func (m *QueryCctxStatusTransitionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCctxStatusTransitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxStatusTransitionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCctxStatusTransitionsResponse proto.InternalMessageInfo
+
+func (m *QueryCctxStatusTransitionsResponse) GetTransitions() []*StatusTransition {
+	if m != nil {
+		return m.Transitions
+	}
+	return nil
+}
+
 type QueryGetCctxByNonceRequest struct {
 	ChainID int64  `protobuf:"varint,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
 	Nonce   uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
@@ -1703,7 +1991,7 @@ func (m *QueryGetCctxByNonceRequest) Reset()         { *m = QueryGetCctxByNonceR
 func (m *QueryGetCctxByNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCctxByNonceRequest) ProtoMessage()    {}
 func (*QueryGetCctxByNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{37}
+	return fileDescriptor_65a992045e92a606, []int{43}
 }
 func (m *QueryGetCctxByNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1754,7 +2042,7 @@ func (m *QueryGetCctxResponse) Reset()         { *m = QueryGetCctxResponse{} }
 func (m *QueryGetCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCctxResponse) ProtoMessage()    {}
 func (*QueryGetCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{38}
+	return fileDescriptor_65a992045e92a606, []int{44}
 }
 func (m *QueryGetCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1798,7 +2086,7 @@ func (m *QueryAllCctxRequest) Reset()         { *m = QueryAllCctxRequest{} }
 func (m *QueryAllCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCctxRequest) ProtoMessage()    {}
 func (*QueryAllCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{39}
+	return fileDescriptor_65a992045e92a606, []int{45}
 }
 func (m *QueryAllCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1843,7 +2131,7 @@ func (m *QueryAllCctxResponse) Reset()         { *m = QueryAllCctxResponse{} }
 func (m *QueryAllCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCctxResponse) ProtoMessage()    {}
 func (*QueryAllCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{40}
+	return fileDescriptor_65a992045e92a606, []int{46}
 }
 func (m *QueryAllCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1895,7 +2183,7 @@ func (m *QueryAllCctxPendingRequest) Reset()         { *m = QueryAllCctxPendingR
 func (m *QueryAllCctxPendingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCctxPendingRequest) ProtoMessage()    {}
 func (*QueryAllCctxPendingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{41}
+	return fileDescriptor_65a992045e92a606, []int{47}
 }
 func (m *QueryAllCctxPendingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1947,7 +2235,7 @@ func (m *QueryAllCctxPendingResponse) Reset()         { *m = QueryAllCctxPending
 func (m *QueryAllCctxPendingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCctxPendingResponse) ProtoMessage()    {}
 func (*QueryAllCctxPendingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{42}
+	return fileDescriptor_65a992045e92a606, []int{48}
 }
 func (m *QueryAllCctxPendingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1990,21 +2278,21 @@ func (m *QueryAllCctxPendingResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-type QueryLastZetaHeightRequest struct {
+type QueryAllCctxPendingReviewRequest struct {
 }
 
-func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightRequest{} }
-func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
-func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{43}
+func (m *QueryAllCctxPendingReviewRequest) Reset()         { *m = QueryAllCctxPendingReviewRequest{} }
+func (m *QueryAllCctxPendingReviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCctxPendingReviewRequest) ProtoMessage()    {}
+func (*QueryAllCctxPendingReviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{49}
 }
-func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllCctxPendingReviewRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllCctxPendingReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLastZetaHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllCctxPendingReviewRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2014,34 +2302,34 @@ func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryLastZetaHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLastZetaHeightRequest.Merge(m, src)
+func (m *QueryAllCctxPendingReviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCctxPendingReviewRequest.Merge(m, src)
 }
-func (m *QueryLastZetaHeightRequest) XXX_Size() int {
+func (m *QueryAllCctxPendingReviewRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLastZetaHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLastZetaHeightRequest.DiscardUnknown(m)
+func (m *QueryAllCctxPendingReviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCctxPendingReviewRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLastZetaHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllCctxPendingReviewRequest proto.InternalMessageInfo
 
-type QueryLastZetaHeightResponse struct {
-	Height int64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+type QueryAllCctxPendingReviewResponse struct {
+	CrossChainTx []*CrossChainTx `protobuf:"bytes,1,rep,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
 }
 
-func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeightResponse{} }
-func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
-func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{44}
+func (m *QueryAllCctxPendingReviewResponse) Reset()         { *m = QueryAllCctxPendingReviewResponse{} }
+func (m *QueryAllCctxPendingReviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCctxPendingReviewResponse) ProtoMessage()    {}
+func (*QueryAllCctxPendingReviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{50}
 }
-func (m *QueryLastZetaHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllCctxPendingReviewResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLastZetaHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllCctxPendingReviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLastZetaHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllCctxPendingReviewResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2051,42 +2339,42 @@ func (m *QueryLastZetaHeightResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryLastZetaHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLastZetaHeightResponse.Merge(m, src)
+func (m *QueryAllCctxPendingReviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCctxPendingReviewResponse.Merge(m, src)
 }
-func (m *QueryLastZetaHeightResponse) XXX_Size() int {
+func (m *QueryAllCctxPendingReviewResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLastZetaHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLastZetaHeightResponse.DiscardUnknown(m)
+func (m *QueryAllCctxPendingReviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCctxPendingReviewResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLastZetaHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllCctxPendingReviewResponse proto.InternalMessageInfo
 
-func (m *QueryLastZetaHeightResponse) GetHeight() int64 {
+func (m *QueryAllCctxPendingReviewResponse) GetCrossChainTx() []*CrossChainTx {
 	if m != nil {
-		return m.Height
+		return m.CrossChainTx
 	}
-	return 0
+	return nil
 }
 
-type QueryConvertGasToZetaRequest struct {
-	ChainId  int64  `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
-	GasLimit string `protobuf:"bytes,2,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+type QueryAllCctxBySenderRequest struct {
+	Sender     string             `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryConvertGasToZetaRequest) Reset()         { *m = QueryConvertGasToZetaRequest{} }
-func (m *QueryConvertGasToZetaRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryConvertGasToZetaRequest) ProtoMessage()    {}
-func (*QueryConvertGasToZetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{45}
+func (m *QueryAllCctxBySenderRequest) Reset()         { *m = QueryAllCctxBySenderRequest{} }
+func (m *QueryAllCctxBySenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCctxBySenderRequest) ProtoMessage()    {}
+func (*QueryAllCctxBySenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{51}
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllCctxBySenderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllCctxBySenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConvertGasToZetaRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllCctxBySenderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2096,50 +2384,49 @@ func (m *QueryConvertGasToZetaRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConvertGasToZetaRequest.Merge(m, src)
+func (m *QueryAllCctxBySenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCctxBySenderRequest.Merge(m, src)
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Size() int {
+func (m *QueryAllCctxBySenderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConvertGasToZetaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConvertGasToZetaRequest.DiscardUnknown(m)
+func (m *QueryAllCctxBySenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCctxBySenderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConvertGasToZetaRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllCctxBySenderRequest proto.InternalMessageInfo
 
-func (m *QueryConvertGasToZetaRequest) GetChainId() int64 {
+func (m *QueryAllCctxBySenderRequest) GetSender() string {
 	if m != nil {
-		return m.ChainId
+		return m.Sender
 	}
-	return 0
+	return ""
 }
 
-func (m *QueryConvertGasToZetaRequest) GetGasLimit() string {
+func (m *QueryAllCctxBySenderRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.GasLimit
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-type QueryConvertGasToZetaResponse struct {
-	OutboundGasInZeta string `protobuf:"bytes,1,opt,name=outboundGasInZeta,proto3" json:"outboundGasInZeta,omitempty"`
-	ProtocolFeeInZeta string `protobuf:"bytes,2,opt,name=protocolFeeInZeta,proto3" json:"protocolFeeInZeta,omitempty"`
-	ZetaBlockHeight   uint64 `protobuf:"varint,3,opt,name=ZetaBlockHeight,proto3" json:"ZetaBlockHeight,omitempty"`
+type QueryAllCctxByReceiverRequest struct {
+	Receiver   string             `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryConvertGasToZetaResponse) Reset()         { *m = QueryConvertGasToZetaResponse{} }
-func (m *QueryConvertGasToZetaResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryConvertGasToZetaResponse) ProtoMessage()    {}
-func (*QueryConvertGasToZetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{46}
+func (m *QueryAllCctxByReceiverRequest) Reset()         { *m = QueryAllCctxByReceiverRequest{} }
+func (m *QueryAllCctxByReceiverRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCctxByReceiverRequest) ProtoMessage()    {}
+func (*QueryAllCctxByReceiverRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{52}
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllCctxByReceiverRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllCctxByReceiverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConvertGasToZetaResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllCctxByReceiverRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2149,54 +2436,49 @@ func (m *QueryConvertGasToZetaResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConvertGasToZetaResponse.Merge(m, src)
+func (m *QueryAllCctxByReceiverRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCctxByReceiverRequest.Merge(m, src)
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Size() int {
+func (m *QueryAllCctxByReceiverRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConvertGasToZetaResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConvertGasToZetaResponse.DiscardUnknown(m)
+func (m *QueryAllCctxByReceiverRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCctxByReceiverRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConvertGasToZetaResponse proto.InternalMessageInfo
-
-func (m *QueryConvertGasToZetaResponse) GetOutboundGasInZeta() string {
-	if m != nil {
-		return m.OutboundGasInZeta
-	}
-	return ""
-}
+var xxx_messageInfo_QueryAllCctxByReceiverRequest proto.InternalMessageInfo
 
-func (m *QueryConvertGasToZetaResponse) GetProtocolFeeInZeta() string {
+func (m *QueryAllCctxByReceiverRequest) GetReceiver() string {
 	if m != nil {
-		return m.ProtocolFeeInZeta
+		return m.Receiver
 	}
 	return ""
 }
 
-func (m *QueryConvertGasToZetaResponse) GetZetaBlockHeight() uint64 {
+func (m *QueryAllCctxByReceiverRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.ZetaBlockHeight
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-type QueryMessagePassingProtocolFeeRequest struct {
+type QueryAllCctxByStatusRequest struct {
+	Status     CctxStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) Reset()         { *m = QueryMessagePassingProtocolFeeRequest{} }
-func (m *QueryMessagePassingProtocolFeeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryMessagePassingProtocolFeeRequest) ProtoMessage()    {}
-func (*QueryMessagePassingProtocolFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{47}
+func (m *QueryAllCctxByStatusRequest) Reset()         { *m = QueryAllCctxByStatusRequest{} }
+func (m *QueryAllCctxByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCctxByStatusRequest) ProtoMessage()    {}
+func (*QueryAllCctxByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{53}
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllCctxByStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllCctxByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllCctxByStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2206,36 +2488,49 @@ func (m *QueryMessagePassingProtocolFeeRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Merge(m, src)
+func (m *QueryAllCctxByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCctxByStatusRequest.Merge(m, src)
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Size() int {
+func (m *QueryAllCctxByStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.DiscardUnknown(m)
+func (m *QueryAllCctxByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCctxByStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMessagePassingProtocolFeeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllCctxByStatusRequest proto.InternalMessageInfo
 
-type QueryMessagePassingProtocolFeeResponse struct {
-	FeeInZeta string `protobuf:"bytes,1,opt,name=feeInZeta,proto3" json:"feeInZeta,omitempty"`
+func (m *QueryAllCctxByStatusRequest) GetStatus() CctxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CctxStatus_PendingInbound
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) Reset() {
-	*m = QueryMessagePassingProtocolFeeResponse{}
+func (m *QueryAllCctxByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryMessagePassingProtocolFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryMessagePassingProtocolFeeResponse) ProtoMessage()    {}
-func (*QueryMessagePassingProtocolFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{48}
+
+type QueryAllCctxBySenderChainRequest struct {
+	SenderChainId int64              `protobuf:"varint,1,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryAllCctxBySenderChainRequest) Reset()         { *m = QueryAllCctxBySenderChainRequest{} }
+func (m *QueryAllCctxBySenderChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCctxBySenderChainRequest) ProtoMessage()    {}
+func (*QueryAllCctxBySenderChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{54}
+}
+func (m *QueryAllCctxBySenderChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllCctxBySenderChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllCctxBySenderChainRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2245,41 +2540,51 @@ func (m *QueryMessagePassingProtocolFeeResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Merge(m, src)
+func (m *QueryAllCctxBySenderChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCctxBySenderChainRequest.Merge(m, src)
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Size() int {
+func (m *QueryAllCctxBySenderChainRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.DiscardUnknown(m)
+func (m *QueryAllCctxBySenderChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCctxBySenderChainRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMessagePassingProtocolFeeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllCctxBySenderChainRequest proto.InternalMessageInfo
 
-func (m *QueryMessagePassingProtocolFeeResponse) GetFeeInZeta() string {
+func (m *QueryAllCctxBySenderChainRequest) GetSenderChainId() int64 {
 	if m != nil {
-		return m.FeeInZeta
+		return m.SenderChainId
 	}
-	return ""
+	return 0
 }
 
-type QueryZEVMGetTransactionReceiptRequest struct {
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+func (m *QueryAllCctxBySenderChainRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryZEVMGetTransactionReceiptRequest) Reset()         { *m = QueryZEVMGetTransactionReceiptRequest{} }
-func (m *QueryZEVMGetTransactionReceiptRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryZEVMGetTransactionReceiptRequest) ProtoMessage()    {}
-func (*QueryZEVMGetTransactionReceiptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{49}
+// the range of blocks is inclusive, only the key and the limit of the pagination are supported
+type QueryAllCctxByHeightRequest struct {
+	StartHeight uint64             `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64             `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryZEVMGetTransactionReceiptRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryAllCctxByHeightRequest) Reset()         { *m = QueryAllCctxByHeightRequest{} }
+func (m *QueryAllCctxByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCctxByHeightRequest) ProtoMessage()    {}
+func (*QueryAllCctxByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{55}
+}
+func (m *QueryAllCctxByHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryZEVMGetTransactionReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllCctxByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryZEVMGetTransactionReceiptRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllCctxByHeightRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2289,54 +2594,54 @@ func (m *QueryZEVMGetTransactionReceiptRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryZEVMGetTransactionReceiptRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryZEVMGetTransactionReceiptRequest.Merge(m, src)
+func (m *QueryAllCctxByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCctxByHeightRequest.Merge(m, src)
 }
-func (m *QueryZEVMGetTransactionReceiptRequest) XXX_Size() int {
+func (m *QueryAllCctxByHeightRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryZEVMGetTransactionReceiptRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryZEVMGetTransactionReceiptRequest.DiscardUnknown(m)
+func (m *QueryAllCctxByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCctxByHeightRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryZEVMGetTransactionReceiptRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllCctxByHeightRequest proto.InternalMessageInfo
 
-func (m *QueryZEVMGetTransactionReceiptRequest) GetHash() string {
+func (m *QueryAllCctxByHeightRequest) GetStartHeight() uint64 {
 	if m != nil {
-		return m.Hash
+		return m.StartHeight
 	}
-	return ""
+	return 0
 }
 
-type QueryZEVMGetTransactionReceiptResponse struct {
-	BlockHash         string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"blockHash"`
-	BlockNumber       string `protobuf:"bytes,2,opt,name=block_number,json=blockNumber,proto3" json:"blockNumber"`
-	ContractAddress   string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contractAddress"`
-	CumulativeGasUsed string `protobuf:"bytes,4,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulativeGasUsed"`
-	From              string `protobuf:"bytes,5,opt,name=from,proto3" json:"from"`
-	GasUsed           string `protobuf:"bytes,6,opt,name=gas_used,json=gasUsed,proto3" json:"gasUsed"`
-	LogsBloom         string `protobuf:"bytes,7,opt,name=logs_bloom,json=logsBloom,proto3" json:"logsBloom"`
-	Status            string `protobuf:"bytes,8,opt,name=status,proto3" json:"status"`
-	To                string `protobuf:"bytes,9,opt,name=to,proto3" json:"to"`
-	TransactionHash   string `protobuf:"bytes,10,opt,name=transaction_hash,json=transactionHash,proto3" json:"transactionHash"`
-	TransactionIndex  string `protobuf:"bytes,11,opt,name=transaction_index,json=transactionIndex,proto3" json:"transactionIndex"`
-	Logs              []*Log `protobuf:"bytes,12,rep,name=logs,proto3" json:"logs,omitempty"`
+func (m *QueryAllCctxByHeightRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
 
-func (m *QueryZEVMGetTransactionReceiptResponse) Reset() {
-	*m = QueryZEVMGetTransactionReceiptResponse{}
+func (m *QueryAllCctxByHeightRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryZEVMGetTransactionReceiptResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryZEVMGetTransactionReceiptResponse) ProtoMessage()    {}
-func (*QueryZEVMGetTransactionReceiptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{50}
+
+type QueryRateLimitsRequest struct {
 }
-func (m *QueryZEVMGetTransactionReceiptResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{56}
+}
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryZEVMGetTransactionReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryZEVMGetTransactionReceiptResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2346,127 +2651,122 @@ func (m *QueryZEVMGetTransactionReceiptResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryZEVMGetTransactionReceiptResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryZEVMGetTransactionReceiptResponse.Merge(m, src)
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
 }
-func (m *QueryZEVMGetTransactionReceiptResponse) XXX_Size() int {
+func (m *QueryRateLimitsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryZEVMGetTransactionReceiptResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryZEVMGetTransactionReceiptResponse.DiscardUnknown(m)
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryZEVMGetTransactionReceiptResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
 
-func (m *QueryZEVMGetTransactionReceiptResponse) GetBlockHash() string {
-	if m != nil {
-		return m.BlockHash
-	}
-	return ""
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimit       `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	Windows    []RateLimitWindow `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows"`
 }
 
-func (m *QueryZEVMGetTransactionReceiptResponse) GetBlockNumber() string {
-	if m != nil {
-		return m.BlockNumber
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{57}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryZEVMGetTransactionReceiptResponse) GetContractAddress() string {
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimit {
 	if m != nil {
-		return m.ContractAddress
+		return m.RateLimits
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryZEVMGetTransactionReceiptResponse) GetCumulativeGasUsed() string {
+func (m *QueryRateLimitsResponse) GetWindows() []RateLimitWindow {
 	if m != nil {
-		return m.CumulativeGasUsed
+		return m.Windows
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryZEVMGetTransactionReceiptResponse) GetFrom() string {
-	if m != nil {
-		return m.From
-	}
-	return ""
+type QueryLastZetaHeightRequest struct {
 }
 
-func (m *QueryZEVMGetTransactionReceiptResponse) GetGasUsed() string {
-	if m != nil {
-		return m.GasUsed
-	}
-	return ""
+func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightRequest{} }
+func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
+func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{58}
 }
-
-func (m *QueryZEVMGetTransactionReceiptResponse) GetLogsBloom() string {
-	if m != nil {
-		return m.LogsBloom
-	}
-	return ""
+func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryZEVMGetTransactionReceiptResponse) GetStatus() string {
-	if m != nil {
-		return m.Status
+func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastZetaHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
 }
-
-func (m *QueryZEVMGetTransactionReceiptResponse) GetTo() string {
-	if m != nil {
-		return m.To
-	}
-	return ""
+func (m *QueryLastZetaHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastZetaHeightRequest.Merge(m, src)
 }
-
-func (m *QueryZEVMGetTransactionReceiptResponse) GetTransactionHash() string {
-	if m != nil {
-		return m.TransactionHash
-	}
-	return ""
+func (m *QueryLastZetaHeightRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryZEVMGetTransactionReceiptResponse) GetTransactionIndex() string {
-	if m != nil {
-		return m.TransactionIndex
-	}
-	return ""
+func (m *QueryLastZetaHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastZetaHeightRequest.DiscardUnknown(m)
 }
 
-func (m *QueryZEVMGetTransactionReceiptResponse) GetLogs() []*Log {
-	if m != nil {
-		return m.Logs
-	}
-	return nil
-}
+var xxx_messageInfo_QueryLastZetaHeightRequest proto.InternalMessageInfo
 
-type Log struct {
-	Address string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Topics  []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
-	Data    string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	// sythetic fields
-	BlockNumber      uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"blockNumber"`
-	TransactionHash  string `protobuf:"bytes,5,opt,name=transaction_hash,json=transactionHash,proto3" json:"transactionHash"`
-	TransactionIndex uint64 `protobuf:"varint,6,opt,name=transaction_index,json=transactionIndex,proto3" json:"transactionIndex"`
-	BlockHash        string `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"blockHash"`
-	LogIndex         uint64 `protobuf:"varint,8,opt,name=log_index,json=logIndex,proto3" json:"logIndex"`
-	Removed          bool   `protobuf:"varint,9,opt,name=removed,proto3" json:"removed"`
+type QueryLastZetaHeightResponse struct {
+	Height int64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
 }
 
-func (m *Log) Reset()         { *m = Log{} }
-func (m *Log) String() string { return proto.CompactTextString(m) }
-func (*Log) ProtoMessage()    {}
-func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{51}
+func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeightResponse{} }
+func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
+func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{59}
 }
-func (m *Log) XXX_Unmarshal(b []byte) error {
+func (m *QueryLastZetaHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLastZetaHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Log.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLastZetaHeightResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2476,97 +2776,152 @@ func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Log) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Log.Merge(m, src)
+func (m *QueryLastZetaHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastZetaHeightResponse.Merge(m, src)
 }
-func (m *Log) XXX_Size() int {
+func (m *QueryLastZetaHeightResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Log) XXX_DiscardUnknown() {
-	xxx_messageInfo_Log.DiscardUnknown(m)
+func (m *QueryLastZetaHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastZetaHeightResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Log proto.InternalMessageInfo
+var xxx_messageInfo_QueryLastZetaHeightResponse proto.InternalMessageInfo
 
-func (m *Log) GetAddress() string {
+func (m *QueryLastZetaHeightResponse) GetHeight() int64 {
 	if m != nil {
-		return m.Address
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *Log) GetTopics() []string {
-	if m != nil {
-		return m.Topics
-	}
-	return nil
+type QueryConvertGasToZetaRequest struct {
+	ChainId  int64  `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	GasLimit string `protobuf:"bytes,2,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
 }
 
-func (m *Log) GetData() string {
-	if m != nil {
-		return m.Data
+func (m *QueryConvertGasToZetaRequest) Reset()         { *m = QueryConvertGasToZetaRequest{} }
+func (m *QueryConvertGasToZetaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConvertGasToZetaRequest) ProtoMessage()    {}
+func (*QueryConvertGasToZetaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{60}
+}
+func (m *QueryConvertGasToZetaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConvertGasToZetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvertGasToZetaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryConvertGasToZetaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvertGasToZetaRequest.Merge(m, src)
+}
+func (m *QueryConvertGasToZetaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConvertGasToZetaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvertGasToZetaRequest.DiscardUnknown(m)
 }
 
-func (m *Log) GetBlockNumber() uint64 {
+var xxx_messageInfo_QueryConvertGasToZetaRequest proto.InternalMessageInfo
+
+func (m *QueryConvertGasToZetaRequest) GetChainId() int64 {
 	if m != nil {
-		return m.BlockNumber
+		return m.ChainId
 	}
 	return 0
 }
 
-func (m *Log) GetTransactionHash() string {
+func (m *QueryConvertGasToZetaRequest) GetGasLimit() string {
 	if m != nil {
-		return m.TransactionHash
+		return m.GasLimit
 	}
 	return ""
 }
 
-func (m *Log) GetTransactionIndex() uint64 {
-	if m != nil {
-		return m.TransactionIndex
+type QueryConvertGasToZetaResponse struct {
+	OutboundGasInZeta string `protobuf:"bytes,1,opt,name=outboundGasInZeta,proto3" json:"outboundGasInZeta,omitempty"`
+	ProtocolFeeInZeta string `protobuf:"bytes,2,opt,name=protocolFeeInZeta,proto3" json:"protocolFeeInZeta,omitempty"`
+	ZetaBlockHeight   uint64 `protobuf:"varint,3,opt,name=ZetaBlockHeight,proto3" json:"ZetaBlockHeight,omitempty"`
+}
+
+func (m *QueryConvertGasToZetaResponse) Reset()         { *m = QueryConvertGasToZetaResponse{} }
+func (m *QueryConvertGasToZetaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConvertGasToZetaResponse) ProtoMessage()    {}
+func (*QueryConvertGasToZetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{61}
+}
+func (m *QueryConvertGasToZetaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConvertGasToZetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvertGasToZetaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryConvertGasToZetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvertGasToZetaResponse.Merge(m, src)
+}
+func (m *QueryConvertGasToZetaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConvertGasToZetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvertGasToZetaResponse.DiscardUnknown(m)
 }
 
-func (m *Log) GetBlockHash() string {
+var xxx_messageInfo_QueryConvertGasToZetaResponse proto.InternalMessageInfo
+
+func (m *QueryConvertGasToZetaResponse) GetOutboundGasInZeta() string {
 	if m != nil {
-		return m.BlockHash
+		return m.OutboundGasInZeta
 	}
 	return ""
 }
 
-func (m *Log) GetLogIndex() uint64 {
+func (m *QueryConvertGasToZetaResponse) GetProtocolFeeInZeta() string {
 	if m != nil {
-		return m.LogIndex
+		return m.ProtocolFeeInZeta
 	}
-	return 0
+	return ""
 }
 
-func (m *Log) GetRemoved() bool {
+func (m *QueryConvertGasToZetaResponse) GetZetaBlockHeight() uint64 {
 	if m != nil {
-		return m.Removed
+		return m.ZetaBlockHeight
 	}
-	return false
+	return 0
 }
 
-type QueryZEVMGetTransactionRequest struct {
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+type QueryMessagePassingProtocolFeeRequest struct {
 }
 
-func (m *QueryZEVMGetTransactionRequest) Reset()         { *m = QueryZEVMGetTransactionRequest{} }
-func (m *QueryZEVMGetTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryZEVMGetTransactionRequest) ProtoMessage()    {}
-func (*QueryZEVMGetTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{52}
+func (m *QueryMessagePassingProtocolFeeRequest) Reset()         { *m = QueryMessagePassingProtocolFeeRequest{} }
+func (m *QueryMessagePassingProtocolFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMessagePassingProtocolFeeRequest) ProtoMessage()    {}
+func (*QueryMessagePassingProtocolFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{62}
 }
-func (m *QueryZEVMGetTransactionRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryZEVMGetTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryZEVMGetTransactionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2576,57 +2931,36 @@ func (m *QueryZEVMGetTransactionRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryZEVMGetTransactionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryZEVMGetTransactionRequest.Merge(m, src)
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Merge(m, src)
 }
-func (m *QueryZEVMGetTransactionRequest) XXX_Size() int {
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryZEVMGetTransactionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryZEVMGetTransactionRequest.DiscardUnknown(m)
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryZEVMGetTransactionRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryMessagePassingProtocolFeeRequest proto.InternalMessageInfo
 
-func (m *QueryZEVMGetTransactionRequest) GetHash() string {
-	if m != nil {
-		return m.Hash
-	}
-	return ""
+type QueryMessagePassingProtocolFeeResponse struct {
+	FeeInZeta string `protobuf:"bytes,1,opt,name=feeInZeta,proto3" json:"feeInZeta,omitempty"`
 }
 
-type QueryZEVMGetTransactionResponse struct {
-	BlockHash        string   `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"blockHash"`
-	BlockNumber      string   `protobuf:"bytes,2,opt,name=block_number,json=blockNumber,proto3" json:"blockNumber"`
-	From             string   `protobuf:"bytes,3,opt,name=from,proto3" json:"from"`
-	Gas              string   `protobuf:"bytes,4,opt,name=gas,proto3" json:"gas"`
-	GasPrice         string   `protobuf:"bytes,5,opt,name=gas_price,json=gasPrice,proto3" json:"gasPrice"`
-	Hash             string   `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash"`
-	Input            string   `protobuf:"bytes,7,opt,name=input,proto3" json:"input"`
-	Nonce            string   `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce"`
-	To               string   `protobuf:"bytes,9,opt,name=to,proto3" json:"to"`
-	TransactionIndex string   `protobuf:"bytes,10,opt,name=transaction_index,json=transactionIndex,proto3" json:"transactionIndex"`
-	Value            string   `protobuf:"bytes,11,opt,name=value,proto3" json:"value"`
-	Type             string   `protobuf:"bytes,12,opt,name=type,proto3" json:"type"`
-	AccessList       []string `protobuf:"bytes,13,rep,name=access_list,json=accessList,proto3" json:"accessList"`
-	ChainId          string   `protobuf:"bytes,14,opt,name=chain_id,json=chainId,proto3" json:"chainId"`
-	V                string   `protobuf:"bytes,15,opt,name=v,proto3" json:"v"`
-	R                string   `protobuf:"bytes,16,opt,name=r,proto3" json:"r"`
-	S                string   `protobuf:"bytes,17,opt,name=s,proto3" json:"s"`
+func (m *QueryMessagePassingProtocolFeeResponse) Reset() {
+	*m = QueryMessagePassingProtocolFeeResponse{}
 }
-
-func (m *QueryZEVMGetTransactionResponse) Reset()         { *m = QueryZEVMGetTransactionResponse{} }
-func (m *QueryZEVMGetTransactionResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryZEVMGetTransactionResponse) ProtoMessage()    {}
-func (*QueryZEVMGetTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{53}
+func (m *QueryMessagePassingProtocolFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMessagePassingProtocolFeeResponse) ProtoMessage()    {}
+func (*QueryMessagePassingProtocolFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{63}
 }
-func (m *QueryZEVMGetTransactionResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryZEVMGetTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryZEVMGetTransactionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2636,153 +2970,228 @@ func (m *QueryZEVMGetTransactionResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryZEVMGetTransactionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryZEVMGetTransactionResponse.Merge(m, src)
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Merge(m, src)
 }
-func (m *QueryZEVMGetTransactionResponse) XXX_Size() int {
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryZEVMGetTransactionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryZEVMGetTransactionResponse.DiscardUnknown(m)
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryZEVMGetTransactionResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryMessagePassingProtocolFeeResponse proto.InternalMessageInfo
 
-func (m *QueryZEVMGetTransactionResponse) GetBlockHash() string {
+func (m *QueryMessagePassingProtocolFeeResponse) GetFeeInZeta() string {
 	if m != nil {
-		return m.BlockHash
+		return m.FeeInZeta
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetBlockNumber() string {
-	if m != nil {
-		return m.BlockNumber
-	}
-	return ""
+type QueryZEVMGetTransactionReceiptRequest struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetFrom() string {
-	if m != nil {
-		return m.From
+func (m *QueryZEVMGetTransactionReceiptRequest) Reset()         { *m = QueryZEVMGetTransactionReceiptRequest{} }
+func (m *QueryZEVMGetTransactionReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryZEVMGetTransactionReceiptRequest) ProtoMessage()    {}
+func (*QueryZEVMGetTransactionReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{64}
+}
+func (m *QueryZEVMGetTransactionReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryZEVMGetTransactionReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryZEVMGetTransactionReceiptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryZEVMGetTransactionReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZEVMGetTransactionReceiptRequest.Merge(m, src)
+}
+func (m *QueryZEVMGetTransactionReceiptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryZEVMGetTransactionReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZEVMGetTransactionReceiptRequest.DiscardUnknown(m)
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetGas() string {
+var xxx_messageInfo_QueryZEVMGetTransactionReceiptRequest proto.InternalMessageInfo
+
+func (m *QueryZEVMGetTransactionReceiptRequest) GetHash() string {
 	if m != nil {
-		return m.Gas
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetGasPrice() string {
-	if m != nil {
-		return m.GasPrice
+type QueryZEVMGetTransactionReceiptResponse struct {
+	BlockHash         string `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"blockHash"`
+	BlockNumber       string `protobuf:"bytes,2,opt,name=block_number,json=blockNumber,proto3" json:"blockNumber"`
+	ContractAddress   string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contractAddress"`
+	CumulativeGasUsed string `protobuf:"bytes,4,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulativeGasUsed"`
+	From              string `protobuf:"bytes,5,opt,name=from,proto3" json:"from"`
+	GasUsed           string `protobuf:"bytes,6,opt,name=gas_used,json=gasUsed,proto3" json:"gasUsed"`
+	LogsBloom         string `protobuf:"bytes,7,opt,name=logs_bloom,json=logsBloom,proto3" json:"logsBloom"`
+	Status            string `protobuf:"bytes,8,opt,name=status,proto3" json:"status"`
+	To                string `protobuf:"bytes,9,opt,name=to,proto3" json:"to"`
+	TransactionHash   string `protobuf:"bytes,10,opt,name=transaction_hash,json=transactionHash,proto3" json:"transactionHash"`
+	TransactionIndex  string `protobuf:"bytes,11,opt,name=transaction_index,json=transactionIndex,proto3" json:"transactionIndex"`
+	Logs              []*Log `protobuf:"bytes,12,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (m *QueryZEVMGetTransactionReceiptResponse) Reset() {
+	*m = QueryZEVMGetTransactionReceiptResponse{}
+}
+func (m *QueryZEVMGetTransactionReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryZEVMGetTransactionReceiptResponse) ProtoMessage()    {}
+func (*QueryZEVMGetTransactionReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{65}
+}
+func (m *QueryZEVMGetTransactionReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryZEVMGetTransactionReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryZEVMGetTransactionReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryZEVMGetTransactionReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZEVMGetTransactionReceiptResponse.Merge(m, src)
+}
+func (m *QueryZEVMGetTransactionReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryZEVMGetTransactionReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZEVMGetTransactionReceiptResponse.DiscardUnknown(m)
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetHash() string {
+var xxx_messageInfo_QueryZEVMGetTransactionReceiptResponse proto.InternalMessageInfo
+
+func (m *QueryZEVMGetTransactionReceiptResponse) GetBlockHash() string {
 	if m != nil {
-		return m.Hash
+		return m.BlockHash
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetInput() string {
+func (m *QueryZEVMGetTransactionReceiptResponse) GetBlockNumber() string {
 	if m != nil {
-		return m.Input
+		return m.BlockNumber
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetNonce() string {
+func (m *QueryZEVMGetTransactionReceiptResponse) GetContractAddress() string {
 	if m != nil {
-		return m.Nonce
+		return m.ContractAddress
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetTo() string {
+func (m *QueryZEVMGetTransactionReceiptResponse) GetCumulativeGasUsed() string {
 	if m != nil {
-		return m.To
+		return m.CumulativeGasUsed
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetTransactionIndex() string {
+func (m *QueryZEVMGetTransactionReceiptResponse) GetFrom() string {
 	if m != nil {
-		return m.TransactionIndex
+		return m.From
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetValue() string {
+func (m *QueryZEVMGetTransactionReceiptResponse) GetGasUsed() string {
 	if m != nil {
-		return m.Value
+		return m.GasUsed
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetType() string {
+func (m *QueryZEVMGetTransactionReceiptResponse) GetLogsBloom() string {
 	if m != nil {
-		return m.Type
+		return m.LogsBloom
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetAccessList() []string {
+func (m *QueryZEVMGetTransactionReceiptResponse) GetStatus() string {
 	if m != nil {
-		return m.AccessList
+		return m.Status
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetChainId() string {
+func (m *QueryZEVMGetTransactionReceiptResponse) GetTo() string {
 	if m != nil {
-		return m.ChainId
+		return m.To
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetV() string {
+func (m *QueryZEVMGetTransactionReceiptResponse) GetTransactionHash() string {
 	if m != nil {
-		return m.V
+		return m.TransactionHash
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetR() string {
+func (m *QueryZEVMGetTransactionReceiptResponse) GetTransactionIndex() string {
 	if m != nil {
-		return m.R
+		return m.TransactionIndex
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetTransactionResponse) GetS() string {
+func (m *QueryZEVMGetTransactionReceiptResponse) GetLogs() []*Log {
 	if m != nil {
-		return m.S
+		return m.Logs
 	}
-	return ""
+	return nil
 }
 
-type QueryZEVMGetBlockByNumberRequest struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+type Log struct {
+	Address string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Topics  []string `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	Data    string   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// sythetic fields
+	BlockNumber      uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"blockNumber"`
+	TransactionHash  string `protobuf:"bytes,5,opt,name=transaction_hash,json=transactionHash,proto3" json:"transactionHash"`
+	TransactionIndex uint64 `protobuf:"varint,6,opt,name=transaction_index,json=transactionIndex,proto3" json:"transactionIndex"`
+	BlockHash        string `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"blockHash"`
+	LogIndex         uint64 `protobuf:"varint,8,opt,name=log_index,json=logIndex,proto3" json:"logIndex"`
+	Removed          bool   `protobuf:"varint,9,opt,name=removed,proto3" json:"removed"`
 }
 
-func (m *QueryZEVMGetBlockByNumberRequest) Reset()         { *m = QueryZEVMGetBlockByNumberRequest{} }
-func (m *QueryZEVMGetBlockByNumberRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryZEVMGetBlockByNumberRequest) ProtoMessage()    {}
-func (*QueryZEVMGetBlockByNumberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{54}
+func (m *Log) Reset()         { *m = Log{} }
+func (m *Log) String() string { return proto.CompactTextString(m) }
+func (*Log) ProtoMessage()    {}
+func (*Log) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{66}
 }
-func (m *QueryZEVMGetBlockByNumberRequest) XXX_Unmarshal(b []byte) error {
+func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryZEVMGetBlockByNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Log) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryZEVMGetBlockByNumberRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Log.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2792,61 +3201,97 @@ func (m *QueryZEVMGetBlockByNumberRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryZEVMGetBlockByNumberRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryZEVMGetBlockByNumberRequest.Merge(m, src)
+func (m *Log) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Log.Merge(m, src)
 }
-func (m *QueryZEVMGetBlockByNumberRequest) XXX_Size() int {
+func (m *Log) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryZEVMGetBlockByNumberRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryZEVMGetBlockByNumberRequest.DiscardUnknown(m)
+func (m *Log) XXX_DiscardUnknown() {
+	xxx_messageInfo_Log.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryZEVMGetBlockByNumberRequest proto.InternalMessageInfo
+var xxx_messageInfo_Log proto.InternalMessageInfo
 
-func (m *QueryZEVMGetBlockByNumberRequest) GetHeight() uint64 {
+func (m *Log) GetAddress() string {
 	if m != nil {
-		return m.Height
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-type QueryZEVMGetBlockByNumberResponse struct {
-	Number           string   `protobuf:"bytes,1,opt,name=number,proto3" json:"number"`
-	Hash             string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash"`
-	ParentHash       string   `protobuf:"bytes,3,opt,name=parent_hash,json=parentHash,proto3" json:"parentHash"`
-	Nonce            string   `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce"`
-	Sha3Uncles       string   `protobuf:"bytes,5,opt,name=sha3_uncles,json=sha3Uncles,proto3" json:"sha3Uncles"`
-	LogsBloom        string   `protobuf:"bytes,6,opt,name=logs_bloom,json=logsBloom,proto3" json:"logsBloom"`
-	TransactionsRoot string   `protobuf:"bytes,7,opt,name=transactions_root,json=transactionsRoot,proto3" json:"transactionsRoot"`
-	StateRoot        string   `protobuf:"bytes,8,opt,name=state_root,json=stateRoot,proto3" json:"stateRoot"`
-	ReceiptsRoot     string   `protobuf:"bytes,9,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receiptsRoot"`
-	Miner            string   `protobuf:"bytes,10,opt,name=miner,proto3" json:"miner"`
-	Difficulty       string   `protobuf:"bytes,11,opt,name=difficulty,proto3" json:"difficulty"`
-	TotalDifficulty  string   `protobuf:"bytes,12,opt,name=total_difficulty,json=totalDifficulty,proto3" json:"totalDifficulty"`
-	ExtraData        string   `protobuf:"bytes,13,opt,name=extra_data,json=extraData,proto3" json:"extraData"`
-	Size_            string   `protobuf:"bytes,14,opt,name=size,proto3" json:"size"`
-	GasLimit         string   `protobuf:"bytes,15,opt,name=gas_limit,json=gasLimit,proto3" json:"gasLimit"`
-	GasUsed          string   `protobuf:"bytes,16,opt,name=gas_used,json=gasUsed,proto3" json:"gasUsed"`
-	Timestamp        string   `protobuf:"bytes,17,opt,name=timestamp,proto3" json:"timestamp"`
-	Transactions     []string `protobuf:"bytes,18,rep,name=transactions,proto3" json:"transactions"`
-	Uncles           []string `protobuf:"bytes,19,rep,name=uncles,proto3" json:"uncles"`
-	BaseFeePerGas    string   `protobuf:"bytes,20,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3" json:"baseFeePerGas"`
-	MixHash          string   `protobuf:"bytes,21,opt,name=mix_hash,json=mixHash,proto3" json:"mixHash"`
+func (m *Log) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) Reset()         { *m = QueryZEVMGetBlockByNumberResponse{} }
-func (m *QueryZEVMGetBlockByNumberResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryZEVMGetBlockByNumberResponse) ProtoMessage()    {}
-func (*QueryZEVMGetBlockByNumberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_65a992045e92a606, []int{55}
+func (m *Log) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
 }
-func (m *QueryZEVMGetBlockByNumberResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *Log) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *Log) GetTransactionHash() string {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return ""
+}
+
+func (m *Log) GetTransactionIndex() uint64 {
+	if m != nil {
+		return m.TransactionIndex
+	}
+	return 0
+}
+
+func (m *Log) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *Log) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *Log) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+type QueryZEVMGetTransactionRequest struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *QueryZEVMGetTransactionRequest) Reset()         { *m = QueryZEVMGetTransactionRequest{} }
+func (m *QueryZEVMGetTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryZEVMGetTransactionRequest) ProtoMessage()    {}
+func (*QueryZEVMGetTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{67}
+}
+func (m *QueryZEVMGetTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryZEVMGetBlockByNumberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryZEVMGetTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryZEVMGetBlockByNumberResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryZEVMGetTransactionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2856,2089 +3301,2119 @@ func (m *QueryZEVMGetBlockByNumberResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryZEVMGetBlockByNumberResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryZEVMGetBlockByNumberResponse.Merge(m, src)
+func (m *QueryZEVMGetTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZEVMGetTransactionRequest.Merge(m, src)
 }
-func (m *QueryZEVMGetBlockByNumberResponse) XXX_Size() int {
+func (m *QueryZEVMGetTransactionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryZEVMGetBlockByNumberResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryZEVMGetBlockByNumberResponse.DiscardUnknown(m)
+func (m *QueryZEVMGetTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZEVMGetTransactionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryZEVMGetBlockByNumberResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryZEVMGetTransactionRequest proto.InternalMessageInfo
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetNumber() string {
+func (m *QueryZEVMGetTransactionRequest) GetHash() string {
 	if m != nil {
-		return m.Number
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetHash() string {
-	if m != nil {
-		return m.Hash
-	}
-	return ""
+type QueryZEVMGetTransactionResponse struct {
+	BlockHash        string   `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"blockHash"`
+	BlockNumber      string   `protobuf:"bytes,2,opt,name=block_number,json=blockNumber,proto3" json:"blockNumber"`
+	From             string   `protobuf:"bytes,3,opt,name=from,proto3" json:"from"`
+	Gas              string   `protobuf:"bytes,4,opt,name=gas,proto3" json:"gas"`
+	GasPrice         string   `protobuf:"bytes,5,opt,name=gas_price,json=gasPrice,proto3" json:"gasPrice"`
+	Hash             string   `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash"`
+	Input            string   `protobuf:"bytes,7,opt,name=input,proto3" json:"input"`
+	Nonce            string   `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce"`
+	To               string   `protobuf:"bytes,9,opt,name=to,proto3" json:"to"`
+	TransactionIndex string   `protobuf:"bytes,10,opt,name=transaction_index,json=transactionIndex,proto3" json:"transactionIndex"`
+	Value            string   `protobuf:"bytes,11,opt,name=value,proto3" json:"value"`
+	Type             string   `protobuf:"bytes,12,opt,name=type,proto3" json:"type"`
+	AccessList       []string `protobuf:"bytes,13,rep,name=access_list,json=accessList,proto3" json:"accessList"`
+	ChainId          string   `protobuf:"bytes,14,opt,name=chain_id,json=chainId,proto3" json:"chainId"`
+	V                string   `protobuf:"bytes,15,opt,name=v,proto3" json:"v"`
+	R                string   `protobuf:"bytes,16,opt,name=r,proto3" json:"r"`
+	S                string   `protobuf:"bytes,17,opt,name=s,proto3" json:"s"`
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetParentHash() string {
-	if m != nil {
-		return m.ParentHash
+func (m *QueryZEVMGetTransactionResponse) Reset()         { *m = QueryZEVMGetTransactionResponse{} }
+func (m *QueryZEVMGetTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryZEVMGetTransactionResponse) ProtoMessage()    {}
+func (*QueryZEVMGetTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{68}
+}
+func (m *QueryZEVMGetTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryZEVMGetTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryZEVMGetTransactionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryZEVMGetTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZEVMGetTransactionResponse.Merge(m, src)
+}
+func (m *QueryZEVMGetTransactionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryZEVMGetTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZEVMGetTransactionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetNonce() string {
+var xxx_messageInfo_QueryZEVMGetTransactionResponse proto.InternalMessageInfo
+
+func (m *QueryZEVMGetTransactionResponse) GetBlockHash() string {
 	if m != nil {
-		return m.Nonce
+		return m.BlockHash
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetSha3Uncles() string {
+func (m *QueryZEVMGetTransactionResponse) GetBlockNumber() string {
 	if m != nil {
-		return m.Sha3Uncles
+		return m.BlockNumber
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetLogsBloom() string {
+func (m *QueryZEVMGetTransactionResponse) GetFrom() string {
 	if m != nil {
-		return m.LogsBloom
+		return m.From
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetTransactionsRoot() string {
+func (m *QueryZEVMGetTransactionResponse) GetGas() string {
 	if m != nil {
-		return m.TransactionsRoot
+		return m.Gas
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetStateRoot() string {
+func (m *QueryZEVMGetTransactionResponse) GetGasPrice() string {
 	if m != nil {
-		return m.StateRoot
+		return m.GasPrice
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetReceiptsRoot() string {
+func (m *QueryZEVMGetTransactionResponse) GetHash() string {
 	if m != nil {
-		return m.ReceiptsRoot
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetMiner() string {
+func (m *QueryZEVMGetTransactionResponse) GetInput() string {
 	if m != nil {
-		return m.Miner
+		return m.Input
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetDifficulty() string {
+func (m *QueryZEVMGetTransactionResponse) GetNonce() string {
 	if m != nil {
-		return m.Difficulty
+		return m.Nonce
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetTotalDifficulty() string {
+func (m *QueryZEVMGetTransactionResponse) GetTo() string {
 	if m != nil {
-		return m.TotalDifficulty
+		return m.To
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetExtraData() string {
+func (m *QueryZEVMGetTransactionResponse) GetTransactionIndex() string {
 	if m != nil {
-		return m.ExtraData
+		return m.TransactionIndex
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetSize_() string {
+func (m *QueryZEVMGetTransactionResponse) GetValue() string {
 	if m != nil {
-		return m.Size_
+		return m.Value
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetGasLimit() string {
+func (m *QueryZEVMGetTransactionResponse) GetType() string {
 	if m != nil {
-		return m.GasLimit
+		return m.Type
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetGasUsed() string {
+func (m *QueryZEVMGetTransactionResponse) GetAccessList() []string {
 	if m != nil {
-		return m.GasUsed
+		return m.AccessList
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetTimestamp() string {
+func (m *QueryZEVMGetTransactionResponse) GetChainId() string {
 	if m != nil {
-		return m.Timestamp
+		return m.ChainId
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetTransactions() []string {
+func (m *QueryZEVMGetTransactionResponse) GetV() string {
 	if m != nil {
-		return m.Transactions
+		return m.V
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetUncles() []string {
+func (m *QueryZEVMGetTransactionResponse) GetR() string {
 	if m != nil {
-		return m.Uncles
+		return m.R
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetBaseFeePerGas() string {
+func (m *QueryZEVMGetTransactionResponse) GetS() string {
 	if m != nil {
-		return m.BaseFeePerGas
+		return m.S
 	}
 	return ""
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) GetMixHash() string {
-	if m != nil {
-		return m.MixHash
-	}
-	return ""
+type QueryZEVMGetBlockByNumberRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*QueryTssHistoryRequest)(nil), "zetachain.zetacore.crosschain.QueryTssHistoryRequest")
-	proto.RegisterType((*QueryTssHistoryResponse)(nil), "zetachain.zetacore.crosschain.QueryTssHistoryResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.crosschain.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.crosschain.QueryParamsResponse")
-	proto.RegisterType((*QueryGetOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerRequest")
-	proto.RegisterType((*QueryGetOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerResponse")
-	proto.RegisterType((*QueryAllOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerRequest")
-	proto.RegisterType((*QueryAllOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerResponse")
-	proto.RegisterType((*QueryAllOutTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainRequest")
-	proto.RegisterType((*QueryAllOutTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainResponse")
-	proto.RegisterType((*QueryGetInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxRequest")
-	proto.RegisterType((*QueryGetInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxResponse")
-	proto.RegisterType((*QueryInTxHashToCctxDataRequest)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataRequest")
-	proto.RegisterType((*QueryInTxHashToCctxDataResponse)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataResponse")
-	proto.RegisterType((*QueryAllInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxRequest")
-	proto.RegisterType((*QueryAllInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxResponse")
-	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "zetachain.zetacore.crosschain.QueryGetTssAddressRequest")
-	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "zetachain.zetacore.crosschain.QueryGetTssAddressResponse")
-	proto.RegisterType((*QueryGetTSSRequest)(nil), "zetachain.zetacore.crosschain.QueryGetTSSRequest")
-	proto.RegisterType((*QueryGetTSSResponse)(nil), "zetachain.zetacore.crosschain.QueryGetTSSResponse")
-	proto.RegisterType((*QueryGetGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceRequest")
-	proto.RegisterType((*QueryGetGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceResponse")
-	proto.RegisterType((*QueryAllGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceRequest")
-	proto.RegisterType((*QueryAllGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceResponse")
-	proto.RegisterType((*QueryGetChainNoncesRequest)(nil), "zetachain.zetacore.crosschain.QueryGetChainNoncesRequest")
-	proto.RegisterType((*QueryGetChainNoncesResponse)(nil), "zetachain.zetacore.crosschain.QueryGetChainNoncesResponse")
-	proto.RegisterType((*QueryAllChainNoncesRequest)(nil), "zetachain.zetacore.crosschain.QueryAllChainNoncesRequest")
-	proto.RegisterType((*QueryAllChainNoncesResponse)(nil), "zetachain.zetacore.crosschain.QueryAllChainNoncesResponse")
-	proto.RegisterType((*QueryAllPendingNoncesRequest)(nil), "zetachain.zetacore.crosschain.QueryAllPendingNoncesRequest")
-	proto.RegisterType((*QueryAllPendingNoncesResponse)(nil), "zetachain.zetacore.crosschain.QueryAllPendingNoncesResponse")
-	proto.RegisterType((*QueryPendingNoncesByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryPendingNoncesByChainRequest")
-	proto.RegisterType((*QueryPendingNoncesByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryPendingNoncesByChainResponse")
-	proto.RegisterType((*QueryGetLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightRequest")
-	proto.RegisterType((*QueryGetLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightResponse")
-	proto.RegisterType((*QueryAllLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightRequest")
-	proto.RegisterType((*QueryAllLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightResponse")
-	proto.RegisterType((*QueryGetCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxRequest")
-	proto.RegisterType((*QueryGetCctxByNonceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxByNonceRequest")
-	proto.RegisterType((*QueryGetCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetCctxResponse")
-	proto.RegisterType((*QueryAllCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxRequest")
-	proto.RegisterType((*QueryAllCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxResponse")
-	proto.RegisterType((*QueryAllCctxPendingRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxPendingRequest")
-	proto.RegisterType((*QueryAllCctxPendingResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxPendingResponse")
-	proto.RegisterType((*QueryLastZetaHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightRequest")
-	proto.RegisterType((*QueryLastZetaHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightResponse")
-	proto.RegisterType((*QueryConvertGasToZetaRequest)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaRequest")
-	proto.RegisterType((*QueryConvertGasToZetaResponse)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaResponse")
-	proto.RegisterType((*QueryMessagePassingProtocolFeeRequest)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeRequest")
-	proto.RegisterType((*QueryMessagePassingProtocolFeeResponse)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeResponse")
-	proto.RegisterType((*QueryZEVMGetTransactionReceiptRequest)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetTransactionReceiptRequest")
-	proto.RegisterType((*QueryZEVMGetTransactionReceiptResponse)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetTransactionReceiptResponse")
-	proto.RegisterType((*Log)(nil), "zetachain.zetacore.crosschain.Log")
-	proto.RegisterType((*QueryZEVMGetTransactionRequest)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetTransactionRequest")
-	proto.RegisterType((*QueryZEVMGetTransactionResponse)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetTransactionResponse")
-	proto.RegisterType((*QueryZEVMGetBlockByNumberRequest)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetBlockByNumberRequest")
-	proto.RegisterType((*QueryZEVMGetBlockByNumberResponse)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetBlockByNumberResponse")
+func (m *QueryZEVMGetBlockByNumberRequest) Reset()         { *m = QueryZEVMGetBlockByNumberRequest{} }
+func (m *QueryZEVMGetBlockByNumberRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryZEVMGetBlockByNumberRequest) ProtoMessage()    {}
+func (*QueryZEVMGetBlockByNumberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{69}
 }
-
-func init() { proto.RegisterFile("crosschain/query.proto", fileDescriptor_65a992045e92a606) }
-
-var fileDescriptor_65a992045e92a606 = []byte{
-	// 3016 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdd, 0x6f, 0x1b, 0xc7,
-	0x11, 0xf7, 0x89, 0xfa, 0x5c, 0x7d, 0x7a, 0xad, 0x38, 0x0c, 0x63, 0x8b, 0xce, 0x39, 0xfe, 0x88,
-	0x3f, 0xc8, 0x58, 0xb1, 0x95, 0xc4, 0x76, 0xd2, 0x48, 0x76, 0xac, 0x18, 0x51, 0x12, 0xf5, 0xa4,
-	0xf4, 0xc3, 0x45, 0x4b, 0x9c, 0x8e, 0x6b, 0xea, 0x90, 0x23, 0x8f, 0xb9, 0x5d, 0x0a, 0x52, 0x0c,
-	0xb5, 0x40, 0xfe, 0x82, 0x00, 0x05, 0xda, 0x97, 0xbe, 0xf6, 0xe3, 0xa1, 0x0f, 0x01, 0x1a, 0x34,
-	0x05, 0x0a, 0xa4, 0x0f, 0x6d, 0xd3, 0x3c, 0x06, 0x2d, 0x50, 0xb4, 0x28, 0x40, 0x14, 0x49, 0x9f,
-	0xf8, 0x1f, 0x14, 0xe8, 0x43, 0xb1, 0x73, 0x73, 0xbc, 0x3d, 0xf2, 0x4e, 0x3c, 0x51, 0x6c, 0xd1,
-	0xbe, 0x88, 0xbb, 0xb3, 0x3b, 0xb3, 0xbf, 0x99, 0x9d, 0xd9, 0x9d, 0xbd, 0x11, 0x39, 0x69, 0x79,
-	0x2e, 0xe7, 0xd6, 0xb6, 0x69, 0xd7, 0x8a, 0xef, 0x36, 0x98, 0xb7, 0x57, 0xa8, 0x7b, 0xae, 0x70,
-	0xe9, 0xe9, 0xf7, 0x98, 0x30, 0x81, 0x5c, 0x80, 0x96, 0xeb, 0xb1, 0x42, 0x38, 0x35, 0x77, 0xc9,
-	0x72, 0x79, 0xd5, 0xe5, 0xc5, 0x2d, 0x93, 0x33, 0x9f, 0xaf, 0xb8, 0x73, 0x6d, 0x8b, 0x09, 0xf3,
-	0x5a, 0xb1, 0x6e, 0x56, 0xec, 0x9a, 0x29, 0x6c, 0xb7, 0xe6, 0x8b, 0xca, 0x9d, 0x56, 0x96, 0x80,
-	0xbf, 0xa5, 0x9a, 0x5b, 0xb3, 0x18, 0xc7, 0xe1, 0xbc, 0x3a, 0x2c, 0x9b, 0x25, 0x7f, 0x92, 0xd8,
-	0xc5, 0x09, 0x39, 0x65, 0x42, 0xc5, 0xe4, 0xa5, 0xba, 0x67, 0x5b, 0x0c, 0xc7, 0xce, 0x2a, 0x63,
-	0xc0, 0x53, 0xda, 0x36, 0xf9, 0x76, 0x49, 0xb8, 0x25, 0xcb, 0x6a, 0x0b, 0xd0, 0x95, 0x49, 0x8e,
-	0xc9, 0x45, 0x69, 0xcb, 0x71, 0xad, 0x77, 0x4a, 0xdb, 0xcc, 0xae, 0x6c, 0x0b, 0x9c, 0xb3, 0xa0,
-	0xcc, 0x01, 0x78, 0x1d, 0x32, 0x54, 0x94, 0x6e, 0x43, 0xc8, 0x95, 0x84, 0x67, 0x5a, 0xef, 0x30,
-	0x0f, 0x27, 0x3c, 0xae, 0x4c, 0xa8, 0x9b, 0x9e, 0x59, 0x0d, 0xf4, 0x9b, 0x57, 0x06, 0x04, 0x6f,
-	0x53, 0x2b, 0x6e, 0xc5, 0x85, 0x66, 0x51, 0xb6, 0x90, 0x7a, 0xaa, 0xe2, 0xba, 0x15, 0x87, 0x15,
-	0xcd, 0xba, 0x5d, 0x34, 0x6b, 0x35, 0x57, 0x80, 0x1d, 0x91, 0x47, 0xcf, 0x92, 0x93, 0x5f, 0x95,
-	0xa6, 0xde, 0xe4, 0xfc, 0x35, 0x9b, 0x0b, 0xd7, 0xdb, 0x33, 0xd8, 0xbb, 0x0d, 0xc6, 0x85, 0xfe,
-	0x1d, 0xf2, 0x78, 0xd7, 0x08, 0xaf, 0xbb, 0x35, 0xce, 0xe8, 0x1d, 0x32, 0x2e, 0x38, 0x2f, 0x39,
-	0x36, 0x17, 0x59, 0xed, 0x4c, 0xe6, 0xe2, 0xe4, 0xa2, 0x5e, 0x38, 0x70, 0x6f, 0x0b, 0x9b, 0x1b,
-	0x1b, 0x2b, 0xc3, 0x9f, 0x35, 0xf3, 0xc7, 0x8c, 0x31, 0xc1, 0xf9, 0x9a, 0xcd, 0x85, 0x3e, 0x4f,
-	0x28, 0xc8, 0x5f, 0x07, 0xc5, 0x82, 0x55, 0x1f, 0x90, 0x13, 0x11, 0x6a, 0x7b, 0xc5, 0x51, 0xdf,
-	0x00, 0x59, 0xed, 0x8c, 0x76, 0x71, 0x72, 0xf1, 0x5c, 0x8f, 0xf5, 0x7c, 0x76, 0x5c, 0x12, 0x59,
-	0xf5, 0x37, 0xc8, 0x93, 0x20, 0x7b, 0x95, 0x89, 0xb7, 0x1a, 0x62, 0x73, 0x77, 0xd3, 0x37, 0x36,
-	0x2e, 0x4d, 0xb3, 0x64, 0x0c, 0x98, 0xef, 0xdf, 0x85, 0x45, 0x32, 0x46, 0xd0, 0xa5, 0xf3, 0x64,
-	0x04, 0xf6, 0x2f, 0x3b, 0x74, 0x46, 0xbb, 0x38, 0x6c, 0xf8, 0x1d, 0xbd, 0x41, 0x4e, 0xc5, 0x8b,
-	0x43, 0xcc, 0x6f, 0x93, 0x29, 0x57, 0xa1, 0x23, 0xf2, 0xcb, 0x3d, 0x90, 0xab, 0xa2, 0x10, 0x7f,
-	0x44, 0x8c, 0xce, 0x50, 0x8b, 0x65, 0xc7, 0x89, 0xd3, 0xe2, 0x1e, 0x21, 0x61, 0xb4, 0xe0, 0x9a,
-	0xe7, 0x0b, 0x7e, 0x68, 0x15, 0x64, 0x68, 0x15, 0xfc, 0x90, 0xc4, 0xd0, 0x2a, 0xac, 0x9b, 0x15,
-	0x86, 0xbc, 0x86, 0xc2, 0xa9, 0x7f, 0xa2, 0xa1, 0x7a, 0x5d, 0xeb, 0x24, 0xaa, 0x97, 0x19, 0x80,
-	0x7a, 0x74, 0x35, 0x82, 0x7f, 0x08, 0xf0, 0x5f, 0xe8, 0x89, 0xdf, 0xc7, 0x14, 0x51, 0xe0, 0x7d,
-	0x8d, 0xe8, 0x71, 0x0a, 0xac, 0xec, 0xdd, 0x91, 0x48, 0x02, 0x7b, 0xcd, 0x93, 0x11, 0x40, 0x86,
-	0x7b, 0xee, 0x77, 0x3a, 0xac, 0x38, 0xd4, 0xb7, 0x15, 0x7f, 0xaf, 0x91, 0xb3, 0x07, 0x82, 0xf8,
-	0x3f, 0x31, 0xe6, 0x2d, 0x72, 0x3a, 0xf0, 0xf5, 0xfb, 0xb5, 0xcd, 0xdd, 0xd7, 0x4c, 0xbe, 0xbd,
-	0xe9, 0xde, 0xb1, 0xc4, 0x6e, 0x60, 0xc6, 0x1c, 0x19, 0xb7, 0x71, 0x00, 0x2c, 0x39, 0x61, 0xb4,
-	0xfb, 0xfa, 0x3e, 0x59, 0x48, 0x62, 0x46, 0xf5, 0xbf, 0x45, 0x66, 0xec, 0xc8, 0x08, 0x3a, 0xee,
-	0xd5, 0x1e, 0x06, 0x88, 0x8a, 0x43, 0x13, 0x74, 0x88, 0xd2, 0x6f, 0xe3, 0xf2, 0xd1, 0xc9, 0x77,
-	0x4d, 0x61, 0xa6, 0x01, 0xff, 0x1e, 0xc9, 0x27, 0x72, 0x23, 0xfa, 0xaf, 0x93, 0xe9, 0x3b, 0x12,
-	0x13, 0x6c, 0xe9, 0xe6, 0x2e, 0x4f, 0xb9, 0x7b, 0x2a, 0x0f, 0x42, 0x8f, 0xca, 0xd1, 0x2b, 0x68,
-	0xf5, 0x65, 0xc7, 0x89, 0xb7, 0xfa, 0xa0, 0x82, 0xfd, 0x53, 0x0d, 0x6d, 0x14, 0xb3, 0xd2, 0x01,
-	0x5b, 0x94, 0x19, 0xd0, 0x16, 0x0d, 0xce, 0x4f, 0x9f, 0x24, 0x4f, 0x04, 0xae, 0xb6, 0xc9, 0xf9,
-	0x72, 0xb9, 0xec, 0x31, 0xde, 0xbe, 0x5b, 0x5e, 0x21, 0xb9, 0xb8, 0x41, 0x54, 0x70, 0x8e, 0x64,
-	0x98, 0x08, 0xf6, 0x5f, 0x36, 0x25, 0x65, 0x4b, 0x58, 0x00, 0x67, 0xc2, 0x90, 0xcd, 0xf6, 0x9d,
-	0x25, 0x25, 0x6c, 0x6c, 0x04, 0x72, 0x5f, 0xc7, 0x3b, 0x2b, 0xa0, 0xa2, 0xc0, 0xeb, 0x24, 0xb3,
-	0xb9, 0xb1, 0x81, 0xbb, 0x92, 0xe2, 0x82, 0x34, 0xe4, 0x74, 0xbd, 0x88, 0xd7, 0xee, 0x2a, 0x13,
-	0xab, 0x26, 0x5f, 0x97, 0x79, 0x89, 0x72, 0x54, 0xd9, 0xb5, 0x32, 0xdb, 0x45, 0x8c, 0x7e, 0x47,
-	0x2f, 0x91, 0x6c, 0x37, 0x43, 0x78, 0x51, 0x07, 0x34, 0xc4, 0x71, 0xa1, 0x07, 0x8e, 0xb6, 0x88,
-	0x36, 0xa3, 0x6e, 0x22, 0xa2, 0x65, 0xc7, 0xe9, 0x44, 0x34, 0x28, 0xff, 0xfb, 0x99, 0x86, 0x4a,
-	0x44, 0xd6, 0x88, 0x55, 0x22, 0xd3, 0x97, 0x12, 0x83, 0xf3, 0xb0, 0xc5, 0xd0, 0x89, 0x20, 0x4e,
-	0xdf, 0x84, 0xbc, 0xf3, 0xe0, 0x2d, 0x7a, 0x27, 0x4c, 0x3c, 0x22, 0x3c, 0xa8, 0xe0, 0x1a, 0x99,
-	0x54, 0xc8, 0x68, 0xc6, 0x4b, 0xbd, 0x4e, 0x0f, 0x45, 0x90, 0xca, 0xae, 0x97, 0x11, 0xe0, 0xb2,
-	0xe3, 0xc4, 0x00, 0x1c, 0xd4, 0x8e, 0x7d, 0xa4, 0x85, 0x69, 0x48, 0x2a, 0x9d, 0x32, 0x47, 0xd0,
-	0x69, 0x70, 0xbb, 0xb7, 0x10, 0x26, 0x35, 0xeb, 0xac, 0x56, 0xb6, 0x6b, 0x95, 0x88, 0x79, 0x74,
-	0x11, 0x9e, 0xb8, 0x1d, 0xe3, 0xa8, 0xd7, 0x06, 0x99, 0xa9, 0xfb, 0x03, 0xf8, 0xe2, 0x40, 0xd5,
-	0xae, 0xf4, 0x4a, 0x48, 0x23, 0xd2, 0xa6, 0xeb, 0x6a, 0x57, 0x7f, 0x89, 0x9c, 0xf1, 0x93, 0x5e,
-	0x95, 0xda, 0x91, 0xa7, 0x3c, 0x41, 0xc6, 0xfd, 0x37, 0x8c, 0x5d, 0x8e, 0xa6, 0xa7, 0x65, 0xfd,
-	0xbb, 0xe4, 0xa9, 0x03, 0xd8, 0x11, 0xf8, 0x37, 0x63, 0x80, 0x6b, 0x87, 0x05, 0x1e, 0x5c, 0x53,
-	0x51, 0xf8, 0x4b, 0xe1, 0xfd, 0xbe, 0x66, 0x72, 0xb1, 0x22, 0x5f, 0x42, 0xaf, 0xc1, 0x43, 0xe8,
-	0xe0, 0xb0, 0x78, 0x84, 0x57, 0x6b, 0x1c, 0x1f, 0xa2, 0xfe, 0x06, 0x99, 0xed, 0x18, 0x42, 0xd8,
-	0x85, 0x1e, 0xb0, 0x3b, 0x05, 0x76, 0x8a, 0xd1, 0xb7, 0xc3, 0x1b, 0x2f, 0x01, 0xf4, 0xa0, 0x42,
-	0xe5, 0x77, 0x1a, 0xea, 0x19, 0xb7, 0xd4, 0x41, 0x7a, 0x66, 0x06, 0xa0, 0xe7, 0xe0, 0x42, 0xe7,
-	0x72, 0x78, 0xcb, 0xa9, 0x29, 0x48, 0xfc, 0xd6, 0xae, 0x29, 0xa7, 0xa4, 0xbc, 0xf6, 0xf7, 0xc0,
-	0x55, 0xfa, 0x7d, 0x69, 0x55, 0xc8, 0x7c, 0x74, 0x69, 0xb4, 0xda, 0x5b, 0x64, 0x4a, 0x4d, 0x98,
-	0x52, 0xbe, 0xb0, 0x54, 0x16, 0x23, 0x22, 0x40, 0xff, 0x36, 0xea, 0x28, 0x0f, 0xb5, 0xff, 0x40,
-	0x9a, 0xf5, 0xa1, 0x86, 0x8a, 0xb4, 0xe5, 0x27, 0x2a, 0x92, 0x39, 0x92, 0x22, 0x83, 0xdb, 0xf5,
-	0xef, 0x29, 0xb7, 0x89, 0x25, 0x76, 0xf1, 0x34, 0xe8, 0x7d, 0x28, 0x0d, 0xec, 0x05, 0xf5, 0xb1,
-	0x7a, 0xd1, 0xa8, 0x08, 0xfe, 0xe7, 0x4d, 0x77, 0x0a, 0x4d, 0x27, 0x23, 0xf2, 0x01, 0x13, 0x66,
-	0xe4, 0x74, 0xd1, 0x6f, 0xa0, 0x5a, 0x9d, 0xa3, 0xa8, 0xd6, 0x49, 0x32, 0xaa, 0x9c, 0x77, 0x19,
-	0x03, 0x7b, 0xfa, 0x26, 0x5e, 0x60, 0x77, 0xdc, 0xda, 0x0e, 0xf3, 0x64, 0xc6, 0xb7, 0xe9, 0x4a,
-	0xf6, 0xae, 0xd0, 0xea, 0xda, 0x90, 0x1c, 0x19, 0xaf, 0x98, 0x7c, 0xcd, 0xae, 0xda, 0x02, 0x53,
-	0xda, 0x76, 0x5f, 0xff, 0xb1, 0x86, 0xf7, 0x5e, 0xb7, 0x58, 0xc4, 0x73, 0x85, 0x1c, 0x77, 0x1b,
-	0x62, 0xcb, 0x6d, 0xd4, 0xca, 0xab, 0x26, 0xbf, 0x5f, 0x93, 0x83, 0x18, 0xf2, 0xdd, 0x03, 0x72,
-	0x36, 0x7c, 0x5e, 0xb2, 0x5c, 0xe7, 0x1e, 0x63, 0x38, 0xdb, 0x5f, 0xb4, 0x7b, 0x80, 0x5e, 0x24,
-	0xb3, 0xf2, 0x57, 0x3d, 0xfc, 0x32, 0x10, 0xfe, 0x9d, 0x64, 0xfd, 0x02, 0x39, 0x07, 0x30, 0xdf,
-	0x60, 0x9c, 0x9b, 0x15, 0xb6, 0x6e, 0x72, 0x6e, 0xd7, 0x2a, 0xeb, 0xa1, 0xc4, 0xc0, 0xba, 0xf7,
-	0xc8, 0xf9, 0x5e, 0x13, 0x51, 0xb1, 0x53, 0x64, 0xe2, 0x61, 0x1b, 0xa2, 0xaf, 0x50, 0x48, 0xd0,
-	0x6f, 0xe1, 0x82, 0x0f, 0x5e, 0xfd, 0xda, 0x1b, 0x32, 0xbd, 0xf7, 0xcc, 0x1a, 0x37, 0x2d, 0xb9,
-	0xbd, 0x06, 0xb3, 0x98, 0x5d, 0x6f, 0x5f, 0x16, 0x94, 0x0c, 0x6f, 0x87, 0xcf, 0x47, 0x68, 0xeb,
-	0xff, 0x1a, 0x46, 0x14, 0x07, 0x70, 0xb7, 0xcd, 0x4b, 0xf0, 0x03, 0x62, 0x5b, 0xc8, 0xca, 0x74,
-	0xab, 0x99, 0x9f, 0x00, 0xaa, 0x7c, 0x29, 0x19, 0x61, 0x93, 0x2e, 0x92, 0x29, 0x7f, 0x76, 0xad,
-	0x51, 0xdd, 0x62, 0x9e, 0x6f, 0xd9, 0x95, 0xd9, 0x56, 0x33, 0x3f, 0x09, 0xf4, 0x37, 0x81, 0x6c,
-	0xa8, 0x1d, 0xfa, 0x32, 0x99, 0xb3, 0xdc, 0x9a, 0xf0, 0x4c, 0x4b, 0x94, 0x4c, 0xff, 0xe9, 0x03,
-	0x56, 0x9e, 0x58, 0x39, 0xd1, 0x6a, 0xe6, 0x67, 0x83, 0xb1, 0xe0, 0x55, 0xd4, 0x49, 0xa0, 0xaf,
-	0x92, 0x13, 0x56, 0xa3, 0xda, 0x70, 0x4c, 0x61, 0xef, 0xb0, 0x52, 0xc5, 0xe4, 0xa5, 0x06, 0x67,
-	0xe5, 0xec, 0x30, 0x88, 0x78, 0xac, 0xd5, 0xcc, 0x1f, 0x0f, 0x87, 0x57, 0x4d, 0xfe, 0x36, 0x67,
-	0x65, 0xa3, 0x9b, 0x44, 0x4f, 0x91, 0xe1, 0x87, 0x9e, 0x5b, 0xcd, 0x8e, 0x00, 0xdf, 0x78, 0xab,
-	0x99, 0x87, 0xbe, 0x01, 0x7f, 0xe9, 0x79, 0xf0, 0x51, 0x5f, 0xf2, 0x28, 0xcc, 0x98, 0x6c, 0x35,
-	0xf3, 0x63, 0x15, 0x94, 0x17, 0x34, 0xa4, 0xb9, 0x1c, 0xb7, 0xc2, 0x4b, 0x5b, 0x8e, 0xeb, 0x56,
-	0xb3, 0x63, 0xa1, 0xb9, 0x24, 0x75, 0x45, 0x12, 0x8d, 0xb0, 0x49, 0x75, 0x32, 0xca, 0x85, 0x29,
-	0x1a, 0x3c, 0x3b, 0x0e, 0x33, 0x49, 0xab, 0x99, 0x47, 0x8a, 0x81, 0xbf, 0xf4, 0x24, 0x19, 0x12,
-	0x6e, 0x76, 0x02, 0xc6, 0x47, 0x5b, 0xcd, 0xfc, 0x90, 0x70, 0x8d, 0x21, 0xe1, 0x4a, 0xb3, 0x89,
-	0x70, 0xdb, 0xfc, 0xed, 0x21, 0xa1, 0xd9, 0x94, 0x31, 0xd8, 0xa4, 0x4e, 0x02, 0x5d, 0x26, 0xc7,
-	0x55, 0x7e, 0xff, 0xaa, 0x9c, 0x04, 0x01, 0xf3, 0xad, 0x66, 0x5e, 0x15, 0x7e, 0x5f, 0x8e, 0x19,
-	0x5d, 0x14, 0xba, 0x44, 0x86, 0xa5, 0x2e, 0xd9, 0xa9, 0x54, 0x5f, 0x5a, 0xd7, 0xdc, 0x8a, 0x01,
-	0xf3, 0xf5, 0xf7, 0x33, 0x24, 0xb3, 0xe6, 0x56, 0xe4, 0x91, 0x10, 0x6c, 0xb8, 0xef, 0x9d, 0x41,
-	0x57, 0x1e, 0x32, 0xc2, 0xad, 0xdb, 0x16, 0xcf, 0x0e, 0x9d, 0xc9, 0x5c, 0x9c, 0x30, 0xb0, 0x27,
-	0x9d, 0xb9, 0x6c, 0x0a, 0xd3, 0xf7, 0x0f, 0x03, 0xda, 0x5d, 0x3e, 0x27, 0x37, 0x7e, 0xb8, 0xb7,
-	0xcf, 0x75, 0x19, 0x6f, 0xe4, 0xa8, 0xc6, 0x1b, 0x85, 0x85, 0xd3, 0x1a, 0x2f, 0x1a, 0x58, 0x63,
-	0x3d, 0x02, 0xeb, 0x19, 0x22, 0xdd, 0x06, 0x17, 0x1a, 0x87, 0x85, 0xa6, 0x5a, 0xcd, 0xfc, 0xb8,
-	0xe3, 0x56, 0xfc, 0x05, 0xda, 0x2d, 0x7a, 0x8e, 0x8c, 0x79, 0xac, 0xea, 0xee, 0xb0, 0x32, 0x78,
-	0xcd, 0xb8, 0xef, 0xa9, 0x48, 0x32, 0x82, 0x86, 0x7e, 0x1d, 0xd3, 0xcc, 0xb8, 0x23, 0x20, 0xf9,
-	0xe4, 0xf8, 0xe7, 0x30, 0xa6, 0x8c, 0x71, 0x6c, 0xff, 0xb5, 0x23, 0x23, 0x88, 0xd5, 0x4c, 0x6c,
-	0xac, 0x3e, 0x41, 0x32, 0x15, 0x93, 0xe3, 0x01, 0x30, 0xd6, 0x6a, 0xe6, 0x65, 0xd7, 0x90, 0x7f,
-	0xa4, 0x19, 0xdb, 0x45, 0x15, 0xdc, 0x70, 0x30, 0x63, 0xa5, 0xfd, 0x2e, 0x0f, 0x5a, 0x72, 0x0d,
-	0xc0, 0x3f, 0x1a, 0xae, 0x21, 0xfb, 0xbe, 0x1d, 0x68, 0x5e, 0x26, 0x97, 0xf5, 0x86, 0xc0, 0x8d,
-	0x9b, 0x68, 0x35, 0xf3, 0x3e, 0xc1, 0xf0, 0x7f, 0xe4, 0x04, 0x3f, 0x5f, 0x1c, 0x0f, 0x27, 0x00,
-	0x01, 0x53, 0xc7, 0xc4, 0xb8, 0x8e, 0x75, 0x2d, 0x72, 0xa8, 0xb8, 0xcc, 0x93, 0x91, 0x1d, 0xd3,
-	0x69, 0x30, 0x0c, 0x67, 0x58, 0x1b, 0x08, 0x86, 0xff, 0x23, 0x75, 0x13, 0x7b, 0x75, 0x96, 0x9d,
-	0x0a, 0x75, 0x93, 0x7d, 0x03, 0xfe, 0xd2, 0x22, 0x99, 0x34, 0x2d, 0x8b, 0x05, 0x75, 0x94, 0x69,
-	0x19, 0x81, 0x2b, 0x33, 0xad, 0x66, 0x9e, 0xf8, 0xe4, 0x35, 0x5b, 0x66, 0x42, 0x61, 0x5b, 0x1e,
-	0x8e, 0xed, 0x64, 0x6b, 0x26, 0x3c, 0x1c, 0xf1, 0x7e, 0x0f, 0x2f, 0xfa, 0x13, 0x44, 0xdb, 0xc9,
-	0xce, 0xc2, 0x84, 0x91, 0x56, 0x33, 0xaf, 0xed, 0x18, 0xda, 0x8e, 0x24, 0x7a, 0xd9, 0xb9, 0x90,
-	0xe8, 0x19, 0x9a, 0x27, 0x89, 0x3c, 0x7b, 0x3c, 0x24, 0x72, 0x43, 0xe3, 0xfa, 0x4d, 0x7c, 0x8c,
-	0xa2, 0xeb, 0xc1, 0xf5, 0xbb, 0xb2, 0x87, 0xfe, 0x81, 0x3e, 0x7b, 0x92, 0x8c, 0x6e, 0x87, 0xd9,
-	0xc9, 0xb0, 0x81, 0x3d, 0xfd, 0xaf, 0x63, 0xf8, 0x14, 0x8d, 0x67, 0x46, 0xcf, 0xd5, 0xc9, 0x28,
-	0x7a, 0xa1, 0x16, 0x9e, 0xc7, 0x3e, 0xc5, 0xc0, 0xdf, 0xb6, 0x5f, 0x0c, 0xc5, 0xfa, 0x45, 0x91,
-	0x4c, 0xd6, 0x4d, 0x8f, 0xd5, 0x84, 0xef, 0xfc, 0xbe, 0x83, 0x82, 0xed, 0x7c, 0x32, 0x78, 0xbf,
-	0xd2, 0x0e, 0xfd, 0x64, 0x38, 0xc1, 0x4f, 0x8a, 0x64, 0x92, 0x6f, 0x9b, 0xcf, 0x95, 0x1a, 0x35,
-	0xcb, 0x61, 0x1c, 0x9d, 0x16, 0x24, 0x4a, 0xf2, 0xdb, 0x40, 0x35, 0x94, 0x76, 0xc7, 0x15, 0x34,
-	0xda, 0xe3, 0x0a, 0x8a, 0xba, 0x1b, 0x2f, 0x79, 0xae, 0x1b, 0x38, 0x75, 0xa7, 0xbb, 0x71, 0xc3,
-	0x75, 0x85, 0xd1, 0x45, 0x91, 0x0b, 0xca, 0xbb, 0x8a, 0xf9, 0xbc, 0xe3, 0xe1, 0x82, 0x40, 0x05,
-	0xa6, 0xb0, 0x49, 0x6f, 0x90, 0x69, 0xcf, 0xcf, 0x31, 0x70, 0x31, 0x3f, 0x04, 0xe6, 0x5a, 0xcd,
-	0xfc, 0x54, 0x30, 0x00, 0x3c, 0x91, 0x9e, 0xb4, 0x53, 0xd5, 0xae, 0x31, 0x0f, 0x43, 0x01, 0xec,
-	0x04, 0x04, 0xc3, 0xff, 0xa1, 0x05, 0x42, 0xca, 0xf6, 0xc3, 0x87, 0xb6, 0xd5, 0x70, 0xc4, 0x1e,
-	0x7a, 0x3e, 0x98, 0x29, 0xa4, 0x1a, 0x4a, 0x1b, 0xae, 0x00, 0x57, 0x98, 0x4e, 0x49, 0xe1, 0x9a,
-	0x52, 0xae, 0x00, 0x39, 0x76, 0x37, 0x64, 0xed, 0x24, 0x48, 0xad, 0xd9, 0xae, 0xf0, 0xcc, 0x12,
-	0x5c, 0x48, 0xd3, 0xa1, 0xd6, 0x40, 0x85, 0xcf, 0xf0, 0x61, 0x53, 0x7a, 0x0d, 0xb7, 0xdf, 0x63,
-	0x18, 0x1e, 0xe0, 0x35, 0xb2, 0x6f, 0xc0, 0xdf, 0xe0, 0x58, 0x72, 0x20, 0x05, 0x9e, 0x8d, 0x1c,
-	0x4b, 0x90, 0x06, 0x87, 0x09, 0x71, 0x24, 0x11, 0x99, 0x3b, 0x20, 0x11, 0xb9, 0x4c, 0x26, 0x84,
-	0x5d, 0x65, 0x5c, 0x98, 0xd5, 0x3a, 0x46, 0x12, 0xa0, 0x6b, 0x13, 0x8d, 0xb0, 0x49, 0xaf, 0x93,
-	0x29, 0x75, 0x57, 0xb3, 0x14, 0x42, 0x1e, 0xb6, 0x24, 0xb2, 0xdb, 0x91, 0x9e, 0x8c, 0x16, 0x74,
-	0xca, 0x13, 0x30, 0x1f, 0xa2, 0xc5, 0xa7, 0x18, 0xf8, 0x4b, 0x6f, 0x92, 0x39, 0xf9, 0x32, 0x29,
-	0x3d, 0x64, 0xac, 0x54, 0x67, 0x9e, 0x4c, 0xcf, 0xb2, 0xf3, 0x80, 0xe6, 0x78, 0xab, 0x99, 0x9f,
-	0x96, 0x63, 0xf7, 0x18, 0x5b, 0x67, 0xde, 0xaa, 0xc9, 0x8d, 0x68, 0x57, 0xaa, 0x5a, 0xb5, 0xfd,
-	0x1a, 0x77, 0xf6, 0xb1, 0x50, 0xd5, 0xaa, 0x0d, 0x1f, 0xe8, 0x8d, 0xa0, 0xb1, 0xf8, 0xe1, 0xd3,
-	0x64, 0x04, 0x62, 0x9b, 0xfe, 0x40, 0x23, 0xa3, 0x7e, 0x81, 0x95, 0x5e, 0xeb, 0x91, 0x8d, 0x74,
-	0x57, 0x78, 0x73, 0x8b, 0x87, 0x61, 0xf1, 0x4f, 0x0c, 0xfd, 0xdc, 0xfb, 0x7f, 0xfa, 0xc7, 0xf7,
-	0x87, 0xf2, 0xf4, 0x74, 0x51, 0x72, 0x5c, 0x55, 0x0a, 0xfb, 0x6a, 0x71, 0x9c, 0x7e, 0xaa, 0x91,
-	0x29, 0xb5, 0x26, 0x46, 0x6f, 0xa6, 0x59, 0x2b, 0xbe, 0x1c, 0x9c, 0xbb, 0xd5, 0x17, 0x2f, 0x02,
-	0x7e, 0x09, 0x00, 0x3f, 0x4f, 0x6f, 0x24, 0x00, 0x56, 0xab, 0x74, 0xc5, 0x47, 0xf8, 0xf5, 0x63,
-	0xbf, 0xf8, 0x08, 0x0e, 0xa3, 0x7d, 0xfa, 0xb1, 0x46, 0x66, 0x55, 0xb9, 0xcb, 0x8e, 0x93, 0x4e,
-	0x97, 0xf8, 0xa2, 0x70, 0x3a, 0x5d, 0x12, 0x0a, 0xbd, 0xfa, 0x65, 0xd0, 0xe5, 0x1c, 0x3d, 0x9b,
-	0x42, 0x17, 0xfa, 0x37, 0x8d, 0x9c, 0xec, 0x40, 0x8e, 0x5f, 0x22, 0xe9, 0x72, 0x1f, 0x20, 0xa2,
-	0x1f, 0x41, 0x73, 0x2b, 0x47, 0x11, 0x81, 0xea, 0xdc, 0x04, 0x75, 0xae, 0xd3, 0xc5, 0x14, 0xea,
-	0x20, 0x2f, 0xee, 0xd0, 0x3e, 0xfd, 0x83, 0x46, 0x66, 0xa2, 0x05, 0x2d, 0x7a, 0x3b, 0xa5, 0x9b,
-	0xc4, 0x16, 0xf0, 0x72, 0x2f, 0xf5, 0xc9, 0x8d, 0xba, 0xbc, 0x00, 0xba, 0x2c, 0xd2, 0x67, 0x13,
-	0x74, 0x89, 0x96, 0xd9, 0x8a, 0x8f, 0x82, 0xfe, 0x3e, 0xfd, 0xb3, 0x46, 0x68, 0x77, 0x49, 0x93,
-	0xa6, 0xc2, 0x93, 0x58, 0x48, 0xcd, 0xbd, 0xdc, 0x2f, 0x3b, 0xea, 0xb3, 0x0c, 0xfa, 0xdc, 0xa2,
-	0x2f, 0x26, 0xea, 0xd3, 0xf9, 0xef, 0x38, 0x70, 0x2f, 0xa8, 0x8a, 0xfd, 0x46, 0x23, 0xc7, 0xa3,
-	0x2b, 0xc8, 0xe0, 0xb9, 0x9d, 0xd2, 0x71, 0x8e, 0xb0, 0x4b, 0x89, 0xa5, 0x53, 0xfd, 0x2a, 0x68,
-	0x75, 0x81, 0x9e, 0x4b, 0xb5, 0x4b, 0xf4, 0x23, 0x8d, 0x4c, 0x47, 0x4a, 0x94, 0xf4, 0x85, 0x94,
-	0x5e, 0xd2, 0x55, 0xf2, 0xcc, 0xbd, 0xd8, 0x07, 0x27, 0xa2, 0x2e, 0x00, 0xea, 0x8b, 0xf4, 0x7c,
-	0x02, 0xea, 0x0a, 0x13, 0x25, 0xc1, 0x79, 0xf0, 0x31, 0x81, 0x7e, 0xa0, 0x41, 0xbd, 0x33, 0xdd,
-	0x95, 0x10, 0x29, 0xa0, 0xa6, 0xbb, 0x12, 0xa2, 0xd5, 0x55, 0x5d, 0x07, 0x78, 0xa7, 0x68, 0x2e,
-	0x01, 0x9e, 0x84, 0xf2, 0x73, 0x2d, 0x2c, 0x1d, 0xd2, 0xa5, 0x94, 0x8b, 0x74, 0xd4, 0x38, 0x73,
-	0xcf, 0x1f, 0x9a, 0x0f, 0x11, 0x16, 0x01, 0xe1, 0x33, 0xf4, 0x42, 0x92, 0x01, 0x91, 0x41, 0x7a,
-	0x6f, 0x99, 0xed, 0xee, 0xd3, 0x9f, 0x6a, 0x64, 0x32, 0x90, 0x22, 0x9d, 0x76, 0x29, 0xa5, 0xdb,
-	0xf5, 0x85, 0x38, 0xa6, 0xd2, 0xaa, 0x5f, 0x00, 0xc4, 0x4f, 0xd1, 0x7c, 0x0f, 0xc4, 0xf4, 0x13,
-	0x8d, 0xcc, 0x75, 0x7e, 0x2a, 0xa4, 0xa9, 0x2e, 0x99, 0x84, 0xef, 0x96, 0xb9, 0xdb, 0xfd, 0x31,
-	0xa7, 0x34, 0xb5, 0xd5, 0x89, 0xf5, 0x53, 0x8d, 0x4c, 0x2a, 0x5f, 0x03, 0xe9, 0xdd, 0x34, 0xcb,
-	0xf7, 0xfa, 0xea, 0x98, 0x7b, 0xf5, 0x88, 0x52, 0x50, 0x9b, 0x4b, 0xa0, 0xcd, 0xd3, 0x54, 0x4f,
-	0xca, 0x76, 0x14, 0xe0, 0xbf, 0xd2, 0x22, 0x85, 0x56, 0x9a, 0x36, 0xe0, 0xbb, 0x4b, 0xc3, 0xb9,
-	0x9b, 0xfd, 0xb0, 0x22, 0xe4, 0x45, 0x80, 0x7c, 0x85, 0x5e, 0x4a, 0xda, 0x80, 0x90, 0xa7, 0xed,
-	0xee, 0xbf, 0xd0, 0xc8, 0x8c, 0x22, 0x4b, 0x7a, 0xfc, 0x8b, 0x29, 0x3d, 0xb7, 0x5f, 0xf4, 0xf1,
-	0xc5, 0xea, 0x9e, 0x06, 0x57, 0xd0, 0xd3, 0x5f, 0x6b, 0x64, 0x2e, 0x52, 0x13, 0x95, 0xb8, 0xd3,
-	0xe6, 0x57, 0x71, 0x35, 0xe7, 0xdc, 0xed, 0xfe, 0x98, 0x11, 0xfb, 0x15, 0xc0, 0x7e, 0x9e, 0x3e,
-	0x9d, 0xe4, 0x2c, 0x2a, 0x17, 0xfd, 0xa3, 0x46, 0xe6, 0xe3, 0xca, 0xc4, 0xf4, 0x2b, 0xa9, 0xb2,
-	0xf2, 0xe4, 0xfa, 0x74, 0xee, 0x95, 0xfe, 0x05, 0xa0, 0x26, 0xcf, 0x83, 0x26, 0xd7, 0x68, 0x31,
-	0x8d, 0x26, 0x98, 0x92, 0x95, 0xec, 0xf2, 0x3e, 0xfd, 0x4c, 0xeb, 0xaa, 0x9e, 0xd2, 0xb4, 0x89,
-	0x55, 0x7c, 0xed, 0x37, 0x5d, 0x22, 0x93, 0x5c, 0xb7, 0xd6, 0x97, 0x40, 0x97, 0x67, 0x69, 0x21,
-	0x41, 0x17, 0x27, 0xca, 0xd7, 0x8e, 0x89, 0xdf, 0x6a, 0x84, 0x76, 0xc8, 0x94, 0xfe, 0x95, 0x36,
-	0x01, 0x39, 0x8a, 0x36, 0xc9, 0xd5, 0xe9, 0x9e, 0xa9, 0x40, 0x87, 0x36, 0xf4, 0x47, 0x1a, 0x19,
-	0x86, 0x54, 0x26, 0xed, 0xc5, 0xae, 0x26, 0x5b, 0xcf, 0x1d, 0x8a, 0x27, 0xe5, 0x1b, 0xc5, 0xc2,
-	0xf4, 0x17, 0x8c, 0xfc, 0x91, 0x3c, 0x33, 0xc3, 0xaa, 0x74, 0xfa, 0x33, 0xb3, 0xab, 0x92, 0xdd,
-	0x1f, 0xd8, 0x1b, 0x00, 0xb6, 0x48, 0xaf, 0x1e, 0x08, 0xb6, 0xeb, 0x51, 0xf8, 0x43, 0x8d, 0x8c,
-	0x05, 0xf9, 0xec, 0x62, 0xda, 0xd3, 0xee, 0xb0, 0x86, 0xed, 0xa8, 0x4c, 0xeb, 0x67, 0x01, 0xeb,
-	0x69, 0xfa, 0xe4, 0x01, 0x58, 0xfd, 0x93, 0xdc, 0x47, 0x86, 0x11, 0x9e, 0xfe, 0x24, 0xef, 0x2a,
-	0x2a, 0xa7, 0x3f, 0xc9, 0xbb, 0xab, 0xc1, 0xbd, 0x4f, 0xf2, 0x90, 0x87, 0xfe, 0x52, 0x23, 0x33,
-	0xd1, 0xea, 0x6b, 0x3a, 0xd4, 0xb1, 0xf5, 0xdc, 0x74, 0xa8, 0xe3, 0x8b, 0xbd, 0x3d, 0x1f, 0x08,
-	0x4e, 0x14, 0xe5, 0x4f, 0x34, 0x42, 0xc2, 0xff, 0xca, 0xa7, 0x37, 0xd2, 0xac, 0xdc, 0xf5, 0xff,
-	0xfd, 0xb9, 0xa5, 0xc3, 0xb2, 0x21, 0xd8, 0x67, 0x00, 0xec, 0x59, 0xfa, 0x54, 0x02, 0x58, 0xd1,
-	0x66, 0x59, 0x79, 0xfd, 0xb3, 0x2f, 0x16, 0xb4, 0xcf, 0xbf, 0x58, 0xd0, 0xfe, 0xfe, 0xc5, 0x82,
-	0xf6, 0xc1, 0x97, 0x0b, 0xc7, 0x3e, 0xff, 0x72, 0xe1, 0xd8, 0x5f, 0xbe, 0x5c, 0x38, 0xf6, 0xe0,
-	0x5a, 0xc5, 0x16, 0xdb, 0x8d, 0xad, 0x82, 0xe5, 0x56, 0x55, 0x31, 0x01, 0x8e, 0xe2, 0x6e, 0x44,
-	0xe2, 0x5e, 0x9d, 0xf1, 0xad, 0x51, 0x48, 0x7b, 0x9e, 0xfb, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe5, 0x77, 0xa5, 0xc6, 0x5e, 0x32, 0x00, 0x00,
+func (m *QueryZEVMGetBlockByNumberRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryZEVMGetBlockByNumberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryZEVMGetBlockByNumberRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryZEVMGetBlockByNumberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZEVMGetBlockByNumberRequest.Merge(m, src)
+}
+func (m *QueryZEVMGetBlockByNumberRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryZEVMGetBlockByNumberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZEVMGetBlockByNumberRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryZEVMGetBlockByNumberRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a OutTxTracker by index.
-	OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error)
-	// Queries a list of OutTxTracker items.
-	OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error)
-	OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error)
-	// Queries a InTxHashToCctx by index.
-	InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error)
-	// Queries a InTxHashToCctx data by index.
-	InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error)
-	// Queries a list of InTxHashToCctx items.
-	InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error)
-	// Queries a list of GetTssAddress items.
-	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
-	// Queries a tSS by index.
-	TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error)
-	// Queries a gasPrice by index.
-	GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error)
-	// Queries a list of gasPrice items.
-	GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error)
-	ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error)
-	ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error)
-	// Queries a chainNonces by index.
-	ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error)
-	// Queries a list of chainNonces items.
-	ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error)
-	PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error)
-	PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error)
-	// Queries a lastBlockHeight by index.
-	LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error)
-	// Queries a list of lastBlockHeight items.
-	LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error)
-	// Queries a send by index.
-	Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
-	// Queries a send by nonce.
-	CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
-	// Queries a list of send items.
-	CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
-	// Queries a list of send items.
-	CctxAllPending(ctx context.Context, in *QueryAllCctxPendingRequest, opts ...grpc.CallOption) (*QueryAllCctxPendingResponse, error)
-	// Queries a list of lastMetaHeight items.
-	LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error)
-	TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error)
+func (m *QueryZEVMGetBlockByNumberRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryZEVMGetBlockByNumberResponse struct {
+	Number           string   `protobuf:"bytes,1,opt,name=number,proto3" json:"number"`
+	Hash             string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash"`
+	ParentHash       string   `protobuf:"bytes,3,opt,name=parent_hash,json=parentHash,proto3" json:"parentHash"`
+	Nonce            string   `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce"`
+	Sha3Uncles       string   `protobuf:"bytes,5,opt,name=sha3_uncles,json=sha3Uncles,proto3" json:"sha3Uncles"`
+	LogsBloom        string   `protobuf:"bytes,6,opt,name=logs_bloom,json=logsBloom,proto3" json:"logsBloom"`
+	TransactionsRoot string   `protobuf:"bytes,7,opt,name=transactions_root,json=transactionsRoot,proto3" json:"transactionsRoot"`
+	StateRoot        string   `protobuf:"bytes,8,opt,name=state_root,json=stateRoot,proto3" json:"stateRoot"`
+	ReceiptsRoot     string   `protobuf:"bytes,9,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receiptsRoot"`
+	Miner            string   `protobuf:"bytes,10,opt,name=miner,proto3" json:"miner"`
+	Difficulty       string   `protobuf:"bytes,11,opt,name=difficulty,proto3" json:"difficulty"`
+	TotalDifficulty  string   `protobuf:"bytes,12,opt,name=total_difficulty,json=totalDifficulty,proto3" json:"totalDifficulty"`
+	ExtraData        string   `protobuf:"bytes,13,opt,name=extra_data,json=extraData,proto3" json:"extraData"`
+	Size_            string   `protobuf:"bytes,14,opt,name=size,proto3" json:"size"`
+	GasLimit         string   `protobuf:"bytes,15,opt,name=gas_limit,json=gasLimit,proto3" json:"gasLimit"`
+	GasUsed          string   `protobuf:"bytes,16,opt,name=gas_used,json=gasUsed,proto3" json:"gasUsed"`
+	Timestamp        string   `protobuf:"bytes,17,opt,name=timestamp,proto3" json:"timestamp"`
+	Transactions     []string `protobuf:"bytes,18,rep,name=transactions,proto3" json:"transactions"`
+	Uncles           []string `protobuf:"bytes,19,rep,name=uncles,proto3" json:"uncles"`
+	BaseFeePerGas    string   `protobuf:"bytes,20,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3" json:"baseFeePerGas"`
+	MixHash          string   `protobuf:"bytes,21,opt,name=mix_hash,json=mixHash,proto3" json:"mixHash"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryZEVMGetBlockByNumberResponse) Reset()         { *m = QueryZEVMGetBlockByNumberResponse{} }
+func (m *QueryZEVMGetBlockByNumberResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryZEVMGetBlockByNumberResponse) ProtoMessage()    {}
+func (*QueryZEVMGetBlockByNumberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{70}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryZEVMGetBlockByNumberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error) {
-	out := new(QueryGetOutTxTrackerResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTracker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryZEVMGetBlockByNumberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryZEVMGetBlockByNumberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryZEVMGetBlockByNumberResponse.Merge(m, src)
+}
+func (m *QueryZEVMGetBlockByNumberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryZEVMGetBlockByNumberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryZEVMGetBlockByNumberResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error) {
-	out := new(QueryAllOutTxTrackerResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryZEVMGetBlockByNumberResponse proto.InternalMessageInfo
+
+func (m *QueryZEVMGetBlockByNumberResponse) GetNumber() string {
+	if m != nil {
+		return m.Number
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error) {
-	out := new(QueryAllOutTxTrackerByChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error) {
-	out := new(QueryGetInTxHashToCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error) {
-	out := new(QueryInTxHashToCctxDataResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetNonce() string {
+	if m != nil {
+		return m.Nonce
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error) {
-	out := new(QueryAllInTxHashToCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetSha3Uncles() string {
+	if m != nil {
+		return m.Sha3Uncles
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
-	out := new(QueryGetTssAddressResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GetTssAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetLogsBloom() string {
+	if m != nil {
+		return m.LogsBloom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error) {
-	out := new(QueryGetTSSResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/TSS", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetTransactionsRoot() string {
+	if m != nil {
+		return m.TransactionsRoot
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error) {
-	out := new(QueryGetGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error) {
-	out := new(QueryAllGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPriceAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetReceiptsRoot() string {
+	if m != nil {
+		return m.ReceiptsRoot
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error) {
-	out := new(QueryConvertGasToZetaResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetMiner() string {
+	if m != nil {
+		return m.Miner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error) {
-	out := new(QueryMessagePassingProtocolFeeResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ProtocolFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetDifficulty() string {
+	if m != nil {
+		return m.Difficulty
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error) {
-	out := new(QueryGetChainNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ChainNonces", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetTotalDifficulty() string {
+	if m != nil {
+		return m.TotalDifficulty
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error) {
-	out := new(QueryAllChainNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ChainNoncesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetExtraData() string {
+	if m != nil {
+		return m.ExtraData
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error) {
-	out := new(QueryAllPendingNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/PendingNoncesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetSize_() string {
+	if m != nil {
+		return m.Size_
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error) {
-	out := new(QueryPendingNoncesByChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/PendingNoncesByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error) {
-	out := new(QueryGetLastBlockHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error) {
-	out := new(QueryAllLastBlockHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
-	out := new(QueryGetCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Cctx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetTransactions() []string {
+	if m != nil {
+		return m.Transactions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
-	out := new(QueryGetCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxByNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetUncles() []string {
+	if m != nil {
+		return m.Uncles
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
-	out := new(QueryAllCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetBaseFeePerGas() string {
+	if m != nil {
+		return m.BaseFeePerGas
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) CctxAllPending(ctx context.Context, in *QueryAllCctxPendingRequest, opts ...grpc.CallOption) (*QueryAllCctxPendingResponse, error) {
-	out := new(QueryAllCctxPendingResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAllPending", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryZEVMGetBlockByNumberResponse) GetMixHash() string {
+	if m != nil {
+		return m.MixHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error) {
-	out := new(QueryLastZetaHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastZetaHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func init() {
+	proto.RegisterType((*QueryTssHistoryRequest)(nil), "zetachain.zetacore.crosschain.QueryTssHistoryRequest")
+	proto.RegisterType((*QueryTssHistoryResponse)(nil), "zetachain.zetacore.crosschain.QueryTssHistoryResponse")
+	proto.RegisterType((*QueryTssMigrationRequest)(nil), "zetachain.zetacore.crosschain.QueryTssMigrationRequest")
+	proto.RegisterType((*QueryTssMigrationResponse)(nil), "zetachain.zetacore.crosschain.QueryTssMigrationResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.crosschain.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.crosschain.QueryParamsResponse")
+	proto.RegisterType((*QueryGetOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerRequest")
+	proto.RegisterType((*QueryGetOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerResponse")
+	proto.RegisterType((*QueryAllOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerRequest")
+	proto.RegisterType((*QueryAllOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerResponse")
+	proto.RegisterType((*QueryAllOutTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainRequest")
+	proto.RegisterType((*QueryAllOutTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainResponse")
+	proto.RegisterType((*QueryGetInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxRequest")
+	proto.RegisterType((*QueryGetInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxResponse")
+	proto.RegisterType((*QueryInTxHashToCctxDataRequest)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataRequest")
+	proto.RegisterType((*QueryInTxHashToCctxDataResponse)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataResponse")
+	proto.RegisterType((*QueryAllInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxRequest")
+	proto.RegisterType((*QueryAllInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxResponse")
+	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "zetachain.zetacore.crosschain.QueryGetTssAddressRequest")
+	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "zetachain.zetacore.crosschain.QueryGetTssAddressResponse")
+	proto.RegisterType((*QueryGetTSSRequest)(nil), "zetachain.zetacore.crosschain.QueryGetTSSRequest")
+	proto.RegisterType((*QueryGetTSSResponse)(nil), "zetachain.zetacore.crosschain.QueryGetTSSResponse")
+	proto.RegisterType((*QueryGetGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceRequest")
+	proto.RegisterType((*QueryGetGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceResponse")
+	proto.RegisterType((*QueryAllGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceRequest")
+	proto.RegisterType((*QueryAllGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceResponse")
+	proto.RegisterType((*QueryGasPriceHistoryRequest)(nil), "zetachain.zetacore.crosschain.QueryGasPriceHistoryRequest")
+	proto.RegisterType((*QueryGasPriceHistoryResponse)(nil), "zetachain.zetacore.crosschain.QueryGasPriceHistoryResponse")
+	proto.RegisterType((*QueryGetChainNoncesRequest)(nil), "zetachain.zetacore.crosschain.QueryGetChainNoncesRequest")
+	proto.RegisterType((*QueryGetChainNoncesResponse)(nil), "zetachain.zetacore.crosschain.QueryGetChainNoncesResponse")
+	proto.RegisterType((*QueryAllChainNoncesRequest)(nil), "zetachain.zetacore.crosschain.QueryAllChainNoncesRequest")
+	proto.RegisterType((*QueryAllChainNoncesResponse)(nil), "zetachain.zetacore.crosschain.QueryAllChainNoncesResponse")
+	proto.RegisterType((*QueryAllPendingNoncesRequest)(nil), "zetachain.zetacore.crosschain.QueryAllPendingNoncesRequest")
+	proto.RegisterType((*QueryAllPendingNoncesResponse)(nil), "zetachain.zetacore.crosschain.QueryAllPendingNoncesResponse")
+	proto.RegisterType((*QueryPendingNoncesByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryPendingNoncesByChainRequest")
+	proto.RegisterType((*QueryPendingNoncesByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryPendingNoncesByChainResponse")
+	proto.RegisterType((*QueryGetLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightRequest")
+	proto.RegisterType((*QueryGetLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightResponse")
+	proto.RegisterType((*QueryAllLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightRequest")
+	proto.RegisterType((*QueryAllLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightResponse")
+	proto.RegisterType((*QueryGetCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxRequest")
+	proto.RegisterType((*QueryCctxStatusTransitionsRequest)(nil), "zetachain.zetacore.crosschain.QueryCctxStatusTransitionsRequest")
+	proto.RegisterType((*QueryCctxStatusTransitionsResponse)(nil), "zetachain.zetacore.crosschain.QueryCctxStatusTransitionsResponse")
+	proto.RegisterType((*QueryGetCctxByNonceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxByNonceRequest")
+	proto.RegisterType((*QueryGetCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetCctxResponse")
+	proto.RegisterType((*QueryAllCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxRequest")
+	proto.RegisterType((*QueryAllCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxResponse")
+	proto.RegisterType((*QueryAllCctxPendingRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxPendingRequest")
+	proto.RegisterType((*QueryAllCctxPendingResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxPendingResponse")
+	proto.RegisterType((*QueryAllCctxPendingReviewRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxPendingReviewRequest")
+	proto.RegisterType((*QueryAllCctxPendingReviewResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxPendingReviewResponse")
+	proto.RegisterType((*QueryAllCctxBySenderRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxBySenderRequest")
+	proto.RegisterType((*QueryAllCctxByReceiverRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxByReceiverRequest")
+	proto.RegisterType((*QueryAllCctxByStatusRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxByStatusRequest")
+	proto.RegisterType((*QueryAllCctxBySenderChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxBySenderChainRequest")
+	proto.RegisterType((*QueryAllCctxByHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxByHeightRequest")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "zetachain.zetacore.crosschain.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "zetachain.zetacore.crosschain.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryLastZetaHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightRequest")
+	proto.RegisterType((*QueryLastZetaHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightResponse")
+	proto.RegisterType((*QueryConvertGasToZetaRequest)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaRequest")
+	proto.RegisterType((*QueryConvertGasToZetaResponse)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaResponse")
+	proto.RegisterType((*QueryMessagePassingProtocolFeeRequest)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeRequest")
+	proto.RegisterType((*QueryMessagePassingProtocolFeeResponse)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeResponse")
+	proto.RegisterType((*QueryZEVMGetTransactionReceiptRequest)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetTransactionReceiptRequest")
+	proto.RegisterType((*QueryZEVMGetTransactionReceiptResponse)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetTransactionReceiptResponse")
+	proto.RegisterType((*Log)(nil), "zetachain.zetacore.crosschain.Log")
+	proto.RegisterType((*QueryZEVMGetTransactionRequest)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetTransactionRequest")
+	proto.RegisterType((*QueryZEVMGetTransactionResponse)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetTransactionResponse")
+	proto.RegisterType((*QueryZEVMGetBlockByNumberRequest)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetBlockByNumberRequest")
+	proto.RegisterType((*QueryZEVMGetBlockByNumberResponse)(nil), "zetachain.zetacore.crosschain.QueryZEVMGetBlockByNumberResponse")
 }
 
-func (c *queryClient) TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error) {
-	out := new(QueryTssHistoryResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/TssHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func init() { proto.RegisterFile("crosschain/query.proto", fileDescriptor_65a992045e92a606) }
+
+var fileDescriptor_65a992045e92a606 = []byte{
+	// 3691 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xdb, 0x6f, 0x1b, 0xc7,
+	0xd5, 0xf7, 0x9a, 0xba, 0x1e, 0xea, 0x3a, 0x56, 0x1c, 0x66, 0x6d, 0x89, 0xf2, 0x3a, 0xbe, 0xdb,
+	0x62, 0x2c, 0xdb, 0x72, 0x2c, 0x3b, 0x17, 0xc9, 0x17, 0xc5, 0xf8, 0xec, 0x44, 0x59, 0x29, 0x5f,
+	0xbe, 0xcf, 0x1f, 0xbe, 0x12, 0x2b, 0x72, 0x44, 0x2d, 0x42, 0xed, 0x32, 0x3b, 0x43, 0x59, 0x8a,
+	0xa1, 0xb6, 0x48, 0xff, 0x81, 0xa0, 0x05, 0xda, 0x97, 0xbe, 0xb6, 0xc9, 0x43, 0xd1, 0x16, 0x68,
+	0xd0, 0x04, 0x4d, 0x91, 0x16, 0x68, 0x93, 0x06, 0xe8, 0x4b, 0xd0, 0x02, 0x45, 0x2f, 0x00, 0x51,
+	0x24, 0x7d, 0xe2, 0x4b, 0x9f, 0x0b, 0xf4, 0xa1, 0x98, 0xd9, 0xd9, 0xdd, 0x59, 0x72, 0x97, 0x5c,
+	0x51, 0x4c, 0xd0, 0xbe, 0x88, 0x33, 0x67, 0xe6, 0x9c, 0xf9, 0x9d, 0x33, 0x67, 0x66, 0xce, 0xcc,
+	0x1e, 0xc1, 0xe1, 0x82, 0x63, 0x13, 0x52, 0xd8, 0x30, 0x4c, 0x2b, 0xf7, 0x7a, 0x15, 0x3b, 0x3b,
+	0x33, 0x15, 0xc7, 0xa6, 0x36, 0x9a, 0x7c, 0x03, 0x53, 0x83, 0x93, 0x67, 0x78, 0xc9, 0x76, 0xf0,
+	0x4c, 0xd0, 0x55, 0x3d, 0x5b, 0xb0, 0xc9, 0xa6, 0x4d, 0x72, 0x6b, 0x06, 0xc1, 0x2e, 0x5f, 0x6e,
+	0xeb, 0xe2, 0x1a, 0xa6, 0xc6, 0xc5, 0x5c, 0xc5, 0x28, 0x99, 0x96, 0x41, 0x4d, 0xdb, 0x72, 0x45,
+	0xa9, 0x93, 0xd2, 0x10, 0xfc, 0x6f, 0xde, 0xb2, 0xad, 0x02, 0x26, 0xa2, 0x39, 0x2b, 0x37, 0xb3,
+	0x62, 0xde, 0xed, 0x44, 0xb7, 0x45, 0x07, 0x55, 0xea, 0x50, 0x32, 0x48, 0xbe, 0xe2, 0x98, 0x05,
+	0x2c, 0xda, 0x8e, 0x4b, 0x6d, 0x9c, 0x27, 0xbf, 0x61, 0x90, 0x8d, 0x3c, 0xb5, 0xf3, 0x85, 0x82,
+	0x2f, 0x40, 0x93, 0x3a, 0x95, 0x0d, 0x42, 0xf3, 0x6b, 0x65, 0xbb, 0xf0, 0x5a, 0x7e, 0x03, 0x9b,
+	0xa5, 0x0d, 0x2a, 0xfa, 0x4c, 0x49, 0x7d, 0x38, 0xbc, 0x06, 0x19, 0x32, 0x4a, 0xbb, 0x4a, 0xd9,
+	0x48, 0xd4, 0x31, 0x0a, 0xaf, 0x61, 0x47, 0x74, 0x78, 0x5c, 0xea, 0x50, 0x31, 0x1c, 0x63, 0xd3,
+	0xd3, 0xef, 0x88, 0xd4, 0xe0, 0x18, 0x14, 0xe7, 0xcb, 0xe6, 0xa6, 0xe9, 0x0d, 0x3b, 0x21, 0x35,
+	0x52, 0xe2, 0xb1, 0x4c, 0x94, 0xec, 0x92, 0xcd, 0x8b, 0x39, 0x56, 0x12, 0xd4, 0xa3, 0x25, 0xdb,
+	0x2e, 0x95, 0x71, 0xce, 0xa8, 0x98, 0x39, 0xc3, 0xb2, 0x6c, 0xca, 0x8d, 0x2c, 0x78, 0xb4, 0x0c,
+	0x1c, 0x7e, 0x99, 0xcd, 0xc3, 0x2a, 0x21, 0x2f, 0x98, 0x84, 0xda, 0xce, 0x8e, 0x8e, 0x5f, 0xaf,
+	0x62, 0x42, 0xb5, 0xaf, 0xc0, 0xe3, 0x4d, 0x2d, 0xa4, 0x62, 0x5b, 0x04, 0xa3, 0x9b, 0x30, 0x40,
+	0x09, 0xc9, 0x97, 0x4d, 0x42, 0x33, 0xca, 0x74, 0xea, 0x74, 0x7a, 0x56, 0x9b, 0x69, 0x39, 0xf1,
+	0x33, 0xab, 0x2b, 0x2b, 0x8b, 0x3d, 0x9f, 0xd4, 0xb2, 0x07, 0xf4, 0x7e, 0x4a, 0xc8, 0x3d, 0x93,
+	0x50, 0x4d, 0x85, 0x8c, 0x27, 0xff, 0xbe, 0x59, 0x72, 0x38, 0x2a, 0x6f, 0xec, 0xbf, 0x2b, 0xf0,
+	0x44, 0x44, 0xa3, 0x18, 0x3e, 0x0b, 0x69, 0xd3, 0xca, 0x57, 0x1c, 0xbb, 0xe4, 0x60, 0x42, 0x32,
+	0xca, 0xb4, 0x72, 0x7a, 0x40, 0x07, 0xd3, 0x5a, 0x16, 0x14, 0x74, 0x17, 0xd2, 0x15, 0x6c, 0x15,
+	0x4d, 0xab, 0x94, 0xa7, 0x84, 0x64, 0x0e, 0x4e, 0x2b, 0x7b, 0x82, 0x08, 0x82, 0x79, 0x95, 0x10,
+	0xb4, 0x0e, 0x88, 0xa9, 0xba, 0x5e, 0xb5, 0x8a, 0xf9, 0x4d, 0x8e, 0xc4, 0x76, 0x48, 0x26, 0xc5,
+	0x95, 0x9e, 0x6d, 0x27, 0x91, 0x90, 0x3b, 0x55, 0xab, 0x78, 0x5f, 0xb0, 0xdd, 0xb5, 0xd6, 0x6d,
+	0x31, 0xc2, 0x18, 0x0d, 0x37, 0x11, 0x6d, 0x02, 0x10, 0x57, 0x78, 0x99, 0xfb, 0x80, 0x67, 0x87,
+	0x07, 0x70, 0x28, 0x44, 0xf5, 0xed, 0xdf, 0xe7, 0xfa, 0x0a, 0xd7, 0x3d, 0x3d, 0x7b, 0xa2, 0x0d,
+	0x10, 0x97, 0x5d, 0x8c, 0x2d, 0x58, 0xb5, 0xfb, 0x70, 0x84, 0xcb, 0x5e, 0xc2, 0xf4, 0xa5, 0x2a,
+	0x5d, 0xdd, 0x5e, 0x75, 0xfd, 0x52, 0x0c, 0x8d, 0x32, 0xd0, 0xcf, 0x99, 0xef, 0xde, 0xe2, 0x83,
+	0xa4, 0x74, 0xaf, 0x8a, 0x26, 0xa0, 0x97, 0xbb, 0x3a, 0xb7, 0x6b, 0x8f, 0xee, 0x56, 0xb4, 0x2a,
+	0x1c, 0x8d, 0x16, 0x27, 0x30, 0xbf, 0x02, 0x43, 0xb6, 0x44, 0x17, 0xc8, 0xcf, 0xb5, 0x41, 0x2e,
+	0x8b, 0x12, 0xf8, 0x43, 0x62, 0x34, 0x2c, 0xb4, 0x58, 0x28, 0x97, 0xa3, 0xb4, 0xb8, 0x03, 0x10,
+	0x6c, 0x2c, 0x62, 0xcc, 0x93, 0x33, 0xee, 0x2e, 0x34, 0xc3, 0x76, 0xa1, 0x19, 0x77, 0xf7, 0x12,
+	0xbb, 0xd0, 0xcc, 0xb2, 0x51, 0xc2, 0x82, 0x57, 0x97, 0x38, 0xb5, 0x0f, 0x15, 0x38, 0x1a, 0x3d,
+	0x4e, 0xac, 0x7a, 0xa9, 0x2e, 0xa8, 0x87, 0x96, 0x42, 0xf8, 0x5d, 0x47, 0x3e, 0xd5, 0x16, 0xbf,
+	0x8b, 0x29, 0xa4, 0xc0, 0x9b, 0x0a, 0x68, 0x51, 0x0a, 0x2c, 0xee, 0xdc, 0x64, 0x48, 0x3c, 0x7b,
+	0x4d, 0x40, 0x2f, 0x47, 0x26, 0xe6, 0xdc, 0xad, 0xa0, 0x3b, 0x11, 0x28, 0x3a, 0xb1, 0xe2, 0x47,
+	0x0a, 0x1c, 0x6f, 0x09, 0xe2, 0x3f, 0xc4, 0x98, 0xd7, 0x61, 0xd2, 0xf3, 0xf5, 0xbb, 0xd6, 0xea,
+	0xf6, 0x0b, 0x06, 0xd9, 0x58, 0xb5, 0x6f, 0x16, 0xe8, 0xb6, 0x67, 0x46, 0x15, 0x06, 0x4c, 0xd1,
+	0xc0, 0x2d, 0x39, 0xa8, 0xfb, 0x75, 0x6d, 0x17, 0xa6, 0xe2, 0x98, 0x85, 0xfa, 0xff, 0x07, 0x23,
+	0x66, 0xa8, 0x45, 0x38, 0xee, 0x85, 0x36, 0x06, 0x08, 0x8b, 0x13, 0x26, 0x68, 0x10, 0xa5, 0xdd,
+	0x10, 0xc3, 0x87, 0x3b, 0xdf, 0x32, 0xa8, 0x91, 0x04, 0xfc, 0x1b, 0x90, 0x8d, 0xe5, 0x16, 0xe8,
+	0x5f, 0x85, 0xe1, 0x9b, 0x0c, 0x13, 0x9f, 0xd2, 0xd5, 0x6d, 0x92, 0x70, 0xf6, 0x64, 0x1e, 0x01,
+	0x3d, 0x2c, 0x47, 0x2b, 0x09, 0xab, 0x2f, 0x94, 0xcb, 0xd1, 0x56, 0xef, 0xd6, 0x62, 0xff, 0x58,
+	0x81, 0xa9, 0xb8, 0x91, 0x5a, 0x4c, 0x51, 0xaa, 0x4b, 0x53, 0xd4, 0x3d, 0x3f, 0x3d, 0x22, 0x4e,
+	0xd1, 0x25, 0x4c, 0x57, 0x09, 0x59, 0x28, 0x16, 0xd9, 0xe9, 0xe8, 0x9d, 0x2d, 0xcf, 0x83, 0x1a,
+	0xd5, 0x28, 0x14, 0x1c, 0x83, 0x14, 0xa6, 0xde, 0xfc, 0xb3, 0x22, 0xa3, 0xac, 0xd1, 0x02, 0x87,
+	0x33, 0xa8, 0xb3, 0xa2, 0x7f, 0x66, 0x31, 0x09, 0x2b, 0x2b, 0x9e, 0xdc, 0xff, 0x82, 0x43, 0x21,
+	0xaa, 0x10, 0x78, 0x19, 0x52, 0xab, 0x2b, 0x2b, 0x19, 0x25, 0xe9, 0x59, 0xac, 0xb3, 0xee, 0x5a,
+	0x4e, 0x04, 0x21, 0x4b, 0x98, 0x2e, 0x19, 0x64, 0xd9, 0x31, 0x0b, 0x58, 0xda, 0xaa, 0x4c, 0xab,
+	0x88, 0xb7, 0x05, 0x46, 0xb7, 0xa2, 0xe5, 0x21, 0xd3, 0xcc, 0x10, 0x84, 0x2d, 0x1e, 0x4d, 0xe0,
+	0x38, 0xd5, 0x06, 0x87, 0x2f, 0xc2, 0x67, 0xd4, 0x0c, 0x81, 0x68, 0xa1, 0x5c, 0x6e, 0x44, 0xd4,
+	0x2d, 0xff, 0x7b, 0x47, 0x81, 0x4c, 0xf3, 0x18, 0x91, 0x4a, 0xa4, 0x3a, 0x52, 0xa2, 0x7b, 0x1e,
+	0xf6, 0x75, 0xc5, 0x8b, 0x22, 0x84, 0xe8, 0x70, 0x10, 0x89, 0x9e, 0x80, 0x01, 0x37, 0x2c, 0x37,
+	0x8b, 0xe1, 0x30, 0xa2, 0xd8, 0xb5, 0x43, 0xe5, 0xe7, 0xde, 0xd1, 0xdc, 0x04, 0x41, 0x58, 0x6c,
+	0x05, 0xfa, 0x37, 0x5c, 0x92, 0x30, 0xd8, 0xa5, 0x84, 0x06, 0x13, 0x82, 0x6e, 0x5b, 0xd4, 0xd9,
+	0xf1, 0xa2, 0x57, 0x21, 0xa9, 0x7b, 0x16, 0x9c, 0x0d, 0x96, 0x21, 0xdf, 0xe9, 0x5e, 0xe4, 0x97,
+	0x9c, 0xd6, 0x4e, 0xfe, 0x1a, 0x1c, 0x89, 0xe4, 0x11, 0x0a, 0xdf, 0x83, 0xb4, 0x44, 0x16, 0x8e,
+	0x78, 0xb6, 0xdd, 0xfe, 0x2b, 0x09, 0x92, 0xd9, 0xb5, 0xa2, 0x00, 0xb8, 0x50, 0x2e, 0x47, 0x00,
+	0xec, 0x96, 0xcf, 0xbf, 0xab, 0xc0, 0x91, 0xc8, 0x61, 0xe2, 0x74, 0x4a, 0xed, 0x43, 0xa7, 0xee,
+	0xcd, 0xde, 0x54, 0x10, 0x16, 0x2e, 0xbb, 0x97, 0x86, 0x90, 0x79, 0x34, 0x0a, 0x93, 0x31, 0xed,
+	0xbe, 0x73, 0x8e, 0x78, 0x57, 0x15, 0x4b, 0x56, 0xed, 0x7c, 0xbb, 0x90, 0x3e, 0x24, 0x6d, 0xb8,
+	0x22, 0x57, 0xb5, 0x67, 0x60, 0xda, 0xbd, 0x36, 0xc8, 0xd4, 0x86, 0x48, 0x2f, 0x7e, 0x65, 0x6a,
+	0x5f, 0x85, 0x63, 0x2d, 0xd8, 0x05, 0xf0, 0xff, 0x8d, 0x00, 0xae, 0xec, 0x15, 0xb8, 0x77, 0xd0,
+	0x87, 0xe1, 0xcf, 0x05, 0x11, 0xd2, 0x3d, 0x83, 0xd0, 0x45, 0x76, 0xed, 0x7e, 0x81, 0xdf, 0xba,
+	0x5b, 0x2f, 0x8b, 0x47, 0x90, 0x8d, 0xe5, 0x13, 0xa8, 0xff, 0x07, 0x46, 0x1b, 0x9a, 0x04, 0xec,
+	0x99, 0x36, 0xb0, 0x1b, 0x05, 0x36, 0x8a, 0xd1, 0x36, 0x82, 0x98, 0x21, 0x06, 0x74, 0xb7, 0x96,
+	0xca, 0xaf, 0x15, 0xc8, 0xc6, 0x0e, 0xd5, 0x4a, 0xcf, 0x54, 0x17, 0xf4, 0xec, 0xde, 0xd2, 0x39,
+	0x17, 0xc4, 0x09, 0x72, 0x10, 0x17, 0x3d, 0xb5, 0xd7, 0x84, 0x4b, 0xb2, 0x9e, 0x2b, 0xd4, 0xa0,
+	0x55, 0xb2, 0xea, 0x18, 0x16, 0x31, 0x99, 0xa4, 0x36, 0x9b, 0xe5, 0x43, 0xd0, 0x5a, 0xb1, 0x0a,
+	0x83, 0xbd, 0x0c, 0x69, 0x1a, 0x90, 0x85, 0xb1, 0x72, 0x6d, 0x8c, 0xd5, 0x28, 0x4e, 0x97, 0x65,
+	0x68, 0xf7, 0xa4, 0x9d, 0x9d, 0x05, 0x7b, 0x3b, 0xdc, 0xbd, 0x3b, 0xbd, 0x5f, 0x97, 0x60, 0x22,
+	0x6c, 0x2e, 0x01, 0xfc, 0x25, 0x18, 0x92, 0xc3, 0xe4, 0x84, 0xf7, 0x6a, 0x99, 0x45, 0x0f, 0x09,
+	0xd0, 0xfe, 0x1f, 0x0e, 0xf9, 0x1b, 0xf1, 0x17, 0x10, 0x5c, 0xff, 0x58, 0x81, 0x89, 0xb0, 0xfc,
+	0x58, 0x45, 0x52, 0xfb, 0x52, 0xa4, 0x7b, 0x9e, 0xfa, 0x35, 0xe9, 0x04, 0x2c, 0xd0, 0x6d, 0xb1,
+	0x83, 0x7d, 0x89, 0x21, 0xce, 0x7b, 0xf2, 0xe1, 0x28, 0x23, 0xf8, 0xb7, 0x37, 0x9d, 0x06, 0xd3,
+	0x91, 0xc0, 0xb7, 0x4c, 0xfc, 0x30, 0x38, 0x23, 0x8f, 0xb5, 0xe8, 0xf3, 0x05, 0xa9, 0xa8, 0xed,
+	0x86, 0x4d, 0xba, 0xb8, 0xb3, 0x82, 0xad, 0x62, 0xf0, 0x70, 0x74, 0x18, 0xfa, 0x08, 0x27, 0x88,
+	0xcd, 0x44, 0xd4, 0xba, 0x36, 0xa5, 0xdf, 0x50, 0x60, 0x32, 0x3c, 0xbe, 0x8e, 0x0b, 0xd8, 0xdc,
+	0x0a, 0x10, 0xa8, 0x30, 0xe0, 0x08, 0x92, 0x77, 0x0d, 0xf7, 0xea, 0x5d, 0x43, 0xf1, 0x8e, 0xd2,
+	0x64, 0x05, 0xbe, 0xa5, 0x79, 0x18, 0x16, 0xa0, 0x8f, 0x70, 0x02, 0x47, 0x30, 0x32, 0x7b, 0xa6,
+	0x9d, 0xbd, 0xfd, 0x3d, 0x56, 0x17, 0x8c, 0x5d, 0x83, 0xfa, 0x4d, 0x05, 0xa6, 0xa3, 0x26, 0x2c,
+	0x14, 0xd4, 0x9c, 0x84, 0x51, 0x77, 0x9e, 0xf2, 0x0d, 0x4b, 0x72, 0x98, 0x04, 0x9d, 0xbb, 0xb8,
+	0x30, 0xdf, 0x6e, 0xb2, 0x5f, 0xf8, 0xc8, 0x3f, 0x06, 0x43, 0x84, 0x1a, 0x0e, 0x15, 0x1f, 0x0d,
+	0x38, 0x98, 0x1e, 0x3d, 0xcd, 0x69, 0xe2, 0x3c, 0x9d, 0x04, 0xc0, 0x56, 0xd1, 0xeb, 0xe0, 0x6e,
+	0xf9, 0x83, 0xd8, 0x2a, 0x8a, 0xe6, 0x30, 0xd2, 0x54, 0xc7, 0x48, 0xbd, 0x77, 0x7e, 0xdd, 0xa0,
+	0xf8, 0x1e, 0xfb, 0x92, 0xe0, 0x87, 0xa8, 0x3f, 0x53, 0xe0, 0xf1, 0xa6, 0x26, 0x7f, 0xd5, 0xa5,
+	0x83, 0x6f, 0x0f, 0xde, 0xa9, 0x78, 0xba, 0x8d, 0x13, 0xf8, 0x72, 0xbc, 0xe7, 0x74, 0xc7, 0x17,
+	0x8c, 0x5e, 0x84, 0xfe, 0x87, 0xa6, 0x55, 0xb4, 0x1f, 0xb2, 0x57, 0xf9, 0x24, 0xf1, 0x88, 0x2f,
+	0xec, 0x55, 0xce, 0xe6, 0x5d, 0xc3, 0x84, 0x10, 0xed, 0xa8, 0xd8, 0x9a, 0x59, 0x94, 0xf2, 0x00,
+	0x53, 0x23, 0x64, 0x7e, 0xed, 0x0a, 0x1c, 0x89, 0x6c, 0x15, 0xda, 0x1d, 0x86, 0x3e, 0x29, 0x06,
+	0x4c, 0xe9, 0xa2, 0xa6, 0xad, 0x8a, 0xa0, 0xfe, 0xa6, 0x6d, 0x6d, 0x61, 0x87, 0xbd, 0x23, 0xac,
+	0xda, 0x8c, 0xbd, 0xe9, 0xe8, 0x6e, 0xda, 0xf0, 0x55, 0x18, 0x28, 0x19, 0x84, 0xe3, 0x15, 0x0f,
+	0x25, 0x7e, 0x5d, 0xfb, 0x9e, 0xb7, 0xe2, 0x9b, 0xc5, 0x0a, 0x3c, 0xe7, 0x61, 0xdc, 0xae, 0xd2,
+	0x35, 0xbb, 0x6a, 0x15, 0x97, 0x0c, 0x72, 0xd7, 0x62, 0x8d, 0x62, 0xe9, 0x37, 0x37, 0xb0, 0xde,
+	0xfc, 0x13, 0x4e, 0xc1, 0x2e, 0xdf, 0xc1, 0x58, 0xf4, 0x76, 0x07, 0x6d, 0x6e, 0x40, 0xa7, 0x61,
+	0x94, 0xfd, 0xca, 0x01, 0x61, 0x8a, 0xfb, 0x5a, 0x23, 0x59, 0x3b, 0x05, 0x27, 0x38, 0xcc, 0xfb,
+	0x98, 0x10, 0xa3, 0x84, 0x97, 0x0d, 0x42, 0x4c, 0xab, 0xb4, 0x1c, 0x48, 0xf4, 0xac, 0x7b, 0x07,
+	0x4e, 0xb6, 0xeb, 0x28, 0x14, 0x3b, 0x0a, 0x83, 0xeb, 0x18, 0x87, 0x14, 0x0a, 0x08, 0xda, 0x75,
+	0x31, 0xe0, 0x83, 0xdb, 0xff, 0x7d, 0x9f, 0x3d, 0x1a, 0x39, 0x86, 0x45, 0x8c, 0x82, 0xfb, 0xc5,
+	0xa7, 0x80, 0xcd, 0x8a, 0xbf, 0x9a, 0x10, 0xf4, 0x6c, 0x04, 0x8f, 0x92, 0xbc, 0xac, 0xfd, 0xb3,
+	0x07, 0x4e, 0xb6, 0xe3, 0xf6, 0xcd, 0x0b, 0xe2, 0x0b, 0x9e, 0x2f, 0x64, 0x71, 0xb8, 0x5e, 0xcb,
+	0x0e, 0x72, 0x2a, 0x7b, 0x7f, 0xd3, 0x83, 0x22, 0x9a, 0x85, 0x21, 0xb7, 0xb7, 0x55, 0xdd, 0x5c,
+	0xc3, 0x8e, 0x6b, 0xd9, 0xc5, 0xd1, 0x7a, 0x2d, 0x9b, 0xe6, 0xf4, 0x17, 0x39, 0x59, 0x97, 0x2b,
+	0xe8, 0x59, 0x18, 0x2b, 0xd8, 0x16, 0x75, 0x8c, 0x02, 0xcd, 0x1b, 0xee, 0x83, 0x1a, 0xb7, 0xf2,
+	0xe0, 0xe2, 0xa1, 0x7a, 0x2d, 0x3b, 0xea, 0xb5, 0x79, 0x6f, 0x6d, 0x8d, 0x04, 0x74, 0x1b, 0x0e,
+	0x15, 0xaa, 0x9b, 0xd5, 0xb2, 0x41, 0xcd, 0x2d, 0x9c, 0x67, 0x1f, 0x2d, 0xab, 0x04, 0x17, 0x33,
+	0x3d, 0x5c, 0xc4, 0x63, 0xf5, 0x5a, 0x76, 0x3c, 0x68, 0x5e, 0x32, 0xc8, 0x2b, 0x04, 0x17, 0xf5,
+	0x66, 0x12, 0x3a, 0x0a, 0x3d, 0xeb, 0x8e, 0xbd, 0x99, 0xe9, 0xe5, 0x7c, 0x03, 0xf5, 0x5a, 0x96,
+	0xd7, 0x75, 0xfe, 0x17, 0x9d, 0x84, 0x01, 0x5f, 0x72, 0x1f, 0xef, 0x91, 0xae, 0xd7, 0xb2, 0xfd,
+	0x25, 0x21, 0xcf, 0x2b, 0x30, 0x73, 0x95, 0xed, 0x12, 0x61, 0x5f, 0x3d, 0xed, 0xcd, 0x4c, 0x7f,
+	0x60, 0x2e, 0x46, 0x5d, 0x64, 0x44, 0x3d, 0x28, 0x22, 0xcd, 0x3f, 0x29, 0x06, 0x78, 0x4f, 0xa8,
+	0xd7, 0xb2, 0x7d, 0x24, 0x7c, 0x14, 0x1c, 0x86, 0x83, 0xd4, 0xce, 0x0c, 0xf2, 0xf6, 0xbe, 0x7a,
+	0x2d, 0x7b, 0x90, 0xda, 0xfa, 0x41, 0x6a, 0x33, 0xb3, 0xd1, 0x60, 0xda, 0xdc, 0xe9, 0x81, 0xc0,
+	0x6c, 0x52, 0x1b, 0x9f, 0xa4, 0x46, 0x02, 0x5a, 0x80, 0x71, 0x99, 0xdf, 0xbd, 0x03, 0xa4, 0xb9,
+	0x80, 0x89, 0x7a, 0x2d, 0x2b, 0x0b, 0xbf, 0xcb, 0xda, 0xf4, 0x26, 0x0a, 0x9a, 0x83, 0x1e, 0xa6,
+	0x4b, 0x66, 0x28, 0xd1, 0xd7, 0xcc, 0x7b, 0x76, 0x49, 0xe7, 0xfd, 0xb5, 0x37, 0x53, 0x90, 0xba,
+	0x67, 0x97, 0xd8, 0x96, 0xe0, 0x4d, 0xb8, 0xeb, 0x9d, 0x5e, 0x95, 0x6d, 0x32, 0xd4, 0xae, 0x98,
+	0x05, 0x77, 0xc3, 0x1b, 0xd4, 0x45, 0x8d, 0x39, 0x73, 0xd1, 0xa0, 0x86, 0xeb, 0x1f, 0x3a, 0x2f,
+	0x37, 0xf9, 0x1c, 0x9b, 0xf8, 0x9e, 0xf6, 0x3e, 0xd7, 0x64, 0xbc, 0xde, 0xfd, 0x1a, 0xaf, 0x8f,
+	0x0f, 0x9c, 0xd4, 0x78, 0xe1, 0x85, 0xd5, 0xdf, 0x66, 0x61, 0x9d, 0x01, 0xe6, 0x36, 0x62, 0xa0,
+	0x01, 0x3e, 0xd0, 0x50, 0xbd, 0x96, 0x1d, 0x28, 0xdb, 0x25, 0x77, 0x00, 0xbf, 0x84, 0x4e, 0x40,
+	0xbf, 0x83, 0x37, 0xed, 0x2d, 0x5c, 0xe4, 0x5e, 0x33, 0xe0, 0x7a, 0xaa, 0x20, 0xe9, 0x5e, 0x41,
+	0xbb, 0x0c, 0x53, 0xb1, 0x5b, 0x40, 0xfc, 0xce, 0xf1, 0x8f, 0x1e, 0xc8, 0xc6, 0xb2, 0x7d, 0x69,
+	0x5b, 0x86, 0xb7, 0x56, 0x53, 0x91, 0x6b, 0xf5, 0x09, 0x48, 0x95, 0x0c, 0x22, 0x36, 0x80, 0xfe,
+	0x7a, 0x2d, 0xcb, 0xaa, 0x3a, 0xfb, 0xc3, 0xcc, 0xe8, 0x67, 0x35, 0x88, 0x09, 0xe7, 0x66, 0x2c,
+	0xf9, 0xaf, 0xbd, 0x5e, 0x89, 0x8d, 0xc1, 0xf1, 0xf7, 0x05, 0x63, 0xb0, 0xba, 0x6b, 0x07, 0x94,
+	0x65, 0xb7, 0xe6, 0x4a, 0x95, 0x8a, 0x89, 0x1b, 0xac, 0xd7, 0xb2, 0x2e, 0x41, 0x77, 0x7f, 0x58,
+	0x07, 0xf7, 0x3e, 0x3a, 0x10, 0x74, 0xe0, 0x04, 0x71, 0x35, 0x8d, 0x5d, 0xd7, 0x91, 0xae, 0x05,
+	0x7b, 0x5a, 0x97, 0x59, 0xe8, 0xdd, 0x32, 0xca, 0x55, 0x9c, 0x49, 0x07, 0x63, 0x73, 0x82, 0xee,
+	0xfe, 0x30, 0xdd, 0xe8, 0x4e, 0x05, 0x67, 0x86, 0x02, 0xdd, 0x58, 0x5d, 0xe7, 0x7f, 0x51, 0x0e,
+	0xd2, 0x46, 0xa1, 0x80, 0xbd, 0x5c, 0x85, 0x61, 0xb6, 0x02, 0x17, 0x47, 0xea, 0xb5, 0x2c, 0xb8,
+	0x64, 0x96, 0x88, 0xa0, 0x4b, 0x65, 0xb6, 0x39, 0xfa, 0x91, 0xe3, 0x48, 0xb0, 0x39, 0x8a, 0xf3,
+	0x3d, 0x38, 0xe8, 0x0f, 0x81, 0xb2, 0x95, 0x19, 0xe5, 0x1d, 0x7a, 0xeb, 0xb5, 0xac, 0xb2, 0xa5,
+	0x2b, 0x5b, 0x8c, 0xe8, 0x64, 0xc6, 0x02, 0xa2, 0xa3, 0x2b, 0x0e, 0x23, 0x92, 0xcc, 0x78, 0x40,
+	0x24, 0xba, 0x42, 0xb4, 0x79, 0x98, 0x96, 0x5d, 0x8f, 0x1f, 0xbf, 0x8b, 0x3b, 0xc2, 0x3f, 0x82,
+	0x1b, 0x48, 0x28, 0x6a, 0x14, 0x35, 0xed, 0x4f, 0xfd, 0x70, 0xac, 0x05, 0xb3, 0xf0, 0x5c, 0x0d,
+	0xfa, 0x84, 0x17, 0x2a, 0xc1, 0x7e, 0xec, 0x52, 0x74, 0xf1, 0xeb, 0xfb, 0xc5, 0xc1, 0x48, 0xbf,
+	0xc8, 0x41, 0xba, 0x62, 0x38, 0xd8, 0xa2, 0xae, 0xf3, 0xbb, 0x0e, 0xca, 0x6d, 0xe7, 0x92, 0xb9,
+	0xf7, 0x4b, 0xe5, 0xc0, 0x4f, 0x7a, 0x62, 0xfc, 0x24, 0x07, 0x69, 0xb2, 0x61, 0x5c, 0xca, 0x57,
+	0xad, 0x42, 0x19, 0x93, 0x4c, 0x6f, 0x20, 0x91, 0x91, 0x5f, 0xe1, 0x54, 0x5d, 0x2a, 0x37, 0x1c,
+	0x41, 0x7d, 0x6d, 0x8e, 0xa0, 0xb0, 0xbb, 0x91, 0xbc, 0x63, 0xdb, 0x9e, 0x53, 0x37, 0xba, 0x1b,
+	0xd1, 0x6d, 0x9b, 0xea, 0x4d, 0x14, 0x36, 0x20, 0xa1, 0x2c, 0xe0, 0xe5, 0xbc, 0x03, 0xc1, 0x80,
+	0x9c, 0xca, 0x99, 0x82, 0x22, 0xba, 0x02, 0xc3, 0x8e, 0x1b, 0x63, 0x88, 0xc1, 0xdc, 0x25, 0x30,
+	0x56, 0xaf, 0x65, 0x87, 0xbc, 0x06, 0xce, 0x13, 0xaa, 0x31, 0x3b, 0x6d, 0x9a, 0x16, 0x76, 0x32,
+	0x10, 0xd8, 0x89, 0x13, 0x74, 0xf7, 0x07, 0xcd, 0x00, 0x14, 0xcd, 0xf5, 0x75, 0xb3, 0x50, 0x2d,
+	0xd3, 0x9d, 0x4c, 0x3a, 0x30, 0x53, 0x40, 0xd5, 0xa5, 0x32, 0x3f, 0x02, 0x6c, 0x6a, 0x94, 0xf3,
+	0x12, 0xd7, 0x90, 0x74, 0x04, 0xb0, 0xb6, 0x5b, 0x01, 0x6b, 0x23, 0x81, 0x69, 0x8d, 0xb7, 0xa9,
+	0x63, 0xe4, 0xf9, 0x81, 0x34, 0x1c, 0x68, 0xcd, 0xa9, 0xfc, 0xe3, 0x6e, 0x50, 0x64, 0x5e, 0x43,
+	0xcc, 0x37, 0x70, 0x66, 0x24, 0xf0, 0x1a, 0x56, 0xd7, 0xf9, 0x5f, 0x6f, 0x5b, 0xe2, 0x17, 0x86,
+	0xcc, 0x68, 0x68, 0x5b, 0xe2, 0x61, 0x70, 0x10, 0x10, 0x87, 0x02, 0x91, 0xb1, 0x16, 0x81, 0xc8,
+	0x39, 0x18, 0xa4, 0xe6, 0x26, 0x26, 0xd4, 0xd8, 0xac, 0x64, 0xc6, 0x03, 0x74, 0x3e, 0x51, 0x0f,
+	0x8a, 0xe8, 0x32, 0x0c, 0xc9, 0xb3, 0x9a, 0x41, 0xd3, 0x29, 0x6f, 0x4a, 0x42, 0xb3, 0x1d, 0xaa,
+	0xb1, 0xd5, 0x22, 0x9c, 0xf2, 0xd0, 0x74, 0xca, 0x5b, 0x2d, 0x2e, 0x45, 0x17, 0xbf, 0x68, 0x1e,
+	0xc6, 0xd8, 0x7d, 0x2b, 0xbf, 0x8e, 0x71, 0xbe, 0x82, 0x1d, 0x16, 0x9e, 0x65, 0x26, 0x38, 0x9a,
+	0xf1, 0x7a, 0x2d, 0x3b, 0xcc, 0xda, 0xee, 0x60, 0xbc, 0x8c, 0x9d, 0x25, 0x83, 0xe8, 0xe1, 0x2a,
+	0x53, 0x75, 0xd3, 0x74, 0x93, 0xcc, 0x32, 0x8f, 0x05, 0xaa, 0x6e, 0x9a, 0xfc, 0xb3, 0xaf, 0xee,
+	0x15, 0x66, 0x3f, 0xb8, 0x04, 0xbd, 0x7c, 0x6d, 0xa3, 0x6f, 0x2b, 0xd0, 0xe7, 0xa6, 0xed, 0xa0,
+	0x8b, 0x6d, 0xa2, 0x91, 0xe6, 0xbc, 0x21, 0x75, 0x76, 0x2f, 0x2c, 0xee, 0x8e, 0xa1, 0x9d, 0x78,
+	0xf3, 0xf7, 0x7f, 0xfb, 0xd6, 0xc1, 0x2c, 0x9a, 0xcc, 0x31, 0x8e, 0x0b, 0x52, 0x66, 0x9d, 0x9c,
+	0x9d, 0x86, 0x3e, 0x56, 0x60, 0x48, 0xce, 0xb4, 0x40, 0xf3, 0x49, 0xc6, 0x8a, 0x4e, 0x32, 0x52,
+	0xaf, 0x77, 0xc4, 0x2b, 0x00, 0x3f, 0xc3, 0x01, 0x5f, 0x45, 0x57, 0x62, 0x00, 0xcb, 0xb9, 0x1f,
+	0xb9, 0x47, 0xe2, 0x75, 0x75, 0x37, 0xf7, 0x88, 0x6f, 0x46, 0xbb, 0xe8, 0x3d, 0x05, 0x46, 0x65,
+	0xb9, 0x0b, 0xe5, 0x72, 0x32, 0x5d, 0xa2, 0x53, 0x8d, 0xd4, 0xeb, 0x1d, 0xf1, 0x0a, 0x5d, 0xce,
+	0x71, 0x5d, 0x4e, 0xa0, 0xe3, 0x09, 0x74, 0x41, 0x7f, 0x51, 0xe0, 0x70, 0x03, 0x72, 0xf1, 0x75,
+	0x06, 0x2d, 0x74, 0x00, 0x22, 0xfc, 0x61, 0x48, 0x5d, 0xdc, 0x8f, 0x08, 0xa1, 0xce, 0x3c, 0x57,
+	0xe7, 0x32, 0x9a, 0x4d, 0xa0, 0x8e, 0xe0, 0x15, 0x33, 0xb4, 0x8b, 0x7e, 0xa3, 0xc0, 0x48, 0x38,
+	0x4d, 0x02, 0xdd, 0x48, 0xe8, 0x26, 0x91, 0x69, 0x21, 0xea, 0x33, 0x1d, 0x72, 0x0b, 0x5d, 0x9e,
+	0xe6, 0xba, 0xcc, 0xa2, 0xa7, 0x62, 0x74, 0x09, 0x27, 0x6f, 0xe4, 0x1e, 0x79, 0xf5, 0x5d, 0xf4,
+	0x07, 0x05, 0x50, 0x73, 0xa2, 0x0c, 0x4a, 0x84, 0x27, 0x36, 0x3d, 0x47, 0x7d, 0xb6, 0x53, 0x76,
+	0xa1, 0xcf, 0x02, 0xd7, 0xe7, 0x3a, 0xba, 0x16, 0xab, 0x4f, 0x63, 0x3e, 0x2c, 0x3f, 0x17, 0x64,
+	0xc5, 0x7e, 0xa1, 0xc0, 0x78, 0x78, 0x04, 0xb6, 0x78, 0x6e, 0x24, 0x74, 0x9c, 0x7d, 0xcc, 0x52,
+	0x6c, 0x42, 0x8e, 0x76, 0x81, 0x6b, 0x75, 0x0a, 0x9d, 0x48, 0x34, 0x4b, 0xe8, 0x5d, 0x05, 0x86,
+	0x43, 0x89, 0x2f, 0xe8, 0xe9, 0x84, 0x5e, 0xd2, 0x94, 0x48, 0xa3, 0x5e, 0xeb, 0x80, 0x53, 0xa0,
+	0x9e, 0xe1, 0xa8, 0x4f, 0xa3, 0x93, 0x31, 0xa8, 0x4b, 0x98, 0xb2, 0x0c, 0x56, 0xef, 0x31, 0x01,
+	0xbd, 0xa5, 0xf0, 0x2c, 0x1a, 0x74, 0x31, 0xe9, 0x90, 0x2b, 0x2b, 0x7b, 0x3a, 0x12, 0xc2, 0x39,
+	0x3b, 0x9a, 0xc6, 0xe1, 0x1d, 0x45, 0x6a, 0x0c, 0x3c, 0x06, 0xe5, 0x07, 0x4a, 0x90, 0x90, 0x82,
+	0xe6, 0x12, 0x0e, 0xd2, 0x90, 0x39, 0xa3, 0x5e, 0xdd, 0x33, 0x9f, 0x40, 0x98, 0xe3, 0x08, 0xcf,
+	0xa0, 0x53, 0x71, 0x06, 0x14, 0x0c, 0xcc, 0x7b, 0x8b, 0x78, 0x7b, 0x17, 0xbd, 0xad, 0x40, 0xda,
+	0x93, 0xc2, 0x9c, 0x76, 0x2e, 0xa1, 0xdb, 0x75, 0x84, 0x38, 0x22, 0x7f, 0x47, 0x3b, 0xc5, 0x11,
+	0x1f, 0x43, 0xd9, 0x36, 0x88, 0xd1, 0x47, 0x0a, 0x8c, 0x36, 0x64, 0xa2, 0x24, 0x3c, 0x6b, 0x23,
+	0x53, 0x71, 0xd4, 0xeb, 0x1d, 0xf1, 0x0a, 0xd4, 0xd7, 0x38, 0xea, 0x4b, 0xe8, 0x62, 0x1b, 0xd4,
+	0x82, 0x4f, 0x6c, 0xe6, 0x79, 0xb3, 0xb8, 0x8b, 0x3e, 0x54, 0x60, 0xac, 0xf1, 0xc9, 0x13, 0x25,
+	0x02, 0x13, 0xf3, 0xfe, 0xaa, 0xde, 0xe8, 0x8c, 0x39, 0xa1, 0xcb, 0x14, 0x1a, 0xb1, 0x7e, 0xac,
+	0x40, 0x5a, 0x7a, 0xd5, 0x44, 0xb7, 0x92, 0x0c, 0xdf, 0xee, 0xf5, 0x54, 0xbd, 0xbd, 0x4f, 0x29,
+	0x42, 0x9b, 0xb3, 0x5c, 0x9b, 0x27, 0x91, 0x16, 0x17, 0xb5, 0x49, 0xc0, 0xdf, 0x57, 0x42, 0x49,
+	0x34, 0x28, 0xe9, 0xc6, 0xd5, 0x9c, 0xf6, 0xa3, 0xce, 0x77, 0xc2, 0x2a, 0x20, 0xcf, 0x72, 0xc8,
+	0xe7, 0xd1, 0xd9, 0xb8, 0x09, 0x08, 0x78, 0xfc, 0x65, 0xfb, 0x13, 0x05, 0x46, 0x24, 0x59, 0x6c,
+	0xe5, 0x5e, 0x4b, 0xb8, 0x02, 0x3b, 0x45, 0x1f, 0x9d, 0x88, 0xd4, 0xd6, 0xe0, 0x12, 0x7a, 0xf4,
+	0x81, 0x02, 0x63, 0xa1, 0x7c, 0x17, 0x86, 0x3b, 0x69, 0x9c, 0x18, 0x95, 0x4f, 0xa4, 0xde, 0xe8,
+	0x8c, 0x59, 0x60, 0x3f, 0xcf, 0xb1, 0x9f, 0x44, 0x4f, 0xc6, 0x39, 0x8b, 0xcc, 0x85, 0x7e, 0xa7,
+	0xc0, 0x44, 0x54, 0x0a, 0x10, 0x7a, 0x2e, 0xd1, 0xed, 0x22, 0x3e, 0xf7, 0x48, 0x7d, 0xbe, 0x73,
+	0x01, 0x42, 0x93, 0xab, 0x5c, 0x93, 0x8b, 0x28, 0x97, 0x44, 0x13, 0x79, 0x37, 0xfa, 0x44, 0x69,
+	0xca, 0x8c, 0x41, 0x49, 0x03, 0xc4, 0xe8, 0xbc, 0x1e, 0xf5, 0xd9, 0x4e, 0xd9, 0x85, 0x2e, 0x73,
+	0x5c, 0x97, 0xa7, 0xd0, 0x4c, 0x8c, 0x2e, 0xe5, 0x30, 0x9f, 0xbf, 0x26, 0x7e, 0xa5, 0x00, 0x6a,
+	0x90, 0xc9, 0xfc, 0x2b, 0x69, 0x20, 0xb5, 0x1f, 0x6d, 0xe2, 0x33, 0x8f, 0xda, 0x86, 0x34, 0x0d,
+	0xda, 0xa0, 0xef, 0x2a, 0xd0, 0xc3, 0x43, 0xb2, 0xa4, 0x01, 0x8a, 0x1c, 0x34, 0x5e, 0xda, 0x13,
+	0x4f, 0xc2, 0xbb, 0x56, 0x41, 0x84, 0xf1, 0xdc, 0xc8, 0x7f, 0x56, 0xe0, 0xb1, 0xc8, 0xcc, 0x21,
+	0x94, 0xc8, 0x89, 0x5b, 0xe5, 0x2b, 0xa9, 0x0b, 0xfb, 0x90, 0x20, 0x74, 0xb9, 0xc1, 0x75, 0x99,
+	0x43, 0x97, 0x5b, 0xe8, 0xd2, 0xc4, 0xed, 0x2b, 0xf7, 0x2e, 0x3b, 0x10, 0x82, 0xd4, 0xa4, 0xe4,
+	0x07, 0x42, 0x53, 0x3a, 0x53, 0x67, 0x33, 0x71, 0x85, 0xa3, 0xcf, 0xa1, 0x0b, 0x2d, 0x67, 0xa2,
+	0xe9, 0xe6, 0xfe, 0x1d, 0x05, 0xfa, 0xbd, 0x4b, 0xc7, 0x6c, 0xd2, 0xad, 0x7c, 0xaf, 0x5e, 0xd3,
+	0x90, 0x9e, 0xa4, 0x1d, 0xe7, 0x58, 0x27, 0xd1, 0x91, 0x16, 0x58, 0xdd, 0x63, 0xca, 0x45, 0x26,
+	0xb6, 0xaf, 0xe4, 0xc7, 0x54, 0x53, 0x66, 0x91, 0x3a, 0xdf, 0x09, 0x6b, 0xd2, 0x63, 0x2a, 0xe0,
+	0x41, 0xbf, 0x55, 0x60, 0x22, 0x8c, 0xda, 0x4d, 0xbe, 0x41, 0xcf, 0x75, 0x02, 0x40, 0x4a, 0xed,
+	0x51, 0x9f, 0xef, 0x5c, 0x80, 0xd0, 0xe3, 0x29, 0xae, 0xc7, 0x59, 0x74, 0xba, 0xbd, 0x1e, 0x02,
+	0xf4, 0xfb, 0x0a, 0x8c, 0x0a, 0x6d, 0xbc, 0x1c, 0x11, 0xb4, 0x17, 0x4b, 0x36, 0x64, 0x02, 0x75,
+	0xe6, 0x2d, 0x97, 0x39, 0xec, 0x19, 0x74, 0xbe, 0x05, 0x6c, 0x6f, 0xa0, 0xdc, 0x23, 0x37, 0x39,
+	0x65, 0x17, 0xfd, 0x52, 0x81, 0x71, 0x1f, 0xba, 0x97, 0x0f, 0x94, 0xf8, 0x5e, 0x1d, 0x99, 0x46,
+	0xd4, 0x19, 0xfc, 0x76, 0x6f, 0x1e, 0x85, 0xd0, 0x50, 0xb9, 0x47, 0x5e, 0x62, 0xd2, 0x6e, 0x83,
+	0xf5, 0xdd, 0xef, 0xbe, 0x7b, 0xb4, 0xbe, 0x9c, 0x81, 0xf4, 0x85, 0x5a, 0x9f, 0x0f, 0x94, 0x7b,
+	0xe4, 0x7e, 0x9d, 0xde, 0x45, 0x9f, 0x06, 0xcb, 0x20, 0x94, 0x5c, 0xb4, 0xa7, 0x65, 0x10, 0x95,
+	0x96, 0xd4, 0x99, 0x12, 0x8b, 0x5c, 0x89, 0x1b, 0x68, 0x3e, 0x81, 0x0b, 0x89, 0x17, 0xb4, 0x86,
+	0xdc, 0xa7, 0x5d, 0xf4, 0x23, 0x79, 0x36, 0xc4, 0x81, 0xbb, 0xb7, 0xd9, 0x08, 0x07, 0x07, 0x1d,
+	0x29, 0x92, 0xe4, 0xbc, 0xf5, 0xd1, 0x7d, 0x5f, 0x01, 0x08, 0x12, 0x91, 0xd0, 0x95, 0x24, 0x03,
+	0x36, 0xe5, 0x34, 0xa9, 0x73, 0x7b, 0x65, 0x13, 0x50, 0xcf, 0x70, 0xa8, 0xc7, 0xd1, 0xb1, 0x18,
+	0xa8, 0x52, 0x26, 0xd3, 0x4f, 0x15, 0x18, 0x09, 0xe7, 0x15, 0x25, 0xdb, 0xea, 0x23, 0x33, 0x95,
+	0xd4, 0xf9, 0x4e, 0x58, 0x13, 0x3e, 0x7d, 0x95, 0xc3, 0x28, 0x99, 0x85, 0x83, 0xff, 0xe9, 0x4e,
+	0x66, 0xe1, 0xa6, 0xff, 0x0e, 0x57, 0xe7, 0xf6, 0xca, 0x96, 0xd0, 0xc2, 0x34, 0x40, 0xf6, 0x43,
+	0x05, 0x86, 0xe4, 0xff, 0xff, 0x46, 0x57, 0x13, 0x8e, 0xd9, 0xf8, 0xef, 0xe4, 0xea, 0xd3, 0x7b,
+	0x67, 0x4c, 0xe8, 0xbb, 0x54, 0x62, 0x5a, 0x5c, 0xfa, 0xe4, 0xb3, 0x29, 0xe5, 0xd3, 0xcf, 0xa6,
+	0x94, 0xbf, 0x7e, 0x36, 0xa5, 0xbc, 0xf5, 0xf9, 0xd4, 0x81, 0x4f, 0x3f, 0x9f, 0x3a, 0xf0, 0xc7,
+	0xcf, 0xa7, 0x0e, 0x3c, 0xb8, 0x50, 0x32, 0xe9, 0x46, 0x75, 0x6d, 0xa6, 0x60, 0x6f, 0xca, 0x82,
+	0x2c, 0xbb, 0x88, 0x73, 0xdb, 0x21, 0x79, 0x3b, 0x15, 0x4c, 0xd6, 0xfa, 0xf8, 0xad, 0xfd, 0xd2,
+	0xbf, 0x06, 0x00, 0xcc, 0xb4, 0x51, 0xde, 0x66, 0x41, 0x00, 0x00,
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
 	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Queries a OutTxTracker by index.
-	OutTxTracker(context.Context, *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error)
+	OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error)
 	// Queries a list of OutTxTracker items.
-	OutTxTrackerAll(context.Context, *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error)
-	OutTxTrackerAllByChain(context.Context, *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error)
+	OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error)
+	OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error)
 	// Queries a InTxHashToCctx by index.
-	InTxHashToCctx(context.Context, *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error)
+	InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error)
 	// Queries a InTxHashToCctx data by index.
-	InTxHashToCctxData(context.Context, *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error)
+	InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error)
 	// Queries a list of InTxHashToCctx items.
-	InTxHashToCctxAll(context.Context, *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error)
+	InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error)
 	// Queries a list of GetTssAddress items.
-	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
+	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
 	// Queries a tSS by index.
-	TSS(context.Context, *QueryGetTSSRequest) (*QueryGetTSSResponse, error)
+	TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error)
 	// Queries a gasPrice by index.
-	GasPrice(context.Context, *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error)
+	GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error)
 	// Queries a list of gasPrice items.
-	GasPriceAll(context.Context, *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error)
-	ConvertGasToZeta(context.Context, *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error)
-	ProtocolFee(context.Context, *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error)
+	GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error)
+	// Queries the history of the gas price of a chain.
+	GasPriceHistory(ctx context.Context, in *QueryGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryGasPriceHistoryResponse, error)
+	ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error)
+	ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error)
 	// Queries a chainNonces by index.
-	ChainNonces(context.Context, *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error)
+	ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error)
 	// Queries a list of chainNonces items.
-	ChainNoncesAll(context.Context, *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error)
-	PendingNoncesAll(context.Context, *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error)
-	PendingNoncesByChain(context.Context, *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error)
+	ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error)
+	PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error)
+	PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error)
 	// Queries a lastBlockHeight by index.
-	LastBlockHeight(context.Context, *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error)
+	LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error)
 	// Queries a list of lastBlockHeight items.
-	LastBlockHeightAll(context.Context, *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error)
+	LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error)
 	// Queries a send by index.
-	Cctx(context.Context, *QueryGetCctxRequest) (*QueryGetCctxResponse, error)
+	Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
+	// Queries the status transitions of a send by index.
+	CctxStatusTransitions(ctx context.Context, in *QueryCctxStatusTransitionsRequest, opts ...grpc.CallOption) (*QueryCctxStatusTransitionsResponse, error)
 	// Queries a send by nonce.
-	CctxByNonce(context.Context, *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error)
+	CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
 	// Queries a list of send items.
-	CctxAll(context.Context, *QueryAllCctxRequest) (*QueryAllCctxResponse, error)
+	CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
 	// Queries a list of send items.
-	CctxAllPending(context.Context, *QueryAllCctxPendingRequest) (*QueryAllCctxPendingResponse, error)
+	CctxAllPending(ctx context.Context, in *QueryAllCctxPendingRequest, opts ...grpc.CallOption) (*QueryAllCctxPendingResponse, error)
+	// Queries the list of cctxs held for review because they exceed a rate limit.
+	CctxAllPendingReview(ctx context.Context, in *QueryAllCctxPendingReviewRequest, opts ...grpc.CallOption) (*QueryAllCctxPendingReviewResponse, error)
+	// Queries the list of cctxs sent by an address, the address is the sender or the origin of the inbound.
+	CctxAllBySender(ctx context.Context, in *QueryAllCctxBySenderRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
+	// Queries the list of cctxs received by an address on the receiver chain.
+	CctxAllByReceiver(ctx context.Context, in *QueryAllCctxByReceiverRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
+	// Queries the list of cctxs with a status.
+	CctxAllByStatus(ctx context.Context, in *QueryAllCctxByStatusRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
+	// Queries the list of cctxs sent from a chain.
+	CctxAllBySenderChain(ctx context.Context, in *QueryAllCctxBySenderChainRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
+	// Queries the list of cctxs created on ZetaChain in a range of blocks.
+	CctxAllByHeight(ctx context.Context, in *QueryAllCctxByHeightRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
+	// Queries the rate limits and their current windows.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
 	// Queries a list of lastMetaHeight items.
-	LastZetaHeight(context.Context, *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error)
-	TssHistory(context.Context, *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+	LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error)
+	TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error)
+	// Queries the migration of the funds of the current TSS to the TSS pending migration
+	TssMigration(ctx context.Context, in *QueryTssMigrationRequest, opts ...grpc.CallOption) (*QueryTssMigrationResponse, error)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) OutTxTracker(ctx context.Context, req *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutTxTracker not implemented")
-}
-func (*UnimplementedQueryServer) OutTxTrackerAll(ctx context.Context, req *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAll not implemented")
-}
-func (*UnimplementedQueryServer) OutTxTrackerAllByChain(ctx context.Context, req *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAllByChain not implemented")
-}
-func (*UnimplementedQueryServer) InTxHashToCctx(ctx context.Context, req *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctx not implemented")
-}
-func (*UnimplementedQueryServer) InTxHashToCctxData(ctx context.Context, req *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxData not implemented")
-}
-func (*UnimplementedQueryServer) InTxHashToCctxAll(ctx context.Context, req *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxAll not implemented")
-}
-func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
-}
-func (*UnimplementedQueryServer) TSS(ctx context.Context, req *QueryGetTSSRequest) (*QueryGetTSSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TSS not implemented")
-}
-func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
-}
-func (*UnimplementedQueryServer) GasPriceAll(ctx context.Context, req *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GasPriceAll not implemented")
-}
-func (*UnimplementedQueryServer) ConvertGasToZeta(ctx context.Context, req *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertGasToZeta not implemented")
-}
-func (*UnimplementedQueryServer) ProtocolFee(ctx context.Context, req *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFee not implemented")
-}
-func (*UnimplementedQueryServer) ChainNonces(ctx context.Context, req *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainNonces not implemented")
-}
-func (*UnimplementedQueryServer) ChainNoncesAll(ctx context.Context, req *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainNoncesAll not implemented")
-}
-func (*UnimplementedQueryServer) PendingNoncesAll(ctx context.Context, req *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesAll not implemented")
-}
-func (*UnimplementedQueryServer) PendingNoncesByChain(ctx context.Context, req *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesByChain not implemented")
-}
-func (*UnimplementedQueryServer) LastBlockHeight(ctx context.Context, req *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeight not implemented")
-}
-func (*UnimplementedQueryServer) LastBlockHeightAll(ctx context.Context, req *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeightAll not implemented")
-}
-func (*UnimplementedQueryServer) Cctx(ctx context.Context, req *QueryGetCctxRequest) (*QueryGetCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cctx not implemented")
-}
-func (*UnimplementedQueryServer) CctxByNonce(ctx context.Context, req *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CctxByNonce not implemented")
-}
-func (*UnimplementedQueryServer) CctxAll(ctx context.Context, req *QueryAllCctxRequest) (*QueryAllCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CctxAll not implemented")
-}
-func (*UnimplementedQueryServer) CctxAllPending(ctx context.Context, req *QueryAllCctxPendingRequest) (*QueryAllCctxPendingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CctxAllPending not implemented")
-}
-func (*UnimplementedQueryServer) LastZetaHeight(ctx context.Context, req *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastZetaHeight not implemented")
-}
-func (*UnimplementedQueryServer) TssHistory(ctx context.Context, req *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TssHistory not implemented")
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_OutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOutTxTrackerRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error) {
+	out := new(QueryGetOutTxTrackerResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTracker", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutTxTracker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTracker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutTxTracker(ctx, req.(*QueryGetOutTxTrackerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_OutTxTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllOutTxTrackerRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error) {
+	out := new(QueryAllOutTxTrackerResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutTxTrackerAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutTxTrackerAll(ctx, req.(*QueryAllOutTxTrackerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_OutTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllOutTxTrackerByChainRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error) {
+	out := new(QueryAllOutTxTrackerByChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, req.(*QueryAllOutTxTrackerByChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_InTxHashToCctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetInTxHashToCctxRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error) {
+	out := new(QueryGetInTxHashToCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctx", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxHashToCctx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxHashToCctx(ctx, req.(*QueryGetInTxHashToCctxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_InTxHashToCctxData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInTxHashToCctxDataRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error) {
+	out := new(QueryInTxHashToCctxDataResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxHashToCctxData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxHashToCctxData(ctx, req.(*QueryInTxHashToCctxDataRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_InTxHashToCctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllInTxHashToCctxRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error) {
+	out := new(QueryAllInTxHashToCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxHashToCctxAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxHashToCctxAll(ctx, req.(*QueryAllInTxHashToCctxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTssAddressRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
+	out := new(QueryGetTssAddressResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GetTssAddress", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTssAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/GetTssAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTSSRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error) {
+	out := new(QueryGetTSSResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/TSS", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TSS(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/TSS",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TSS(ctx, req.(*QueryGetTSSRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetGasPriceRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error) {
+	out := new(QueryGetGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPrice", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GasPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GasPrice(ctx, req.(*QueryGetGasPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GasPriceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGasPriceRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error) {
+	out := new(QueryAllGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPriceAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GasPriceAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPriceAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GasPriceAll(ctx, req.(*QueryAllGasPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ConvertGasToZeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConvertGasToZetaRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GasPriceHistory(ctx context.Context, in *QueryGasPriceHistoryRequest, opts ...grpc.CallOption) (*QueryGasPriceHistoryResponse, error) {
+	out := new(QueryGasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPriceHistory", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ConvertGasToZeta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConvertGasToZeta(ctx, req.(*QueryConvertGasToZetaRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ProtocolFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMessagePassingProtocolFeeRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error) {
+	out := new(QueryConvertGasToZetaResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProtocolFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/ProtocolFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProtocolFee(ctx, req.(*QueryMessagePassingProtocolFeeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainNoncesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error) {
+	out := new(QueryMessagePassingProtocolFeeResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ProtocolFee", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ChainNonces(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/ChainNonces",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ChainNonces(ctx, req.(*QueryGetChainNoncesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ChainNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllChainNoncesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error) {
+	out := new(QueryGetChainNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ChainNonces", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ChainNoncesAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/ChainNoncesAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ChainNoncesAll(ctx, req.(*QueryAllChainNoncesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PendingNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPendingNoncesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error) {
+	out := new(QueryAllChainNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ChainNoncesAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingNoncesAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/PendingNoncesAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingNoncesAll(ctx, req.(*QueryAllPendingNoncesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PendingNoncesByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingNoncesByChainRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error) {
+	out := new(QueryAllPendingNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/PendingNoncesAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingNoncesByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/PendingNoncesByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingNoncesByChain(ctx, req.(*QueryPendingNoncesByChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_LastBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetLastBlockHeightRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error) {
+	out := new(QueryPendingNoncesByChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/PendingNoncesByChain", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastBlockHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastBlockHeight(ctx, req.(*QueryGetLastBlockHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_LastBlockHeightAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllLastBlockHeightRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error) {
+	out := new(QueryGetLastBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeight", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastBlockHeightAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastBlockHeightAll(ctx, req.(*QueryAllLastBlockHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Cctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCctxRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error) {
+	out := new(QueryAllLastBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Cctx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/Cctx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Cctx(ctx, req.(*QueryGetCctxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CctxByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCctxByNonceRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
+	out := new(QueryGetCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Cctx", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CctxByNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxByNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CctxByNonce(ctx, req.(*QueryGetCctxByNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllCctxRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) CctxStatusTransitions(ctx context.Context, in *QueryCctxStatusTransitionsRequest, opts ...grpc.CallOption) (*QueryCctxStatusTransitionsResponse, error) {
+	out := new(QueryCctxStatusTransitionsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxStatusTransitions", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CctxAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CctxAll(ctx, req.(*QueryAllCctxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CctxAllPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllCctxPendingRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
+	out := new(QueryGetCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxByNonce", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CctxAllPending(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAllPending",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CctxAllPending(ctx, req.(*QueryAllCctxPendingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_LastZetaHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastZetaHeightRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
+	out := new(QueryAllCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastZetaHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/LastZetaHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastZetaHeight(ctx, req.(*QueryLastZetaHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TssHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTssHistoryRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) CctxAllPending(ctx context.Context, in *QueryAllCctxPendingRequest, opts ...grpc.CallOption) (*QueryAllCctxPendingResponse, error) {
+	out := new(QueryAllCctxPendingResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAllPending", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TssHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/TssHistory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TssHistory(ctx, req.(*QueryTssHistoryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.crosschain.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "OutTxTracker",
-			Handler:    _Query_OutTxTracker_Handler,
-		},
-		{
-			MethodName: "OutTxTrackerAll",
-			Handler:    _Query_OutTxTrackerAll_Handler,
-		},
-		{
-			MethodName: "OutTxTrackerAllByChain",
-			Handler:    _Query_OutTxTrackerAllByChain_Handler,
-		},
-		{
-			MethodName: "InTxHashToCctx",
-			Handler:    _Query_InTxHashToCctx_Handler,
-		},
-		{
-			MethodName: "InTxHashToCctxData",
-			Handler:    _Query_InTxHashToCctxData_Handler,
-		},
-		{
-			MethodName: "InTxHashToCctxAll",
-			Handler:    _Query_InTxHashToCctxAll_Handler,
-		},
-		{
-			MethodName: "GetTssAddress",
-			Handler:    _Query_GetTssAddress_Handler,
-		},
-		{
-			MethodName: "TSS",
-			Handler:    _Query_TSS_Handler,
-		},
-		{
-			MethodName: "GasPrice",
-			Handler:    _Query_GasPrice_Handler,
-		},
-		{
-			MethodName: "GasPriceAll",
-			Handler:    _Query_GasPriceAll_Handler,
-		},
-		{
-			MethodName: "ConvertGasToZeta",
-			Handler:    _Query_ConvertGasToZeta_Handler,
-		},
-		{
-			MethodName: "ProtocolFee",
-			Handler:    _Query_ProtocolFee_Handler,
-		},
-		{
-			MethodName: "ChainNonces",
-			Handler:    _Query_ChainNonces_Handler,
-		},
-		{
-			MethodName: "ChainNoncesAll",
-			Handler:    _Query_ChainNoncesAll_Handler,
-		},
-		{
-			MethodName: "PendingNoncesAll",
-			Handler:    _Query_PendingNoncesAll_Handler,
-		},
-		{
-			MethodName: "PendingNoncesByChain",
-			Handler:    _Query_PendingNoncesByChain_Handler,
-		},
-		{
-			MethodName: "LastBlockHeight",
-			Handler:    _Query_LastBlockHeight_Handler,
-		},
-		{
-			MethodName: "LastBlockHeightAll",
-			Handler:    _Query_LastBlockHeightAll_Handler,
-		},
-		{
-			MethodName: "Cctx",
-			Handler:    _Query_Cctx_Handler,
-		},
-		{
-			MethodName: "CctxByNonce",
-			Handler:    _Query_CctxByNonce_Handler,
-		},
-		{
-			MethodName: "CctxAll",
-			Handler:    _Query_CctxAll_Handler,
-		},
-		{
-			MethodName: "CctxAllPending",
-			Handler:    _Query_CctxAllPending_Handler,
-		},
-		{
-			MethodName: "LastZetaHeight",
-			Handler:    _Query_LastZetaHeight_Handler,
-		},
-		{
-			MethodName: "TssHistory",
-			Handler:    _Query_TssHistory_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "crosschain/query.proto",
+func (c *queryClient) CctxAllPendingReview(ctx context.Context, in *QueryAllCctxPendingReviewRequest, opts ...grpc.CallOption) (*QueryAllCctxPendingReviewResponse, error) {
+	out := new(QueryAllCctxPendingReviewResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAllPendingReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CctxAllBySender(ctx context.Context, in *QueryAllCctxBySenderRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
+	out := new(QueryAllCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAllBySender", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CctxAllByReceiver(ctx context.Context, in *QueryAllCctxByReceiverRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
+	out := new(QueryAllCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAllByReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) CctxAllByStatus(ctx context.Context, in *QueryAllCctxByStatusRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
+	out := new(QueryAllCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAllByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CctxAllBySenderChain(ctx context.Context, in *QueryAllCctxBySenderChainRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
+	out := new(QueryAllCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAllBySenderChain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CctxAllByHeight(ctx context.Context, in *QueryAllCctxByHeightRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
+	out := new(QueryAllCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAllByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TssList) > 0 {
-		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error) {
+	out := new(QueryLastZetaHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastZetaHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error) {
+	out := new(QueryTssHistoryResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/TssHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TssMigration(ctx context.Context, in *QueryTssMigrationRequest, opts ...grpc.CallOption) (*QueryTssMigrationResponse, error) {
+	out := new(QueryTssMigrationResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/TssMigration", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a OutTxTracker by index.
+	OutTxTracker(context.Context, *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error)
+	// Queries a list of OutTxTracker items.
+	OutTxTrackerAll(context.Context, *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error)
+	OutTxTrackerAllByChain(context.Context, *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error)
+	// Queries a InTxHashToCctx by index.
+	InTxHashToCctx(context.Context, *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error)
+	// Queries a InTxHashToCctx data by index.
+	InTxHashToCctxData(context.Context, *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error)
+	// Queries a list of InTxHashToCctx items.
+	InTxHashToCctxAll(context.Context, *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error)
+	// Queries a list of GetTssAddress items.
+	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
+	// Queries a tSS by index.
+	TSS(context.Context, *QueryGetTSSRequest) (*QueryGetTSSResponse, error)
+	// Queries a gasPrice by index.
+	GasPrice(context.Context, *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error)
+	// Queries a list of gasPrice items.
+	GasPriceAll(context.Context, *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error)
+	// Queries the history of the gas price of a chain.
+	GasPriceHistory(context.Context, *QueryGasPriceHistoryRequest) (*QueryGasPriceHistoryResponse, error)
+	ConvertGasToZeta(context.Context, *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error)
+	ProtocolFee(context.Context, *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error)
+	// Queries a chainNonces by index.
+	ChainNonces(context.Context, *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error)
+	// Queries a list of chainNonces items.
+	ChainNoncesAll(context.Context, *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error)
+	PendingNoncesAll(context.Context, *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error)
+	PendingNoncesByChain(context.Context, *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error)
+	// Queries a lastBlockHeight by index.
+	LastBlockHeight(context.Context, *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error)
+	// Queries a list of lastBlockHeight items.
+	LastBlockHeightAll(context.Context, *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error)
+	// Queries a send by index.
+	Cctx(context.Context, *QueryGetCctxRequest) (*QueryGetCctxResponse, error)
+	// Queries the status transitions of a send by index.
+	CctxStatusTransitions(context.Context, *QueryCctxStatusTransitionsRequest) (*QueryCctxStatusTransitionsResponse, error)
+	// Queries a send by nonce.
+	CctxByNonce(context.Context, *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error)
+	// Queries a list of send items.
+	CctxAll(context.Context, *QueryAllCctxRequest) (*QueryAllCctxResponse, error)
+	// Queries a list of send items.
+	CctxAllPending(context.Context, *QueryAllCctxPendingRequest) (*QueryAllCctxPendingResponse, error)
+	// Queries the list of cctxs held for review because they exceed a rate limit.
+	CctxAllPendingReview(context.Context, *QueryAllCctxPendingReviewRequest) (*QueryAllCctxPendingReviewResponse, error)
+	// Queries the list of cctxs sent by an address, the address is the sender or the origin of the inbound.
+	CctxAllBySender(context.Context, *QueryAllCctxBySenderRequest) (*QueryAllCctxResponse, error)
+	// Queries the list of cctxs received by an address on the receiver chain.
+	CctxAllByReceiver(context.Context, *QueryAllCctxByReceiverRequest) (*QueryAllCctxResponse, error)
+	// Queries the list of cctxs with a status.
+	CctxAllByStatus(context.Context, *QueryAllCctxByStatusRequest) (*QueryAllCctxResponse, error)
+	// Queries the list of cctxs sent from a chain.
+	CctxAllBySenderChain(context.Context, *QueryAllCctxBySenderChainRequest) (*QueryAllCctxResponse, error)
+	// Queries the list of cctxs created on ZetaChain in a range of blocks.
+	CctxAllByHeight(context.Context, *QueryAllCctxByHeightRequest) (*QueryAllCctxResponse, error)
+	// Queries the rate limits and their current windows.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// Queries a list of lastMetaHeight items.
+	LastZetaHeight(context.Context, *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error)
+	TssHistory(context.Context, *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error)
+	// Queries the migration of the funds of the current TSS to the TSS pending migration
+	TssMigration(context.Context, *QueryTssMigrationRequest) (*QueryTssMigrationResponse, error)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) OutTxTracker(ctx context.Context, req *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutTxTracker not implemented")
+}
+func (*UnimplementedQueryServer) OutTxTrackerAll(ctx context.Context, req *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAll not implemented")
+}
+func (*UnimplementedQueryServer) OutTxTrackerAllByChain(ctx context.Context, req *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAllByChain not implemented")
+}
+func (*UnimplementedQueryServer) InTxHashToCctx(ctx context.Context, req *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctx not implemented")
+}
+func (*UnimplementedQueryServer) InTxHashToCctxData(ctx context.Context, req *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxData not implemented")
+}
+func (*UnimplementedQueryServer) InTxHashToCctxAll(ctx context.Context, req *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxAll not implemented")
+}
+func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
+}
+func (*UnimplementedQueryServer) TSS(ctx context.Context, req *QueryGetTSSRequest) (*QueryGetTSSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSS not implemented")
+}
+func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
+}
+func (*UnimplementedQueryServer) GasPriceAll(ctx context.Context, req *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceAll not implemented")
+}
+func (*UnimplementedQueryServer) GasPriceHistory(ctx context.Context, req *QueryGasPriceHistoryRequest) (*QueryGasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) ConvertGasToZeta(ctx context.Context, req *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertGasToZeta not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFee(ctx context.Context, req *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFee not implemented")
+}
+func (*UnimplementedQueryServer) ChainNonces(ctx context.Context, req *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainNonces not implemented")
+}
+func (*UnimplementedQueryServer) ChainNoncesAll(ctx context.Context, req *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainNoncesAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingNoncesAll(ctx context.Context, req *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingNoncesByChain(ctx context.Context, req *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesByChain not implemented")
+}
+func (*UnimplementedQueryServer) LastBlockHeight(ctx context.Context, req *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeight not implemented")
+}
+func (*UnimplementedQueryServer) LastBlockHeightAll(ctx context.Context, req *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeightAll not implemented")
+}
+func (*UnimplementedQueryServer) Cctx(ctx context.Context, req *QueryGetCctxRequest) (*QueryGetCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cctx not implemented")
+}
+func (*UnimplementedQueryServer) CctxStatusTransitions(ctx context.Context, req *QueryCctxStatusTransitionsRequest) (*QueryCctxStatusTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxStatusTransitions not implemented")
+}
+func (*UnimplementedQueryServer) CctxByNonce(ctx context.Context, req *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxByNonce not implemented")
+}
+func (*UnimplementedQueryServer) CctxAll(ctx context.Context, req *QueryAllCctxRequest) (*QueryAllCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxAll not implemented")
+}
+func (*UnimplementedQueryServer) CctxAllPending(ctx context.Context, req *QueryAllCctxPendingRequest) (*QueryAllCctxPendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxAllPending not implemented")
+}
+func (*UnimplementedQueryServer) CctxAllPendingReview(ctx context.Context, req *QueryAllCctxPendingReviewRequest) (*QueryAllCctxPendingReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxAllPendingReview not implemented")
+}
+func (*UnimplementedQueryServer) CctxAllBySender(ctx context.Context, req *QueryAllCctxBySenderRequest) (*QueryAllCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxAllBySender not implemented")
+}
+func (*UnimplementedQueryServer) CctxAllByReceiver(ctx context.Context, req *QueryAllCctxByReceiverRequest) (*QueryAllCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxAllByReceiver not implemented")
+}
+func (*UnimplementedQueryServer) CctxAllByStatus(ctx context.Context, req *QueryAllCctxByStatusRequest) (*QueryAllCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxAllByStatus not implemented")
+}
+func (*UnimplementedQueryServer) CctxAllBySenderChain(ctx context.Context, req *QueryAllCctxBySenderChainRequest) (*QueryAllCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxAllBySenderChain not implemented")
+}
+func (*UnimplementedQueryServer) CctxAllByHeight(ctx context.Context, req *QueryAllCctxByHeightRequest) (*QueryAllCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxAllByHeight not implemented")
+}
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) LastZetaHeight(ctx context.Context, req *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastZetaHeight not implemented")
+}
+func (*UnimplementedQueryServer) TssHistory(ctx context.Context, req *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TssHistory not implemented")
+}
+func (*UnimplementedQueryServer) TssMigration(ctx context.Context, req *QueryTssMigrationRequest) (*QueryTssMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TssMigration not implemented")
 }
 
-func (m *QueryGetOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryGetOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChainID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOutTxTrackerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OutTxTracker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTracker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutTxTracker(ctx, req.(*QueryGetOutTxTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_OutTxTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOutTxTrackerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutTxTrackerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutTxTrackerAll(ctx, req.(*QueryAllOutTxTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.OutTxTracker.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_OutTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOutTxTrackerByChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, req.(*QueryAllOutTxTrackerByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_InTxHashToCctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetInTxHashToCctxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).InTxHashToCctx(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxHashToCctx(ctx, req.(*QueryGetInTxHashToCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_InTxHashToCctxData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInTxHashToCctxDataRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).InTxHashToCctxData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxHashToCctxData(ctx, req.(*QueryInTxHashToCctxDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_InTxHashToCctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInTxHashToCctxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OutTxTracker) > 0 {
-		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).InTxHashToCctxAll(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxHashToCctxAll(ctx, req.(*QueryAllInTxHashToCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerByChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTssAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetTssAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/GetTssAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerByChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTSSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TSS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/TSS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TSS(ctx, req.(*QueryGetTSSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Chain != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Chain))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).GasPrice(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPrice(ctx, req.(*QueryGetGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerByChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GasPriceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGasPriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPriceAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceAll(ctx, req.(*QueryAllGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerByChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceHistory(ctx, req.(*QueryGasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_ConvertGasToZeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConvertGasToZetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OutTxTracker) > 0 {
-		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ConvertGasToZeta(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConvertGasToZeta(ctx, req.(*QueryConvertGasToZetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ProtocolFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMessagePassingProtocolFeeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/ProtocolFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFee(ctx, req.(*QueryMessagePassingProtocolFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/ChainNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainNonces(ctx, req.(*QueryGetChainNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InTxHash) > 0 {
-		i -= len(m.InTxHash)
-		copy(dAtA[i:], m.InTxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
-		i--
-		dAtA[i] = 0xa
+func _Query_ChainNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChainNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ChainNoncesAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/ChainNoncesAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainNoncesAll(ctx, req.(*QueryAllChainNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PendingNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingNoncesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNoncesAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/PendingNoncesAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNoncesAll(ctx, req.(*QueryAllPendingNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PendingNoncesByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingNoncesByChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNoncesByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/PendingNoncesByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNoncesByChain(ctx, req.(*QueryPendingNoncesByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InTxHashToCctx.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_LastBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLastBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).LastBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastBlockHeight(ctx, req.(*QueryGetLastBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LastBlockHeightAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLastBlockHeightRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LastBlockHeightAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastBlockHeightAll(ctx, req.(*QueryAllLastBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Cctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCctxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Cctx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/Cctx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Cctx(ctx, req.(*QueryGetCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InTxHash) > 0 {
-		i -= len(m.InTxHash)
-		copy(dAtA[i:], m.InTxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
-		i--
-		dAtA[i] = 0xa
+func _Query_CctxStatusTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCctxStatusTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxStatusTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxStatusTransitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxStatusTransitions(ctx, req.(*QueryCctxStatusTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CctxByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCctxByNonceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxByNonce(ctx, req.(*QueryGetCctxByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryInTxHashToCctxDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CrossChainTxs) > 0 {
-		for iNdEx := len(m.CrossChainTxs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CrossChainTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_CctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCctxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxAll(ctx, req.(*QueryAllCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CctxAllPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCctxPendingRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).CctxAllPending(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAllPending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxAllPending(ctx, req.(*QueryAllCctxPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CctxAllPendingReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCctxPendingReviewRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).CctxAllPendingReview(ctx, in)
 	}
-	if len(m.InTxHashToCctx) > 0 {
-		for iNdEx := len(m.InTxHashToCctx) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InTxHashToCctx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAllPendingReview",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxAllPendingReview(ctx, req.(*QueryAllCctxPendingReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CctxAllBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCctxBySenderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxAllBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAllBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxAllBySender(ctx, req.(*QueryAllCctxBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CctxAllByReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCctxByReceiverRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Btc) > 0 {
-		i -= len(m.Btc)
-		copy(dAtA[i:], m.Btc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).CctxAllByReceiver(ctx, in)
 	}
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAllByReceiver",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxAllByReceiver(ctx, req.(*QueryAllCctxByReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CctxAllByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCctxByStatusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxAllByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAllByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxAllByStatus(ctx, req.(*QueryAllCctxByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_CctxAllBySenderChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCctxBySenderChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CctxAllBySenderChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAllBySenderChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxAllBySenderChain(ctx, req.(*QueryAllCctxBySenderChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_CctxAllByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCctxByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CctxAllByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAllByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxAllByHeight(ctx, req.(*QueryAllCctxByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTSSResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_LastZetaHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastZetaHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastZetaHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/LastZetaHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastZetaHeight(ctx, req.(*QueryLastZetaHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TSS != nil {
-		{
-			size, err := m.TSS.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_TssHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTssHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TssHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/TssHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TssHistory(ctx, req.(*QueryTssHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetGasPriceRequest) Marshal() (dAtA []byte, err error) {
+func _Query_TssMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTssMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TssMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/TssMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TssMigration(ctx, req.(*QueryTssMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.crosschain.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "OutTxTracker",
+			Handler:    _Query_OutTxTracker_Handler,
+		},
+		{
+			MethodName: "OutTxTrackerAll",
+			Handler:    _Query_OutTxTrackerAll_Handler,
+		},
+		{
+			MethodName: "OutTxTrackerAllByChain",
+			Handler:    _Query_OutTxTrackerAllByChain_Handler,
+		},
+		{
+			MethodName: "InTxHashToCctx",
+			Handler:    _Query_InTxHashToCctx_Handler,
+		},
+		{
+			MethodName: "InTxHashToCctxData",
+			Handler:    _Query_InTxHashToCctxData_Handler,
+		},
+		{
+			MethodName: "InTxHashToCctxAll",
+			Handler:    _Query_InTxHashToCctxAll_Handler,
+		},
+		{
+			MethodName: "GetTssAddress",
+			Handler:    _Query_GetTssAddress_Handler,
+		},
+		{
+			MethodName: "TSS",
+			Handler:    _Query_TSS_Handler,
+		},
+		{
+			MethodName: "GasPrice",
+			Handler:    _Query_GasPrice_Handler,
+		},
+		{
+			MethodName: "GasPriceAll",
+			Handler:    _Query_GasPriceAll_Handler,
+		},
+		{
+			MethodName: "GasPriceHistory",
+			Handler:    _Query_GasPriceHistory_Handler,
+		},
+		{
+			MethodName: "ConvertGasToZeta",
+			Handler:    _Query_ConvertGasToZeta_Handler,
+		},
+		{
+			MethodName: "ProtocolFee",
+			Handler:    _Query_ProtocolFee_Handler,
+		},
+		{
+			MethodName: "ChainNonces",
+			Handler:    _Query_ChainNonces_Handler,
+		},
+		{
+			MethodName: "ChainNoncesAll",
+			Handler:    _Query_ChainNoncesAll_Handler,
+		},
+		{
+			MethodName: "PendingNoncesAll",
+			Handler:    _Query_PendingNoncesAll_Handler,
+		},
+		{
+			MethodName: "PendingNoncesByChain",
+			Handler:    _Query_PendingNoncesByChain_Handler,
+		},
+		{
+			MethodName: "LastBlockHeight",
+			Handler:    _Query_LastBlockHeight_Handler,
+		},
+		{
+			MethodName: "LastBlockHeightAll",
+			Handler:    _Query_LastBlockHeightAll_Handler,
+		},
+		{
+			MethodName: "Cctx",
+			Handler:    _Query_Cctx_Handler,
+		},
+		{
+			MethodName: "CctxStatusTransitions",
+			Handler:    _Query_CctxStatusTransitions_Handler,
+		},
+		{
+			MethodName: "CctxByNonce",
+			Handler:    _Query_CctxByNonce_Handler,
+		},
+		{
+			MethodName: "CctxAll",
+			Handler:    _Query_CctxAll_Handler,
+		},
+		{
+			MethodName: "CctxAllPending",
+			Handler:    _Query_CctxAllPending_Handler,
+		},
+		{
+			MethodName: "CctxAllPendingReview",
+			Handler:    _Query_CctxAllPendingReview_Handler,
+		},
+		{
+			MethodName: "CctxAllBySender",
+			Handler:    _Query_CctxAllBySender_Handler,
+		},
+		{
+			MethodName: "CctxAllByReceiver",
+			Handler:    _Query_CctxAllByReceiver_Handler,
+		},
+		{
+			MethodName: "CctxAllByStatus",
+			Handler:    _Query_CctxAllByStatus_Handler,
+		},
+		{
+			MethodName: "CctxAllBySenderChain",
+			Handler:    _Query_CctxAllBySenderChain_Handler,
+		},
+		{
+			MethodName: "CctxAllByHeight",
+			Handler:    _Query_CctxAllByHeight_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "LastZetaHeight",
+			Handler:    _Query_LastZetaHeight_Handler,
+		},
+		{
+			MethodName: "TssHistory",
+			Handler:    _Query_TssHistory_Handler,
+		},
+		{
+			MethodName: "TssMigration",
+			Handler:    _Query_TssMigration_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "crosschain/query.proto",
+}
+
+func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4948,27 +5423,20 @@ func (m *QueryGetGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGasPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4978,32 +5446,34 @@ func (m *QueryGetGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GasPrice != nil {
-		{
-			size, err := m.GasPrice.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.TssList) > 0 {
+		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGasPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssMigrationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5013,32 +5483,20 @@ func (m *QueryAllGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssMigrationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssMigrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGasPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssMigrationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5048,32 +5506,20 @@ func (m *QueryAllGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GasPrice) > 0 {
-		for iNdEx := len(m.GasPrice) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TssFundMigrators) > 0 {
+		for iNdEx := len(m.TssFundMigrators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.GasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TssFundMigrators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5081,13 +5527,33 @@ func (m *QueryAllGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.PendingTss.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.InProgress {
+		i--
+		if m.InProgress {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5097,27 +5563,86 @@ func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.ChainID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5127,32 +5652,30 @@ func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainNonces != nil {
-		{
-			size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.OutTxTracker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5162,12 +5685,12 @@ func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5187,7 +5710,7 @@ func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5197,12 +5720,12 @@ func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5219,10 +5742,10 @@ func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ChainNonces) > 0 {
-		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.OutTxTracker) > 0 {
+		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5236,7 +5759,7 @@ func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOutTxTrackerByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5246,20 +5769,37 @@ func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Chain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Chain))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOutTxTrackerByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5269,20 +5809,32 @@ func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllPendingNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PendingNonces) > 0 {
-		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OutTxTracker) > 0 {
+		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5296,7 +5848,7 @@ func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5306,25 +5858,27 @@ func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if len(m.InTxHash) > 0 {
+		i -= len(m.InTxHash)
+		copy(dAtA[i:], m.InTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5334,18 +5888,18 @@ func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.InTxHashToCctx.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5357,7 +5911,7 @@ func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryInTxHashToCctxDataRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5367,27 +5921,27 @@ func (m *QueryGetLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryInTxHashToCctxDataRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryInTxHashToCctxDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+	if len(m.InTxHash) > 0 {
+		i -= len(m.InTxHash)
+		copy(dAtA[i:], m.InTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryInTxHashToCctxDataResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5397,32 +5951,34 @@ func (m *QueryGetLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryInTxHashToCctxDataResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryInTxHashToCctxDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastBlockHeight != nil {
-		{
-			size, err := m.LastBlockHeight.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.CrossChainTxs) > 0 {
+		for iNdEx := len(m.CrossChainTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5432,12 +5988,12 @@ func (m *QueryAllLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5457,7 +6013,7 @@ func (m *QueryAllLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5467,12 +6023,12 @@ func (m *QueryAllLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5489,10 +6045,10 @@ func (m *QueryAllLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.LastBlockHeight) > 0 {
-		for iNdEx := len(m.LastBlockHeight) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InTxHashToCctx) > 0 {
+		for iNdEx := len(m.InTxHashToCctx) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LastBlockHeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InTxHashToCctx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5506,7 +6062,7 @@ func (m *QueryAllLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5516,27 +6072,57 @@ func (m *QueryGetCctxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Btc) > 0 {
+		i -= len(m.Btc)
+		copy(dAtA[i:], m.Btc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxByNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5546,30 +6132,85 @@ func (m *QueryGetCctxByNonceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCctxByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTSSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TSS != nil {
+		{
+			size, err := m.TSS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xa
 	}
-	if m.ChainID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5579,19 +6220,19 @@ func (m *QueryGetCctxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CrossChainTx != nil {
+	if m.GasPrice != nil {
 		{
-			size, err := m.CrossChainTx.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.GasPrice.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5604,7 +6245,7 @@ func (m *QueryGetCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5614,12 +6255,12 @@ func (m *QueryAllCctxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5639,7 +6280,7 @@ func (m *QueryAllCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5649,12 +6290,12 @@ func (m *QueryAllCctxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5671,10 +6312,10 @@ func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.CrossChainTx) > 0 {
-		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.GasPrice) > 0 {
+		for iNdEx := len(m.GasPrice) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.GasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5688,7 +6329,7 @@ func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxPendingRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGasPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5698,12 +6339,12 @@ func (m *QueryAllCctxPendingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllCctxPendingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGasPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllCctxPendingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGasPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5728,7 +6369,7 @@ func (m *QueryAllCctxPendingRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxPendingResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGasPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5738,12 +6379,12 @@ func (m *QueryAllCctxPendingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllCctxPendingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGasPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllCctxPendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGasPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5760,10 +6401,10 @@ func (m *QueryAllCctxPendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.CrossChainTx) > 0 {
-		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5777,7 +6418,7 @@ func (m *QueryAllCctxPendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5787,20 +6428,27 @@ func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastZetaHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastZetaHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5810,25 +6458,32 @@ func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastZetaHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastZetaHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+	if m.ChainNonces != nil {
+		{
+			size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryConvertGasToZetaRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5838,32 +6493,32 @@ func (m *QueryConvertGasToZetaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryConvertGasToZetaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConvertGasToZetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GasLimit) > 0 {
-		i -= len(m.GasLimit)
-		copy(dAtA[i:], m.GasLimit)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryConvertGasToZetaResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5873,39 +6528,46 @@ func (m *QueryConvertGasToZetaResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryConvertGasToZetaResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConvertGasToZetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ZetaBlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ZetaBlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ProtocolFeeInZeta) > 0 {
-		i -= len(m.ProtocolFeeInZeta)
-		copy(dAtA[i:], m.ProtocolFeeInZeta)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolFeeInZeta)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.OutboundGasInZeta) > 0 {
-		i -= len(m.OutboundGasInZeta)
-		copy(dAtA[i:], m.OutboundGasInZeta)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundGasInZeta)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.ChainNonces) > 0 {
+		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5915,12 +6577,12 @@ func (m *QueryMessagePassingProtocolFeeRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5928,7 +6590,7 @@ func (m *QueryMessagePassingProtocolFeeRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5938,27 +6600,34 @@ func (m *QueryMessagePassingProtocolFeeResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeeInZeta) > 0 {
-		i -= len(m.FeeInZeta)
-		copy(dAtA[i:], m.FeeInZeta)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeInZeta)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.PendingNonces) > 0 {
+		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryZEVMGetTransactionReceiptRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5968,27 +6637,25 @@ func (m *QueryZEVMGetTransactionReceiptRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryZEVMGetTransactionReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryZEVMGetTransactionReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryZEVMGetTransactionReceiptResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5998,111 +6665,30 @@ func (m *QueryZEVMGetTransactionReceiptResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryZEVMGetTransactionReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryZEVMGetTransactionReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
+	{
+		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if len(m.TransactionIndex) > 0 {
-		i -= len(m.TransactionIndex)
-		copy(dAtA[i:], m.TransactionIndex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionIndex)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.TransactionHash) > 0 {
-		i -= len(m.TransactionHash)
-		copy(dAtA[i:], m.TransactionHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionHash)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.LogsBloom) > 0 {
-		i -= len(m.LogsBloom)
-		copy(dAtA[i:], m.LogsBloom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LogsBloom)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.GasUsed) > 0 {
-		i -= len(m.GasUsed)
-		copy(dAtA[i:], m.GasUsed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasUsed)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CumulativeGasUsed) > 0 {
-		i -= len(m.CumulativeGasUsed)
-		copy(dAtA[i:], m.CumulativeGasUsed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CumulativeGasUsed)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BlockNumber) > 0 {
-		i -= len(m.BlockNumber)
-		copy(dAtA[i:], m.BlockNumber)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockNumber)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0xa
-	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Log) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6112,82 +6698,27 @@ func (m *Log) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Log) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Removed {
-		i--
-		if m.Removed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.LogIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LogIndex))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.TransactionIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TransactionIndex))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.TransactionHash) > 0 {
-		i -= len(m.TransactionHash)
-		copy(dAtA[i:], m.TransactionHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Topics[iNdEx])
-			copy(dAtA[i:], m.Topics[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Topics[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryZEVMGetTransactionRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6197,27 +6728,32 @@ func (m *QueryZEVMGetTransactionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryZEVMGetTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryZEVMGetTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+	if m.LastBlockHeight != nil {
+		{
+			size, err := m.LastBlockHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryZEVMGetTransactionResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6227,145 +6763,141 @@ func (m *QueryZEVMGetTransactionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryZEVMGetTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryZEVMGetTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.S) > 0 {
-		i -= len(m.S)
-		copy(dAtA[i:], m.S)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.S)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	if len(m.R) > 0 {
-		i -= len(m.R)
-		copy(dAtA[i:], m.R)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.R)))
-		i--
-		dAtA[i] = 0x1
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0xa
 	}
-	if len(m.V) > 0 {
-		i -= len(m.V)
-		copy(dAtA[i:], m.V)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.V)))
-		i--
-		dAtA[i] = 0x7a
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x12
 	}
-	if len(m.AccessList) > 0 {
-		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AccessList[iNdEx])
-			copy(dAtA[i:], m.AccessList[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.AccessList[iNdEx])))
+	if len(m.LastBlockHeight) > 0 {
+		for iNdEx := len(m.LastBlockHeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastBlockHeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x6a
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.TransactionIndex) > 0 {
-		i -= len(m.TransactionIndex)
-		copy(dAtA[i:], m.TransactionIndex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionIndex)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.To) > 0 {
-		i -= len(m.To)
-		copy(dAtA[i:], m.To)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.To)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Input) > 0 {
-		i -= len(m.Input)
-		copy(dAtA[i:], m.Input)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Input)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.GasPrice) > 0 {
-		i -= len(m.GasPrice)
-		copy(dAtA[i:], m.GasPrice)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasPrice)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Gas) > 0 {
-		i -= len(m.Gas)
-		copy(dAtA[i:], m.Gas)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Gas)))
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCctxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.From)))
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if len(m.BlockNumber) > 0 {
-		i -= len(m.BlockNumber)
-		copy(dAtA[i:], m.BlockNumber)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockNumber)))
-		i--
-		dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCctxStatusTransitionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+	return dAtA[:n], nil
+}
+
+func (m *QueryCctxStatusTransitionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCctxStatusTransitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryZEVMGetBlockByNumberRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCctxStatusTransitionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6375,25 +6907,34 @@ func (m *QueryZEVMGetBlockByNumberRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryZEVMGetBlockByNumberRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCctxStatusTransitionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryZEVMGetBlockByNumberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCctxStatusTransitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Transitions) > 0 {
+		for iNdEx := len(m.Transitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetCctxByNonceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6403,1193 +6944,3455 @@ func (m *QueryZEVMGetBlockByNumberResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetCctxByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetCctxByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MixHash) > 0 {
-		i -= len(m.MixHash)
-		copy(dAtA[i:], m.MixHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MixHash)))
-		i--
-		dAtA[i] = 0x1
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0x10
 	}
-	if len(m.BaseFeePerGas) > 0 {
-		i -= len(m.BaseFeePerGas)
-		copy(dAtA[i:], m.BaseFeePerGas)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseFeePerGas)))
-		i--
-		dAtA[i] = 0x1
+	if m.ChainID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
 		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0x8
 	}
-	if len(m.Uncles) > 0 {
-		for iNdEx := len(m.Uncles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Uncles[iNdEx])
-			copy(dAtA[i:], m.Uncles[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Uncles[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCctxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Transactions) > 0 {
-		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Transactions[iNdEx])
-			copy(dAtA[i:], m.Transactions[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Transactions[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CrossChainTx != nil {
+		{
+			size, err := m.CrossChainTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Timestamp) > 0 {
-		i -= len(m.Timestamp)
-		copy(dAtA[i:], m.Timestamp)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Timestamp)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	if len(m.GasUsed) > 0 {
-		i -= len(m.GasUsed)
-		copy(dAtA[i:], m.GasUsed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasUsed)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if len(m.GasLimit) > 0 {
-		i -= len(m.GasLimit)
-		copy(dAtA[i:], m.GasLimit)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.Size_) > 0 {
-		i -= len(m.Size_)
-		copy(dAtA[i:], m.Size_)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Size_)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.ExtraData) > 0 {
-		i -= len(m.ExtraData)
-		copy(dAtA[i:], m.ExtraData)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExtraData)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.TotalDifficulty) > 0 {
-		i -= len(m.TotalDifficulty)
-		copy(dAtA[i:], m.TotalDifficulty)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalDifficulty)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Difficulty) > 0 {
-		i -= len(m.Difficulty)
-		copy(dAtA[i:], m.Difficulty)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Difficulty)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Miner) > 0 {
-		i -= len(m.Miner)
-		copy(dAtA[i:], m.Miner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Miner)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.ReceiptsRoot) > 0 {
-		i -= len(m.ReceiptsRoot)
-		copy(dAtA[i:], m.ReceiptsRoot)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReceiptsRoot)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.StateRoot) > 0 {
-		i -= len(m.StateRoot)
-		copy(dAtA[i:], m.StateRoot)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateRoot)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.TransactionsRoot) > 0 {
-		i -= len(m.TransactionsRoot)
-		copy(dAtA[i:], m.TransactionsRoot)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionsRoot)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.LogsBloom) > 0 {
-		i -= len(m.LogsBloom)
-		copy(dAtA[i:], m.LogsBloom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LogsBloom)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Sha3Uncles) > 0 {
-		i -= len(m.Sha3Uncles)
-		copy(dAtA[i:], m.Sha3Uncles)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sha3Uncles)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ParentHash) > 0 {
-		i -= len(m.ParentHash)
-		copy(dAtA[i:], m.ParentHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ParentHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Number) > 0 {
-		i -= len(m.Number)
-		copy(dAtA[i:], m.Number)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Number)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAllCctxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTssHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryAllCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TssList) > 0 {
-		for _, e := range m.TssList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCctxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetOutTxTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainID != 0 {
-		n += 1 + sovQuery(uint64(m.ChainID))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOutTxTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCctxPendingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.OutTxTracker.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllOutTxTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllCctxPendingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOutTxTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxPendingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OutTxTracker) > 0 {
-		for _, e := range m.OutTxTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOutTxTrackerByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Chain != 0 {
-		n += 1 + sovQuery(uint64(m.Chain))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllCctxPendingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllOutTxTrackerByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxPendingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCctxPendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OutTxTracker) > 0 {
-		for _, e := range m.OutTxTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetInTxHashToCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InTxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllCctxPendingReviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetInTxHashToCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InTxHashToCctx.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllCctxPendingReviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInTxHashToCctxDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxPendingReviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.InTxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInTxHashToCctxDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCctxPendingReviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCctxPendingReviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCctxPendingReviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CrossChainTxs) > 0 {
-		for _, e := range m.CrossChainTxs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllInTxHashToCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllCctxBySenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllInTxHashToCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxBySenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCctxBySenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.InTxHashToCctx) > 0 {
-		for _, e := range m.InTxHashToCctx {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCctxByReceiverRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetTssAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxByReceiverRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCctxByReceiverRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Btc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTSSRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCctxByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetTSSResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TSS != nil {
-		l = m.TSS.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllCctxByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GasPrice != nil {
-		l = m.GasPrice.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllCctxBySenderChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllCctxBySenderChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxBySenderChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.GasPrice) > 0 {
-		for _, e := range m.GasPrice {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SenderChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SenderChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllCctxByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainNonces != nil {
-		l = m.ChainNonces.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllCctxByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ChainNonces) > 0 {
-		for _, e := range m.ChainNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PendingNonces) > 0 {
-		for _, e := range m.PendingNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PendingNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryLastZetaHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetLastBlockHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLastZetaHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLastBlockHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastBlockHeight != nil {
-		l = m.LastBlockHeight.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllLastBlockHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLastZetaHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastZetaHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLastBlockHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConvertGasToZetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConvertGasToZetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConvertGasToZetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LastBlockHeight) > 0 {
-		for _, e := range m.LastBlockHeight {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.GasLimit) > 0 {
+		i -= len(m.GasLimit)
+		copy(dAtA[i:], m.GasLimit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryConvertGasToZetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxByNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryConvertGasToZetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConvertGasToZetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainID != 0 {
-		n += 1 + sovQuery(uint64(m.ChainID))
+	if m.ZetaBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ZetaBlockHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.ProtocolFeeInZeta) > 0 {
+		i -= len(m.ProtocolFeeInZeta)
+		copy(dAtA[i:], m.ProtocolFeeInZeta)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolFeeInZeta)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.OutboundGasInZeta) > 0 {
+		i -= len(m.OutboundGasInZeta)
+		copy(dAtA[i:], m.OutboundGasInZeta)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundGasInZeta)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CrossChainTx != nil {
-		l = m.CrossChainTx.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMessagePassingProtocolFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMessagePassingProtocolFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessagePassingProtocolFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMessagePassingProtocolFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMessagePassingProtocolFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessagePassingProtocolFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CrossChainTx) > 0 {
-		for _, e := range m.CrossChainTx {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.FeeInZeta) > 0 {
+		i -= len(m.FeeInZeta)
+		copy(dAtA[i:], m.FeeInZeta)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeInZeta)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxPendingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryZEVMGetTransactionReceiptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZEVMGetTransactionReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZEVMGetTransactionReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxPendingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryZEVMGetTransactionReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZEVMGetTransactionReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZEVMGetTransactionReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CrossChainTx) > 0 {
-		for _, e := range m.CrossChainTx {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TransactionIndex) > 0 {
+		i -= len(m.TransactionIndex)
+		copy(dAtA[i:], m.TransactionIndex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionIndex)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
-}
-
-func (m *QueryLastZetaHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TransactionHash) > 0 {
+		i -= len(m.TransactionHash)
+		copy(dAtA[i:], m.TransactionHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionHash)))
+		i--
+		dAtA[i] = 0x52
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryLastZetaHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *QueryConvertGasToZetaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LogsBloom) > 0 {
+		i -= len(m.LogsBloom)
+		copy(dAtA[i:], m.LogsBloom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LogsBloom)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if len(m.GasUsed) > 0 {
+		i -= len(m.GasUsed)
+		copy(dAtA[i:], m.GasUsed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasUsed)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.GasLimit)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *QueryConvertGasToZetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.CumulativeGasUsed) > 0 {
+		i -= len(m.CumulativeGasUsed)
+		copy(dAtA[i:], m.CumulativeGasUsed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CumulativeGasUsed)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.OutboundGasInZeta)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ProtocolFeeInZeta)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BlockNumber) > 0 {
+		i -= len(m.BlockNumber)
+		copy(dAtA[i:], m.BlockNumber)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockNumber)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ZetaBlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.ZetaBlockHeight))
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Log) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeeInZeta)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *Log) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryZEVMGetTransactionReceiptRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Log) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryZEVMGetTransactionReceiptResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BlockNumber)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CumulativeGasUsed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.GasUsed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.LogsBloom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TransactionHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TransactionIndex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x48
 	}
-	return n
-}
-
-func (m *Log) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.LogIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x40
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.Topics) > 0 {
-		for _, s := range m.Topics {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.TransactionIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TransactionIndex))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TransactionHash) > 0 {
+		i -= len(m.TransactionHash)
+		copy(dAtA[i:], m.TransactionHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.BlockNumber != 0 {
-		n += 1 + sovQuery(uint64(m.BlockNumber))
-	}
-	l = len(m.TransactionHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TransactionIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TransactionIndex))
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.LogIndex != 0 {
-		n += 1 + sovQuery(uint64(m.LogIndex))
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Removed {
-		n += 2
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryZEVMGetTransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryZEVMGetTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZEVMGetTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZEVMGetTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryZEVMGetTransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryZEVMGetTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZEVMGetTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZEVMGetTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.S) > 0 {
+		i -= len(m.S)
+		copy(dAtA[i:], m.S)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.S)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	l = len(m.BlockNumber)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.R) > 0 {
+		i -= len(m.R)
+		copy(dAtA[i:], m.R)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.R)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	l = len(m.Gas)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x72
 	}
-	l = len(m.GasPrice)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AccessList[iNdEx])
+			copy(dAtA[i:], m.AccessList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AccessList[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x62
 	}
-	l = len(m.Input)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TransactionIndex) > 0 {
+		i -= len(m.TransactionIndex)
+		copy(dAtA[i:], m.TransactionIndex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionIndex)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.TransactionIndex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.AccessList) > 0 {
-		for _, s := range m.AccessList {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.GasPrice) > 0 {
+		i -= len(m.GasPrice)
+		copy(dAtA[i:], m.GasPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasPrice)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Gas) > 0 {
+		i -= len(m.Gas)
+		copy(dAtA[i:], m.Gas)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Gas)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.V)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.R)
-	if l > 0 {
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.BlockNumber) > 0 {
+		i -= len(m.BlockNumber)
+		copy(dAtA[i:], m.BlockNumber)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockNumber)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.S)
-	if l > 0 {
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryZEVMGetBlockByNumberRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryZEVMGetBlockByNumberRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZEVMGetBlockByNumberRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZEVMGetBlockByNumberRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryZEVMGetBlockByNumberResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryZEVMGetBlockByNumberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryZEVMGetBlockByNumberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryZEVMGetBlockByNumberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Number)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.MixHash) > 0 {
+		i -= len(m.MixHash)
+		copy(dAtA[i:], m.MixHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MixHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BaseFeePerGas) > 0 {
+		i -= len(m.BaseFeePerGas)
+		copy(dAtA[i:], m.BaseFeePerGas)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseFeePerGas)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	l = len(m.ParentHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Uncles) > 0 {
+		for iNdEx := len(m.Uncles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Uncles[iNdEx])
+			copy(dAtA[i:], m.Uncles[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Uncles[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Transactions[iNdEx])
+			copy(dAtA[i:], m.Transactions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Transactions[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	l = len(m.Sha3Uncles)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Timestamp) > 0 {
+		i -= len(m.Timestamp)
+		copy(dAtA[i:], m.Timestamp)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Timestamp)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	l = len(m.LogsBloom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GasUsed) > 0 {
+		i -= len(m.GasUsed)
+		copy(dAtA[i:], m.GasUsed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasUsed)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	l = len(m.TransactionsRoot)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GasLimit) > 0 {
+		i -= len(m.GasLimit)
+		copy(dAtA[i:], m.GasLimit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	l = len(m.StateRoot)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Size_) > 0 {
+		i -= len(m.Size_)
+		copy(dAtA[i:], m.Size_)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Size_)))
+		i--
+		dAtA[i] = 0x72
 	}
-	l = len(m.ReceiptsRoot)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ExtraData) > 0 {
+		i -= len(m.ExtraData)
+		copy(dAtA[i:], m.ExtraData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExtraData)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = len(m.Miner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TotalDifficulty) > 0 {
+		i -= len(m.TotalDifficulty)
+		copy(dAtA[i:], m.TotalDifficulty)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalDifficulty)))
+		i--
+		dAtA[i] = 0x62
 	}
-	l = len(m.Difficulty)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Difficulty) > 0 {
+		i -= len(m.Difficulty)
+		copy(dAtA[i:], m.Difficulty)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Difficulty)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.TotalDifficulty)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Miner) > 0 {
+		i -= len(m.Miner)
+		copy(dAtA[i:], m.Miner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Miner)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.ExtraData)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Size_)
-	if l > 0 {
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TransactionsRoot) > 0 {
+		i -= len(m.TransactionsRoot)
+		copy(dAtA[i:], m.TransactionsRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransactionsRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LogsBloom) > 0 {
+		i -= len(m.LogsBloom)
+		copy(dAtA[i:], m.LogsBloom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LogsBloom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sha3Uncles) > 0 {
+		i -= len(m.Sha3Uncles)
+		copy(dAtA[i:], m.Sha3Uncles)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sha3Uncles)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ParentHash) > 0 {
+		i -= len(m.ParentHash)
+		copy(dAtA[i:], m.ParentHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ParentHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Number) > 0 {
+		i -= len(m.Number)
+		copy(dAtA[i:], m.Number)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Number)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTssHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTssHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TssList) > 0 {
+		for _, e := range m.TssList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTssMigrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTssMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InProgress {
+		n += 2
+	}
+	l = m.PendingTss.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TssFundMigrators) > 0 {
+		for _, e := range m.TssFundMigrators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetOutTxTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != 0 {
+		n += 1 + sovQuery(uint64(m.ChainID))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryGetOutTxTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OutTxTracker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllOutTxTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.GasLimit)
-	if l > 0 {
+	return n
+}
+
+func (m *QueryAllOutTxTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutTxTracker) > 0 {
+		for _, e := range m.OutTxTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.GasUsed)
-	if l > 0 {
-		n += 2 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllOutTxTrackerByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Timestamp)
-	if l > 0 {
-		n += 2 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	if m.Chain != 0 {
+		n += 1 + sovQuery(uint64(m.Chain))
 	}
-	if len(m.Transactions) > 0 {
-		for _, s := range m.Transactions {
-			l = len(s)
-			n += 2 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOutTxTrackerByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutTxTracker) > 0 {
+		for _, e := range m.OutTxTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if len(m.Uncles) > 0 {
-		for _, s := range m.Uncles {
-			l = len(s)
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInTxHashToCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInTxHashToCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InTxHashToCctx.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInTxHashToCctxDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInTxHashToCctxDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTxs) > 0 {
+		for _, e := range m.CrossChainTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllInTxHashToCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInTxHashToCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InTxHashToCctx) > 0 {
+		for _, e := range m.InTxHashToCctx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetTssAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTSSRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetTSSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TSS != nil {
+		l = m.TSS.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrice) > 0 {
+		for _, e := range m.GasPrice {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainNonces != nil {
+		l = m.ChainNonces.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChainNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChainNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainNonces) > 0 {
+		for _, e := range m.ChainNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllPendingNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingNonces) > 0 {
+		for _, e := range m.PendingNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetLastBlockHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLastBlockHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastBlockHeight != nil {
+		l = m.LastBlockHeight.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLastBlockHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLastBlockHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LastBlockHeight) > 0 {
+		for _, e := range m.LastBlockHeight {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCctxStatusTransitionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCctxStatusTransitionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transitions) > 0 {
+		for _, e := range m.Transitions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetCctxByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != 0 {
+		n += 1 + sovQuery(uint64(m.ChainID))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryGetCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CrossChainTx != nil {
+		l = m.CrossChainTx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxPendingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxPendingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxPendingReviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllCctxPendingReviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllCctxBySenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxByReceiverRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxBySenderChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SenderChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SenderChainId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLastZetaHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastZetaHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryConvertGasToZetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.GasLimit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConvertGasToZetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OutboundGasInZeta)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProtocolFeeInZeta)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ZetaBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ZetaBlockHeight))
+	}
+	return n
+}
+
+func (m *QueryMessagePassingProtocolFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMessagePassingProtocolFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeInZeta)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZEVMGetTransactionReceiptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZEVMGetTransactionReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockNumber)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CumulativeGasUsed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasUsed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LogsBloom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TransactionHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TransactionIndex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Log) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, s := range m.Topics {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.TransactionHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TransactionIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TransactionIndex))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LogIndex))
+	}
+	if m.Removed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryZEVMGetTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZEVMGetTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockNumber)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Gas)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TransactionIndex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AccessList) > 0 {
+		for _, s := range m.AccessList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.R)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.S)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZEVMGetBlockByNumberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryZEVMGetBlockByNumberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Number)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ParentHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sha3Uncles)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LogsBloom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TransactionsRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Miner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Difficulty)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalDifficulty)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExtraData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Size_)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasLimit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GasUsed)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Timestamp)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if len(m.Transactions) > 0 {
+		for _, s := range m.Transactions {
+			l = len(s)
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Uncles) > 0 {
+		for _, s := range m.Uncles {
+			l = len(s)
 			n += 2 + l + sovQuery(uint64(l))
 		}
 	}
-	l = len(m.BaseFeePerGas)
-	if l > 0 {
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	l = len(m.MixHash)
-	if l > 0 {
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	l = len(m.BaseFeePerGas)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MixHash)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssList = append(m.TssList, TSS{})
+			if err := m.TssList[len(m.TssList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTssMigrationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssMigrationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssMigrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTssMigrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssMigrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InProgress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InProgress = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTss", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingTss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssFundMigrators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssFundMigrators = append(m.TssFundMigrators, TssFundMigratorInfo{})
+			if err := m.TssFundMigrators[len(m.TssFundMigrators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			m.ChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutTxTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
+			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7612,12 +10415,67 @@ func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7639,7 +10497,7 @@ func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7662,15 +10520,15 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7697,8 +10555,44 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TssList = append(m.TssList, TSS{})
-			if err := m.TssList[len(m.TssList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
+			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7723,7 +10617,7 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7746,12 +10640,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7773,7 +10699,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7796,15 +10722,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7831,7 +10757,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InTxHashToCctx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7856,7 +10782,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7879,17 +10805,17 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
 			}
-			m.ChainID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7899,30 +10825,24 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainID |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7944,7 +10864,7 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7967,15 +10887,15 @@ func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTxs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8002,7 +10922,8 @@ func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutTxTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CrossChainTxs = append(m.CrossChainTxs, CrossChainTx{})
+			if err := m.CrossChainTxs[len(m.CrossChainTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8027,7 +10948,7 @@ func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8050,10 +10971,10 @@ func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8113,7 +11034,7 @@ func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8136,15 +11057,15 @@ func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8171,8 +11092,8 @@ func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
-			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InTxHashToCctx = append(m.InTxHashToCctx, InTxHashToCctx{})
+			if err := m.InTxHashToCctx[len(m.InTxHashToCctx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8233,7 +11154,7 @@ func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8256,17 +11177,67 @@ func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
 			}
-			m.Chain = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8276,16 +11247,29 @@ func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Chain |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8295,27 +11279,23 @@ func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Btc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8338,7 +11318,7 @@ func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8361,49 +11341,65 @@ func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTSSRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTSSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
-			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTSSResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TSS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8430,10 +11426,10 @@ func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.TSS == nil {
+				m.TSS = &TSS{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8458,7 +11454,7 @@ func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8481,15 +11477,15 @@ func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8517,7 +11513,7 @@ func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8540,7 +11536,7 @@ func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8563,15 +11559,15 @@ func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8598,7 +11594,10 @@ func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InTxHashToCctx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GasPrice == nil {
+				m.GasPrice = &GasPrice{}
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8623,7 +11622,7 @@ func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8646,17 +11645,17 @@ func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8666,23 +11665,27 @@ func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8705,7 +11708,7 @@ func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8728,15 +11731,15 @@ func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8763,8 +11766,44 @@ func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CrossChainTxs = append(m.CrossChainTxs, CrossChainTx{})
-			if err := m.CrossChainTxs[len(m.CrossChainTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasPrice = append(m.GasPrice, &GasPrice{})
+			if err := m.GasPrice[len(m.GasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8789,7 +11828,7 @@ func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGasPriceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8812,13 +11851,32 @@ func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasPriceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -8875,7 +11933,7 @@ func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGasPriceHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8898,15 +11956,15 @@ func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasPriceHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8933,8 +11991,8 @@ func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHashToCctx = append(m.InTxHashToCctx, InTxHashToCctx{})
-			if err := m.InTxHashToCctx[len(m.InTxHashToCctx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.History = append(m.History, GasPriceHistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8995,7 +12053,7 @@ func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9018,12 +12076,44 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainNoncesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9045,7 +12135,7 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9068,17 +12158,17 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainNoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9088,55 +12178,27 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Eth = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.ChainNonces == nil {
+				m.ChainNonces = &ChainNonces{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ChainNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Btc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9159,7 +12221,7 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9182,12 +12244,48 @@ func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTSSRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChainNoncesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTSSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9209,7 +12307,7 @@ func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9232,15 +12330,49 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTSSResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChainNoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainNonces = append(m.ChainNonces, &ChainNonces{})
+			if err := m.ChainNonces[len(m.ChainNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9267,10 +12399,10 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TSS == nil {
-				m.TSS = &TSS{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.TSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9295,7 +12427,7 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9318,44 +12450,12 @@ func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9377,7 +12477,7 @@ func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9400,15 +12500,15 @@ func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9435,10 +12535,8 @@ func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasPrice == nil {
-				m.GasPrice = &GasPrice{}
-			}
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingNonces = append(m.PendingNonces, &PendingNonces{})
+			if err := m.PendingNonces[len(m.PendingNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9463,7 +12561,7 @@ func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9486,17 +12584,17 @@ func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9506,28 +12604,11 @@ func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9549,7 +12630,7 @@ func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9572,49 +12653,15 @@ func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GasPrice = append(m.GasPrice, &GasPrice{})
-			if err := m.GasPrice[len(m.GasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9641,10 +12688,7 @@ func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9669,7 +12713,7 @@ func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9692,10 +12736,10 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9751,7 +12795,7 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9774,15 +12818,15 @@ func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9809,10 +12853,10 @@ func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChainNonces == nil {
-				m.ChainNonces = &ChainNonces{}
+			if m.LastBlockHeight == nil {
+				m.LastBlockHeight = &LastBlockHeight{}
 			}
-			if err := m.ChainNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9837,7 +12881,7 @@ func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9860,10 +12904,10 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9923,7 +12967,7 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9946,15 +12990,15 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9981,16 +13025,102 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainNonces = append(m.ChainNonces, &ChainNonces{})
-			if err := m.ChainNonces[len(m.ChainNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastBlockHeight = append(m.LastBlockHeight, &LastBlockHeight{})
+			if err := m.LastBlockHeight[len(m.LastBlockHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCctxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10000,27 +13130,23 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10043,7 +13169,7 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCctxStatusTransitionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10066,12 +13192,44 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCctxStatusTransitionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCctxStatusTransitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10093,7 +13251,7 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCctxStatusTransitionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10116,15 +13274,15 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCctxStatusTransitionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCctxStatusTransitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10151,8 +13309,8 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingNonces = append(m.PendingNonces, &PendingNonces{})
-			if err := m.PendingNonces[len(m.PendingNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Transitions = append(m.Transitions, &StatusTransition{})
+			if err := m.Transitions[len(m.Transitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10177,7 +13335,7 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10200,17 +13358,17 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			m.ChainId = 0
+			m.ChainID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10220,7 +13378,26 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.ChainID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10246,7 +13423,7 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10269,15 +13446,15 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10304,7 +13481,10 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PendingNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CrossChainTx == nil {
+				m.CrossChainTx = &CrossChainTx{}
+			}
+			if err := m.CrossChainTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10329,7 +13509,7 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10352,17 +13532,17 @@ func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10372,23 +13552,27 @@ func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10411,7 +13595,7 @@ func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10434,15 +13618,15 @@ func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10469,10 +13653,44 @@ func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastBlockHeight == nil {
-				m.LastBlockHeight = &LastBlockHeight{}
+			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
+			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.LastBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10497,7 +13715,7 @@ func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxPendingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10520,13 +13738,32 @@ func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxPendingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxPendingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -10583,7 +13820,7 @@ func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxPendingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10606,15 +13843,15 @@ func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxPendingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxPendingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10641,8 +13878,8 @@ func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastBlockHeight = append(m.LastBlockHeight, &LastBlockHeight{})
-			if err := m.LastBlockHeight[len(m.LastBlockHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
+			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10672,16 +13909,66 @@ func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCctxPendingReviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCctxPendingReviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCctxPendingReviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10703,7 +13990,7 @@ func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxPendingReviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10726,17 +14013,17 @@ func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxPendingReviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxPendingReviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10746,23 +14033,25 @@ func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
+			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10785,7 +14074,7 @@ func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxBySenderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10808,17 +14097,17 @@ func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxBySenderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxBySenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.ChainID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10828,16 +14117,29 @@ func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainID |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10847,11 +14149,28 @@ func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10873,7 +14192,7 @@ func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxByReceiverRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10896,15 +14215,47 @@ func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxByReceiverRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxByReceiverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10931,10 +14282,10 @@ func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CrossChainTx == nil {
-				m.CrossChainTx = &CrossChainTx{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.CrossChainTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10959,7 +14310,7 @@ func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxByStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10982,13 +14333,32 @@ func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxByStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CctxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -11045,7 +14415,7 @@ func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxBySenderChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11068,17 +14438,17 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxBySenderChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxBySenderChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChainId", wireType)
 			}
-			var msglen int
+			m.SenderChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11088,26 +14458,11 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SenderChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
-			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -11138,7 +14493,7 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -11165,7 +14520,7 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCctxPendingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxByHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11188,17 +14543,17 @@ func (m *QueryAllCctxPendingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCctxPendingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxByHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCctxPendingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.ChainId = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11208,12 +14563,31 @@ func (m *QueryAllCctxPendingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -11270,7 +14644,7 @@ func (m *QueryAllCctxPendingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCctxPendingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11293,15 +14667,65 @@ func (m *QueryAllCctxPendingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCctxPendingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCctxPendingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11328,14 +14752,14 @@ func (m *QueryAllCctxPendingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
-			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11362,10 +14786,8 @@ func (m *QueryAllCctxPendingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Windows = append(m.Windows, RateLimitWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// RateLimitKey returns the store key of the rate limit of a chain, or of a foreign coin of the chain if the ZRC20
+// address is not empty
+func RateLimitKey(chainID int64, zrc20Address string) []byte {
+	if zrc20Address != "" {
+		zrc20Address = ethcommon.HexToAddress(zrc20Address).Hex()
+	}
+	return KeyPrefix(fmt.Sprintf("%d-%s", chainID, zrc20Address))
+}
+
+// HasMaximum returns true if the rate limit limits the value or the number of CCTXs
+// a rate limit without maximum is removed
+func (m RateLimit) HasMaximum() bool {
+	return m.MaxCctxCount > 0 || (!m.MaxValue.IsNil() && !m.MaxValue.IsZero())
+}
+
+// Validate checks the rate limit is valid
+func (m RateLimit) Validate() error {
+	if m.Zrc20Address != "" && !ethcommon.IsHexAddress(m.Zrc20Address) {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid zrc20 address (%s)", m.Zrc20Address)
+	}
+	if !m.HasMaximum() {
+		return nil
+	}
+	if m.Window <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid window (%d)", m.Window)
+	}
+	if m.Zrc20Address == "" && !m.MaxValue.IsNil() && !m.MaxValue.IsZero() {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "max value can only be set for the rate limit of a foreign coin")
+	}
+	return nil
+}
@@ -2,8 +2,25 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// StatusTrigger is the message and the finalized ballot, if any, triggering a status transition
+type StatusTrigger struct {
+	MsgTypeURL  string
+	BallotIndex string
+}
+
+// TssMigrationHoldMessage is the status message of a cctx held for review while the funds of the current TSS are
+// migrated to a new TSS, the cctx is released once the new TSS becomes the current TSS
+const TssMigrationHoldMessage = "held until the migration of the TSS funds is completed"
+
+// IsHeldForTssMigration returns true if the cctx is held for review until the migration of the TSS funds is completed
+func (m Status) IsHeldForTssMigration() bool {
+	return m.Status == CctxStatus_PendingReview && m.StatusMessage == TssMigrationHoldMessage
+}
+
 // empty msg does not overwrite old status message
 func (m *Status) ChangeStatus(newStatus CctxStatus, msg string) {
 	if len(msg) > 0 {
@@ -18,6 +35,27 @@ func (m *Status) ChangeStatus(newStatus CctxStatus, msg string) {
 
 } //nolint:typecheck
 
+// TransitionStatus changes the status like ChangeStatus and appends the transition to the status transitions
+func (m *Status) TransitionStatus(ctx sdk.Context, newStatus CctxStatus, msg string, trigger StatusTrigger) StatusTransition {
+	oldStatus := m.Status
+	m.ChangeStatus(newStatus, msg)
+	// the status message is overwritten if the transition is invalid
+	if m.Status != newStatus {
+		msg = m.StatusMessage
+	}
+	transition := StatusTransition{
+		OldStatus:   oldStatus,
+		NewStatus:   m.Status,
+		Message:     msg,
+		ZetaHeight:  ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime().Unix(),
+		MsgTypeUrl:  trigger.MsgTypeURL,
+		BallotIndex: trigger.BallotIndex,
+	}
+	m.Transitions = append(m.Transitions, &transition)
+	return transition
+}
+
 func (m *Status) ValidateTransition(newStatus CctxStatus) bool {
 	stateTransitionMap := stateTransitionMap()
 	oldStatus := m.Status
@@ -40,18 +78,34 @@ func stateTransitionMap() map[CctxStatus][]CctxStatus {
 		CctxStatus_Aborted,
 		CctxStatus_OutboundMined, // EVM Deposit
 		CctxStatus_PendingRevert, // EVM Deposit contract call reverted; should refund
+		CctxStatus_PendingReview, // rate limit exceeded
 	}
 	stateTransitionMap[CctxStatus_PendingOutbound] = []CctxStatus{
 		CctxStatus_Aborted,
 		CctxStatus_PendingRevert,
 		CctxStatus_OutboundMined,
 		CctxStatus_Reverted,
+		CctxStatus_PendingReview, // rate limit exceeded
 	}
 
 	stateTransitionMap[CctxStatus_PendingRevert] = []CctxStatus{
 		CctxStatus_Aborted,
 		CctxStatus_OutboundMined,
 		CctxStatus_Reverted,
+		CctxStatus_PendingReview, // held until the migration of the TSS funds is completed
+	}
+
+	// an aborted cctx can be retried by the admin policy
+	stateTransitionMap[CctxStatus_Aborted] = []CctxStatus{
+		CctxStatus_PendingOutbound,
+		CctxStatus_PendingRevert,
+	}
+
+	// a cctx held for review is released by the admin policy to the status it was held from
+	stateTransitionMap[CctxStatus_PendingReview] = []CctxStatus{
+		CctxStatus_PendingInbound,
+		CctxStatus_PendingOutbound,
+		CctxStatus_PendingRevert,
 	}
 	return stateTransitionMap
 
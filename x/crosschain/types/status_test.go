@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestStatus_TransitionStatus(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeight(10)
+	trigger := types.StatusTrigger{MsgTypeURL: sdk.MsgTypeURL(&types.MsgVoteOnObservedInboundTx{}), BallotIndex: "ballot"}
+
+	t.Run("valid transitions are appended", func(t *testing.T) {
+		status := types.Status{Status: types.CctxStatus_PendingInbound}
+		transition := status.TransitionStatus(ctx, types.CctxStatus_PendingOutbound, "", trigger)
+		require.Equal(t, types.CctxStatus_PendingOutbound, status.Status)
+		require.Equal(t, types.StatusTransition{
+			OldStatus:   types.CctxStatus_PendingInbound,
+			NewStatus:   types.CctxStatus_PendingOutbound,
+			ZetaHeight:  10,
+			BlockTime:   ctx.BlockTime().Unix(),
+			MsgTypeUrl:  trigger.MsgTypeURL,
+			BallotIndex: "ballot",
+		}, transition)
+
+		status.TransitionStatus(ctx, types.CctxStatus_OutboundMined, "mined", types.StatusTrigger{})
+		require.Len(t, status.Transitions, 2)
+		require.Equal(t, transition, *status.Transitions[0])
+		require.Equal(t, types.CctxStatus_OutboundMined, status.Transitions[1].NewStatus)
+		require.Equal(t, "mined", status.Transitions[1].Message)
+	})
+
+	t.Run("invalid transitions are recorded as aborted", func(t *testing.T) {
+		status := types.Status{Status: types.CctxStatus_OutboundMined}
+		transition := status.TransitionStatus(ctx, types.CctxStatus_PendingOutbound, "retry", trigger)
+		require.Equal(t, types.CctxStatus_Aborted, status.Status)
+		require.Equal(t, types.CctxStatus_OutboundMined, transition.OldStatus)
+		require.Equal(t, types.CctxStatus_Aborted, transition.NewStatus)
+		require.Equal(t, status.StatusMessage, transition.Message)
+		require.Len(t, status.Transitions, 1)
+	})
+}
@@ -100,32 +100,101 @@ func (m *TSS) GetKeyGenZetaHeight() int64 {
 	return 0
 }
 
+// TssFundMigratorInfo is a cctx migrating the funds of the current TSS to the TSS pending migration on a chain
+type TssFundMigratorInfo struct {
+	ChainId            int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	MigrationCctxIndex string `protobuf:"bytes,2,opt,name=migration_cctx_index,json=migrationCctxIndex,proto3" json:"migration_cctx_index,omitempty"`
+	// number of times the aborted migration cctx has been re-created, an aborted migration cctx is no longer re-created
+	// after the maximum number of retries
+	RetryCount uint64 `protobuf:"varint,3,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+}
+
+func (m *TssFundMigratorInfo) Reset()         { *m = TssFundMigratorInfo{} }
+func (m *TssFundMigratorInfo) String() string { return proto.CompactTextString(m) }
+func (*TssFundMigratorInfo) ProtoMessage()    {}
+func (*TssFundMigratorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8ccd105b767be6, []int{1}
+}
+func (m *TssFundMigratorInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TssFundMigratorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TssFundMigratorInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TssFundMigratorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TssFundMigratorInfo.Merge(m, src)
+}
+func (m *TssFundMigratorInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TssFundMigratorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TssFundMigratorInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TssFundMigratorInfo proto.InternalMessageInfo
+
+func (m *TssFundMigratorInfo) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *TssFundMigratorInfo) GetMigrationCctxIndex() string {
+	if m != nil {
+		return m.MigrationCctxIndex
+	}
+	return ""
+}
+
+func (m *TssFundMigratorInfo) GetRetryCount() uint64 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TSS)(nil), "zetachain.zetacore.crosschain.TSS")
+	proto.RegisterType((*TssFundMigratorInfo)(nil), "zetachain.zetacore.crosschain.TssFundMigratorInfo")
 }
 
 func init() { proto.RegisterFile("crosschain/tss.proto", fileDescriptor_ba8ccd105b767be6) }
 
 var fileDescriptor_ba8ccd105b767be6 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x46, 0x3b, 0xe4, 0xff, 0x2b, 0x9d, 0x95, 0x4c, 0x2b, 0x04, 0xa1, 0x43, 0x70, 0x15, 0x04,
-	0x93, 0xaa, 0x4f, 0xa0, 0x1b, 0x05, 0x5d, 0x48, 0xea, 0xaa, 0x9b, 0x30, 0x49, 0xc6, 0x64, 0x68,
-	0xcd, 0x84, 0xb9, 0xb7, 0x60, 0xfa, 0x14, 0x3e, 0x96, 0xcb, 0x2e, 0x5d, 0x4a, 0xb2, 0xf1, 0x31,
-	0x24, 0x13, 0x4a, 0x0b, 0xba, 0xbb, 0xdc, 0x73, 0xbe, 0xcd, 0xa1, 0x93, 0xd4, 0x68, 0x80, 0xb4,
-	0x10, 0xaa, 0x0c, 0x11, 0x20, 0xa8, 0x8c, 0x46, 0xcd, 0xa6, 0x1b, 0x89, 0xc2, 0x3e, 0x03, 0x7b,
-	0x69, 0x23, 0x83, 0xbd, 0x78, 0x3a, 0xc9, 0x75, 0xae, 0xad, 0x19, 0x76, 0x57, 0x3f, 0x3a, 0xfb,
-	0x26, 0xd4, 0x79, 0x9e, 0xcf, 0xd9, 0x94, 0x52, 0x04, 0x88, 0xab, 0x75, 0xb2, 0x94, 0xb5, 0xeb,
-	0x78, 0xc4, 0x1f, 0x45, 0x23, 0x04, 0x78, 0xb2, 0x0f, 0x36, 0xa3, 0x13, 0x8b, 0x85, 0x41, 0x95,
-	0xaa, 0x4a, 0x94, 0x18, 0xaf, 0x14, 0xa0, 0xfb, 0xcf, 0x73, 0xfc, 0x51, 0xc4, 0x3a, 0x71, 0x8f,
-	0x1e, 0x15, 0x20, 0xbb, 0xa2, 0x27, 0xba, 0x92, 0x46, 0xa0, 0x36, 0xb1, 0xc8, 0x32, 0x23, 0x01,
-	0xfa, 0xc9, 0x7f, 0x3b, 0x19, 0xef, 0xe0, 0x4d, 0xcf, 0xec, 0x66, 0x46, 0xc7, 0x2f, 0xaa, 0x14,
-	0x2b, 0xb5, 0x91, 0xd9, 0x42, 0xa2, 0xb8, 0x97, 0x2a, 0x2f, 0xd0, 0x1d, 0x7a, 0xc4, 0x77, 0xa2,
-	0xbf, 0x10, 0x3b, 0xa7, 0xc7, 0x4b, 0x59, 0xdf, 0xc9, 0xf2, 0x40, 0x3f, 0xb2, 0xfa, 0xaf, 0xff,
-	0xed, 0xc3, 0x47, 0xc3, 0xc9, 0xb6, 0xe1, 0xe4, 0xab, 0xe1, 0xe4, 0xbd, 0xe5, 0x83, 0x6d, 0xcb,
-	0x07, 0x9f, 0x2d, 0x1f, 0x2c, 0x2e, 0x73, 0x85, 0xc5, 0x3a, 0x09, 0x52, 0xfd, 0x1a, 0x76, 0xe9,
-	0x2e, 0xfa, 0xb4, 0xbb, 0x8a, 0xe1, 0x5b, 0x78, 0x18, 0xbc, 0xae, 0x24, 0x24, 0x43, 0x9b, 0xef,
-	0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x52, 0x84, 0x75, 0x8b, 0x01, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0xaa, 0xd3, 0x40,
+	0x18, 0x85, 0x3b, 0xe6, 0x7a, 0xaf, 0x1d, 0x37, 0x32, 0xad, 0x10, 0x85, 0x1b, 0xcb, 0x5d, 0x15,
+	0xa1, 0x49, 0xd1, 0x27, 0xd0, 0x82, 0xb5, 0xa0, 0x20, 0x69, 0x57, 0xdd, 0x84, 0xe9, 0xcc, 0x34,
+	0x1d, 0xda, 0xce, 0x84, 0x99, 0x3f, 0x90, 0x74, 0xeb, 0x0b, 0xf8, 0x58, 0x2e, 0xbb, 0x74, 0x29,
+	0xed, 0xc6, 0xc7, 0x90, 0xfc, 0x41, 0x5b, 0xd0, 0xdd, 0x9f, 0xf3, 0x9d, 0x43, 0x98, 0x73, 0x68,
+	0x5f, 0x38, 0xeb, 0xbd, 0xd8, 0x70, 0x6d, 0x12, 0xf0, 0x3e, 0x2e, 0x9c, 0x05, 0xcb, 0xee, 0x0f,
+	0x0a, 0x38, 0x8a, 0x31, 0x5e, 0xd6, 0xa9, 0xf8, 0x62, 0x7c, 0xd9, 0xcf, 0x6d, 0x6e, 0xd1, 0x99,
+	0x34, 0x57, 0x1b, 0x7a, 0xf8, 0x45, 0x68, 0xb0, 0x98, 0xcf, 0xd9, 0x3d, 0xa5, 0xe0, 0x7d, 0x56,
+	0x94, 0xab, 0xad, 0xaa, 0xc3, 0x60, 0x40, 0x86, 0xdd, 0xb4, 0x0b, 0xde, 0x7f, 0x41, 0x81, 0x8d,
+	0x69, 0x1f, 0x31, 0x77, 0xa0, 0x85, 0x2e, 0xb8, 0x81, 0x6c, 0xa7, 0x3d, 0x84, 0x37, 0x83, 0x60,
+	0xd8, 0x4d, 0x59, 0x63, 0xbc, 0xa0, 0x4f, 0xda, 0x03, 0x7b, 0x43, 0x9f, 0xdb, 0x42, 0x39, 0x0e,
+	0xd6, 0x65, 0x5c, 0x4a, 0xa7, 0xbc, 0x6f, 0x23, 0x8f, 0x31, 0xd2, 0xfb, 0x03, 0xdf, 0xb5, 0x0c,
+	0x33, 0x63, 0xda, 0x5b, 0x6b, 0xc3, 0x77, 0xfa, 0xa0, 0xe4, 0x52, 0x01, 0xff, 0xa8, 0x74, 0xbe,
+	0x81, 0xf0, 0x76, 0x40, 0x86, 0x41, 0xfa, 0x3f, 0xc4, 0x5e, 0xd3, 0x67, 0x5b, 0x55, 0x4f, 0x95,
+	0xb9, 0xb2, 0xdf, 0xa1, 0xfd, 0x1f, 0xfd, 0xe1, 0x2b, 0xa1, 0xbd, 0x85, 0xf7, 0x1f, 0x4a, 0x23,
+	0x3f, 0xeb, 0x1c, 0x7f, 0x3e, 0x33, 0x6b, 0xcb, 0x5e, 0xd0, 0x27, 0xd8, 0x50, 0xa6, 0x65, 0x48,
+	0x30, 0x7b, 0x87, 0xdf, 0x33, 0xd9, 0x3c, 0x7b, 0x8f, 0x56, 0x6d, 0x4d, 0x26, 0x04, 0x54, 0x99,
+	0x36, 0x52, 0x55, 0xe1, 0x23, 0xec, 0x87, 0xfd, 0x65, 0x13, 0x01, 0xd5, 0xac, 0x21, 0xec, 0x15,
+	0x7d, 0xea, 0x14, 0xb8, 0x3a, 0x13, 0xb6, 0x34, 0x80, 0x45, 0xde, 0xa4, 0x14, 0xa5, 0x49, 0xa3,
+	0xbc, 0x9f, 0x7e, 0x3f, 0x45, 0xe4, 0x78, 0x8a, 0xc8, 0xcf, 0x53, 0x44, 0xbe, 0x9d, 0xa3, 0xce,
+	0xf1, 0x1c, 0x75, 0x7e, 0x9c, 0xa3, 0xce, 0x72, 0x94, 0x6b, 0xd8, 0x94, 0xab, 0x58, 0xd8, 0x7d,
+	0xd2, 0x0c, 0x38, 0x6a, 0x07, 0x36, 0x56, 0xaa, 0xa4, 0x4a, 0xae, 0x27, 0xaf, 0x0b, 0xe5, 0x57,
+	0xb7, 0x38, 0xe0, 0xdb, 0xdf, 0x03, 0x00, 0xa6, 0x1e, 0x4f, 0x96, 0x0d, 0x02, 0x00, 0x00,
 }
 
 func (m *TSS) Marshal() (dAtA []byte, err error) {
@@ -186,6 +255,46 @@ func (m *TSS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TssFundMigratorInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TssFundMigratorInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TssFundMigratorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryCount != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.RetryCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MigrationCctxIndex) > 0 {
+		i -= len(m.MigrationCctxIndex)
+		copy(dAtA[i:], m.MigrationCctxIndex)
+		i = encodeVarintTss(dAtA, i, uint64(len(m.MigrationCctxIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTss(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTss(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTss(v)
 	base := offset
@@ -228,6 +337,25 @@ func (m *TSS) Size() (n int) {
 	return n
 }
 
+func (m *TssFundMigratorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovTss(uint64(m.ChainId))
+	}
+	l = len(m.MigrationCctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTss(uint64(l))
+	}
+	if m.RetryCount != 0 {
+		n += 1 + sovTss(uint64(m.RetryCount))
+	}
+	return n
+}
+
 func sovTss(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -418,6 +546,126 @@ func (m *TSS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TssFundMigratorInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTss
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TssFundMigratorInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TssFundMigratorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationCctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTss
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTss
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationCctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTss
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTss(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTss
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTss(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
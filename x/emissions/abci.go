@@ -8,6 +8,7 @@ import (
 	"github.com/zeta-chain/node/cmd/zetacored/config"
 	"github.com/zeta-chain/node/x/emissions/keeper"
 	"github.com/zeta-chain/node/x/emissions/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
 )
 
 func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
@@ -27,7 +28,7 @@ func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	if err != nil {
 		panic(err)
 	}
-	err = DistributeTssRewards(ctx, tssSignerRewards, keeper.GetBankKeeper())
+	err = DistributeTssRewards(ctx, tssSignerRewards, keeper)
 	if err != nil {
 		panic(err)
 	}
@@ -123,14 +124,65 @@ func DistributeObserverRewards(ctx sdk.Context, amount sdkmath.Int, keeper keepe
 		}
 	}
 	types.EmitObserverEmissions(ctx, finalDistributionList)
-	// TODO : Delete Ballots after distribution
-	// https://github.com/zeta-chain/node/issues/942
+	// the matured ballots are pruned by the observer module at the end of the block
 	return nil
 }
 
-// DistributeTssRewards trasferes the allocated rewards to the Undistributed Tss Rewards Pool.
-// This is done so that the reserves factor is properly calculated in the next block
-func DistributeTssRewards(ctx sdk.Context, amount sdk.Int, bankKeeper types.BankKeeper) error {
+// DistributeTssRewards transfers the allocated rewards to the Undistributed Tss Rewards Pool.
+// The balance of the pool is then split equally among the participants of the current TSS and credited to their TSS signer emissions,
+// a participant is credited through its node account, disabled nodes don't receive rewards
+// The credited amount stays in the Undistributed Tss Rewards Pool from which the TSS signer emissions are withdrawn
+func DistributeTssRewards(ctx sdk.Context, amount sdk.Int, keeper keeper.Keeper) error {
 	coin := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, amount))
-	return bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.UndistributedTssRewardsPool, coin)
+	err := keeper.GetBankKeeper().SendCoinsFromModuleToModule(ctx, types.ModuleName, types.UndistributedTssRewardsPool, coin)
+	if err != nil {
+		return err
+	}
+
+	// the rewards accumulate in the pool until there are signers to distribute them to
+	tss, found := keeper.GetCrosschainKeeper().GetTSS(ctx)
+	if !found {
+		return nil
+	}
+	nodeAccounts := make(map[string]observertypes.NodeAccount)
+	for _, nodeAccount := range keeper.GetObserverKeeper().GetAllNodeAccount(ctx) {
+		if nodeAccount.GranteePubkey != nil {
+			nodeAccounts[nodeAccount.GranteePubkey.Secp256k1.String()] = nodeAccount
+		}
+	}
+	signerSet := make(map[string]bool)
+	for _, participant := range tss.TssParticipantList {
+		nodeAccount, found := nodeAccounts[participant]
+		if !found || nodeAccount.NodeStatus == observertypes.NodeStatus_Disabled {
+			continue
+		}
+		signerSet[nodeAccount.Operator] = true
+	}
+	if len(signerSet) == 0 {
+		return nil
+	}
+	signers := make([]string, 0, len(signerSet))
+	for signer := range signerSet {
+		signers = append(signers, signer)
+	}
+	sort.Strings(signers)
+
+	// the pool also holds the credited emissions that have not been withdrawn yet
+	credited := sdkmath.ZeroInt()
+	for _, we := range keeper.GetAllWithdrawableTssEmission(ctx) {
+		credited = credited.Add(we.Amount)
+	}
+	poolBalance := keeper.GetBankKeeper().GetBalance(ctx, types.UndistributedTssRewardsPoolAddress, config.BaseDenom)
+	if poolBalance.Amount.LTE(credited) {
+		return nil
+	}
+	amountPerSigner := poolBalance.Amount.Sub(credited).Quo(sdkmath.NewInt(int64(len(signers))))
+	if !amountPerSigner.IsPositive() {
+		return nil
+	}
+	for _, signer := range signers {
+		keeper.AddTssEmission(ctx, signer, amountPerSigner)
+	}
+	types.EmitTssEmissions(ctx, signers, amountPerSigner)
+	return nil
 }
@@ -19,9 +19,11 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 	zetaapp "github.com/zeta-chain/node/app"
 	"github.com/zeta-chain/node/cmd/zetacored/config"
+	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/testutil/simapp"
 	emissionsModule "github.com/zeta-chain/node/x/emissions"
 	emissionsModuleTypes "github.com/zeta-chain/node/x/emissions/types"
+	observerTypes "github.com/zeta-chain/node/x/observer/types"
 )
 
 func getaZetaFromString(amount string) sdk.Coins {
@@ -250,3 +252,68 @@ func GenerateSampleFile(fp string, data []EmissionTestData) {
 	file, _ := json.MarshalIndent(data, "", " ")
 	_ = ioutil.WriteFile(fp, file, 0600)
 }
+
+func TestDistributeTssRewards(t *testing.T) {
+	t.Run("rewards are split among the tss participants", func(t *testing.T) {
+		app, ctx, _, minter := SetupApp(t, emissionsModuleTypes.DefaultParams(), getaZetaFromString("1000"))
+		err := app.BankKeeper.SendCoinsFromAccountToModule(ctx, minter.GetAddress(), emissionsModuleTypes.ModuleName, getaZetaFromString("1000"))
+		assert.NoError(t, err)
+
+		// the first two nodes are active tss participants, the third one is disabled and the last one is not a participant
+		tss := sample.Tss()
+		var nodeAccounts []*observerTypes.NodeAccount
+		for i := 0; i < 4; i++ {
+			nodeAccount := sample.NodeAccount()
+			if i == 2 {
+				nodeAccount.NodeStatus = observerTypes.NodeStatus_Disabled
+			}
+			if i < 3 {
+				tss.TssParticipantList = append(tss.TssParticipantList, nodeAccount.GranteePubkey.Secp256k1.String())
+			}
+			app.ZetaObserverKeeper.SetNodeAccount(ctx, *nodeAccount)
+			nodeAccounts = append(nodeAccounts, nodeAccount)
+		}
+		app.ZetaCoreKeeper.SetTSS(ctx, *tss)
+
+		err = emissionsModule.DistributeTssRewards(ctx, sdk.NewInt(101), app.EmissionsKeeper)
+		assert.NoError(t, err)
+		for _, nodeAccount := range nodeAccounts[:2] {
+			we, found := app.EmissionsKeeper.GetWithdrawableTssEmission(ctx, nodeAccount.Operator)
+			assert.True(t, found)
+			assert.Equal(t, sdk.NewInt(50), we.Amount)
+		}
+		for _, nodeAccount := range nodeAccounts[2:] {
+			_, found := app.EmissionsKeeper.GetWithdrawableTssEmission(ctx, nodeAccount.Operator)
+			assert.False(t, found)
+		}
+		// the tss rewards are not credited as observer rewards
+		assert.Empty(t, app.EmissionsKeeper.GetAllWithdrawableEmission(ctx))
+
+		// the credited rewards and the remainder stay in the tss pool
+		tssPool := app.BankKeeper.GetBalance(ctx, emissionsModuleTypes.UndistributedTssRewardsPoolAddress, config.BaseDenom)
+		assert.Equal(t, sdk.NewInt(101), tssPool.Amount)
+		observerPool := app.BankKeeper.GetBalance(ctx, emissionsModuleTypes.UndistributedObserverRewardsPoolAddress, config.BaseDenom)
+		assert.True(t, observerPool.Amount.IsZero())
+
+		// the credited rewards are not distributed again
+		err = emissionsModule.DistributeTssRewards(ctx, sdk.NewInt(99), app.EmissionsKeeper)
+		assert.NoError(t, err)
+		for _, nodeAccount := range nodeAccounts[:2] {
+			we, _ := app.EmissionsKeeper.GetWithdrawableTssEmission(ctx, nodeAccount.Operator)
+			assert.Equal(t, sdk.NewInt(100), we.Amount)
+		}
+	})
+
+	t.Run("rewards accumulate if there is no tss", func(t *testing.T) {
+		app, ctx, _, minter := SetupApp(t, emissionsModuleTypes.DefaultParams(), getaZetaFromString("1000"))
+		err := app.BankKeeper.SendCoinsFromAccountToModule(ctx, minter.GetAddress(), emissionsModuleTypes.ModuleName, getaZetaFromString("1000"))
+		assert.NoError(t, err)
+		app.ZetaObserverKeeper.SetNodeAccount(ctx, *sample.NodeAccount())
+
+		err = emissionsModule.DistributeTssRewards(ctx, sdk.NewInt(100), app.EmissionsKeeper)
+		assert.NoError(t, err)
+		tssPool := app.BankKeeper.GetBalance(ctx, emissionsModuleTypes.UndistributedTssRewardsPoolAddress, config.BaseDenom)
+		assert.Equal(t, sdk.NewInt(100), tssPool.Amount)
+		assert.Empty(t, app.EmissionsKeeper.GetAllWithdrawableTssEmission(ctx))
+	})
+}
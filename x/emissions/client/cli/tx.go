@@ -20,5 +20,9 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(
+		CmdWithdrawEmission(),
+	)
+
 	return cmd
 }
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/x/emissions/types"
+)
+
+func CmdWithdrawEmission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-emission [amount]",
+		Short: "Broadcast message withdrawEmission to withdraw the accumulated emissions, 0 withdraws all",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, ok := sdkmath.NewIntFromString(args[0])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[0])
+			}
+
+			msg := types.NewMsgWithdrawEmission(
+				clientCtx.GetFromAddress().String(),
+				amount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
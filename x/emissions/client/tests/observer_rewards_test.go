@@ -70,6 +70,13 @@ func (s *CliTestSuite) TestObserverRewards() {
 		s.Require().Equal(sdk.NewCoin(config.BaseDenom, asertValues[s.network.Validators[i].Address.String()]).String(), resAvailable.Amount, "Validator %s has incorrect withdrawable rewards", s.network.Validators[i].Address.String())
 	}
 
+	// TSS signer rewards are only credited to the TSS participants, the validators are not participants of a TSS in this network
+	// so they are not part of the withdrawable rewards asserted above and accumulate in the undistributed TSS rewards pool
+	tssPoolBalance := sdk.Coin{}
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetBalancesCmd(), []string{resPools.UndistributedTssBalancesAddress, fmt.Sprintf("--%s=%s", cli.FlagDenom, config.BaseDenom), "--output", "json"})
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &tssPoolBalance))
+	s.Require().True(tssPoolBalance.Amount.IsPositive(), "TSS signer rewards should accumulate in the undistributed TSS rewards pool")
 }
 
 func CalculateObserverRewards(ballots []*observerTypes.Ballot, observerEmissionPercentage, reservesFactor, bondFactor, durationFactor string) map[string]sdkmath.Int {
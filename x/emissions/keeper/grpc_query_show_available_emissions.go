@@ -9,15 +9,17 @@ import (
 	"github.com/zeta-chain/node/x/emissions/types"
 )
 
+// ShowAvailableEmissions returns the emissions an address can withdraw, observer and TSS signer emissions combined
 func (k Keeper) ShowAvailableEmissions(goCtx context.Context, req *types.QueryShowAvailableEmissionsRequest) (*types.QueryShowAvailableEmissionsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	emissions, found := k.GetWithdrawableEmission(ctx, req.Address)
-	if !found {
-		return &types.QueryShowAvailableEmissionsResponse{
-			Amount: sdk.NewCoin(config.BaseDenom, sdk.ZeroInt()).String(),
-		}, nil
+	amount := sdk.ZeroInt()
+	if emissions, found := k.GetWithdrawableEmission(ctx, req.Address); found {
+		amount = amount.Add(emissions.Amount)
+	}
+	if emissions, found := k.GetWithdrawableTssEmission(ctx, req.Address); found {
+		amount = amount.Add(emissions.Amount)
 	}
 	return &types.QueryShowAvailableEmissionsResponse{
-		Amount: sdk.NewCoin(config.BaseDenom, emissions.Amount).String(),
+		Amount: sdk.NewCoin(config.BaseDenom, amount).String(),
 	}, nil
 }
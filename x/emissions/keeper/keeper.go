@@ -22,6 +22,7 @@ type (
 		bankKeeper       types.BankKeeper
 		stakingKeeper    types.StakingKeeper
 		observerKeeper   types.ZetaObserverKeeper
+		crosschainKeeper types.ZetaCrosschainKeeper
 	}
 )
 
@@ -34,7 +35,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
 	observerKeeper types.ZetaObserverKeeper,
-
+	crosschainKeeper types.ZetaCrosschainKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -51,6 +52,7 @@ func NewKeeper(
 		bankKeeper:       bankKeeper,
 		stakingKeeper:    stakingKeeper,
 		observerKeeper:   observerKeeper,
+		crosschainKeeper: crosschainKeeper,
 	}
 }
 
@@ -73,3 +75,7 @@ func (k Keeper) GetStakingKeeper() types.StakingKeeper {
 func (k Keeper) GetObserverKeeper() types.ZetaObserverKeeper {
 	return k.observerKeeper
 }
+
+func (k Keeper) GetCrosschainKeeper() types.ZetaCrosschainKeeper {
+	return k.crosschainKeeper
+}
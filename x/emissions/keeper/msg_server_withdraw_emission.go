@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	"github.com/zeta-chain/node/x/emissions/types"
+)
+
+// WithdrawEmission withdraws the emissions accumulated by an observer or a TSS signer
+// the observer emissions are withdrawn first from the undistributed observer rewards pool, the remaining amount is
+// withdrawn from the TSS signer emissions held by the undistributed TSS rewards pool
+// a zero amount withdraws all the emissions
+func (k msgServer) WithdrawEmission(goCtx context.Context, msg *types.MsgWithdrawEmission) (*types.MsgWithdrawEmissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	observerEmission, observerFound := k.GetWithdrawableEmission(ctx, msg.Creator)
+	tssEmission, tssFound := k.GetWithdrawableTssEmission(ctx, msg.Creator)
+	if !observerFound && !tssFound {
+		return nil, cosmoserrors.Wrapf(types.ErrEmissionsNotFound, "address %s", msg.Creator)
+	}
+	observerAmount, tssAmount := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	if observerFound {
+		observerAmount = observerEmission.Amount
+	}
+	if tssFound {
+		tssAmount = tssEmission.Amount
+	}
+	total := observerAmount.Add(tssAmount)
+
+	amount := msg.Amount
+	if msg.IsFullWithdrawal() {
+		amount = total
+	}
+	if !amount.IsPositive() {
+		return nil, cosmoserrors.Wrapf(types.ErrEmissionsNotFound, "address %s has no emissions to withdraw", msg.Creator)
+	}
+	if amount.GT(total) {
+		return nil, cosmoserrors.Wrapf(types.ErrInsufficientEmissions, "withdrawable emissions %s, requested %s", total, amount)
+	}
+	fromObserver := sdkmath.MinInt(amount, observerAmount)
+	fromTss := amount.Sub(fromObserver)
+
+	// the pools must hold enough funds to cover the withdrawal
+	if err := k.checkPoolBalance(ctx, types.UndistributedObserverRewardsPoolAddress, fromObserver); err != nil {
+		return nil, err
+	}
+	if err := k.checkPoolBalance(ctx, types.UndistributedTssRewardsPoolAddress, fromTss); err != nil {
+		return nil, err
+	}
+
+	withdrawAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if fromObserver.IsPositive() {
+		if err := k.RemoveObserverEmission(ctx, msg.Creator, fromObserver); err != nil {
+			return nil, err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, fromObserver))
+		if err := k.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, types.UndistributedObserverRewardsPool, withdrawAddress, coins); err != nil {
+			return nil, err
+		}
+	}
+	if fromTss.IsPositive() {
+		if err := k.RemoveTssEmission(ctx, msg.Creator, fromTss); err != nil {
+			return nil, err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, fromTss))
+		if err := k.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, types.UndistributedTssRewardsPool, withdrawAddress, coins); err != nil {
+			return nil, err
+		}
+	}
+
+	types.EmitEmissionWithdrawn(ctx, sdk.MsgTypeURL(msg), msg.Creator, amount, total.Sub(amount))
+	return &types.MsgWithdrawEmissionResponse{}, nil
+}
+
+// checkPoolBalance returns an error if the rewards pool balance doesn't cover the amount
+func (k msgServer) checkPoolBalance(ctx sdk.Context, poolAddress sdk.AccAddress, amount sdkmath.Int) error {
+	poolBalance := k.GetBankKeeper().GetBalance(ctx, poolAddress, config.BaseDenom)
+	if poolBalance.Amount.LT(amount) {
+		return cosmoserrors.Wrapf(types.ErrRewardsPoolInsufficient, "pool balance %s, requested %s", poolBalance.Amount, amount)
+	}
+	return nil
+}
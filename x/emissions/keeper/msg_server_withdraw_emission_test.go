@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	zetaapp "github.com/zeta-chain/node/app"
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/testutil/simapp"
+	"github.com/zeta-chain/node/x/emissions/keeper"
+	"github.com/zeta-chain/node/x/emissions/types"
+)
+
+// setupWithdrawApp returns an app with the given amount in the undistributed observer rewards pool
+func setupWithdrawApp(t *testing.T, poolAmount int64) (*zetaapp.App, sdk.Context) {
+	pk := ed25519.GenPrivKey().PubKey()
+	acc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(pk.Address()))
+	coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdkmath.NewInt(poolAmount)))
+	vset := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(ed25519.GenPrivKey().PubKey(), 1)})
+	app := simapp.SetupWithGenesisValSet(t, vset, authtypes.GenesisAccounts{acc}, sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction),
+		types.DefaultParams(), []banktypes.Balance{{Address: acc.GetAddress().String(), Coins: coins}}, nil)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	if poolAmount > 0 {
+		err := app.BankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.UndistributedObserverRewardsPool, coins)
+		require.NoError(t, err)
+	}
+	return app, ctx
+}
+
+func TestMsgServer_WithdrawEmission(t *testing.T) {
+	t.Run("partial withdrawal", func(t *testing.T) {
+		app, ctx := setupWithdrawApp(t, 1000)
+		srv := keeper.NewMsgServerImpl(app.EmissionsKeeper)
+		observer := sample.AccAddress()
+		app.EmissionsKeeper.AddObserverEmission(ctx, observer, sdkmath.NewInt(100))
+
+		_, err := srv.WithdrawEmission(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawEmission(observer, sdkmath.NewInt(40)))
+		require.NoError(t, err)
+
+		we, found := app.EmissionsKeeper.GetWithdrawableEmission(ctx, observer)
+		require.True(t, found)
+		require.Equal(t, sdkmath.NewInt(60), we.Amount)
+		balance := app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(observer), config.BaseDenom)
+		require.Equal(t, sdkmath.NewInt(40), balance.Amount)
+	})
+
+	t.Run("full withdrawal with zero amount", func(t *testing.T) {
+		app, ctx := setupWithdrawApp(t, 1000)
+		srv := keeper.NewMsgServerImpl(app.EmissionsKeeper)
+		observer := sample.AccAddress()
+		app.EmissionsKeeper.AddObserverEmission(ctx, observer, sdkmath.NewInt(100))
+
+		_, err := srv.WithdrawEmission(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawEmission(observer, sdkmath.ZeroInt()))
+		require.NoError(t, err)
+
+		we, found := app.EmissionsKeeper.GetWithdrawableEmission(ctx, observer)
+		require.True(t, found)
+		require.True(t, we.Amount.IsZero())
+		balance := app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(observer), config.BaseDenom)
+		require.Equal(t, sdkmath.NewInt(100), balance.Amount)
+
+		// nothing left to withdraw
+		_, err = srv.WithdrawEmission(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawEmission(observer, sdkmath.ZeroInt()))
+		require.ErrorIs(t, err, types.ErrEmissionsNotFound)
+	})
+
+	t.Run("fail if no emissions", func(t *testing.T) {
+		app, ctx := setupWithdrawApp(t, 1000)
+		srv := keeper.NewMsgServerImpl(app.EmissionsKeeper)
+
+		_, err := srv.WithdrawEmission(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawEmission(sample.AccAddress(), sdkmath.NewInt(1)))
+		require.ErrorIs(t, err, types.ErrEmissionsNotFound)
+	})
+
+	t.Run("fail if amount exceeds emissions", func(t *testing.T) {
+		app, ctx := setupWithdrawApp(t, 1000)
+		srv := keeper.NewMsgServerImpl(app.EmissionsKeeper)
+		observer := sample.AccAddress()
+		app.EmissionsKeeper.AddObserverEmission(ctx, observer, sdkmath.NewInt(100))
+
+		_, err := srv.WithdrawEmission(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawEmission(observer, sdkmath.NewInt(101)))
+		require.ErrorIs(t, err, types.ErrInsufficientEmissions)
+	})
+
+	t.Run("fail if pool balance is insufficient", func(t *testing.T) {
+		app, ctx := setupWithdrawApp(t, 50)
+		srv := keeper.NewMsgServerImpl(app.EmissionsKeeper)
+		observer := sample.AccAddress()
+		app.EmissionsKeeper.AddObserverEmission(ctx, observer, sdkmath.NewInt(100))
+
+		_, err := srv.WithdrawEmission(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawEmission(observer, sdkmath.NewInt(100)))
+		require.ErrorIs(t, err, types.ErrRewardsPoolInsufficient)
+
+		we, _ := app.EmissionsKeeper.GetWithdrawableEmission(ctx, observer)
+		require.Equal(t, sdkmath.NewInt(100), we.Amount)
+	})
+}
+
+func TestMsgServer_WithdrawTssEmission(t *testing.T) {
+	t.Run("observer emissions are withdrawn before tss signer emissions", func(t *testing.T) {
+		app, ctx := setupWithdrawApp(t, 1000)
+		srv := keeper.NewMsgServerImpl(app.EmissionsKeeper)
+		signer := sample.AccAddress()
+		app.EmissionsKeeper.AddObserverEmission(ctx, signer, sdkmath.NewInt(100))
+		app.EmissionsKeeper.AddTssEmission(ctx, signer, sdkmath.NewInt(50))
+		err := app.BankKeeper.SendCoinsFromModuleToModule(ctx, types.UndistributedObserverRewardsPool, types.UndistributedTssRewardsPool,
+			sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdkmath.NewInt(50))))
+		require.NoError(t, err)
+
+		_, err = srv.WithdrawEmission(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawEmission(signer, sdkmath.NewInt(120)))
+		require.NoError(t, err)
+
+		we, found := app.EmissionsKeeper.GetWithdrawableEmission(ctx, signer)
+		require.True(t, found)
+		require.True(t, we.Amount.IsZero())
+		we, found = app.EmissionsKeeper.GetWithdrawableTssEmission(ctx, signer)
+		require.True(t, found)
+		require.Equal(t, sdkmath.NewInt(30), we.Amount)
+		tssPool := app.BankKeeper.GetBalance(ctx, types.UndistributedTssRewardsPoolAddress, config.BaseDenom)
+		require.Equal(t, sdkmath.NewInt(30), tssPool.Amount)
+		balance := app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(signer), config.BaseDenom)
+		require.Equal(t, sdkmath.NewInt(120), balance.Amount)
+	})
+
+	t.Run("fail if tss pool balance is insufficient", func(t *testing.T) {
+		app, ctx := setupWithdrawApp(t, 1000)
+		srv := keeper.NewMsgServerImpl(app.EmissionsKeeper)
+		signer := sample.AccAddress()
+		app.EmissionsKeeper.AddTssEmission(ctx, signer, sdkmath.NewInt(50))
+
+		_, err := srv.WithdrawEmission(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawEmission(signer, sdkmath.ZeroInt()))
+		require.ErrorIs(t, err, types.ErrRewardsPoolInsufficient)
+
+		we, _ := app.EmissionsKeeper.GetWithdrawableTssEmission(ctx, signer)
+		require.Equal(t, sdkmath.NewInt(50), we.Amount)
+	})
+}
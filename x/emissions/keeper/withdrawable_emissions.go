@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cosmoserrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -65,3 +66,76 @@ func (k Keeper) SlashObserverEmission(ctx sdk.Context, address string, slashAmou
 	}
 	k.SetWithdrawableEmission(ctx, we)
 }
+
+// RemoveObserverEmission removes the given amount from the withdrawable emissions of an address
+// the amount must not exceed the withdrawable emissions of the address
+func (k Keeper) RemoveObserverEmission(ctx sdk.Context, address string, amount sdkmath.Int) error {
+	we, found := k.GetWithdrawableEmission(ctx, address)
+	if !found {
+		return types.ErrEmissionsNotFound
+	}
+	if amount.GT(we.Amount) {
+		return cosmoserrors.Wrapf(types.ErrInsufficientEmissions, "withdrawable emissions %s, requested %s", we.Amount, amount)
+	}
+	we.Amount = we.Amount.Sub(amount)
+	k.SetWithdrawableEmission(ctx, we)
+	return nil
+}
+
+// SetWithdrawableTssEmission sets the emissions of a TSS signer, TSS signer rewards are recorded separately from the observer rewards
+func (k Keeper) SetWithdrawableTssEmission(ctx sdk.Context, we types.WithdrawableEmissions) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawableTssEmissionsKey))
+	b := k.cdc.MustMarshal(&we)
+	store.Set([]byte(we.Address), b)
+}
+
+// GetWithdrawableTssEmission returns the emissions of a TSS signer
+func (k Keeper) GetWithdrawableTssEmission(ctx sdk.Context, address string) (val types.WithdrawableEmissions, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawableTssEmissionsKey))
+	b := store.Get([]byte(address))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllWithdrawableTssEmission returns the emissions of all the TSS signers
+func (k Keeper) GetAllWithdrawableTssEmission(ctx sdk.Context) (list []types.WithdrawableEmissions) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.WithdrawableTssEmissionsKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.WithdrawableEmissions
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// AddTssEmission credits the rewards of a TSS signer, the rewards are held by the undistributed TSS rewards pool
+func (k Keeper) AddTssEmission(ctx sdk.Context, address string, amount sdkmath.Int) {
+	we, found := k.GetWithdrawableTssEmission(ctx, address)
+	if !found {
+		we = types.WithdrawableEmissions{Address: address, Amount: sdkmath.ZeroInt()}
+	}
+	we.Amount = we.Amount.Add(amount)
+	k.SetWithdrawableTssEmission(ctx, we)
+}
+
+// RemoveTssEmission removes the given amount from the TSS signer emissions of an address
+// the amount must not exceed the TSS signer emissions of the address
+func (k Keeper) RemoveTssEmission(ctx sdk.Context, address string, amount sdkmath.Int) error {
+	we, found := k.GetWithdrawableTssEmission(ctx, address)
+	if !found {
+		return types.ErrEmissionsNotFound
+	}
+	if amount.GT(we.Amount) {
+		return cosmoserrors.Wrapf(types.ErrInsufficientEmissions, "withdrawable tss emissions %s, requested %s", we.Amount, amount)
+	}
+	we.Amount = we.Amount.Sub(amount)
+	k.SetWithdrawableTssEmission(ctx, we)
+	return nil
+}
@@ -11,4 +11,8 @@ var (
 	ErrEmissionTrackerNotFound = sdkerrors.Register(ModuleName, 1100, "Emission Tracker Not found")
 	ErrParsingSenderAddress    = sdkerrors.Register(ModuleName, 1101, "Unable to parse address of sender")
 	ErrAddingCoinstoTracker    = sdkerrors.Register(ModuleName, 1102, "Unable to add coins to emissionTracker ")
+	ErrInvalidAmount           = sdkerrors.Register(ModuleName, 1103, "Invalid amount")
+	ErrEmissionsNotFound       = sdkerrors.Register(ModuleName, 1104, "No withdrawable emissions found")
+	ErrInsufficientEmissions   = sdkerrors.Register(ModuleName, 1105, "Amount exceeds the withdrawable emissions")
+	ErrRewardsPoolInsufficient = sdkerrors.Register(ModuleName, 1106, "Rewards pool does not have enough balance")
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -28,3 +29,26 @@ func EmitObserverEmissions(ctx sdk.Context, em []*ObserverEmission) {
 		ctx.Logger().Error("Error emitting ObserverEmissions :", err)
 	}
 }
+
+func EmitEmissionWithdrawn(ctx sdk.Context, msgTypeURL string, address string, amount, remaining sdkmath.Int) {
+	err := ctx.EventManager().EmitTypedEvents(&EventEmissionWithdrawn{
+		MsgTypeUrl:      msgTypeURL,
+		WithdrawAddress: address,
+		Amount:          amount,
+		RemainingAmount: remaining,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EmissionWithdrawn :", err)
+	}
+}
+
+func EmitTssEmissions(ctx sdk.Context, signers []string, amountPerSigner sdkmath.Int) {
+	err := ctx.EventManager().EmitTypedEvents(&EventTssEmissions{
+		MsgTypeUrl:      "/zetachain.zetacore.emissions.internal.TssEmissions",
+		Signers:         signers,
+		AmountPerSigner: amountPerSigner,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting TssEmissions :", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/zeta-chain/node/common"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	zetaObserverTypes "github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -24,6 +25,11 @@ type ZetaObserverKeeper interface {
 	GetParams(ctx sdk.Context) (params zetaObserverTypes.Params)
 	GetCoreParamsByChainID(ctx sdk.Context, chainID int64) (params *zetaObserverTypes.CoreParams, found bool)
 	GetMaturedBallotList(ctx sdk.Context) []string
+	GetAllNodeAccount(ctx sdk.Context) (nodeAccounts []zetaObserverTypes.NodeAccount)
+}
+
+type ZetaCrosschainKeeper interface {
+	GetTSS(ctx sdk.Context) (val crosschaintypes.TSS, found bool)
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
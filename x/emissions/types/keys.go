@@ -20,8 +20,9 @@ const (
 	QuerierRoute = ModuleName
 
 	// MemStoreKey defines the in-memory store key
-	MemStoreKey              = "mem_emissions"
-	WithdrawableEmissionsKey = "WithdrawableEmissions-value-"
+	MemStoreKey                 = "mem_emissions"
+	WithdrawableEmissionsKey    = "WithdrawableEmissions-value-"
+	WithdrawableTssEmissionsKey = "WithdrawableTssEmissions-value-"
 
 	SecsInMonth = 30 * 24 * 60 * 60
 )
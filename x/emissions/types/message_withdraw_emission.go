@@ -0,0 +1,56 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgWithdrawEmission = "withdraw_emission"
+
+var _ sdk.Msg = &MsgWithdrawEmission{}
+
+// NewMsgWithdrawEmission creates a new MsgWithdrawEmission, a zero amount withdraws all the available emissions
+func NewMsgWithdrawEmission(creator string, amount sdkmath.Int) *MsgWithdrawEmission {
+	return &MsgWithdrawEmission{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgWithdrawEmission) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawEmission) Type() string {
+	return TypeMsgWithdrawEmission
+}
+
+func (msg *MsgWithdrawEmission) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWithdrawEmission) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgWithdrawEmission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+		return cosmoserrors.Wrapf(ErrInvalidAmount, "invalid amount (%s)", msg.Amount)
+	}
+	return nil
+}
+
+// IsFullWithdrawal returns true if the message withdraws all the available emissions
+func (msg *MsgWithdrawEmission) IsFullWithdrawal() bool {
+	return msg.Amount.IsZero()
+}
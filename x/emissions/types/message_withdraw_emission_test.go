@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/emissions/types"
+)
+
+func TestMsgWithdrawEmission_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgWithdrawEmission
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: types.MsgWithdrawEmission{
+				Creator: "invalid_address",
+				Amount:  sdkmath.NewInt(1),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "nil amount",
+			msg: types.MsgWithdrawEmission{
+				Creator: sample.AccAddress(),
+			},
+			err: types.ErrInvalidAmount,
+		}, {
+			name: "negative amount",
+			msg: types.MsgWithdrawEmission{
+				Creator: sample.AccAddress(),
+				Amount:  sdkmath.NewInt(-1),
+			},
+			err: types.ErrInvalidAmount,
+		}, {
+			name: "full withdrawal",
+			msg: types.MsgWithdrawEmission{
+				Creator: sample.AccAddress(),
+				Amount:  sdkmath.ZeroInt(),
+			},
+		}, {
+			name: "partial withdrawal",
+			msg: types.MsgWithdrawEmission{
+				Creator: sample.AccAddress(),
+				Amount:  sdkmath.NewInt(100),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
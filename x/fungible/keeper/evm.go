@@ -93,14 +93,11 @@ func (k Keeper) DeployZRC20Contract(
 	erc20Contract string,
 	gasLimit *big.Int,
 ) (common.Address, error) {
-	chain := zetacommon.GetChainFromChainID(chainID)
-	if chain == nil {
+	chainInfo, found := k.observerKeeper.GetChainInfo(ctx, chainID)
+	if !found {
 		return common.Address{}, cosmoserrors.Wrapf(zetaObserverTypes.ErrSupportedChains, "chain %d not found", chainID)
 	}
-	chainStr := chain.ChainName.String()
-	if chain == nil {
-		return common.Address{}, cosmoserrors.Wrapf(zetaObserverTypes.ErrSupportedChains, "chain %s not found", chainStr)
-	}
+	chain := chainInfo.Chain
 	system, found := k.GetSystemContract(ctx)
 	if !found {
 		return common.Address{}, cosmoserrors.Wrapf(types.ErrSystemContractNotFound, "system contract not found")
@@ -26,10 +26,11 @@ func (k Keeper) SetupChainGasCoinAndPool(
 	decimals uint8,
 	gasLimit *big.Int,
 ) (ethcommon.Address, error) {
-	chain := common.GetChainFromChainID(chainID)
-	if chain == nil {
+	chainInfo, found := k.observerKeeper.GetChainInfo(ctx, chainID)
+	if !found {
 		return ethcommon.Address{}, zetaObserverTypes.ErrSupportedChains
 	}
+	chain := chainInfo.Chain
 	name := fmt.Sprintf("%s-%s", gasAssetName, chain.ChainName)
 
 	transferGasLimit := gasLimit
@@ -37,7 +38,7 @@ func (k Keeper) SetupChainGasCoinAndPool(
 	// default values
 	if transferGasLimit == nil {
 		transferGasLimit = big.NewInt(21_000)
-		if common.IsBitcoinChain(chain.ChainId) {
+		if chainInfo.IsBitcoinChain() {
 			transferGasLimit = big.NewInt(100) // 100B for a typical tx
 		}
 	}
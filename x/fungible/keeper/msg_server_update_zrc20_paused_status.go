@@ -25,12 +25,13 @@ func (k Keeper) UpdateZRC20PausedStatus(
 	}
 
 	// check if the sender is the admin
+	// pausing is an emergency action that any single signer of the group1 signer set can take
 	// unpausing requires group2 admin
-	requirePolicyAccount := zetaObserverTypes.Policy_Type_group1
 	if msg.Action == types.UpdatePausedStatusAction_UNPAUSE {
-		requirePolicyAccount = zetaObserverTypes.Policy_Type_group2
-	}
-	if msg.Creator != k.observerKeeper.GetParams(ctx).GetAdminPolicyAccount(requirePolicyAccount) {
+		if msg.Creator != k.observerKeeper.GetParams(ctx).GetAdminPolicyAccount(zetaObserverTypes.Policy_Type_group2) {
+			return nil, cosmoserrors.Wrap(sdkerrors.ErrUnauthorized, "Update can only be executed by the correct policy account")
+		}
+	} else if !k.observerKeeper.IsEmergencyAdmin(ctx, msg.Creator) {
 		return nil, cosmoserrors.Wrap(sdkerrors.ErrUnauthorized, "Update can only be executed by the correct policy account")
 	}
 
@@ -132,6 +132,32 @@ func TestKeeper_UpdateZRC20PausedStatus(t *testing.T) {
 		requireUnpaused(zrc20C)
 	})
 
+	t.Run("a single signer of the group1 signer set can pause but not unpause", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.FungibleKeeper(t)
+		zrc20 := sample.EthAddress().String()
+		k.SetForeignCoins(ctx, sample.ForeignCoins(t, zrc20))
+
+		signerSet := sample.AdminSignerSet(observertypes.Policy_Type_group1)
+		zk.ObserverKeeper.SetAdminSignerSet(ctx, signerSet)
+
+		_, err := k.UpdateZRC20PausedStatus(ctx, types.NewMsgUpdateZRC20PausedStatus(
+			signerSet.Signers[0],
+			[]string{zrc20},
+			types.UpdatePausedStatusAction_PAUSE,
+		))
+		require.NoError(t, err)
+		fc, found := k.GetForeignCoins(ctx, zrc20)
+		require.True(t, found)
+		require.True(t, fc.Paused)
+
+		_, err = k.UpdateZRC20PausedStatus(ctx, types.NewMsgUpdateZRC20PausedStatus(
+			signerSet.Signers[0],
+			[]string{zrc20},
+			types.UpdatePausedStatusAction_UNPAUSE,
+		))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
 	t.Run("should fail if invalid message", func(t *testing.T) {
 		k, ctx, _, zk := keepertest.FungibleKeeper(t)
 		admin := sample.AccAddress()
@@ -49,6 +49,8 @@ type ObserverKeeper interface {
 	GetAllBallots(ctx sdk.Context) (voters []*observertypes.Ballot)
 	GetParams(ctx sdk.Context) (params observertypes.Params)
 	GetCoreParamsByChainID(ctx sdk.Context, chainID int64) (params *observertypes.CoreParams, found bool)
+	GetChainInfo(ctx sdk.Context, chainID int64) (val common.ChainInfo, found bool)
+	IsEmergencyAdmin(ctx sdk.Context, address string) bool
 }
 
 type EVMKeeper interface {
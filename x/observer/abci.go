@@ -37,3 +37,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// #nosec G701 always positive
 	k.SetLastObserverCount(ctx, &types.LastObserverCount{Count: uint64(totalObserverCountCurrentBlock), LastChangeHeight: ctx.BlockHeight()})
 }
+
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// matured ballots are pruned once the observer rewards have been distributed
+	k.PruneMaturedBallots(ctx)
+}
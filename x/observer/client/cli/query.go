@@ -35,11 +35,23 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListNodeAccount(),
 		CmdShowNodeAccount(),
 		CmdShowCrosschainFlags(),
+		CmdShowChainCrosschainFlags(),
+		CmdListChainCrosschainFlags(),
 		CmdShowKeygen(),
 		CmdShowObserverCount(),
 		CmdBlameByIdentifier(),
 		CmdGetAllBlameRecords(),
 		CmdGetBlameByChainAndNonce(),
+		CmdShowChainInfo(),
+		CmdListChainInfo(),
+		CmdShowBallotSummary(),
+		CmdListBallotSummary(),
+		CmdShowChainState(),
+		CmdShowAdminProposal(),
+		CmdListPendingAdminProposals(),
+		CmdListAdminSignerSets(),
+		CmdShowNodeBlameScore(),
+		CmdListNodeBlameScore(),
 	)
 
 	return cmd
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func CmdShowAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-admin-proposal [proposal-id]",
+		Short: "shows an admin proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			params := &types.QueryGetAdminProposalRequest{
+				Id: id,
+			}
+
+			res, err := queryClient.AdminProposal(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListPendingAdminProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pending-admin-proposals [policy-type]",
+		Short: "lists the pending admin proposals, optionally of a single policy type",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingAdminProposalsRequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				policyType, err := parsePolicyType(args[0])
+				if err != nil {
+					return err
+				}
+				params.FilterPolicyType = true
+				params.PolicyType = policyType
+			}
+
+			res, err := queryClient.PendingAdminProposals(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListAdminSignerSets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-admin-signer-sets",
+		Short: "lists the signer sets of the admin policies",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAdminSignerSetsRequest{}
+
+			res, err := queryClient.AdminSignerSets(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
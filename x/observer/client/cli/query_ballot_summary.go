@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func CmdShowBallotSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-ballot-summary [ballot-identifier]",
+		Short: "shows the summary of a pruned ballot",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetBallotSummaryRequest{
+				BallotIdentifier: args[0],
+			}
+
+			res, err := queryClient.BallotSummary(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListBallotSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-ballot-summary",
+		Short: "lists the summaries of the pruned ballots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllBallotSummaryRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BallotSummaryAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func CmdShowChainCrosschainFlags() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-chain-crosschain-flags [chain-id]",
+		Short: "shows the crosschain flags of a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			params := &types.QueryGetChainCrosschainFlagsRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.ChainCrosschainFlags(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListChainCrosschainFlags() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-chain-crosschain-flags",
+		Short: "lists the crosschain flags of all the supported chains",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllChainCrosschainFlagsRequest{}
+
+			res, err := queryClient.ChainCrosschainFlagsAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func CmdShowNodeBlameScore() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-node-blame-score [operator]",
+		Short: "shows the blames of a node in the blame window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetNodeBlameScoreRequest{
+				Operator: args[0],
+			}
+
+			res, err := queryClient.NodeBlameScore(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListNodeBlameScore() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-node-blame-score",
+		Short: "lists the blames of the nodes blamed in the blame window",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NodeBlameScoreAll(context.Background(), &types.QueryAllNodeBlameScoreRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
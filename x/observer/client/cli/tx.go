@@ -24,9 +24,16 @@ func GetTxCmd() *cobra.Command {
 		CmdAddObserver(),
 		CmdUpdateCoreParams(),
 		CmdUpdateCrosschainFlags(),
+		CmdUpdateChainCrosschainFlags(),
 		CmdUpdateKeygen(),
 		CmdAddBlameVote(),
 		CmdEncode(),
+		CmdUpdateChainInfo(),
+		CmdUpdateAdminSignerSet(),
+		CmdSubmitAdminProposal(),
+		CmdApproveAdminProposal(),
+		CmdExecuteAdminProposal(),
+		CmdCancelAdminProposal(),
 	)
 
 	return cmd
@@ -0,0 +1,206 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// parsePolicyType parses a policy type from its name
+func parsePolicyType(arg string) (types.Policy_Type, error) {
+	policyType, ok := types.Policy_Type_value[arg]
+	if !ok {
+		return 0, fmt.Errorf("unknown policy type %s", arg)
+	}
+	return types.Policy_Type(policyType), nil
+}
+
+func CmdUpdateAdminSignerSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-admin-signer-set [policy-type] [signers] [threshold] [timelock]",
+		Short: "Set the comma separated signers, the threshold of approvals and the timelock in blocks of the admin proposals of a policy type",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			argPolicyType, err := parsePolicyType(args[0])
+			if err != nil {
+				return err
+			}
+			argThreshold, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			argTimelock, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateAdminSignerSet(
+				clientCtx.GetFromAddress().String(),
+				types.AdminSignerSet{
+					PolicyType: argPolicyType,
+					Signers:    strings.Split(args[1], ","),
+					Threshold:  uint32(argThreshold),
+					Timelock:   argTimelock,
+				},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSubmitAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-admin-proposal [policy-type] [messages.json]",
+		Short: "Submit an admin proposal executing the messages of the json file {\"messages\": [...]} with the admin proposal account of a policy type",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			argPolicyType, err := parsePolicyType(args[0])
+			if err != nil {
+				return err
+			}
+			file, err := filepath.Abs(args[1])
+			if err != nil {
+				return err
+			}
+			file = filepath.Clean(file)
+			input, err := os.ReadFile(file) // #nosec G304
+			if err != nil {
+				return err
+			}
+			var content struct {
+				Messages []json.RawMessage `json:"messages"`
+			}
+			if err := json.Unmarshal(input, &content); err != nil {
+				return err
+			}
+			msgs := make([]sdk.Msg, len(content.Messages))
+			for i, rawMsg := range content.Messages {
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+					return err
+				}
+			}
+
+			msg, err := types.NewMsgSubmitAdminProposal(clientCtx.GetFromAddress().String(), argPolicyType, msgs)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdApproveAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-admin-proposal [proposal-id]",
+		Short: "Approve a pending admin proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			argProposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveAdminProposal(clientCtx.GetFromAddress().String(), argProposalID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdExecuteAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-admin-proposal [proposal-id]",
+		Short: "Execute an approved admin proposal once its timelock is over",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			argProposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecuteAdminProposal(clientCtx.GetFromAddress().String(), argProposalID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-admin-proposal [proposal-id]",
+		Short: "Cancel a pending admin proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			argProposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAdminProposal(clientCtx.GetFromAddress().String(), argProposalID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func CmdUpdateChainCrosschainFlags() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-chain-crosschain-flags [chain-id] [is-inbound-enabled] [is-outbound-enabled]",
+		Short: "Pause or resume the inbounds and outbounds of a chain",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			argChainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argIsInboundEnabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			argIsOutboundEnabled, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUpdateChainCrosschainFlags(
+				clientCtx.GetFromAddress().String(),
+				argChainID,
+				argIsInboundEnabled,
+				argIsOutboundEnabled,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func CmdUpdateChainInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-chain-info [chain-info.json]",
+		Short: "Broadcast message updateChainInfo to add or update a chain in the chain registry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			file, err := filepath.Abs(args[0])
+			if err != nil {
+				return err
+			}
+			file = filepath.Clean(file)
+			input, err := os.ReadFile(file) // #nosec G304
+			if err != nil {
+				return err
+			}
+			var chainInfo common.ChainInfo
+			if err := clientCtx.Codec.UnmarshalJSON(input, &chainInfo); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateChainInfo(
+				clientCtx.GetFromAddress().String(),
+				chainInfo,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package observer
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/common"
 	"github.com/zeta-chain/node/x/observer/keeper"
 	"github.com/zeta-chain/node/x/observer/types"
 )
@@ -25,6 +26,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetCoreParams(ctx, types.GetCoreParams())
 	}
 
+	// If the chain registry is defined set it, otherwise set the default chains
+	chainInfoList := genState.ChainInfoList
+	if len(chainInfoList) == 0 {
+		chainInfoList = common.DefaultChainInfoList()
+	}
+	for _, chainInfo := range chainInfoList {
+		k.SetChainInfo(ctx, chainInfo)
+	}
+
 	// Set all the nodeAccount
 	for _, elem := range genState.NodeAccountList {
 		if elem != nil {
@@ -45,6 +55,24 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
 	}
 
+	for _, flags := range genState.ChainCrosschainFlags {
+		k.SetChainCrosschainFlags(ctx, flags)
+	}
+
+	for _, signerSet := range genState.AdminSignerSets {
+		k.SetAdminSignerSet(ctx, signerSet)
+	}
+
+	// the proposal count is the id of the last proposal
+	adminProposalCount := uint64(0)
+	for _, proposal := range genState.AdminProposals {
+		k.SetAdminProposal(ctx, proposal)
+		if proposal.Id > adminProposalCount {
+			adminProposalCount = proposal.Id
+		}
+	}
+	k.SetAdminProposalCount(ctx, adminProposalCount)
+
 	// Set if defined
 	if genState.Keygen != nil {
 		k.SetKeygen(ctx, *genState.Keygen)
@@ -67,6 +95,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		})
 	}
 
+	for _, summary := range genState.BallotSummaries {
+		k.SetBallotSummary(ctx, summary)
+	}
+
+	for _, list := range genState.PrunedBallotLists {
+		k.SetPrunedBallotList(ctx, list)
+		for _, index := range list.BallotsIndexList {
+			k.SetPrunedBallot(ctx, index, list.Height)
+		}
+	}
+
+	for _, score := range genState.NodeBlameScores {
+		k.SetNodeBlameScore(ctx, score)
+	}
+
 	if genState.LastObserverCount != nil {
 		k.SetLastObserverCount(ctx, genState.LastObserverCount)
 	} else {
@@ -111,13 +154,20 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		Ballots:           k.GetAllBallots(ctx),
-		Observers:         k.GetAllObserverMappers(ctx),
-		CoreParamsList:    coreParams,
-		Params:            &params,
-		NodeAccountList:   nodeAccounts,
-		CrosschainFlags:   cf,
-		Keygen:            kn,
-		LastObserverCount: oc,
+		Ballots:              k.GetAllBallots(ctx),
+		Observers:            k.GetAllObserverMappers(ctx),
+		CoreParamsList:       coreParams,
+		Params:               &params,
+		NodeAccountList:      nodeAccounts,
+		CrosschainFlags:      cf,
+		Keygen:               kn,
+		LastObserverCount:    oc,
+		ChainInfoList:        k.GetAllChainInfo(ctx),
+		BallotSummaries:      k.GetAllBallotSummaries(ctx),
+		ChainCrosschainFlags: k.GetAllChainCrosschainFlags(ctx),
+		AdminSignerSets:      k.GetAllAdminSignerSets(ctx),
+		AdminProposals:       k.GetAllAdminProposals(ctx),
+		NodeBlameScores:      k.GetAllNodeBlameScores(ctx),
+		PrunedBallotLists:    k.GetAllPrunedBallotLists(ctx),
 	}
 }
@@ -35,6 +35,31 @@ func TestGenesis(t *testing.T) {
 		Keygen:            sample.Keygen(t),
 		LastObserverCount: sample.LastObserverCount(1000),
 		CoreParamsList:    sample.CoreParamsList(),
+		BallotSummaries: []types.BallotSummary{
+			sample.BallotSummary(t, "0"),
+			sample.BallotSummary(t, "1"),
+			sample.BallotSummary(t, "2"),
+		},
+		ChainCrosschainFlags: []types.ChainCrosschainFlags{
+			{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: true},
+			{ChainId: 2, IsInboundEnabled: true, IsOutboundEnabled: false},
+		},
+		AdminSignerSets: []types.AdminSignerSet{
+			sample.AdminSignerSet(types.Policy_Type_group1),
+			sample.AdminSignerSet(types.Policy_Type_group2),
+		},
+		AdminProposals: []types.AdminProposal{
+			sample.AdminProposal(t, 1, types.Policy_Type_group1),
+			sample.AdminProposal(t, 2, types.Policy_Type_group2),
+		},
+		NodeBlameScores: []types.NodeBlameScore{
+			{Operator: sample.AccAddress(), PubKey: sample.PubKeyString(), BlameHeights: []int64{1, 2}},
+			{Operator: sample.AccAddress(), PubKey: sample.PubKeyString(), BlameHeights: []int64{3}},
+		},
+		PrunedBallotLists: []types.BallotListForHeight{
+			{Height: 10, BallotsIndexList: []string{"3", "4"}},
+			{Height: 20, BallotsIndexList: []string{"5"}},
+		},
 	}
 
 	// Init and export
@@ -43,6 +68,12 @@ func TestGenesis(t *testing.T) {
 	got := observer.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
 
+	require.Equal(t, uint64(2), k.GetAdminProposalCount(ctx))
+	require.True(t, k.IsBallotPruned(ctx, "3"))
+	height, found := k.GetPrunedBallotHeight(ctx, "5")
+	require.True(t, found)
+	require.Equal(t, int64(20), height)
+
 	// Compare genesis after init and export
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
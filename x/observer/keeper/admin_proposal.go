@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/node/x/observer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetAdminSignerSet sets the signer set of the admin proposals of a policy type in the store
+func (k Keeper) SetAdminSignerSet(ctx sdk.Context, signerSet types.AdminSignerSet) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminSignerSetKey))
+	b := k.cdc.MustMarshal(&signerSet)
+	store.Set(types.GetAdminSignerSetKey(signerSet.PolicyType), b)
+}
+
+// GetAdminSignerSet returns the signer set of the admin proposals of a policy type
+func (k Keeper) GetAdminSignerSet(ctx sdk.Context, policyType types.Policy_Type) (val types.AdminSignerSet, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminSignerSetKey))
+	b := store.Get(types.GetAdminSignerSetKey(policyType))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllAdminSignerSets returns the signer sets of all the policy types
+func (k Keeper) GetAllAdminSignerSets(ctx sdk.Context) (list []types.AdminSignerSet) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminSignerSetKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AdminSignerSet
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// SetAdminProposal sets an admin proposal in the store
+func (k Keeper) SetAdminProposal(ctx sdk.Context, proposal types.AdminProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalKey))
+	b := k.cdc.MustMarshal(&proposal)
+	store.Set(types.GetAdminProposalKey(proposal.Id), b)
+}
+
+// GetAdminProposal returns an admin proposal by id
+func (k Keeper) GetAdminProposal(ctx sdk.Context, id uint64) (val types.AdminProposal, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalKey))
+	b := store.Get(types.GetAdminProposalKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllAdminProposals returns all the admin proposals ordered by id
+func (k Keeper) GetAllAdminProposals(ctx sdk.Context) (list []types.AdminProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AdminProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// SetAdminProposalCount sets the id of the last submitted admin proposal
+func (k Keeper) SetAdminProposalCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.AdminProposalCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// GetAdminProposalCount returns the id of the last submitted admin proposal
+func (k Keeper) GetAdminProposalCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyPrefix(types.AdminProposalCountKey))
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// IsEmergencyAdmin returns true if the address can broadcast the emergency messages of the group1 policy, pausing the
+// crosschain transactions: the group1 policy account and, when configured, any single signer of the group1 signer set
+func (k Keeper) IsEmergencyAdmin(ctx sdk.Context, address string) bool {
+	if address == k.GetParams(ctx).GetAdminPolicyAccount(types.Policy_Type_group1) {
+		return true
+	}
+	signerSet, found := k.GetAdminSignerSet(ctx, types.Policy_Type_group1)
+	return found && signerSet.IsSigner(address)
+}
+
+// approveAdminProposal records the approval of a signer, starts the timelock once the threshold of approvals is
+// reached and executes the proposal if it is executable
+func (k Keeper) approveAdminProposal(ctx sdk.Context, proposal *types.AdminProposal, signerSet types.AdminSignerSet, signer string) {
+	proposal.Approvals = append(proposal.Approvals, signer)
+	approvals := proposal.CountApprovals(signerSet)
+	if proposal.ExecutableHeight == 0 && approvals >= signerSet.Threshold {
+		proposal.ExecutableHeight = ctx.BlockHeight() + signerSet.Timelock
+	}
+	EmitEventAdminProposalApproved(ctx, *proposal, approvals, signer)
+
+	// the proposal stays pending if the execution fails, it can be executed later with MsgExecuteAdminProposal
+	if proposal.IsExecutable(ctx.BlockHeight()) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.executeAdminProposal(cacheCtx, *proposal)
+		if err == nil {
+			writeCache()
+			proposal.Status = types.AdminProposalStatus_AdminProposalExecuted
+		}
+		EmitEventAdminProposalExecuted(ctx, proposal.Id, err, signer)
+	}
+	k.SetAdminProposal(ctx, *proposal)
+}
+
+// executeAdminProposal executes the messages of an admin proposal with the admin proposal account of its policy type
+// the approvals are counted against the current signer set of the policy type
+func (k Keeper) executeAdminProposal(ctx sdk.Context, proposal types.AdminProposal) error {
+	if !proposal.IsExecutable(ctx.BlockHeight()) {
+		return cosmoserrors.Wrapf(
+			types.ErrAdminProposalNotExecutable,
+			"proposal %d executable height %d, current height %d",
+			proposal.Id,
+			proposal.ExecutableHeight,
+			ctx.BlockHeight(),
+		)
+	}
+	signerSet, found := k.GetAdminSignerSet(ctx, proposal.PolicyType)
+	if !found {
+		return cosmoserrors.Wrapf(types.ErrAdminSignerSetNotFound, "policy type %s", proposal.PolicyType.String())
+	}
+	if approvals := proposal.CountApprovals(signerSet); approvals < signerSet.Threshold {
+		return cosmoserrors.Wrapf(
+			types.ErrAdminProposalNotExecutable,
+			"proposal %d has %d approvals, threshold is %d",
+			proposal.Id,
+			approvals,
+			signerSet.Threshold,
+		)
+	}
+
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrInvalidAdminProposal, err.Error())
+	}
+	if err := types.ValidateAdminProposalMsgs(proposal.PolicyType, msgs); err != nil {
+		return err
+	}
+	for i, msg := range msgs {
+		handler := k.msgRouter.Handler(msg)
+		if handler == nil {
+			return cosmoserrors.Wrapf(types.ErrInvalidAdminProposal, "no message handler found for %s", sdk.MsgTypeURL(msg))
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return cosmoserrors.Wrapf(err, "message %s at position %d", sdk.MsgTypeURL(msg), i)
+		}
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+	return nil
+}
+
+// Queries
+
+func (k Keeper) AdminProposal(c context.Context, req *types.QueryGetAdminProposalRequest) (*types.QueryGetAdminProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := k.GetAdminProposal(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &types.QueryGetAdminProposalResponse{AdminProposal: proposal}, nil
+}
+
+func (k Keeper) PendingAdminProposals(c context.Context, req *types.QueryPendingAdminProposalsRequest) (*types.QueryPendingAdminProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AdminProposalKey))
+
+	var proposals []types.AdminProposal
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var proposal types.AdminProposal
+		if err := k.cdc.Unmarshal(value, &proposal); err != nil {
+			return false, err
+		}
+		if proposal.Status != types.AdminProposalStatus_AdminProposalPending {
+			return false, nil
+		}
+		if req.FilterPolicyType && proposal.PolicyType != req.PolicyType {
+			return false, nil
+		}
+		if accumulate {
+			proposals = append(proposals, proposal)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPendingAdminProposalsResponse{AdminProposals: proposals, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AdminSignerSets(c context.Context, req *types.QueryAdminSignerSetsRequest) (*types.QueryAdminSignerSetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAdminSignerSetsResponse{SignerSets: k.GetAllAdminSignerSets(ctx)}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/zeta-chain/node/x/observer/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -75,6 +76,188 @@ func (k Keeper) GetMaturedBallotList(ctx sdk.Context) []string {
 	return list.BallotsIndexList
 }
 
+// RemoveBallot removes a ballot from the store
+func (k Keeper) RemoveBallot(ctx sdk.Context, index string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VoterKey))
+	store.Delete(types.KeyPrefix(index))
+}
+
+// RemoveBallotList removes the list of ballots for a given height
+func (k Keeper) RemoveBallotList(ctx sdk.Context, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotListKey))
+	store.Delete(types.BallotListKeyPrefix(height))
+}
+
+func (k Keeper) SetBallotSummary(ctx sdk.Context, summary types.BallotSummary) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotSummaryKey))
+	b := k.cdc.MustMarshal(&summary)
+	store.Set(types.KeyPrefix(summary.BallotIdentifier), b)
+}
+
+func (k Keeper) GetBallotSummary(ctx sdk.Context, index string) (val types.BallotSummary, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotSummaryKey))
+	b := store.Get(types.KeyPrefix(index))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) GetAllBallotSummaries(ctx sdk.Context) (list []types.BallotSummary) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotSummaryKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BallotSummary
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// SetPrunedBallot records that a finalized ballot has been pruned, the height of pruning is stored as value
+func (k Keeper) SetPrunedBallot(ctx sdk.Context, index string, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrunedBallotKey))
+	// #nosec G701 always positive
+	store.Set(types.KeyPrefix(index), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetPrunedBallotHeight returns the height a ballot has been pruned at
+func (k Keeper) GetPrunedBallotHeight(ctx sdk.Context, index string) (int64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrunedBallotKey))
+	b := store.Get(types.KeyPrefix(index))
+	if b == nil {
+		return 0, false
+	}
+	// #nosec G701 always in range
+	return int64(sdk.BigEndianToUint64(b)), true
+}
+
+// RemovePrunedBallot removes the tombstone of a pruned ballot
+func (k Keeper) RemovePrunedBallot(ctx sdk.Context, index string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrunedBallotKey))
+	store.Delete(types.KeyPrefix(index))
+}
+
+// IsBallotPruned returns true if the ballot has been finalized and pruned
+func (k Keeper) IsBallotPruned(ctx sdk.Context, index string) bool {
+	if _, found := k.GetPrunedBallotHeight(ctx, index); found {
+		return true
+	}
+	// the summary of an archived ballot is kept after its tombstone expired
+	_, found := k.GetBallotSummary(ctx, index)
+	return found
+}
+
+// SetPrunedBallotList sets the list of the ballots pruned at a given height
+func (k Keeper) SetPrunedBallotList(ctx sdk.Context, list types.BallotListForHeight) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrunedBallotListKey))
+	b := k.cdc.MustMarshal(&list)
+	store.Set(types.BallotListKeyPrefix(list.Height), b)
+}
+
+// GetPrunedBallotList returns the list of the ballots pruned at a given height
+func (k Keeper) GetPrunedBallotList(ctx sdk.Context, height int64) (val types.BallotListForHeight, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrunedBallotListKey))
+	b := store.Get(types.BallotListKeyPrefix(height))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPrunedBallotLists returns the lists of the pruned ballots whose tombstone has not expired
+func (k Keeper) GetAllPrunedBallotLists(ctx sdk.Context) (list []types.BallotListForHeight) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrunedBallotListKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BallotListForHeight
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// RemovePrunedBallotList removes the list of the ballots pruned at a given height
+func (k Keeper) RemovePrunedBallotList(ctx sdk.Context, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrunedBallotListKey))
+	store.Delete(types.BallotListKeyPrefix(height))
+}
+
+// ExpirePrunedBallots removes the tombstones of the ballots pruned PrunedBallotRetentionBlocks blocks ago
+// a tombstone set again at a later height for a ballot created again is kept
+func (k Keeper) ExpirePrunedBallots(ctx sdk.Context) {
+	height := ctx.BlockHeight() - types.PrunedBallotRetentionBlocks
+	list, found := k.GetPrunedBallotList(ctx, height)
+	if !found {
+		return
+	}
+	for _, index := range list.BallotsIndexList {
+		if prunedHeight, found := k.GetPrunedBallotHeight(ctx, index); found && prunedHeight == height {
+			k.RemovePrunedBallot(ctx, index)
+		}
+	}
+	k.RemovePrunedBallotList(ctx, height)
+}
+
+// PruneMaturedBallots removes the ballots matured at current height, the rewards for these ballots have been distributed
+// in the begin blocker of the emissions module.
+// A tombstone of each finalized ballot is kept for PrunedBallotRetentionBlocks blocks so the ballot can't be created
+// again by a late vote, and a full summary is kept if ballot archival is enabled.
+// Ballots still in progress are kept and added to the list of the current height to be checked again at their next
+// maturity, unless they are older than MaxPendingBallotAge blocks, such ballot is removed without tombstone
+func (k Keeper) PruneMaturedBallots(ctx sdk.Context) {
+	k.ExpirePrunedBallots(ctx)
+
+	params := k.GetParams(ctx)
+	maturedHeight := ctx.BlockHeight() - params.BallotMaturityBlocks
+	list, found := k.GetBallotList(ctx, maturedHeight)
+	if !found {
+		return
+	}
+
+	var pending, pruned []string
+	for _, index := range list.BallotsIndexList {
+		ballot, found := k.GetBallot(ctx, index)
+		if !found {
+			continue
+		}
+		if ballot.BallotStatus == types.BallotStatus_BallotInProgress {
+			if ctx.BlockHeight()-ballot.BallotCreationHeight < types.MaxPendingBallotAge {
+				pending = append(pending, index)
+			} else {
+				k.RemoveBallot(ctx, index)
+			}
+			continue
+		}
+		k.SetPrunedBallot(ctx, index, ctx.BlockHeight())
+		pruned = append(pruned, index)
+		if params.BallotArchivalEnabled {
+			k.SetBallotSummary(ctx, ballot.Summary())
+		}
+		k.RemoveBallot(ctx, index)
+	}
+	k.RemoveBallotList(ctx, maturedHeight)
+
+	// the tombstones of the ballots pruned at current height expire together
+	if len(pruned) > 0 {
+		k.SetPrunedBallotList(ctx, types.BallotListForHeight{Height: ctx.BlockHeight(), BallotsIndexList: pruned})
+	}
+
+	// in-progress ballots are checked again at their next maturity
+	if len(pending) > 0 {
+		current, found := k.GetBallotList(ctx, ctx.BlockHeight())
+		if !found {
+			current = types.BallotListForHeight{Height: ctx.BlockHeight(), BallotsIndexList: []string{}}
+		}
+		current.BallotsIndexList = append(current.BallotsIndexList, pending...)
+		k.SetBallotList(ctx, &current)
+	}
+}
+
 // Queries
 
 func (k Keeper) BallotByIdentifier(goCtx context.Context, req *types.QueryBallotByIdentifierRequest) (*types.QueryBallotByIdentifierResponse, error) {
@@ -103,3 +286,38 @@ func (k Keeper) BallotByIdentifier(goCtx context.Context, req *types.QueryBallot
 		BallotStatus:     ballot.BallotStatus,
 	}, nil
 }
+
+// BallotSummary queries the summary of a pruned ballot
+func (k Keeper) BallotSummary(goCtx context.Context, req *types.QueryGetBallotSummaryRequest) (*types.QueryGetBallotSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	summary, found := k.GetBallotSummary(sdk.UnwrapSDKContext(goCtx), req.BallotIdentifier)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found ballot summary")
+	}
+	return &types.QueryGetBallotSummaryResponse{BallotSummary: summary}, nil
+}
+
+// BallotSummaryAll queries the summaries of the pruned ballots
+func (k Keeper) BallotSummaryAll(goCtx context.Context, req *types.QueryAllBallotSummaryRequest) (*types.QueryAllBallotSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotSummaryKey))
+
+	var summaries []types.BallotSummary
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var summary types.BallotSummary
+		if err := k.cdc.Unmarshal(value, &summary); err != nil {
+			return err
+		}
+		summaries = append(summaries, summary)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllBallotSummaryResponse{BallotSummary: summaries, Pagination: pageRes}, nil
+}
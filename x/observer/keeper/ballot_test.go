@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
 	"github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -21,3 +24,157 @@ func TestKeeper_GetBallot(t *testing.T) {
 
 	k.GetBallot(ctx, identifier)
 }
+
+func TestKeeper_PruneMaturedBallots(t *testing.T) {
+	setup := func(t *testing.T, archival bool) (*Keeper, sdk.Context) {
+		k, ctx := SetupKeeper(t)
+		params := types.DefaultParams()
+		params.BallotMaturityBlocks = 10
+		params.BallotArchivalEnabled = archival
+		k.SetParams(ctx, params)
+		for _, ballot := range []types.Ballot{
+			{
+				BallotIdentifier:     "finalized",
+				VoterList:            []string{"observer1", "observer2"},
+				Votes:                []types.VoteType{types.VoteType_SuccessObservation, types.VoteType_NotYetVoted},
+				BallotStatus:         types.BallotStatus_BallotFinalized_SuccessObservation,
+				BallotCreationHeight: 5,
+			},
+			{
+				BallotIdentifier:     "in-progress",
+				VoterList:            []string{"observer1", "observer2"},
+				Votes:                []types.VoteType{types.VoteType_SuccessObservation, types.VoteType_NotYetVoted},
+				BallotStatus:         types.BallotStatus_BallotInProgress,
+				BallotCreationHeight: 5,
+			},
+		} {
+			ballot := ballot
+			k.SetBallot(ctx, &ballot)
+			k.AddBallotToList(ctx, ballot)
+		}
+		return k, ctx.WithBlockHeight(15)
+	}
+
+	t.Run("finalized ballots are pruned and archived", func(t *testing.T) {
+		k, ctx := setup(t, true)
+		k.PruneMaturedBallots(ctx)
+
+		_, found := k.GetBallot(ctx, "finalized")
+		require.False(t, found)
+		summary, found := k.GetBallotSummary(ctx, "finalized")
+		require.True(t, found)
+		require.Equal(t, types.BallotStatus_BallotFinalized_SuccessObservation, summary.BallotStatus)
+		require.True(t, summary.VotedForFinalStatus(0))
+		require.False(t, summary.VotedForFinalStatus(1))
+
+		// the ballot list of the matured height is removed
+		_, found = k.GetBallotList(ctx, 5)
+		require.False(t, found)
+
+		// in-progress ballots are kept and checked again at their next maturity
+		_, found = k.GetBallot(ctx, "in-progress")
+		require.True(t, found)
+		list, found := k.GetBallotList(ctx, 15)
+		require.True(t, found)
+		require.Equal(t, []string{"in-progress"}, list.BallotsIndexList)
+		_, found = k.GetBallotSummary(ctx, "in-progress")
+		require.False(t, found)
+	})
+
+	t.Run("finalized ballots are pruned without summary if archival is disabled", func(t *testing.T) {
+		k, ctx := setup(t, false)
+		k.PruneMaturedBallots(ctx)
+
+		_, found := k.GetBallot(ctx, "finalized")
+		require.False(t, found)
+		require.Empty(t, k.GetAllBallotSummaries(ctx))
+
+		// a late vote can't create the ballot again
+		require.True(t, k.IsBallotPruned(ctx, "finalized"))
+		require.False(t, k.IsBallotPruned(ctx, "in-progress"))
+		chain := common.ZetaChain()
+		_, _, err := k.FindBallot(ctx, "finalized", &chain, types.ObservationType_InBoundTx)
+		require.ErrorIs(t, err, types.ErrBallotPruned)
+		_, found = k.GetBallot(ctx, "finalized")
+		require.False(t, found)
+	})
+
+	t.Run("the tombstones of pruned ballots expire after the retention window", func(t *testing.T) {
+		k, ctx := setup(t, false)
+		k.PruneMaturedBallots(ctx)
+		list, found := k.GetPrunedBallotList(ctx, 15)
+		require.True(t, found)
+		require.Equal(t, []string{"finalized"}, list.BallotsIndexList)
+
+		k.PruneMaturedBallots(ctx.WithBlockHeight(15 + types.PrunedBallotRetentionBlocks - 1))
+		require.True(t, k.IsBallotPruned(ctx, "finalized"))
+
+		k.PruneMaturedBallots(ctx.WithBlockHeight(15 + types.PrunedBallotRetentionBlocks))
+		require.False(t, k.IsBallotPruned(ctx, "finalized"))
+		require.Empty(t, k.GetAllPrunedBallotLists(ctx))
+	})
+
+	t.Run("a tombstone set again at a later height is not expired with the previous list", func(t *testing.T) {
+		k, ctx := setup(t, false)
+		k.PruneMaturedBallots(ctx)
+		k.SetPrunedBallot(ctx, "finalized", 20)
+
+		k.PruneMaturedBallots(ctx.WithBlockHeight(15 + types.PrunedBallotRetentionBlocks))
+		require.True(t, k.IsBallotPruned(ctx, "finalized"))
+	})
+
+	t.Run("in-progress ballots older than the maximum age are removed", func(t *testing.T) {
+		k, ctx := setup(t, false)
+		ctx = ctx.WithBlockHeight(5 + types.MaxPendingBallotAge)
+		k.SetBallotList(ctx, &types.BallotListForHeight{
+			Height:           ctx.BlockHeight() - 10,
+			BallotsIndexList: []string{"in-progress"},
+		})
+		k.PruneMaturedBallots(ctx)
+
+		_, found := k.GetBallot(ctx, "in-progress")
+		require.False(t, found)
+		require.False(t, k.IsBallotPruned(ctx, "in-progress"))
+		_, found = k.GetBallotList(ctx, ctx.BlockHeight())
+		require.False(t, found)
+	})
+
+	t.Run("nothing is pruned before maturity", func(t *testing.T) {
+		k, ctx := setup(t, true)
+		k.PruneMaturedBallots(ctx.WithBlockHeight(14))
+
+		require.Len(t, k.GetAllBallots(ctx), 2)
+		require.Empty(t, k.GetAllBallotSummaries(ctx))
+	})
+}
+
+func TestKeeper_BallotSummaryAll(t *testing.T) {
+	k, ctx := SetupKeeper(t)
+	for _, identifier := range []string{"a", "b", "c"} {
+		k.SetBallotSummary(ctx, types.BallotSummary{BallotIdentifier: identifier})
+	}
+	wctx := sdk.WrapSDKContext(ctx)
+
+	res, err := k.BallotSummaryAll(wctx, &types.QueryAllBallotSummaryRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.BallotSummary, 2)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = k.BallotSummaryAll(wctx, &types.QueryAllBallotSummaryRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.BallotSummary, 1)
+	require.Equal(t, "c", res.BallotSummary[0].BallotIdentifier)
+
+	_, err = k.BallotSummary(wctx, &types.QueryGetBallotSummaryRequest{BallotIdentifier: "d"})
+	require.Error(t, err)
+}
+
+func TestKeeper_FindBallotPruned(t *testing.T) {
+	k, ctx := SetupKeeper(t)
+	k.SetParams(ctx, types.DefaultParams())
+	k.SetBallotSummary(ctx, types.BallotSummary{BallotIdentifier: "pruned"})
+
+	chain := common.GoerliChain()
+	_, _, err := k.FindBallot(ctx, "pruned", &chain, types.ObservationType_InBoundTx)
+	require.ErrorIs(t, err, types.ErrBallotPruned)
+}
@@ -55,6 +55,82 @@ func (k Keeper) GetBlamesByChainAndNonce(ctx sdk.Context, chainID int64, nonce i
 	return
 }
 
+// SetNodeBlameScore set the blame score of a node in the store from its operator
+func (k Keeper) SetNodeBlameScore(ctx sdk.Context, score types.NodeBlameScore) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NodeBlameScoreKey))
+	b := k.cdc.MustMarshal(&score)
+	store.Set([]byte(score.Operator), b)
+}
+
+// GetNodeBlameScore returns the blame score of a node from its operator
+func (k Keeper) GetNodeBlameScore(ctx sdk.Context, operator string) (val types.NodeBlameScore, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NodeBlameScoreKey))
+	b := store.Get([]byte(operator))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveNodeBlameScore removes the blame score of a node from the store
+func (k Keeper) RemoveNodeBlameScore(ctx sdk.Context, operator string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NodeBlameScoreKey))
+	store.Delete([]byte(operator))
+}
+
+// GetAllNodeBlameScores returns the blame scores of all the blamed nodes
+func (k Keeper) GetAllNodeBlameScores(ctx sdk.Context) (list []types.NodeBlameScore) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NodeBlameScoreKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.NodeBlameScore
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// ProcessBlame adds a finalized blame to the blame scores of the blamed nodes
+// A node reaching the maximum number of blames in the blame window is disabled, the disabled nodes are excluded from
+// the next keygen and don't receive the rewards of the TSS signers
+func (k Keeper) ProcessBlame(ctx sdk.Context, blame *types.Blame) {
+	blameParams := k.GetParams(ctx).BlameParams
+	windowStart := blameParams.WindowStart(ctx.BlockHeight())
+
+	nodeAccounts := make(map[string]types.NodeAccount)
+	for _, nodeAccount := range k.GetAllNodeAccount(ctx) {
+		if nodeAccount.GranteePubkey != nil {
+			nodeAccounts[nodeAccount.GranteePubkey.Secp256k1.String()] = nodeAccount
+		}
+	}
+
+	for _, node := range blame.Nodes {
+		nodeAccount, found := nodeAccounts[node.PubKey]
+		if !found {
+			continue
+		}
+		// a node blamed several times in the same blame is counted once
+		delete(nodeAccounts, node.PubKey)
+
+		score, found := k.GetNodeBlameScore(ctx, nodeAccount.Operator)
+		if !found {
+			score = types.NodeBlameScore{Operator: nodeAccount.Operator, PubKey: node.PubKey}
+		}
+		score.PruneBlames(windowStart)
+		score.BlameHeights = append(score.BlameHeights, ctx.BlockHeight())
+		k.SetNodeBlameScore(ctx, score)
+
+		if blameParams.MaxBlames == 0 || score.Score() < blameParams.MaxBlames || nodeAccount.NodeStatus == types.NodeStatus_Disabled {
+			continue
+		}
+		nodeAccount.NodeStatus = types.NodeStatus_Disabled
+		k.SetNodeAccount(ctx, nodeAccount)
+		EmitEventNodeDisabled(ctx, score, blameParams.WindowBlocks)
+	}
+}
+
 // Query
 
 func (k Keeper) BlameByIdentifier(goCtx context.Context, request *types.QueryBlameByIdentifierRequest) (*types.QueryBlameByIdentifierResponse, error) {
@@ -100,3 +176,46 @@ func (k Keeper) BlamesByChainAndNonce(goCtx context.Context, request *types.Quer
 		BlameInfo: blameRecords,
 	}, nil
 }
+
+func (k Keeper) NodeBlameScore(goCtx context.Context, request *types.QueryGetNodeBlameScoreRequest) (*types.QueryGetNodeBlameScoreResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	nodeAccount, found := k.GetNodeAccount(ctx, request.Operator)
+	if !found {
+		return nil, status.Error(codes.NotFound, "node account not found")
+	}
+	score, found := k.GetNodeBlameScore(ctx, request.Operator)
+	if !found {
+		score = types.NodeBlameScore{Operator: nodeAccount.Operator}
+		if nodeAccount.GranteePubkey != nil {
+			score.PubKey = nodeAccount.GranteePubkey.Secp256k1.String()
+		}
+	}
+	score.PruneBlames(k.GetParams(ctx).BlameParams.WindowStart(ctx.BlockHeight()))
+
+	return &types.QueryGetNodeBlameScoreResponse{
+		NodeBlameScore: score,
+		Score:          score.Score(),
+	}, nil
+}
+
+func (k Keeper) NodeBlameScoreAll(goCtx context.Context, request *types.QueryAllNodeBlameScoreRequest) (*types.QueryAllNodeBlameScoreResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	windowStart := k.GetParams(ctx).BlameParams.WindowStart(ctx.BlockHeight())
+
+	scores := make([]types.NodeBlameScore, 0)
+	for _, score := range k.GetAllNodeBlameScores(ctx) {
+		score.PruneBlames(windowStart)
+		if score.Score() > 0 {
+			scores = append(scores, score)
+		}
+	}
+	return &types.QueryAllNodeBlameScoreResponse{
+		NodeBlameScores: scores,
+	}, nil
+}
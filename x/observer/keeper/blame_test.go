@@ -3,7 +3,9 @@ package keeper
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -45,3 +47,69 @@ func TestKeeper_BlameByChainAndNonce(t *testing.T) {
 	require.Equal(t, 1, len(blameRecords))
 	require.Equal(t, index, blameRecords[0].Index)
 }
+
+func TestKeeper_ProcessBlame(t *testing.T) {
+	k, ctx := SetupKeeper(t)
+	admin := sample.AccAddress()
+	params := types.DefaultParams()
+	params.AdminPolicy = []*types.Admin_Policy{{PolicyType: types.Policy_Type_group1, Address: admin}}
+	params.BlameParams = types.BlameParams{WindowBlocks: 10, MaxBlames: 3}
+	k.SetParams(ctx, params)
+
+	flakyNode := sample.NodeAccount()
+	otherNode := sample.NodeAccount()
+	k.SetNodeAccount(ctx, *flakyNode)
+	k.SetNodeAccount(ctx, *otherNode)
+	flakyPubKey := flakyNode.GranteePubkey.Secp256k1.String()
+	otherPubKey := otherNode.GranteePubkey.Secp256k1.String()
+
+	blameAt := func(height int64, pubKeys ...string) {
+		nodes := make([]*types.Node, len(pubKeys))
+		for i, pubKey := range pubKeys {
+			nodes[i] = &types.Node{PubKey: pubKey}
+		}
+		k.ProcessBlame(ctx.WithBlockHeight(height), &types.Blame{Nodes: nodes})
+	}
+	scoreAt := func(height int64, operator string) uint64 {
+		res, err := k.NodeBlameScore(sdk.WrapSDKContext(ctx.WithBlockHeight(height)), &types.QueryGetNodeBlameScoreRequest{
+			Operator: operator,
+		})
+		require.NoError(t, err)
+		return res.Score
+	}
+
+	// a node blamed several times in a blame is counted once and the unknown nodes are ignored
+	blameAt(1, flakyPubKey, flakyPubKey, otherPubKey, sample.PubKeyString())
+	blameAt(2, flakyPubKey)
+	require.EqualValues(t, 2, scoreAt(2, flakyNode.Operator))
+	require.EqualValues(t, 1, scoreAt(2, otherNode.Operator))
+
+	// the blames out of the window are not counted
+	blameAt(20, flakyPubKey)
+	require.EqualValues(t, 1, scoreAt(20, flakyNode.Operator))
+	require.EqualValues(t, 0, scoreAt(20, otherNode.Operator))
+	blameAt(21, flakyPubKey)
+	nodeAccount, found := k.GetNodeAccount(ctx, flakyNode.Operator)
+	require.True(t, found)
+	require.Equal(t, types.NodeStatus_Active, nodeAccount.NodeStatus)
+
+	// the node is disabled when reaching the maximum number of blames in the window
+	blameAt(22, flakyPubKey)
+	nodeAccount, found = k.GetNodeAccount(ctx, flakyNode.Operator)
+	require.True(t, found)
+	require.Equal(t, types.NodeStatus_Disabled, nodeAccount.NodeStatus)
+
+	all, err := k.NodeBlameScoreAll(sdk.WrapSDKContext(ctx.WithBlockHeight(22)), &types.QueryAllNodeBlameScoreRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.NodeBlameScores, 1)
+	require.Equal(t, flakyNode.Operator, all.NodeBlameScores[0].Operator)
+	require.Equal(t, []int64{20, 21, 22}, all.NodeBlameScores[0].BlameHeights)
+
+	// the disabled node is excluded from the next keygen
+	k.SetKeygen(ctx, types.Keygen{})
+	_, err = NewMsgServerImpl(*k).UpdateKeygen(sdk.WrapSDKContext(ctx), &types.MsgUpdateKeygen{Creator: admin, Block: 100})
+	require.NoError(t, err)
+	keygen, found := k.GetKeygen(ctx)
+	require.True(t, found)
+	require.Equal(t, []string{otherPubKey}, keygen.GranteePubkeys)
+}
@@ -1,8 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"math/big"
+	"strconv"
 
+	cosmoserrors "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -38,6 +43,211 @@ func (k Keeper) RemoveBlockHeader(ctx sdk.Context, hash []byte) {
 	store.Delete(hash)
 }
 
+// SetBlockHeaderHashByHeight sets the hash of the canonical block header at a given height of a chain
+func (k Keeper) SetBlockHeaderHashByHeight(ctx sdk.Context, chainID int64, height int64, hash []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderHeightKey))
+	store.Set(types.BlockHeaderHeightKeyPrefix(chainID, height), hash)
+}
+
+// GetBlockHeaderHashByHeight returns the hash of the canonical block header at a given height of a chain
+func (k Keeper) GetBlockHeaderHashByHeight(ctx sdk.Context, chainID int64, height int64) ([]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderHeightKey))
+	hash := store.Get(types.BlockHeaderHeightKeyPrefix(chainID, height))
+	return hash, hash != nil
+}
+
+// RemoveBlockHeaderHashByHeight removes the hash of the canonical block header at a given height of a chain
+func (k Keeper) RemoveBlockHeaderHashByHeight(ctx sdk.Context, chainID int64, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderHeightKey))
+	store.Delete(types.BlockHeaderHeightKeyPrefix(chainID, height))
+}
+
+// SetBlockHeaderWork sets the cumulative work of the header chain ending with a block header
+func (k Keeper) SetBlockHeaderWork(ctx sdk.Context, hash []byte, work *big.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderWorkKey))
+	store.Set(hash, work.Bytes())
+}
+
+// GetBlockHeaderWork returns the cumulative work of the header chain ending with a block header
+func (k Keeper) GetBlockHeaderWork(ctx sdk.Context, hash []byte) (*big.Int, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderWorkKey))
+	b := store.Get(hash)
+	if b == nil {
+		return nil, false
+	}
+	return new(big.Int).SetBytes(b), true
+}
+
+// SetChainState sets the state of the block header chain of a chain
+func (k Keeper) SetChainState(ctx sdk.Context, chainState types.ChainState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainStateKey))
+	b := k.cdc.MustMarshal(&chainState)
+	store.Set([]byte(strconv.FormatInt(chainState.ChainId, 10)), b)
+}
+
+// GetChainState returns the state of the block header chain of a chain
+func (k Keeper) GetChainState(ctx sdk.Context, chainID int64) (val types.ChainState, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainStateKey))
+	b := store.Get([]byte(strconv.FormatInt(chainID, 10)))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// IsCanonicalBlockHeader returns true if the block header is part of the canonical chain
+// the headers added before the chain state is tracked are considered canonical
+func (k Keeper) IsCanonicalBlockHeader(ctx sdk.Context, header common.BlockHeader) bool {
+	if _, found := k.GetChainState(ctx, header.ChainId); !found {
+		return true
+	}
+	hash, found := k.GetBlockHeaderHashByHeight(ctx, header.ChainId, header.Height)
+	return found && bytes.Equal(hash, header.Hash)
+}
+
+// AddBlockHeaderToChain validates a block header against the header chain and adds it to the store
+// The header chain is bootstrapped from the header at the bootstrap height of the core params, the following headers
+// must extend a known parent.
+// The canonical chain is the chain with the most cumulative work, on reorg the canonical index is updated back to the
+// common ancestor
+func (k Keeper) AddBlockHeaderToChain(ctx sdk.Context, header common.BlockHeader) error {
+	work, err := header.Header.Work()
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrUnrecognizedBlockHeader, err.Error())
+	}
+
+	chainState, found := k.GetChainState(ctx, header.ChainId)
+	if !found {
+		coreParams, found := k.GetCoreParamsByChainID(ctx, header.ChainId)
+		if !found {
+			return cosmoserrors.Wrapf(types.ErrCoreParamsNotSet, "chain id %d", header.ChainId)
+		}
+		if coreParams.BlockHeaderBootstrapHeight == 0 || header.Height != coreParams.BlockHeaderBootstrapHeight {
+			return cosmoserrors.Wrapf(types.ErrInvalidHeaderHeight, "height %d, bootstrap height %d", header.Height, coreParams.BlockHeaderBootstrapHeight)
+		}
+		k.SetBlockHeader(ctx, header)
+		k.SetBlockHeaderWork(ctx, header.Hash, work)
+		k.SetBlockHeaderHashByHeight(ctx, header.ChainId, header.Height, header.Hash)
+		k.SetChainState(ctx, types.ChainState{
+			ChainId:         header.ChainId,
+			EarliestHeight:  header.Height,
+			LatestHeight:    header.Height,
+			LatestBlockHash: header.Hash,
+		})
+		return nil
+	}
+
+	parent, found := k.GetBlockHeader(ctx, header.ParentHash)
+	if !found || parent.ChainId != header.ChainId {
+		return cosmoserrors.Wrapf(types.ErrUnknownParentHeader, "parent hash %x", header.ParentHash)
+	}
+	parentWork, found := k.GetBlockHeaderWork(ctx, header.ParentHash)
+	if !found {
+		return cosmoserrors.Wrapf(types.ErrUnknownParentHeader, "no work for parent hash %x", header.ParentHash)
+	}
+	if header.Height != parent.Height+1 {
+		return cosmoserrors.Wrapf(types.ErrInvalidHeaderHeight, "height %d, parent height %d", header.Height, parent.Height)
+	}
+	windowStart := k.bitcoinRetargetWindowStart(ctx, header)
+	lastRegular := k.bitcoinLastRegularHeader(ctx, parent)
+	chainParams := k.bitcoinChainParams(ctx, header.ChainId)
+	if err := header.Header.ValidateDifficulty(parent.Header, windowStart, lastRegular, header.Height, chainParams); err != nil {
+		return cosmoserrors.Wrap(types.ErrInvalidDifficulty, err.Error())
+	}
+	cumulativeWork := new(big.Int).Add(parentWork, work)
+	k.SetBlockHeader(ctx, header)
+	k.SetBlockHeaderWork(ctx, header.Hash, cumulativeWork)
+
+	// headers not extending the chain with the most work are kept as forks
+	if latestWork, found := k.GetBlockHeaderWork(ctx, chainState.LatestBlockHash); found && cumulativeWork.Cmp(latestWork) <= 0 {
+		return nil
+	}
+	// the new canonical chain can be shorter than the previous one
+	for height := header.Height + 1; height <= chainState.LatestHeight; height++ {
+		k.RemoveBlockHeaderHashByHeight(ctx, header.ChainId, height)
+	}
+	k.SetBlockHeaderHashByHeight(ctx, header.ChainId, header.Height, header.Hash)
+	parentHash := header.ParentHash
+	for height := header.Height - 1; height >= chainState.EarliestHeight; height-- {
+		canonicalHash, found := k.GetBlockHeaderHashByHeight(ctx, header.ChainId, height)
+		if found && bytes.Equal(canonicalHash, parentHash) {
+			break
+		}
+		k.SetBlockHeaderHashByHeight(ctx, header.ChainId, height, parentHash)
+		ancestor, found := k.GetBlockHeader(ctx, parentHash)
+		if !found {
+			break
+		}
+		parentHash = ancestor.ParentHash
+	}
+	chainState.LatestHeight = header.Height
+	chainState.LatestBlockHash = header.Hash
+	k.SetChainState(ctx, chainState)
+	return nil
+}
+
+// bitcoinRetargetWindowStart returns the header at the start of the difficulty retarget window for a Bitcoin retarget block
+// nil is returned if the header is not a retarget block or if the window start is before the earliest header
+func (k Keeper) bitcoinRetargetWindowStart(ctx sdk.Context, header common.BlockHeader) *common.HeaderData {
+	chainParams := k.bitcoinChainParams(ctx, header.ChainId)
+	if chainParams == nil {
+		return nil
+	}
+	interval := common.BitcoinRetargetInterval(chainParams)
+	if header.Height%interval != 0 {
+		return nil
+	}
+	hash, found := k.GetBlockHeaderHashByHeight(ctx, header.ChainId, header.Height-interval)
+	if !found {
+		return nil
+	}
+	windowStart, found := k.GetBlockHeader(ctx, hash)
+	if !found {
+		return nil
+	}
+	return &windowStart.Header
+}
+
+// bitcoinLastRegularHeader returns the last ancestor of a Bitcoin header, starting from the parent, that is not a
+// min-difficulty block, the blocks at a retarget height always have a regular difficulty
+// nil is returned if the chain is not a Bitcoin chain or if the ancestor is before the earliest header
+func (k Keeper) bitcoinLastRegularHeader(ctx sdk.Context, parent common.BlockHeader) *common.HeaderData {
+	chainParams := k.bitcoinChainParams(ctx, parent.ChainId)
+	if chainParams == nil {
+		return nil
+	}
+	interval := common.BitcoinRetargetInterval(chainParams)
+	ancestor := parent
+	for {
+		minDifficulty, err := ancestor.Header.IsBitcoinMinDifficulty(chainParams)
+		if err != nil {
+			return nil
+		}
+		if !minDifficulty || ancestor.Height%interval == 0 {
+			return &ancestor.Header
+		}
+		next, found := k.GetBlockHeader(ctx, ancestor.ParentHash)
+		if !found {
+			return nil
+		}
+		ancestor = next
+	}
+}
+
+// bitcoinChainParams returns the Bitcoin network params of a chain, nil is returned if the chain is not a Bitcoin chain
+func (k Keeper) bitcoinChainParams(ctx sdk.Context, chainID int64) *chaincfg.Params {
+	chainInfo, found := k.GetChainInfo(ctx, chainID)
+	if !found || !chainInfo.IsBitcoinChain() {
+		return nil
+	}
+	chainParams, err := chainInfo.BTCChainParams()
+	if err != nil {
+		return nil
+	}
+	return chainParams
+}
+
 // GetAllBlockHeaders queries all for block header
 func (k Keeper) GetAllBlockHeaders(c context.Context, req *types.QueryAllBlockHeaderRequest) (*types.QueryAllBlockHeaderResponse, error) {
 	if req == nil {
@@ -76,3 +286,17 @@ func (k Keeper) GetBlockHeaderByHash(c context.Context, req *types.QueryGetBlock
 
 	return &types.QueryGetBlockHeaderByHashResponse{BlockHeader: &header}, nil
 }
+
+// ChainState queries the state of the block header chain of a chain
+func (k Keeper) ChainState(c context.Context, req *types.QueryGetChainStateRequest) (*types.QueryGetChainStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	chainState, found := k.GetChainState(sdk.UnwrapSDKContext(c), req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetChainStateResponse{ChainState: &chainState}, nil
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// newTestHeader returns a block header for the header chain tests, ethereum headers have no difficulty check
+func newTestHeader(hash, parentHash byte, height int64) common.BlockHeader {
+	return common.BlockHeader{
+		Height:     height,
+		Hash:       []byte{hash},
+		ParentHash: []byte{parentHash},
+		ChainId:    common.GoerliChain().ChainId,
+		Header:     common.NewEthereumHeader([]byte{hash}),
+	}
+}
+
+// btcTestNetChain is the Bitcoin testnet chain, defined here since it is only a default chain of the testnet build
+var btcTestNetChain = common.Chain{ChainName: common.ChainName_btc_testnet, ChainId: 18332}
+
+// newTestBitcoinHeader returns a Bitcoin testnet header for the header chain tests, only the difficulty bits and the
+// timestamp are used to validate the difficulty
+func newTestBitcoinHeader(t *testing.T, hash, parentHash byte, height int64, bits uint32, timestamp time.Time) common.BlockHeader {
+	var buf bytes.Buffer
+	require.NoError(t, (&wire.BlockHeader{Bits: bits, Timestamp: timestamp}).Serialize(&buf))
+	return common.BlockHeader{
+		Height:     height,
+		Hash:       []byte{hash},
+		ParentHash: []byte{parentHash},
+		ChainId:    btcTestNetChain.ChainId,
+		Header:     common.NewBitcoinHeader(buf.Bytes()),
+	}
+}
+
+// setupHeaderChain sets the bootstrap height of the block header chain of a chain
+func setupHeaderChain(k *keeper.Keeper, ctx sdk.Context, chainID int64, bootstrapHeight int64) {
+	k.SetCoreParams(ctx, types.CoreParamsList{CoreParams: []*types.CoreParams{
+		{ChainId: chainID, BlockHeaderBootstrapHeight: bootstrapHeight},
+	}})
+}
+
+func TestKeeper_AddBlockHeaderToChain(t *testing.T) {
+	chainID := common.GoerliChain().ChainId
+
+	t.Run("first header bootstraps the chain state", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		setupHeaderChain(k, ctx, chainID, 100)
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestHeader(1, 0, 100)))
+
+		chainState, found := k.GetChainState(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, types.ChainState{
+			ChainId:         chainID,
+			EarliestHeight:  100,
+			LatestHeight:    100,
+			LatestBlockHash: []byte{1},
+		}, chainState)
+	})
+
+	t.Run("fail if the first header is not at the bootstrap height", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		err := k.AddBlockHeaderToChain(ctx, newTestHeader(1, 0, 100))
+		require.ErrorIs(t, err, types.ErrCoreParamsNotSet)
+
+		setupHeaderChain(k, ctx, chainID, 0)
+		err = k.AddBlockHeaderToChain(ctx, newTestHeader(1, 0, 100))
+		require.ErrorIs(t, err, types.ErrInvalidHeaderHeight)
+
+		setupHeaderChain(k, ctx, chainID, 101)
+		err = k.AddBlockHeaderToChain(ctx, newTestHeader(1, 0, 100))
+		require.ErrorIs(t, err, types.ErrInvalidHeaderHeight)
+		_, found := k.GetChainState(ctx, chainID)
+		require.False(t, found)
+	})
+
+	t.Run("fail if parent is unknown", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		setupHeaderChain(k, ctx, chainID, 100)
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestHeader(1, 0, 100)))
+
+		err := k.AddBlockHeaderToChain(ctx, newTestHeader(3, 2, 102))
+		require.ErrorIs(t, err, types.ErrUnknownParentHeader)
+		_, found := k.GetBlockHeader(ctx, []byte{3})
+		require.False(t, found)
+	})
+
+	t.Run("fail if height doesn't follow the parent", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		setupHeaderChain(k, ctx, chainID, 100)
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestHeader(1, 0, 100)))
+
+		err := k.AddBlockHeaderToChain(ctx, newTestHeader(2, 1, 102))
+		require.ErrorIs(t, err, types.ErrInvalidHeaderHeight)
+	})
+
+	t.Run("longest chain is canonical", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		setupHeaderChain(k, ctx, chainID, 100)
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestHeader(1, 0, 100)))
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestHeader(2, 1, 101)))
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestHeader(3, 2, 102)))
+
+		// a fork from 1 is stored but not canonical
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestHeader(12, 1, 101)))
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestHeader(13, 12, 102)))
+		chainState, _ := k.GetChainState(ctx, chainID)
+		require.Equal(t, int64(102), chainState.LatestHeight)
+		require.Equal(t, []byte{3}, chainState.LatestBlockHash)
+		fork, found := k.GetBlockHeader(ctx, []byte{13})
+		require.True(t, found)
+		require.False(t, k.IsCanonicalBlockHeader(ctx, fork))
+
+		// the fork becomes the longest chain
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestHeader(14, 13, 103)))
+		chainState, _ = k.GetChainState(ctx, chainID)
+		require.Equal(t, int64(103), chainState.LatestHeight)
+		require.Equal(t, []byte{14}, chainState.LatestBlockHash)
+		require.True(t, k.IsCanonicalBlockHeader(ctx, fork))
+		for height, hash := range map[int64]byte{100: 1, 101: 12, 102: 13, 103: 14} {
+			canonicalHash, found := k.GetBlockHeaderHashByHeight(ctx, chainID, height)
+			require.True(t, found)
+			require.Equal(t, []byte{hash}, canonicalHash)
+		}
+		replaced, _ := k.GetBlockHeader(ctx, []byte{3})
+		require.False(t, k.IsCanonicalBlockHeader(ctx, replaced))
+	})
+
+	t.Run("the chain with the most work is canonical", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		btcChain := btcTestNetChain
+		k.SetChainInfo(ctx, common.ChainInfo{
+			Chain:                    btcChain,
+			VmFamily:                 common.VmFamily_bitcoin,
+			NetworkType:              common.NetworkType_testnet,
+			AddressCodec:             common.AddressCodec_bech32_btc,
+			DefaultConfirmationCount: 2,
+		})
+		bootstrapHeight := common.BitcoinRetargetInterval(&chaincfg.TestNet3Params) * 10
+		setupHeaderChain(k, ctx, btcChain.ChainId, bootstrapHeight)
+
+		regularBits := uint32(0x1a00ffff)
+		minBits := blockchain.BigToCompact(chaincfg.TestNet3Params.PowLimit)
+		minDelay := chaincfg.TestNet3Params.MinDiffReductionTime + time.Second
+		start := time.Unix(1600000000, 0)
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestBitcoinHeader(t, 1, 0, bootstrapHeight, regularBits, start)))
+
+		// a longer chain of min-difficulty blocks
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestBitcoinHeader(t, 2, 1, bootstrapHeight+1, minBits, start.Add(minDelay))))
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestBitcoinHeader(t, 3, 2, bootstrapHeight+2, minBits, start.Add(2*minDelay))))
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestBitcoinHeader(t, 4, 3, bootstrapHeight+3, minBits, start.Add(3*minDelay))))
+		chainState, _ := k.GetChainState(ctx, btcChain.ChainId)
+		require.Equal(t, []byte{4}, chainState.LatestBlockHash)
+
+		// the block following a min-difficulty block uses the difficulty of the last regular block
+		err := k.AddBlockHeaderToChain(ctx, newTestBitcoinHeader(t, 5, 4, bootstrapHeight+4, 0x1b00ffff, start.Add(3*minDelay+time.Minute)))
+		require.ErrorIs(t, err, types.ErrInvalidDifficulty)
+
+		// a shorter chain with more work replaces it
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestBitcoinHeader(t, 12, 1, bootstrapHeight+1, regularBits, start.Add(time.Minute))))
+		chainState, _ = k.GetChainState(ctx, btcChain.ChainId)
+		require.Equal(t, bootstrapHeight+1, chainState.LatestHeight)
+		require.Equal(t, []byte{12}, chainState.LatestBlockHash)
+		canonicalHash, found := k.GetBlockHeaderHashByHeight(ctx, btcChain.ChainId, bootstrapHeight+1)
+		require.True(t, found)
+		require.Equal(t, []byte{12}, canonicalHash)
+		for _, height := range []int64{bootstrapHeight + 2, bootstrapHeight + 3} {
+			_, found := k.GetBlockHeaderHashByHeight(ctx, btcChain.ChainId, height)
+			require.False(t, found)
+		}
+
+		// a regular block on top of the min-difficulty chain gives it the most work again
+		require.NoError(t, k.AddBlockHeaderToChain(ctx, newTestBitcoinHeader(t, 5, 4, bootstrapHeight+4, regularBits, start.Add(3*minDelay+time.Minute))))
+		chainState, _ = k.GetChainState(ctx, btcChain.ChainId)
+		require.Equal(t, []byte{5}, chainState.LatestBlockHash)
+		for height, hash := range map[int64]byte{bootstrapHeight + 1: 2, bootstrapHeight + 2: 3, bootstrapHeight + 3: 4, bootstrapHeight + 4: 5} {
+			canonicalHash, found := k.GetBlockHeaderHashByHeight(ctx, btcChain.ChainId, height)
+			require.True(t, found)
+			require.Equal(t, []byte{hash}, canonicalHash)
+		}
+	})
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/observer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetChainInfo sets the registry entry of a chain in the store
+func (k Keeper) SetChainInfo(ctx sdk.Context, chainInfo common.ChainInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainInfoKey))
+	b := k.cdc.MustMarshal(&chainInfo)
+	store.Set(types.GetChainInfoKey(chainInfo.Chain.ChainId), b)
+}
+
+// GetChainInfo returns the registry entry of a chain
+// if the registry has not been set in the store, the entry is looked up in the default chains of the build
+func (k Keeper) GetChainInfo(ctx sdk.Context, chainID int64) (val common.ChainInfo, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainInfoKey))
+	b := store.Get(types.GetChainInfoKey(chainID))
+	if b == nil {
+		if k.IsChainRegistrySet(ctx) {
+			return val, false
+		}
+		for _, info := range common.DefaultChainInfoList() {
+			if info.Chain.ChainId == chainID {
+				return info, true
+			}
+		}
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// IsChainRegistrySet returns true if the chain registry has been set in the store
+func (k Keeper) IsChainRegistrySet(ctx sdk.Context) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainInfoKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// RemoveChainInfo removes the registry entry of a chain from the store
+func (k Keeper) RemoveChainInfo(ctx sdk.Context, chainID int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainInfoKey))
+	store.Delete(types.GetChainInfoKey(chainID))
+}
+
+// GetAllChainInfo returns all the entries of the chain registry
+// if the registry has not been set in the store, the default chains of the build are returned
+func (k Keeper) GetAllChainInfo(ctx sdk.Context) (list []common.ChainInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainInfoKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val common.ChainInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	if len(list) == 0 {
+		return common.DefaultChainInfoList()
+	}
+	return
+}
+
+// GetRegisteredChains returns the chains of the chain registry
+func (k Keeper) GetRegisteredChains(ctx sdk.Context) []*common.Chain {
+	infos := k.GetAllChainInfo(ctx)
+	chains := make([]*common.Chain, len(infos))
+	for i := range infos {
+		chains[i] = &infos[i].Chain
+	}
+	return chains
+}
+
+// IsEVMChain returns true if the chain is registered as an EVM chain
+func (k Keeper) IsEVMChain(ctx sdk.Context, chainID int64) bool {
+	info, found := k.GetChainInfo(ctx, chainID)
+	return found && info.IsEVMChain()
+}
+
+// IsBitcoinChain returns true if the chain is registered as a Bitcoin chain
+func (k Keeper) IsBitcoinChain(ctx sdk.Context, chainID int64) bool {
+	info, found := k.GetChainInfo(ctx, chainID)
+	return found && info.IsBitcoinChain()
+}
+
+// onboardChain sets a newly registered chain as supported in the observer params and sets the current observers
+// as observers of the chain
+func (k Keeper) onboardChain(ctx sdk.Context, chain common.Chain) {
+	params := k.GetParams(ctx)
+	found := false
+	for _, observerParams := range params.ObserverParams {
+		if observerParams.Chain != nil && observerParams.Chain.ChainId == chain.ChainId {
+			observerParams.IsSupported = true
+			found = true
+		}
+	}
+	if !found {
+		params.ObserverParams = append(params.ObserverParams, types.DefaultObserverParams(&chain))
+	}
+	k.SetParams(ctx, params)
+
+	if _, found := k.GetObserverMapper(ctx, &chain); found {
+		return
+	}
+	observers := k.GetAllObserverAddresses(ctx)
+	if len(observers) == 0 {
+		return
+	}
+	k.SetObserverMapper(ctx, &types.ObserverMapper{
+		ObserverChain: &chain,
+		ObserverList:  observers,
+	})
+
+	// update the observer count so the new mapper is not considered as an unexpected change of the observer set
+	totalObserverCount := uint64(0)
+	for _, mapper := range k.GetAllObserverMappers(ctx) {
+		totalObserverCount += uint64(len(mapper.ObserverList))
+	}
+	k.SetLastObserverCount(ctx, &types.LastObserverCount{Count: totalObserverCount, LastChangeHeight: ctx.BlockHeight()})
+}
+
+// Queries
+
+func (k Keeper) ChainInfo(c context.Context, req *types.QueryGetChainInfoRequest) (*types.QueryGetChainInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info, found := k.GetChainInfo(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &types.QueryGetChainInfoResponse{ChainInfo: info}, nil
+}
+
+func (k Keeper) ChainInfoAll(c context.Context, req *types.QueryAllChainInfoRequest) (*types.QueryAllChainInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAllChainInfoResponse{ChainInfo: k.GetAllChainInfo(ctx)}, nil
+}
@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+)
+
+func TestKeeper_GetChainInfo(t *testing.T) {
+	t.Run("default chains are returned if the registry is not set", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		require.False(t, k.IsChainRegistrySet(ctx))
+		for _, chain := range common.DefaultChainsList() {
+			info, found := k.GetChainInfo(ctx, chain.ChainId)
+			require.True(t, found)
+			require.Equal(t, *chain, info.Chain)
+		}
+		require.Equal(t, common.DefaultChainInfoList(), k.GetAllChainInfo(ctx))
+		require.True(t, k.IsBitcoinChain(ctx, common.BtcChainID()))
+		require.False(t, k.IsEVMChain(ctx, common.BtcChainID()))
+	})
+
+	t.Run("only the chains of the registry are returned once it is set", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		chainInfo := newChainInfo(424242)
+		k.SetChainInfo(ctx, chainInfo)
+		require.True(t, k.IsChainRegistrySet(ctx))
+
+		info, found := k.GetChainInfo(ctx, chainInfo.Chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, chainInfo, info)
+		require.True(t, k.IsEVMChain(ctx, chainInfo.Chain.ChainId))
+		_, found = k.GetChainInfo(ctx, common.BtcChainID())
+		require.False(t, found)
+		require.Equal(t, []common.ChainInfo{chainInfo}, k.GetAllChainInfo(ctx))
+	})
+}
@@ -56,3 +56,61 @@ func (k Keeper) DisableInboundOnly(ctx sdk.Context) {
 	flags.IsInboundEnabled = false
 	k.SetCrosschainFlags(ctx, flags)
 }
+
+// SetChainCrosschainFlags sets the crosschain flags of a chain in the store
+func (k Keeper) SetChainCrosschainFlags(ctx sdk.Context, flags types.ChainCrosschainFlags) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainCrosschainFlagsKey))
+	b := k.cdc.MustMarshal(&flags)
+	store.Set(types.GetChainCrosschainFlagsKey(flags.ChainId), b)
+}
+
+// GetChainCrosschainFlags returns the crosschain flags of a chain
+func (k Keeper) GetChainCrosschainFlags(ctx sdk.Context, chainID int64) (val types.ChainCrosschainFlags, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainCrosschainFlagsKey))
+	b := store.Get(types.GetChainCrosschainFlagsKey(chainID))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllChainCrosschainFlags returns the crosschain flags of all the chains with paused inbounds or outbounds
+func (k Keeper) GetAllChainCrosschainFlags(ctx sdk.Context) (list []types.ChainCrosschainFlags) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainCrosschainFlagsKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ChainCrosschainFlags
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// RemoveChainCrosschainFlags removes the crosschain flags of a chain from the store
+func (k Keeper) RemoveChainCrosschainFlags(ctx sdk.Context, chainID int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainCrosschainFlagsKey))
+	store.Delete(types.GetChainCrosschainFlagsKey(chainID))
+}
+
+// IsChainInboundEnabled returns false if the inbounds of the chain are paused
+// the global crosschain flags are not checked
+func (k Keeper) IsChainInboundEnabled(ctx sdk.Context, chainID int64) bool {
+	flags, found := k.GetChainCrosschainFlags(ctx, chainID)
+	if !found {
+		return true
+	}
+	return flags.IsInboundEnabled
+}
+
+// IsChainOutboundEnabled returns false if the outbounds of the chain are paused
+// the global crosschain flags are not checked
+func (k Keeper) IsChainOutboundEnabled(ctx sdk.Context, chainID int64) bool {
+	flags, found := k.GetChainCrosschainFlags(ctx, chainID)
+	if !found {
+		return true
+	}
+	return flags.IsOutboundEnabled
+}
@@ -44,3 +44,48 @@ func EmitEventAddObserver(ctx sdk.Context, observerCount uint64, operatorAddress
 		ctx.Logger().Error("Error emitting EmitEventAddObserver :", err)
 	}
 }
+
+func EmitEventAdminProposalApproved(ctx sdk.Context, proposal types.AdminProposal, approvals uint32, signer string) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventAdminProposalApproved{
+		MsgTypeUrl:       sdk.MsgTypeURL(&types.MsgApproveAdminProposal{}),
+		ProposalId:       proposal.Id,
+		Approvals:        approvals,
+		ExecutableHeight: proposal.ExecutableHeight,
+		Signer:           signer,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventAdminProposalApproved :", err)
+	}
+}
+
+// EmitEventAdminProposalExecuted emits the result of the execution of an admin proposal, executionErr is nil if the
+// execution succeeded
+func EmitEventAdminProposalExecuted(ctx sdk.Context, proposalID uint64, executionErr error, signer string) {
+	event := &types.EventAdminProposalExecuted{
+		MsgTypeUrl: sdk.MsgTypeURL(&types.MsgExecuteAdminProposal{}),
+		ProposalId: proposalID,
+		Success:    executionErr == nil,
+		Signer:     signer,
+	}
+	if executionErr != nil {
+		event.Error = executionErr.Error()
+	}
+	err := ctx.EventManager().EmitTypedEvents(event)
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventAdminProposalExecuted :", err)
+	}
+}
+
+// EmitEventNodeDisabled emits the disabling of a node that reached the maximum number of blames in the blame window
+func EmitEventNodeDisabled(ctx sdk.Context, score types.NodeBlameScore, windowBlocks int64) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventNodeDisabled{
+		MsgTypeUrl:   sdk.MsgTypeURL(&types.MsgAddBlameVote{}),
+		Operator:     score.Operator,
+		PubKey:       score.PubKey,
+		BlameCount:   score.Score(),
+		WindowBlocks: windowBlocks,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventNodeDisabled :", err)
+	}
+}
@@ -22,3 +22,40 @@ func (k Keeper) CrosschainFlags(c context.Context, req *types.QueryGetCrosschain
 
 	return &types.QueryGetCrosschainFlagsResponse{CrosschainFlags: val}, nil
 }
+
+func (k Keeper) ChainCrosschainFlags(c context.Context, req *types.QueryGetChainCrosschainFlagsRequest) (*types.QueryGetChainCrosschainFlagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if k.GetParams(ctx).GetChainFromChainID(req.ChainId) == nil {
+		return nil, status.Error(codes.NotFound, "chain not supported")
+	}
+	val, found := k.GetChainCrosschainFlags(ctx, req.ChainId)
+	if !found {
+		val = types.DefaultChainCrosschainFlags(req.ChainId)
+	}
+
+	return &types.QueryGetChainCrosschainFlagsResponse{ChainCrosschainFlags: val}, nil
+}
+
+// ChainCrosschainFlagsAll returns the crosschain flags of all the supported chains, including the chains that are not paused
+func (k Keeper) ChainCrosschainFlagsAll(c context.Context, req *types.QueryAllChainCrosschainFlagsRequest) (*types.QueryAllChainCrosschainFlagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chains := k.GetParams(ctx).GetSupportedChains()
+	list := make([]types.ChainCrosschainFlags, 0, len(chains))
+	for _, chain := range chains {
+		val, found := k.GetChainCrosschainFlags(ctx, chain.ChainId)
+		if !found {
+			val = types.DefaultChainCrosschainFlags(chain.ChainId)
+		}
+		list = append(list, val)
+	}
+
+	return &types.QueryAllChainCrosschainFlagsResponse{ChainCrosschainFlags: list}, nil
+}
@@ -31,6 +31,9 @@ func (k Keeper) Prove(c context.Context, req *types.QueryProveRequest) (*types.Q
 	if !found {
 		return nil, status.Error(codes.NotFound, "block header not found")
 	}
+	if !k.IsCanonicalBlockHeader(ctx, res) {
+		return nil, status.Error(codes.NotFound, "block header not in the canonical chain")
+	}
 
 	proven := false
 
@@ -39,7 +42,7 @@ func (k Keeper) Prove(c context.Context, req *types.QueryProveRequest) (*types.Q
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	if err == nil {
-		if common.IsEVMChain(req.ChainId) {
+		if k.IsEVMChain(ctx, req.ChainId) {
 			var txx ethtypes.Transaction
 			err = txx.UnmarshalBinary(txBytes)
 			if err != nil {
@@ -49,7 +52,7 @@ func (k Keeper) Prove(c context.Context, req *types.QueryProveRequest) (*types.Q
 				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("tx hash mismatch: %s != %s", txx.Hash().Hex(), req.TxHash))
 			}
 			proven = true
-		} else if common.IsBitcoinChain(req.ChainId) {
+		} else if k.IsBitcoinChain(ctx, req.ChainId) {
 			tx, err := btcutil.NewTxFromBytes(txBytes)
 			if err != nil {
 				return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unmarshal btc transaction: %s", err))
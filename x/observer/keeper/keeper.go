@@ -19,6 +19,7 @@ type (
 		memKey        storetypes.StoreKey
 		paramstore    paramtypes.Subspace
 		stakingKeeper types.StakingKeeper
+		msgRouter     types.MsgRouter
 	}
 )
 
@@ -28,7 +29,7 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
 	stakingKeeper types.StakingKeeper,
-
+	msgRouter types.MsgRouter,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -41,6 +42,7 @@ func NewKeeper(
 		memKey:        memKey,
 		paramstore:    ps,
 		stakingKeeper: stakingKeeper,
+		msgRouter:     msgRouter,
 	}
 }
 
@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store"
@@ -42,6 +43,7 @@ func SetupKeeper(t testing.TB) (*Keeper, sdk.Context) {
 		memStoreKey,
 		paramsSubspace,
 		stakingkeeper.Keeper{},
+		baseapp.NewMsgServiceRouter(),
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, nil)
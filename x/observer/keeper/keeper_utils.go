@@ -49,6 +49,11 @@ func (k Keeper) FindBallot(ctx sdk.Context, index string, chain *common.Chain, o
 	isNew = false
 	ballot, found := k.GetBallot(ctx, index)
 	if !found {
+		// the ballot must not be created again if it has been finalized and pruned
+		if k.IsBallotPruned(ctx, index) {
+			err = errors.Wrap(types.ErrBallotPruned, fmt.Sprintf("ballot %s", index))
+			return
+		}
 		observerMapper, _ := k.GetObserverMapper(ctx, chain)
 		obsParams := k.GetParams(ctx).GetParamsForChain(chain)
 		if !obsParams.IsSupported {
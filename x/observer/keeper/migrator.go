@@ -4,6 +4,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v2 "github.com/zeta-chain/node/x/observer/migrations/v2"
 	v3 "github.com/zeta-chain/node/x/observer/migrations/v3"
+	v4 "github.com/zeta-chain/node/x/observer/migrations/v4"
+	v5 "github.com/zeta-chain/node/x/observer/migrations/v5"
+	v6 "github.com/zeta-chain/node/x/observer/migrations/v6"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -27,3 +30,18 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.observerKeeper)
 }
+
+// Migrate3to4 migrates the store from consensus version 3 to 4
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.observerKeeper)
+}
+
+// Migrate4to5 migrates the store from consensus version 4 to 5
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.observerKeeper)
+}
+
+// Migrate5to6 migrates the store from consensus version 5 to 6
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.observerKeeper)
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/zeta-chain/node/x/observer/types"
 )
 
+// AddBlameVote votes on the blame data of a failed keysign.
+// Once the blame is finalized, it is added to the blame scores of the blamed nodes and a node reaching the maximum
+// number of blames in the blame window is disabled.
 func (k msgServer) AddBlameVote(goCtx context.Context, vote *types.MsgAddBlameVote) (*types.MsgAddBlameVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	observationType := types.ObservationType_TSSKeySign
@@ -51,6 +54,10 @@ func (k msgServer) AddBlameVote(goCtx context.Context, vote *types.MsgAddBlameVo
 	// below only happens when ballot is finalized: exactly when threshold vote is in
 	// ******************************************************************************
 
+	// a blame finalized again with different blame data is only counted once in the blame scores
+	if _, found := k.GetBlame(ctx, vote.BlameInfo.Index); !found {
+		k.ProcessBlame(ctx, vote.BlameInfo)
+	}
 	k.SetBlame(ctx, vote.BlameInfo)
 	return &types.MsgAddBlameVoteResponse{}, nil
 }
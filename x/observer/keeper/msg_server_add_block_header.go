@@ -15,10 +15,17 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// check authorization for this chain
-	chain := common.GetChainFromChainID(msg.ChainId)
+	chainInfo, found := k.GetChainInfo(ctx, msg.ChainId)
+	if !found {
+		return nil, cosmoserrors.Wrapf(types.ErrSupportedChains, "chain id %d", msg.ChainId)
+	}
+	chain := &chainInfo.Chain
 	if ok := k.IsAuthorized(ctx, msg.Creator, chain); !ok {
 		return nil, types.ErrNotAuthorizedPolicy
 	}
+	if err := msg.Header.Validate(msg.BlockHash, chainInfo, msg.Height); err != nil {
+		return nil, cosmoserrors.Wrapf(types.ErrUnrecognizedBlockHeader, "invalid block header (%s)", err)
+	}
 
 	// add vote to ballot
 	ballot, _, err := k.FindBallot(ctx, msg.Digest(), chain, types.ObservationType_InBoundTx)
@@ -37,7 +44,7 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 	/**
 	 * Vote finalized, add block header to store
 	 */
-	_, found := k.GetBlockHeader(ctx, msg.BlockHash)
+	_, found = k.GetBlockHeader(ctx, msg.BlockHash)
 	if found {
 		hashString, err := common.HashToString(msg.ChainId, msg.BlockHash)
 		if err != nil {
@@ -58,8 +65,6 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 		return nil, cosmoserrors.Wrap(types.ErrNoParentHash, err.Error())
 	}
 
-	// TODO: add check for parent block header's existence here https://github.com/zeta-chain/node/issues/1133
-
 	bh := common.BlockHeader{
 		Header:     msg.Header,
 		Height:     msg.Height,
@@ -67,7 +72,9 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 		ParentHash: pHash,
 		ChainId:    msg.ChainId,
 	}
-	k.SetBlockHeader(ctx, bh)
+	if err := k.AddBlockHeaderToChain(ctx, bh); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgAddBlockHeaderResponse{}, nil
 }
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// SubmitAdminProposal submits privileged messages to be executed with the admin proposal account of a policy type.
+// Only the signers of the signer set of the policy type are authorized to broadcast this message, the submission counts
+// as the approval of the proposer and the proposal is executed right away if the threshold is reached without timelock.
+func (k msgServer) SubmitAdminProposal(goCtx context.Context, msg *types.MsgSubmitAdminProposal) (*types.MsgSubmitAdminProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signerSet, found := k.GetAdminSignerSet(ctx, msg.PolicyType)
+	if !found {
+		return nil, cosmoserrors.Wrapf(types.ErrAdminSignerSetNotFound, "policy type %s", msg.PolicyType.String())
+	}
+	if !signerSet.IsSigner(msg.Creator) {
+		return nil, types.ErrNotAuthorizedPolicy
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrInvalidAdminProposal, err.Error())
+	}
+	if err := types.ValidateAdminProposalMsgs(msg.PolicyType, msgs); err != nil {
+		return nil, err
+	}
+
+	id := k.GetAdminProposalCount(ctx) + 1
+	k.SetAdminProposalCount(ctx, id)
+	proposal := types.AdminProposal{
+		Id:           id,
+		PolicyType:   msg.PolicyType,
+		Proposer:     msg.Creator,
+		Messages:     msg.Messages,
+		SubmitHeight: ctx.BlockHeight(),
+		Status:       types.AdminProposalStatus_AdminProposalPending,
+	}
+
+	msgTypeURLs := make([]string, len(msgs))
+	for i, m := range msgs {
+		msgTypeURLs[i] = sdk.MsgTypeURL(m)
+	}
+	err = ctx.EventManager().EmitTypedEvents(&types.EventAdminProposalSubmitted{
+		MsgTypeUrl:      sdk.MsgTypeURL(&types.MsgSubmitAdminProposal{}),
+		ProposalId:      id,
+		PolicyType:      msg.PolicyType,
+		MessageTypeUrls: msgTypeURLs,
+		Signer:          msg.Creator,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventAdminProposalSubmitted :", err)
+	}
+
+	k.approveAdminProposal(ctx, &proposal, signerSet, msg.Creator)
+
+	return &types.MsgSubmitAdminProposalResponse{ProposalId: id}, nil
+}
+
+// ApproveAdminProposal approves a pending admin proposal.
+// Only the signers of the signer set of the policy type of the proposal are authorized to broadcast this message, the
+// proposal is executed right away if the threshold is reached and the timelock is over.
+func (k msgServer) ApproveAdminProposal(goCtx context.Context, msg *types.MsgApproveAdminProposal) (*types.MsgApproveAdminProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, err := k.getPendingAdminProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	signerSet, found := k.GetAdminSignerSet(ctx, proposal.PolicyType)
+	if !found {
+		return nil, cosmoserrors.Wrapf(types.ErrAdminSignerSetNotFound, "policy type %s", proposal.PolicyType.String())
+	}
+	if !signerSet.IsSigner(msg.Creator) {
+		return nil, types.ErrNotAuthorizedPolicy
+	}
+	if proposal.HasApproved(msg.Creator) {
+		return nil, cosmoserrors.Wrapf(types.ErrAdminProposalApproved, "proposal %d signer %s", proposal.Id, msg.Creator)
+	}
+
+	k.approveAdminProposal(ctx, &proposal, signerSet, msg.Creator)
+
+	return &types.MsgApproveAdminProposalResponse{}, nil
+}
+
+// ExecuteAdminProposal executes a pending admin proposal that reached the threshold of approvals once the timelock is
+// over, used when the proposal could not be executed with its last approval.
+// Any account can broadcast this message, the transaction fails if the execution of a message of the proposal fails.
+func (k msgServer) ExecuteAdminProposal(goCtx context.Context, msg *types.MsgExecuteAdminProposal) (*types.MsgExecuteAdminProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, err := k.getPendingAdminProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.executeAdminProposal(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	proposal.Status = types.AdminProposalStatus_AdminProposalExecuted
+	k.SetAdminProposal(ctx, proposal)
+	EmitEventAdminProposalExecuted(ctx, proposal.Id, nil, msg.Creator)
+
+	return &types.MsgExecuteAdminProposalResponse{}, nil
+}
+
+// CancelAdminProposal cancels a pending admin proposal.
+// Only the proposer and the signers of the signer set of the policy type of the proposal are authorized to broadcast
+// this message.
+func (k msgServer) CancelAdminProposal(goCtx context.Context, msg *types.MsgCancelAdminProposal) (*types.MsgCancelAdminProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, err := k.getPendingAdminProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	signerSet, found := k.GetAdminSignerSet(ctx, proposal.PolicyType)
+	if msg.Creator != proposal.Proposer && !(found && signerSet.IsSigner(msg.Creator)) {
+		return nil, types.ErrNotAuthorizedPolicy
+	}
+
+	proposal.Status = types.AdminProposalStatus_AdminProposalCancelled
+	k.SetAdminProposal(ctx, proposal)
+
+	err = ctx.EventManager().EmitTypedEvents(&types.EventAdminProposalCancelled{
+		MsgTypeUrl: sdk.MsgTypeURL(&types.MsgCancelAdminProposal{}),
+		ProposalId: proposal.Id,
+		Signer:     msg.Creator,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventAdminProposalCancelled :", err)
+	}
+
+	return &types.MsgCancelAdminProposalResponse{}, nil
+}
+
+// getPendingAdminProposal returns an admin proposal that is still pending
+func (k Keeper) getPendingAdminProposal(ctx sdk.Context, id uint64) (types.AdminProposal, error) {
+	proposal, found := k.GetAdminProposal(ctx, id)
+	if !found {
+		return proposal, cosmoserrors.Wrapf(types.ErrAdminProposalNotFound, "proposal %d", id)
+	}
+	if proposal.Status != types.AdminProposalStatus_AdminProposalPending {
+		return proposal, cosmoserrors.Wrapf(types.ErrAdminProposalNotPending, "proposal %d status %s", id, proposal.Status.String())
+	}
+	return proposal, nil
+}
@@ -0,0 +1,334 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// setAdminSignerSet sets the admin policy account of the group and sets a signer set for the group with this account
+func setAdminSignerSet(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	group types.Policy_Type,
+	threshold uint32,
+	timelock int64,
+) types.AdminSignerSet {
+	admin := sample.AccAddress()
+	params := types.DefaultParams()
+	params.AdminPolicy = []*types.Admin_Policy{
+		{
+			PolicyType: group,
+			Address:    admin,
+		},
+	}
+	k.SetParams(ctx, params)
+
+	signerSet := types.AdminSignerSet{
+		PolicyType: group,
+		Signers:    []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()},
+		Threshold:  threshold,
+		Timelock:   timelock,
+	}
+	srv := keeper.NewMsgServerImpl(*k)
+	_, err := srv.UpdateAdminSignerSet(sdk.WrapSDKContext(ctx), types.NewMsgUpdateAdminSignerSet(admin, signerSet))
+	require.NoError(t, err)
+	return signerSet
+}
+
+// submitDisableCrosschainFlags submits a proposal disabling the crosschain transactions and updating the gas price
+// increase flags, requiring the group2 policy
+func submitDisableCrosschainFlags(t *testing.T, ctx sdk.Context, k *keeper.Keeper, proposer string) (uint64, error) {
+	msg, err := types.NewMsgSubmitAdminProposal(proposer, types.Policy_Type_group2, []sdk.Msg{
+		&types.MsgUpdateCrosschainFlags{
+			Creator:               types.AdminProposalAccount(types.Policy_Type_group2).String(),
+			IsInboundEnabled:      false,
+			IsOutboundEnabled:     false,
+			GasPriceIncreaseFlags: &types.DefaultGasPriceIncreaseFlags,
+		},
+	})
+	require.NoError(t, err)
+	res, err := keeper.NewMsgServerImpl(*k).SubmitAdminProposal(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return 0, err
+	}
+	return res.ProposalId, nil
+}
+
+func requireCrosschainEnabled(t *testing.T, ctx sdk.Context, k *keeper.Keeper, enabled bool) {
+	flags, found := k.GetCrosschainFlags(ctx)
+	require.True(t, found)
+	require.Equal(t, enabled, flags.IsInboundEnabled)
+	require.Equal(t, enabled, flags.IsOutboundEnabled)
+}
+
+func TestMsgServer_UpdateAdminSignerSet(t *testing.T) {
+	t.Run("sets the signer set and the admin proposal account as policy account", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		signerSet := setAdminSignerSet(t, ctx, k, types.Policy_Type_group2, 2, 0)
+
+		got, found := k.GetAdminSignerSet(ctx, types.Policy_Type_group2)
+		require.True(t, found)
+		require.Equal(t, signerSet, got)
+		require.Equal(t,
+			types.AdminProposalAccount(types.Policy_Type_group2).String(),
+			k.GetParams(ctx).GetAdminPolicyAccount(types.Policy_Type_group2),
+		)
+	})
+
+	t.Run("cannot set the signer set if not the policy account", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		_, err := srv.UpdateAdminSignerSet(sdk.WrapSDKContext(ctx), types.NewMsgUpdateAdminSignerSet(
+			sample.AccAddress(),
+			sample.AdminSignerSet(types.Policy_Type_group2),
+		))
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+	})
+
+	t.Run("the signer set can be updated through an admin proposal", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		signerSet := setAdminSignerSet(t, ctx, k, types.Policy_Type_group2, 1, 0)
+
+		newSignerSet := sample.AdminSignerSet(types.Policy_Type_group2)
+		msg, err := types.NewMsgSubmitAdminProposal(signerSet.Signers[0], types.Policy_Type_group2, []sdk.Msg{
+			types.NewMsgUpdateAdminSignerSet(types.AdminProposalAccount(types.Policy_Type_group2).String(), newSignerSet),
+		})
+		require.NoError(t, err)
+		_, err = srv.SubmitAdminProposal(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		got, found := k.GetAdminSignerSet(ctx, types.Policy_Type_group2)
+		require.True(t, found)
+		require.Equal(t, newSignerSet, got)
+	})
+}
+
+func TestMsgServer_AdminProposal(t *testing.T) {
+	t.Run("proposal is executed once the threshold is reached", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		signerSet := setAdminSignerSet(t, ctx, k, types.Policy_Type_group2, 2, 0)
+		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
+
+		// the submission counts as the first approval
+		id, err := submitDisableCrosschainFlags(t, ctx, k, signerSet.Signers[0])
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), id)
+		proposal, found := k.GetAdminProposal(ctx, id)
+		require.True(t, found)
+		require.Equal(t, types.AdminProposalStatus_AdminProposalPending, proposal.Status)
+		require.Equal(t, []string{signerSet.Signers[0]}, proposal.Approvals)
+		requireCrosschainEnabled(t, ctx, k, true)
+
+		// a signer cannot approve twice
+		_, err = srv.ApproveAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgApproveAdminProposal(signerSet.Signers[0], id))
+		require.ErrorIs(t, err, types.ErrAdminProposalApproved)
+
+		// an address outside the signer set cannot approve
+		_, err = srv.ApproveAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgApproveAdminProposal(sample.AccAddress(), id))
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+
+		// the second approval reaches the threshold and executes the proposal
+		_, err = srv.ApproveAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgApproveAdminProposal(signerSet.Signers[1], id))
+		require.NoError(t, err)
+		proposal, found = k.GetAdminProposal(ctx, id)
+		require.True(t, found)
+		require.Equal(t, types.AdminProposalStatus_AdminProposalExecuted, proposal.Status)
+		require.Equal(t, ctx.BlockHeight(), proposal.ExecutableHeight)
+		requireCrosschainEnabled(t, ctx, k, false)
+
+		// an executed proposal cannot be approved
+		_, err = srv.ApproveAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgApproveAdminProposal(signerSet.Signers[2], id))
+		require.ErrorIs(t, err, types.ErrAdminProposalNotPending)
+	})
+
+	t.Run("proposal can only be executed after the timelock", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		signerSet := setAdminSignerSet(t, ctx, k, types.Policy_Type_group2, 2, 10)
+		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
+
+		id, err := submitDisableCrosschainFlags(t, ctx, k, signerSet.Signers[0])
+		require.NoError(t, err)
+		_, err = srv.ApproveAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgApproveAdminProposal(signerSet.Signers[1], id))
+		require.NoError(t, err)
+
+		proposal, found := k.GetAdminProposal(ctx, id)
+		require.True(t, found)
+		require.Equal(t, types.AdminProposalStatus_AdminProposalPending, proposal.Status)
+		require.Equal(t, ctx.BlockHeight()+10, proposal.ExecutableHeight)
+		requireCrosschainEnabled(t, ctx, k, true)
+
+		// cannot execute during the timelock
+		_, err = srv.ExecuteAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgExecuteAdminProposal(sample.AccAddress(), id))
+		require.ErrorIs(t, err, types.ErrAdminProposalNotExecutable)
+
+		// can execute once the timelock is over
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+		_, err = srv.ExecuteAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgExecuteAdminProposal(sample.AccAddress(), id))
+		require.NoError(t, err)
+		proposal, found = k.GetAdminProposal(ctx, id)
+		require.True(t, found)
+		require.Equal(t, types.AdminProposalStatus_AdminProposalExecuted, proposal.Status)
+		requireCrosschainEnabled(t, ctx, k, false)
+	})
+
+	t.Run("approvals of removed signers are not counted", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		signerSet := setAdminSignerSet(t, ctx, k, types.Policy_Type_group2, 2, 10)
+
+		id, err := submitDisableCrosschainFlags(t, ctx, k, signerSet.Signers[0])
+		require.NoError(t, err)
+		_, err = srv.ApproveAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgApproveAdminProposal(signerSet.Signers[1], id))
+		require.NoError(t, err)
+
+		// remove the proposer from the signer set
+		signerSet.Signers[0] = sample.AccAddress()
+		k.SetAdminSignerSet(ctx, signerSet)
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+		_, err = srv.ExecuteAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgExecuteAdminProposal(sample.AccAddress(), id))
+		require.ErrorIs(t, err, types.ErrAdminProposalNotExecutable)
+	})
+
+	t.Run("failed execution keeps the proposal pending", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		signerSet := setAdminSignerSet(t, ctx, k, types.Policy_Type_group1, 1, 0)
+		chain := *common.ExternalChainList()[1]
+
+		// pausing an unsupported chain fails
+		params := k.GetParams(ctx)
+		params.ObserverParams = nil
+		k.SetParams(ctx, params)
+		msg, err := types.NewMsgSubmitAdminProposal(signerSet.Signers[0], types.Policy_Type_group1, []sdk.Msg{
+			types.NewMsgUpdateChainCrosschainFlags(types.AdminProposalAccount(types.Policy_Type_group1).String(), chain.ChainId, false, false),
+		})
+		require.NoError(t, err)
+		res, err := srv.SubmitAdminProposal(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		proposal, found := k.GetAdminProposal(ctx, res.ProposalId)
+		require.True(t, found)
+		require.Equal(t, types.AdminProposalStatus_AdminProposalPending, proposal.Status)
+
+		_, err = srv.ExecuteAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgExecuteAdminProposal(sample.AccAddress(), res.ProposalId))
+		require.ErrorIs(t, err, types.ErrSupportedChains)
+	})
+
+	t.Run("only signers can submit a proposal", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+
+		_, err := submitDisableCrosschainFlags(t, ctx, k, sample.AccAddress())
+		require.ErrorIs(t, err, types.ErrAdminSignerSetNotFound)
+
+		setAdminSignerSet(t, ctx, k, types.Policy_Type_group2, 2, 0)
+		_, err = submitDisableCrosschainFlags(t, ctx, k, sample.AccAddress())
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+	})
+
+	t.Run("proposal can be cancelled by a signer", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		signerSet := setAdminSignerSet(t, ctx, k, types.Policy_Type_group2, 2, 0)
+
+		id, err := submitDisableCrosschainFlags(t, ctx, k, signerSet.Signers[0])
+		require.NoError(t, err)
+
+		_, err = srv.CancelAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgCancelAdminProposal(sample.AccAddress(), id))
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+
+		_, err = srv.CancelAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgCancelAdminProposal(signerSet.Signers[2], id))
+		require.NoError(t, err)
+		proposal, found := k.GetAdminProposal(ctx, id)
+		require.True(t, found)
+		require.Equal(t, types.AdminProposalStatus_AdminProposalCancelled, proposal.Status)
+
+		_, err = srv.ApproveAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgApproveAdminProposal(signerSet.Signers[1], id))
+		require.ErrorIs(t, err, types.ErrAdminProposalNotPending)
+
+		_, err = srv.CancelAdminProposal(sdk.WrapSDKContext(ctx), types.NewMsgCancelAdminProposal(signerSet.Signers[2], 42))
+		require.ErrorIs(t, err, types.ErrAdminProposalNotFound)
+	})
+}
+
+func TestKeeper_IsEmergencyAdmin(t *testing.T) {
+	t.Run("a single group1 signer can pause but not resume", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		signerSet := setAdminSignerSet(t, ctx, k, types.Policy_Type_group1, 2, 10)
+		chain := *common.ExternalChainList()[1]
+		params := k.GetParams(ctx)
+		params.ObserverParams = []*types.ObserverParams{types.DefaultObserverParams(&chain)}
+		k.SetParams(ctx, params)
+
+		require.True(t, k.IsEmergencyAdmin(ctx, signerSet.Signers[1]))
+		require.True(t, k.IsEmergencyAdmin(ctx, types.AdminProposalAccount(types.Policy_Type_group1).String()))
+		require.False(t, k.IsEmergencyAdmin(ctx, sample.AccAddress()))
+
+		_, err := srv.UpdateChainCrosschainFlags(sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainCrosschainFlags(signerSet.Signers[1], chain.ChainId, false, false),
+		)
+		require.NoError(t, err)
+		require.False(t, k.IsChainInboundEnabled(ctx, chain.ChainId))
+
+		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateCrosschainFlags(signerSet.Signers[1], false, false),
+		)
+		require.NoError(t, err)
+		requireCrosschainEnabled(t, ctx, k, false)
+
+		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateCrosschainFlags(signerSet.Signers[1], true, true),
+		)
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+	})
+}
+
+func TestKeeper_PendingAdminProposals(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	executed := sample.AdminProposal(t, 3, types.Policy_Type_group1)
+	executed.Status = types.AdminProposalStatus_AdminProposalExecuted
+	proposals := []types.AdminProposal{
+		sample.AdminProposal(t, 1, types.Policy_Type_group1),
+		sample.AdminProposal(t, 2, types.Policy_Type_group2),
+		executed,
+	}
+	for _, proposal := range proposals {
+		k.SetAdminProposal(ctx, proposal)
+	}
+
+	res, err := k.PendingAdminProposals(wctx, &types.QueryPendingAdminProposalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.AdminProposals, 2)
+	require.Equal(t, uint64(1), res.AdminProposals[0].Id)
+	require.Equal(t, uint64(2), res.AdminProposals[1].Id)
+
+	res, err = k.PendingAdminProposals(wctx, &types.QueryPendingAdminProposalsRequest{
+		FilterPolicyType: true,
+		PolicyType:       types.Policy_Type_group2,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.AdminProposals, 1)
+	require.Equal(t, uint64(2), res.AdminProposals[0].Id)
+
+	proposal, err := k.AdminProposal(wctx, &types.QueryGetAdminProposalRequest{Id: 3})
+	require.NoError(t, err)
+	require.Equal(t, types.AdminProposalStatus_AdminProposalExecuted, proposal.AdminProposal.Status)
+
+	_, err = k.AdminProposal(wctx, &types.QueryGetAdminProposalRequest{Id: 4})
+	require.Error(t, err)
+}
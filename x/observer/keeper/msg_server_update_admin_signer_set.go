@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UpdateAdminSignerSet sets the signers, the threshold of approvals and the timelock of the admin proposals of a policy
+// type, and sets the admin proposal account of the policy type as its admin policy account.
+// Only the admin policy account of the policy type is authorized to broadcast this message, once the signer set is
+// configured it can only be updated through an admin proposal.
+func (k msgServer) UpdateAdminSignerSet(goCtx context.Context, msg *types.MsgUpdateAdminSignerSet) (*types.MsgUpdateAdminSignerSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	params := k.GetParams(ctx)
+	if msg.Creator != params.GetAdminPolicyAccount(msg.SignerSet.PolicyType) {
+		return &types.MsgUpdateAdminSignerSetResponse{}, types.ErrNotAuthorizedPolicy
+	}
+
+	k.SetAdminSignerSet(ctx, msg.SignerSet)
+
+	// the privileged messages of the policy type must now be submitted as admin proposals
+	policyAddress := types.AdminProposalAccount(msg.SignerSet.PolicyType).String()
+	for _, policy := range params.AdminPolicy {
+		if policy.PolicyType == msg.SignerSet.PolicyType {
+			policy.Address = policyAddress
+		}
+	}
+	k.SetParams(ctx, params)
+
+	err := ctx.EventManager().EmitTypedEvents(&types.EventAdminSignerSetUpdated{
+		MsgTypeUrl:    sdk.MsgTypeURL(&types.MsgUpdateAdminSignerSet{}),
+		SignerSet:     msg.SignerSet,
+		PolicyAddress: policyAddress,
+		Signer:        msg.Creator,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventAdminSignerSetUpdated :", err)
+	}
+
+	return &types.MsgUpdateAdminSignerSetResponse{}, nil
+}
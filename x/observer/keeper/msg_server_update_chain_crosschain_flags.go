@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UpdateChainCrosschainFlags pauses or resumes the inbounds and the outbounds of a single supported chain.
+// The flags of a chain only restrict the global crosschain flags, a chain can't be enabled if the global flag is disabled.
+// Only the admin policy account is authorized to broadcast this message, re-enabling a flag requires the group2 policy.
+// Pausing a chain is an emergency action that any single signer of the group1 signer set can also take.
+func (k msgServer) UpdateChainCrosschainFlags(goCtx context.Context, msg *types.MsgUpdateChainCrosschainFlags) (*types.MsgUpdateChainCrosschainFlagsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	flags, found := k.GetChainCrosschainFlags(ctx, msg.ChainId)
+	if !found {
+		flags = types.DefaultChainCrosschainFlags(msg.ChainId)
+	}
+
+	requiredGroup := types.Policy_Type_group1
+	if (msg.IsInboundEnabled && !flags.IsInboundEnabled) || (msg.IsOutboundEnabled && !flags.IsOutboundEnabled) {
+		requiredGroup = types.Policy_Type_group2
+	}
+
+	// check permission
+	if requiredGroup == types.Policy_Type_group1 {
+		if !k.IsEmergencyAdmin(ctx, msg.Creator) {
+			return &types.MsgUpdateChainCrosschainFlagsResponse{}, types.ErrNotAuthorizedPolicy
+		}
+	} else if msg.Creator != k.GetParams(ctx).GetAdminPolicyAccount(requiredGroup) {
+		return &types.MsgUpdateChainCrosschainFlagsResponse{}, types.ErrNotAuthorizedPolicy
+	}
+
+	if k.GetParams(ctx).GetChainFromChainID(msg.ChainId) == nil {
+		return &types.MsgUpdateChainCrosschainFlagsResponse{}, cosmoserrors.Wrap(
+			types.ErrSupportedChains,
+			fmt.Sprintf("chain %d is not supported", msg.ChainId),
+		)
+	}
+
+	// update values, the flags are only stored while the chain is paused
+	flags.IsInboundEnabled = msg.IsInboundEnabled
+	flags.IsOutboundEnabled = msg.IsOutboundEnabled
+	if flags.IsDefault() {
+		k.RemoveChainCrosschainFlags(ctx, msg.ChainId)
+	} else {
+		k.SetChainCrosschainFlags(ctx, flags)
+	}
+
+	err := ctx.EventManager().EmitTypedEvents(&types.EventChainCrosschainFlagsUpdated{
+		MsgTypeUrl:        sdk.MsgTypeURL(&types.MsgUpdateChainCrosschainFlags{}),
+		ChainId:           msg.ChainId,
+		IsInboundEnabled:  msg.IsInboundEnabled,
+		IsOutboundEnabled: msg.IsOutboundEnabled,
+		Signer:            msg.Creator,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventChainCrosschainFlagsUpdated :", err)
+	}
+
+	return &types.MsgUpdateChainCrosschainFlagsResponse{}, nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// setAdminChainCrosschainFlags sets the admin policy and the chain as the only supported chain
+func setAdminChainCrosschainFlags(ctx sdk.Context, k *keeper.Keeper, admin string, group types.Policy_Type, chain common.Chain) {
+	k.SetParams(ctx, types.Params{
+		ObserverParams: []*types.ObserverParams{types.DefaultObserverParams(&chain)},
+		AdminPolicy: []*types.Admin_Policy{
+			{
+				PolicyType: group,
+				Address:    admin,
+			},
+		},
+	})
+}
+
+func TestMsgServer_UpdateChainCrosschainFlags(t *testing.T) {
+	chain := *common.ExternalChainList()[1]
+
+	t.Run("can pause and resume a chain", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		setAdminChainCrosschainFlags(ctx, k, admin, types.Policy_Type_group1, chain)
+
+		// group 1 can pause the inbounds of the chain
+		_, err := srv.UpdateChainCrosschainFlags(sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainCrosschainFlags(admin, chain.ChainId, false, true),
+		)
+		require.NoError(t, err)
+		require.False(t, k.IsChainInboundEnabled(ctx, chain.ChainId))
+		require.True(t, k.IsChainOutboundEnabled(ctx, chain.ChainId))
+		flags, found := k.GetChainCrosschainFlags(ctx, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, chain.ChainId, flags.ChainId)
+
+		// other chains are not paused
+		require.True(t, k.IsChainInboundEnabled(ctx, common.BtcChainID()))
+
+		// group 1 cannot resume the inbounds of the chain
+		_, err = srv.UpdateChainCrosschainFlags(sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainCrosschainFlags(admin, chain.ChainId, true, false),
+		)
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+
+		// group 2 can resume the chain, the flags are removed once the chain is no longer paused
+		setAdminChainCrosschainFlags(ctx, k, admin, types.Policy_Type_group2, chain)
+		_, err = srv.UpdateChainCrosschainFlags(sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainCrosschainFlags(admin, chain.ChainId, true, true),
+		)
+		require.NoError(t, err)
+		require.True(t, k.IsChainInboundEnabled(ctx, chain.ChainId))
+		require.True(t, k.IsChainOutboundEnabled(ctx, chain.ChainId))
+		_, found = k.GetChainCrosschainFlags(ctx, chain.ChainId)
+		require.False(t, found)
+	})
+
+	t.Run("cannot update the flags if not authorized", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		setAdminChainCrosschainFlags(ctx, k, sample.AccAddress(), types.Policy_Type_group1, chain)
+
+		_, err := srv.UpdateChainCrosschainFlags(sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainCrosschainFlags(sample.AccAddress(), chain.ChainId, false, false),
+		)
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+	})
+
+	t.Run("cannot update the flags of an unsupported chain", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		setAdminChainCrosschainFlags(ctx, k, admin, types.Policy_Type_group1, chain)
+
+		_, err := srv.UpdateChainCrosschainFlags(sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainCrosschainFlags(admin, common.BtcChainID(), false, false),
+		)
+		require.ErrorIs(t, err, types.ErrSupportedChains)
+	})
+}
+
+func TestKeeper_ChainCrosschainFlagsQuery(t *testing.T) {
+	chain := *common.ExternalChainList()[1]
+	k, ctx := keepertest.ObserverKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	setAdminChainCrosschainFlags(ctx, k, sample.AccAddress(), types.Policy_Type_group1, chain)
+
+	// the chain is enabled by default
+	res, err := k.ChainCrosschainFlags(wctx, &types.QueryGetChainCrosschainFlagsRequest{ChainId: chain.ChainId})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultChainCrosschainFlags(chain.ChainId), res.ChainCrosschainFlags)
+
+	paused := types.ChainCrosschainFlags{ChainId: chain.ChainId, IsInboundEnabled: true, IsOutboundEnabled: false}
+	k.SetChainCrosschainFlags(ctx, paused)
+	res, err = k.ChainCrosschainFlags(wctx, &types.QueryGetChainCrosschainFlagsRequest{ChainId: chain.ChainId})
+	require.NoError(t, err)
+	require.Equal(t, paused, res.ChainCrosschainFlags)
+
+	resAll, err := k.ChainCrosschainFlagsAll(wctx, &types.QueryAllChainCrosschainFlagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ChainCrosschainFlags{paused}, resAll.ChainCrosschainFlags)
+
+	// unsupported chain
+	_, err = k.ChainCrosschainFlags(wctx, &types.QueryGetChainCrosschainFlagsRequest{ChainId: common.BtcChainID()})
+	require.Error(t, err)
+	_, err = k.ChainCrosschainFlags(wctx, nil)
+	require.Error(t, err)
+	_, err = k.ChainCrosschainFlagsAll(wctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UpdateChainInfo adds a chain to the chain registry or updates the confirmation count of a registered chain.
+// When the chain is new, it is added as a supported chain in the observer params and the current observers
+// are set as observers of the chain. The core params of the chain can then be set with UpdateCoreParams.
+//
+// The chains of the registry are resolved from the store by the modules and by zetaclient.
+//
+// Only the admin policy account is authorized to broadcast this message.
+func (k msgServer) UpdateChainInfo(goCtx context.Context, msg *types.MsgUpdateChainInfo) (*types.MsgUpdateChainInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.GetParams(ctx).GetAdminPolicyAccount(types.Policy_Type_group2) {
+		return &types.MsgUpdateChainInfoResponse{}, types.ErrNotAuthorizedPolicy
+	}
+
+	// the registry holds the default chains until the first update, they are persisted with the first update
+	if !k.IsChainRegistrySet(ctx) {
+		for _, info := range common.DefaultChainInfoList() {
+			k.SetChainInfo(ctx, info)
+		}
+	}
+
+	info, found := k.GetChainInfo(ctx, msg.ChainInfo.Chain.ChainId)
+	isNew := !found
+
+	// the nature of a registered chain cannot be changed
+	if found && (info.Chain.ChainName != msg.ChainInfo.Chain.ChainName ||
+		info.VmFamily != msg.ChainInfo.VmFamily ||
+		info.NetworkType != msg.ChainInfo.NetworkType ||
+		info.AddressCodec != msg.ChainInfo.AddressCodec) {
+		return &types.MsgUpdateChainInfoResponse{}, cosmoserrors.Wrap(
+			types.ErrInvalidChainInfo,
+			fmt.Sprintf("only the confirmation count of chain %d can be updated", info.Chain.ChainId),
+		)
+	}
+	k.SetChainInfo(ctx, msg.ChainInfo)
+
+	if isNew {
+		k.onboardChain(ctx, msg.ChainInfo.Chain)
+	}
+
+	err := ctx.EventManager().EmitTypedEvents(&types.EventChainInfoUpdated{
+		MsgTypeUrl: sdk.MsgTypeURL(&types.MsgUpdateChainInfo{}),
+		ChainInfo:  msg.ChainInfo,
+		IsNewChain: isNew,
+		Signer:     msg.Creator,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventChainInfoUpdated :", err)
+	}
+
+	return &types.MsgUpdateChainInfoResponse{}, nil
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func newChainInfo(chainID int64) common.ChainInfo {
+	return common.ChainInfo{
+		Chain:                    common.Chain{ChainId: chainID},
+		VmFamily:                 common.VmFamily_evm,
+		NetworkType:              common.NetworkType_testnet,
+		AddressCodec:             common.AddressCodec_hex,
+		DefaultConfirmationCount: 10,
+	}
+}
+
+func TestMsgServer_UpdateChainInfo(t *testing.T) {
+	t.Run("can add a new chain to the registry", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group2)
+
+		observer := sample.AccAddress()
+		existingChain := common.DefaultChainsList()[0]
+		k.SetObserverMapper(ctx, &types.ObserverMapper{
+			ObserverChain: existingChain,
+			ObserverList:  []string{observer},
+		})
+
+		chainInfo := newChainInfo(424242)
+		_, err := srv.UpdateChainInfo(sdk.WrapSDKContext(ctx), &types.MsgUpdateChainInfo{
+			Creator:   admin,
+			ChainInfo: chainInfo,
+		})
+		require.NoError(t, err)
+
+		// the default chains are persisted with the new chain
+		stored, found := k.GetChainInfo(ctx, chainInfo.Chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, chainInfo, stored)
+		require.Len(t, k.GetAllChainInfo(ctx), len(common.DefaultChainsList())+1)
+
+		// the chain is supported and observed by the current observers
+		require.True(t, k.GetParams(ctx).IsChainIDSupported(chainInfo.Chain.ChainId))
+		mapper, found := k.GetObserverMapper(ctx, &chainInfo.Chain)
+		require.True(t, found)
+		require.Equal(t, []string{observer}, mapper.ObserverList)
+		count, found := k.GetLastObserverCount(ctx)
+		require.True(t, found)
+		require.Equal(t, uint64(2), count.Count)
+
+		// the chain is resolved from the registry of the store
+		require.False(t, common.IsEVMChain(chainInfo.Chain.ChainId))
+		require.True(t, k.IsEVMChain(ctx, chainInfo.Chain.ChainId))
+		require.False(t, k.IsBitcoinChain(ctx, chainInfo.Chain.ChainId))
+
+		// the default chains are not persisted again by the next update
+		k.RemoveChainInfo(ctx, existingChain.ChainId)
+		_, err = srv.UpdateChainInfo(sdk.WrapSDKContext(ctx), &types.MsgUpdateChainInfo{
+			Creator:   admin,
+			ChainInfo: newChainInfo(424243),
+		})
+		require.NoError(t, err)
+		_, found = k.GetChainInfo(ctx, existingChain.ChainId)
+		require.False(t, found)
+	})
+
+	t.Run("can update the confirmation count of a registered chain", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group2)
+
+		chainInfo := newChainInfo(424242)
+		k.SetChainInfo(ctx, chainInfo)
+
+		chainInfo.DefaultConfirmationCount = 42
+		_, err := srv.UpdateChainInfo(sdk.WrapSDKContext(ctx), &types.MsgUpdateChainInfo{
+			Creator:   admin,
+			ChainInfo: chainInfo,
+		})
+		require.NoError(t, err)
+		stored, found := k.GetChainInfo(ctx, chainInfo.Chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, uint64(42), stored.DefaultConfirmationCount)
+
+		// the vm family cannot be updated
+		chainInfo.VmFamily = common.VmFamily_bitcoin
+		chainInfo.AddressCodec = common.AddressCodec_bech32_btc
+		_, err = srv.UpdateChainInfo(sdk.WrapSDKContext(ctx), &types.MsgUpdateChainInfo{
+			Creator:   admin,
+			ChainInfo: chainInfo,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidChainInfo)
+	})
+
+	t.Run("cannot update the registry if not authorized", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		setAdminCrossChainFlags(ctx, k, sample.AccAddress(), types.Policy_Type_group2)
+
+		_, err := srv.UpdateChainInfo(sdk.WrapSDKContext(ctx), &types.MsgUpdateChainInfo{
+			Creator:   sample.AccAddress(),
+			ChainInfo: newChainInfo(424242),
+		})
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+	})
+}
@@ -11,7 +11,10 @@ import (
 // confirmation count, outbound transaction schedule interval, ZETA token,
 // connector and ERC20 custody contract addresses, etc.
 //
-// Throws an error if the chain ID is not supported.
+// Throws an error if the chain ID is not supported or if the core params are invalid for the type of the chain in the
+// chain registry.
+// If the chain has no core params yet, for example a chain newly added to the chain registry,
+// the core params are added to the list.
 //
 // Only the admin policy account is authorized to broadcast this message.
 func (k msgServer) UpdateCoreParams(goCtx context.Context, msg *types.MsgUpdateCoreParams) (*types.MsgUpdateCoreParamsResponse, error) {
@@ -22,18 +25,30 @@ func (k msgServer) UpdateCoreParams(goCtx context.Context, msg *types.MsgUpdateC
 	if !k.GetParams(ctx).IsChainIDSupported(msg.CoreParams.ChainId) {
 		return &types.MsgUpdateCoreParamsResponse{}, types.ErrSupportedChains
 	}
+	chainInfo, found := k.GetChainInfo(ctx, msg.CoreParams.ChainId)
+	if !found {
+		return &types.MsgUpdateCoreParamsResponse{}, types.ErrSupportedChains
+	}
+	if err := types.ValidateCoreParams(msg.CoreParams, chainInfo); err != nil {
+		return &types.MsgUpdateCoreParamsResponse{}, err
+	}
 	coreParams, found := k.GetAllCoreParams(ctx)
 	if !found {
 		return &types.MsgUpdateCoreParamsResponse{}, types.ErrCoreParamsNotSet
 	}
 	newCoreParams := make([]*types.CoreParams, len(coreParams.CoreParams))
+	found = false
 	for i, cp := range coreParams.CoreParams {
 		if cp.ChainId == msg.CoreParams.ChainId {
 			newCoreParams[i] = msg.CoreParams
+			found = true
 			continue
 		}
 		newCoreParams[i] = cp
 	}
+	if !found {
+		newCoreParams = append(newCoreParams, msg.CoreParams)
+	}
 	k.SetCoreParams(ctx, types.CoreParamsList{CoreParams: newCoreParams})
 	return &types.MsgUpdateCoreParamsResponse{}, nil
 }
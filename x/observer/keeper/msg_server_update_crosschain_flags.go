@@ -8,17 +8,22 @@ import (
 )
 
 // UpdateCrosschainFlags updates the crosschain related flags.
-// Only the admin policy account is authorized to broadcast this message.
+// Only the admin policy account is authorized to broadcast this message, disabling the crosschain transactions is an
+// emergency action that any single signer of the group1 signer set can also take.
 func (k msgServer) UpdateCrosschainFlags(goCtx context.Context, msg *types.MsgUpdateCrosschainFlags) (*types.MsgUpdateCrosschainFlagsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	requiredGroup := types.Policy_Type_group1
-	if msg.IsInboundEnabled || msg.IsOutboundEnabled || msg.GasPriceIncreaseFlags != nil {
+	if msg.IsInboundEnabled || msg.IsOutboundEnabled || msg.GasPriceIncreaseFlags != nil || msg.GasPriceOracleFlags != nil {
 		requiredGroup = types.Policy_Type_group2
 	}
 
 	// check permission
-	if msg.Creator != k.GetParams(ctx).GetAdminPolicyAccount(requiredGroup) {
+	if requiredGroup == types.Policy_Type_group1 {
+		if !k.IsEmergencyAdmin(ctx, msg.Creator) {
+			return &types.MsgUpdateCrosschainFlagsResponse{}, types.ErrNotAuthorizedPolicy
+		}
+	} else if msg.Creator != k.GetParams(ctx).GetAdminPolicyAccount(requiredGroup) {
 		return &types.MsgUpdateCrosschainFlagsResponse{}, types.ErrNotAuthorizedPolicy
 	}
 
@@ -36,6 +41,10 @@ func (k msgServer) UpdateCrosschainFlags(goCtx context.Context, msg *types.MsgUp
 		flags.GasPriceIncreaseFlags = msg.GasPriceIncreaseFlags
 	}
 
+	if msg.GasPriceOracleFlags != nil {
+		flags.GasPriceOracleFlags = msg.GasPriceOracleFlags
+	}
+
 	k.SetCrosschainFlags(ctx, flags)
 
 	err := ctx.EventManager().EmitTypedEvents(&types.EventCrosschainFlagsUpdated{
@@ -44,6 +53,7 @@ func (k msgServer) UpdateCrosschainFlags(goCtx context.Context, msg *types.MsgUp
 		IsOutboundEnabled:     msg.IsOutboundEnabled,
 		GasPriceIncreaseFlags: msg.GasPriceIncreaseFlags,
 		Signer:                msg.Creator,
+		GasPriceOracleFlags:   msg.GasPriceOracleFlags,
 	})
 	if err != nil {
 		ctx.Logger().Error("Error emitting EventCrosschainFlagsUpdated :", err)
@@ -117,6 +117,44 @@ func TestMsgServer_UpdateCrosschainFlags(t *testing.T) {
 		require.Equal(t, types.DefaultGasPriceIncreaseFlags.GasPriceIncreasePercent, flags.GasPriceIncreaseFlags.GasPriceIncreasePercent)
 	})
 
+	t.Run("can update gas price oracle flags", func(t *testing.T) {
+		k, ctx := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		// group 1 can't update the gas price oracle flags
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group1)
+		msg := &types.MsgUpdateCrosschainFlags{
+			Creator: admin,
+			GasPriceOracleFlags: &types.GasPriceOracleFlags{
+				MaxVoteAge:       42,
+				EpochLength:      42,
+				MaxChangePercent: 42,
+			},
+		}
+		_, err := srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), msg)
+		require.Equal(t, types.ErrNotAuthorizedPolicy, err)
+
+		setAdminCrossChainFlags(ctx, k, admin, types.Policy_Type_group2)
+		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.Equal(t, *msg.GasPriceOracleFlags, *flags.GasPriceOracleFlags)
+
+		// if gas price oracle flags is nil, it should not be updated
+		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:          admin,
+			IsInboundEnabled: true,
+		})
+		require.NoError(t, err)
+
+		flags, found = k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.Equal(t, *msg.GasPriceOracleFlags, *flags.GasPriceOracleFlags)
+	})
+
 	t.Run("cannot update crosschain flags if not authorized", func(t *testing.T) {
 		k, ctx := keepertest.ObserverKeeper(t)
 		srv := keeper.NewMsgServerImpl(*k)
@@ -8,6 +8,7 @@ import (
 )
 
 // UpdateKeygen updates the block height of the keygen and sets the status to "pending keygen".
+// The nodes disabled for repeated keysign failures are excluded from the keygen.
 //
 // Only the admin policy account is authorized to broadcast this message.
 func (k msgServer) UpdateKeygen(goCtx context.Context, msg *types.MsgUpdateKeygen) (*types.MsgUpdateKeygenResponse, error) {
@@ -24,9 +25,12 @@ func (k msgServer) UpdateKeygen(goCtx context.Context, msg *types.MsgUpdateKeyge
 		return nil, types.ErrKeygenBlockTooLow
 	}
 	nodeAccountList := k.GetAllNodeAccount(ctx)
-	granteePubKeys := make([]string, len(nodeAccountList))
-	for i, nodeAccount := range nodeAccountList {
-		granteePubKeys[i] = nodeAccount.GranteePubkey.Secp256k1.String()
+	granteePubKeys := make([]string, 0, len(nodeAccountList))
+	for _, nodeAccount := range nodeAccountList {
+		if nodeAccount.NodeStatus == types.NodeStatus_Disabled {
+			continue
+		}
+		granteePubKeys = append(granteePubKeys, nodeAccount.GranteePubkey.Secp256k1.String())
 	}
 	keygen.GranteePubkeys = granteePubKeys
 	keygen.BlockNumber = msg.Block
@@ -117,6 +117,8 @@ func (k msgServer) AddObserver(goCtx context.Context, msg *types.MsgAddObserver)
 			GranteePubkey:  &pubkeySet,
 			NodeStatus:     types.NodeStatus_Active,
 		})
+		// adding back a disabled node resets its blame score
+		k.RemoveNodeBlameScore(ctx, msg.ObserverAddress)
 		k.SetKeygen(ctx, types.Keygen{BlockNumber: math.MaxInt64})
 		return &types.MsgAddObserverResponse{}, nil
 	}
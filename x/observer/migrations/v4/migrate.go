@@ -0,0 +1,22 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/common"
+)
+
+type ObserverKeeper interface {
+	GetChainInfo(ctx sdk.Context, chainID int64) (val common.ChainInfo, found bool)
+	SetChainInfo(ctx sdk.Context, chainInfo common.ChainInfo)
+}
+
+// MigrateStore migrates the x/observer module state from the consensus version 3 to 4
+// This migration initializes the chain registry with the default chains
+func MigrateStore(ctx sdk.Context, k ObserverKeeper) error {
+	for _, chainInfo := range common.DefaultChainInfoList() {
+		if _, found := k.GetChainInfo(ctx, chainInfo.Chain.ChainId); !found {
+			k.SetChainInfo(ctx, chainInfo)
+		}
+	}
+	return nil
+}
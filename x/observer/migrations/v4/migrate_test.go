@@ -0,0 +1,35 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	v4 "github.com/zeta-chain/node/x/observer/migrations/v4"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+
+	// an existing entry is not overwritten
+	chain := common.ZetaChain()
+	k.SetChainInfo(ctx, common.ChainInfo{
+		Chain:       chain,
+		VmFamily:    common.VmFamily_zeta_core,
+		NetworkType: common.NetworkType_privnet,
+	})
+
+	err := v4.MigrateStore(ctx, k)
+	require.NoError(t, err)
+
+	for _, chainInfo := range common.DefaultChainInfoList() {
+		stored, found := k.GetChainInfo(ctx, chainInfo.Chain.ChainId)
+		require.True(t, found)
+		if chainInfo.Chain.ChainId == chain.ChainId {
+			require.Equal(t, common.NetworkType_privnet, stored.NetworkType)
+			continue
+		}
+		require.Equal(t, chainInfo, stored)
+	}
+}
@@ -0,0 +1,20 @@
+package v5
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+type ObserverKeeper interface {
+	GetParamsIsExists(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+}
+
+// MigrateStore migrates the x/observer module state from the consensus version 4 to 5
+// This migration sets the ballot archival param which is disabled by default
+func MigrateStore(ctx sdk.Context, k ObserverKeeper) error {
+	params := k.GetParamsIsExists(ctx)
+	params.BallotArchivalEnabled = types.DefaultParams().BallotArchivalEnabled
+	k.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,24 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	v5 "github.com/zeta-chain/node/x/observer/migrations/v5"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	params := types.DefaultParams()
+	params.BallotMaturityBlocks = 42
+	k.SetParams(ctx, params)
+
+	err := v5.MigrateStore(ctx, k)
+	require.NoError(t, err)
+
+	migrated := k.GetParams(ctx)
+	require.Equal(t, int64(42), migrated.BallotMaturityBlocks)
+	require.False(t, migrated.BallotArchivalEnabled)
+}
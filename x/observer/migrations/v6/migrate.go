@@ -0,0 +1,20 @@
+package v6
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+type ObserverKeeper interface {
+	GetParamsIsExists(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
+}
+
+// MigrateStore migrates the x/observer module state from the consensus version 5 to 6
+// This migration sets the default blame params
+func MigrateStore(ctx sdk.Context, k ObserverKeeper) error {
+	params := k.GetParamsIsExists(ctx)
+	params.BlameParams = types.DefaultBlameParams()
+	k.SetParams(ctx, params)
+	return nil
+}
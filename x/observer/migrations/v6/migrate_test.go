@@ -0,0 +1,26 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	v6 "github.com/zeta-chain/node/x/observer/migrations/v6"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.ObserverKeeper(t)
+	params := types.DefaultParams()
+	params.BallotMaturityBlocks = 42
+	params.BallotArchivalEnabled = true
+	k.SetParams(ctx, params)
+
+	err := v6.MigrateStore(ctx, k)
+	require.NoError(t, err)
+
+	migrated := k.GetParams(ctx)
+	require.Equal(t, int64(42), migrated.BallotMaturityBlocks)
+	require.True(t, migrated.BallotArchivalEnabled)
+	require.Equal(t, types.DefaultBlameParams(), migrated.BlameParams)
+}
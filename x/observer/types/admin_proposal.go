@@ -0,0 +1,149 @@
+package types
+
+import (
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// AdminProposalAccount returns the account executing the messages of the admin proposals of a policy type
+// the account is set as the admin policy account of the policy type once a signer set is configured for it
+func AdminProposalAccount(policyType Policy_Type) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("admin_proposal_"+policyType.String()))
+}
+
+// ValidatePolicyType returns an error if the policy type is unknown
+func ValidatePolicyType(policyType Policy_Type) error {
+	if _, ok := Policy_Type_name[int32(policyType)]; !ok {
+		return fmt.Errorf("unknown policy type %d", policyType)
+	}
+	return nil
+}
+
+// Validate checks the signers are unique valid addresses and the threshold can be reached
+func (s AdminSignerSet) Validate() error {
+	if err := ValidatePolicyType(s.PolicyType); err != nil {
+		return err
+	}
+	if len(s.Signers) == 0 {
+		return fmt.Errorf("signer set must contain at least one signer")
+	}
+	signers := make(map[string]bool)
+	for _, signer := range s.Signers {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return fmt.Errorf("invalid signer address %s: %s", signer, err.Error())
+		}
+		if signers[signer] {
+			return fmt.Errorf("duplicated signer %s", signer)
+		}
+		signers[signer] = true
+	}
+	if s.Threshold == 0 || int(s.Threshold) > len(s.Signers) {
+		return fmt.Errorf("threshold must be between 1 and the number of signers, got %d", s.Threshold)
+	}
+	if s.Timelock < 0 {
+		return fmt.Errorf("timelock cannot be negative")
+	}
+	return nil
+}
+
+// IsSigner returns true if the address is a signer of the set
+func (s AdminSignerSet) IsSigner(address string) bool {
+	for _, signer := range s.Signers {
+		if signer == address {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAdminProposalMsgs checks the messages of an admin proposal are valid and only signed by the admin proposal
+// account of the policy type
+func ValidateAdminProposalMsgs(policyType Policy_Type, msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return cosmoserrors.Wrap(ErrInvalidAdminProposal, "proposal must contain at least one message")
+	}
+	account := AdminProposalAccount(policyType)
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return cosmoserrors.Wrapf(ErrInvalidAdminProposal, "message %s at position %d: %s", sdk.MsgTypeURL(msg), i, err.Error())
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(account) {
+			return cosmoserrors.Wrapf(
+				ErrInvalidAdminProposal,
+				"message %s at position %d must be signed by the admin proposal account %s",
+				sdk.MsgTypeURL(msg),
+				i,
+				account.String(),
+			)
+		}
+	}
+	return nil
+}
+
+// GetMsgs returns the messages of the proposal
+func (p AdminProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(p.Messages, "admin proposal")
+}
+
+// SetMsgs sets the messages of the proposal
+func (p *AdminProposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	p.Messages = anys
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p AdminProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, p.Messages)
+}
+
+// HasApproved returns true if the address approved the proposal
+func (p AdminProposal) HasApproved(address string) bool {
+	for _, approval := range p.Approvals {
+		if approval == address {
+			return true
+		}
+	}
+	return false
+}
+
+// CountApprovals returns the number of approvals of the proposal from the signers of the signer set
+// approvals from addresses removed from the signer set are not counted
+func (p AdminProposal) CountApprovals(signerSet AdminSignerSet) uint32 {
+	count := uint32(0)
+	for _, approval := range p.Approvals {
+		if signerSet.IsSigner(approval) {
+			count++
+		}
+	}
+	return count
+}
+
+// IsExecutable returns true if the proposal reached the threshold of approvals and the timelock is over
+func (p AdminProposal) IsExecutable(height int64) bool {
+	return p.Status == AdminProposalStatus_AdminProposalPending && p.ExecutableHeight > 0 && height >= p.ExecutableHeight
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryGetAdminProposalResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return m.AdminProposal.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryPendingAdminProposalsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, proposal := range m.AdminProposals {
+		if err := proposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestAdminSignerSet_Validate(t *testing.T) {
+	signer := sample.AccAddress()
+
+	tests := []struct {
+		name      string
+		signerSet types.AdminSignerSet
+		wantErr   bool
+	}{
+		{
+			name:      "valid signer set",
+			signerSet: sample.AdminSignerSet(types.Policy_Type_group1),
+		},
+		{
+			name: "unknown policy type",
+			signerSet: types.AdminSignerSet{
+				PolicyType: types.Policy_Type(42),
+				Signers:    []string{signer},
+				Threshold:  1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no signer",
+			signerSet: types.AdminSignerSet{
+				PolicyType: types.Policy_Type_group1,
+				Threshold:  1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid signer",
+			signerSet: types.AdminSignerSet{
+				PolicyType: types.Policy_Type_group1,
+				Signers:    []string{"invalid"},
+				Threshold:  1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated signer",
+			signerSet: types.AdminSignerSet{
+				PolicyType: types.Policy_Type_group1,
+				Signers:    []string{signer, signer},
+				Threshold:  1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero threshold",
+			signerSet: types.AdminSignerSet{
+				PolicyType: types.Policy_Type_group1,
+				Signers:    []string{signer},
+				Threshold:  0,
+			},
+			wantErr: true,
+		},
+		{
+			name: "threshold higher than the number of signers",
+			signerSet: types.AdminSignerSet{
+				PolicyType: types.Policy_Type_group1,
+				Signers:    []string{signer},
+				Threshold:  2,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative timelock",
+			signerSet: types.AdminSignerSet{
+				PolicyType: types.Policy_Type_group1,
+				Signers:    []string{signer},
+				Threshold:  1,
+				Timelock:   -1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.signerSet.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAdminProposal_CountApprovals(t *testing.T) {
+	signerSet := sample.AdminSignerSet(types.Policy_Type_group1)
+	proposal := types.AdminProposal{
+		Approvals: []string{signerSet.Signers[0], sample.AccAddress(), signerSet.Signers[2]},
+	}
+	require.Equal(t, uint32(2), proposal.CountApprovals(signerSet))
+	require.True(t, proposal.HasApproved(signerSet.Signers[0]))
+	require.False(t, proposal.HasApproved(signerSet.Signers[1]))
+}
+
+func TestAdminProposal_IsExecutable(t *testing.T) {
+	proposal := types.AdminProposal{Status: types.AdminProposalStatus_AdminProposalPending}
+	require.False(t, proposal.IsExecutable(100))
+
+	proposal.ExecutableHeight = 50
+	require.False(t, proposal.IsExecutable(49))
+	require.True(t, proposal.IsExecutable(50))
+
+	proposal.Status = types.AdminProposalStatus_AdminProposalCancelled
+	require.False(t, proposal.IsExecutable(50))
+}
+
+func TestValidateAdminProposalMsgs(t *testing.T) {
+	account := types.AdminProposalAccount(types.Policy_Type_group1).String()
+
+	t.Run("valid messages", func(t *testing.T) {
+		err := types.ValidateAdminProposalMsgs(types.Policy_Type_group1, []sdk.Msg{
+			types.NewMsgUpdateCrosschainFlags(account, false, false),
+			types.NewMsgUpdateChainCrosschainFlags(account, 1, false, false),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("no message", func(t *testing.T) {
+		err := types.ValidateAdminProposalMsgs(types.Policy_Type_group1, []sdk.Msg{})
+		require.ErrorIs(t, err, types.ErrInvalidAdminProposal)
+	})
+
+	t.Run("message signed by another account", func(t *testing.T) {
+		err := types.ValidateAdminProposalMsgs(types.Policy_Type_group1, []sdk.Msg{
+			types.NewMsgUpdateCrosschainFlags(types.AdminProposalAccount(types.Policy_Type_group2).String(), false, false),
+		})
+		require.ErrorIs(t, err, types.ErrInvalidAdminProposal)
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		err := types.ValidateAdminProposalMsgs(types.Policy_Type_group1, []sdk.Msg{
+			types.NewMsgUpdateChainCrosschainFlags(account, common.ZetaChain().ChainId, false, false),
+		})
+		require.ErrorIs(t, err, types.ErrInvalidAdminProposal)
+	})
+}
@@ -7,6 +7,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+const (
+	// PrunedBallotRetentionBlocks is the number of blocks the tombstone of a pruned ballot is kept, a late vote on the
+	// ballot is rejected during this window, about a week
+	PrunedBallotRetentionBlocks int64 = 100_800
+
+	// MaxPendingBallotAge is the number of blocks after which a ballot still in progress is removed at its maturity,
+	// about a day
+	MaxPendingBallotAge int64 = 14_400
+)
+
 func (m Ballot) AddVote(address string, vote VoteType) (Ballot, error) {
 	if m.HasVoted(address) {
 		return m, errors.Wrap(ErrUnableToAddVote, fmt.Sprintf(" Voter : %s | Ballot :%s | Already Voted", address, m.String()))
@@ -100,3 +110,39 @@ func (m Ballot) BuildRewardsDistribution(rewardsMap map[string]int64) int64 {
 	}
 	return totalRewardUnits
 }
+
+// Summary returns the compact record of the ballot stored when the ballot is pruned
+func (m Ballot) Summary() BallotSummary {
+	var expectedVote VoteType
+	switch m.BallotStatus {
+	case BallotStatus_BallotFinalized_SuccessObservation:
+		expectedVote = VoteType_SuccessObservation
+	case BallotStatus_BallotFinalized_FailureObservation:
+		expectedVote = VoteType_FailureObservation
+	default:
+		expectedVote = VoteType_NotYetVoted
+	}
+
+	bitmap := make([]byte, (len(m.VoterList)+7)/8)
+	for i := range m.VoterList {
+		if i < len(m.Votes) && m.Votes[i] == expectedVote && expectedVote != VoteType_NotYetVoted {
+			bitmap[i/8] |= 1 << (i % 8)
+		}
+	}
+	return BallotSummary{
+		BallotIdentifier:     m.BallotIdentifier,
+		BallotStatus:         m.BallotStatus,
+		ObservationType:      m.ObservationType,
+		BallotCreationHeight: m.BallotCreationHeight,
+		VoterList:            m.VoterList,
+		VoterBitmap:          bitmap,
+	}
+}
+
+// VotedForFinalStatus returns true if the voter at the given index voted for the final status of the ballot
+func (m BallotSummary) VotedForFinalStatus(index int) bool {
+	if index < 0 || index/8 >= len(m.VoterBitmap) {
+		return false
+	}
+	return m.VoterBitmap[index/8]&(1<<(index%8)) != 0
+}
@@ -226,51 +226,142 @@ func (m *BallotListForHeight) GetBallotsIndexList() []string {
 	return nil
 }
 
+// BallotSummary is the compact record of a ballot kept after the ballot is pruned
+// bit i of voter_bitmap is set if the voter at index i of voter_list voted for the final status of the ballot
+type BallotSummary struct {
+	BallotIdentifier     string          `protobuf:"bytes,1,opt,name=ballot_identifier,json=ballotIdentifier,proto3" json:"ballot_identifier,omitempty"`
+	BallotStatus         BallotStatus    `protobuf:"varint,2,opt,name=ballot_status,json=ballotStatus,proto3,enum=zetachain.zetacore.observer.BallotStatus" json:"ballot_status,omitempty"`
+	ObservationType      ObservationType `protobuf:"varint,3,opt,name=observation_type,json=observationType,proto3,enum=zetachain.zetacore.observer.ObservationType" json:"observation_type,omitempty"`
+	BallotCreationHeight int64           `protobuf:"varint,4,opt,name=ballot_creation_height,json=ballotCreationHeight,proto3" json:"ballot_creation_height,omitempty"`
+	VoterList            []string        `protobuf:"bytes,5,rep,name=voter_list,json=voterList,proto3" json:"voter_list,omitempty"`
+	VoterBitmap          []byte          `protobuf:"bytes,6,opt,name=voter_bitmap,json=voterBitmap,proto3" json:"voter_bitmap,omitempty"`
+}
+
+func (m *BallotSummary) Reset()         { *m = BallotSummary{} }
+func (m *BallotSummary) String() string { return proto.CompactTextString(m) }
+func (*BallotSummary) ProtoMessage()    {}
+func (*BallotSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eac86b249c97b5b, []int{2}
+}
+func (m *BallotSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BallotSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BallotSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BallotSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BallotSummary.Merge(m, src)
+}
+func (m *BallotSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *BallotSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_BallotSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BallotSummary proto.InternalMessageInfo
+
+func (m *BallotSummary) GetBallotIdentifier() string {
+	if m != nil {
+		return m.BallotIdentifier
+	}
+	return ""
+}
+
+func (m *BallotSummary) GetBallotStatus() BallotStatus {
+	if m != nil {
+		return m.BallotStatus
+	}
+	return BallotStatus_BallotFinalized_SuccessObservation
+}
+
+func (m *BallotSummary) GetObservationType() ObservationType {
+	if m != nil {
+		return m.ObservationType
+	}
+	return ObservationType_EmptyObserverType
+}
+
+func (m *BallotSummary) GetBallotCreationHeight() int64 {
+	if m != nil {
+		return m.BallotCreationHeight
+	}
+	return 0
+}
+
+func (m *BallotSummary) GetVoterList() []string {
+	if m != nil {
+		return m.VoterList
+	}
+	return nil
+}
+
+func (m *BallotSummary) GetVoterBitmap() []byte {
+	if m != nil {
+		return m.VoterBitmap
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("zetachain.zetacore.observer.VoteType", VoteType_name, VoteType_value)
 	proto.RegisterEnum("zetachain.zetacore.observer.BallotStatus", BallotStatus_name, BallotStatus_value)
 	proto.RegisterType((*Ballot)(nil), "zetachain.zetacore.observer.Ballot")
 	proto.RegisterType((*BallotListForHeight)(nil), "zetachain.zetacore.observer.BallotListForHeight")
+	proto.RegisterType((*BallotSummary)(nil), "zetachain.zetacore.observer.BallotSummary")
 }
 
 func init() { proto.RegisterFile("observer/ballot.proto", fileDescriptor_9eac86b249c97b5b) }
 
 var fileDescriptor_9eac86b249c97b5b = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x93, 0x26, 0x34, 0x43, 0x69, 0xc2, 0x12, 0x82, 0x15, 0x84, 0x1b, 0x45, 0xa2, 0x0a,
-	0xa5, 0xb5, 0xa5, 0xc2, 0x8d, 0x5b, 0x40, 0x11, 0x91, 0x50, 0x01, 0xb7, 0x02, 0x15, 0x0e, 0x96,
-	0x63, 0x0f, 0xf1, 0x0a, 0xd7, 0x1b, 0xed, 0x6e, 0xaa, 0x36, 0x5f, 0xc1, 0x47, 0x70, 0xe0, 0x53,
-	0x7a, 0xec, 0x11, 0x71, 0xa8, 0x20, 0xf9, 0x11, 0xe4, 0x5d, 0x3b, 0x04, 0x29, 0xca, 0xc9, 0x3b,
-	0xf3, 0xe6, 0xbd, 0xd9, 0x9d, 0x37, 0x86, 0xfb, 0x6c, 0x28, 0x90, 0x9f, 0x23, 0x77, 0x86, 0x7e,
-	0x1c, 0x33, 0x69, 0x8f, 0x39, 0x93, 0x8c, 0x3c, 0x9c, 0xa2, 0xf4, 0x83, 0xc8, 0xa7, 0x89, 0xad,
-	0x4e, 0x8c, 0xa3, 0x9d, 0x57, 0xb6, 0x1a, 0x23, 0x36, 0x62, 0xaa, 0xce, 0x49, 0x4f, 0x9a, 0xd2,
-	0x7a, 0xb0, 0x50, 0xca, 0x0f, 0x1a, 0xe8, 0xfc, 0x29, 0x41, 0xa5, 0xa7, 0xc4, 0x49, 0x03, 0xca,
-	0x34, 0x09, 0xf1, 0xc2, 0x34, 0xda, 0x46, 0xb7, 0xea, 0xea, 0x80, 0x3c, 0x85, 0xbb, 0xba, 0xb9,
-	0x47, 0x43, 0x4c, 0x24, 0xfd, 0x42, 0x91, 0x9b, 0x45, 0x55, 0x51, 0xd7, 0xc0, 0x60, 0x91, 0x27,
-	0x8f, 0x00, 0xce, 0x99, 0x44, 0xee, 0xc5, 0x54, 0x48, 0xb3, 0xd4, 0x2e, 0x75, 0xab, 0x6e, 0x55,
-	0x65, 0xde, 0x50, 0x21, 0xc9, 0x0b, 0x28, 0xa7, 0x81, 0x30, 0x37, 0xda, 0xa5, 0xee, 0xf6, 0xe1,
-	0x63, 0x7b, 0xcd, 0x43, 0xec, 0x0f, 0x4c, 0xe2, 0xc9, 0xe5, 0x18, 0x5d, 0xcd, 0x21, 0x1f, 0xa1,
-	0xae, 0x31, 0x5f, 0x52, 0x96, 0x78, 0xf2, 0x72, 0x8c, 0x66, 0xb9, 0x6d, 0x74, 0xb7, 0x0f, 0xf7,
-	0xd7, 0xea, 0xbc, 0xfd, 0x47, 0x52, 0x72, 0x35, 0xf6, 0x7f, 0x82, 0x9c, 0x42, 0xf6, 0x10, 0x4f,
-	0x46, 0x1c, 0x45, 0xc4, 0xe2, 0xd0, 0xac, 0xa4, 0x0f, 0xec, 0xd9, 0x57, 0x37, 0x3b, 0x85, 0x5f,
-	0x37, 0x3b, 0xbb, 0x23, 0x2a, 0xa3, 0xc9, 0xd0, 0x0e, 0xd8, 0x99, 0x13, 0x30, 0x71, 0xc6, 0x44,
-	0xf6, 0x39, 0x10, 0xe1, 0x57, 0x27, 0xbd, 0x89, 0xb0, 0x5f, 0x61, 0xe0, 0xd6, 0xb4, 0xce, 0x49,
-	0x2e, 0x43, 0x8e, 0xe0, 0x4e, 0x26, 0x2d, 0xa4, 0x2f, 0x27, 0xc2, 0xbc, 0xa5, 0x2e, 0xfc, 0x64,
-	0xed, 0x85, 0xb5, 0x1d, 0xc7, 0x8a, 0xe0, 0x6e, 0x0d, 0x97, 0x22, 0xf2, 0x1c, 0x9a, 0x99, 0x5e,
-	0xc0, 0x51, 0xcf, 0x21, 0x42, 0x3a, 0x8a, 0xa4, 0xb9, 0xd9, 0x36, 0xba, 0x25, 0xb7, 0xa1, 0xd1,
-	0x97, 0x19, 0xf8, 0x5a, 0x61, 0x9d, 0xcf, 0x70, 0x4f, 0x6b, 0xa6, 0x26, 0xf4, 0x19, 0xd7, 0x69,
-	0xd2, 0x84, 0x4a, 0x46, 0x36, 0x14, 0x39, 0x8b, 0xc8, 0x3e, 0x10, 0x2d, 0x23, 0x3c, 0xb5, 0x02,
-	0xda, 0xcc, 0xa2, 0x32, 0x33, 0x9b, 0x94, 0x18, 0xa4, 0x40, 0x2a, 0xb7, 0xf7, 0x1e, 0x36, 0x73,
-	0xa7, 0x48, 0x13, 0xc8, 0xf1, 0x24, 0x08, 0x50, 0x88, 0xa5, 0xa1, 0xd7, 0x0b, 0x69, 0xbe, 0xef,
-	0xd3, 0x78, 0xc2, 0x71, 0x39, 0x6f, 0x90, 0x1a, 0xdc, 0x3e, 0x62, 0xf2, 0x14, 0x65, 0xaa, 0x10,
-	0xd6, 0x8b, 0xad, 0x8d, 0x1f, 0xdf, 0x2d, 0x63, 0x6f, 0x0a, 0x5b, 0xcb, 0x33, 0x20, 0xbb, 0xd0,
-	0xd1, 0x71, 0x9f, 0x26, 0x7e, 0x4c, 0xa7, 0x18, 0x7a, 0x2b, 0xdb, 0xac, 0xa8, 0x5b, 0xd9, 0xb6,
-	0x01, 0x75, 0x5d, 0x37, 0x48, 0xde, 0x71, 0x36, 0xe2, 0x28, 0x44, 0xde, 0xbb, 0x37, 0xb8, 0x9a,
-	0x59, 0xc6, 0xf5, 0xcc, 0x32, 0x7e, 0xcf, 0x2c, 0xe3, 0xdb, 0xdc, 0x2a, 0x5c, 0xcf, 0xad, 0xc2,
-	0xcf, 0xb9, 0x55, 0xf8, 0xe4, 0x2c, 0x2d, 0x41, 0x6a, 0xda, 0x81, 0xf2, 0xcf, 0xc9, 0xfd, 0x73,
-	0x2e, 0x16, 0xbf, 0x96, 0xde, 0x88, 0x61, 0x45, 0xfd, 0x61, 0xcf, 0xfe, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0xd6, 0xe7, 0x98, 0x67, 0xc6, 0x03, 0x00, 0x00,
+	// 604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x5d, 0x6f, 0x12, 0x4d,
+	0x14, 0x66, 0xd8, 0xc2, 0x5b, 0xa6, 0xb4, 0xf0, 0x8e, 0x88, 0x1b, 0x8c, 0x5b, 0x24, 0xb1, 0xc1,
+	0x7e, 0xec, 0x26, 0xd5, 0x3b, 0xef, 0x50, 0x89, 0x24, 0xa6, 0xea, 0xb6, 0xd1, 0x54, 0x2f, 0x36,
+	0xfb, 0x31, 0xb2, 0x13, 0x97, 0x1d, 0x32, 0x33, 0x34, 0x85, 0x5f, 0xe1, 0x8f, 0xf0, 0xc2, 0x9f,
+	0xd2, 0xcb, 0x5e, 0x1a, 0x13, 0x1b, 0x85, 0x3f, 0x62, 0x76, 0x66, 0x41, 0xac, 0xc0, 0x8d, 0x5e,
+	0xed, 0x9c, 0xe7, 0x39, 0xe7, 0x99, 0x33, 0xe7, 0x63, 0xe1, 0x4d, 0xea, 0x71, 0xcc, 0xce, 0x30,
+	0xb3, 0x3c, 0x37, 0x8a, 0xa8, 0x30, 0xfb, 0x8c, 0x0a, 0x8a, 0x6e, 0x8f, 0xb0, 0x70, 0xfd, 0xd0,
+	0x25, 0xb1, 0x29, 0x4f, 0x94, 0x61, 0x73, 0xea, 0x59, 0xab, 0x74, 0x69, 0x97, 0x4a, 0x3f, 0x2b,
+	0x39, 0xa9, 0x90, 0xda, 0xad, 0x99, 0xd2, 0xf4, 0xa0, 0x88, 0xc6, 0x0f, 0x0d, 0xe6, 0x5b, 0x52,
+	0x1c, 0x55, 0x60, 0x8e, 0xc4, 0x01, 0x3e, 0xd7, 0x41, 0x1d, 0x34, 0x0b, 0xb6, 0x32, 0xd0, 0x1e,
+	0xfc, 0x5f, 0x5d, 0xee, 0x90, 0x00, 0xc7, 0x82, 0xbc, 0x27, 0x98, 0xe9, 0x59, 0xe9, 0x51, 0x56,
+	0x44, 0x67, 0x86, 0xa3, 0x3b, 0x10, 0x9e, 0x51, 0x81, 0x99, 0x13, 0x11, 0x2e, 0x74, 0xad, 0xae,
+	0x35, 0x0b, 0x76, 0x41, 0x22, 0xcf, 0x09, 0x17, 0xe8, 0x11, 0xcc, 0x25, 0x06, 0xd7, 0xd7, 0xea,
+	0x5a, 0x73, 0xeb, 0xf0, 0x9e, 0xb9, 0xe2, 0x21, 0xe6, 0x6b, 0x2a, 0xf0, 0xc9, 0xb0, 0x8f, 0x6d,
+	0x15, 0x83, 0xde, 0xc0, 0xb2, 0xe2, 0x5c, 0x41, 0x68, 0xec, 0x88, 0x61, 0x1f, 0xeb, 0xb9, 0x3a,
+	0x68, 0x6e, 0x1d, 0xee, 0xaf, 0xd4, 0x79, 0xf1, 0x2b, 0x48, 0xca, 0x95, 0xe8, 0xef, 0x00, 0x3a,
+	0x85, 0xe9, 0x43, 0x1c, 0x11, 0x32, 0xcc, 0x43, 0x1a, 0x05, 0x7a, 0x3e, 0x79, 0x60, 0xcb, 0xbc,
+	0xb8, 0xda, 0xce, 0x7c, 0xbd, 0xda, 0xde, 0xe9, 0x12, 0x11, 0x0e, 0x3c, 0xd3, 0xa7, 0x3d, 0xcb,
+	0xa7, 0xbc, 0x47, 0x79, 0xfa, 0x39, 0xe0, 0xc1, 0x07, 0x2b, 0xc9, 0x84, 0x9b, 0x4f, 0xb0, 0x6f,
+	0x97, 0x94, 0xce, 0xc9, 0x54, 0x06, 0x1d, 0xc1, 0xcd, 0x54, 0x9a, 0x0b, 0x57, 0x0c, 0xb8, 0xfe,
+	0x9f, 0x4c, 0xf8, 0xfe, 0xca, 0x84, 0x55, 0x3b, 0x8e, 0x65, 0x80, 0x5d, 0xf4, 0xe6, 0x2c, 0xf4,
+	0x10, 0x56, 0x53, 0x3d, 0x9f, 0x61, 0x55, 0x87, 0x10, 0x93, 0x6e, 0x28, 0xf4, 0xf5, 0x3a, 0x68,
+	0x6a, 0x76, 0x45, 0xb1, 0x8f, 0x53, 0xf2, 0x99, 0xe4, 0x1a, 0xef, 0xe0, 0x0d, 0xa5, 0x99, 0x34,
+	0xa1, 0x4d, 0x99, 0x82, 0x51, 0x15, 0xe6, 0xd3, 0x60, 0x20, 0x83, 0x53, 0x0b, 0xed, 0x43, 0xa4,
+	0x64, 0xb8, 0x23, 0x47, 0x40, 0x35, 0x33, 0x2b, 0x9b, 0x99, 0x56, 0x8a, 0x77, 0x12, 0x22, 0x91,
+	0x6b, 0x7c, 0xcb, 0xc2, 0xcd, 0x34, 0xe3, 0x41, 0xaf, 0xe7, 0xb2, 0xe1, 0xe2, 0x89, 0x01, 0x4b,
+	0x26, 0xe6, 0x8f, 0x0a, 0x65, 0xff, 0xae, 0x42, 0x8b, 0xa6, 0x44, 0xfb, 0x17, 0x53, 0xb2, 0xbc,
+	0xf4, 0x6b, 0xcb, 0x4b, 0x7f, 0x6d, 0x21, 0x72, 0xd7, 0x17, 0xe2, 0x2e, 0x2c, 0x2a, 0xda, 0x23,
+	0xa2, 0xe7, 0xf6, 0xe5, 0xd8, 0x15, 0xed, 0x0d, 0x89, 0xb5, 0x24, 0xb4, 0xfb, 0x0a, 0xae, 0x4f,
+	0x37, 0x01, 0x55, 0x21, 0x3a, 0x1e, 0xf8, 0x3e, 0xe6, 0x7c, 0x2e, 0xdd, 0x72, 0x26, 0xc1, 0xdb,
+	0x2e, 0x89, 0x06, 0x0c, 0xcf, 0xe3, 0x00, 0x95, 0xe0, 0xc6, 0x11, 0x15, 0xa7, 0x58, 0x24, 0x0a,
+	0x41, 0x39, 0x5b, 0x5b, 0xfb, 0xfc, 0xc9, 0x00, 0xbb, 0x23, 0x58, 0x9c, 0xaf, 0x20, 0xda, 0x81,
+	0x0d, 0x65, 0xb7, 0x49, 0xec, 0x46, 0x64, 0x84, 0x03, 0x67, 0xe1, 0x35, 0x0b, 0xfc, 0x16, 0x5e,
+	0x5b, 0x81, 0x65, 0xe5, 0xd7, 0x89, 0x5f, 0x32, 0xda, 0x65, 0x98, 0xf3, 0xe9, 0xdd, 0xad, 0xa7,
+	0x17, 0x63, 0x03, 0x5c, 0x8e, 0x0d, 0xf0, 0x7d, 0x6c, 0x80, 0x8f, 0x13, 0x23, 0x73, 0x39, 0x31,
+	0x32, 0x5f, 0x26, 0x46, 0xe6, 0xed, 0xde, 0xdc, 0x92, 0x25, 0xfd, 0x39, 0x90, 0xad, 0xb2, 0x62,
+	0x1a, 0x60, 0xeb, 0x7c, 0xf6, 0xdb, 0x52, 0xdb, 0xe6, 0xe5, 0xe5, 0xdf, 0xeb, 0xc1, 0xcf, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xde, 0x02, 0x20, 0xad, 0x22, 0x05, 0x00, 0x00,
 }
 
 func (m *Ballot) Marshal() (dAtA []byte, err error) {
@@ -399,6 +490,67 @@ func (m *BallotListForHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BallotSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BallotSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BallotSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoterBitmap) > 0 {
+		i -= len(m.VoterBitmap)
+		copy(dAtA[i:], m.VoterBitmap)
+		i = encodeVarintBallot(dAtA, i, uint64(len(m.VoterBitmap)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VoterList) > 0 {
+		for iNdEx := len(m.VoterList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VoterList[iNdEx])
+			copy(dAtA[i:], m.VoterList[iNdEx])
+			i = encodeVarintBallot(dAtA, i, uint64(len(m.VoterList[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BallotCreationHeight != 0 {
+		i = encodeVarintBallot(dAtA, i, uint64(m.BallotCreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ObservationType != 0 {
+		i = encodeVarintBallot(dAtA, i, uint64(m.ObservationType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BallotStatus != 0 {
+		i = encodeVarintBallot(dAtA, i, uint64(m.BallotStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintBallot(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBallot(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBallot(v)
 	base := offset
@@ -469,6 +621,38 @@ func (m *BallotListForHeight) Size() (n int) {
 	return n
 }
 
+func (m *BallotSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovBallot(uint64(l))
+	}
+	if m.BallotStatus != 0 {
+		n += 1 + sovBallot(uint64(m.BallotStatus))
+	}
+	if m.ObservationType != 0 {
+		n += 1 + sovBallot(uint64(m.ObservationType))
+	}
+	if m.BallotCreationHeight != 0 {
+		n += 1 + sovBallot(uint64(m.BallotCreationHeight))
+	}
+	if len(m.VoterList) > 0 {
+		for _, s := range m.VoterList {
+			l = len(s)
+			n += 1 + l + sovBallot(uint64(l))
+		}
+	}
+	l = len(m.VoterBitmap)
+	if l > 0 {
+		n += 1 + l + sovBallot(uint64(l))
+	}
+	return n
+}
+
 func sovBallot(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -882,6 +1066,211 @@ func (m *BallotListForHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BallotSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBallot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BallotSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BallotSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBallot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
+			}
+			m.BallotStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotStatus |= BallotStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
+			}
+			m.ObservationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationType |= ObservationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotCreationHeight", wireType)
+			}
+			m.BallotCreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotCreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBallot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterList = append(m.VoterList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterBitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBallot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBallot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterBitmap = append(m.VoterBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoterBitmap == nil {
+				m.VoterBitmap = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBallot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBallot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBallot(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -156,3 +156,40 @@ func Test_BuildRewardsDistribution(t *testing.T) {
 	}
 
 }
+
+func TestBallot_Summary(t *testing.T) {
+	ballot := Ballot{
+		BallotIdentifier: "identifier",
+		VoterList:        []string{"Observer1", "Observer2", "Observer3", "Observer4", "Observer5", "Observer6", "Observer7", "Observer8", "Observer9"},
+		Votes: []VoteType{
+			VoteType_FailureObservation,
+			VoteType_FailureObservation,
+			VoteType_SuccessObservation,
+			VoteType_NotYetVoted,
+			VoteType_FailureObservation,
+			VoteType_FailureObservation,
+			VoteType_FailureObservation,
+			VoteType_FailureObservation,
+			VoteType_FailureObservation,
+		},
+		BallotStatus:         BallotStatus_BallotFinalized_FailureObservation,
+		BallotCreationHeight: 10,
+	}
+
+	summary := ballot.Summary()
+	assert.Equal(t, ballot.BallotIdentifier, summary.BallotIdentifier)
+	assert.Equal(t, ballot.BallotStatus, summary.BallotStatus)
+	assert.Equal(t, ballot.BallotCreationHeight, summary.BallotCreationHeight)
+	assert.Len(t, summary.VoterBitmap, 2)
+	for i, vote := range ballot.Votes {
+		assert.Equal(t, vote == VoteType_FailureObservation, summary.VotedForFinalStatus(i))
+	}
+	assert.False(t, summary.VotedForFinalStatus(16))
+
+	// no voter is recorded for a ballot in progress
+	ballot.BallotStatus = BallotStatus_BallotInProgress
+	summary = ballot.Summary()
+	for i := range ballot.Votes {
+		assert.False(t, summary.VotedForFinalStatus(i))
+	}
+}
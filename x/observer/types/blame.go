@@ -0,0 +1,22 @@
+package types
+
+// PruneBlames removes the blames before the start height of the blame window
+func (m *NodeBlameScore) PruneBlames(windowStart int64) {
+	heights := make([]int64, 0, len(m.BlameHeights))
+	for _, height := range m.BlameHeights {
+		if height >= windowStart {
+			heights = append(heights, height)
+		}
+	}
+	m.BlameHeights = heights
+}
+
+// Score returns the number of blames of the node
+func (m NodeBlameScore) Score() uint64 {
+	return uint64(len(m.BlameHeights))
+}
+
+// WindowStart returns the first height of the blame window ending at the given height
+func (p BlameParams) WindowStart(height int64) int64 {
+	return height - p.WindowBlocks + 1
+}
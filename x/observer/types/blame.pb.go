@@ -143,34 +143,99 @@ func (m *Blame) GetNodes() []*Node {
 	return nil
 }
 
+// NodeBlameScore records the heights of the finalized blames of a node in the blame window
+type NodeBlameScore struct {
+	Operator     string  `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	PubKey       string  `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	BlameHeights []int64 `protobuf:"varint,3,rep,packed,name=blame_heights,json=blameHeights,proto3" json:"blame_heights,omitempty"`
+}
+
+func (m *NodeBlameScore) Reset()         { *m = NodeBlameScore{} }
+func (m *NodeBlameScore) String() string { return proto.CompactTextString(m) }
+func (*NodeBlameScore) ProtoMessage()    {}
+func (*NodeBlameScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eda3a934f0dc78, []int{2}
+}
+func (m *NodeBlameScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeBlameScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeBlameScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeBlameScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeBlameScore.Merge(m, src)
+}
+func (m *NodeBlameScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeBlameScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeBlameScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeBlameScore proto.InternalMessageInfo
+
+func (m *NodeBlameScore) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *NodeBlameScore) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *NodeBlameScore) GetBlameHeights() []int64 {
+	if m != nil {
+		return m.BlameHeights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Node)(nil), "zetachain.zetacore.observer.Node")
 	proto.RegisterType((*Blame)(nil), "zetachain.zetacore.observer.Blame")
+	proto.RegisterType((*NodeBlameScore)(nil), "zetachain.zetacore.observer.NodeBlameScore")
 }
 
 func init() { proto.RegisterFile("observer/blame.proto", fileDescriptor_e9eda3a934f0dc78) }
 
 var fileDescriptor_e9eda3a934f0dc78 = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4b, 0xc3, 0x30,
-	0x14, 0xc6, 0x17, 0xe7, 0x26, 0x8d, 0x3a, 0x21, 0x0c, 0x56, 0x14, 0x43, 0x1d, 0x88, 0xbd, 0x98,
-	0x80, 0x1e, 0xbc, 0x0f, 0x2f, 0x22, 0x78, 0xa8, 0x37, 0x2f, 0x25, 0x59, 0x9f, 0x5d, 0x71, 0x6b,
-	0x4a, 0x9a, 0xca, 0x2a, 0xf8, 0x3f, 0xf8, 0x67, 0x79, 0xdc, 0xd1, 0xa3, 0xb4, 0xff, 0x88, 0x34,
-	0x5d, 0x77, 0xf4, 0xf6, 0xf2, 0xcb, 0xf7, 0xbe, 0x8f, 0xf7, 0xe1, 0xb1, 0x92, 0x39, 0xe8, 0x77,
-	0xd0, 0x5c, 0x2e, 0xc5, 0x0a, 0x58, 0xa6, 0x95, 0x51, 0xe4, 0xec, 0x03, 0x8c, 0x98, 0x2f, 0x44,
-	0x92, 0x32, 0x3b, 0x29, 0x0d, 0xac, 0x13, 0x9e, 0x4e, 0x76, 0x2b, 0xdd, 0xd0, 0x6e, 0x4d, 0x63,
-	0xbc, 0xff, 0xa4, 0x22, 0x20, 0x13, 0x7c, 0x90, 0x15, 0x32, 0x7c, 0x83, 0xd2, 0x45, 0x1e, 0xf2,
-	0x9d, 0x60, 0x98, 0x15, 0xf2, 0x11, 0x4a, 0x72, 0x8e, 0xb1, 0x4d, 0x09, 0x23, 0x61, 0x84, 0xbb,
-	0xe7, 0x21, 0xff, 0x28, 0x70, 0x2c, 0xb9, 0x17, 0x46, 0x90, 0x2b, 0x7c, 0xd2, 0x7e, 0xe7, 0x49,
-	0x9c, 0x0a, 0x53, 0x68, 0x70, 0xfb, 0x56, 0x33, 0xb2, 0xf8, 0xb9, 0xa3, 0xd3, 0x4f, 0x3c, 0x98,
-	0x35, 0x84, 0x8c, 0xf1, 0x20, 0x49, 0x23, 0x58, 0x6f, 0x73, 0xda, 0x07, 0xb9, 0xc4, 0xa3, 0x57,
-	0x91, 0x2c, 0x0b, 0x0d, 0xa1, 0x06, 0x91, 0xab, 0xd4, 0x46, 0x39, 0xc1, 0xf1, 0x96, 0x06, 0x16,
-	0x92, 0x3b, 0x3c, 0x48, 0x55, 0x04, 0xb9, 0xdb, 0xf7, 0xfa, 0xfe, 0xe1, 0xcd, 0x05, 0xfb, 0xe7,
-	0x68, 0xd6, 0x1c, 0x16, 0xb4, 0xfa, 0xd9, 0xc3, 0x77, 0x45, 0xd1, 0xa6, 0xa2, 0xe8, 0xb7, 0xa2,
-	0xe8, 0xab, 0xa6, 0xbd, 0x4d, 0x4d, 0x7b, 0x3f, 0x35, 0xed, 0xbd, 0xf0, 0x38, 0x31, 0x8b, 0x42,
-	0xb2, 0xb9, 0x5a, 0xf1, 0xc6, 0xe3, 0xda, 0xda, 0xf1, 0xce, 0x8e, 0xaf, 0x77, 0x95, 0x71, 0x53,
-	0x66, 0x90, 0xcb, 0xa1, 0x6d, 0xee, 0xf6, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x5e, 0x43, 0xc9,
-	0x87, 0x01, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0x9b, 0xc6, 0x56, 0x3b, 0xb6, 0x15, 0x96, 0x42, 0x43, 0xc5, 0x50, 0x2b, 0x62, 0x41,
+	0x4c, 0x40, 0x0f, 0xde, 0x8b, 0x82, 0x20, 0x78, 0x48, 0x6f, 0x5e, 0xca, 0xa6, 0x19, 0x93, 0xd5,
+	0x36, 0x1b, 0x36, 0x1b, 0x69, 0x05, 0xdf, 0xc1, 0xc7, 0xf2, 0xd8, 0xa3, 0x47, 0x69, 0x5f, 0x44,
+	0x76, 0xb7, 0x2d, 0x7a, 0xf1, 0xb6, 0xf3, 0xed, 0x3f, 0xcc, 0xff, 0xcf, 0x40, 0x8b, 0x87, 0x39,
+	0x8a, 0x57, 0x14, 0x7e, 0x38, 0xa1, 0x53, 0xf4, 0x32, 0xc1, 0x25, 0x27, 0x87, 0x6f, 0x28, 0xe9,
+	0x38, 0xa1, 0x2c, 0xf5, 0xf4, 0x8b, 0x0b, 0xf4, 0x36, 0xc2, 0x4e, 0x7b, 0xdb, 0xb2, 0x79, 0x98,
+	0xae, 0x5e, 0x0c, 0x3b, 0x0f, 0x3c, 0x42, 0xd2, 0x86, 0xdd, 0xac, 0x08, 0x47, 0x2f, 0x38, 0x77,
+	0xac, 0xae, 0xd5, 0xaf, 0x05, 0xd5, 0xac, 0x08, 0xef, 0x71, 0x4e, 0x8e, 0x00, 0xf4, 0x94, 0x51,
+	0x44, 0x25, 0x75, 0xca, 0x5d, 0xab, 0x5f, 0x0f, 0x6a, 0x9a, 0xdc, 0x50, 0x49, 0xc9, 0x19, 0x1c,
+	0x98, 0xef, 0x9c, 0xc5, 0x29, 0x95, 0x85, 0x40, 0xc7, 0xd6, 0x9a, 0xa6, 0xc6, 0xc3, 0x0d, 0xed,
+	0xbd, 0x43, 0x65, 0xa0, 0x08, 0x69, 0x41, 0x85, 0xa5, 0x11, 0xce, 0xd6, 0x73, 0x4c, 0x41, 0x4e,
+	0xa1, 0xf9, 0x44, 0xd9, 0xa4, 0x10, 0x38, 0x12, 0x48, 0x73, 0x9e, 0xea, 0x51, 0xb5, 0xa0, 0xb1,
+	0xa6, 0x81, 0x86, 0xe4, 0x1a, 0x2a, 0x29, 0x8f, 0x30, 0x77, 0xec, 0xae, 0xdd, 0xdf, 0xbf, 0x3c,
+	0xf6, 0xfe, 0x09, 0xed, 0xa9, 0x60, 0x81, 0xd1, 0xf7, 0x9e, 0xa1, 0xa9, 0x4a, 0x6d, 0x61, 0xa8,
+	0x64, 0xa4, 0x03, 0x7b, 0x3c, 0x43, 0x41, 0x25, 0x17, 0x6b, 0x2b, 0xdb, 0xfa, 0xf7, 0x36, 0xca,
+	0x7f, 0xb6, 0x71, 0x02, 0x0d, 0x13, 0x37, 0x41, 0x16, 0x27, 0xd2, 0xf8, 0xb0, 0x83, 0xba, 0x86,
+	0x77, 0x86, 0x0d, 0x6e, 0x3f, 0x97, 0xae, 0xb5, 0x58, 0xba, 0xd6, 0xf7, 0xd2, 0xb5, 0x3e, 0x56,
+	0x6e, 0x69, 0xb1, 0x72, 0x4b, 0x5f, 0x2b, 0xb7, 0xf4, 0x78, 0x1e, 0x33, 0x99, 0x14, 0xa1, 0x37,
+	0xe6, 0x53, 0x5f, 0xf9, 0xbd, 0xd0, 0xd6, 0x7d, 0x65, 0xd1, 0x9f, 0x6d, 0x4f, 0xe3, 0xcb, 0x79,
+	0x86, 0x79, 0x58, 0xd5, 0x17, 0xba, 0xfa, 0x19, 0x00, 0x74, 0x44, 0xd6, 0x30, 0xef, 0x01, 0x00,
+	0x00,
 }
 
 func (m *Node) Marshal() (dAtA []byte, err error) {
@@ -268,6 +333,62 @@ func (m *Blame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NodeBlameScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeBlameScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeBlameScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlameHeights) > 0 {
+		dAtA2 := make([]byte, len(m.BlameHeights)*10)
+		var j1 int
+		for _, num1 := range m.BlameHeights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBlame(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintBlame(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintBlame(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlame(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlame(v)
 	base := offset
@@ -323,6 +444,30 @@ func (m *Blame) Size() (n int) {
 	return n
 }
 
+func (m *NodeBlameScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovBlame(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovBlame(uint64(l))
+	}
+	if len(m.BlameHeights) > 0 {
+		l = 0
+		for _, e := range m.BlameHeights {
+			l += sovBlame(uint64(e))
+		}
+		n += 1 + sovBlame(uint64(l)) + l
+	}
+	return n
+}
+
 func sovBlame(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -627,6 +772,196 @@ func (m *Blame) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NodeBlameScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlame
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeBlameScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeBlameScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlame
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlameHeights = append(m.BlameHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBlame
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBlame
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBlame
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlameHeights) == 0 {
+					m.BlameHeights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBlame
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlameHeights = append(m.BlameHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameHeights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlame(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBlame(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,6 +11,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateCoreParams{}, "observer/UpdateClientParams", nil)
 	cdc.RegisterConcrete(&MsgUpdateCrosschainFlags{}, "crosschain/UpdateCrosschainFlags", nil)
 	cdc.RegisterConcrete(&MsgUpdateKeygen{}, "crosschain/UpdateKeygen", nil)
+	cdc.RegisterConcrete(&MsgUpdateChainInfo{}, "observer/UpdateChainInfo", nil)
+	cdc.RegisterConcrete(&MsgUpdateChainCrosschainFlags{}, "observer/UpdateChainCrosschainFlags", nil)
+	cdc.RegisterConcrete(&MsgUpdateAdminSignerSet{}, "observer/UpdateAdminSignerSet", nil)
+	cdc.RegisterConcrete(&MsgSubmitAdminProposal{}, "observer/SubmitAdminProposal", nil)
+	cdc.RegisterConcrete(&MsgApproveAdminProposal{}, "observer/ApproveAdminProposal", nil)
+	cdc.RegisterConcrete(&MsgExecuteAdminProposal{}, "observer/ExecuteAdminProposal", nil)
+	cdc.RegisterConcrete(&MsgCancelAdminProposal{}, "observer/CancelAdminProposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -18,6 +25,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateCoreParams{},
 		&MsgUpdateCrosschainFlags{},
 		&MsgUpdateKeygen{},
+		&MsgUpdateChainInfo{},
+		&MsgUpdateChainCrosschainFlags{},
+		&MsgUpdateAdminSignerSet{},
+		&MsgSubmitAdminProposal{},
+		&MsgApproveAdminProposal{},
+		&MsgExecuteAdminProposal{},
+		&MsgCancelAdminProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
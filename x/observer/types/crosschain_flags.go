@@ -14,11 +14,38 @@ var DefaultGasPriceIncreaseFlags = GasPriceIncreaseFlags{
 	GasPriceIncreasePercent: 100,
 }
 
+var DefaultGasPriceOracleFlags = GasPriceOracleFlags{
+	// MaxVoteAge is the number of blocks after which the gas price vote of an observer is discarded
+	MaxVoteAge: 600,
+
+	// EpochLength is the number of blocks in an epoch of the gas price
+	EpochLength: 100,
+
+	// MaxChangePercent is the maximum change of the gas price in an epoch
+	// 100 means the gas price can at most double in an epoch
+	MaxChangePercent: 100,
+}
+
 // DefaultCrosschainFlags returns the default crosschain flags used when not defined
 func DefaultCrosschainFlags() *CrosschainFlags {
 	return &CrosschainFlags{
 		IsInboundEnabled:      true,
 		IsOutboundEnabled:     true,
 		GasPriceIncreaseFlags: &DefaultGasPriceIncreaseFlags,
+		GasPriceOracleFlags:   &DefaultGasPriceOracleFlags,
 	}
 }
+
+// DefaultChainCrosschainFlags returns the crosschain flags of a chain when they are not defined, the chain is not paused
+func DefaultChainCrosschainFlags(chainID int64) ChainCrosschainFlags {
+	return ChainCrosschainFlags{
+		ChainId:           chainID,
+		IsInboundEnabled:  true,
+		IsOutboundEnabled: true,
+	}
+}
+
+// IsDefault returns true if neither the inbounds nor the outbounds of the chain are paused
+func (f ChainCrosschainFlags) IsDefault() bool {
+	return f.IsInboundEnabled && f.IsOutboundEnabled
+}
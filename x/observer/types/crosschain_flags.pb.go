@@ -88,17 +88,83 @@ func (m *GasPriceIncreaseFlags) GetGasPriceIncreasePercent() uint32 {
 	return 0
 }
 
+// GasPriceOracleFlags configures how the gas prices voted by the observers are aggregated into the gas price of a chain
+type GasPriceOracleFlags struct {
+	// votes older than maxVoteAge blocks are discarded, zero keeps the votes until they are updated
+	MaxVoteAge int64 `protobuf:"varint,1,opt,name=maxVoteAge,proto3" json:"maxVoteAge,omitempty"`
+	// number of blocks of an epoch of the gas price
+	EpochLength int64 `protobuf:"varint,2,opt,name=epochLength,proto3" json:"epochLength,omitempty"`
+	// maximum change of the gas price in an epoch in percent of the gas price at the start of the epoch
+	// zero doesn't bound the change
+	MaxChangePercent uint32 `protobuf:"varint,3,opt,name=maxChangePercent,proto3" json:"maxChangePercent,omitempty"`
+}
+
+func (m *GasPriceOracleFlags) Reset()         { *m = GasPriceOracleFlags{} }
+func (m *GasPriceOracleFlags) String() string { return proto.CompactTextString(m) }
+func (*GasPriceOracleFlags) ProtoMessage()    {}
+func (*GasPriceOracleFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b948b59e4d986f49, []int{1}
+}
+func (m *GasPriceOracleFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceOracleFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceOracleFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceOracleFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceOracleFlags.Merge(m, src)
+}
+func (m *GasPriceOracleFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceOracleFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceOracleFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceOracleFlags proto.InternalMessageInfo
+
+func (m *GasPriceOracleFlags) GetMaxVoteAge() int64 {
+	if m != nil {
+		return m.MaxVoteAge
+	}
+	return 0
+}
+
+func (m *GasPriceOracleFlags) GetEpochLength() int64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *GasPriceOracleFlags) GetMaxChangePercent() uint32 {
+	if m != nil {
+		return m.MaxChangePercent
+	}
+	return 0
+}
+
 type CrosschainFlags struct {
 	IsInboundEnabled      bool                   `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled     bool                   `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
 	GasPriceIncreaseFlags *GasPriceIncreaseFlags `protobuf:"bytes,3,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
+	GasPriceOracleFlags   *GasPriceOracleFlags   `protobuf:"bytes,4,opt,name=gasPriceOracleFlags,proto3" json:"gasPriceOracleFlags,omitempty"`
 }
 
 func (m *CrosschainFlags) Reset()         { *m = CrosschainFlags{} }
 func (m *CrosschainFlags) String() string { return proto.CompactTextString(m) }
 func (*CrosschainFlags) ProtoMessage()    {}
 func (*CrosschainFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b948b59e4d986f49, []int{1}
+	return fileDescriptor_b948b59e4d986f49, []int{2}
 }
 func (m *CrosschainFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -148,38 +214,115 @@ func (m *CrosschainFlags) GetGasPriceIncreaseFlags() *GasPriceIncreaseFlags {
 	return nil
 }
 
+func (m *CrosschainFlags) GetGasPriceOracleFlags() *GasPriceOracleFlags {
+	if m != nil {
+		return m.GasPriceOracleFlags
+	}
+	return nil
+}
+
+// ChainCrosschainFlags pauses the inbounds or the outbounds of a single chain
+// the flags of a chain only apply if the corresponding global crosschain flag is enabled
+type ChainCrosschainFlags struct {
+	ChainId           int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	IsInboundEnabled  bool  `protobuf:"varint,2,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
+	IsOutboundEnabled bool  `protobuf:"varint,3,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
+}
+
+func (m *ChainCrosschainFlags) Reset()         { *m = ChainCrosschainFlags{} }
+func (m *ChainCrosschainFlags) String() string { return proto.CompactTextString(m) }
+func (*ChainCrosschainFlags) ProtoMessage()    {}
+func (*ChainCrosschainFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b948b59e4d986f49, []int{3}
+}
+func (m *ChainCrosschainFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainCrosschainFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainCrosschainFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainCrosschainFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainCrosschainFlags.Merge(m, src)
+}
+func (m *ChainCrosschainFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainCrosschainFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainCrosschainFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainCrosschainFlags proto.InternalMessageInfo
+
+func (m *ChainCrosschainFlags) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainCrosschainFlags) GetIsInboundEnabled() bool {
+	if m != nil {
+		return m.IsInboundEnabled
+	}
+	return false
+}
+
+func (m *ChainCrosschainFlags) GetIsOutboundEnabled() bool {
+	if m != nil {
+		return m.IsOutboundEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GasPriceIncreaseFlags)(nil), "zetachain.zetacore.observer.GasPriceIncreaseFlags")
+	proto.RegisterType((*GasPriceOracleFlags)(nil), "zetachain.zetacore.observer.GasPriceOracleFlags")
 	proto.RegisterType((*CrosschainFlags)(nil), "zetachain.zetacore.observer.CrosschainFlags")
+	proto.RegisterType((*ChainCrosschainFlags)(nil), "zetachain.zetacore.observer.ChainCrosschainFlags")
 }
 
 func init() { proto.RegisterFile("observer/crosschain_flags.proto", fileDescriptor_b948b59e4d986f49) }
 
 var fileDescriptor_b948b59e4d986f49 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0x6b, 0xe2, 0x40,
-	0x14, 0xc7, 0x33, 0x0a, 0x8b, 0x8c, 0xc8, 0xee, 0x86, 0x95, 0x75, 0x5d, 0x88, 0xc1, 0x93, 0x2c,
-	0xdb, 0x19, 0xb0, 0x97, 0x9e, 0xed, 0x2f, 0x02, 0x85, 0x4a, 0x8e, 0xbd, 0x94, 0x49, 0x7c, 0x4e,
-	0x02, 0xe9, 0x8c, 0xcc, 0x4c, 0xa4, 0xf6, 0xaf, 0xe8, 0xb1, 0x7f, 0x4d, 0xcf, 0x1e, 0xbd, 0x14,
-	0x7a, 0x6a, 0x8b, 0xfe, 0x23, 0xc5, 0x49, 0x95, 0x5a, 0x6d, 0x6f, 0x2f, 0xef, 0xbd, 0x6f, 0x3e,
-	0x5f, 0xbe, 0x6f, 0x70, 0x4b, 0x46, 0x1a, 0xd4, 0x18, 0x14, 0x8d, 0x95, 0xd4, 0x3a, 0x4e, 0x58,
-	0x2a, 0x2e, 0x87, 0x19, 0xe3, 0x9a, 0x8c, 0x94, 0x34, 0xd2, 0xfd, 0x7b, 0x03, 0x86, 0xd9, 0x36,
-	0xb1, 0x95, 0x54, 0x40, 0x56, 0x9a, 0xe6, 0x2f, 0x2e, 0xb9, 0xb4, 0x7b, 0x74, 0x59, 0x15, 0x92,
-	0xa6, 0xc7, 0xa5, 0xe4, 0x19, 0x50, 0xfb, 0x15, 0xe5, 0x43, 0x3a, 0xc8, 0x15, 0x33, 0xa9, 0x14,
-	0xc5, 0xbc, 0x7d, 0x8f, 0x70, 0xfd, 0x94, 0xe9, 0xbe, 0x4a, 0x63, 0x08, 0x44, 0xac, 0x80, 0x69,
-	0x38, 0x59, 0x22, 0x5d, 0x1f, 0x57, 0x61, 0x24, 0xe3, 0xe4, 0x0c, 0x04, 0x37, 0x49, 0x03, 0xf9,
-	0xa8, 0x53, 0x0e, 0xdf, 0xb7, 0xdc, 0x00, 0xd7, 0x14, 0x18, 0x35, 0x09, 0x84, 0x01, 0x35, 0x66,
-	0x59, 0xa3, 0xe4, 0xa3, 0x4e, 0xb5, 0xfb, 0x87, 0x14, 0x4c, 0xb2, 0x62, 0x92, 0xa3, 0x37, 0x66,
-	0xaf, 0x32, 0x7d, 0x6a, 0x39, 0x77, 0xcf, 0x2d, 0x14, 0x6e, 0x2a, 0xdd, 0x03, 0xfc, 0x9b, 0x7f,
-	0x70, 0xd1, 0x07, 0x15, 0x83, 0x30, 0x8d, 0xb2, 0x8f, 0x3a, 0xb5, 0xf0, 0xb3, 0x71, 0xfb, 0x01,
-	0xe1, 0xef, 0x87, 0xeb, 0xb8, 0x0a, 0xeb, 0xff, 0xf0, 0x8f, 0x54, 0x07, 0x22, 0x92, 0xb9, 0x18,
-	0x1c, 0x0b, 0x16, 0x65, 0x30, 0xb0, 0xfe, 0x2b, 0xe1, 0x56, 0xdf, 0xfd, 0x8f, 0x7f, 0xa6, 0xfa,
-	0x3c, 0x37, 0x1b, 0xcb, 0x25, 0xbb, 0xbc, 0x3d, 0x70, 0x13, 0x5c, 0xe7, 0xbb, 0xd2, 0xb2, 0x2e,
-	0xab, 0xdd, 0x2e, 0xf9, 0xe2, 0x42, 0x64, 0x67, 0xce, 0xe1, 0xee, 0x1f, 0xf6, 0x82, 0xe9, 0xdc,
-	0x43, 0xb3, 0xb9, 0x87, 0x5e, 0xe6, 0x1e, 0xba, 0x5d, 0x78, 0xce, 0x6c, 0xe1, 0x39, 0x8f, 0x0b,
-	0xcf, 0xb9, 0xa0, 0x3c, 0x35, 0x49, 0x1e, 0x91, 0x58, 0x5e, 0xd1, 0x25, 0x64, 0xcf, 0xf2, 0xe8,
-	0x8a, 0x47, 0xaf, 0xe9, 0xfa, 0x1d, 0x99, 0xc9, 0x08, 0x74, 0xf4, 0xcd, 0x1e, 0x62, 0xff, 0x35,
-	0x00, 0x00, 0xff, 0xff, 0xc1, 0xe4, 0x6d, 0x3a, 0x60, 0x02, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x39, 0x08, 0xa2, 0x17, 0x55, 0x14, 0xb7, 0x15, 0x69, 0x91, 0x9c, 0x28, 0x53, 0x54,
+	0xe0, 0x8c, 0xc2, 0xc2, 0x4a, 0x43, 0x41, 0x96, 0x90, 0x5a, 0x79, 0x60, 0x60, 0x41, 0x67, 0xfb,
+	0xf5, 0x6c, 0xc9, 0xb9, 0x8b, 0xee, 0xce, 0x55, 0xca, 0xca, 0xc8, 0xc2, 0xc8, 0xbf, 0xc2, 0xc2,
+	0xdc, 0xb1, 0x23, 0x13, 0xa0, 0xe4, 0x1f, 0x41, 0x39, 0x27, 0x55, 0x1a, 0xbb, 0x08, 0xb6, 0xbb,
+	0xef, 0xfd, 0xf8, 0xbe, 0xf7, 0xbd, 0x3b, 0xe8, 0xca, 0x48, 0xa3, 0x3a, 0x47, 0xe5, 0xc7, 0x4a,
+	0x6a, 0x1d, 0xa7, 0x2c, 0x13, 0x1f, 0xce, 0x72, 0xc6, 0x35, 0x9d, 0x28, 0x69, 0xa4, 0xfb, 0xe8,
+	0x23, 0x1a, 0x66, 0x61, 0x6a, 0x4f, 0x52, 0x21, 0x5d, 0xd5, 0x1c, 0xec, 0x72, 0xc9, 0xa5, 0xcd,
+	0xf3, 0x17, 0xa7, 0xb2, 0xe4, 0xc0, 0xe3, 0x52, 0xf2, 0x1c, 0x7d, 0x7b, 0x8b, 0x8a, 0x33, 0x3f,
+	0x29, 0x14, 0x33, 0x99, 0x14, 0x65, 0xbc, 0xff, 0x9d, 0xc0, 0xde, 0x1b, 0xa6, 0x4f, 0x55, 0x16,
+	0x63, 0x20, 0x62, 0x85, 0x4c, 0xe3, 0xeb, 0x05, 0xa5, 0xdb, 0x83, 0x36, 0x4e, 0x64, 0x9c, 0xbe,
+	0x45, 0xc1, 0x4d, 0xda, 0x21, 0x3d, 0x32, 0x68, 0x86, 0xeb, 0x90, 0x1b, 0xc0, 0x96, 0x42, 0xa3,
+	0x2e, 0x02, 0x61, 0x50, 0x9d, 0xb3, 0xbc, 0xe3, 0xf4, 0xc8, 0xa0, 0x3d, 0xdc, 0xa7, 0x25, 0x27,
+	0x5d, 0x71, 0xd2, 0x57, 0x4b, 0xce, 0xa3, 0xd6, 0xe5, 0xcf, 0x6e, 0xe3, 0xeb, 0xaf, 0x2e, 0x09,
+	0x6f, 0x56, 0xba, 0x2f, 0xe0, 0x21, 0xdf, 0x50, 0x71, 0x8a, 0x2a, 0x46, 0x61, 0x3a, 0xcd, 0x1e,
+	0x19, 0x6c, 0x85, 0xb7, 0x85, 0xfb, 0x9f, 0x08, 0xec, 0xac, 0x06, 0x38, 0x51, 0x2c, 0xce, 0x97,
+	0xf2, 0x3d, 0x80, 0x31, 0x9b, 0xbe, 0x93, 0x06, 0x5f, 0x72, 0x5c, 0xaa, 0x5f, 0x43, 0x36, 0xc7,
+	0x73, 0xaa, 0xe3, 0x1d, 0xc2, 0xf6, 0x98, 0x4d, 0x47, 0x29, 0x13, 0x7c, 0x43, 0x4c, 0x05, 0xef,
+	0x7f, 0x73, 0xe0, 0xfe, 0xe8, 0x7a, 0x69, 0xa5, 0x82, 0x43, 0xd8, 0xce, 0x74, 0x20, 0x22, 0x59,
+	0x88, 0xe4, 0x58, 0xb0, 0x28, 0xc7, 0xc4, 0xea, 0x68, 0x85, 0x15, 0xdc, 0x7d, 0x02, 0x0f, 0x32,
+	0x7d, 0x52, 0x98, 0x1b, 0xc9, 0x8e, 0x4d, 0xae, 0x06, 0xdc, 0x14, 0xf6, 0x78, 0xdd, 0xce, 0xac,
+	0xbc, 0xf6, 0x70, 0x48, 0xff, 0xf2, 0x4e, 0x68, 0xed, 0xb6, 0xc3, 0xfa, 0x86, 0x6e, 0x04, 0x3b,
+	0xbc, 0x6a, 0x6e, 0xe7, 0x8e, 0xe5, 0x79, 0xf6, 0x4f, 0x3c, 0x6b, 0x75, 0x61, 0x5d, 0xb3, 0xfe,
+	0x67, 0x02, 0xbb, 0xa3, 0x45, 0x93, 0x4d, 0x03, 0xf7, 0xa1, 0x55, 0xfe, 0x81, 0x2c, 0x59, 0x2e,
+	0xf0, 0x9e, 0xbd, 0x07, 0x49, 0xad, 0xb7, 0xce, 0xff, 0x78, 0xdb, 0xbc, 0xc5, 0xdb, 0xa3, 0xe3,
+	0xcb, 0x99, 0x47, 0xae, 0x66, 0x1e, 0xf9, 0x3d, 0xf3, 0xc8, 0x97, 0xb9, 0xd7, 0xb8, 0x9a, 0x7b,
+	0x8d, 0x1f, 0x73, 0xaf, 0xf1, 0xfe, 0x31, 0xcf, 0x4c, 0x5a, 0x44, 0x34, 0x96, 0x63, 0x7f, 0x31,
+	0xee, 0x53, 0x2b, 0xc6, 0x17, 0x32, 0x41, 0x7f, 0xea, 0x5f, 0xff, 0x5d, 0x73, 0x31, 0x41, 0x1d,
+	0xdd, 0xb5, 0x8f, 0xff, 0xf9, 0x9f, 0x01, 0x00, 0x75, 0xce, 0x5a, 0xea, 0xd4, 0x03, 0x00, 0x00,
 }
 
 func (m *GasPriceIncreaseFlags) Marshal() (dAtA []byte, err error) {
@@ -223,6 +366,44 @@ func (m *GasPriceIncreaseFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasPriceOracleFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceOracleFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceOracleFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxChangePercent != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.MaxChangePercent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxVoteAge != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.MaxVoteAge))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CrosschainFlags) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -243,6 +424,18 @@ func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasPriceOracleFlags != nil {
+		{
+			size, err := m.GasPriceOracleFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.GasPriceIncreaseFlags != nil {
 		{
 			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
@@ -278,6 +471,54 @@ func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChainCrosschainFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainCrosschainFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainCrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsOutboundEnabled {
+		i--
+		if m.IsOutboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsInboundEnabled {
+		i--
+		if m.IsInboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCrosschainFlags(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCrosschainFlags(v)
 	base := offset
@@ -306,6 +547,24 @@ func (m *GasPriceIncreaseFlags) Size() (n int) {
 	return n
 }
 
+func (m *GasPriceOracleFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxVoteAge != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.MaxVoteAge))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.EpochLength))
+	}
+	if m.MaxChangePercent != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.MaxChangePercent))
+	}
+	return n
+}
+
 func (m *CrosschainFlags) Size() (n int) {
 	if m == nil {
 		return 0
@@ -322,6 +581,28 @@ func (m *CrosschainFlags) Size() (n int) {
 		l = m.GasPriceIncreaseFlags.Size()
 		n += 1 + l + sovCrosschainFlags(uint64(l))
 	}
+	if m.GasPriceOracleFlags != nil {
+		l = m.GasPriceOracleFlags.Size()
+		n += 1 + l + sovCrosschainFlags(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainCrosschainFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.ChainId))
+	}
+	if m.IsInboundEnabled {
+		n += 2
+	}
+	if m.IsOutboundEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -452,6 +733,113 @@ func (m *GasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GasPriceOracleFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschainFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceOracleFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceOracleFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteAge", wireType)
+			}
+			m.MaxVoteAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteAge |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePercent", wireType)
+			}
+			m.MaxChangePercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChangePercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CrosschainFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -557,6 +945,151 @@ func (m *CrosschainFlags) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceOracleFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasPriceOracleFlags == nil {
+				m.GasPriceOracleFlags = &GasPriceOracleFlags{}
+			}
+			if err := m.GasPriceOracleFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainCrosschainFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschainFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainCrosschainFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainCrosschainFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
@@ -30,4 +30,17 @@ var (
 	ErrBlockAlreadyExist       = errorsmod.Register(ModuleName, 1119, "block already exists")
 	ErrNoParentHash            = errorsmod.Register(ModuleName, 1120, "no parent hash")
 	ErrInvalidTimestamp        = errorsmod.Register(ModuleName, 1121, "invalid timestamp")
+	ErrInvalidChainInfo        = errorsmod.Register(ModuleName, 1122, "invalid chain info")
+	ErrBallotPruned            = errorsmod.Register(ModuleName, 1123, "ballot already finalized and pruned")
+	ErrUnknownParentHeader     = errorsmod.Register(ModuleName, 1124, "parent block header not found")
+	ErrInvalidHeaderHeight     = errorsmod.Register(ModuleName, 1125, "invalid block header height")
+	ErrInvalidDifficulty       = errorsmod.Register(ModuleName, 1126, "invalid block header difficulty")
+
+	ErrInvalidAdminSignerSet      = errorsmod.Register(ModuleName, 1127, "invalid admin signer set")
+	ErrAdminSignerSetNotFound     = errorsmod.Register(ModuleName, 1128, "admin signer set not found")
+	ErrInvalidAdminProposal       = errorsmod.Register(ModuleName, 1129, "invalid admin proposal")
+	ErrAdminProposalNotFound      = errorsmod.Register(ModuleName, 1130, "admin proposal not found")
+	ErrAdminProposalNotPending    = errorsmod.Register(ModuleName, 1131, "admin proposal is not pending")
+	ErrAdminProposalApproved      = errorsmod.Register(ModuleName, 1132, "admin proposal already approved by signer")
+	ErrAdminProposalNotExecutable = errorsmod.Register(ModuleName, 1133, "admin proposal cannot be executed yet")
 )
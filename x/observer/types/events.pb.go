@@ -11,6 +11,7 @@ import (
 
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	common "github.com/zeta-chain/node/common"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -242,6 +243,7 @@ type EventCrosschainFlagsUpdated struct {
 	IsOutboundEnabled     bool                   `protobuf:"varint,3,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
 	GasPriceIncreaseFlags *GasPriceIncreaseFlags `protobuf:"bytes,4,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
 	Signer                string                 `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	GasPriceOracleFlags   *GasPriceOracleFlags   `protobuf:"bytes,6,opt,name=gasPriceOracleFlags,proto3" json:"gasPriceOracleFlags,omitempty"`
 }
 
 func (m *EventCrosschainFlagsUpdated) Reset()         { *m = EventCrosschainFlagsUpdated{} }
@@ -312,405 +314,2975 @@ func (m *EventCrosschainFlagsUpdated) GetSigner() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventBallotCreated)(nil), "zetachain.zetacore.observer.EventBallotCreated")
-	proto.RegisterType((*EventKeygenBlockUpdated)(nil), "zetachain.zetacore.observer.EventKeygenBlockUpdated")
-	proto.RegisterType((*EventNewObserverAdded)(nil), "zetachain.zetacore.observer.EventNewObserverAdded")
-	proto.RegisterType((*EventCrosschainFlagsUpdated)(nil), "zetachain.zetacore.observer.EventCrosschainFlagsUpdated")
+func (m *EventCrosschainFlagsUpdated) GetGasPriceOracleFlags() *GasPriceOracleFlags {
+	if m != nil {
+		return m.GasPriceOracleFlags
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("observer/events.proto", fileDescriptor_1f1ca57368474456) }
-
-var fileDescriptor_1f1ca57368474456 = []byte{
-	// 564 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xba, 0x31, 0x81, 0x3b, 0x58, 0x67, 0xd1, 0x35, 0xeb, 0xa4, 0x6c, 0x54, 0x42, 0xe2,
-	0x67, 0x22, 0x8d, 0x13, 0x88, 0x0b, 0xad, 0xc6, 0xa8, 0x40, 0x6c, 0xaa, 0xd8, 0x85, 0x4b, 0xe4,
-	0x24, 0x6f, 0x49, 0xd4, 0xd4, 0xae, 0x6c, 0x67, 0x50, 0xee, 0xdc, 0xb9, 0x22, 0xfe, 0x21, 0x8e,
-	0x3b, 0x72, 0xe0, 0x80, 0xda, 0x7f, 0x04, 0xd9, 0x4e, 0xd3, 0xa2, 0x56, 0xa8, 0x37, 0xfb, 0x7b,
-	0xdf, 0xf7, 0xfc, 0xbd, 0x1f, 0x46, 0x0d, 0x16, 0x08, 0xe0, 0x57, 0xc0, 0x3d, 0xb8, 0x02, 0x2a,
-	0x85, 0x3b, 0xe2, 0x4c, 0x32, 0x7c, 0xf0, 0x05, 0x24, 0x09, 0x13, 0x92, 0x52, 0x57, 0x9f, 0x18,
-	0x07, 0x77, 0xc6, 0x6c, 0xdd, 0x8d, 0x59, 0xcc, 0x34, 0xcf, 0x53, 0x27, 0x23, 0x69, 0x1d, 0x96,
-	0x99, 0x42, 0xce, 0x84, 0xd0, 0x62, 0xff, 0x32, 0x23, 0x71, 0x91, 0xb3, 0xd5, 0x2c, 0x09, 0xb3,
-	0x83, 0x09, 0xb4, 0x7f, 0x5b, 0x08, 0x9f, 0xa8, 0xd7, 0x3b, 0x24, 0xcb, 0x98, 0xec, 0x72, 0x20,
-	0x12, 0x22, 0x7c, 0x84, 0xb6, 0x87, 0x22, 0xf6, 0xe5, 0x78, 0x04, 0x7e, 0xce, 0x33, 0xdb, 0x3a,
-	0xb2, 0x1e, 0xdc, 0xea, 0xa3, 0xa1, 0x88, 0x3f, 0x8c, 0x47, 0x70, 0xc1, 0x33, 0xfc, 0x18, 0xed,
-	0x06, 0x5a, 0xe2, 0xa7, 0x11, 0x50, 0x99, 0x5e, 0xa6, 0xc0, 0xed, 0xaa, 0xa6, 0xd5, 0x4d, 0xa0,
-	0x57, 0xe2, 0xf8, 0x21, 0xaa, 0x9b, 0x77, 0x89, 0x4c, 0x19, 0xf5, 0x13, 0x22, 0x12, 0x7b, 0x43,
-	0x73, 0x77, 0x16, 0xf0, 0x37, 0x44, 0x24, 0x2a, 0xef, 0x22, 0x55, 0x97, 0x62, 0x6f, 0x9a, 0xbc,
-	0x0b, 0x81, 0xae, 0xc2, 0xf1, 0x21, 0xaa, 0x15, 0x26, 0x94, 0x53, 0xfb, 0x86, 0x71, 0x69, 0x20,
-	0x65, 0xb4, 0xfd, 0xd5, 0x42, 0x4d, 0x5d, 0xde, 0x5b, 0x18, 0xc7, 0x40, 0x3b, 0x19, 0x0b, 0x07,
-	0x17, 0xa3, 0x68, 0xcd, 0x1a, 0xef, 0xa1, 0xed, 0x81, 0xd6, 0xf9, 0x81, 0x12, 0x16, 0xe5, 0xd5,
-	0x06, 0xf3, 0x5c, 0xf8, 0x3e, 0xba, 0x53, 0x50, 0x46, 0x79, 0x30, 0x80, 0xb1, 0x28, 0xea, 0xba,
-	0x6d, 0xd0, 0x73, 0x03, 0xb6, 0xbf, 0x57, 0x51, 0x43, 0xfb, 0x78, 0x0f, 0x9f, 0xce, 0x8a, 0x09,
-	0xbc, 0x8a, 0xa2, 0xb5, 0x5c, 0x94, 0xcd, 0x03, 0xee, 0x93, 0x28, 0xe2, 0x20, 0x44, 0xe1, 0x64,
-	0x87, 0xcd, 0x53, 0x29, 0x18, 0xbf, 0x44, 0x2d, 0xbd, 0x32, 0x59, 0x0a, 0x54, 0xfa, 0x31, 0x27,
-	0x54, 0x02, 0x94, 0x22, 0xe3, 0xcc, 0x9e, 0x33, 0x4e, 0x0d, 0x61, 0xa6, 0x7e, 0x81, 0xf6, 0x57,
-	0xa8, 0x4d, 0x5d, 0xc5, 0x08, 0x9a, 0x4b, 0x62, 0x53, 0x21, 0x7e, 0x8e, 0xf6, 0x4b, 0x93, 0x19,
-	0x11, 0xd2, 0x74, 0xcc, 0x0f, 0x59, 0x4e, 0xa5, 0x9e, 0xcb, 0x66, 0x7f, 0x6f, 0x46, 0x78, 0x47,
-	0x84, 0xd4, 0xdd, 0xeb, 0xaa, 0x68, 0xfb, 0x47, 0x15, 0x1d, 0xe8, 0xde, 0x74, 0xcb, 0xdd, 0x7d,
-	0xad, 0x56, 0x77, 0xfd, 0x39, 0x3d, 0x42, 0xf5, 0x54, 0xf4, 0x68, 0xc0, 0x72, 0x1a, 0x9d, 0x50,
-	0x12, 0x64, 0x10, 0xe9, 0x0e, 0xdd, 0xec, 0x2f, 0xe1, 0xf8, 0x09, 0xda, 0x4d, 0xc5, 0x59, 0x2e,
-	0xff, 0x21, 0x6f, 0x68, 0xf2, 0x72, 0x00, 0x27, 0xa8, 0x11, 0x13, 0x71, 0xce, 0xd3, 0x10, 0x7a,
-	0x34, 0xe4, 0x40, 0x04, 0x68, 0x6f, 0xba, 0x1d, 0xb5, 0xe3, 0x63, 0xf7, 0x3f, 0x7f, 0xd5, 0x3d,
-	0x5d, 0xa5, 0xec, 0xaf, 0x4e, 0x88, 0xf7, 0xd0, 0x96, 0x48, 0x63, 0x0a, 0xbc, 0xd8, 0xe2, 0xe2,
-	0xd6, 0xe9, 0xfd, 0x9c, 0x38, 0xd6, 0xf5, 0xc4, 0xb1, 0xfe, 0x4c, 0x1c, 0xeb, 0xdb, 0xd4, 0xa9,
-	0x5c, 0x4f, 0x9d, 0xca, 0xaf, 0xa9, 0x53, 0xf9, 0xe8, 0xc5, 0xa9, 0x4c, 0xf2, 0xc0, 0x0d, 0xd9,
-	0xd0, 0x53, 0x8f, 0x3f, 0xd5, 0x3e, 0xbc, 0x99, 0x0f, 0xef, 0x73, 0xf9, 0xd7, 0x3d, 0xd5, 0x3b,
-	0x11, 0x6c, 0xe9, 0x2f, 0xff, 0xec, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0x1b, 0xca, 0x44,
-	0x78, 0x04, 0x00, 0x00,
+type EventChainCrosschainFlagsUpdated struct {
+	MsgTypeUrl        string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ChainId           int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	IsInboundEnabled  bool   `protobuf:"varint,3,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
+	IsOutboundEnabled bool   `protobuf:"varint,4,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
+	Signer            string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *EventBallotCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventChainCrosschainFlagsUpdated) Reset()         { *m = EventChainCrosschainFlagsUpdated{} }
+func (m *EventChainCrosschainFlagsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventChainCrosschainFlagsUpdated) ProtoMessage()    {}
+func (*EventChainCrosschainFlagsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{4}
+}
+func (m *EventChainCrosschainFlagsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainCrosschainFlagsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainCrosschainFlagsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventBallotCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventChainCrosschainFlagsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainCrosschainFlagsUpdated.Merge(m, src)
+}
+func (m *EventChainCrosschainFlagsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainCrosschainFlagsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainCrosschainFlagsUpdated.DiscardUnknown(m)
 }
 
-func (m *EventBallotCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BallotType) > 0 {
-		i -= len(m.BallotType)
-		copy(dAtA[i:], m.BallotType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ObservationChain) > 0 {
-		i -= len(m.ObservationChain)
-		copy(dAtA[i:], m.ObservationChain)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObservationChain)))
-		i--
-		dAtA[i] = 0x22
+var xxx_messageInfo_EventChainCrosschainFlagsUpdated proto.InternalMessageInfo
+
+func (m *EventChainCrosschainFlagsUpdated) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	if len(m.ObservationHash) > 0 {
-		i -= len(m.ObservationHash)
-		copy(dAtA[i:], m.ObservationHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObservationHash)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventChainCrosschainFlagsUpdated) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EventChainCrosschainFlagsUpdated) GetIsInboundEnabled() bool {
+	if m != nil {
+		return m.IsInboundEnabled
 	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *EventChainCrosschainFlagsUpdated) GetIsOutboundEnabled() bool {
+	if m != nil {
+		return m.IsOutboundEnabled
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *EventKeygenBlockUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventChainCrosschainFlagsUpdated) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventKeygenBlockUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventChainInfoUpdated struct {
+	MsgTypeUrl string           `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ChainInfo  common.ChainInfo `protobuf:"bytes,2,opt,name=chain_info,json=chainInfo,proto3" json:"chain_info"`
+	IsNewChain bool             `protobuf:"varint,3,opt,name=is_new_chain,json=isNewChain,proto3" json:"is_new_chain,omitempty"`
+	Signer     string           `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *EventKeygenBlockUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeygenPubkeys) > 0 {
-		i -= len(m.KeygenPubkeys)
-		copy(dAtA[i:], m.KeygenPubkeys)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.KeygenPubkeys)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.KeygenBlock) > 0 {
-		i -= len(m.KeygenBlock)
-		copy(dAtA[i:], m.KeygenBlock)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.KeygenBlock)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventChainInfoUpdated) Reset()         { *m = EventChainInfoUpdated{} }
+func (m *EventChainInfoUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventChainInfoUpdated) ProtoMessage()    {}
+func (*EventChainInfoUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{5}
+}
+func (m *EventChainInfoUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainInfoUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainInfoUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventChainInfoUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainInfoUpdated.Merge(m, src)
+}
+func (m *EventChainInfoUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainInfoUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainInfoUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainInfoUpdated proto.InternalMessageInfo
+
+func (m *EventChainInfoUpdated) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventNewObserverAdded) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventChainInfoUpdated) GetChainInfo() common.ChainInfo {
+	if m != nil {
+		return m.ChainInfo
 	}
-	return dAtA[:n], nil
+	return common.ChainInfo{}
 }
 
-func (m *EventNewObserverAdded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventChainInfoUpdated) GetIsNewChain() bool {
+	if m != nil {
+		return m.IsNewChain
+	}
+	return false
 }
 
-func (m *EventNewObserverAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ObserverLastBlockCount != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ObserverLastBlockCount))
-		i--
-		dAtA[i] = 0x28
+func (m *EventChainInfoUpdated) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.ZetaclientGranteePubkey) > 0 {
-		i -= len(m.ZetaclientGranteePubkey)
-		copy(dAtA[i:], m.ZetaclientGranteePubkey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ZetaclientGranteePubkey)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+type EventAdminSignerSetUpdated struct {
+	MsgTypeUrl    string         `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	SignerSet     AdminSignerSet `protobuf:"bytes,2,opt,name=signer_set,json=signerSet,proto3" json:"signer_set"`
+	PolicyAddress string         `protobuf:"bytes,3,opt,name=policy_address,json=policyAddress,proto3" json:"policy_address,omitempty"`
+	Signer        string         `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventAdminSignerSetUpdated) Reset()         { *m = EventAdminSignerSetUpdated{} }
+func (m *EventAdminSignerSetUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventAdminSignerSetUpdated) ProtoMessage()    {}
+func (*EventAdminSignerSetUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{6}
+}
+func (m *EventAdminSignerSetUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminSignerSetUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminSignerSetUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ZetaclientGranteeAddress) > 0 {
-		i -= len(m.ZetaclientGranteeAddress)
-		copy(dAtA[i:], m.ZetaclientGranteeAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ZetaclientGranteeAddress)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventAdminSignerSetUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminSignerSetUpdated.Merge(m, src)
+}
+func (m *EventAdminSignerSetUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminSignerSetUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminSignerSetUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminSignerSetUpdated proto.InternalMessageInfo
+
+func (m *EventAdminSignerSetUpdated) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	if len(m.ObserverAddress) > 0 {
-		i -= len(m.ObserverAddress)
-		copy(dAtA[i:], m.ObserverAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventAdminSignerSetUpdated) GetSignerSet() AdminSignerSet {
+	if m != nil {
+		return m.SignerSet
 	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+	return AdminSignerSet{}
+}
+
+func (m *EventAdminSignerSetUpdated) GetPolicyAddress() string {
+	if m != nil {
+		return m.PolicyAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventCrosschainFlagsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAdminSignerSetUpdated) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventCrosschainFlagsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventAdminProposalSubmitted struct {
+	MsgTypeUrl      string      `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ProposalId      uint64      `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	PolicyType      Policy_Type `protobuf:"varint,3,opt,name=policy_type,json=policyType,proto3,enum=zetachain.zetacore.observer.Policy_Type" json:"policy_type,omitempty"`
+	MessageTypeUrls []string    `protobuf:"bytes,4,rep,name=message_type_urls,json=messageTypeUrls,proto3" json:"message_type_urls,omitempty"`
+	Signer          string      `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *EventCrosschainFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
-		i--
+func (m *EventAdminProposalSubmitted) Reset()         { *m = EventAdminProposalSubmitted{} }
+func (m *EventAdminProposalSubmitted) String() string { return proto.CompactTextString(m) }
+func (*EventAdminProposalSubmitted) ProtoMessage()    {}
+func (*EventAdminProposalSubmitted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{7}
+}
+func (m *EventAdminProposalSubmitted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminProposalSubmitted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminProposalSubmitted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminProposalSubmitted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminProposalSubmitted.Merge(m, src)
+}
+func (m *EventAdminProposalSubmitted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminProposalSubmitted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminProposalSubmitted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminProposalSubmitted proto.InternalMessageInfo
+
+func (m *EventAdminProposalSubmitted) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventAdminProposalSubmitted) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventAdminProposalSubmitted) GetPolicyType() Policy_Type {
+	if m != nil {
+		return m.PolicyType
+	}
+	return Policy_Type_group1
+}
+
+func (m *EventAdminProposalSubmitted) GetMessageTypeUrls() []string {
+	if m != nil {
+		return m.MessageTypeUrls
+	}
+	return nil
+}
+
+func (m *EventAdminProposalSubmitted) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type EventAdminProposalApproved struct {
+	MsgTypeUrl       string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ProposalId       uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Approvals        uint32 `protobuf:"varint,3,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	ExecutableHeight int64  `protobuf:"varint,4,opt,name=executable_height,json=executableHeight,proto3" json:"executable_height,omitempty"`
+	Signer           string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventAdminProposalApproved) Reset()         { *m = EventAdminProposalApproved{} }
+func (m *EventAdminProposalApproved) String() string { return proto.CompactTextString(m) }
+func (*EventAdminProposalApproved) ProtoMessage()    {}
+func (*EventAdminProposalApproved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{8}
+}
+func (m *EventAdminProposalApproved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminProposalApproved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminProposalApproved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminProposalApproved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminProposalApproved.Merge(m, src)
+}
+func (m *EventAdminProposalApproved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminProposalApproved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminProposalApproved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminProposalApproved proto.InternalMessageInfo
+
+func (m *EventAdminProposalApproved) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventAdminProposalApproved) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventAdminProposalApproved) GetApprovals() uint32 {
+	if m != nil {
+		return m.Approvals
+	}
+	return 0
+}
+
+func (m *EventAdminProposalApproved) GetExecutableHeight() int64 {
+	if m != nil {
+		return m.ExecutableHeight
+	}
+	return 0
+}
+
+func (m *EventAdminProposalApproved) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type EventAdminProposalExecuted struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Success    bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Error      string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Signer     string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventAdminProposalExecuted) Reset()         { *m = EventAdminProposalExecuted{} }
+func (m *EventAdminProposalExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventAdminProposalExecuted) ProtoMessage()    {}
+func (*EventAdminProposalExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{9}
+}
+func (m *EventAdminProposalExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminProposalExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminProposalExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminProposalExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminProposalExecuted.Merge(m, src)
+}
+func (m *EventAdminProposalExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminProposalExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminProposalExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminProposalExecuted proto.InternalMessageInfo
+
+func (m *EventAdminProposalExecuted) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventAdminProposalExecuted) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventAdminProposalExecuted) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *EventAdminProposalExecuted) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EventAdminProposalExecuted) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type EventAdminProposalCancelled struct {
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Signer     string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventAdminProposalCancelled) Reset()         { *m = EventAdminProposalCancelled{} }
+func (m *EventAdminProposalCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventAdminProposalCancelled) ProtoMessage()    {}
+func (*EventAdminProposalCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{10}
+}
+func (m *EventAdminProposalCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAdminProposalCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAdminProposalCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAdminProposalCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAdminProposalCancelled.Merge(m, src)
+}
+func (m *EventAdminProposalCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAdminProposalCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAdminProposalCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAdminProposalCancelled proto.InternalMessageInfo
+
+func (m *EventAdminProposalCancelled) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventAdminProposalCancelled) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventAdminProposalCancelled) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+type EventNodeDisabled struct {
+	MsgTypeUrl   string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Operator     string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	PubKey       string `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	BlameCount   uint64 `protobuf:"varint,4,opt,name=blame_count,json=blameCount,proto3" json:"blame_count,omitempty"`
+	WindowBlocks int64  `protobuf:"varint,5,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+}
+
+func (m *EventNodeDisabled) Reset()         { *m = EventNodeDisabled{} }
+func (m *EventNodeDisabled) String() string { return proto.CompactTextString(m) }
+func (*EventNodeDisabled) ProtoMessage()    {}
+func (*EventNodeDisabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{11}
+}
+func (m *EventNodeDisabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNodeDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNodeDisabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNodeDisabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNodeDisabled.Merge(m, src)
+}
+func (m *EventNodeDisabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNodeDisabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNodeDisabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNodeDisabled proto.InternalMessageInfo
+
+func (m *EventNodeDisabled) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventNodeDisabled) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *EventNodeDisabled) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *EventNodeDisabled) GetBlameCount() uint64 {
+	if m != nil {
+		return m.BlameCount
+	}
+	return 0
+}
+
+func (m *EventNodeDisabled) GetWindowBlocks() int64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventBallotCreated)(nil), "zetachain.zetacore.observer.EventBallotCreated")
+	proto.RegisterType((*EventKeygenBlockUpdated)(nil), "zetachain.zetacore.observer.EventKeygenBlockUpdated")
+	proto.RegisterType((*EventNewObserverAdded)(nil), "zetachain.zetacore.observer.EventNewObserverAdded")
+	proto.RegisterType((*EventCrosschainFlagsUpdated)(nil), "zetachain.zetacore.observer.EventCrosschainFlagsUpdated")
+	proto.RegisterType((*EventChainCrosschainFlagsUpdated)(nil), "zetachain.zetacore.observer.EventChainCrosschainFlagsUpdated")
+	proto.RegisterType((*EventChainInfoUpdated)(nil), "zetachain.zetacore.observer.EventChainInfoUpdated")
+	proto.RegisterType((*EventAdminSignerSetUpdated)(nil), "zetachain.zetacore.observer.EventAdminSignerSetUpdated")
+	proto.RegisterType((*EventAdminProposalSubmitted)(nil), "zetachain.zetacore.observer.EventAdminProposalSubmitted")
+	proto.RegisterType((*EventAdminProposalApproved)(nil), "zetachain.zetacore.observer.EventAdminProposalApproved")
+	proto.RegisterType((*EventAdminProposalExecuted)(nil), "zetachain.zetacore.observer.EventAdminProposalExecuted")
+	proto.RegisterType((*EventAdminProposalCancelled)(nil), "zetachain.zetacore.observer.EventAdminProposalCancelled")
+	proto.RegisterType((*EventNodeDisabled)(nil), "zetachain.zetacore.observer.EventNodeDisabled")
+}
+
+func init() { proto.RegisterFile("observer/events.proto", fileDescriptor_1f1ca57368474456) }
+
+var fileDescriptor_1f1ca57368474456 = []byte{
+	// 1036 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x4f, 0x24, 0x45,
+	0x14, 0xa7, 0x99, 0xd9, 0x05, 0xde, 0xc0, 0x0a, 0xbd, 0xcb, 0x32, 0xcc, 0xea, 0x80, 0x63, 0x4c,
+	0xd6, 0x45, 0x67, 0x0c, 0x26, 0x26, 0x1a, 0x2f, 0x80, 0xb8, 0x3b, 0x59, 0xb3, 0x90, 0xc6, 0xbd,
+	0x78, 0xe9, 0x54, 0x77, 0x3f, 0x7a, 0x3a, 0xf4, 0x54, 0x75, 0xaa, 0xaa, 0x81, 0xf1, 0xee, 0xdd,
+	0xab, 0x9f, 0xc0, 0x83, 0x17, 0x3f, 0x83, 0xa7, 0xbd, 0xb9, 0xde, 0x3c, 0x18, 0x63, 0xe0, 0x03,
+	0xf8, 0x11, 0x34, 0xf5, 0xa7, 0x7b, 0x18, 0x99, 0x21, 0xa3, 0xe1, 0xd4, 0x55, 0xaf, 0xde, 0x9f,
+	0xdf, 0xef, 0xbd, 0xaa, 0xf7, 0x1a, 0x56, 0x59, 0x20, 0x90, 0x9f, 0x22, 0xef, 0xe0, 0x29, 0x52,
+	0x29, 0xda, 0x19, 0x67, 0x92, 0xb9, 0x8f, 0xbe, 0x41, 0x49, 0xc2, 0x1e, 0x49, 0x68, 0x5b, 0xaf,
+	0x18, 0xc7, 0x76, 0xa1, 0xd9, 0xb8, 0x1f, 0xb2, 0x7e, 0x9f, 0xd1, 0x8e, 0xf9, 0x18, 0x8b, 0xc6,
+	0x83, 0x98, 0xc5, 0x4c, 0x2f, 0x3b, 0x6a, 0x65, 0xa5, 0x6f, 0x95, 0xee, 0x49, 0xd4, 0x4f, 0xa8,
+	0x9f, 0x71, 0x96, 0x31, 0x41, 0x52, 0x7b, 0xbc, 0x51, 0x1e, 0x87, 0x9c, 0x09, 0xa1, 0x03, 0xfa,
+	0xc7, 0x29, 0x89, 0x2d, 0x8e, 0xc6, 0x5a, 0xa9, 0x50, 0x2c, 0xec, 0xc1, 0x10, 0x77, 0x46, 0x38,
+	0xe9, 0x5b, 0xfd, 0xd6, 0xef, 0x0e, 0xb8, 0xfb, 0x8a, 0xc8, 0x2e, 0x49, 0x53, 0x26, 0xf7, 0x38,
+	0x12, 0x89, 0x91, 0xbb, 0x09, 0x8b, 0x7d, 0x11, 0xfb, 0x72, 0x90, 0xa1, 0x9f, 0xf3, 0xb4, 0xee,
+	0x6c, 0x3a, 0x8f, 0x17, 0x3c, 0xe8, 0x8b, 0xf8, 0xab, 0x41, 0x86, 0x2f, 0x79, 0xea, 0x6e, 0xc1,
+	0x4a, 0xa0, 0x4d, 0xfc, 0x24, 0x42, 0x2a, 0x93, 0xe3, 0x04, 0x79, 0x7d, 0x56, 0xab, 0x2d, 0x9b,
+	0x83, 0x6e, 0x29, 0x77, 0xdf, 0x83, 0x65, 0x13, 0x9e, 0xc8, 0x84, 0x51, 0xbf, 0x47, 0x44, 0xaf,
+	0x5e, 0xd1, 0xba, 0x6f, 0x5c, 0x91, 0x3f, 0x23, 0xa2, 0xa7, 0xfc, 0x5e, 0x55, 0xd5, 0x0c, 0xeb,
+	0x55, 0xe3, 0xf7, 0xca, 0xc1, 0x9e, 0x92, 0xbb, 0x1b, 0x50, 0xb3, 0x20, 0x14, 0xd2, 0xfa, 0x1d,
+	0x83, 0xd2, 0x88, 0x14, 0xd0, 0xd6, 0xb7, 0x0e, 0xac, 0x69, 0x7a, 0xcf, 0x71, 0x10, 0x23, 0xdd,
+	0x4d, 0x59, 0x78, 0xf2, 0x32, 0x8b, 0xa6, 0xe4, 0xf8, 0x36, 0x2c, 0x9e, 0x68, 0x3b, 0x3f, 0x50,
+	0x86, 0x96, 0x5e, 0xed, 0x64, 0xe8, 0xcb, 0x7d, 0x17, 0xee, 0x59, 0x95, 0x2c, 0x0f, 0x4e, 0x70,
+	0x20, 0x2c, 0xaf, 0x25, 0x23, 0x3d, 0x34, 0xc2, 0xd6, 0xf7, 0xb3, 0xb0, 0xaa, 0x71, 0xbc, 0xc0,
+	0xb3, 0x03, 0x5b, 0x88, 0x9d, 0x28, 0x9a, 0x0a, 0x45, 0x99, 0x3c, 0xe4, 0x3e, 0x89, 0x22, 0x8e,
+	0x42, 0xd4, 0x67, 0xaf, 0x26, 0x4f, 0xbb, 0x52, 0x62, 0xf7, 0x33, 0x68, 0xe8, 0xdb, 0x97, 0x26,
+	0x48, 0xa5, 0x1f, 0x73, 0x42, 0x25, 0x62, 0x69, 0x64, 0x90, 0xd5, 0x87, 0x1a, 0x4f, 0x8d, 0x42,
+	0x61, 0xfd, 0x29, 0xac, 0x8f, 0xb1, 0x36, 0xbc, 0x6c, 0x09, 0xd6, 0xae, 0x19, 0x1b, 0x86, 0xee,
+	0x27, 0xb0, 0x5e, 0x82, 0x4c, 0x89, 0x90, 0x26, 0x63, 0x7e, 0xc8, 0x72, 0x2a, 0x75, 0x5d, 0xaa,
+	0xde, 0xc3, 0x42, 0xe1, 0x4b, 0x22, 0xa4, 0xce, 0xde, 0x9e, 0x3a, 0x6d, 0xfd, 0x3d, 0x0b, 0x8f,
+	0x74, 0x6e, 0xf6, 0xca, 0x2b, 0xfd, 0x85, 0xba, 0xd1, 0xd3, 0xd7, 0xe9, 0x09, 0x2c, 0x27, 0xa2,
+	0x4b, 0x03, 0x96, 0xd3, 0x68, 0x9f, 0x92, 0x20, 0xc5, 0x48, 0x67, 0x68, 0xde, 0xbb, 0x26, 0x77,
+	0xdf, 0x87, 0x95, 0x44, 0x1c, 0xe4, 0x72, 0x44, 0xb9, 0xa2, 0x95, 0xaf, 0x1f, 0xb8, 0x3d, 0x58,
+	0x8d, 0x89, 0x38, 0xe4, 0x49, 0x88, 0x5d, 0x1a, 0x72, 0x24, 0x02, 0x35, 0x36, 0x9d, 0x8e, 0xda,
+	0xf6, 0x76, 0xfb, 0x86, 0x67, 0xdf, 0x7e, 0x3a, 0xce, 0xd2, 0x1b, 0xef, 0xd0, 0x7d, 0x08, 0x77,
+	0x45, 0x12, 0x53, 0xe4, 0xf6, 0x16, 0xdb, 0x9d, 0x1b, 0xc0, 0xfd, 0xc2, 0xe0, 0x80, 0x93, 0x30,
+	0xb5, 0xf1, 0xef, 0xea, 0xf8, 0x1f, 0x4e, 0x15, 0xff, 0x8a, 0x9d, 0x37, 0xce, 0x59, 0xeb, 0x57,
+	0x07, 0x36, 0x4d, 0x05, 0x94, 0xa7, 0xff, 0x5d, 0x86, 0x75, 0x98, 0x37, 0x0d, 0x29, 0x31, 0xe9,
+	0xaf, 0x78, 0x73, 0x7a, 0xdf, 0x8d, 0xc6, 0x56, 0xa8, 0xf2, 0x5f, 0x2a, 0x54, 0x9d, 0x54, 0xa1,
+	0x09, 0x79, 0x6b, 0xfd, 0xe8, 0xc0, 0xea, 0x90, 0x53, 0x97, 0x1e, 0xb3, 0xe9, 0x89, 0x7c, 0x0c,
+	0x60, 0x89, 0xd0, 0x63, 0xa6, 0xa9, 0xd4, 0xb6, 0x57, 0xda, 0xb6, 0x7b, 0x97, 0xfe, 0x76, 0xab,
+	0xaf, 0xfe, 0xd8, 0x98, 0xf1, 0x16, 0xc2, 0x42, 0xa0, 0x3c, 0x27, 0xc2, 0xa7, 0x78, 0x66, 0xdb,
+	0x96, 0x61, 0x08, 0x89, 0x78, 0x81, 0x67, 0xa6, 0x61, 0x0d, 0xd1, 0x56, 0x47, 0xd0, 0xfe, 0xe2,
+	0x40, 0x43, 0xa3, 0xdd, 0x51, 0x5d, 0xff, 0x48, 0x0b, 0x8f, 0x50, 0x4e, 0x0f, 0xf9, 0x10, 0xc0,
+	0xb8, 0xf2, 0x05, 0x4a, 0x0b, 0x79, 0xeb, 0xc6, 0xdb, 0x31, 0x1a, 0xa9, 0x20, 0x23, 0x0a, 0x81,
+	0xea, 0x6c, 0x19, 0x4b, 0x93, 0x70, 0xf0, 0xaf, 0xfe, 0xb1, 0x64, 0xa4, 0x45, 0xd3, 0x98, 0xc4,
+	0xe8, 0x2f, 0xc7, 0xbe, 0x6a, 0x1d, 0xe7, 0xd0, 0x8e, 0xb1, 0xa3, 0x3c, 0xe8, 0x27, 0x72, 0x3a,
+	0x4a, 0x1b, 0x50, 0x2b, 0xa6, 0x5f, 0x71, 0xa3, 0xaa, 0x1e, 0x14, 0xa2, 0x6e, 0xe4, 0x76, 0xa1,
+	0x66, 0x11, 0xea, 0xee, 0xaf, 0xe0, 0xdd, 0xdb, 0x7e, 0x7c, 0x23, 0xe9, 0x43, 0xa3, 0xaf, 0x42,
+	0x78, 0x60, 0x8c, 0xd5, 0xda, 0x7d, 0x02, 0x2b, 0x7d, 0x14, 0x82, 0xc4, 0x58, 0x22, 0x52, 0x6f,
+	0xbc, 0xa2, 0x9a, 0xac, 0x3d, 0xb0, 0xb0, 0x26, 0xbe, 0xd4, 0xd6, 0xcf, 0x23, 0x35, 0x2c, 0x18,
+	0xef, 0x64, 0x19, 0x67, 0xa7, 0xb7, 0x43, 0xf8, 0x4d, 0x58, 0x20, 0xda, 0x1d, 0x49, 0x4d, 0x35,
+	0x96, 0xbc, 0xa1, 0x40, 0x4d, 0x4e, 0x3c, 0xc7, 0x30, 0x97, 0xea, 0x61, 0xf8, 0x3d, 0x4c, 0xe2,
+	0x9e, 0xd4, 0x45, 0xa9, 0x78, 0xcb, 0xc3, 0x83, 0x67, 0x5a, 0x3e, 0x91, 0xc4, 0x0f, 0x63, 0x49,
+	0xec, 0x6b, 0xf3, 0xdb, 0x21, 0x51, 0x87, 0x39, 0x91, 0x87, 0x61, 0x71, 0xa1, 0xe6, 0xbd, 0x62,
+	0xeb, 0x3e, 0x80, 0x3b, 0xc8, 0x39, 0x2b, 0x6e, 0x92, 0xd9, 0x4c, 0x44, 0x7a, 0x3e, 0xee, 0x7e,
+	0xed, 0x11, 0x1a, 0x62, 0x9a, 0xde, 0x0e, 0xd2, 0x61, 0xe4, 0xca, 0x48, 0xe4, 0x9f, 0x1c, 0x58,
+	0x31, 0xc3, 0x9c, 0x45, 0xf8, 0x79, 0x22, 0x48, 0x30, 0x5d, 0xc0, 0x06, 0xcc, 0xb3, 0x0c, 0x39,
+	0x91, 0xac, 0xf8, 0x53, 0x2a, 0xf7, 0xee, 0x1a, 0xcc, 0x65, 0x79, 0xe0, 0xab, 0x49, 0x6b, 0x83,
+	0x65, 0x79, 0xf0, 0x1c, 0x07, 0xfa, 0x17, 0x27, 0x25, 0x7d, 0xb4, 0xa3, 0xb4, 0x6a, 0x50, 0x6a,
+	0x91, 0x1e, 0x9f, 0xee, 0x3b, 0xb0, 0x74, 0x96, 0xd0, 0x88, 0x9d, 0x99, 0x91, 0x2b, 0x74, 0x9a,
+	0x2a, 0xde, 0xa2, 0x11, 0xea, 0x39, 0x2b, 0x76, 0xf7, 0x5f, 0x5d, 0x34, 0x9d, 0xd7, 0x17, 0x4d,
+	0xe7, 0xcf, 0x8b, 0xa6, 0xf3, 0xdd, 0x65, 0x73, 0xe6, 0xf5, 0x65, 0x73, 0xe6, 0xb7, 0xcb, 0xe6,
+	0xcc, 0xd7, 0x5b, 0x71, 0x22, 0x7b, 0x79, 0xa0, 0xba, 0x5b, 0x47, 0xbd, 0x97, 0x0f, 0xf4, 0xd3,
+	0xe9, 0x50, 0x16, 0x61, 0xe7, 0xbc, 0xfc, 0x89, 0xec, 0x28, 0x5a, 0x22, 0xb8, 0xab, 0x7f, 0x1a,
+	0x3f, 0xfa, 0x67, 0x00, 0x75, 0x44, 0xd3, 0x68, 0x05, 0x0b, 0x00, 0x00,
+}
+
+func (m *EventBallotCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBallotCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBallotCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BallotType) > 0 {
+		i -= len(m.BallotType)
+		copy(dAtA[i:], m.BallotType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ObservationChain) > 0 {
+		i -= len(m.ObservationChain)
+		copy(dAtA[i:], m.ObservationChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObservationChain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ObservationHash) > 0 {
+		i -= len(m.ObservationHash)
+		copy(dAtA[i:], m.ObservationHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObservationHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventKeygenBlockUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventKeygenBlockUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventKeygenBlockUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeygenPubkeys) > 0 {
+		i -= len(m.KeygenPubkeys)
+		copy(dAtA[i:], m.KeygenPubkeys)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.KeygenPubkeys)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeygenBlock) > 0 {
+		i -= len(m.KeygenBlock)
+		copy(dAtA[i:], m.KeygenBlock)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.KeygenBlock)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNewObserverAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewObserverAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewObserverAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ObserverLastBlockCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ObserverLastBlockCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ZetaclientGranteePubkey) > 0 {
+		i -= len(m.ZetaclientGranteePubkey)
+		copy(dAtA[i:], m.ZetaclientGranteePubkey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ZetaclientGranteePubkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ZetaclientGranteeAddress) > 0 {
+		i -= len(m.ZetaclientGranteeAddress)
+		copy(dAtA[i:], m.ZetaclientGranteeAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ZetaclientGranteeAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCrosschainFlagsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCrosschainFlagsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCrosschainFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPriceOracleFlags != nil {
+		{
+			size, err := m.GasPriceOracleFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasPriceIncreaseFlags != nil {
+		{
+			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsOutboundEnabled {
+		i--
+		if m.IsOutboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsInboundEnabled {
+		i--
+		if m.IsInboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainCrosschainFlagsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainCrosschainFlagsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainCrosschainFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IsOutboundEnabled {
+		i--
+		if m.IsOutboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsInboundEnabled {
+		i--
+		if m.IsInboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainInfoUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainInfoUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainInfoUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsNewChain {
+		i--
+		if m.IsNewChain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.ChainInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminSignerSetUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminSignerSetUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminSignerSetUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PolicyAddress) > 0 {
+		i -= len(m.PolicyAddress)
+		copy(dAtA[i:], m.PolicyAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PolicyAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminProposalSubmitted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminProposalSubmitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminProposalSubmitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MessageTypeUrls) > 0 {
+		for iNdEx := len(m.MessageTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MessageTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MessageTypeUrls[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.MessageTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PolicyType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PolicyType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminProposalApproved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminProposalApproved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminProposalApproved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExecutableHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExecutableHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Approvals != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Approvals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminProposalExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminProposalExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminProposalExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
 		dAtA[i] = 0x2a
 	}
-	if m.GasPriceIncreaseFlags != nil {
-		{
-			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAdminProposalCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAdminProposalCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAdminProposalCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNodeDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNodeDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNodeDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlameCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlameCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBallotCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObservationHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObservationChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventKeygenBlockUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.KeygenBlock)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.KeygenPubkeys)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNewObserverAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ZetaclientGranteeAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ZetaclientGranteePubkey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ObserverLastBlockCount != 0 {
+		n += 1 + sovEvents(uint64(m.ObserverLastBlockCount))
+	}
+	return n
+}
+
+func (m *EventCrosschainFlagsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsInboundEnabled {
+		n += 2
+	}
+	if m.IsOutboundEnabled {
+		n += 2
+	}
+	if m.GasPriceIncreaseFlags != nil {
+		l = m.GasPriceIncreaseFlags.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.GasPriceOracleFlags != nil {
+		l = m.GasPriceOracleFlags.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainCrosschainFlagsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	if m.IsInboundEnabled {
+		n += 2
+	}
+	if m.IsOutboundEnabled {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainInfoUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ChainInfo.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.IsNewChain {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAdminSignerSetUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.SignerSet.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.PolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAdminProposalSubmitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	if m.PolicyType != 0 {
+		n += 1 + sovEvents(uint64(m.PolicyType))
+	}
+	if len(m.MessageTypeUrls) > 0 {
+		for _, s := range m.MessageTypeUrls {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAdminProposalApproved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	if m.Approvals != 0 {
+		n += 1 + sovEvents(uint64(m.Approvals))
+	}
+	if m.ExecutableHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExecutableHeight))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAdminProposalExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAdminProposalCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNodeDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlameCount != 0 {
+		n += 1 + sovEvents(uint64(m.BlameCount))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovEvents(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBallotCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBallotCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
+			}
+			m.ObserverLastBlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundEnabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasPriceIncreaseFlags == nil {
+				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
+			}
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceOracleFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasPriceOracleFlags == nil {
+				m.GasPriceOracleFlags = &GasPriceOracleFlags{}
+			}
+			if err := m.GasPriceOracleFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChainCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChainCrosschainFlagsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChainCrosschainFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChainInfoUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChainInfoUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChainInfoUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsNewChain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsNewChain = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.IsOutboundEnabled {
-		i--
-		if m.IsOutboundEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.IsInboundEnabled {
-		i--
-		if m.IsInboundEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+func (m *EventAdminSignerSetUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAdminSignerSetUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAdminSignerSetUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *EventBallotCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ObservationHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ObservationChain)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.BallotType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
-}
-
-func (m *EventKeygenBlockUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.KeygenBlock)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.KeygenPubkeys)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
-}
-
-func (m *EventNewObserverAdded) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ZetaclientGranteeAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ZetaclientGranteePubkey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.ObserverLastBlockCount != 0 {
-		n += 1 + sovEvents(uint64(m.ObserverLastBlockCount))
-	}
-	return n
-}
 
-func (m *EventCrosschainFlagsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.IsInboundEnabled {
-		n += 2
-	}
-	if m.IsOutboundEnabled {
-		n += 2
-	}
-	if m.GasPriceIncreaseFlags != nil {
-		l = m.GasPriceIncreaseFlags.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
+func (m *EventAdminProposalSubmitted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -733,10 +3305,10 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBallotCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAdminProposalSubmitted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBallotCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAdminProposalSubmitted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -772,8 +3344,46 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyType", wireType)
+			}
+			m.PolicyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyType |= Policy_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTypeUrls", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -801,11 +3411,93 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			m.MessageTypeUrls = append(m.MessageTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAdminProposalApproved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAdminProposalApproved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAdminProposalApproved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -833,13 +3525,13 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservationHash = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -849,27 +3541,52 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.Approvals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Approvals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableHeight", wireType)
+			}
+			m.ExecutableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ObservationChain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -897,7 +3614,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotType = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -920,7 +3637,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventAdminProposalExecuted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -943,10 +3660,10 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAdminProposalExecuted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAdminProposalExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -982,8 +3699,47 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1011,11 +3767,11 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1043,7 +3799,7 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1066,7 +3822,7 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
+func (m *EventAdminProposalCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1089,10 +3845,10 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAdminProposalCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAdminProposalCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1128,10 +3884,10 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1141,59 +3897,14 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1221,27 +3932,8 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
-			}
-			m.ObserverLastBlockCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1263,7 +3955,7 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventNodeDisabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1286,10 +3978,10 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNodeDisabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNodeDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1325,10 +4017,10 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1338,17 +4030,29 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsInboundEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1358,17 +4062,29 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsOutboundEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameCount", wireType)
 			}
-			var msglen int
+			m.BlameCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1378,33 +4094,16 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlameCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GasPriceIncreaseFlags == nil {
-				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
-			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
 			}
-			var stringLen uint64
+			m.WindowBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1414,24 +4113,11 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WindowBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
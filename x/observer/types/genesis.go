@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
 // DefaultGenesis returns the default observer genesis state
@@ -39,9 +40,88 @@ func (gs GenesisState) Validate() error {
 		nodeAccountIndexMap[elem.GetOperator()] = true
 	}
 
+	// Check for duplicated chain in the chain registry
+	chainInfoIndexMap := make(map[int64]bool)
+	for _, elem := range gs.ChainInfoList {
+		if _, ok := chainInfoIndexMap[elem.Chain.ChainId]; ok {
+			return fmt.Errorf("duplicated chain id %d in chain registry", elem.Chain.ChainId)
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		chainInfoIndexMap[elem.Chain.ChainId] = true
+	}
+
+	// Check for duplicated ballot summary
+	ballotSummaryIndexMap := make(map[string]bool)
+	for _, elem := range gs.BallotSummaries {
+		if _, ok := ballotSummaryIndexMap[elem.BallotIdentifier]; ok {
+			return fmt.Errorf("duplicated ballot summary %s", elem.BallotIdentifier)
+		}
+		ballotSummaryIndexMap[elem.BallotIdentifier] = true
+	}
+
+	// Check for duplicated chain in the crosschain flags of the chains
+	chainCrosschainFlagsIndexMap := make(map[int64]bool)
+	for _, elem := range gs.ChainCrosschainFlags {
+		if _, ok := chainCrosschainFlagsIndexMap[elem.ChainId]; ok {
+			return fmt.Errorf("duplicated crosschain flags for chain %d", elem.ChainId)
+		}
+		chainCrosschainFlagsIndexMap[elem.ChainId] = true
+	}
+
+	// Check for duplicated policy type in the admin signer sets
+	adminSignerSetIndexMap := make(map[Policy_Type]bool)
+	for _, elem := range gs.AdminSignerSets {
+		if _, ok := adminSignerSetIndexMap[elem.PolicyType]; ok {
+			return fmt.Errorf("duplicated admin signer set for policy type %s", elem.PolicyType.String())
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		adminSignerSetIndexMap[elem.PolicyType] = true
+	}
+
+	// Check for duplicated id in the admin proposals
+	adminProposalIndexMap := make(map[uint64]bool)
+	for _, elem := range gs.AdminProposals {
+		if _, ok := adminProposalIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated admin proposal %d", elem.Id)
+		}
+		adminProposalIndexMap[elem.Id] = true
+	}
+
+	// Check for duplicated node in the blame scores
+	nodeBlameScoreIndexMap := make(map[string]bool)
+	for _, elem := range gs.NodeBlameScores {
+		if _, ok := nodeBlameScoreIndexMap[elem.Operator]; ok {
+			return fmt.Errorf("duplicated blame score for node %s", elem.Operator)
+		}
+		nodeBlameScoreIndexMap[elem.Operator] = true
+	}
+
+	// Check for duplicated height in the pruned ballot lists
+	prunedBallotListIndexMap := make(map[int64]bool)
+	for _, elem := range gs.PrunedBallotLists {
+		if _, ok := prunedBallotListIndexMap[elem.Height]; ok {
+			return fmt.Errorf("duplicated pruned ballot list for height %d", elem.Height)
+		}
+		prunedBallotListIndexMap[elem.Height] = true
+	}
+
 	return VerifyObserverMapper(gs.Observers)
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, proposal := range gs.AdminProposals {
+		if err := proposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func GetGenesisStateFromAppState(marshaler codec.JSONCodec, appState map[string]json.RawMessage) GenesisState {
 	var genesisState GenesisState
 	if appState[ModuleName] != nil {
@@ -11,6 +11,7 @@ import (
 
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	common "github.com/zeta-chain/node/common"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -25,14 +26,21 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Ballots           []*Ballot          `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots,omitempty"`
-	Observers         []*ObserverMapper  `protobuf:"bytes,2,rep,name=observers,proto3" json:"observers,omitempty"`
-	NodeAccountList   []*NodeAccount     `protobuf:"bytes,3,rep,name=nodeAccountList,proto3" json:"nodeAccountList,omitempty"`
-	CrosschainFlags   *CrosschainFlags   `protobuf:"bytes,4,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags,omitempty"`
-	Params            *Params            `protobuf:"bytes,5,opt,name=params,proto3" json:"params,omitempty"`
-	Keygen            *Keygen            `protobuf:"bytes,6,opt,name=keygen,proto3" json:"keygen,omitempty"`
-	LastObserverCount *LastObserverCount `protobuf:"bytes,7,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
-	CoreParamsList    CoreParamsList     `protobuf:"bytes,8,opt,name=core_params_list,json=coreParamsList,proto3" json:"core_params_list"`
+	Ballots              []*Ballot              `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots,omitempty"`
+	Observers            []*ObserverMapper      `protobuf:"bytes,2,rep,name=observers,proto3" json:"observers,omitempty"`
+	NodeAccountList      []*NodeAccount         `protobuf:"bytes,3,rep,name=nodeAccountList,proto3" json:"nodeAccountList,omitempty"`
+	CrosschainFlags      *CrosschainFlags       `protobuf:"bytes,4,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags,omitempty"`
+	Params               *Params                `protobuf:"bytes,5,opt,name=params,proto3" json:"params,omitempty"`
+	Keygen               *Keygen                `protobuf:"bytes,6,opt,name=keygen,proto3" json:"keygen,omitempty"`
+	LastObserverCount    *LastObserverCount     `protobuf:"bytes,7,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
+	CoreParamsList       CoreParamsList         `protobuf:"bytes,8,opt,name=core_params_list,json=coreParamsList,proto3" json:"core_params_list"`
+	ChainInfoList        []common.ChainInfo     `protobuf:"bytes,9,rep,name=chain_info_list,json=chainInfoList,proto3" json:"chain_info_list"`
+	BallotSummaries      []BallotSummary        `protobuf:"bytes,10,rep,name=ballot_summaries,json=ballotSummaries,proto3" json:"ballot_summaries"`
+	ChainCrosschainFlags []ChainCrosschainFlags `protobuf:"bytes,11,rep,name=chain_crosschain_flags,json=chainCrosschainFlags,proto3" json:"chain_crosschain_flags"`
+	AdminSignerSets      []AdminSignerSet       `protobuf:"bytes,12,rep,name=admin_signer_sets,json=adminSignerSets,proto3" json:"admin_signer_sets"`
+	AdminProposals       []AdminProposal        `protobuf:"bytes,13,rep,name=admin_proposals,json=adminProposals,proto3" json:"admin_proposals"`
+	NodeBlameScores      []NodeBlameScore       `protobuf:"bytes,14,rep,name=node_blame_scores,json=nodeBlameScores,proto3" json:"node_blame_scores"`
+	PrunedBallotLists    []BallotListForHeight  `protobuf:"bytes,15,rep,name=pruned_ballot_lists,json=prunedBallotLists,proto3" json:"pruned_ballot_lists"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -124,6 +132,55 @@ func (m *GenesisState) GetCoreParamsList() CoreParamsList {
 	return CoreParamsList{}
 }
 
+func (m *GenesisState) GetChainInfoList() []common.ChainInfo {
+	if m != nil {
+		return m.ChainInfoList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBallotSummaries() []BallotSummary {
+	if m != nil {
+		return m.BallotSummaries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChainCrosschainFlags() []ChainCrosschainFlags {
+	if m != nil {
+		return m.ChainCrosschainFlags
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminSignerSets() []AdminSignerSet {
+	if m != nil {
+		return m.AdminSignerSets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminProposals() []AdminProposal {
+	if m != nil {
+		return m.AdminProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNodeBlameScores() []NodeBlameScore {
+	if m != nil {
+		return m.NodeBlameScores
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrunedBallotLists() []BallotListForHeight {
+	if m != nil {
+		return m.PrunedBallotLists
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.observer.GenesisState")
 }
@@ -131,34 +188,49 @@ func init() {
 func init() { proto.RegisterFile("observer/genesis.proto", fileDescriptor_15ea8c9d44da7399) }
 
 var fileDescriptor_15ea8c9d44da7399 = []byte{
-	// 431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x5f, 0xab, 0xd3, 0x30,
-	0x18, 0xc6, 0x5b, 0x37, 0x37, 0xcd, 0xc4, 0xcd, 0xf8, 0xaf, 0x6c, 0xd0, 0x0d, 0xbd, 0x19, 0xa8,
-	0x2d, 0xcc, 0x4b, 0xf1, 0xc2, 0x0d, 0x94, 0xe1, 0xfc, 0x43, 0xbc, 0x10, 0x14, 0x2c, 0x69, 0x8d,
-	0x5d, 0xb1, 0x6b, 0x4a, 0x92, 0x89, 0xf3, 0x53, 0xf8, 0xb1, 0x76, 0xb9, 0xcb, 0x73, 0x75, 0x38,
-	0x6c, 0x5f, 0xe3, 0x5c, 0x1c, 0x92, 0x34, 0x1d, 0xdb, 0xa0, 0x9c, 0xbb, 0x97, 0x27, 0xef, 0xf3,
-	0x4b, 0xde, 0xe7, 0x0d, 0x78, 0x44, 0x43, 0x4e, 0xd8, 0x1f, 0xc2, 0xfc, 0x98, 0x64, 0x84, 0x27,
-	0xdc, 0xcb, 0x19, 0x15, 0x14, 0xf6, 0xfe, 0x11, 0x81, 0xa3, 0x39, 0x4e, 0x32, 0x4f, 0x55, 0x94,
-	0x11, 0xcf, 0xb4, 0x76, 0x1f, 0xc4, 0x34, 0xa6, 0xaa, 0xcf, 0x97, 0x95, 0xb6, 0x74, 0x1f, 0x96,
-	0xa8, 0x10, 0xa7, 0x29, 0x15, 0x85, 0xdc, 0x2f, 0xe5, 0x88, 0x51, 0xce, 0x15, 0x33, 0xf8, 0x95,
-	0xe2, 0x98, 0x9f, 0xf8, 0x7e, 0x93, 0x55, 0x4c, 0xb2, 0x42, 0xee, 0x95, 0x72, 0x46, 0x7f, 0x92,
-	0x00, 0x47, 0x11, 0x5d, 0x66, 0x06, 0xfa, 0xb8, 0x3c, 0x34, 0xc5, 0x09, 0x2c, 0xc7, 0x0c, 0x2f,
-	0x8a, 0x3b, 0x9e, 0x5c, 0xd6, 0xc1, 0x9d, 0x77, 0x7a, 0xc0, 0x2f, 0x02, 0x0b, 0x02, 0x5f, 0x83,
-	0xa6, 0x7e, 0x25, 0x77, 0xec, 0x41, 0x6d, 0xd8, 0x1a, 0x3d, 0xf5, 0x2a, 0x26, 0xf6, 0xc6, 0xaa,
-	0x17, 0x19, 0x0f, 0x9c, 0x82, 0xdb, 0xe6, 0x8c, 0x3b, 0x37, 0x14, 0xe0, 0x59, 0x25, 0xe0, 0x53,
-	0x51, 0x7c, 0xc0, 0x79, 0x4e, 0x18, 0xda, 0xbb, 0x21, 0x02, 0x6d, 0x39, 0xe0, 0x1b, 0x3d, 0xdf,
-	0x2c, 0xe1, 0xc2, 0xa9, 0x29, 0xe0, 0xb0, 0x12, 0xf8, 0x71, 0xef, 0x41, 0xc7, 0x00, 0xf8, 0x15,
-	0x74, 0x8e, 0xc3, 0x76, 0xea, 0x03, 0x7b, 0xd8, 0x1a, 0x3d, 0xaf, 0x84, 0x4e, 0x4a, 0xd3, 0x5b,
-	0xe9, 0x41, 0xed, 0xe8, 0x50, 0x80, 0xaf, 0x40, 0x43, 0xe7, 0xea, 0xdc, 0x54, 0xb8, 0xea, 0xd4,
-	0x3e, 0xab, 0x56, 0x54, 0x58, 0xa4, 0x59, 0x6f, 0xd8, 0x69, 0x5c, 0xc3, 0xfc, 0x5e, 0xb5, 0xa2,
-	0xc2, 0x02, 0x7f, 0x80, 0xfb, 0x29, 0xe6, 0x22, 0x30, 0xe7, 0x81, 0x9a, 0xd6, 0x69, 0x2a, 0x92,
-	0x57, 0x49, 0x9a, 0x61, 0x2e, 0x4c, 0xfe, 0x13, 0x15, 0xd8, 0xbd, 0xf4, 0x58, 0x82, 0xdf, 0x41,
-	0x47, 0xba, 0x02, 0xfd, 0xd6, 0x20, 0x95, 0x7b, 0xb8, 0xa5, 0xe0, 0xd5, 0x8b, 0x9d, 0x50, 0x46,
-	0xf4, 0x9c, 0x32, 0xf9, 0x71, 0x7d, 0x7d, 0xde, 0xb7, 0xd0, 0xdd, 0xe8, 0x50, 0x9d, 0xae, 0xb7,
-	0xae, 0xbd, 0xd9, 0xba, 0xf6, 0xc5, 0xd6, 0xb5, 0xff, 0xef, 0x5c, 0x6b, 0xb3, 0x73, 0xad, 0xb3,
-	0x9d, 0x6b, 0x7d, 0xf3, 0xe3, 0x44, 0xcc, 0x97, 0xa1, 0x17, 0xd1, 0x85, 0x2f, 0xe1, 0x2f, 0xd4,
-	0x3d, 0xbe, 0xb9, 0xc7, 0xff, 0x5b, 0x7e, 0x70, 0x5f, 0xac, 0x72, 0xc2, 0xc3, 0x86, 0xfa, 0xd0,
-	0x2f, 0xaf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9a, 0xb6, 0x15, 0x7a, 0xb9, 0x03, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0x69, 0x69, 0xe9, 0xf6, 0xc3, 0x8d, 0x5b, 0x8a, 0xd5, 0x8a, 0x34, 0x82, 0x4b, 0x45,
+	0xc1, 0x81, 0x72, 0x44, 0x08, 0x35, 0x11, 0x85, 0x8a, 0x02, 0x95, 0x73, 0x40, 0x50, 0x81, 0xb5,
+	0x71, 0x37, 0xae, 0x85, 0xed, 0xb5, 0x76, 0x1c, 0x44, 0xf9, 0x09, 0x9c, 0xf8, 0x59, 0x3d, 0xf6,
+	0xc8, 0x09, 0xa1, 0xf6, 0x8f, 0xa0, 0x9d, 0xdd, 0x75, 0x9a, 0x04, 0xa5, 0x39, 0x79, 0xf5, 0x66,
+	0xdf, 0x9b, 0xd9, 0x37, 0xe3, 0x21, 0x6b, 0xbc, 0x03, 0x4c, 0x7c, 0x63, 0xa2, 0x11, 0xb1, 0x8c,
+	0x41, 0x0c, 0x5e, 0x2e, 0x78, 0xc1, 0x9d, 0x8d, 0x1f, 0xac, 0xa0, 0xe1, 0x09, 0x8d, 0x33, 0x0f,
+	0x4f, 0x5c, 0x30, 0xcf, 0x5c, 0x5d, 0x5f, 0x09, 0x79, 0x9a, 0xf2, 0xac, 0xa1, 0x3e, 0x8a, 0xb1,
+	0xbe, 0x1a, 0xf1, 0x88, 0xe3, 0xb1, 0x21, 0x4f, 0x1a, 0xbd, 0x5b, 0xea, 0xd3, 0xe3, 0x34, 0xce,
+	0x82, 0x5c, 0xf0, 0x9c, 0x03, 0x4d, 0x74, 0xf8, 0x76, 0x19, 0xee, 0xd0, 0x24, 0xe1, 0x85, 0xd1,
+	0xea, 0xc3, 0x09, 0x4d, 0x99, 0x46, 0x37, 0x4b, 0x34, 0x14, 0x1c, 0x00, 0xab, 0x0b, 0xba, 0x09,
+	0x8d, 0x60, 0x44, 0xed, 0x2b, 0x3b, 0x8d, 0x98, 0xa9, 0x6c, 0xa3, 0x84, 0x33, 0x7e, 0xcc, 0x02,
+	0x1a, 0x86, 0xbc, 0x97, 0x99, 0x54, 0x77, 0xca, 0xa0, 0x39, 0x8c, 0x88, 0xe5, 0x54, 0xd0, 0x54,
+	0xe7, 0xb8, 0xf7, 0x93, 0x90, 0x85, 0x57, 0xca, 0xaa, 0x76, 0x41, 0x0b, 0xe6, 0x3c, 0x27, 0xb3,
+	0xaa, 0x76, 0x70, 0xad, 0xfa, 0xd4, 0xd6, 0xfc, 0xce, 0x7d, 0x6f, 0x8c, 0x77, 0x5e, 0x13, 0xef,
+	0xfa, 0x86, 0xe3, 0xec, 0x93, 0x39, 0x13, 0x03, 0xf7, 0x06, 0x0a, 0x6c, 0x8f, 0x15, 0x78, 0xaf,
+	0x0f, 0x6f, 0x69, 0x9e, 0x33, 0xe1, 0xf7, 0xd9, 0x8e, 0x4f, 0x6c, 0xf9, 0xc0, 0x5d, 0xf5, 0xbe,
+	0x83, 0x18, 0x0a, 0x77, 0x0a, 0x05, 0xb7, 0xc6, 0x0a, 0xbe, 0xeb, 0x73, 0xfc, 0x61, 0x01, 0xe7,
+	0x03, 0x59, 0x1e, 0x36, 0xdb, 0x9d, 0xae, 0x5b, 0x5b, 0xf3, 0x3b, 0x0f, 0xc7, 0x8a, 0xb6, 0x4a,
+	0xd2, 0x9e, 0xe4, 0xf8, 0x76, 0x38, 0x08, 0x38, 0xcf, 0xc8, 0x8c, 0xf2, 0xd5, 0xbd, 0x59, 0xb7,
+	0xae, 0x75, 0xed, 0x10, 0xaf, 0xfa, 0x9a, 0x22, 0xc9, 0xaa, 0xc3, 0xee, 0xcc, 0x04, 0xe4, 0x37,
+	0x78, 0xd5, 0xd7, 0x14, 0xe7, 0x0b, 0x59, 0x49, 0x28, 0x14, 0x81, 0x89, 0x07, 0xf8, 0x5a, 0x77,
+	0x16, 0x95, 0xbc, 0xb1, 0x4a, 0x07, 0x14, 0x0a, 0xe3, 0x7f, 0x0b, 0x0d, 0xab, 0x26, 0xc3, 0x90,
+	0x73, 0x44, 0x96, 0x25, 0x2b, 0x50, 0xb5, 0x06, 0x89, 0xec, 0xc3, 0xad, 0xba, 0x75, 0x6d, 0x63,
+	0x5b, 0x5c, 0x30, 0xf5, 0x4e, 0xe9, 0x7c, 0x73, 0xfa, 0xec, 0xcf, 0x66, 0xc5, 0x5f, 0x0a, 0x07,
+	0x50, 0xe7, 0x05, 0xb1, 0x55, 0x2b, 0xe2, 0xac, 0xcb, 0x95, 0xf6, 0x1c, 0xf6, 0xb8, 0xea, 0xe9,
+	0xbf, 0xb1, 0x25, 0xc3, 0xfb, 0x59, 0x97, 0x6b, 0x85, 0xc5, 0xd0, 0x00, 0x28, 0x70, 0x44, 0x96,
+	0xd5, 0xe8, 0x05, 0xd0, 0x4b, 0x53, 0x2a, 0x62, 0x06, 0x2e, 0x41, 0x85, 0x07, 0x13, 0xcc, 0x6d,
+	0x1b, 0x39, 0xa7, 0x5a, 0xda, 0xee, 0x5c, 0x01, 0x63, 0x06, 0x4e, 0x4a, 0xd6, 0x54, 0x75, 0x23,
+	0x33, 0x33, 0x8f, 0x29, 0x9e, 0x8c, 0x37, 0x40, 0xe2, 0x43, 0x83, 0xa3, 0x33, 0xad, 0x86, 0xff,
+	0x89, 0x39, 0x9f, 0x49, 0x55, 0x6d, 0x15, 0x88, 0xa3, 0x8c, 0x89, 0x00, 0x58, 0x01, 0xee, 0xc2,
+	0x04, 0xff, 0xd0, 0xae, 0x64, 0xb5, 0x91, 0xd4, 0x66, 0xc6, 0x6a, 0x9b, 0x0e, 0xa0, 0xe0, 0x7c,
+	0x24, 0xf6, 0xe0, 0xd2, 0x02, 0x77, 0x71, 0x02, 0xa7, 0x50, 0xfc, 0x50, 0x53, 0x4c, 0x1b, 0xe9,
+	0x55, 0x10, 0x2b, 0xc7, 0x5d, 0x84, 0xeb, 0x2d, 0x00, 0x29, 0x00, 0xee, 0xd2, 0x04, 0x95, 0xcb,
+	0x9f, 0xb5, 0x29, 0x49, 0x6d, 0x89, 0x9b, 0xca, 0xb3, 0x01, 0x14, 0x9c, 0x2e, 0x59, 0xc9, 0x45,
+	0x2f, 0x63, 0xc7, 0x81, 0xee, 0xb5, 0x1c, 0x14, 0x70, 0x6d, 0x4c, 0xf0, 0x78, 0x82, 0x3e, 0xcb,
+	0x51, 0xd9, 0xe3, 0xe2, 0x35, 0x8b, 0xa3, 0x13, 0xe3, 0x4f, 0x55, 0x49, 0xf6, 0x2f, 0x40, 0xf3,
+	0xe5, 0xd9, 0x45, 0xcd, 0x3a, 0xbf, 0xa8, 0x59, 0x7f, 0x2f, 0x6a, 0xd6, 0xaf, 0xcb, 0x5a, 0xe5,
+	0xfc, 0xb2, 0x56, 0xf9, 0x7d, 0x59, 0xab, 0x7c, 0xda, 0x8e, 0xe2, 0xe2, 0xa4, 0xd7, 0x91, 0x43,
+	0xd9, 0x90, 0x49, 0x1e, 0x61, 0x3e, 0x5c, 0xc0, 0x8d, 0xef, 0xe5, 0xaa, 0x6d, 0x14, 0xa7, 0x39,
+	0x83, 0xce, 0x0c, 0xae, 0xd6, 0xa7, 0xff, 0x06, 0x00, 0x2a, 0xe6, 0x49, 0xb1, 0x8d, 0x06, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -181,6 +253,104 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PrunedBallotLists) > 0 {
+		for iNdEx := len(m.PrunedBallotLists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrunedBallotLists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.NodeBlameScores) > 0 {
+		for iNdEx := len(m.NodeBlameScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeBlameScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AdminProposals) > 0 {
+		for iNdEx := len(m.AdminProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AdminSignerSets) > 0 {
+		for iNdEx := len(m.AdminSignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminSignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ChainCrosschainFlags) > 0 {
+		for iNdEx := len(m.ChainCrosschainFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainCrosschainFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.BallotSummaries) > 0 {
+		for iNdEx := len(m.BallotSummaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BallotSummaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ChainInfoList) > 0 {
+		for iNdEx := len(m.ChainInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size, err := m.CoreParamsList.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -337,6 +507,48 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.CoreParamsList.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ChainInfoList) > 0 {
+		for _, e := range m.ChainInfoList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BallotSummaries) > 0 {
+		for _, e := range m.BallotSummaries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainCrosschainFlags) > 0 {
+		for _, e := range m.ChainCrosschainFlags {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdminSignerSets) > 0 {
+		for _, e := range m.AdminSignerSets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdminProposals) > 0 {
+		for _, e := range m.AdminProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NodeBlameScores) > 0 {
+		for _, e := range m.NodeBlameScores {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PrunedBallotLists) > 0 {
+		for _, e := range m.PrunedBallotLists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -654,6 +866,244 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainInfoList = append(m.ChainInfoList, common.ChainInfo{})
+			if err := m.ChainInfoList[len(m.ChainInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotSummaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotSummaries = append(m.BallotSummaries, BallotSummary{})
+			if err := m.BallotSummaries[len(m.BallotSummaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainCrosschainFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainCrosschainFlags = append(m.ChainCrosschainFlags, ChainCrosschainFlags{})
+			if err := m.ChainCrosschainFlags[len(m.ChainCrosschainFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminSignerSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminSignerSets = append(m.AdminSignerSets, AdminSignerSet{})
+			if err := m.AdminSignerSets[len(m.AdminSignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminProposals = append(m.AdminProposals, AdminProposal{})
+			if err := m.AdminProposals[len(m.AdminProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeBlameScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeBlameScores = append(m.NodeBlameScores, NodeBlameScore{})
+			if err := m.NodeBlameScores[len(m.NodeBlameScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedBallotLists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedBallotLists = append(m.PrunedBallotLists, BallotListForHeight{})
+			if err := m.PrunedBallotLists[len(m.PrunedBallotLists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
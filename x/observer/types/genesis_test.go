@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/observer/types"
 )
 
@@ -23,6 +24,65 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "duplicated chain crosschain flags",
+			genState: &types.GenesisState{
+				ChainCrosschainFlags: []types.ChainCrosschainFlags{
+					{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: true},
+					{ChainId: 1, IsInboundEnabled: true, IsOutboundEnabled: false},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated admin signer set",
+			genState: &types.GenesisState{
+				AdminSignerSets: []types.AdminSignerSet{
+					sample.AdminSignerSet(types.Policy_Type_group1),
+					sample.AdminSignerSet(types.Policy_Type_group1),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid admin signer set",
+			genState: &types.GenesisState{
+				AdminSignerSets: []types.AdminSignerSet{
+					{PolicyType: types.Policy_Type_group1, Signers: []string{sample.AccAddress()}, Threshold: 2},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated admin proposal",
+			genState: &types.GenesisState{
+				AdminProposals: []types.AdminProposal{
+					sample.AdminProposal(t, 1, types.Policy_Type_group1),
+					sample.AdminProposal(t, 1, types.Policy_Type_group2),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated node blame score",
+			genState: &types.GenesisState{
+				NodeBlameScores: []types.NodeBlameScore{
+					{Operator: "operator", BlameHeights: []int64{1}},
+					{Operator: "operator", BlameHeights: []int64{2}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pruned ballot list",
+			genState: &types.GenesisState{
+				PrunedBallotLists: []types.BallotListForHeight{
+					{Height: 10, BallotsIndexList: []string{"0"}},
+					{Height: 10, BallotsIndexList: []string{"1"}},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
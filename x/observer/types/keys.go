@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -38,19 +42,42 @@ const (
 	ObserverParamsKey             = "ObserverParams"
 	AdminPolicyParamsKey          = "AdminParams"
 	BallotMaturityBlocksParamsKey = "BallotMaturityBlocksParams"
+	BallotArchivalParamsKey       = "BallotArchivalParams"
+	BlameParamsKey                = "BlameParams"
 
 	// CrosschainFlagsKey is the key for the crosschain flags
 	// NOTE: PermissionFlags is old name for CrosschainFlags we keep it as key value for backward compatibility
 	CrosschainFlagsKey = "PermissionFlags-value-"
 
+	// ChainCrosschainFlagsKey is the key prefix for the crosschain flags of a single chain
+	ChainCrosschainFlagsKey = "ChainCrosschainFlags-value-"
+
 	LastBlockObserverCountKey = "ObserverCount-value-"
 	NodeAccountKey            = "NodeAccount-value-"
 	KeygenKey                 = "Keygen-value-"
 	BlockHeaderKey            = "BlockHeader-value-"
+	BlockHeaderHeightKey      = "BlockHeaderHeight-value-"
+	BlockHeaderWorkKey        = "BlockHeaderWork-value-"
+	ChainStateKey             = "ChainState-value-"
+	ChainInfoKey              = "ChainInfo-value-"
+
+	BallotListKey       = "BallotList-value-"
+	BallotSummaryKey    = "BallotSummary-value-"
+	PrunedBallotKey     = "PrunedBallot-value-"
+	PrunedBallotListKey = "PrunedBallotList-value-"
 
-	BallotListKey = "BallotList-value-"
+	AdminSignerSetKey     = "AdminSignerSet-value-"
+	AdminProposalKey      = "AdminProposal-value-"
+	AdminProposalCountKey = "AdminProposal-count-"
+
+	NodeBlameScoreKey = "NodeBlameScore-value-"
 )
 
+// BlockHeaderHeightKeyPrefix returns the key of the canonical block header at a given height of a chain
+func BlockHeaderHeightKeyPrefix(chainID int64, height int64) []byte {
+	return []byte(fmt.Sprintf("%d-%d", chainID, height))
+}
+
 func GetBlameIndex(chainID int64, nonce uint64, digest string, height uint64) string {
 	return fmt.Sprintf("%d-%d-%s-%d", chainID, nonce, digest, height)
 }
@@ -58,3 +85,20 @@ func GetBlameIndex(chainID int64, nonce uint64, digest string, height uint64) st
 func GetBlamePrefix(chainID int64, nonce int64) string {
 	return fmt.Sprintf("%d-%d", chainID, nonce)
 }
+
+func GetChainInfoKey(chainID int64) []byte {
+	return []byte(fmt.Sprintf("%d", chainID))
+}
+
+func GetChainCrosschainFlagsKey(chainID int64) []byte {
+	return []byte(fmt.Sprintf("%d", chainID))
+}
+
+func GetAdminSignerSetKey(policyType Policy_Type) []byte {
+	return []byte(policyType.String())
+}
+
+// GetAdminProposalKey returns the big endian key of a proposal id so the proposals are iterated in order
+func GetAdminProposalKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
@@ -4,7 +4,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/zeta-chain/node/common"
 )
 
 const TypeMsgAddBlameVote = "add_blame_vote"
@@ -32,7 +31,8 @@ func (m *MsgAddBlameVote) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	if common.GetChainFromChainID(m.ChainId) == nil {
+	// the support of the chain is checked when the message is processed
+	if m.ChainId <= 0 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidChainID, "chain id (%d)", m.ChainId)
 	}
 	return nil
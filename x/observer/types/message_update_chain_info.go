@@ -0,0 +1,53 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/node/common"
+)
+
+const TypeMsgUpdateChainInfo = "update_chain_info"
+
+var _ sdk.Msg = &MsgUpdateChainInfo{}
+
+func NewMsgUpdateChainInfo(creator string, chainInfo common.ChainInfo) *MsgUpdateChainInfo {
+	return &MsgUpdateChainInfo{
+		Creator:   creator,
+		ChainInfo: chainInfo,
+	}
+}
+
+func (msg *MsgUpdateChainInfo) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateChainInfo) Type() string {
+	return TypeMsgUpdateChainInfo
+}
+
+func (msg *MsgUpdateChainInfo) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateChainInfo) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateChainInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.ChainInfo.Validate(); err != nil {
+		return cosmoserrors.Wrap(ErrInvalidChainInfo, err.Error())
+	}
+	if msg.ChainInfo.VmFamily == common.VmFamily_zeta_core {
+		return cosmoserrors.Wrap(ErrInvalidChainInfo, "zeta chain cannot be updated")
+	}
+	return nil
+}
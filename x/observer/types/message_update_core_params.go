@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/chaincfg"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -48,20 +49,27 @@ func (msg *MsgUpdateCoreParams) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return ValidateCoreParams(msg.CoreParams)
+	if msg.CoreParams == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "core params cannot be nil")
+	}
+	// the core params are validated against the chain registry when the message is processed
+	if msg.CoreParams.ChainId <= 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid chain id (%d)", msg.CoreParams.ChainId)
+	}
+	return nil
 }
 
-// ValidateCoreParams performs some basic checks on core params
-func ValidateCoreParams(params *CoreParams) error {
+// ValidateCoreParams performs some basic checks on core params, chainInfo is the registry entry of the chain of the
+// core params
+func ValidateCoreParams(params *CoreParams, chainInfo common.ChainInfo) error {
 	if params == nil {
 		return fmt.Errorf("core params cannot be nil")
 	}
-	chain := common.GetChainFromChainID(params.ChainId)
-	if chain == nil {
-		return fmt.Errorf("ChainId %d not supported", params.ChainId)
+	if params.ChainId != chainInfo.Chain.ChainId {
+		return fmt.Errorf("ChainId %d doesn't match the chain info of chain %d", params.ChainId, chainInfo.Chain.ChainId)
 	}
 	// zeta chain skips the rest of the checks for now
-	if chain.IsZetaChain() {
+	if chainInfo.VmFamily == common.VmFamily_zeta_core {
 		return nil
 	}
 
@@ -83,14 +91,42 @@ func ValidateCoreParams(params *CoreParams) error {
 	if params.OutboundTxScheduleLookahead == 0 || params.OutboundTxScheduleLookahead > 500 { // 500 cctxs
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "OutboundTxScheduleLookahead %d out of range", params.OutboundTxScheduleLookahead)
 	}
+	if params.BlockHeaderBootstrapHeight < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "BlockHeaderBootstrapHeight %d out of range", params.BlockHeaderBootstrapHeight)
+	}
 
 	// chain type specific checks
-	if common.IsBitcoinChain(params.ChainId) {
+	if chainInfo.IsBitcoinChain() {
 		if params.WatchUtxoTicker == 0 || params.WatchUtxoTicker > 300 {
 			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "WatchUtxoTicker %d out of range", params.WatchUtxoTicker)
 		}
+		if params.OutboundTxBatchSize > 50 { // 50 outputs per tx
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "OutboundTxBatchSize %d out of range", params.OutboundTxBatchSize)
+		}
+		// the difficulty of a retarget block is checked from the start of its window, the header chain must start at a retarget
+		chainParams, err := chainInfo.BTCChainParams()
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot get chain params (%s)", err)
+		}
+		if chainParams.Net != chaincfg.RegressionNetParams.Net && params.BlockHeaderBootstrapHeight%common.BitcoinRetargetInterval(chainParams) != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "BlockHeaderBootstrapHeight %d is not a difficulty retarget height", params.BlockHeaderBootstrapHeight)
+		}
+		if params.UtxoConsolidationThreshold != 0 {
+			if params.UtxoConsolidationThreshold < 2 || params.UtxoConsolidationThreshold > 10000 { // 10000 utxos
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "UtxoConsolidationThreshold %d out of range", params.UtxoConsolidationThreshold)
+			}
+			if params.UtxoConsolidationMaxFeeRate == 0 {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "UtxoConsolidationMaxFeeRate must be greater than 0")
+			}
+		}
 	}
-	if common.IsEVMChain(params.ChainId) {
+	if chainInfo.IsEVMChain() {
+		if params.OutboundTxBatchSize != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "OutboundTxBatchSize is only supported for bitcoin")
+		}
+		if params.UtxoConsolidationThreshold != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "UtxoConsolidationThreshold is only supported for bitcoin")
+		}
 		if !validCoreContractAddress(params.ZetaTokenContractAddress) {
 			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ZetaTokenContractAddress %s", params.ZetaTokenContractAddress)
 		}
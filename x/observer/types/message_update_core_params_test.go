@@ -3,8 +3,10 @@ package types
 import (
 	"testing"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/zeta-chain/node/common"
 	. "gopkg.in/check.v1"
 )
 
@@ -21,6 +23,16 @@ func TestUpdateCoreParamsSuiteSuite(t *testing.T) {
 }
 
 func (s *UpdateCoreParamsSuite) SetupTest() {
+	// the params are built for chains of the network the tests are built for
+	var evmChainID int64
+	for _, info := range common.DefaultChainInfoList() {
+		if info.IsEVMChain() {
+			evmChainID = info.Chain.ChainId
+			break
+		}
+	}
+	require.NotZero(s.T(), evmChainID)
+
 	s.evmParams = &CoreParams{
 		ConfirmationCount:           1,
 		GasPriceTicker:              1,
@@ -30,7 +42,7 @@ func (s *UpdateCoreParamsSuite) SetupTest() {
 		ZetaTokenContractAddress:    "0xA8D5060feb6B456e886F023709A2795373691E63",
 		ConnectorContractAddress:    "0x733aB8b06DDDEf27Eaa72294B0d7c9cEF7f12db9",
 		Erc20CustodyContractAddress: "0xD28D6A0b8189305551a0A8bd247a6ECa9CE781Ca",
-		ChainId:                     5,
+		ChainId:                     evmChainID,
 		OutboundTxScheduleInterval:  1,
 		OutboundTxScheduleLookahead: 1,
 	}
@@ -43,19 +55,41 @@ func (s *UpdateCoreParamsSuite) SetupTest() {
 		ZetaTokenContractAddress:    "",
 		ConnectorContractAddress:    "",
 		Erc20CustodyContractAddress: "",
-		ChainId:                     18332,
+		ChainId:                     common.BtcChainID(),
 		OutboundTxScheduleInterval:  1,
 		OutboundTxScheduleLookahead: 1,
 	}
 }
 
 func (s *UpdateCoreParamsSuite) TestValidParams() {
-	err := ValidateCoreParams(s.evmParams)
+	err := s.validateCoreParams(s.evmParams)
 	require.Nil(s.T(), err)
-	err = ValidateCoreParams(s.btcParams)
+	err = s.validateCoreParams(s.btcParams)
 	require.Nil(s.T(), err)
 }
 
+func (s *UpdateCoreParamsSuite) TestChainInfo() {
+	// the params of a chain added to the chain registry are validated from its registry entry
+	copy := *s.evmParams
+	copy.ChainId = 8453
+	info := common.ChainInfo{
+		Chain:                    common.Chain{ChainName: common.ChainName_empty, ChainId: 8453},
+		VmFamily:                 common.VmFamily_evm,
+		NetworkType:              common.NetworkType_mainnet,
+		AddressCodec:             common.AddressCodec_hex,
+		DefaultConfirmationCount: 12,
+	}
+	err := ValidateCoreParams(&copy, info)
+	require.Nil(s.T(), err)
+	copy.Erc20CustodyContractAddress = ""
+	err = ValidateCoreParams(&copy, info)
+	require.NotNil(s.T(), err)
+
+	// the params must be validated against the entry of their chain
+	err = ValidateCoreParams(s.evmParams, info)
+	require.NotNil(s.T(), err)
+}
+
 func (s *UpdateCoreParamsSuite) TestCommonParams() {
 	s.Validate(s.evmParams)
 	s.Validate(s.btcParams)
@@ -64,100 +98,161 @@ func (s *UpdateCoreParamsSuite) TestCommonParams() {
 func (s *UpdateCoreParamsSuite) TestBTCParams() {
 	copy := *s.btcParams
 	copy.WatchUtxoTicker = 0
-	err := ValidateCoreParams(&copy)
+	err := s.validateCoreParams(&copy)
+	require.NotNil(s.T(), err)
+
+	copy = *s.btcParams
+	copy.OutboundTxBatchSize = 50
+	err = s.validateCoreParams(&copy)
+	require.Nil(s.T(), err)
+	copy.OutboundTxBatchSize = 51
+	err = s.validateCoreParams(&copy)
+	require.NotNil(s.T(), err)
+
+	copy = *s.btcParams
+	copy.UtxoConsolidationThreshold = 100
+	err = s.validateCoreParams(&copy)
+	require.NotNil(s.T(), err)
+	copy.UtxoConsolidationMaxFeeRate = 10
+	err = s.validateCoreParams(&copy)
+	require.Nil(s.T(), err)
+	copy.UtxoConsolidationThreshold = 1
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
+
+	// the block header chain starts at a difficulty retarget unless the network never retargets
+	chainParams, err := s.chainInfo(s.btcParams.ChainId).BTCChainParams()
+	require.NoError(s.T(), err)
+	copy = *s.btcParams
+	copy.BlockHeaderBootstrapHeight = common.BitcoinRetargetInterval(chainParams) * 100
+	err = s.validateCoreParams(&copy)
+	require.Nil(s.T(), err)
+	copy.BlockHeaderBootstrapHeight++
+	err = s.validateCoreParams(&copy)
+	require.Equal(s.T(), chainParams.Net == chaincfg.RegressionNetParams.Net, err == nil)
 }
 
 func (s *UpdateCoreParamsSuite) TestCoreContractAddresses() {
 	copy := *s.evmParams
+	copy.OutboundTxBatchSize = 2
+	err := s.validateCoreParams(&copy)
+	require.NotNil(s.T(), err)
+
+	copy = *s.evmParams
+	copy.UtxoConsolidationThreshold = 100
+	err = s.validateCoreParams(&copy)
+	require.NotNil(s.T(), err)
+
+	copy = *s.evmParams
 	copy.ZetaTokenContractAddress = "0x123"
-	err := ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 
 	copy = *s.evmParams
 	copy.ZetaTokenContractAddress = "733aB8b06DDDEf27Eaa72294B0d7c9cEF7f12db9"
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 
 	copy = *s.evmParams
 	copy.ConnectorContractAddress = "0x123"
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 
 	copy = *s.evmParams
 	copy.ConnectorContractAddress = "733aB8b06DDDEf27Eaa72294B0d7c9cEF7f12db9"
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 
 	copy = *s.evmParams
 	copy.Erc20CustodyContractAddress = "0x123"
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 
 	copy = *s.evmParams
 	copy.Erc20CustodyContractAddress = "733aB8b06DDDEf27Eaa72294B0d7c9cEF7f12db9"
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 }
 
 func (s *UpdateCoreParamsSuite) Validate(params *CoreParams) {
 	copy := *params
 	copy.ConfirmationCount = 0
-	err := ValidateCoreParams(&copy)
+	err := s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 
 	copy = *params
 	copy.GasPriceTicker = 0
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 	copy.GasPriceTicker = 300
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.Nil(s.T(), err)
 	copy.GasPriceTicker = 301
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 
 	copy = *params
 	copy.InTxTicker = 0
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 	copy.InTxTicker = 300
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.Nil(s.T(), err)
 	copy.InTxTicker = 301
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 
 	copy = *params
 	copy.OutTxTicker = 0
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 	copy.OutTxTicker = 300
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.Nil(s.T(), err)
 	copy.OutTxTicker = 301
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 
 	copy = *params
 	copy.OutboundTxScheduleInterval = 0
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 	copy.OutboundTxScheduleInterval = 100
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.Nil(s.T(), err)
 	copy.OutboundTxScheduleInterval = 101
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 
 	copy = *params
 	copy.OutboundTxScheduleLookahead = 0
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
 	copy.OutboundTxScheduleLookahead = 500
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.Nil(s.T(), err)
 	copy.OutboundTxScheduleLookahead = 501
-	err = ValidateCoreParams(&copy)
+	err = s.validateCoreParams(&copy)
 	require.NotNil(s.T(), err)
+
+	copy = *params
+	copy.BlockHeaderBootstrapHeight = -1
+	err = s.validateCoreParams(&copy)
+	require.NotNil(s.T(), err)
+}
+
+// chainInfo returns the default registry entry of a chain
+func (s *UpdateCoreParamsSuite) chainInfo(chainID int64) common.ChainInfo {
+	for _, info := range common.DefaultChainInfoList() {
+		if info.Chain.ChainId == chainID {
+			return info
+		}
+	}
+	s.T().Fatalf("chain %d is not a default chain", chainID)
+	return common.ChainInfo{}
+}
+
+// validateCoreParams validates core params against the default registry entry of their chain
+func (s *UpdateCoreParamsSuite) validateCoreParams(params *CoreParams) error {
+	return ValidateCoreParams(params, s.chainInfo(params.ChainId))
 }
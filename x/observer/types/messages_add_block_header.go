@@ -52,16 +52,12 @@ func (msg *MsgAddBlockHeader) ValidateBasic() error {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, err.Error())
 	}
 
-	if common.IsEthereumChain(msg.ChainId) || common.IsBitcoinChain(msg.ChainId) {
-		if len(msg.BlockHash) != 32 {
-			return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid block hash length (%d)", len(msg.BlockHash))
-		}
-	} else {
+	// the header is validated against the chain registry when the message is processed
+	if msg.ChainId <= 0 {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid chain id (%d)", msg.ChainId)
 	}
-
-	if err := msg.Header.Validate(msg.BlockHash, msg.ChainId, msg.Height); err != nil {
-		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid block header (%s)", err)
+	if len(msg.BlockHash) != 32 {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid block hash length (%d)", len(msg.BlockHash))
 	}
 
 	if _, err := msg.Header.ParentHash(); err != nil {
@@ -0,0 +1,186 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	TypeMsgSubmitAdminProposal  = "submit_admin_proposal"
+	TypeMsgApproveAdminProposal = "approve_admin_proposal"
+	TypeMsgExecuteAdminProposal = "execute_admin_proposal"
+	TypeMsgCancelAdminProposal  = "cancel_admin_proposal"
+)
+
+var (
+	_ sdk.Msg                            = &MsgSubmitAdminProposal{}
+	_ codectypes.UnpackInterfacesMessage = &MsgSubmitAdminProposal{}
+	_ sdk.Msg                            = &MsgApproveAdminProposal{}
+	_ sdk.Msg                            = &MsgExecuteAdminProposal{}
+	_ sdk.Msg                            = &MsgCancelAdminProposal{}
+)
+
+func NewMsgSubmitAdminProposal(creator string, policyType Policy_Type, msgs []sdk.Msg) (*MsgSubmitAdminProposal, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSubmitAdminProposal{
+		Creator:    creator,
+		PolicyType: policyType,
+		Messages:   anys,
+	}, nil
+}
+
+func (msg *MsgSubmitAdminProposal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitAdminProposal) Type() string {
+	return TypeMsgSubmitAdminProposal
+}
+
+func (msg *MsgSubmitAdminProposal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubmitAdminProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitAdminProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidatePolicyType(msg.PolicyType); err != nil {
+		return cosmoserrors.Wrap(ErrInvalidAdminProposal, err.Error())
+	}
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return cosmoserrors.Wrap(ErrInvalidAdminProposal, err.Error())
+	}
+	return ValidateAdminProposalMsgs(msg.PolicyType, msgs)
+}
+
+// GetMsgs returns the messages of the proposal
+func (msg *MsgSubmitAdminProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Messages, "admin proposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg *MsgSubmitAdminProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Messages)
+}
+
+func NewMsgApproveAdminProposal(creator string, proposalID uint64) *MsgApproveAdminProposal {
+	return &MsgApproveAdminProposal{
+		Creator:    creator,
+		ProposalId: proposalID,
+	}
+}
+
+func (msg *MsgApproveAdminProposal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApproveAdminProposal) Type() string {
+	return TypeMsgApproveAdminProposal
+}
+
+func (msg *MsgApproveAdminProposal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgApproveAdminProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgApproveAdminProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+func NewMsgExecuteAdminProposal(creator string, proposalID uint64) *MsgExecuteAdminProposal {
+	return &MsgExecuteAdminProposal{
+		Creator:    creator,
+		ProposalId: proposalID,
+	}
+}
+
+func (msg *MsgExecuteAdminProposal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgExecuteAdminProposal) Type() string {
+	return TypeMsgExecuteAdminProposal
+}
+
+func (msg *MsgExecuteAdminProposal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgExecuteAdminProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgExecuteAdminProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+func NewMsgCancelAdminProposal(creator string, proposalID uint64) *MsgCancelAdminProposal {
+	return &MsgCancelAdminProposal{
+		Creator:    creator,
+		ProposalId: proposalID,
+	}
+}
+
+func (msg *MsgCancelAdminProposal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelAdminProposal) Type() string {
+	return TypeMsgCancelAdminProposal
+}
+
+func (msg *MsgCancelAdminProposal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelAdminProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelAdminProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
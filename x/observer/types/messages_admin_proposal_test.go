@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgUpdateAdminSignerSet_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateAdminSignerSet
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateAdminSignerSet("invalid_address", sample.AdminSignerSet(types.Policy_Type_group1)),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid signer set",
+			msg: types.NewMsgUpdateAdminSignerSet(sample.AccAddress(), types.AdminSignerSet{
+				PolicyType: types.Policy_Type_group1,
+				Signers:    []string{sample.AccAddress()},
+				Threshold:  2,
+			}),
+			err: types.ErrInvalidAdminSignerSet,
+		},
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdateAdminSignerSet(sample.AccAddress(), sample.AdminSignerSet(types.Policy_Type_group1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSubmitAdminProposal_ValidateBasic(t *testing.T) {
+	account := types.AdminProposalAccount(types.Policy_Type_group2).String()
+	newMsg := func(creator string, policyType types.Policy_Type, msgs ...sdk.Msg) *types.MsgSubmitAdminProposal {
+		msg, err := types.NewMsgSubmitAdminProposal(creator, policyType, msgs)
+		require.NoError(t, err)
+		return msg
+	}
+
+	tests := []struct {
+		name string
+		msg  *types.MsgSubmitAdminProposal
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  newMsg("invalid_address", types.Policy_Type_group2, types.NewMsgUpdateCrosschainFlags(account, true, true)),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "unknown policy type",
+			msg:  newMsg(sample.AccAddress(), types.Policy_Type(42), types.NewMsgUpdateCrosschainFlags(account, true, true)),
+			err:  types.ErrInvalidAdminProposal,
+		},
+		{
+			name: "no message",
+			msg:  newMsg(sample.AccAddress(), types.Policy_Type_group2),
+			err:  types.ErrInvalidAdminProposal,
+		},
+		{
+			name: "message not signed by the admin proposal account",
+			msg:  newMsg(sample.AccAddress(), types.Policy_Type_group2, types.NewMsgUpdateCrosschainFlags(sample.AccAddress(), true, true)),
+			err:  types.ErrInvalidAdminProposal,
+		},
+		{
+			name: "valid message",
+			msg:  newMsg(sample.AccAddress(), types.Policy_Type_group2, types.NewMsgUpdateCrosschainFlags(account, true, true)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
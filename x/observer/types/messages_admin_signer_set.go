@@ -0,0 +1,51 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgUpdateAdminSignerSet = "update_admin_signer_set"
+)
+
+var _ sdk.Msg = &MsgUpdateAdminSignerSet{}
+
+func NewMsgUpdateAdminSignerSet(creator string, signerSet AdminSignerSet) *MsgUpdateAdminSignerSet {
+	return &MsgUpdateAdminSignerSet{
+		Creator:   creator,
+		SignerSet: signerSet,
+	}
+}
+
+func (msg *MsgUpdateAdminSignerSet) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateAdminSignerSet) Type() string {
+	return TypeMsgUpdateAdminSignerSet
+}
+
+func (msg *MsgUpdateAdminSignerSet) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateAdminSignerSet) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateAdminSignerSet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.SignerSet.Validate(); err != nil {
+		return cosmoserrors.Wrap(ErrInvalidAdminSignerSet, err.Error())
+	}
+	return nil
+}
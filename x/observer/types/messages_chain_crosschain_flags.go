@@ -0,0 +1,54 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/node/common"
+)
+
+const (
+	TypeMsgUpdateChainCrosschainFlags = "update_chain_crosschain_flags"
+)
+
+var _ sdk.Msg = &MsgUpdateChainCrosschainFlags{}
+
+func NewMsgUpdateChainCrosschainFlags(creator string, chainID int64, isInboundEnabled, isOutboundEnabled bool) *MsgUpdateChainCrosschainFlags {
+	return &MsgUpdateChainCrosschainFlags{
+		Creator:           creator,
+		ChainId:           chainID,
+		IsInboundEnabled:  isInboundEnabled,
+		IsOutboundEnabled: isOutboundEnabled,
+	}
+}
+
+func (msg *MsgUpdateChainCrosschainFlags) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateChainCrosschainFlags) Type() string {
+	return TypeMsgUpdateChainCrosschainFlags
+}
+
+func (msg *MsgUpdateChainCrosschainFlags) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateChainCrosschainFlags) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateChainCrosschainFlags) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ChainId == common.ZetaChain().ChainId {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidChainID, "zeta chain cannot be paused")
+	}
+	return nil
+}
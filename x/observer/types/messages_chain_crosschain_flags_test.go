@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgUpdateChainCrosschainFlags_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateChainCrosschainFlags
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateChainCrosschainFlags("invalid_address", common.BtcChainID(), false, false),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zeta chain",
+			msg:  types.NewMsgUpdateChainCrosschainFlags(sample.AccAddress(), common.ZetaChain().ChainId, false, false),
+			err:  sdkerrors.ErrInvalidChainID,
+		},
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdateChainCrosschainFlags(sample.AccAddress(), common.BtcChainID(), false, true),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -55,6 +55,12 @@ func (msg *MsgUpdateCrosschainFlags) ValidateBasic() error {
 		}
 	}
 
+	if msg.GasPriceOracleFlags != nil {
+		if err := msg.GasPriceOracleFlags.Validate(); err != nil {
+			return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -67,3 +73,13 @@ func (gpf GasPriceIncreaseFlags) Validate() error {
 	}
 	return nil
 }
+
+func (gof GasPriceOracleFlags) Validate() error {
+	if gof.MaxVoteAge < 0 {
+		return errors.New("max vote age must not be negative")
+	}
+	if gof.EpochLength <= 0 {
+		return errors.New("epoch length must be positive")
+	}
+	return nil
+}
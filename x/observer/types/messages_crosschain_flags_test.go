@@ -45,6 +45,17 @@ func TestMsgUpdateCrosschainFlags_ValidateBasic(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "invalid gas price oracle flags",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator: sample.AccAddress(),
+				GasPriceOracleFlags: &types.GasPriceOracleFlags{
+					MaxVoteAge:  -1,
+					EpochLength: 1,
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 		{
 			name: "gas price increase flags can be nil",
 			msg: types.MsgUpdateCrosschainFlags{
@@ -116,3 +127,52 @@ func TestGasPriceIncreaseFlags_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGasPriceOracleFlags_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		gof         types.GasPriceOracleFlags
+		errContains string
+	}{
+		{
+			name: "invalid max vote age",
+			gof: types.GasPriceOracleFlags{
+				MaxVoteAge:  -1,
+				EpochLength: 1,
+			},
+			errContains: "max vote age must not be negative",
+		},
+		{
+			name: "invalid epoch length",
+			gof: types.GasPriceOracleFlags{
+				MaxVoteAge:  1,
+				EpochLength: 0,
+			},
+			errContains: "epoch length must be positive",
+		},
+		{
+			name: "valid",
+			gof: types.GasPriceOracleFlags{
+				MaxVoteAge:       1,
+				EpochLength:      1,
+				MaxChangePercent: 1,
+			},
+		},
+		{
+			name: "max vote age and percent can be 0",
+			gof: types.GasPriceOracleFlags{
+				EpochLength: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.gof.Validate()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -24,14 +24,29 @@ func DefaultParams() Params {
 	chains := common.DefaultChainsList()
 	observerParams := make([]*ObserverParams, len(chains))
 	for i, chain := range chains {
-		observerParams[i] = &ObserverParams{
-			IsSupported:           true,
-			Chain:                 chain,
-			BallotThreshold:       sdk.MustNewDecFromStr("0.66"),
-			MinObserverDelegation: sdk.MustNewDecFromStr("1000000000000000000000"), // 1000 ZETA
-		}
+		observerParams[i] = DefaultObserverParams(chain)
+	}
+	params := NewParams(observerParams, DefaultAdminPolicy(), 100)
+	params.BlameParams = DefaultBlameParams()
+	return params
+}
+
+// DefaultBlameParams returns the default blame params, a node is disabled after 100 blames in about a day
+func DefaultBlameParams() BlameParams {
+	return BlameParams{
+		WindowBlocks: 14_400,
+		MaxBlames:    100,
+	}
+}
+
+// DefaultObserverParams returns the default observer params of a supported chain
+func DefaultObserverParams(chain *common.Chain) *ObserverParams {
+	return &ObserverParams{
+		IsSupported:           true,
+		Chain:                 chain,
+		BallotThreshold:       sdk.MustNewDecFromStr("0.66"),
+		MinObserverDelegation: sdk.MustNewDecFromStr("1000000000000000000000"), // 1000 ZETA
 	}
-	return NewParams(observerParams, DefaultAdminPolicy(), 100)
 }
 
 func DefaultAdminPolicy() []*Admin_Policy {
@@ -53,6 +68,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPrefix(ObserverParamsKey), &p.ObserverParams, validateVotingThresholds),
 		paramtypes.NewParamSetPair(KeyPrefix(AdminPolicyParamsKey), &p.AdminPolicy, validateAdminPolicy),
 		paramtypes.NewParamSetPair(KeyPrefix(BallotMaturityBlocksParamsKey), &p.BallotMaturityBlocks, validateBallotMaturityBlocks),
+		paramtypes.NewParamSetPair(KeyPrefix(BallotArchivalParamsKey), &p.BallotArchivalEnabled, validateBallotArchivalEnabled),
+		paramtypes.NewParamSetPair(KeyPrefix(BlameParamsKey), &p.BlameParams, validateBlameParams),
 	}
 }
 
@@ -99,6 +116,27 @@ func validateBallotMaturityBlocks(i interface{}) error {
 	return nil
 }
 
+func validateBallotArchivalEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBlameParams(i interface{}) error {
+	v, ok := i.(BlameParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.WindowBlocks < 0 {
+		return fmt.Errorf("blame window can't be negative: %d", v.WindowBlocks)
+	}
+
+	return nil
+}
+
 func (p Params) GetAdminPolicyAccount(policyType Policy_Type) string {
 	for _, admin := range p.AdminPolicy {
 		if admin.PolicyType == policyType {
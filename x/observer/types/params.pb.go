@@ -107,6 +107,14 @@ type CoreParams struct {
 	ChainId                     int64  `protobuf:"varint,11,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	OutboundTxScheduleInterval  int64  `protobuf:"varint,12,opt,name=outbound_tx_schedule_interval,json=outboundTxScheduleInterval,proto3" json:"outbound_tx_schedule_interval,omitempty"`
 	OutboundTxScheduleLookahead int64  `protobuf:"varint,13,opt,name=outbound_tx_schedule_lookahead,json=outboundTxScheduleLookahead,proto3" json:"outbound_tx_schedule_lookahead,omitempty"`
+	// maximum number of outbounds paid by a single bitcoin transaction, batching is disabled if less than 2
+	OutboundTxBatchSize uint64 `protobuf:"varint,14,opt,name=outbound_tx_batch_size,json=outboundTxBatchSize,proto3" json:"outbound_tx_batch_size,omitempty"`
+	// number of bitcoin TSS UTXOs above which the small UTXOs are consolidated, consolidation is disabled if 0
+	UtxoConsolidationThreshold uint64 `protobuf:"varint,15,opt,name=utxo_consolidation_threshold,json=utxoConsolidationThreshold,proto3" json:"utxo_consolidation_threshold,omitempty"`
+	// maximum fee rate in satoshis per byte at which the bitcoin TSS UTXOs are consolidated
+	UtxoConsolidationMaxFeeRate uint64 `protobuf:"varint,16,opt,name=utxo_consolidation_max_fee_rate,json=utxoConsolidationMaxFeeRate,proto3" json:"utxo_consolidation_max_fee_rate,omitempty"`
+	// height of the first block header accepted for the chain, the header chain is bootstrapped from this header
+	BlockHeaderBootstrapHeight int64 `protobuf:"varint,17,opt,name=block_header_bootstrap_height,json=blockHeaderBootstrapHeight,proto3" json:"block_header_bootstrap_height,omitempty"`
 }
 
 func (m *CoreParams) Reset()         { *m = CoreParams{} }
@@ -219,6 +227,34 @@ func (m *CoreParams) GetOutboundTxScheduleLookahead() int64 {
 	return 0
 }
 
+func (m *CoreParams) GetOutboundTxBatchSize() uint64 {
+	if m != nil {
+		return m.OutboundTxBatchSize
+	}
+	return 0
+}
+
+func (m *CoreParams) GetUtxoConsolidationThreshold() uint64 {
+	if m != nil {
+		return m.UtxoConsolidationThreshold
+	}
+	return 0
+}
+
+func (m *CoreParams) GetUtxoConsolidationMaxFeeRate() uint64 {
+	if m != nil {
+		return m.UtxoConsolidationMaxFeeRate
+	}
+	return 0
+}
+
+func (m *CoreParams) GetBlockHeaderBootstrapHeight() int64 {
+	if m != nil {
+		return m.BlockHeaderBootstrapHeight
+	}
+	return 0
+}
+
 type ObserverParams struct {
 	Chain                 *common.Chain                          `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
 	BallotThreshold       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=ballot_threshold,json=ballotThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ballot_threshold"`
@@ -325,17 +361,76 @@ func (m *Admin_Policy) GetAddress() string {
 	return ""
 }
 
+// BlameParams configures how the keysign failures blamed on a node are aggregated
+type BlameParams struct {
+	// number of blocks of the sliding window in which the blames of a node are counted
+	WindowBlocks int64 `protobuf:"varint,1,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// number of blames in the window at which the node is disabled and excluded from the next keygen
+	// zero doesn't disable the nodes
+	MaxBlames uint64 `protobuf:"varint,2,opt,name=max_blames,json=maxBlames,proto3" json:"max_blames,omitempty"`
+}
+
+func (m *BlameParams) Reset()         { *m = BlameParams{} }
+func (m *BlameParams) String() string { return proto.CompactTextString(m) }
+func (*BlameParams) ProtoMessage()    {}
+func (*BlameParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4542fa62877488a1, []int{4}
+}
+func (m *BlameParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlameParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlameParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlameParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlameParams.Merge(m, src)
+}
+func (m *BlameParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlameParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlameParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlameParams proto.InternalMessageInfo
+
+func (m *BlameParams) GetWindowBlocks() int64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *BlameParams) GetMaxBlames() uint64 {
+	if m != nil {
+		return m.MaxBlames
+	}
+	return 0
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	ObserverParams       []*ObserverParams `protobuf:"bytes,1,rep,name=observer_params,json=observerParams,proto3" json:"observer_params,omitempty"`
 	AdminPolicy          []*Admin_Policy   `protobuf:"bytes,2,rep,name=admin_policy,json=adminPolicy,proto3" json:"admin_policy,omitempty"`
 	BallotMaturityBlocks int64             `protobuf:"varint,3,opt,name=ballot_maturity_blocks,json=ballotMaturityBlocks,proto3" json:"ballot_maturity_blocks,omitempty"`
+	// if enabled, a summary of each finalized ballot is stored when the ballot is pruned
+	BallotArchivalEnabled bool        `protobuf:"varint,4,opt,name=ballot_archival_enabled,json=ballotArchivalEnabled,proto3" json:"ballot_archival_enabled,omitempty"`
+	BlameParams           BlameParams `protobuf:"bytes,5,opt,name=blame_params,json=blameParams,proto3" json:"blame_params"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4542fa62877488a1, []int{4}
+	return fileDescriptor_4542fa62877488a1, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,69 +480,97 @@ func (m *Params) GetBallotMaturityBlocks() int64 {
 	return 0
 }
 
+func (m *Params) GetBallotArchivalEnabled() bool {
+	if m != nil {
+		return m.BallotArchivalEnabled
+	}
+	return false
+}
+
+func (m *Params) GetBlameParams() BlameParams {
+	if m != nil {
+		return m.BlameParams
+	}
+	return BlameParams{}
+}
+
 func init() {
 	proto.RegisterEnum("zetachain.zetacore.observer.Policy_Type", Policy_Type_name, Policy_Type_value)
 	proto.RegisterType((*CoreParamsList)(nil), "zetachain.zetacore.observer.CoreParamsList")
 	proto.RegisterType((*CoreParams)(nil), "zetachain.zetacore.observer.CoreParams")
 	proto.RegisterType((*ObserverParams)(nil), "zetachain.zetacore.observer.ObserverParams")
 	proto.RegisterType((*Admin_Policy)(nil), "zetachain.zetacore.observer.Admin_Policy")
+	proto.RegisterType((*BlameParams)(nil), "zetachain.zetacore.observer.BlameParams")
 	proto.RegisterType((*Params)(nil), "zetachain.zetacore.observer.Params")
 }
 
 func init() { proto.RegisterFile("observer/params.proto", fileDescriptor_4542fa62877488a1) }
 
 var fileDescriptor_4542fa62877488a1 = []byte{
-	// 792 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0x89, 0x9b, 0x26, 0x6f, 0x1d, 0x27, 0x5d, 0x5a, 0xba, 0x38, 0xc2, 0x31, 0x46,
-	0x02, 0xd3, 0x2a, 0x36, 0x18, 0x4e, 0x08, 0x0e, 0x89, 0x7b, 0x20, 0x52, 0x10, 0xd1, 0xd6, 0x1c,
-	0xe8, 0x65, 0x34, 0x9e, 0x9d, 0xda, 0x23, 0xaf, 0xf7, 0xad, 0x66, 0x66, 0x8b, 0xcd, 0x5f, 0xc1,
-	0x11, 0x89, 0x0b, 0x07, 0x0e, 0xfc, 0x11, 0xfc, 0x01, 0x3d, 0xf6, 0x88, 0x38, 0x54, 0x28, 0xb9,
-	0xf0, 0x67, 0xa0, 0x7d, 0xfb, 0xa3, 0x4e, 0x82, 0x22, 0xf5, 0xe4, 0xb7, 0xf3, 0x3e, 0xef, 0x3b,
-	0x6f, 0xde, 0x7c, 0x3d, 0xf0, 0x00, 0x27, 0x46, 0xea, 0x17, 0x52, 0x0f, 0x12, 0xae, 0xf9, 0xc2,
-	0xf4, 0x13, 0x8d, 0x16, 0xbd, 0x83, 0x9f, 0xa4, 0xe5, 0x62, 0xc6, 0x55, 0xdc, 0xa7, 0x08, 0xb5,
-	0xec, 0x97, 0x64, 0xeb, 0x1d, 0x81, 0x8b, 0x05, 0xc6, 0x83, 0xfc, 0x27, 0xaf, 0x68, 0xdd, 0x9f,
-	0xe2, 0x14, 0x29, 0x1c, 0x64, 0x51, 0xb1, 0xfa, 0xb0, 0x92, 0x2f, 0x83, 0x3c, 0xd1, 0x7d, 0x06,
-	0xcd, 0x11, 0x6a, 0x79, 0x4e, 0x9b, 0x9e, 0x29, 0x63, 0xbd, 0x6f, 0xc0, 0xcd, 0xb6, 0x61, 0x79,
-	0x1f, 0xbe, 0xd3, 0xd9, 0xec, 0xb9, 0xc3, 0x8f, 0xfb, 0xb7, 0x34, 0xd2, 0x7f, 0xa3, 0x10, 0x80,
-	0xa8, 0xe2, 0xee, 0x9f, 0x75, 0x80, 0x37, 0x29, 0xef, 0x08, 0x3c, 0x81, 0xf1, 0x73, 0xa5, 0x17,
-	0xdc, 0x2a, 0x8c, 0x99, 0xc0, 0x34, 0xb6, 0xbe, 0xd3, 0x71, 0x7a, 0xf5, 0xe0, 0xde, 0x7a, 0x66,
-	0x94, 0x25, 0xbc, 0x1e, 0xec, 0x4f, 0xb9, 0x61, 0x89, 0x56, 0x42, 0x32, 0xab, 0xc4, 0x5c, 0x6a,
-	0x7f, 0x83, 0xe0, 0xe6, 0x94, 0x9b, 0xf3, 0x6c, 0x79, 0x4c, 0xab, 0x5e, 0x07, 0x1a, 0x2a, 0x66,
-	0x76, 0x59, 0x52, 0x9b, 0x44, 0x81, 0x8a, 0xc7, 0xcb, 0x82, 0xe8, 0xc2, 0x2e, 0xa6, 0x76, 0x0d,
-	0xa9, 0x13, 0xe2, 0x62, 0x6a, 0x2b, 0xe6, 0x11, 0xdc, 0xfb, 0x91, 0x5b, 0x31, 0x63, 0xa9, 0x5d,
-	0x62, 0xc9, 0xdd, 0x21, 0x6e, 0x8f, 0x12, 0xdf, 0xdb, 0x25, 0x16, 0xec, 0xd7, 0x40, 0x17, 0xc3,
-	0x2c, 0xce, 0x65, 0x76, 0x90, 0xd8, 0x6a, 0x2e, 0x2c, 0xe3, 0x61, 0xa8, 0xa5, 0x31, 0xfe, 0x76,
-	0xc7, 0xe9, 0xed, 0x04, 0x7e, 0x86, 0x8c, 0x33, 0x62, 0x54, 0x00, 0xc7, 0x79, 0xde, 0xfb, 0x0a,
-	0x5a, 0x02, 0xe3, 0x58, 0x0a, 0x8b, 0xfa, 0x66, 0xf5, 0x4e, 0x5e, 0x5d, 0x11, 0xd7, 0xab, 0x47,
-	0xd0, 0x96, 0x5a, 0x0c, 0x3f, 0x65, 0x22, 0x35, 0x16, 0xc3, 0xd5, 0x4d, 0x05, 0x20, 0x85, 0x03,
-	0xa2, 0x46, 0x39, 0x74, 0x5d, 0xe4, 0x3d, 0xd8, 0xa6, 0xdb, 0x64, 0x2a, 0xf4, 0xdd, 0x8e, 0xd3,
-	0xdb, 0x0c, 0xee, 0xd2, 0xf7, 0x69, 0xe8, 0x1d, 0xc3, 0xfb, 0x98, 0xda, 0x09, 0xa6, 0x71, 0x98,
-	0x4d, 0xcc, 0x88, 0x99, 0x0c, 0xd3, 0x48, 0x32, 0x15, 0x5b, 0xa9, 0x5f, 0xf0, 0xc8, 0x6f, 0x10,
-	0xdf, 0x2a, 0xa1, 0xf1, 0xf2, 0x69, 0x81, 0x9c, 0x16, 0x44, 0xd6, 0xe2, 0xff, 0x4a, 0x44, 0x88,
-	0x73, 0x3e, 0x93, 0x3c, 0xf4, 0x77, 0x49, 0xe3, 0xe0, 0xa6, 0xc6, 0x59, 0x89, 0x74, 0x7f, 0xdd,
-	0x80, 0xe6, 0x77, 0x85, 0xc5, 0x0a, 0x0b, 0x7d, 0x08, 0x77, 0xa8, 0x4b, 0x72, 0x8d, 0x3b, 0xdc,
-	0xed, 0x17, 0xd6, 0x1f, 0x65, 0x8b, 0x41, 0x9e, 0xf3, 0x7e, 0x80, 0xfd, 0x09, 0x8f, 0x22, 0xb4,
-	0xcc, 0xce, 0xb4, 0x34, 0x33, 0x8c, 0x42, 0xb2, 0xc4, 0xce, 0x49, 0xff, 0xe5, 0xeb, 0xc3, 0xda,
-	0xdf, 0xaf, 0x0f, 0x3f, 0x9a, 0x2a, 0x3b, 0x4b, 0x27, 0x59, 0xf5, 0x40, 0xa0, 0x59, 0xa0, 0x29,
-	0x7e, 0x8e, 0x4c, 0x38, 0x1f, 0xd8, 0x55, 0x22, 0x4d, 0xff, 0x89, 0x14, 0xc1, 0x5e, 0xae, 0x33,
-	0x2e, 0x65, 0xbc, 0xe7, 0xf0, 0x70, 0xa1, 0x62, 0x56, 0x1a, 0x9f, 0x85, 0x32, 0x92, 0x53, 0xf2,
-	0x2c, 0x39, 0xea, 0xed, 0x77, 0x78, 0xb0, 0x50, 0x71, 0x79, 0xc6, 0x27, 0x95, 0x98, 0xf7, 0x01,
-	0x34, 0x94, 0x61, 0x26, 0x4d, 0x12, 0xd4, 0x56, 0x86, 0x64, 0xc3, 0xed, 0xc0, 0x55, 0xe6, 0x69,
-	0xb9, 0xd4, 0x35, 0xd0, 0x38, 0x0e, 0xb3, 0x66, 0xce, 0x31, 0x52, 0x62, 0xe5, 0x9d, 0x82, 0x9b,
-	0x50, 0xc4, 0x32, 0x75, 0x1a, 0x50, 0x73, 0xd8, 0xbb, 0xf5, 0x6f, 0x9b, 0x57, 0xb2, 0xf1, 0x2a,
-	0x91, 0x01, 0xe4, 0xc5, 0x59, 0xec, 0xf9, 0x70, 0xb7, 0x74, 0xd2, 0x06, 0x39, 0xa9, 0xfc, 0xec,
-	0xfe, 0xeb, 0xc0, 0x56, 0x71, 0x15, 0x63, 0xd8, 0xab, 0xc6, 0x70, 0xe5, 0xa9, 0x78, 0x7c, 0xeb,
-	0x9e, 0x57, 0x2f, 0x34, 0x68, 0xe2, 0xd5, 0x0b, 0x3e, 0x83, 0x06, 0xa7, 0x53, 0xe5, 0xed, 0xf8,
-	0x1b, 0x24, 0xf9, 0xc9, 0xad, 0x92, 0xeb, 0x63, 0x08, 0x5c, 0x2a, 0x2f, 0x66, 0xf2, 0x05, 0xbc,
-	0x5b, 0x38, 0x61, 0xc1, 0x6d, 0xaa, 0x95, 0x5d, 0xb1, 0x49, 0x84, 0x62, 0x6e, 0xc8, 0x0f, 0x9b,
-	0xc1, 0xfd, 0x3c, 0xfb, 0x6d, 0x91, 0x3c, 0xa1, 0xdc, 0x97, 0xf5, 0x5f, 0x7e, 0x3b, 0xac, 0x3d,
-	0x7a, 0x0c, 0xee, 0xda, 0x7c, 0x3c, 0x80, 0xad, 0xa9, 0xc6, 0x34, 0xf9, 0x6c, 0xbf, 0x56, 0xc5,
-	0xc3, 0x7d, 0xa7, 0x55, 0xff, 0xe3, 0xf7, 0xb6, 0x73, 0x72, 0xfa, 0xf2, 0xa2, 0xed, 0xbc, 0xba,
-	0x68, 0x3b, 0xff, 0x5c, 0xb4, 0x9d, 0x9f, 0x2f, 0xdb, 0xb5, 0x57, 0x97, 0xed, 0xda, 0x5f, 0x97,
-	0xed, 0xda, 0xb3, 0xc1, 0x9a, 0x11, 0xb2, 0xd6, 0x8f, 0xe8, 0x14, 0x83, 0xf2, 0x14, 0x83, 0x65,
-	0xf5, 0x20, 0xe7, 0xae, 0x98, 0x6c, 0xd1, 0xbb, 0xfc, 0xf9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x7f, 0x57, 0xd8, 0xba, 0x11, 0x06, 0x00, 0x00,
+	// 995 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcf, 0x4f, 0xe3, 0x46,
+	0x14, 0xc7, 0x13, 0x08, 0x2c, 0x3c, 0x87, 0x00, 0xde, 0x65, 0x71, 0x43, 0x37, 0xd0, 0x54, 0x6a,
+	0xd3, 0x45, 0x24, 0x2d, 0x5b, 0xf5, 0x50, 0xb5, 0x52, 0x49, 0xd8, 0x0a, 0x24, 0x56, 0x65, 0x4d,
+	0x7a, 0xe8, 0x5e, 0x46, 0x63, 0x7b, 0x48, 0x46, 0xd8, 0x1e, 0x6b, 0x66, 0x0c, 0x81, 0xbf, 0xa2,
+	0xc7, 0x4a, 0xbd, 0xf4, 0xd0, 0x43, 0xff, 0x88, 0xfe, 0x01, 0x7b, 0xdc, 0x63, 0xd5, 0xc3, 0xaa,
+	0x82, 0x3f, 0xa1, 0xff, 0x40, 0x35, 0xcf, 0x76, 0x08, 0xcb, 0x2a, 0x87, 0x9e, 0x32, 0x79, 0xef,
+	0xf3, 0xbe, 0x9e, 0xf7, 0xcb, 0x86, 0x35, 0xe1, 0x29, 0x26, 0xcf, 0x99, 0xec, 0x24, 0x54, 0xd2,
+	0x48, 0xb5, 0x13, 0x29, 0xb4, 0xb0, 0x37, 0xae, 0x98, 0xa6, 0xfe, 0x90, 0xf2, 0xb8, 0x8d, 0x27,
+	0x21, 0x59, 0xbb, 0x20, 0xeb, 0x0f, 0x7d, 0x11, 0x45, 0x22, 0xee, 0x64, 0x3f, 0x59, 0x44, 0xfd,
+	0xd1, 0x40, 0x0c, 0x04, 0x1e, 0x3b, 0xe6, 0x94, 0x5b, 0xd7, 0xc7, 0xf2, 0xc5, 0x21, 0x73, 0x34,
+	0x5f, 0x41, 0xad, 0x27, 0x24, 0x3b, 0xc6, 0x87, 0x1e, 0x71, 0xa5, 0xed, 0x03, 0xb0, 0xcc, 0x63,
+	0x48, 0x76, 0x0f, 0xa7, 0xbc, 0x35, 0xdb, 0xb2, 0x76, 0x3f, 0x6d, 0x4f, 0xb9, 0x48, 0xfb, 0x56,
+	0xc1, 0x05, 0x7f, 0x7c, 0x6e, 0xfe, 0x39, 0x0f, 0x70, 0xeb, 0xb2, 0x77, 0xc0, 0xf6, 0x45, 0x7c,
+	0xca, 0x65, 0x44, 0x35, 0x17, 0x31, 0xf1, 0x45, 0x1a, 0x6b, 0xa7, 0xbc, 0x55, 0x6e, 0x55, 0xdc,
+	0xd5, 0x49, 0x4f, 0xcf, 0x38, 0xec, 0x16, 0xac, 0x0c, 0xa8, 0x22, 0x89, 0xe4, 0x3e, 0x23, 0x9a,
+	0xfb, 0x67, 0x4c, 0x3a, 0x33, 0x08, 0xd7, 0x06, 0x54, 0x1d, 0x1b, 0x73, 0x1f, 0xad, 0xf6, 0x16,
+	0x54, 0x79, 0x4c, 0xf4, 0xa8, 0xa0, 0x66, 0x91, 0x02, 0x1e, 0xf7, 0x47, 0x39, 0xd1, 0x84, 0x25,
+	0x91, 0xea, 0x09, 0xa4, 0x82, 0x88, 0x25, 0x52, 0x3d, 0x66, 0x9e, 0xc2, 0xea, 0x05, 0xd5, 0xfe,
+	0x90, 0xa4, 0x7a, 0x24, 0x0a, 0x6e, 0x0e, 0xb9, 0x65, 0x74, 0xfc, 0xa8, 0x47, 0x22, 0x67, 0xbf,
+	0x05, 0x6c, 0x0c, 0xd1, 0xe2, 0x8c, 0x99, 0x44, 0x62, 0x2d, 0xa9, 0xaf, 0x09, 0x0d, 0x02, 0xc9,
+	0x94, 0x72, 0x16, 0xb6, 0xca, 0xad, 0x45, 0xd7, 0x31, 0x48, 0xdf, 0x10, 0xbd, 0x1c, 0xd8, 0xcb,
+	0xfc, 0xf6, 0x37, 0x50, 0xf7, 0x45, 0x1c, 0x33, 0x5f, 0x0b, 0x79, 0x3f, 0x7a, 0x31, 0x8b, 0x1e,
+	0x13, 0xef, 0x46, 0xf7, 0xa0, 0xc1, 0xa4, 0xbf, 0xfb, 0x39, 0xf1, 0x53, 0xa5, 0x45, 0x70, 0x79,
+	0x5f, 0x01, 0x50, 0x61, 0x03, 0xa9, 0x5e, 0x06, 0xbd, 0x2b, 0xf2, 0x01, 0x2c, 0x60, 0x37, 0x09,
+	0x0f, 0x1c, 0x6b, 0xab, 0xdc, 0x9a, 0x75, 0x1f, 0xe0, 0xff, 0xc3, 0xc0, 0xde, 0x83, 0x27, 0x22,
+	0xd5, 0x9e, 0x48, 0xe3, 0xc0, 0x54, 0x4c, 0xf9, 0x43, 0x16, 0xa4, 0x21, 0x23, 0x3c, 0xd6, 0x4c,
+	0x9e, 0xd3, 0xd0, 0xa9, 0x22, 0x5f, 0x2f, 0xa0, 0xfe, 0xe8, 0x24, 0x47, 0x0e, 0x73, 0xc2, 0x5c,
+	0xf1, 0xbd, 0x12, 0xa1, 0x10, 0x67, 0x74, 0xc8, 0x68, 0xe0, 0x2c, 0xa1, 0xc6, 0xc6, 0x7d, 0x8d,
+	0xa3, 0x02, 0xb1, 0x9f, 0xc1, 0xe3, 0x49, 0x11, 0x0f, 0x9b, 0xa3, 0xf8, 0x15, 0x73, 0x6a, 0xd8,
+	0x95, 0x87, 0xb7, 0xc1, 0x5d, 0xe3, 0x3b, 0xe1, 0x57, 0xcc, 0xfe, 0x0e, 0x3e, 0xc4, 0xfe, 0xf9,
+	0x22, 0x56, 0x22, 0xe4, 0x41, 0x36, 0x6a, 0x7a, 0x28, 0x99, 0x1a, 0x8a, 0x30, 0x70, 0x96, 0x31,
+	0xb4, 0x6e, 0x98, 0xde, 0x24, 0xd2, 0x2f, 0x08, 0x7b, 0x1f, 0x36, 0xdf, 0xa3, 0x10, 0xd1, 0x11,
+	0x39, 0x65, 0x8c, 0x48, 0xaa, 0x99, 0xb3, 0x82, 0x22, 0x1b, 0xf7, 0x44, 0x5e, 0xd0, 0xd1, 0xf7,
+	0x8c, 0xb9, 0x54, 0x33, 0x53, 0x44, 0x2f, 0x14, 0xfe, 0x19, 0x31, 0xa9, 0x30, 0x49, 0x3c, 0x21,
+	0xb4, 0xd2, 0x92, 0x26, 0x64, 0xc8, 0xf8, 0x60, 0xa8, 0x9d, 0xd5, 0xac, 0x88, 0x08, 0x1d, 0x20,
+	0xd3, 0x2d, 0x90, 0x03, 0x24, 0x9a, 0xbf, 0xce, 0x40, 0xed, 0x87, 0x7c, 0xc5, 0xf2, 0x15, 0xfa,
+	0x18, 0xe6, 0xb0, 0x4b, 0xb8, 0x35, 0xd6, 0xee, 0x52, 0x3b, 0x5f, 0xfd, 0x9e, 0x31, 0xba, 0x99,
+	0xcf, 0xfe, 0x09, 0x56, 0x3c, 0x1a, 0x86, 0x42, 0x4f, 0xa4, 0x6d, 0x56, 0x62, 0xb1, 0xdb, 0x7e,
+	0xfd, 0x76, 0xb3, 0xf4, 0xf7, 0xdb, 0xcd, 0x4f, 0x06, 0x5c, 0x0f, 0x53, 0xcf, 0x44, 0x77, 0x7c,
+	0xa1, 0x22, 0xa1, 0xf2, 0x9f, 0x1d, 0x15, 0x9c, 0x75, 0xf4, 0x65, 0xc2, 0x54, 0x7b, 0x9f, 0xf9,
+	0xee, 0x72, 0xa6, 0x73, 0x5b, 0x9b, 0x53, 0x58, 0x8f, 0x78, 0x4c, 0x8a, 0xc5, 0x27, 0x01, 0x0b,
+	0xd9, 0x00, 0x53, 0x77, 0x2a, 0xff, 0xeb, 0x09, 0x6b, 0x11, 0x8f, 0x8b, 0x1c, 0xf7, 0xc7, 0x62,
+	0xf6, 0x47, 0x50, 0xe5, 0x8a, 0xa8, 0x34, 0x49, 0x84, 0xd4, 0x2c, 0xc0, 0x35, 0x5c, 0x70, 0x2d,
+	0xae, 0x4e, 0x0a, 0x53, 0x53, 0x41, 0x75, 0x2f, 0x30, 0x97, 0x39, 0x16, 0x21, 0xf7, 0x2f, 0xed,
+	0x43, 0xb0, 0x12, 0x3c, 0x11, 0xa3, 0x8e, 0x05, 0xaa, 0xed, 0xb6, 0xa6, 0xbe, 0xb6, 0xb2, 0x48,
+	0xd2, 0xbf, 0x4c, 0x98, 0x0b, 0x59, 0xb0, 0x39, 0xdb, 0x0e, 0x3c, 0x28, 0x36, 0x69, 0x06, 0x37,
+	0xa9, 0xf8, 0xdb, 0x7c, 0x09, 0x56, 0x37, 0xa4, 0x11, 0x1b, 0xb7, 0x63, 0xe9, 0x82, 0xc7, 0x81,
+	0xb8, 0x20, 0xd8, 0x46, 0x85, 0x4f, 0x9d, 0x75, 0xab, 0x99, 0xb1, 0x8b, 0x36, 0xfb, 0x09, 0x80,
+	0x19, 0x1e, 0xcf, 0xc4, 0xa9, 0xfc, 0x0d, 0xb6, 0x18, 0xd1, 0x11, 0x0a, 0xa9, 0xe6, 0xbf, 0x33,
+	0x30, 0x9f, 0xcb, 0xf5, 0x61, 0x79, 0x5c, 0xd9, 0x3b, 0x6f, 0xdf, 0xed, 0xa9, 0x69, 0xdc, 0x9d,
+	0x11, 0xb7, 0x26, 0xee, 0xce, 0xcc, 0x11, 0x54, 0x29, 0x16, 0x2a, 0xcb, 0xd0, 0x99, 0x41, 0xc9,
+	0xcf, 0xa6, 0x4a, 0x4e, 0x56, 0xd6, 0xb5, 0x30, 0x3c, 0x2f, 0xf3, 0x97, 0xf0, 0x38, 0x1f, 0xae,
+	0x88, 0xea, 0x54, 0x72, 0x7d, 0x59, 0xe4, 0x3e, 0x8b, 0xb9, 0x3f, 0xca, 0xbc, 0x2f, 0x72, 0x67,
+	0x5e, 0x83, 0xaf, 0x60, 0x3d, 0x8f, 0xa2, 0xd2, 0x1f, 0xf2, 0x73, 0x1a, 0x12, 0x16, 0x53, 0x2f,
+	0x64, 0x01, 0xce, 0xcd, 0x82, 0xbb, 0x96, 0xb9, 0xf7, 0x72, 0xef, 0xf3, 0xcc, 0x69, 0xbf, 0x84,
+	0x2a, 0xd6, 0xad, 0x28, 0xc7, 0x1c, 0x8e, 0xfd, 0xf4, 0xae, 0x4e, 0x34, 0xa8, 0x5b, 0x31, 0xe3,
+	0xe8, 0x5a, 0xde, 0xad, 0xe9, 0xeb, 0xca, 0x2f, 0xbf, 0x6d, 0x96, 0x9e, 0x6e, 0x83, 0x35, 0xd1,
+	0x7d, 0x1b, 0x60, 0x7e, 0x20, 0x45, 0x9a, 0x7c, 0xb1, 0x52, 0x1a, 0x9f, 0x77, 0x57, 0xca, 0xf5,
+	0xca, 0x1f, 0xbf, 0x37, 0xca, 0xdd, 0xe7, 0xaf, 0xaf, 0x1b, 0xe5, 0x37, 0xd7, 0x8d, 0xf2, 0x3f,
+	0xd7, 0x8d, 0xf2, 0xcf, 0x37, 0x8d, 0xd2, 0x9b, 0x9b, 0x46, 0xe9, 0xaf, 0x9b, 0x46, 0xe9, 0xd5,
+	0xf6, 0xc4, 0x98, 0x9b, 0x9b, 0xec, 0xe0, 0xa5, 0x3a, 0xb1, 0x08, 0x58, 0x67, 0x34, 0xfe, 0xd4,
+	0x66, 0xf3, 0xee, 0xcd, 0xe3, 0x17, 0xf7, 0xd9, 0x7f, 0x03, 0x00, 0x73, 0x58, 0xd5, 0x61, 0xeb,
+	0x07, 0x00, 0x00,
 }
 
 func (m *CoreParamsList) Marshal() (dAtA []byte, err error) {
@@ -507,6 +630,30 @@ func (m *CoreParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockHeaderBootstrapHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockHeaderBootstrapHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.UtxoConsolidationMaxFeeRate != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UtxoConsolidationMaxFeeRate))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.UtxoConsolidationThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UtxoConsolidationThreshold))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.OutboundTxBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OutboundTxBatchSize))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.OutboundTxScheduleLookahead != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.OutboundTxScheduleLookahead))
 		i--
@@ -671,6 +818,39 @@ func (m *Admin_Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlameParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlameParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlameParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBlames != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlames))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -691,6 +871,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.BlameParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.BallotArchivalEnabled {
+		i--
+		if m.BallotArchivalEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BallotMaturityBlocks != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.BallotMaturityBlocks))
 		i--
@@ -795,6 +995,18 @@ func (m *CoreParams) Size() (n int) {
 	if m.OutboundTxScheduleLookahead != 0 {
 		n += 1 + sovParams(uint64(m.OutboundTxScheduleLookahead))
 	}
+	if m.OutboundTxBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.OutboundTxBatchSize))
+	}
+	if m.UtxoConsolidationThreshold != 0 {
+		n += 1 + sovParams(uint64(m.UtxoConsolidationThreshold))
+	}
+	if m.UtxoConsolidationMaxFeeRate != 0 {
+		n += 2 + sovParams(uint64(m.UtxoConsolidationMaxFeeRate))
+	}
+	if m.BlockHeaderBootstrapHeight != 0 {
+		n += 2 + sovParams(uint64(m.BlockHeaderBootstrapHeight))
+	}
 	return n
 }
 
@@ -834,6 +1046,21 @@ func (m *Admin_Policy) Size() (n int) {
 	return n
 }
 
+func (m *BlameParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		n += 1 + sovParams(uint64(m.WindowBlocks))
+	}
+	if m.MaxBlames != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlames))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -855,6 +1082,11 @@ func (m *Params) Size() (n int) {
 	if m.BallotMaturityBlocks != 0 {
 		n += 1 + sovParams(uint64(m.BallotMaturityBlocks))
 	}
+	if m.BallotArchivalEnabled {
+		n += 2
+	}
+	l = m.BlameParams.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1225,6 +1457,82 @@ func (m *CoreParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxBatchSize", wireType)
+			}
+			m.OutboundTxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundTxBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtxoConsolidationThreshold", wireType)
+			}
+			m.UtxoConsolidationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtxoConsolidationThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtxoConsolidationMaxFeeRate", wireType)
+			}
+			m.UtxoConsolidationMaxFeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UtxoConsolidationMaxFeeRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderBootstrapHeight", wireType)
+			}
+			m.BlockHeaderBootstrapHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeaderBootstrapHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1521,6 +1829,94 @@ func (m *Admin_Policy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlameParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlameParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlameParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlames", wireType)
+			}
+			m.MaxBlames = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlames |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1637,6 +2033,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotArchivalEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BallotArchivalEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlameParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
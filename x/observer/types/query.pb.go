@@ -1078,6 +1078,174 @@ func (m *QueryGetCrosschainFlagsResponse) GetCrosschainFlags() CrosschainFlags {
 	return CrosschainFlags{}
 }
 
+type QueryGetChainCrosschainFlagsRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryGetChainCrosschainFlagsRequest) Reset()         { *m = QueryGetChainCrosschainFlagsRequest{} }
+func (m *QueryGetChainCrosschainFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainCrosschainFlagsRequest) ProtoMessage()    {}
+func (*QueryGetChainCrosschainFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{23}
+}
+func (m *QueryGetChainCrosschainFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChainCrosschainFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainCrosschainFlagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChainCrosschainFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainCrosschainFlagsRequest.Merge(m, src)
+}
+func (m *QueryGetChainCrosschainFlagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChainCrosschainFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainCrosschainFlagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetChainCrosschainFlagsRequest proto.InternalMessageInfo
+
+func (m *QueryGetChainCrosschainFlagsRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type QueryGetChainCrosschainFlagsResponse struct {
+	ChainCrosschainFlags ChainCrosschainFlags `protobuf:"bytes,1,opt,name=chain_crosschain_flags,json=chainCrosschainFlags,proto3" json:"chain_crosschain_flags"`
+}
+
+func (m *QueryGetChainCrosschainFlagsResponse) Reset()         { *m = QueryGetChainCrosschainFlagsResponse{} }
+func (m *QueryGetChainCrosschainFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainCrosschainFlagsResponse) ProtoMessage()    {}
+func (*QueryGetChainCrosschainFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{24}
+}
+func (m *QueryGetChainCrosschainFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChainCrosschainFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainCrosschainFlagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChainCrosschainFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainCrosschainFlagsResponse.Merge(m, src)
+}
+func (m *QueryGetChainCrosschainFlagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChainCrosschainFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainCrosschainFlagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetChainCrosschainFlagsResponse proto.InternalMessageInfo
+
+func (m *QueryGetChainCrosschainFlagsResponse) GetChainCrosschainFlags() ChainCrosschainFlags {
+	if m != nil {
+		return m.ChainCrosschainFlags
+	}
+	return ChainCrosschainFlags{}
+}
+
+type QueryAllChainCrosschainFlagsRequest struct {
+}
+
+func (m *QueryAllChainCrosschainFlagsRequest) Reset()         { *m = QueryAllChainCrosschainFlagsRequest{} }
+func (m *QueryAllChainCrosschainFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChainCrosschainFlagsRequest) ProtoMessage()    {}
+func (*QueryAllChainCrosschainFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{25}
+}
+func (m *QueryAllChainCrosschainFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChainCrosschainFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChainCrosschainFlagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChainCrosschainFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChainCrosschainFlagsRequest.Merge(m, src)
+}
+func (m *QueryAllChainCrosschainFlagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChainCrosschainFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChainCrosschainFlagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChainCrosschainFlagsRequest proto.InternalMessageInfo
+
+type QueryAllChainCrosschainFlagsResponse struct {
+	ChainCrosschainFlags []ChainCrosschainFlags `protobuf:"bytes,1,rep,name=chain_crosschain_flags,json=chainCrosschainFlags,proto3" json:"chain_crosschain_flags"`
+}
+
+func (m *QueryAllChainCrosschainFlagsResponse) Reset()         { *m = QueryAllChainCrosschainFlagsResponse{} }
+func (m *QueryAllChainCrosschainFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChainCrosschainFlagsResponse) ProtoMessage()    {}
+func (*QueryAllChainCrosschainFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{26}
+}
+func (m *QueryAllChainCrosschainFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChainCrosschainFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChainCrosschainFlagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChainCrosschainFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChainCrosschainFlagsResponse.Merge(m, src)
+}
+func (m *QueryAllChainCrosschainFlagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChainCrosschainFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChainCrosschainFlagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChainCrosschainFlagsResponse proto.InternalMessageInfo
+
+func (m *QueryAllChainCrosschainFlagsResponse) GetChainCrosschainFlags() []ChainCrosschainFlags {
+	if m != nil {
+		return m.ChainCrosschainFlags
+	}
+	return nil
+}
+
 type QueryGetKeygenRequest struct {
 }
 
@@ -1085,7 +1253,7 @@ func (m *QueryGetKeygenRequest) Reset()         { *m = QueryGetKeygenRequest{} }
 func (m *QueryGetKeygenRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetKeygenRequest) ProtoMessage()    {}
 func (*QueryGetKeygenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{23}
+	return fileDescriptor_dcb801e455adaee4, []int{27}
 }
 func (m *QueryGetKeygenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1122,7 +1290,7 @@ func (m *QueryGetKeygenResponse) Reset()         { *m = QueryGetKeygenResponse{}
 func (m *QueryGetKeygenResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetKeygenResponse) ProtoMessage()    {}
 func (*QueryGetKeygenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{24}
+	return fileDescriptor_dcb801e455adaee4, []int{28}
 }
 func (m *QueryGetKeygenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1165,7 +1333,7 @@ func (m *QueryShowObserverCountRequest) Reset()         { *m = QueryShowObserver
 func (m *QueryShowObserverCountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryShowObserverCountRequest) ProtoMessage()    {}
 func (*QueryShowObserverCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{25}
+	return fileDescriptor_dcb801e455adaee4, []int{29}
 }
 func (m *QueryShowObserverCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1202,7 +1370,7 @@ func (m *QueryShowObserverCountResponse) Reset()         { *m = QueryShowObserve
 func (m *QueryShowObserverCountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryShowObserverCountResponse) ProtoMessage()    {}
 func (*QueryShowObserverCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{26}
+	return fileDescriptor_dcb801e455adaee4, []int{30}
 }
 func (m *QueryShowObserverCountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1246,7 +1414,7 @@ func (m *QueryBlameByIdentifierRequest) Reset()         { *m = QueryBlameByIdent
 func (m *QueryBlameByIdentifierRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByIdentifierRequest) ProtoMessage()    {}
 func (*QueryBlameByIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{27}
+	return fileDescriptor_dcb801e455adaee4, []int{31}
 }
 func (m *QueryBlameByIdentifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1290,7 +1458,7 @@ func (m *QueryBlameByIdentifierResponse) Reset()         { *m = QueryBlameByIden
 func (m *QueryBlameByIdentifierResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByIdentifierResponse) ProtoMessage()    {}
 func (*QueryBlameByIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{28}
+	return fileDescriptor_dcb801e455adaee4, []int{32}
 }
 func (m *QueryBlameByIdentifierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1333,7 +1501,7 @@ func (m *QueryAllBlameRecordsRequest) Reset()         { *m = QueryAllBlameRecord
 func (m *QueryAllBlameRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlameRecordsRequest) ProtoMessage()    {}
 func (*QueryAllBlameRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{29}
+	return fileDescriptor_dcb801e455adaee4, []int{33}
 }
 func (m *QueryAllBlameRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,7 +1538,7 @@ func (m *QueryAllBlameRecordsResponse) Reset()         { *m = QueryAllBlameRecor
 func (m *QueryAllBlameRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlameRecordsResponse) ProtoMessage()    {}
 func (*QueryAllBlameRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{30}
+	return fileDescriptor_dcb801e455adaee4, []int{34}
 }
 func (m *QueryAllBlameRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1415,7 +1583,7 @@ func (m *QueryBlameByChainAndNonceRequest) Reset()         { *m = QueryBlameByCh
 func (m *QueryBlameByChainAndNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByChainAndNonceRequest) ProtoMessage()    {}
 func (*QueryBlameByChainAndNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{31}
+	return fileDescriptor_dcb801e455adaee4, []int{35}
 }
 func (m *QueryBlameByChainAndNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1466,7 +1634,7 @@ func (m *QueryBlameByChainAndNonceResponse) Reset()         { *m = QueryBlameByC
 func (m *QueryBlameByChainAndNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByChainAndNonceResponse) ProtoMessage()    {}
 func (*QueryBlameByChainAndNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{32}
+	return fileDescriptor_dcb801e455adaee4, []int{36}
 }
 func (m *QueryBlameByChainAndNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1510,7 +1678,7 @@ func (m *QueryAllBlockHeaderRequest) Reset()         { *m = QueryAllBlockHeaderR
 func (m *QueryAllBlockHeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlockHeaderRequest) ProtoMessage()    {}
 func (*QueryAllBlockHeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{33}
+	return fileDescriptor_dcb801e455adaee4, []int{37}
 }
 func (m *QueryAllBlockHeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1555,7 +1723,7 @@ func (m *QueryAllBlockHeaderResponse) Reset()         { *m = QueryAllBlockHeader
 func (m *QueryAllBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlockHeaderResponse) ProtoMessage()    {}
 func (*QueryAllBlockHeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{34}
+	return fileDescriptor_dcb801e455adaee4, []int{38}
 }
 func (m *QueryAllBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1606,7 +1774,7 @@ func (m *QueryGetBlockHeaderByHashRequest) Reset()         { *m = QueryGetBlockH
 func (m *QueryGetBlockHeaderByHashRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBlockHeaderByHashRequest) ProtoMessage()    {}
 func (*QueryGetBlockHeaderByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{35}
+	return fileDescriptor_dcb801e455adaee4, []int{39}
 }
 func (m *QueryGetBlockHeaderByHashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1650,7 +1818,7 @@ func (m *QueryGetBlockHeaderByHashResponse) Reset()         { *m = QueryGetBlock
 func (m *QueryGetBlockHeaderByHashResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBlockHeaderByHashResponse) ProtoMessage()    {}
 func (*QueryGetBlockHeaderByHashResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{36}
+	return fileDescriptor_dcb801e455adaee4, []int{40}
 }
 func (m *QueryGetBlockHeaderByHashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1686,1868 +1854,2319 @@ func (m *QueryGetBlockHeaderByHashResponse) GetBlockHeader() *common.BlockHeader
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryProveRequest)(nil), "zetachain.zetacore.observer.QueryProveRequest")
-	proto.RegisterType((*QueryProveResponse)(nil), "zetachain.zetacore.observer.QueryProveResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.observer.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.observer.QueryParamsResponse")
-	proto.RegisterType((*QueryBallotByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierRequest")
-	proto.RegisterType((*VoterList)(nil), "zetachain.zetacore.observer.VoterList")
-	proto.RegisterType((*QueryBallotByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierResponse")
-	proto.RegisterType((*QueryObserversByChainRequest)(nil), "zetachain.zetacore.observer.QueryObserversByChainRequest")
-	proto.RegisterType((*QueryObserversByChainResponse)(nil), "zetachain.zetacore.observer.QueryObserversByChainResponse")
-	proto.RegisterType((*QueryAllObserverMappersRequest)(nil), "zetachain.zetacore.observer.QueryAllObserverMappersRequest")
-	proto.RegisterType((*QueryAllObserverMappersResponse)(nil), "zetachain.zetacore.observer.QueryAllObserverMappersResponse")
-	proto.RegisterType((*QuerySupportedChains)(nil), "zetachain.zetacore.observer.QuerySupportedChains")
-	proto.RegisterType((*QuerySupportedChainsResponse)(nil), "zetachain.zetacore.observer.QuerySupportedChainsResponse")
-	proto.RegisterType((*QueryGetCoreParamsForChainRequest)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsForChainRequest")
-	proto.RegisterType((*QueryGetCoreParamsForChainResponse)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsForChainResponse")
-	proto.RegisterType((*QueryGetCoreParamsRequest)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsRequest")
-	proto.RegisterType((*QueryGetCoreParamsResponse)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsResponse")
-	proto.RegisterType((*QueryGetNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountRequest")
-	proto.RegisterType((*QueryGetNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountResponse")
-	proto.RegisterType((*QueryAllNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountRequest")
-	proto.RegisterType((*QueryAllNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountResponse")
-	proto.RegisterType((*QueryGetCrosschainFlagsRequest)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsRequest")
-	proto.RegisterType((*QueryGetCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsResponse")
-	proto.RegisterType((*QueryGetKeygenRequest)(nil), "zetachain.zetacore.observer.QueryGetKeygenRequest")
-	proto.RegisterType((*QueryGetKeygenResponse)(nil), "zetachain.zetacore.observer.QueryGetKeygenResponse")
-	proto.RegisterType((*QueryShowObserverCountRequest)(nil), "zetachain.zetacore.observer.QueryShowObserverCountRequest")
-	proto.RegisterType((*QueryShowObserverCountResponse)(nil), "zetachain.zetacore.observer.QueryShowObserverCountResponse")
-	proto.RegisterType((*QueryBlameByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierRequest")
-	proto.RegisterType((*QueryBlameByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierResponse")
-	proto.RegisterType((*QueryAllBlameRecordsRequest)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsRequest")
-	proto.RegisterType((*QueryAllBlameRecordsResponse)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsResponse")
-	proto.RegisterType((*QueryBlameByChainAndNonceRequest)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceRequest")
-	proto.RegisterType((*QueryBlameByChainAndNonceResponse)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceResponse")
-	proto.RegisterType((*QueryAllBlockHeaderRequest)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderRequest")
-	proto.RegisterType((*QueryAllBlockHeaderResponse)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderResponse")
-	proto.RegisterType((*QueryGetBlockHeaderByHashRequest)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashRequest")
-	proto.RegisterType((*QueryGetBlockHeaderByHashResponse)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashResponse")
+type QueryGetChainStateRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("observer/query.proto", fileDescriptor_dcb801e455adaee4) }
-
-var fileDescriptor_dcb801e455adaee4 = []byte{
-	// 1881 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd6, 0x4a, 0x11, 0x23, 0x3d, 0x4a, 0x96, 0x34, 0xa2, 0x62, 0x79, 0x25, 0x51, 0xea, 0xa8,
-	0x76, 0x64, 0xc9, 0xe1, 0x46, 0x34, 0x90, 0xd8, 0x51, 0xa4, 0x94, 0x14, 0x62, 0x59, 0xb1, 0xe3,
-	0xb8, 0x54, 0x9b, 0x16, 0x2d, 0x5a, 0x62, 0x49, 0x8e, 0x28, 0x26, 0xab, 0x1d, 0x66, 0x77, 0x25,
-	0x8b, 0x15, 0x04, 0x14, 0x3d, 0xf7, 0x60, 0xa0, 0x40, 0xcf, 0x3d, 0xf5, 0xd6, 0x1e, 0x7c, 0xe9,
-	0xa1, 0xe8, 0xa5, 0x97, 0xfa, 0x54, 0xb8, 0x28, 0x50, 0xb4, 0x87, 0x16, 0x86, 0xdd, 0x3f, 0xa0,
-	0x7f, 0x42, 0x31, 0x3f, 0x76, 0x39, 0x5c, 0xee, 0x92, 0x4b, 0xc1, 0x27, 0x71, 0x67, 0xe6, 0xbd,
-	0xf9, 0xbe, 0x37, 0x33, 0xef, 0xbd, 0x0f, 0x82, 0x0c, 0xad, 0xb8, 0xc4, 0x39, 0x25, 0x8e, 0xf1,
-	0xcd, 0x09, 0x71, 0x5a, 0xb9, 0xa6, 0x43, 0x3d, 0x8a, 0x16, 0x7e, 0x46, 0x3c, 0xb3, 0x7a, 0x64,
-	0x36, 0xec, 0x1c, 0xff, 0x45, 0x1d, 0x92, 0xf3, 0x17, 0xea, 0xb3, 0x55, 0x7a, 0x7c, 0x4c, 0x6d,
-	0x43, 0xfc, 0x11, 0x16, 0xfa, 0x7a, 0x95, 0xba, 0xc7, 0xd4, 0x35, 0x2a, 0xa6, 0x4b, 0x84, 0x2b,
-	0xe3, 0x74, 0xb3, 0x42, 0x3c, 0x73, 0xd3, 0x68, 0x9a, 0xf5, 0x86, 0x6d, 0x7a, 0x8d, 0x60, 0x6d,
-	0xa6, 0x4e, 0xeb, 0x94, 0xff, 0x34, 0xd8, 0x2f, 0x39, 0xba, 0x58, 0xa7, 0xb4, 0x6e, 0x11, 0xc3,
-	0x6c, 0x36, 0x0c, 0xd3, 0xb6, 0xa9, 0xc7, 0x4d, 0x5c, 0x39, 0x3b, 0x17, 0xe0, 0xac, 0x98, 0x96,
-	0x45, 0x3d, 0xdf, 0x55, 0x7b, 0xd8, 0x32, 0x8f, 0x89, 0x1c, 0x5d, 0x0e, 0x46, 0xab, 0x0e, 0x75,
-	0x5d, 0x4e, 0xa4, 0x7c, 0x68, 0x99, 0xf5, 0x6e, 0x6f, 0x5f, 0x93, 0x56, 0x9d, 0xf8, 0xc0, 0x16,
-	0x82, 0x61, 0x9b, 0xd6, 0x48, 0xd9, 0xac, 0x56, 0xe9, 0x89, 0xed, 0x6f, 0x75, 0x35, 0x98, 0xf4,
-	0x7f, 0x74, 0x39, 0x6b, 0x9a, 0x8e, 0x79, 0x2c, 0xf7, 0xc0, 0xbf, 0xd5, 0x60, 0xe6, 0xbb, 0x2c,
-	0x10, 0x8f, 0x1d, 0x7a, 0x4a, 0x4a, 0xe4, 0x9b, 0x13, 0xe2, 0x7a, 0xe8, 0x1a, 0x8c, 0x09, 0x38,
-	0x8d, 0xda, 0xbc, 0xb6, 0xa2, 0xad, 0x8d, 0x94, 0xde, 0xe6, 0xdf, 0xfb, 0x35, 0x74, 0x15, 0xde,
-	0xf6, 0xce, 0xca, 0x47, 0xa6, 0x7b, 0x34, 0x3f, 0xbc, 0xa2, 0xad, 0x8d, 0x97, 0x52, 0xde, 0xd9,
-	0x7d, 0xd3, 0x3d, 0x42, 0xab, 0x30, 0xda, 0x74, 0x28, 0x3d, 0x9c, 0x1f, 0x59, 0xd1, 0xd6, 0xd2,
-	0xf9, 0xc9, 0x9c, 0x8c, 0xfc, 0x63, 0x36, 0x58, 0x12, 0x73, 0x68, 0x09, 0xa0, 0x62, 0xd1, 0xea,
-	0xd7, 0xc2, 0xc1, 0x5b, 0xdc, 0xc1, 0x38, 0x1f, 0xe1, 0x3e, 0xae, 0xc1, 0x98, 0x77, 0x56, 0x6e,
-	0xd8, 0x35, 0x72, 0x36, 0x3f, 0x2a, 0xf6, 0xf5, 0xce, 0xf6, 0xd9, 0x27, 0x5e, 0x07, 0xa4, 0xe2,
-	0x74, 0x9b, 0xd4, 0x76, 0x09, 0xca, 0xc0, 0xe8, 0xa9, 0x69, 0x49, 0x94, 0x63, 0x25, 0xf1, 0x81,
-	0x33, 0xfe, 0x5a, 0xce, 0x54, 0x92, 0xc2, 0x3f, 0x84, 0xd9, 0x8e, 0x51, 0xe9, 0xa2, 0x00, 0x29,
-	0x11, 0x11, 0xee, 0x23, 0x9d, 0x5f, 0xcd, 0xf5, 0xb8, 0x56, 0x39, 0x61, 0x5c, 0x7c, 0xeb, 0xf9,
-	0x7f, 0x96, 0x87, 0x4a, 0xd2, 0x10, 0x7f, 0x0e, 0x59, 0xee, 0xb9, 0xc8, 0x0f, 0xbd, 0xd8, 0xda,
-	0xaf, 0x11, 0xdb, 0x6b, 0x1c, 0x36, 0x88, 0xe3, 0x07, 0x74, 0x03, 0x66, 0xc4, 0x8d, 0x28, 0x37,
-	0x82, 0x39, 0xbe, 0xdf, 0x78, 0x69, 0x5a, 0x4c, 0xb4, 0x6d, 0xb0, 0x07, 0xe3, 0x5f, 0x52, 0x8f,
-	0x38, 0x0f, 0x1b, 0xae, 0x87, 0x56, 0x61, 0xf2, 0x94, 0x7d, 0x94, 0xcd, 0x5a, 0xcd, 0x21, 0xae,
-	0x2b, 0xad, 0x26, 0xf8, 0x60, 0x41, 0x8c, 0xa1, 0x22, 0x8c, 0xb3, 0xef, 0xb2, 0xd7, 0x6a, 0x12,
-	0x7e, 0x2c, 0x57, 0xf2, 0xd7, 0x7b, 0xd2, 0x60, 0xfe, 0xbf, 0xd7, 0x6a, 0x92, 0xd2, 0xd8, 0xa9,
-	0xfc, 0x85, 0xff, 0x30, 0x0c, 0xcb, 0xb1, 0x2c, 0x64, 0xac, 0x06, 0xa1, 0x81, 0x76, 0x20, 0xc5,
-	0x41, 0xba, 0xf3, 0xc3, 0x2b, 0x23, 0x6b, 0xe9, 0xfc, 0x8d, 0xbe, 0x88, 0x38, 0xe3, 0x92, 0xb4,
-	0x42, 0x3f, 0x80, 0x69, 0x31, 0xcb, 0x9f, 0x98, 0xe0, 0x36, 0xc2, 0xb9, 0xdd, 0xea, 0xe9, 0xe9,
-	0x8b, 0xb6, 0x11, 0xa7, 0x38, 0x45, 0x3b, 0x07, 0xd0, 0x23, 0x98, 0x94, 0x2c, 0x5c, 0xcf, 0xf4,
-	0x4e, 0x5c, 0x7e, 0x0f, 0xaf, 0xe4, 0x6f, 0xf6, 0xf4, 0x2a, 0xa2, 0x72, 0xc0, 0x0d, 0x4a, 0x13,
-	0x15, 0xe5, 0x0b, 0x3f, 0x80, 0x45, 0x1e, 0xb8, 0x2f, 0xe4, 0x5a, 0xb7, 0xd8, 0xda, 0x65, 0x5e,
-	0x94, 0xc3, 0x57, 0x89, 0xf0, 0x1d, 0xfc, 0xa8, 0x29, 0x13, 0xdc, 0x06, 0x6f, 0xc3, 0x52, 0x8c,
-	0x33, 0x79, 0x06, 0x8b, 0x30, 0xee, 0x83, 0x62, 0x97, 0x61, 0x84, 0xbd, 0xa0, 0x60, 0x00, 0xaf,
-	0xc8, 0xab, 0x58, 0xb0, 0x2c, 0xdf, 0xc3, 0xe7, 0x66, 0xb3, 0x49, 0x9c, 0xe0, 0x19, 0xb4, 0xe4,
-	0x31, 0x47, 0xad, 0x90, 0x5b, 0x7c, 0xe9, 0x47, 0x9e, 0x38, 0xe5, 0x63, 0x31, 0xc7, 0x77, 0x4a,
-	0xe7, 0x37, 0x12, 0x44, 0xde, 0xf7, 0xe7, 0x07, 0x3e, 0xf0, 0x8f, 0xdf, 0x81, 0x0c, 0xdf, 0xfa,
-	0xe0, 0xa4, 0xd9, 0xa4, 0x8e, 0x47, 0x6a, 0x9c, 0x99, 0x8b, 0x3f, 0x95, 0x01, 0x0c, 0x8d, 0x07,
-	0x78, 0xae, 0x43, 0x8a, 0x6f, 0xe9, 0xa3, 0x08, 0x72, 0x8b, 0x88, 0x8c, 0x9c, 0xc4, 0x3b, 0xf0,
-	0x2d, 0xee, 0x66, 0x8f, 0x78, 0xbb, 0xd4, 0x21, 0xe2, 0xa9, 0xde, 0xa3, 0x4e, 0xc7, 0x61, 0xc4,
-	0xa7, 0x36, 0x6c, 0x03, 0xee, 0x65, 0x2f, 0xc1, 0xdc, 0x87, 0x34, 0x63, 0x5d, 0xee, 0x48, 0x1a,
-	0xef, 0xf6, 0x8c, 0x4b, 0xdb, 0x5b, 0x09, 0xaa, 0xc1, 0x6f, 0xbc, 0x00, 0xd7, 0xba, 0xf7, 0xf3,
-	0x8f, 0xe9, 0x2b, 0xd0, 0xa3, 0x26, 0x25, 0x88, 0x87, 0x51, 0x20, 0x36, 0x12, 0x82, 0xe0, 0xaf,
-	0x4c, 0x05, 0x92, 0x6f, 0xef, 0xf5, 0x88, 0xd6, 0x48, 0x41, 0x54, 0x14, 0x3f, 0x62, 0x19, 0x18,
-	0x15, 0x19, 0x59, 0x5c, 0x59, 0xf1, 0x81, 0xbf, 0x82, 0x85, 0x48, 0x1b, 0x09, 0xf0, 0x01, 0x4c,
-	0xa8, 0xd5, 0x49, 0x22, 0x5c, 0xeb, 0x89, 0x50, 0xf5, 0x93, 0xb6, 0xdb, 0x1f, 0xb8, 0x26, 0xf1,
-	0x15, 0x2c, 0x2b, 0x02, 0xdf, 0x3d, 0x80, 0x76, 0xf1, 0x96, 0x1b, 0xdd, 0xc8, 0x89, 0x4a, 0x9f,
-	0x63, 0x95, 0x3e, 0x27, 0x9a, 0x06, 0x59, 0xe9, 0x73, 0x8f, 0xcd, 0xba, 0x5f, 0xe8, 0x4a, 0x8a,
-	0x25, 0x7e, 0xa6, 0x49, 0x4a, 0xe1, 0x6d, 0x24, 0xa5, 0xcf, 0x20, 0xad, 0x0c, 0xcb, 0xab, 0x38,
-	0x00, 0x23, 0xe5, 0x03, 0xed, 0x75, 0x60, 0x1e, 0x96, 0x77, 0xa8, 0x1f, 0x66, 0x01, 0xa4, 0x03,
-	0xb4, 0xff, 0xde, 0xd9, 0x35, 0x09, 0xba, 0x88, 0x7b, 0xac, 0x89, 0xf0, 0x2f, 0xd2, 0xcf, 0x35,
-	0xf9, 0xe0, 0xa3, 0x96, 0x48, 0x6a, 0x3f, 0x81, 0xe9, 0x70, 0x0f, 0x22, 0x03, 0xd9, 0x3b, 0xd5,
-	0x86, 0xfc, 0xc9, 0xb2, 0x38, 0x55, 0xed, 0x1c, 0xc6, 0x57, 0x61, 0xce, 0x47, 0xf0, 0x80, 0x77,
-	0x32, 0x3e, 0xb6, 0xef, 0xc3, 0x3b, 0xe1, 0x09, 0x89, 0x68, 0x0b, 0x52, 0xa2, 0xe9, 0x49, 0x54,
-	0x95, 0xa5, 0xb1, 0x34, 0xc1, 0xcb, 0x32, 0x87, 0x1e, 0x1c, 0xd1, 0x27, 0x7e, 0x4e, 0xda, 0x55,
-	0xae, 0x0c, 0x8b, 0x49, 0x36, 0x6e, 0x85, 0x04, 0xf0, 0x53, 0x98, 0xb5, 0x4c, 0xd7, 0x2b, 0x07,
-	0x89, 0x50, 0xbd, 0xc7, 0xb9, 0x9e, 0x68, 0x1e, 0x9a, 0xae, 0xd7, 0xe9, 0x74, 0xc6, 0x0a, 0x0f,
-	0xe1, 0xcf, 0x24, 0xc6, 0x22, 0xeb, 0x08, 0xa3, 0x5a, 0x86, 0x9b, 0x30, 0xcd, 0xbb, 0xc5, 0xee,
-	0x52, 0x3b, 0xc5, 0xc7, 0x95, 0x86, 0xa1, 0xea, 0xf7, 0x1f, 0xdd, 0xbe, 0x82, 0x26, 0x07, 0xa4,
-	0x33, 0xfb, 0x90, 0x4a, 0x12, 0xb8, 0x77, 0xbd, 0x63, 0xcb, 0x59, 0x6f, 0xc6, 0xb6, 0xb2, 0x0f,
-	0x29, 0x5e, 0x6a, 0xbf, 0x0e, 0x31, 0x47, 0xaa, 0xd4, 0xa9, 0x05, 0xd7, 0xcc, 0x94, 0x39, 0xbc,
-	0x6b, 0x3a, 0x06, 0xc1, 0xc8, 0xe0, 0x08, 0x0e, 0x60, 0x45, 0xa5, 0xc9, 0xd3, 0x72, 0xc1, 0xae,
-	0x3d, 0xa2, 0x76, 0x35, 0x49, 0xe7, 0x9a, 0x81, 0x51, 0x9b, 0x2d, 0xe5, 0xcf, 0x6d, 0xa4, 0x24,
-	0x3e, 0xf0, 0xa1, 0x2c, 0x1a, 0xd1, 0x4e, 0xdf, 0x1c, 0x78, 0x25, 0x87, 0x15, 0x79, 0xbf, 0x4b,
-	0xcc, 0x5a, 0xfb, 0xb0, 0xdf, 0x54, 0x0e, 0xfb, 0x8d, 0xa6, 0x9e, 0x92, 0xb2, 0x8d, 0x24, 0x72,
-	0x07, 0x26, 0x65, 0xff, 0xcd, 0xc7, 0xfd, 0x82, 0x3a, 0xeb, 0x17, 0x54, 0xd5, 0x66, 0xa2, 0xd2,
-	0xfe, 0x70, 0xdf, 0x5c, 0xc6, 0x2a, 0xc8, 0x53, 0xdc, 0x23, 0x9e, 0xb2, 0x5b, 0xb1, 0xc5, 0x04,
-	0x80, 0x1f, 0x8e, 0x4e, 0x99, 0xc0, 0xc2, 0x31, 0xa1, 0xc8, 0x04, 0xfc, 0xe3, 0x76, 0xa1, 0x8f,
-	0x70, 0x21, 0xa9, 0x7e, 0x00, 0x13, 0x2a, 0x55, 0x19, 0xd4, 0x48, 0xa6, 0x69, 0x85, 0x69, 0xfe,
-	0x7f, 0x3a, 0x8c, 0x72, 0xef, 0xe8, 0xa9, 0x06, 0x29, 0x51, 0x21, 0x91, 0xd1, 0xf3, 0xb0, 0xbb,
-	0xc5, 0x86, 0xfe, 0x7e, 0x72, 0x03, 0x81, 0x17, 0xaf, 0xfe, 0xe2, 0xef, 0xff, 0xfd, 0xd5, 0xf0,
-	0x12, 0x5a, 0x30, 0xd8, 0xfa, 0xf7, 0xb8, 0xa9, 0x11, 0x12, 0x6d, 0xe8, 0x1f, 0x1a, 0xa0, 0xee,
-	0xfe, 0x1c, 0x6d, 0xf5, 0xdf, 0x2d, 0x56, 0x9b, 0xe8, 0x1f, 0x5f, 0xce, 0x58, 0xc2, 0xfe, 0x94,
-	0xc3, 0xfe, 0x04, 0x6d, 0x47, 0xc2, 0x96, 0x7d, 0x76, 0xa5, 0xa5, 0x64, 0x31, 0xe3, 0xbc, 0x4b,
-	0x43, 0x5c, 0xa0, 0xbf, 0x6a, 0x30, 0x1d, 0x6e, 0x79, 0xd1, 0xdd, 0xfe, 0xc8, 0x62, 0x7a, 0x6e,
-	0xfd, 0xa3, 0xcb, 0x98, 0x4a, 0x4a, 0xbb, 0x9c, 0xd2, 0x36, 0xda, 0x8a, 0xa4, 0x14, 0xf4, 0xda,
-	0x8c, 0x95, 0x98, 0x3b, 0xef, 0x6a, 0xef, 0x2f, 0xd0, 0x9f, 0x35, 0x40, 0xdd, 0x2d, 0x76, 0x92,
-	0x93, 0x8a, 0x6d, 0xdd, 0x93, 0x9c, 0x54, 0x7c, 0x57, 0x8f, 0x37, 0x39, 0xad, 0x0d, 0x74, 0x33,
-	0x92, 0x96, 0x69, 0x59, 0xe5, 0x70, 0xd3, 0x8f, 0x7e, 0xa7, 0xc1, 0x54, 0xa8, 0x29, 0x47, 0x9b,
-	0xfd, 0x41, 0x84, 0x4c, 0xf4, 0xbb, 0x03, 0x9b, 0x04, 0xa0, 0x6f, 0x71, 0xd0, 0x37, 0xd0, 0xb7,
-	0x23, 0x41, 0xbb, 0x21, 0x6c, 0xff, 0xd6, 0x60, 0x2e, 0xb2, 0x7b, 0x47, 0x3b, 0xfd, 0x21, 0xf4,
-	0x92, 0x0d, 0xfa, 0x27, 0x97, 0xb6, 0x4f, 0x74, 0xa9, 0xea, 0xc4, 0x2b, 0x57, 0xad, 0x06, 0xb1,
-	0x3d, 0xd9, 0xd2, 0x97, 0x0f, 0xa9, 0xe3, 0xdf, 0x2e, 0xbf, 0xa0, 0x5d, 0xa0, 0xdf, 0x6b, 0x30,
-	0xd9, 0xb1, 0x0d, 0xfa, 0x60, 0x40, 0x5c, 0x3e, 0x9f, 0x0f, 0x07, 0xb6, 0x4b, 0x74, 0x20, 0x9c,
-	0x47, 0x5b, 0x98, 0xa0, 0x67, 0x5a, 0x47, 0xd3, 0x8c, 0x92, 0x6d, 0xdb, 0xdd, 0xe4, 0xeb, 0x77,
-	0x06, 0x37, 0x94, 0x80, 0xdf, 0xe7, 0x80, 0xd7, 0xd1, 0x5a, 0x24, 0x60, 0x45, 0x66, 0x18, 0xe7,
-	0x5c, 0xd9, 0x5c, 0xb0, 0x5b, 0x7f, 0x45, 0xf1, 0x54, 0xb0, 0xac, 0x24, 0xb8, 0x23, 0xc5, 0x49,
-	0x12, 0xdc, 0xd1, 0x72, 0x03, 0xaf, 0x71, 0xdc, 0x18, 0xad, 0xf4, 0xc3, 0x8d, 0xfe, 0xa8, 0xc1,
-	0x54, 0xa8, 0x13, 0x4f, 0x92, 0x67, 0x62, 0x25, 0x43, 0x92, 0x3c, 0x13, 0x2f, 0x26, 0xf0, 0x7b,
-	0x1c, 0xf8, 0xbb, 0xe8, 0x7a, 0x24, 0xf0, 0xb0, 0xce, 0x40, 0xbf, 0xd6, 0x20, 0x25, 0xfa, 0x77,
-	0x94, 0x4f, 0xb4, 0x6f, 0x87, 0x84, 0xd0, 0x6f, 0x0f, 0x64, 0x93, 0xa8, 0xd6, 0x0a, 0x15, 0x81,
-	0xfe, 0xa2, 0xc1, 0x4c, 0x97, 0x3e, 0x40, 0x09, 0x0a, 0x4b, 0x9c, 0xec, 0xd0, 0xb7, 0x2e, 0x65,
-	0x2b, 0x31, 0xdf, 0xe5, 0x98, 0x6f, 0xa3, 0x4d, 0x15, 0xb3, 0xef, 0x45, 0x49, 0x89, 0x47, 0xf4,
-	0x49, 0x48, 0xb4, 0xa0, 0xbf, 0x69, 0x30, 0xd3, 0xa5, 0x0d, 0x92, 0x30, 0x89, 0x13, 0x27, 0x49,
-	0x98, 0xc4, 0x8a, 0x91, 0x3e, 0xa9, 0x50, 0x34, 0xda, 0xe1, 0x8e, 0x21, 0xa4, 0x84, 0x2e, 0xd0,
-	0x9f, 0x34, 0x40, 0x7b, 0xc4, 0x0b, 0xc9, 0x0d, 0x94, 0xec, 0xbd, 0x45, 0x08, 0x98, 0x24, 0x45,
-	0x2a, 0x46, 0xdb, 0xe0, 0x3c, 0x27, 0x74, 0x0b, 0xad, 0xc7, 0xe6, 0x44, 0x56, 0x5d, 0x05, 0x07,
-	0x47, 0x02, 0x7d, 0xa9, 0xc1, 0x1c, 0x77, 0xe6, 0x86, 0x44, 0x07, 0xda, 0x4e, 0x1c, 0xdb, 0x28,
-	0x05, 0xa4, 0xef, 0x5c, 0xd6, 0x5c, 0x92, 0xb9, 0xcf, 0xc9, 0x14, 0xd1, 0x77, 0x7a, 0x9f, 0x8e,
-	0x78, 0xc2, 0xa6, 0x5d, 0x2b, 0x73, 0x1d, 0xa5, 0x54, 0x29, 0xe3, 0x9c, 0x8f, 0x5c, 0xb0, 0xbc,
-	0x14, 0x1c, 0x91, 0xa2, 0x24, 0x3e, 0x4c, 0x18, 0xe8, 0xb0, 0x48, 0xd2, 0xef, 0x0c, 0x6e, 0x38,
-	0xe0, 0x01, 0x29, 0xca, 0x08, 0xfd, 0x4b, 0x83, 0x4c, 0x94, 0xc0, 0x48, 0x72, 0x3e, 0x3d, 0xb4,
-	0x8d, 0xbe, 0x73, 0x59, 0x73, 0xc9, 0xa5, 0xc8, 0xb9, 0x7c, 0x8c, 0x3e, 0x8a, 0xe5, 0xa2, 0xf2,
-	0x60, 0x47, 0xc5, 0x44, 0x14, 0x7b, 0x42, 0xbe, 0xa0, 0xba, 0x40, 0xbf, 0xd4, 0x60, 0x94, 0xff,
-	0x23, 0x05, 0xe5, 0x12, 0xe8, 0x14, 0xe5, 0x3f, 0x43, 0xba, 0x91, 0x78, 0xbd, 0x84, 0x8b, 0x39,
-	0xdc, 0x45, 0xa4, 0x47, 0xcb, 0x1a, 0xb6, 0xb6, 0xb8, 0xff, 0xfc, 0x55, 0x56, 0x7b, 0xf1, 0x2a,
-	0xab, 0xbd, 0x7c, 0x95, 0xd5, 0x9e, 0xbe, 0xce, 0x0e, 0xbd, 0x78, 0x9d, 0x1d, 0xfa, 0xe7, 0xeb,
-	0xec, 0xd0, 0x8f, 0x8c, 0x7a, 0xc3, 0x3b, 0x3a, 0xa9, 0x30, 0xd1, 0x16, 0x99, 0xf6, 0xce, 0xda,
-	0xae, 0xbc, 0x56, 0x93, 0xb8, 0x95, 0x14, 0xff, 0xb7, 0xd6, 0xed, 0xff, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x1c, 0x38, 0x4c, 0x21, 0x32, 0x1c, 0x00, 0x00,
+func (m *QueryGetChainStateRequest) Reset()         { *m = QueryGetChainStateRequest{} }
+func (m *QueryGetChainStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainStateRequest) ProtoMessage()    {}
+func (*QueryGetChainStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{41}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error)
-	// Queries a list of ObserversByChainAndType items.
-	ObserversByChain(ctx context.Context, in *QueryObserversByChainRequest, opts ...grpc.CallOption) (*QueryObserversByChainResponse, error)
-	AllObserverMappers(ctx context.Context, in *QueryAllObserverMappersRequest, opts ...grpc.CallOption) (*QueryAllObserverMappersResponse, error)
-	SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error)
-	// Queries a list of GetClientParamsForChain items.
-	GetCoreParamsForChain(ctx context.Context, in *QueryGetCoreParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsForChainResponse, error)
-	// Queries a list of GetCoreParams items.
-	GetCoreParams(ctx context.Context, in *QueryGetCoreParamsRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsResponse, error)
-	// Queries a nodeAccount by index.
-	NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error)
-	// Queries a list of nodeAccount items.
-	NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error)
-	CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error)
-	// Queries a keygen by index.
-	Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error)
-	// Queries a list of ShowObserverCount items.
-	ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error)
-	GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error)
-	GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error)
-	// merkle proof verification
-	Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error)
+func (m *QueryGetChainStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetChainStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetChainStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainStateRequest.Merge(m, src)
+}
+func (m *QueryGetChainStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChainStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainStateRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetChainStateRequest proto.InternalMessageInfo
+
+func (m *QueryGetChainStateRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error) {
-	out := new(QueryBallotByIdentifierResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotByIdentifier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetChainStateResponse struct {
+	ChainState *ChainState `protobuf:"bytes,1,opt,name=chain_state,json=chainState,proto3" json:"chain_state,omitempty"`
 }
 
-func (c *queryClient) ObserversByChain(ctx context.Context, in *QueryObserversByChainRequest, opts ...grpc.CallOption) (*QueryObserversByChainResponse, error) {
-	out := new(QueryObserversByChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserversByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetChainStateResponse) Reset()         { *m = QueryGetChainStateResponse{} }
+func (m *QueryGetChainStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainStateResponse) ProtoMessage()    {}
+func (*QueryGetChainStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{42}
 }
-
-func (c *queryClient) AllObserverMappers(ctx context.Context, in *QueryAllObserverMappersRequest, opts ...grpc.CallOption) (*QueryAllObserverMappersResponse, error) {
-	out := new(QueryAllObserverMappersResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/AllObserverMappers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetChainStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error) {
-	out := new(QuerySupportedChainsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/SupportedChains", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChainStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GetCoreParamsForChain(ctx context.Context, in *QueryGetCoreParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsForChainResponse, error) {
-	out := new(QueryGetCoreParamsForChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetCoreParamsForChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetChainStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainStateResponse.Merge(m, src)
 }
-
-func (c *queryClient) GetCoreParams(ctx context.Context, in *QueryGetCoreParamsRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsResponse, error) {
-	out := new(QueryGetCoreParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetCoreParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetChainStateResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error) {
-	out := new(QueryGetNodeAccountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetChainStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainStateResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error) {
-	out := new(QueryAllNodeAccountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccountAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryGetChainStateResponse proto.InternalMessageInfo
 
-func (c *queryClient) CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error) {
-	out := new(QueryGetCrosschainFlagsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/CrosschainFlags", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChainStateResponse) GetChainState() *ChainState {
+	if m != nil {
+		return m.ChainState
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error) {
-	out := new(QueryGetKeygenResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Keygen", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetChainInfoRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (c *queryClient) ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error) {
-	out := new(QueryShowObserverCountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ShowObserverCount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetChainInfoRequest) Reset()         { *m = QueryGetChainInfoRequest{} }
+func (m *QueryGetChainInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainInfoRequest) ProtoMessage()    {}
+func (*QueryGetChainInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{43}
 }
-
-func (c *queryClient) BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error) {
-	out := new(QueryBlameByIdentifierResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlameByIdentifier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetChainInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error) {
-	out := new(QueryAllBlameRecordsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlameRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChainInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetChainInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainInfoRequest.Merge(m, src)
+}
+func (m *QueryGetChainInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChainInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainInfoRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error) {
-	out := new(QueryBlameByChainAndNonceResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetChainInfoRequest proto.InternalMessageInfo
+
+func (m *QueryGetChainInfoRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error) {
-	out := new(QueryAllBlockHeaderResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlockHeaders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryGetChainInfoResponse struct {
+	ChainInfo common.ChainInfo `protobuf:"bytes,1,opt,name=chain_info,json=chainInfo,proto3" json:"chain_info"`
 }
 
-func (c *queryClient) GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error) {
-	out := new(QueryGetBlockHeaderByHashResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetChainInfoResponse) Reset()         { *m = QueryGetChainInfoResponse{} }
+func (m *QueryGetChainInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainInfoResponse) ProtoMessage()    {}
+func (*QueryGetChainInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{44}
+}
+func (m *QueryGetChainInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChainInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetChainInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainInfoResponse.Merge(m, src)
+}
+func (m *QueryGetChainInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChainInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainInfoResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error) {
-	out := new(QueryProveResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Prove", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetChainInfoResponse proto.InternalMessageInfo
+
+func (m *QueryGetChainInfoResponse) GetChainInfo() common.ChainInfo {
+	if m != nil {
+		return m.ChainInfo
 	}
-	return out, nil
+	return common.ChainInfo{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BallotByIdentifier(context.Context, *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error)
-	// Queries a list of ObserversByChainAndType items.
-	ObserversByChain(context.Context, *QueryObserversByChainRequest) (*QueryObserversByChainResponse, error)
-	AllObserverMappers(context.Context, *QueryAllObserverMappersRequest) (*QueryAllObserverMappersResponse, error)
-	SupportedChains(context.Context, *QuerySupportedChains) (*QuerySupportedChainsResponse, error)
-	// Queries a list of GetClientParamsForChain items.
-	GetCoreParamsForChain(context.Context, *QueryGetCoreParamsForChainRequest) (*QueryGetCoreParamsForChainResponse, error)
-	// Queries a list of GetCoreParams items.
-	GetCoreParams(context.Context, *QueryGetCoreParamsRequest) (*QueryGetCoreParamsResponse, error)
-	// Queries a nodeAccount by index.
-	NodeAccount(context.Context, *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error)
-	// Queries a list of nodeAccount items.
-	NodeAccountAll(context.Context, *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error)
-	CrosschainFlags(context.Context, *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error)
-	// Queries a keygen by index.
-	Keygen(context.Context, *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error)
-	// Queries a list of ShowObserverCount items.
-	ShowObserverCount(context.Context, *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlameByIdentifier(context.Context, *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	GetAllBlameRecords(context.Context, *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlamesByChainAndNonce(context.Context, *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error)
-	GetAllBlockHeaders(context.Context, *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error)
-	GetBlockHeaderByHash(context.Context, *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error)
-	// merkle proof verification
-	Prove(context.Context, *QueryProveRequest) (*QueryProveResponse, error)
+type QueryAllChainInfoRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllChainInfoRequest) Reset()         { *m = QueryAllChainInfoRequest{} }
+func (m *QueryAllChainInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChainInfoRequest) ProtoMessage()    {}
+func (*QueryAllChainInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{45}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllChainInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BallotByIdentifier(ctx context.Context, req *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BallotByIdentifier not implemented")
+func (m *QueryAllChainInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChainInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ObserversByChain(ctx context.Context, req *QueryObserversByChainRequest) (*QueryObserversByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ObserversByChain not implemented")
+func (m *QueryAllChainInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChainInfoRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AllObserverMappers(ctx context.Context, req *QueryAllObserverMappersRequest) (*QueryAllObserverMappersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllObserverMappers not implemented")
+func (m *QueryAllChainInfoRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) SupportedChains(ctx context.Context, req *QuerySupportedChains) (*QuerySupportedChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupportedChains not implemented")
+func (m *QueryAllChainInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChainInfoRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetCoreParamsForChain(ctx context.Context, req *QueryGetCoreParamsForChainRequest) (*QueryGetCoreParamsForChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCoreParamsForChain not implemented")
+
+var xxx_messageInfo_QueryAllChainInfoRequest proto.InternalMessageInfo
+
+type QueryAllChainInfoResponse struct {
+	ChainInfo []common.ChainInfo `protobuf:"bytes,1,rep,name=chain_info,json=chainInfo,proto3" json:"chain_info"`
 }
-func (*UnimplementedQueryServer) GetCoreParams(ctx context.Context, req *QueryGetCoreParamsRequest) (*QueryGetCoreParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCoreParams not implemented")
+
+func (m *QueryAllChainInfoResponse) Reset()         { *m = QueryAllChainInfoResponse{} }
+func (m *QueryAllChainInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChainInfoResponse) ProtoMessage()    {}
+func (*QueryAllChainInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{46}
 }
-func (*UnimplementedQueryServer) NodeAccount(ctx context.Context, req *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeAccount not implemented")
+func (m *QueryAllChainInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) NodeAccountAll(ctx context.Context, req *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeAccountAll not implemented")
+func (m *QueryAllChainInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChainInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) CrosschainFlags(ctx context.Context, req *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFlags not implemented")
+func (m *QueryAllChainInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChainInfoResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Keygen(ctx context.Context, req *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Keygen not implemented")
+func (m *QueryAllChainInfoResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ShowObserverCount(ctx context.Context, req *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowObserverCount not implemented")
+func (m *QueryAllChainInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChainInfoResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) BlameByIdentifier(ctx context.Context, req *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlameByIdentifier not implemented")
+
+var xxx_messageInfo_QueryAllChainInfoResponse proto.InternalMessageInfo
+
+func (m *QueryAllChainInfoResponse) GetChainInfo() []common.ChainInfo {
+	if m != nil {
+		return m.ChainInfo
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetAllBlameRecords(ctx context.Context, req *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlameRecords not implemented")
+
+type QueryGetBallotSummaryRequest struct {
+	BallotIdentifier string `protobuf:"bytes,1,opt,name=ballot_identifier,json=ballotIdentifier,proto3" json:"ballot_identifier,omitempty"`
 }
-func (*UnimplementedQueryServer) BlamesByChainAndNonce(ctx context.Context, req *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlamesByChainAndNonce not implemented")
+
+func (m *QueryGetBallotSummaryRequest) Reset()         { *m = QueryGetBallotSummaryRequest{} }
+func (m *QueryGetBallotSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBallotSummaryRequest) ProtoMessage()    {}
+func (*QueryGetBallotSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{47}
 }
-func (*UnimplementedQueryServer) GetAllBlockHeaders(ctx context.Context, req *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlockHeaders not implemented")
+func (m *QueryGetBallotSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetBlockHeaderByHash(ctx context.Context, req *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderByHash not implemented")
+func (m *QueryGetBallotSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBallotSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Prove(ctx context.Context, req *QueryProveRequest) (*QueryProveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Prove not implemented")
+func (m *QueryGetBallotSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBallotSummaryRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGetBallotSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBallotSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBallotSummaryRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryGetBallotSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryGetBallotSummaryRequest) GetBallotIdentifier() string {
+	if m != nil {
+		return m.BallotIdentifier
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BallotByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBallotByIdentifierRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BallotByIdentifier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BallotByIdentifier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BallotByIdentifier(ctx, req.(*QueryBallotByIdentifierRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetBallotSummaryResponse struct {
+	BallotSummary BallotSummary `protobuf:"bytes,1,opt,name=ballot_summary,json=ballotSummary,proto3" json:"ballot_summary"`
 }
 
-func _Query_ObserversByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryObserversByChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ObserversByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ObserversByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ObserversByChain(ctx, req.(*QueryObserversByChainRequest))
+func (m *QueryGetBallotSummaryResponse) Reset()         { *m = QueryGetBallotSummaryResponse{} }
+func (m *QueryGetBallotSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBallotSummaryResponse) ProtoMessage()    {}
+func (*QueryGetBallotSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{48}
+}
+func (m *QueryGetBallotSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBallotSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBallotSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetBallotSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBallotSummaryResponse.Merge(m, src)
+}
+func (m *QueryGetBallotSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBallotSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBallotSummaryResponse.DiscardUnknown(m)
 }
 
-func _Query_AllObserverMappers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllObserverMappersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllObserverMappers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/AllObserverMappers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllObserverMappers(ctx, req.(*QueryAllObserverMappersRequest))
+var xxx_messageInfo_QueryGetBallotSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryGetBallotSummaryResponse) GetBallotSummary() BallotSummary {
+	if m != nil {
+		return m.BallotSummary
 	}
-	return interceptor(ctx, in, info, handler)
+	return BallotSummary{}
 }
 
-func _Query_SupportedChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySupportedChains)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SupportedChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/SupportedChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SupportedChains(ctx, req.(*QuerySupportedChains))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllBallotSummaryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_GetCoreParamsForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCoreParamsForChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCoreParamsForChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetCoreParamsForChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCoreParamsForChain(ctx, req.(*QueryGetCoreParamsForChainRequest))
+func (m *QueryAllBallotSummaryRequest) Reset()         { *m = QueryAllBallotSummaryRequest{} }
+func (m *QueryAllBallotSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBallotSummaryRequest) ProtoMessage()    {}
+func (*QueryAllBallotSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{49}
+}
+func (m *QueryAllBallotSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBallotSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBallotSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllBallotSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBallotSummaryRequest.Merge(m, src)
+}
+func (m *QueryAllBallotSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBallotSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBallotSummaryRequest.DiscardUnknown(m)
 }
 
-func _Query_GetCoreParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCoreParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCoreParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetCoreParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCoreParams(ctx, req.(*QueryGetCoreParamsRequest))
+var xxx_messageInfo_QueryAllBallotSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryAllBallotSummaryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_NodeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetNodeAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NodeAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NodeAccount(ctx, req.(*QueryGetNodeAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllBallotSummaryResponse struct {
+	BallotSummary []BallotSummary     `protobuf:"bytes,1,rep,name=ballot_summary,json=ballotSummary,proto3" json:"ballot_summary"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_NodeAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllNodeAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NodeAccountAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccountAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NodeAccountAll(ctx, req.(*QueryAllNodeAccountRequest))
+func (m *QueryAllBallotSummaryResponse) Reset()         { *m = QueryAllBallotSummaryResponse{} }
+func (m *QueryAllBallotSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBallotSummaryResponse) ProtoMessage()    {}
+func (*QueryAllBallotSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{50}
+}
+func (m *QueryAllBallotSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBallotSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBallotSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllBallotSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBallotSummaryResponse.Merge(m, src)
+}
+func (m *QueryAllBallotSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBallotSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBallotSummaryResponse.DiscardUnknown(m)
 }
 
-func _Query_CrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCrosschainFlagsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CrosschainFlags(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/CrosschainFlags",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CrosschainFlags(ctx, req.(*QueryGetCrosschainFlagsRequest))
+var xxx_messageInfo_QueryAllBallotSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryAllBallotSummaryResponse) GetBallotSummary() []BallotSummary {
+	if m != nil {
+		return m.BallotSummary
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Keygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetKeygenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Keygen(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/Keygen",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Keygen(ctx, req.(*QueryGetKeygenRequest))
+func (m *QueryAllBallotSummaryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ShowObserverCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryShowObserverCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ShowObserverCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ShowObserverCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ShowObserverCount(ctx, req.(*QueryShowObserverCountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetAdminProposalRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_BlameByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlameByIdentifierRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlameByIdentifier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BlameByIdentifier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlameByIdentifier(ctx, req.(*QueryBlameByIdentifierRequest))
+func (m *QueryGetAdminProposalRequest) Reset()         { *m = QueryGetAdminProposalRequest{} }
+func (m *QueryGetAdminProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAdminProposalRequest) ProtoMessage()    {}
+func (*QueryGetAdminProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{51}
+}
+func (m *QueryGetAdminProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAdminProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAdminProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetAdminProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAdminProposalRequest.Merge(m, src)
+}
+func (m *QueryGetAdminProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAdminProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAdminProposalRequest.DiscardUnknown(m)
 }
 
-func _Query_GetAllBlameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlameRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllBlameRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlameRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllBlameRecords(ctx, req.(*QueryAllBlameRecordsRequest))
+var xxx_messageInfo_QueryGetAdminProposalRequest proto.InternalMessageInfo
+
+func (m *QueryGetAdminProposalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_BlamesByChainAndNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlameByChainAndNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlamesByChainAndNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce",
+type QueryGetAdminProposalResponse struct {
+	AdminProposal AdminProposal `protobuf:"bytes,1,opt,name=admin_proposal,json=adminProposal,proto3" json:"admin_proposal"`
+}
+
+func (m *QueryGetAdminProposalResponse) Reset()         { *m = QueryGetAdminProposalResponse{} }
+func (m *QueryGetAdminProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAdminProposalResponse) ProtoMessage()    {}
+func (*QueryGetAdminProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{52}
+}
+func (m *QueryGetAdminProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAdminProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAdminProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlamesByChainAndNonce(ctx, req.(*QueryBlameByChainAndNonceRequest))
+}
+func (m *QueryGetAdminProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAdminProposalResponse.Merge(m, src)
+}
+func (m *QueryGetAdminProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAdminProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAdminProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAdminProposalResponse proto.InternalMessageInfo
+
+func (m *QueryGetAdminProposalResponse) GetAdminProposal() AdminProposal {
+	if m != nil {
+		return m.AdminProposal
 	}
-	return interceptor(ctx, in, info, handler)
+	return AdminProposal{}
 }
 
-func _Query_GetAllBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlockHeaderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryPendingAdminProposalsRequest struct {
+	// filter the proposals by policy type when set
+	FilterPolicyType bool               `protobuf:"varint,1,opt,name=filter_policy_type,json=filterPolicyType,proto3" json:"filter_policy_type,omitempty"`
+	PolicyType       Policy_Type        `protobuf:"varint,2,opt,name=policy_type,json=policyType,proto3,enum=zetachain.zetacore.observer.Policy_Type" json:"policy_type,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingAdminProposalsRequest) Reset()         { *m = QueryPendingAdminProposalsRequest{} }
+func (m *QueryPendingAdminProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingAdminProposalsRequest) ProtoMessage()    {}
+func (*QueryPendingAdminProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{53}
+}
+func (m *QueryPendingAdminProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingAdminProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingAdminProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllBlockHeaders(ctx, in)
+}
+func (m *QueryPendingAdminProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingAdminProposalsRequest.Merge(m, src)
+}
+func (m *QueryPendingAdminProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingAdminProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingAdminProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingAdminProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingAdminProposalsRequest) GetFilterPolicyType() bool {
+	if m != nil {
+		return m.FilterPolicyType
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlockHeaders",
+	return false
+}
+
+func (m *QueryPendingAdminProposalsRequest) GetPolicyType() Policy_Type {
+	if m != nil {
+		return m.PolicyType
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllBlockHeaders(ctx, req.(*QueryAllBlockHeaderRequest))
+	return Policy_Type_group1
+}
+
+func (m *QueryPendingAdminProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetBlockHeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBlockHeaderByHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryPendingAdminProposalsResponse struct {
+	AdminProposals []AdminProposal     `protobuf:"bytes,1,rep,name=admin_proposals,json=adminProposals,proto3" json:"admin_proposals"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingAdminProposalsResponse) Reset()         { *m = QueryPendingAdminProposalsResponse{} }
+func (m *QueryPendingAdminProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingAdminProposalsResponse) ProtoMessage()    {}
+func (*QueryPendingAdminProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{54}
+}
+func (m *QueryPendingAdminProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingAdminProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingAdminProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBlockHeaderByHash(ctx, in)
+}
+func (m *QueryPendingAdminProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingAdminProposalsResponse.Merge(m, src)
+}
+func (m *QueryPendingAdminProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingAdminProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingAdminProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingAdminProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingAdminProposalsResponse) GetAdminProposals() []AdminProposal {
+	if m != nil {
+		return m.AdminProposals
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash",
+	return nil
+}
+
+func (m *QueryPendingAdminProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBlockHeaderByHash(ctx, req.(*QueryGetBlockHeaderByHashRequest))
+	return nil
+}
+
+type QueryAdminSignerSetsRequest struct {
+}
+
+func (m *QueryAdminSignerSetsRequest) Reset()         { *m = QueryAdminSignerSetsRequest{} }
+func (m *QueryAdminSignerSetsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminSignerSetsRequest) ProtoMessage()    {}
+func (*QueryAdminSignerSetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{55}
+}
+func (m *QueryAdminSignerSetsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminSignerSetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminSignerSetsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAdminSignerSetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminSignerSetsRequest.Merge(m, src)
+}
+func (m *QueryAdminSignerSetsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminSignerSetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminSignerSetsRequest.DiscardUnknown(m)
 }
 
-func _Query_Prove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAdminSignerSetsRequest proto.InternalMessageInfo
+
+type QueryAdminSignerSetsResponse struct {
+	SignerSets []AdminSignerSet `protobuf:"bytes,1,rep,name=signer_sets,json=signerSets,proto3" json:"signer_sets"`
+}
+
+func (m *QueryAdminSignerSetsResponse) Reset()         { *m = QueryAdminSignerSetsResponse{} }
+func (m *QueryAdminSignerSetsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminSignerSetsResponse) ProtoMessage()    {}
+func (*QueryAdminSignerSetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{56}
+}
+func (m *QueryAdminSignerSetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminSignerSetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminSignerSetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Prove(ctx, in)
+}
+func (m *QueryAdminSignerSetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminSignerSetsResponse.Merge(m, src)
+}
+func (m *QueryAdminSignerSetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminSignerSetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminSignerSetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminSignerSetsResponse proto.InternalMessageInfo
+
+func (m *QueryAdminSignerSetsResponse) GetSignerSets() []AdminSignerSet {
+	if m != nil {
+		return m.SignerSets
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/Prove",
+	return nil
+}
+
+type QueryGetNodeBlameScoreRequest struct {
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *QueryGetNodeBlameScoreRequest) Reset()         { *m = QueryGetNodeBlameScoreRequest{} }
+func (m *QueryGetNodeBlameScoreRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNodeBlameScoreRequest) ProtoMessage()    {}
+func (*QueryGetNodeBlameScoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{57}
+}
+func (m *QueryGetNodeBlameScoreRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetNodeBlameScoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetNodeBlameScoreRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Prove(ctx, req.(*QueryProveRequest))
+}
+func (m *QueryGetNodeBlameScoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNodeBlameScoreRequest.Merge(m, src)
+}
+func (m *QueryGetNodeBlameScoreRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetNodeBlameScoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNodeBlameScoreRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetNodeBlameScoreRequest proto.InternalMessageInfo
+
+func (m *QueryGetNodeBlameScoreRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.observer.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "BallotByIdentifier",
-			Handler:    _Query_BallotByIdentifier_Handler,
-		},
-		{
-			MethodName: "ObserversByChain",
-			Handler:    _Query_ObserversByChain_Handler,
-		},
-		{
-			MethodName: "AllObserverMappers",
-			Handler:    _Query_AllObserverMappers_Handler,
-		},
-		{
-			MethodName: "SupportedChains",
-			Handler:    _Query_SupportedChains_Handler,
-		},
-		{
-			MethodName: "GetCoreParamsForChain",
-			Handler:    _Query_GetCoreParamsForChain_Handler,
-		},
-		{
-			MethodName: "GetCoreParams",
-			Handler:    _Query_GetCoreParams_Handler,
-		},
-		{
-			MethodName: "NodeAccount",
-			Handler:    _Query_NodeAccount_Handler,
-		},
-		{
-			MethodName: "NodeAccountAll",
-			Handler:    _Query_NodeAccountAll_Handler,
-		},
-		{
-			MethodName: "CrosschainFlags",
-			Handler:    _Query_CrosschainFlags_Handler,
-		},
-		{
-			MethodName: "Keygen",
-			Handler:    _Query_Keygen_Handler,
-		},
-		{
-			MethodName: "ShowObserverCount",
-			Handler:    _Query_ShowObserverCount_Handler,
-		},
-		{
-			MethodName: "BlameByIdentifier",
-			Handler:    _Query_BlameByIdentifier_Handler,
-		},
-		{
-			MethodName: "GetAllBlameRecords",
-			Handler:    _Query_GetAllBlameRecords_Handler,
-		},
-		{
-			MethodName: "BlamesByChainAndNonce",
-			Handler:    _Query_BlamesByChainAndNonce_Handler,
-		},
-		{
-			MethodName: "GetAllBlockHeaders",
-			Handler:    _Query_GetAllBlockHeaders_Handler,
-		},
-		{
-			MethodName: "GetBlockHeaderByHash",
-			Handler:    _Query_GetBlockHeaderByHash_Handler,
-		},
-		{
-			MethodName: "Prove",
-			Handler:    _Query_Prove_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "observer/query.proto",
+type QueryGetNodeBlameScoreResponse struct {
+	// blames of the node in the blame window
+	NodeBlameScore NodeBlameScore `protobuf:"bytes,1,opt,name=node_blame_score,json=nodeBlameScore,proto3" json:"node_blame_score"`
+	// number of blames of the node in the blame window
+	Score uint64 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (m *QueryProveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetNodeBlameScoreResponse) Reset()         { *m = QueryGetNodeBlameScoreResponse{} }
+func (m *QueryGetNodeBlameScoreResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNodeBlameScoreResponse) ProtoMessage()    {}
+func (*QueryGetNodeBlameScoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{58}
 }
-
-func (m *QueryProveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetNodeBlameScoreResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryProveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Proof != nil {
-		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetNodeBlameScoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetNodeBlameScoreResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryProveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetNodeBlameScoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNodeBlameScoreResponse.Merge(m, src)
 }
-
-func (m *QueryProveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetNodeBlameScoreResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetNodeBlameScoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNodeBlameScoreResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Valid {
-		i--
-		if m.Valid {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryGetNodeBlameScoreResponse proto.InternalMessageInfo
+
+func (m *QueryGetNodeBlameScoreResponse) GetNodeBlameScore() NodeBlameScore {
+	if m != nil {
+		return m.NodeBlameScore
 	}
-	return len(dAtA) - i, nil
+	return NodeBlameScore{}
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetNodeBlameScoreResponse) GetScore() uint64 {
+	if m != nil {
+		return m.Score
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAllNodeBlameScoreRequest struct {
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllNodeBlameScoreRequest) Reset()         { *m = QueryAllNodeBlameScoreRequest{} }
+func (m *QueryAllNodeBlameScoreRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllNodeBlameScoreRequest) ProtoMessage()    {}
+func (*QueryAllNodeBlameScoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{59}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllNodeBlameScoreRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllNodeBlameScoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllNodeBlameScoreRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAllNodeBlameScoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllNodeBlameScoreRequest.Merge(m, src)
+}
+func (m *QueryAllNodeBlameScoreRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllNodeBlameScoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllNodeBlameScoreRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAllNodeBlameScoreRequest proto.InternalMessageInfo
+
+type QueryAllNodeBlameScoreResponse struct {
+	NodeBlameScores []NodeBlameScore `protobuf:"bytes,1,rep,name=node_blame_scores,json=nodeBlameScores,proto3" json:"node_blame_scores"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllNodeBlameScoreResponse) Reset()         { *m = QueryAllNodeBlameScoreResponse{} }
+func (m *QueryAllNodeBlameScoreResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllNodeBlameScoreResponse) ProtoMessage()    {}
+func (*QueryAllNodeBlameScoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{60}
+}
+func (m *QueryAllNodeBlameScoreResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllNodeBlameScoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllNodeBlameScoreResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllNodeBlameScoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllNodeBlameScoreResponse.Merge(m, src)
+}
+func (m *QueryAllNodeBlameScoreResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllNodeBlameScoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllNodeBlameScoreResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAllNodeBlameScoreResponse proto.InternalMessageInfo
+
+func (m *QueryAllNodeBlameScoreResponse) GetNodeBlameScores() []NodeBlameScore {
+	if m != nil {
+		return m.NodeBlameScores
+	}
+	return nil
 }
 
-func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryProveRequest)(nil), "zetachain.zetacore.observer.QueryProveRequest")
+	proto.RegisterType((*QueryProveResponse)(nil), "zetachain.zetacore.observer.QueryProveResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.observer.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.observer.QueryParamsResponse")
+	proto.RegisterType((*QueryBallotByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierRequest")
+	proto.RegisterType((*VoterList)(nil), "zetachain.zetacore.observer.VoterList")
+	proto.RegisterType((*QueryBallotByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierResponse")
+	proto.RegisterType((*QueryObserversByChainRequest)(nil), "zetachain.zetacore.observer.QueryObserversByChainRequest")
+	proto.RegisterType((*QueryObserversByChainResponse)(nil), "zetachain.zetacore.observer.QueryObserversByChainResponse")
+	proto.RegisterType((*QueryAllObserverMappersRequest)(nil), "zetachain.zetacore.observer.QueryAllObserverMappersRequest")
+	proto.RegisterType((*QueryAllObserverMappersResponse)(nil), "zetachain.zetacore.observer.QueryAllObserverMappersResponse")
+	proto.RegisterType((*QuerySupportedChains)(nil), "zetachain.zetacore.observer.QuerySupportedChains")
+	proto.RegisterType((*QuerySupportedChainsResponse)(nil), "zetachain.zetacore.observer.QuerySupportedChainsResponse")
+	proto.RegisterType((*QueryGetCoreParamsForChainRequest)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsForChainRequest")
+	proto.RegisterType((*QueryGetCoreParamsForChainResponse)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsForChainResponse")
+	proto.RegisterType((*QueryGetCoreParamsRequest)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsRequest")
+	proto.RegisterType((*QueryGetCoreParamsResponse)(nil), "zetachain.zetacore.observer.QueryGetCoreParamsResponse")
+	proto.RegisterType((*QueryGetNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountRequest")
+	proto.RegisterType((*QueryGetNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountResponse")
+	proto.RegisterType((*QueryAllNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountRequest")
+	proto.RegisterType((*QueryAllNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountResponse")
+	proto.RegisterType((*QueryGetCrosschainFlagsRequest)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsRequest")
+	proto.RegisterType((*QueryGetCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsResponse")
+	proto.RegisterType((*QueryGetChainCrosschainFlagsRequest)(nil), "zetachain.zetacore.observer.QueryGetChainCrosschainFlagsRequest")
+	proto.RegisterType((*QueryGetChainCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.QueryGetChainCrosschainFlagsResponse")
+	proto.RegisterType((*QueryAllChainCrosschainFlagsRequest)(nil), "zetachain.zetacore.observer.QueryAllChainCrosschainFlagsRequest")
+	proto.RegisterType((*QueryAllChainCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.QueryAllChainCrosschainFlagsResponse")
+	proto.RegisterType((*QueryGetKeygenRequest)(nil), "zetachain.zetacore.observer.QueryGetKeygenRequest")
+	proto.RegisterType((*QueryGetKeygenResponse)(nil), "zetachain.zetacore.observer.QueryGetKeygenResponse")
+	proto.RegisterType((*QueryShowObserverCountRequest)(nil), "zetachain.zetacore.observer.QueryShowObserverCountRequest")
+	proto.RegisterType((*QueryShowObserverCountResponse)(nil), "zetachain.zetacore.observer.QueryShowObserverCountResponse")
+	proto.RegisterType((*QueryBlameByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierRequest")
+	proto.RegisterType((*QueryBlameByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierResponse")
+	proto.RegisterType((*QueryAllBlameRecordsRequest)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsRequest")
+	proto.RegisterType((*QueryAllBlameRecordsResponse)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsResponse")
+	proto.RegisterType((*QueryBlameByChainAndNonceRequest)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceRequest")
+	proto.RegisterType((*QueryBlameByChainAndNonceResponse)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceResponse")
+	proto.RegisterType((*QueryAllBlockHeaderRequest)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderRequest")
+	proto.RegisterType((*QueryAllBlockHeaderResponse)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderResponse")
+	proto.RegisterType((*QueryGetBlockHeaderByHashRequest)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashRequest")
+	proto.RegisterType((*QueryGetBlockHeaderByHashResponse)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashResponse")
+	proto.RegisterType((*QueryGetChainStateRequest)(nil), "zetachain.zetacore.observer.QueryGetChainStateRequest")
+	proto.RegisterType((*QueryGetChainStateResponse)(nil), "zetachain.zetacore.observer.QueryGetChainStateResponse")
+	proto.RegisterType((*QueryGetChainInfoRequest)(nil), "zetachain.zetacore.observer.QueryGetChainInfoRequest")
+	proto.RegisterType((*QueryGetChainInfoResponse)(nil), "zetachain.zetacore.observer.QueryGetChainInfoResponse")
+	proto.RegisterType((*QueryAllChainInfoRequest)(nil), "zetachain.zetacore.observer.QueryAllChainInfoRequest")
+	proto.RegisterType((*QueryAllChainInfoResponse)(nil), "zetachain.zetacore.observer.QueryAllChainInfoResponse")
+	proto.RegisterType((*QueryGetBallotSummaryRequest)(nil), "zetachain.zetacore.observer.QueryGetBallotSummaryRequest")
+	proto.RegisterType((*QueryGetBallotSummaryResponse)(nil), "zetachain.zetacore.observer.QueryGetBallotSummaryResponse")
+	proto.RegisterType((*QueryAllBallotSummaryRequest)(nil), "zetachain.zetacore.observer.QueryAllBallotSummaryRequest")
+	proto.RegisterType((*QueryAllBallotSummaryResponse)(nil), "zetachain.zetacore.observer.QueryAllBallotSummaryResponse")
+	proto.RegisterType((*QueryGetAdminProposalRequest)(nil), "zetachain.zetacore.observer.QueryGetAdminProposalRequest")
+	proto.RegisterType((*QueryGetAdminProposalResponse)(nil), "zetachain.zetacore.observer.QueryGetAdminProposalResponse")
+	proto.RegisterType((*QueryPendingAdminProposalsRequest)(nil), "zetachain.zetacore.observer.QueryPendingAdminProposalsRequest")
+	proto.RegisterType((*QueryPendingAdminProposalsResponse)(nil), "zetachain.zetacore.observer.QueryPendingAdminProposalsResponse")
+	proto.RegisterType((*QueryAdminSignerSetsRequest)(nil), "zetachain.zetacore.observer.QueryAdminSignerSetsRequest")
+	proto.RegisterType((*QueryAdminSignerSetsResponse)(nil), "zetachain.zetacore.observer.QueryAdminSignerSetsResponse")
+	proto.RegisterType((*QueryGetNodeBlameScoreRequest)(nil), "zetachain.zetacore.observer.QueryGetNodeBlameScoreRequest")
+	proto.RegisterType((*QueryGetNodeBlameScoreResponse)(nil), "zetachain.zetacore.observer.QueryGetNodeBlameScoreResponse")
+	proto.RegisterType((*QueryAllNodeBlameScoreRequest)(nil), "zetachain.zetacore.observer.QueryAllNodeBlameScoreRequest")
+	proto.RegisterType((*QueryAllNodeBlameScoreResponse)(nil), "zetachain.zetacore.observer.QueryAllNodeBlameScoreResponse")
 }
 
-func (m *VoterList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("observer/query.proto", fileDescriptor_dcb801e455adaee4) }
+
+var fileDescriptor_dcb801e455adaee4 = []byte{
+	// 2692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x37, 0xa5, 0x48, 0xb1, 0x9e, 0xbe, 0xc7, 0xb2, 0x2d, 0x53, 0x9f, 0x1e, 0xc5, 0xb6, 0x6c,
+	0xc9, 0xbb, 0xd6, 0xba, 0xb6, 0xf5, 0x61, 0xcb, 0xde, 0x35, 0xfc, 0x15, 0x3b, 0x8e, 0xba, 0x6a,
+	0x93, 0xb6, 0x41, 0xbb, 0xe0, 0xee, 0x52, 0xab, 0x4d, 0x28, 0x72, 0x43, 0x52, 0x8a, 0xb6, 0x82,
+	0xd0, 0xa2, 0xe7, 0x1e, 0x02, 0x04, 0xed, 0xb9, 0xa7, 0xdc, 0xda, 0x43, 0x0e, 0x6d, 0x81, 0xa0,
+	0x87, 0xb6, 0x87, 0xfa, 0x14, 0xa4, 0x08, 0x50, 0xb4, 0x28, 0x5a, 0x04, 0x76, 0xfe, 0x8f, 0x16,
+	0xf3, 0x41, 0x72, 0xc8, 0x25, 0xa9, 0xd9, 0x8d, 0x4e, 0x22, 0x67, 0xe6, 0xbd, 0xf9, 0xfd, 0xde,
+	0x3c, 0xce, 0xbc, 0xf9, 0x69, 0x61, 0xcc, 0x2a, 0x3b, 0xba, 0xbd, 0xa7, 0xdb, 0xd9, 0x0f, 0x77,
+	0x75, 0xbb, 0x99, 0x69, 0xd8, 0x96, 0x6b, 0xa1, 0x89, 0x9f, 0xea, 0xae, 0x56, 0xd9, 0xd6, 0xea,
+	0x66, 0x86, 0x3e, 0x59, 0xb6, 0x9e, 0xf1, 0x06, 0xaa, 0xa7, 0x2a, 0xd6, 0xce, 0x8e, 0x65, 0x66,
+	0xd9, 0x1f, 0x66, 0xa1, 0x5e, 0xa9, 0x58, 0xce, 0x8e, 0xe5, 0x64, 0xcb, 0x9a, 0xa3, 0x33, 0x57,
+	0xd9, 0xbd, 0xa5, 0xb2, 0xee, 0x6a, 0x4b, 0xd9, 0x86, 0x56, 0xab, 0x9b, 0x9a, 0x5b, 0xf7, 0xc7,
+	0x8e, 0xd5, 0xac, 0x9a, 0x45, 0x1f, 0xb3, 0xe4, 0x89, 0xb7, 0x4e, 0xd6, 0x2c, 0xab, 0x66, 0xe8,
+	0x59, 0xad, 0x51, 0xcf, 0x6a, 0xa6, 0x69, 0xb9, 0xd4, 0xc4, 0xe1, 0xbd, 0x53, 0x3e, 0x4e, 0xad,
+	0xba, 0x53, 0x37, 0x4b, 0x0d, 0xdb, 0x6a, 0x58, 0x8e, 0x66, 0xf0, 0xee, 0xd3, 0x7e, 0x77, 0x59,
+	0x33, 0x0c, 0xcb, 0xf5, 0x66, 0x0a, 0x9a, 0x0d, 0x6d, 0x47, 0xe7, 0xad, 0x13, 0x42, 0xab, 0x55,
+	0xf9, 0xa0, 0xb4, 0xad, 0x6b, 0x55, 0xdd, 0xe6, 0x9d, 0x33, 0x7e, 0x67, 0xc5, 0xb6, 0x1c, 0x87,
+	0x06, 0xa1, 0xb4, 0x65, 0x68, 0x35, 0xa7, 0x65, 0xaa, 0x0f, 0xf4, 0x66, 0x4d, 0x37, 0x5b, 0x9c,
+	0x9a, 0x56, 0x55, 0x2f, 0x69, 0x95, 0x8a, 0xb5, 0x6b, 0x7a, 0x38, 0xce, 0xfa, 0x9d, 0xde, 0x43,
+	0x8b, 0xb3, 0x86, 0x66, 0x6b, 0x3b, 0x7c, 0x0e, 0xfc, 0xa9, 0x02, 0xa3, 0xdf, 0x25, 0x41, 0xdc,
+	0xb0, 0xad, 0x3d, 0xbd, 0xa8, 0x7f, 0xb8, 0xab, 0x3b, 0x2e, 0x3a, 0x07, 0x27, 0x19, 0x9c, 0x7a,
+	0x75, 0x5c, 0x99, 0x55, 0xe6, 0xbb, 0x8b, 0xaf, 0xd3, 0xf7, 0x27, 0x55, 0x74, 0x16, 0x5e, 0x77,
+	0xf7, 0x4b, 0xdb, 0x9a, 0xb3, 0x3d, 0xde, 0x35, 0xab, 0xcc, 0xf7, 0x15, 0x7b, 0xdd, 0xfd, 0xc7,
+	0x9a, 0xb3, 0x8d, 0xe6, 0xa0, 0xa7, 0x61, 0x5b, 0xd6, 0xd6, 0x78, 0xf7, 0xac, 0x32, 0xdf, 0x9f,
+	0x1b, 0xcc, 0xf0, 0x55, 0xdb, 0x20, 0x8d, 0x45, 0xd6, 0x87, 0xa6, 0x00, 0x78, 0x24, 0x88, 0x83,
+	0xd7, 0xa8, 0x83, 0x3e, 0xda, 0x42, 0x7d, 0x9c, 0x83, 0x93, 0xee, 0x7e, 0xa9, 0x6e, 0x56, 0xf5,
+	0xfd, 0xf1, 0x1e, 0x36, 0xaf, 0xbb, 0xff, 0x84, 0xbc, 0xe2, 0x2b, 0x80, 0x44, 0x9c, 0x4e, 0xc3,
+	0x32, 0x1d, 0x1d, 0x8d, 0x41, 0xcf, 0x9e, 0x66, 0x70, 0x94, 0x27, 0x8b, 0xec, 0x05, 0x8f, 0x79,
+	0x63, 0x29, 0x53, 0x4e, 0x0a, 0xff, 0x00, 0x4e, 0x85, 0x5a, 0xb9, 0x8b, 0x3c, 0xf4, 0xb2, 0x88,
+	0x50, 0x1f, 0xfd, 0xb9, 0xb9, 0x4c, 0x4a, 0x4a, 0x66, 0x98, 0x71, 0xe1, 0xb5, 0x17, 0xff, 0x9d,
+	0x39, 0x51, 0xe4, 0x86, 0xf8, 0x2d, 0x98, 0xa6, 0x9e, 0x0b, 0x34, 0x23, 0x0a, 0xcd, 0x27, 0x55,
+	0xdd, 0x74, 0xeb, 0x5b, 0x75, 0xdd, 0xf6, 0x02, 0xba, 0x00, 0xa3, 0x2c, 0x5d, 0x4a, 0x75, 0xbf,
+	0x8f, 0xce, 0xd7, 0x57, 0x1c, 0x61, 0x1d, 0x81, 0x0d, 0x76, 0xa1, 0xef, 0x1d, 0xcb, 0xd5, 0xed,
+	0x67, 0x75, 0xc7, 0x45, 0x73, 0x30, 0xb8, 0x47, 0x5e, 0x4a, 0x5a, 0xb5, 0x6a, 0xeb, 0x8e, 0xc3,
+	0xad, 0x06, 0x68, 0x63, 0x9e, 0xb5, 0xa1, 0x02, 0xf4, 0x91, 0xf7, 0x92, 0xdb, 0x6c, 0xe8, 0x74,
+	0x59, 0x86, 0x72, 0x17, 0x52, 0x69, 0x10, 0xff, 0xdf, 0x6b, 0x36, 0xf4, 0xe2, 0xc9, 0x3d, 0xfe,
+	0x84, 0xff, 0xd0, 0x05, 0x33, 0x89, 0x2c, 0x78, 0xac, 0xda, 0xa1, 0x81, 0xd6, 0xa1, 0x97, 0x82,
+	0x74, 0xc6, 0xbb, 0x66, 0xbb, 0xe7, 0xfb, 0x73, 0x17, 0x8f, 0x44, 0x44, 0x19, 0x17, 0xb9, 0x15,
+	0x7a, 0x17, 0x46, 0x58, 0x2f, 0xfd, 0x3c, 0x19, 0xb7, 0x6e, 0xca, 0x6d, 0x31, 0xd5, 0xd3, 0xdb,
+	0x81, 0x11, 0xa5, 0x38, 0x6c, 0x85, 0x1b, 0xd0, 0x73, 0x18, 0xe4, 0x2c, 0x1c, 0x57, 0x73, 0x77,
+	0x1d, 0x9a, 0x87, 0x43, 0xb9, 0xcb, 0xa9, 0x5e, 0x59, 0x54, 0x36, 0xa9, 0x41, 0x71, 0xa0, 0x2c,
+	0xbc, 0xe1, 0xa7, 0x30, 0x49, 0x03, 0xf7, 0x36, 0x1f, 0xeb, 0x14, 0x9a, 0xf7, 0x89, 0x17, 0x61,
+	0xf1, 0x45, 0x22, 0x74, 0x06, 0x2f, 0x6a, 0x42, 0x07, 0xb5, 0xc1, 0x77, 0x60, 0x2a, 0xc1, 0x19,
+	0x5f, 0x83, 0x49, 0xe8, 0xf3, 0x40, 0x91, 0x64, 0xe8, 0x26, 0x5f, 0x90, 0xdf, 0x80, 0x67, 0x79,
+	0x2a, 0xe6, 0x0d, 0xc3, 0xf3, 0xf0, 0x96, 0xd6, 0x68, 0xe8, 0xb6, 0xff, 0x19, 0x34, 0x61, 0x26,
+	0x71, 0x04, 0x9f, 0xe2, 0x1d, 0x2f, 0xf2, 0xba, 0x5d, 0xda, 0x61, 0x7d, 0x74, 0xa6, 0xfe, 0xdc,
+	0x82, 0x44, 0xe4, 0x3d, 0x7f, 0x5e, 0xe0, 0x7d, 0xff, 0xf8, 0x0c, 0x8c, 0xd1, 0xa9, 0x37, 0x77,
+	0x1b, 0x0d, 0xcb, 0x76, 0xf5, 0x2a, 0x65, 0xe6, 0xe0, 0x07, 0x30, 0x19, 0xd7, 0xee, 0xe3, 0xb9,
+	0x00, 0xbd, 0x74, 0x4a, 0x0f, 0x85, 0xbf, 0xb7, 0xb0, 0xc8, 0xf0, 0x4e, 0xbc, 0x0e, 0xe7, 0xa9,
+	0x9b, 0x47, 0xba, 0x7b, 0xdf, 0xb2, 0x75, 0xf6, 0xa9, 0x3e, 0xb4, 0xec, 0xd0, 0x62, 0x24, 0x6f,
+	0x6d, 0xd8, 0x04, 0x9c, 0x66, 0xcf, 0xc1, 0x3c, 0x86, 0x7e, 0xc2, 0xba, 0x14, 0xda, 0x34, 0x2e,
+	0xa5, 0xc6, 0x25, 0xf0, 0x56, 0x84, 0x8a, 0xff, 0x8c, 0x27, 0xe0, 0x5c, 0xeb, 0x7c, 0xde, 0x32,
+	0xbd, 0x0f, 0x6a, 0x5c, 0x27, 0x07, 0xf1, 0x2c, 0x0e, 0xc4, 0x82, 0x24, 0x08, 0xfa, 0x95, 0x89,
+	0x40, 0x72, 0xc1, 0x5c, 0xcf, 0xad, 0xaa, 0x9e, 0x67, 0x27, 0x8a, 0x17, 0xb1, 0x31, 0xe8, 0x61,
+	0x3b, 0x32, 0x4b, 0x59, 0xf6, 0x82, 0xdf, 0x87, 0x89, 0x58, 0x1b, 0x0e, 0xf0, 0x29, 0x0c, 0x88,
+	0xa7, 0x13, 0x47, 0x38, 0x9f, 0x8a, 0x50, 0xf4, 0xd3, 0x6f, 0x06, 0x2f, 0xb8, 0xca, 0xf1, 0xe5,
+	0x0d, 0x23, 0x06, 0xdf, 0x43, 0x80, 0xe0, 0xe0, 0xe7, 0x13, 0x5d, 0xcc, 0xb0, 0x2a, 0x21, 0x53,
+	0xd6, 0x1c, 0x3d, 0xc3, 0x0a, 0x0e, 0x5e, 0x25, 0x64, 0x36, 0xb4, 0x9a, 0x77, 0xd0, 0x15, 0x05,
+	0x4b, 0xfc, 0x99, 0x02, 0x13, 0xb1, 0xd3, 0x70, 0x4a, 0x6f, 0x42, 0xbf, 0xd0, 0xcc, 0x53, 0xb1,
+	0x0d, 0x46, 0xc2, 0x0b, 0x7a, 0x14, 0xc2, 0xdc, 0xc5, 0x73, 0xe8, 0x28, 0xcc, 0x0c, 0x48, 0x08,
+	0xb4, 0xf7, 0xbd, 0x93, 0x34, 0xf1, 0xab, 0x88, 0x87, 0xa4, 0x88, 0xf0, 0x12, 0xe9, 0xe7, 0x0a,
+	0xcc, 0x24, 0x0e, 0xe1, 0xd4, 0x7e, 0x0c, 0x23, 0xd1, 0x1a, 0x84, 0x07, 0x32, 0x7d, 0xab, 0x8d,
+	0xf8, 0xe3, 0xc7, 0xe2, 0x70, 0x25, 0xdc, 0x8c, 0xef, 0xc1, 0x9c, 0x8f, 0x80, 0xb4, 0xc6, 0x23,
+	0x4d, 0xfb, 0x34, 0x7f, 0xa5, 0xc0, 0x1b, 0xe9, 0x2e, 0x38, 0x93, 0x1d, 0x38, 0xc3, 0x7c, 0x24,
+	0xf0, 0x59, 0x4a, 0xe7, 0x13, 0xe3, 0x9a, 0x93, 0x1a, 0xab, 0xc4, 0xf4, 0xe1, 0x0b, 0x9c, 0x59,
+	0xde, 0x30, 0x52, 0x98, 0x05, 0xf0, 0x13, 0xc7, 0x49, 0xc0, 0xef, 0x3e, 0x7e, 0xf8, 0x67, 0xe1,
+	0xb4, 0x17, 0xd5, 0xa7, 0xb4, 0xc4, 0xf4, 0x00, 0x7f, 0x1f, 0xce, 0x44, 0x3b, 0x38, 0xc2, 0x35,
+	0xe8, 0x65, 0xd5, 0xa8, 0x54, 0xb9, 0xc4, 0x8d, 0xb9, 0x09, 0x9e, 0xe1, 0x87, 0xdb, 0xe6, 0xb6,
+	0xf5, 0x91, 0x77, 0x58, 0xdc, 0x17, 0xbe, 0x65, 0x92, 0xac, 0xd3, 0x49, 0x23, 0x38, 0x80, 0x9f,
+	0xc0, 0x29, 0x43, 0x73, 0xdc, 0x92, 0x7f, 0x42, 0x89, 0x1b, 0x4c, 0x26, 0x15, 0xcd, 0x33, 0xcd,
+	0x71, 0xc3, 0x4e, 0x47, 0x8d, 0x68, 0x13, 0x7e, 0x93, 0x63, 0x2c, 0x90, 0x3a, 0x3e, 0xae, 0x96,
+	0xbb, 0x0c, 0x23, 0xb4, 0xc6, 0x6f, 0xad, 0x81, 0x86, 0x69, 0x7b, 0x60, 0x81, 0x2b, 0x5e, 0x61,
+	0xd8, 0xea, 0xcb, 0xaf, 0x3e, 0x81, 0x3b, 0x33, 0xb7, 0x2c, 0x4e, 0x02, 0xa7, 0x17, 0x22, 0x64,
+	0x78, 0xb1, 0x8f, 0x4d, 0x65, 0x6e, 0x59, 0x78, 0x2a, 0xd8, 0xb6, 0x58, 0x9f, 0x5e, 0xb1, 0xec,
+	0xaa, 0x9f, 0x7b, 0x1a, 0x4c, 0xc6, 0x77, 0x27, 0x20, 0xe8, 0x6e, 0x1f, 0xc1, 0x26, 0xcc, 0x8a,
+	0x34, 0x69, 0x1a, 0xe6, 0xcd, 0xea, 0x73, 0xcb, 0xac, 0xc8, 0x5c, 0x29, 0xc6, 0xa0, 0xc7, 0x24,
+	0x43, 0xe9, 0x3e, 0xd8, 0x5d, 0x64, 0x2f, 0x78, 0x0b, 0xce, 0xa7, 0x38, 0x3d, 0x3e, 0xf0, 0xc2,
+	0xe1, 0x52, 0xa0, 0x17, 0x11, 0x7a, 0x47, 0x3b, 0xee, 0xc3, 0xe5, 0x37, 0x0a, 0x4c, 0xc4, 0x4e,
+	0xc3, 0x89, 0x2c, 0xc3, 0xa0, 0x78, 0x45, 0xf4, 0xbe, 0xf7, 0x53, 0x5e, 0xa5, 0x23, 0xda, 0x0c,
+	0x94, 0x83, 0x17, 0xe7, 0xf8, 0x8e, 0x92, 0x3c, 0x5f, 0xc5, 0x47, 0xba, 0x2b, 0xcc, 0x56, 0x68,
+	0x92, 0x9b, 0x99, 0x17, 0x8e, 0xf0, 0xfd, 0x8d, 0x84, 0x63, 0x40, 0xb8, 0xbf, 0xe1, 0xf7, 0xe0,
+	0x7c, 0x8a, 0x0b, 0x4e, 0xf5, 0x26, 0x0c, 0x88, 0x54, 0x79, 0x50, 0x63, 0x99, 0xf6, 0x0b, 0x4c,
+	0xf1, 0x4d, 0xa1, 0x5c, 0x22, 0x8b, 0x4b, 0xaa, 0x6f, 0x89, 0xf4, 0xc2, 0x5b, 0x42, 0x25, 0x25,
+	0xd8, 0x09, 0xe5, 0x1c, 0x35, 0x74, 0x48, 0xb3, 0x5c, 0x39, 0x17, 0x78, 0x81, 0x8a, 0xff, 0x8c,
+	0x6f, 0xc0, 0x78, 0x68, 0x1e, 0x92, 0x5d, 0x12, 0xf0, 0x36, 0xe1, 0x5c, 0x8c, 0x99, 0x1f, 0x2b,
+	0xe0, 0x76, 0xc1, 0xf6, 0x30, 0x1a, 0xaa, 0x7e, 0xc9, 0x70, 0xbe, 0xc7, 0xf7, 0x55, 0xbc, 0x06,
+	0xac, 0xc2, 0x78, 0xe8, 0xbc, 0x11, 0xb0, 0xf8, 0x13, 0x86, 0xfb, 0x12, 0x26, 0xec, 0x96, 0x9c,
+	0xd0, 0xbb, 0x03, 0x91, 0x95, 0x67, 0x77, 0xa3, 0xdd, 0x9d, 0x1d, 0xcd, 0x6e, 0x76, 0x74, 0x01,
+	0xde, 0x87, 0xa9, 0x04, 0x67, 0x1c, 0xe5, 0xbb, 0x30, 0xc4, 0xbd, 0x39, 0xac, 0x87, 0x87, 0xe6,
+	0x8a, 0xcc, 0x15, 0x8e, 0x59, 0x70, 0x0a, 0x83, 0x65, 0xb1, 0x11, 0x6f, 0x09, 0x9b, 0x65, 0x1c,
+	0x8d, 0xe3, 0xda, 0x0e, 0xfe, 0xac, 0xc0, 0x54, 0xc2, 0x44, 0x29, 0x14, 0xbb, 0x8f, 0x81, 0xe2,
+	0xf1, 0xed, 0x17, 0x99, 0x60, 0xc9, 0xf3, 0x44, 0x29, 0xdb, 0xe0, 0x42, 0x99, 0x17, 0xab, 0x21,
+	0xe8, 0xe2, 0xd9, 0xfe, 0x5a, 0xb1, 0xab, 0x5e, 0x15, 0x57, 0x35, 0x32, 0x3e, 0xa0, 0x1c, 0x96,
+	0xdc, 0xa4, 0x56, 0x35, 0xe4, 0xcb, 0xa3, 0xac, 0x89, 0x8d, 0xf8, 0x1b, 0x85, 0xef, 0x4b, 0x1b,
+	0xba, 0x59, 0xad, 0x9b, 0xb5, 0x90, 0x89, 0x5f, 0x7e, 0x2e, 0x02, 0xda, 0xaa, 0x1b, 0xae, 0x6e,
+	0x97, 0x1a, 0x96, 0x51, 0xaf, 0x34, 0x99, 0xe2, 0xc0, 0x84, 0xa5, 0x11, 0xd6, 0xb3, 0x41, 0x3b,
+	0xa8, 0x88, 0xf0, 0x04, 0xfa, 0xc5, 0x61, 0x4c, 0x74, 0x49, 0xbf, 0x0d, 0x30, 0xeb, 0x12, 0x31,
+	0x2f, 0x42, 0x23, 0x70, 0x15, 0x4e, 0xaa, 0xee, 0x8e, 0x93, 0xea, 0x85, 0x02, 0x38, 0x8d, 0x26,
+	0x0f, 0xf3, 0x0f, 0x61, 0x38, 0x1c, 0x66, 0x47, 0x2a, 0xb5, 0xe2, 0xe2, 0x3c, 0x14, 0x8a, 0xf3,
+	0x31, 0x9e, 0x45, 0x7e, 0x4d, 0x43, 0xfc, 0x6f, 0xd6, 0x6b, 0xa6, 0x6e, 0x6f, 0xea, 0xae, 0x5f,
+	0xd3, 0xd8, 0x30, 0x19, 0xdf, 0xcd, 0x29, 0x16, 0xa1, 0xdf, 0xa1, 0xad, 0x25, 0x47, 0x77, 0xe5,
+	0xb4, 0x8b, 0xb0, 0x2b, 0xce, 0x0f, 0x1c, 0xdf, 0x37, 0x5e, 0x0b, 0xd2, 0x97, 0xdc, 0xe4, 0x68,
+	0x1d, 0xb1, 0x49, 0x7c, 0x78, 0xf9, 0xa3, 0xc2, 0x49, 0xab, 0xa1, 0xdb, 0x9a, 0x6b, 0x79, 0x3b,
+	0x9b, 0xff, 0x8e, 0x3f, 0x51, 0x60, 0x3a, 0xc9, 0x9a, 0x63, 0x7e, 0x0f, 0x46, 0xe8, 0x8d, 0x99,
+	0xd5, 0x33, 0x0e, 0xe9, 0x93, 0xba, 0xd7, 0x87, 0xdd, 0x79, 0x0b, 0x63, 0x86, 0x5a, 0x49, 0x89,
+	0xc5, 0x3c, 0x76, 0xd1, 0xcf, 0x91, 0xbd, 0xf8, 0xe5, 0x38, 0xbf, 0xf0, 0xb6, 0x50, 0xc2, 0x3f,
+	0x83, 0xe9, 0xa4, 0x01, 0xfe, 0xcd, 0x71, 0x34, 0x8a, 0x5a, 0x2e, 0xde, 0xb1, 0xb0, 0x87, 0xc3,
+	0xb0, 0x9d, 0xdc, 0xff, 0x16, 0xa1, 0x87, 0x22, 0x40, 0x1f, 0x2b, 0xd0, 0xcb, 0xe4, 0x0a, 0x94,
+	0x4d, 0x75, 0xdc, 0xaa, 0xfc, 0xaa, 0xd7, 0xe4, 0x0d, 0x18, 0x2d, 0x3c, 0xf7, 0x8b, 0xaf, 0xbe,
+	0xf9, 0xa4, 0x6b, 0x0a, 0x4d, 0x64, 0xc9, 0xf8, 0xab, 0xd4, 0x34, 0x1b, 0x51, 0xd0, 0xd1, 0x3f,
+	0x14, 0x40, 0xad, 0x62, 0x29, 0x5a, 0x3b, 0x7a, 0xb6, 0x44, 0xa1, 0x58, 0xbd, 0xdd, 0x99, 0x31,
+	0x87, 0xfd, 0x80, 0xc2, 0xbe, 0x8b, 0xee, 0xc4, 0xc2, 0xe6, 0xe7, 0x49, 0xb9, 0x29, 0x9c, 0xc1,
+	0xd9, 0x83, 0x96, 0x63, 0xf9, 0x10, 0x7d, 0xa1, 0xc0, 0x48, 0x54, 0x7f, 0x44, 0x2b, 0x47, 0x23,
+	0x4b, 0x10, 0x40, 0xd5, 0xd5, 0x4e, 0x4c, 0x39, 0xa5, 0xfb, 0x94, 0xd2, 0x1d, 0xb4, 0x16, 0x4b,
+	0xc9, 0x7b, 0x70, 0x08, 0x2b, 0xd6, 0x77, 0xd0, 0xa2, 0xb5, 0x1e, 0xa2, 0xbf, 0x28, 0x80, 0x5a,
+	0xf5, 0x4e, 0x99, 0x95, 0x4a, 0xd4, 0x51, 0xd5, 0xdb, 0x9d, 0x19, 0x73, 0x5a, 0x4b, 0x94, 0xd6,
+	0x02, 0xba, 0x1c, 0x4b, 0x4b, 0x33, 0x8c, 0x52, 0x54, 0x81, 0x45, 0xbf, 0x55, 0x60, 0x38, 0xa2,
+	0x90, 0xa2, 0xa5, 0xa3, 0x41, 0x44, 0x4c, 0xd4, 0x95, 0xb6, 0x4d, 0x7c, 0xd0, 0x8b, 0x14, 0xf4,
+	0x45, 0xf4, 0x46, 0x2c, 0x68, 0x27, 0x82, 0xed, 0x3f, 0x0a, 0x9c, 0x8e, 0x95, 0x52, 0xd1, 0xfa,
+	0xd1, 0x10, 0xd2, 0x34, 0x5c, 0xf5, 0x6e, 0xc7, 0xf6, 0x52, 0x49, 0x55, 0xd3, 0xdd, 0x52, 0xc5,
+	0xa8, 0xeb, 0xa6, 0xcb, 0xf5, 0xd5, 0xd2, 0x96, 0x65, 0x7b, 0xd9, 0xe5, 0x95, 0xf1, 0x87, 0xe8,
+	0x77, 0x0a, 0x0c, 0x86, 0xa6, 0x41, 0x37, 0xdb, 0xc4, 0xe5, 0xf1, 0xb9, 0xd5, 0xb6, 0x9d, 0xd4,
+	0x82, 0x50, 0x1e, 0x81, 0x4a, 0x8c, 0x3e, 0x53, 0x42, 0x0a, 0x26, 0x92, 0x9b, 0xb6, 0x55, 0x71,
+	0x55, 0x97, 0xdb, 0x37, 0xe4, 0x80, 0xaf, 0x51, 0xc0, 0x57, 0xd0, 0x7c, 0x2c, 0x60, 0x41, 0xf3,
+	0xcd, 0x1e, 0x50, 0x99, 0xf9, 0x90, 0x64, 0xfd, 0x90, 0xe0, 0x29, 0x6f, 0x18, 0x32, 0xb8, 0x63,
+	0x95, 0x62, 0x75, 0xb9, 0x7d, 0x43, 0x8e, 0x7b, 0x9e, 0xe2, 0xc6, 0x68, 0xf6, 0x28, 0xdc, 0xe8,
+	0x73, 0x05, 0x86, 0x23, 0x32, 0x1b, 0x5a, 0x93, 0x5b, 0xdf, 0x58, 0xed, 0x50, 0xbd, 0xdd, 0x99,
+	0x31, 0x07, 0x7e, 0x95, 0x02, 0xbf, 0x84, 0x2e, 0xc4, 0x02, 0x8f, 0xaa, 0x8c, 0xe8, 0xdf, 0x0a,
+	0x8c, 0xc5, 0xa9, 0x88, 0xe8, 0x9e, 0x1c, 0x8a, 0x64, 0x0d, 0x54, 0xcd, 0x7f, 0x0b, 0x0f, 0x9c,
+	0xcc, 0x3a, 0x25, 0xb3, 0x8c, 0x6e, 0xc6, 0x93, 0x89, 0x15, 0x4e, 0xc5, 0x2f, 0xf6, 0x2b, 0x05,
+	0xce, 0xc6, 0x4d, 0x40, 0x92, 0xea, 0x9e, 0x54, 0x6e, 0x7c, 0x4b, 0x82, 0x47, 0xc8, 0xbf, 0xf8,
+	0x3a, 0x25, 0x78, 0x15, 0x2d, 0xb4, 0x41, 0x10, 0xfd, 0x5a, 0x81, 0x5e, 0xa6, 0xb3, 0xa2, 0x9c,
+	0x54, 0x8c, 0x43, 0x52, 0xaf, 0x7a, 0xbd, 0x2d, 0x1b, 0xa9, 0xfa, 0x88, 0xa9, 0xbd, 0xe8, 0x6f,
+	0x0a, 0x8c, 0xb6, 0xe8, 0xb8, 0x48, 0xa2, 0x18, 0x48, 0x92, 0x87, 0xd5, 0xb5, 0x8e, 0x6c, 0x39,
+	0xe6, 0x15, 0x8a, 0xf9, 0x3a, 0x5a, 0x12, 0x31, 0x7b, 0x5e, 0x02, 0xf0, 0xce, 0xb6, 0xf5, 0x51,
+	0x44, 0x5c, 0x46, 0x7f, 0x57, 0x60, 0xb4, 0x45, 0xc3, 0x95, 0x61, 0x92, 0x24, 0x22, 0xab, 0x6b,
+	0x1d, 0xd9, 0x4a, 0x1d, 0x5f, 0xac, 0x14, 0x8f, 0x56, 0x79, 0x11, 0xc5, 0xfa, 0x10, 0xfd, 0x49,
+	0x01, 0x44, 0xae, 0xe2, 0x61, 0x59, 0x18, 0xc9, 0xed, 0x91, 0x31, 0x42, 0xb3, 0xba, 0xd2, 0x81,
+	0x25, 0x27, 0x94, 0xa3, 0x84, 0x16, 0xd1, 0x95, 0xc4, 0x73, 0x8c, 0x54, 0x44, 0x8c, 0x83, 0xcd,
+	0x81, 0x7e, 0xad, 0xc0, 0x69, 0xea, 0xcc, 0x89, 0x88, 0xc3, 0xe8, 0x8e, 0x74, 0x6c, 0xe3, 0x94,
+	0x6a, 0x75, 0xbd, 0x53, 0x73, 0x4e, 0xe6, 0x31, 0x25, 0x53, 0x40, 0xf7, 0xd2, 0x57, 0x87, 0x7d,
+	0xc2, 0x9a, 0x59, 0x2d, 0x51, 0xbd, 0x5b, 0xd8, 0xa7, 0xb2, 0x07, 0xb4, 0xe5, 0x10, 0x7d, 0x2e,
+	0x2c, 0x91, 0xa0, 0xf8, 0xde, 0x92, 0x0c, 0x74, 0x54, 0xcc, 0x56, 0x97, 0xdb, 0x37, 0x6c, 0x73,
+	0x81, 0x04, 0x05, 0x1b, 0xfd, 0x4b, 0x81, 0xb1, 0x38, 0x21, 0x58, 0x66, 0x7d, 0x52, 0x34, 0x68,
+	0x75, 0xbd, 0x53, 0x73, 0xce, 0xa5, 0x40, 0xb9, 0xdc, 0x46, 0xab, 0x89, 0x5c, 0x44, 0x1e, 0x64,
+	0xa9, 0x88, 0xd8, 0x9d, 0x3d, 0xe0, 0xad, 0x9a, 0xb3, 0x7d, 0x48, 0x4a, 0x29, 0x08, 0x64, 0x60,
+	0xd9, 0xc2, 0x2f, 0xaa, 0x5a, 0xab, 0xb7, 0xda, 0xb6, 0x6b, 0xe3, 0xa0, 0xa0, 0x82, 0x76, 0xa4,
+	0x60, 0xed, 0xf3, 0x25, 0x5c, 0x74, 0x43, 0x7e, 0x6e, 0x41, 0x3d, 0x56, 0x6f, 0xb6, 0x6b, 0x26,
+	0x95, 0x41, 0x81, 0xe6, 0x2c, 0x02, 0xfe, 0x54, 0x81, 0x01, 0xdf, 0x13, 0x39, 0xa4, 0x6f, 0xc8,
+	0x1f, 0xb1, 0x6d, 0x62, 0x8e, 0x13, 0xc3, 0xf1, 0x25, 0x8a, 0xf9, 0x3c, 0x9a, 0x39, 0x02, 0x33,
+	0x39, 0xe9, 0x06, 0x43, 0xd2, 0x2b, 0x5a, 0x91, 0x4b, 0xd2, 0x18, 0x8d, 0x59, 0x5d, 0xed, 0xc4,
+	0x94, 0x23, 0xbe, 0x4b, 0x11, 0xaf, 0xa0, 0x5b, 0x69, 0x02, 0x00, 0x17, 0x94, 0x63, 0xaf, 0xfe,
+	0xbf, 0x57, 0x60, 0x24, 0xe4, 0x9a, 0x84, 0x5d, 0x72, 0x67, 0xef, 0x90, 0x4c, 0x92, 0x04, 0x8e,
+	0x17, 0x28, 0x99, 0x0b, 0x68, 0x4e, 0x82, 0x0c, 0xfa, 0xa5, 0x02, 0x3d, 0xf4, 0xb7, 0x81, 0x28,
+	0x23, 0xa1, 0xf6, 0x08, 0x3f, 0x76, 0x54, 0xb3, 0xd2, 0xe3, 0x39, 0x2e, 0x4c, 0x71, 0x4d, 0x22,
+	0x35, 0x5e, 0x1c, 0xa2, 0x20, 0xfe, 0xa8, 0xc0, 0x60, 0x48, 0x31, 0x95, 0xcc, 0x88, 0x38, 0x25,
+	0x5d, 0x5d, 0xed, 0xc4, 0x54, 0xea, 0xc6, 0x15, 0x16, 0x82, 0xb3, 0x07, 0xe4, 0xab, 0xfb, 0x42,
+	0x81, 0xd3, 0xb1, 0x0a, 0xb2, 0xcc, 0xbd, 0x3d, 0x4d, 0x61, 0x57, 0xef, 0x76, 0x6c, 0xcf, 0xc9,
+	0x7c, 0x87, 0x92, 0xc9, 0xa0, 0xc5, 0xf8, 0xc8, 0x33, 0xdb, 0x52, 0x98, 0x94, 0x43, 0x72, 0x7a,
+	0x38, 0xa2, 0x14, 0x4b, 0x95, 0x39, 0xb1, 0xda, 0xb3, 0xba, 0xd2, 0x81, 0x25, 0x87, 0x9f, 0xa1,
+	0xf0, 0xe7, 0xd1, 0xc5, 0x94, 0xb5, 0x10, 0x74, 0x6b, 0xf4, 0x57, 0x7e, 0xf7, 0x15, 0x84, 0xdc,
+	0x55, 0xe9, 0xab, 0x77, 0x8b, 0x9a, 0xab, 0xae, 0x75, 0x64, 0xcb, 0xb1, 0x2f, 0x53, 0xec, 0x39,
+	0x74, 0x2d, 0xf1, 0x06, 0x2c, 0x6a, 0xc0, 0xd9, 0x03, 0x4f, 0xfa, 0xa6, 0x55, 0xcc, 0x68, 0xd8,
+	0x29, 0xd9, 0x53, 0x56, 0xa5, 0xef, 0xe2, 0x1d, 0x11, 0x49, 0x54, 0xac, 0x8f, 0xb8, 0x11, 0x47,
+	0x89, 0x14, 0x1e, 0xbc, 0x78, 0x39, 0xad, 0x7c, 0xf9, 0x72, 0x5a, 0xf9, 0xfa, 0xe5, 0xb4, 0xf2,
+	0xf1, 0xab, 0xe9, 0x13, 0x5f, 0xbe, 0x9a, 0x3e, 0xf1, 0xcf, 0x57, 0xd3, 0x27, 0x7e, 0xb4, 0x50,
+	0xab, 0xbb, 0xdb, 0xbb, 0x65, 0xf2, 0xcf, 0x51, 0xd1, 0x15, 0xf1, 0x90, 0xdd, 0x0f, 0x3c, 0x92,
+	0x7f, 0x0f, 0x39, 0xe5, 0x5e, 0xfa, 0x73, 0xeb, 0xeb, 0xff, 0x1f, 0x00, 0x57, 0x54, 0x3a, 0xbd,
+	0x06, 0x2f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error)
+	// Queries a list of ObserversByChainAndType items.
+	ObserversByChain(ctx context.Context, in *QueryObserversByChainRequest, opts ...grpc.CallOption) (*QueryObserversByChainResponse, error)
+	AllObserverMappers(ctx context.Context, in *QueryAllObserverMappersRequest, opts ...grpc.CallOption) (*QueryAllObserverMappersResponse, error)
+	SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error)
+	// Queries a list of GetClientParamsForChain items.
+	GetCoreParamsForChain(ctx context.Context, in *QueryGetCoreParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsForChainResponse, error)
+	// Queries a list of GetCoreParams items.
+	GetCoreParams(ctx context.Context, in *QueryGetCoreParamsRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsResponse, error)
+	// Queries a nodeAccount by index.
+	NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error)
+	// Queries a list of nodeAccount items.
+	NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error)
+	CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error)
+	// Queries the crosschain flags of a chain
+	ChainCrosschainFlags(ctx context.Context, in *QueryGetChainCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetChainCrosschainFlagsResponse, error)
+	// Queries the crosschain flags of all the supported chains
+	ChainCrosschainFlagsAll(ctx context.Context, in *QueryAllChainCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryAllChainCrosschainFlagsResponse, error)
+	// Queries a keygen by index.
+	Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error)
+	// Queries a list of ShowObserverCount items.
+	ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error)
+	GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error)
+	GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error)
+	// Queries the state of the block header chain of a chain
+	ChainState(ctx context.Context, in *QueryGetChainStateRequest, opts ...grpc.CallOption) (*QueryGetChainStateResponse, error)
+	// Queries the registry entry of a chain
+	ChainInfo(ctx context.Context, in *QueryGetChainInfoRequest, opts ...grpc.CallOption) (*QueryGetChainInfoResponse, error)
+	// Queries all the entries of the chain registry
+	ChainInfoAll(ctx context.Context, in *QueryAllChainInfoRequest, opts ...grpc.CallOption) (*QueryAllChainInfoResponse, error)
+	// Queries the summary of a pruned ballot
+	BallotSummary(ctx context.Context, in *QueryGetBallotSummaryRequest, opts ...grpc.CallOption) (*QueryGetBallotSummaryResponse, error)
+	// Queries the summaries of the pruned ballots
+	BallotSummaryAll(ctx context.Context, in *QueryAllBallotSummaryRequest, opts ...grpc.CallOption) (*QueryAllBallotSummaryResponse, error)
+	// merkle proof verification
+	Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error)
+	// Queries an admin proposal by id
+	AdminProposal(ctx context.Context, in *QueryGetAdminProposalRequest, opts ...grpc.CallOption) (*QueryGetAdminProposalResponse, error)
+	// Queries the pending admin proposals, optionally of a single policy type
+	PendingAdminProposals(ctx context.Context, in *QueryPendingAdminProposalsRequest, opts ...grpc.CallOption) (*QueryPendingAdminProposalsResponse, error)
+	// Queries the signer sets of the admin policies
+	AdminSignerSets(ctx context.Context, in *QueryAdminSignerSetsRequest, opts ...grpc.CallOption) (*QueryAdminSignerSetsResponse, error)
+	// Queries the blame score of a node in the blame window
+	NodeBlameScore(ctx context.Context, in *QueryGetNodeBlameScoreRequest, opts ...grpc.CallOption) (*QueryGetNodeBlameScoreResponse, error)
+	// Queries the blame scores of all the blamed nodes in the blame window
+	NodeBlameScoreAll(ctx context.Context, in *QueryAllNodeBlameScoreRequest, opts ...grpc.CallOption) (*QueryAllNodeBlameScoreResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error) {
+	out := new(QueryBallotByIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotByIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VoteType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ObserversByChain(ctx context.Context, in *QueryObserversByChainRequest, opts ...grpc.CallOption) (*QueryObserversByChainResponse, error) {
+	out := new(QueryObserversByChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserversByChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllObserverMappers(ctx context.Context, in *QueryAllObserverMappersRequest, opts ...grpc.CallOption) (*QueryAllObserverMappersResponse, error) {
+	out := new(QueryAllObserverMappersResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/AllObserverMappers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error) {
+	out := new(QuerySupportedChainsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/SupportedChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BallotStatus != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ObservationType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voters) > 0 {
-		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetCoreParamsForChain(ctx context.Context, in *QueryGetCoreParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsForChainResponse, error) {
+	out := new(QueryGetCoreParamsForChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetCoreParamsForChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryObserversByChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetCoreParams(ctx context.Context, in *QueryGetCoreParamsRequest, opts ...grpc.CallOption) (*QueryGetCoreParamsResponse, error) {
+	out := new(QueryGetCoreParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetCoreParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryObserversByChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error) {
+	out := new(QueryGetNodeAccountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryObserversByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObservationChain) > 0 {
-		i -= len(m.ObservationChain)
-		copy(dAtA[i:], m.ObservationChain)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObservationChain)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error) {
+	out := new(QueryAllNodeAccountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccountAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryObserversByChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error) {
+	out := new(QueryGetCrosschainFlagsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/CrosschainFlags", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryObserversByChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryObserversByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Observers) > 0 {
-		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Observers[iNdEx])
-			copy(dAtA[i:], m.Observers[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ChainCrosschainFlags(ctx context.Context, in *QueryGetChainCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetChainCrosschainFlagsResponse, error) {
+	out := new(QueryGetChainCrosschainFlagsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainCrosschainFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllObserverMappersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ChainCrosschainFlagsAll(ctx context.Context, in *QueryAllChainCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryAllChainCrosschainFlagsResponse, error) {
+	out := new(QueryAllChainCrosschainFlagsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainCrosschainFlagsAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllObserverMappersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllObserverMappersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllObserverMappersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error) {
+	out := new(QueryGetKeygenResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Keygen", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllObserverMappersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAllObserverMappersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ObserverMappers) > 0 {
-		for iNdEx := len(m.ObserverMappers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ObserverMappers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error) {
+	out := new(QueryShowObserverCountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ShowObserverCount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error) {
+	out := new(QueryBlameByIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlameByIdentifier", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error) {
+	out := new(QueryAllBlameRecordsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlameRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error) {
+	out := new(QueryBlameByChainAndNonceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error) {
+	out := new(QueryAllBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlockHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Chains) > 0 {
-		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error) {
+	out := new(QueryGetBlockHeaderByHashResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsForChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ChainState(ctx context.Context, in *QueryGetChainStateRequest, opts ...grpc.CallOption) (*QueryGetChainStateResponse, error) {
+	out := new(QueryGetChainStateResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ChainInfo(ctx context.Context, in *QueryGetChainInfoRequest, opts ...grpc.CallOption) (*QueryGetChainInfoResponse, error) {
+	out := new(QueryGetChainInfoResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) ChainInfoAll(ctx context.Context, in *QueryAllChainInfoRequest, opts ...grpc.CallOption) (*QueryAllChainInfoResponse, error) {
+	out := new(QueryAllChainInfoResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainInfoAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsForChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BallotSummary(ctx context.Context, in *QueryGetBallotSummaryRequest, opts ...grpc.CallOption) (*QueryGetBallotSummaryResponse, error) {
+	out := new(QueryGetBallotSummaryResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotSummary", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BallotSummaryAll(ctx context.Context, in *QueryAllBallotSummaryRequest, opts ...grpc.CallOption) (*QueryAllBallotSummaryResponse, error) {
+	out := new(QueryAllBallotSummaryResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotSummaryAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CoreParams != nil {
-		{
-			size, err := m.CoreParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error) {
+	out := new(QueryProveResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Prove", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AdminProposal(ctx context.Context, in *QueryGetAdminProposalRequest, opts ...grpc.CallOption) (*QueryGetAdminProposalResponse, error) {
+	out := new(QueryGetAdminProposalResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/AdminProposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingAdminProposals(ctx context.Context, in *QueryPendingAdminProposalsRequest, opts ...grpc.CallOption) (*QueryPendingAdminProposalsResponse, error) {
+	out := new(QueryPendingAdminProposalsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingAdminProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) AdminSignerSets(ctx context.Context, in *QueryAdminSignerSetsRequest, opts ...grpc.CallOption) (*QueryAdminSignerSetsResponse, error) {
+	out := new(QueryAdminSignerSetsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/AdminSignerSets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NodeBlameScore(ctx context.Context, in *QueryGetNodeBlameScoreRequest, opts ...grpc.CallOption) (*QueryGetNodeBlameScoreResponse, error) {
+	out := new(QueryGetNodeBlameScoreResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeBlameScore", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NodeBlameScoreAll(ctx context.Context, in *QueryAllNodeBlameScoreRequest, opts ...grpc.CallOption) (*QueryAllNodeBlameScoreResponse, error) {
+	out := new(QueryAllNodeBlameScoreResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeBlameScoreAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetCoreParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CoreParams != nil {
-		{
-			size, err := m.CoreParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BallotByIdentifier(context.Context, *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error)
+	// Queries a list of ObserversByChainAndType items.
+	ObserversByChain(context.Context, *QueryObserversByChainRequest) (*QueryObserversByChainResponse, error)
+	AllObserverMappers(context.Context, *QueryAllObserverMappersRequest) (*QueryAllObserverMappersResponse, error)
+	SupportedChains(context.Context, *QuerySupportedChains) (*QuerySupportedChainsResponse, error)
+	// Queries a list of GetClientParamsForChain items.
+	GetCoreParamsForChain(context.Context, *QueryGetCoreParamsForChainRequest) (*QueryGetCoreParamsForChainResponse, error)
+	// Queries a list of GetCoreParams items.
+	GetCoreParams(context.Context, *QueryGetCoreParamsRequest) (*QueryGetCoreParamsResponse, error)
+	// Queries a nodeAccount by index.
+	NodeAccount(context.Context, *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error)
+	// Queries a list of nodeAccount items.
+	NodeAccountAll(context.Context, *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error)
+	CrosschainFlags(context.Context, *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error)
+	// Queries the crosschain flags of a chain
+	ChainCrosschainFlags(context.Context, *QueryGetChainCrosschainFlagsRequest) (*QueryGetChainCrosschainFlagsResponse, error)
+	// Queries the crosschain flags of all the supported chains
+	ChainCrosschainFlagsAll(context.Context, *QueryAllChainCrosschainFlagsRequest) (*QueryAllChainCrosschainFlagsResponse, error)
+	// Queries a keygen by index.
+	Keygen(context.Context, *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error)
+	// Queries a list of ShowObserverCount items.
+	ShowObserverCount(context.Context, *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlameByIdentifier(context.Context, *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	GetAllBlameRecords(context.Context, *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlamesByChainAndNonce(context.Context, *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error)
+	GetAllBlockHeaders(context.Context, *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error)
+	GetBlockHeaderByHash(context.Context, *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error)
+	// Queries the state of the block header chain of a chain
+	ChainState(context.Context, *QueryGetChainStateRequest) (*QueryGetChainStateResponse, error)
+	// Queries the registry entry of a chain
+	ChainInfo(context.Context, *QueryGetChainInfoRequest) (*QueryGetChainInfoResponse, error)
+	// Queries all the entries of the chain registry
+	ChainInfoAll(context.Context, *QueryAllChainInfoRequest) (*QueryAllChainInfoResponse, error)
+	// Queries the summary of a pruned ballot
+	BallotSummary(context.Context, *QueryGetBallotSummaryRequest) (*QueryGetBallotSummaryResponse, error)
+	// Queries the summaries of the pruned ballots
+	BallotSummaryAll(context.Context, *QueryAllBallotSummaryRequest) (*QueryAllBallotSummaryResponse, error)
+	// merkle proof verification
+	Prove(context.Context, *QueryProveRequest) (*QueryProveResponse, error)
+	// Queries an admin proposal by id
+	AdminProposal(context.Context, *QueryGetAdminProposalRequest) (*QueryGetAdminProposalResponse, error)
+	// Queries the pending admin proposals, optionally of a single policy type
+	PendingAdminProposals(context.Context, *QueryPendingAdminProposalsRequest) (*QueryPendingAdminProposalsResponse, error)
+	// Queries the signer sets of the admin policies
+	AdminSignerSets(context.Context, *QueryAdminSignerSetsRequest) (*QueryAdminSignerSetsResponse, error)
+	// Queries the blame score of a node in the blame window
+	NodeBlameScore(context.Context, *QueryGetNodeBlameScoreRequest) (*QueryGetNodeBlameScoreResponse, error)
+	// Queries the blame scores of all the blamed nodes in the blame window
+	NodeBlameScoreAll(context.Context, *QueryAllNodeBlameScoreRequest) (*QueryAllNodeBlameScoreResponse, error)
 }
 
-func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) BallotByIdentifier(ctx context.Context, req *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotByIdentifier not implemented")
 }
-
-func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ObserversByChain(ctx context.Context, req *QueryObserversByChainRequest) (*QueryObserversByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserversByChain not implemented")
 }
-
-func (m *QueryGetNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) AllObserverMappers(ctx context.Context, req *QueryAllObserverMappersRequest) (*QueryAllObserverMappersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllObserverMappers not implemented")
+}
+func (*UnimplementedQueryServer) SupportedChains(ctx context.Context, req *QuerySupportedChains) (*QuerySupportedChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupportedChains not implemented")
+}
+func (*UnimplementedQueryServer) GetCoreParamsForChain(ctx context.Context, req *QueryGetCoreParamsForChainRequest) (*QueryGetCoreParamsForChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoreParamsForChain not implemented")
+}
+func (*UnimplementedQueryServer) GetCoreParams(ctx context.Context, req *QueryGetCoreParamsRequest) (*QueryGetCoreParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoreParams not implemented")
+}
+func (*UnimplementedQueryServer) NodeAccount(ctx context.Context, req *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeAccount not implemented")
+}
+func (*UnimplementedQueryServer) NodeAccountAll(ctx context.Context, req *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeAccountAll not implemented")
+}
+func (*UnimplementedQueryServer) CrosschainFlags(ctx context.Context, req *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFlags not implemented")
+}
+func (*UnimplementedQueryServer) ChainCrosschainFlags(ctx context.Context, req *QueryGetChainCrosschainFlagsRequest) (*QueryGetChainCrosschainFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainCrosschainFlags not implemented")
+}
+func (*UnimplementedQueryServer) ChainCrosschainFlagsAll(ctx context.Context, req *QueryAllChainCrosschainFlagsRequest) (*QueryAllChainCrosschainFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainCrosschainFlagsAll not implemented")
+}
+func (*UnimplementedQueryServer) Keygen(ctx context.Context, req *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keygen not implemented")
+}
+func (*UnimplementedQueryServer) ShowObserverCount(ctx context.Context, req *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowObserverCount not implemented")
+}
+func (*UnimplementedQueryServer) BlameByIdentifier(ctx context.Context, req *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlameByIdentifier not implemented")
+}
+func (*UnimplementedQueryServer) GetAllBlameRecords(ctx context.Context, req *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlameRecords not implemented")
+}
+func (*UnimplementedQueryServer) BlamesByChainAndNonce(ctx context.Context, req *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlamesByChainAndNonce not implemented")
+}
+func (*UnimplementedQueryServer) GetAllBlockHeaders(ctx context.Context, req *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlockHeaders not implemented")
+}
+func (*UnimplementedQueryServer) GetBlockHeaderByHash(ctx context.Context, req *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderByHash not implemented")
+}
+func (*UnimplementedQueryServer) ChainState(ctx context.Context, req *QueryGetChainStateRequest) (*QueryGetChainStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainState not implemented")
+}
+func (*UnimplementedQueryServer) ChainInfo(ctx context.Context, req *QueryGetChainInfoRequest) (*QueryGetChainInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainInfo not implemented")
+}
+func (*UnimplementedQueryServer) ChainInfoAll(ctx context.Context, req *QueryAllChainInfoRequest) (*QueryAllChainInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainInfoAll not implemented")
+}
+func (*UnimplementedQueryServer) BallotSummary(ctx context.Context, req *QueryGetBallotSummaryRequest) (*QueryGetBallotSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotSummary not implemented")
+}
+func (*UnimplementedQueryServer) BallotSummaryAll(ctx context.Context, req *QueryAllBallotSummaryRequest) (*QueryAllBallotSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotSummaryAll not implemented")
+}
+func (*UnimplementedQueryServer) Prove(ctx context.Context, req *QueryProveRequest) (*QueryProveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prove not implemented")
+}
+func (*UnimplementedQueryServer) AdminProposal(ctx context.Context, req *QueryGetAdminProposalRequest) (*QueryGetAdminProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminProposal not implemented")
+}
+func (*UnimplementedQueryServer) PendingAdminProposals(ctx context.Context, req *QueryPendingAdminProposalsRequest) (*QueryPendingAdminProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingAdminProposals not implemented")
+}
+func (*UnimplementedQueryServer) AdminSignerSets(ctx context.Context, req *QueryAdminSignerSetsRequest) (*QueryAdminSignerSetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSignerSets not implemented")
+}
+func (*UnimplementedQueryServer) NodeBlameScore(ctx context.Context, req *QueryGetNodeBlameScoreRequest) (*QueryGetNodeBlameScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeBlameScore not implemented")
+}
+func (*UnimplementedQueryServer) NodeBlameScoreAll(ctx context.Context, req *QueryAllNodeBlameScoreRequest) (*QueryAllNodeBlameScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeBlameScoreAll not implemented")
 }
 
-func (m *QueryGetNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NodeAccount != nil {
-		{
-			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BallotByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotByIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BallotByIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BallotByIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotByIdentifier(ctx, req.(*QueryBallotByIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_ObserversByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserversByChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ObserversByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserversByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserversByChain(ctx, req.(*QueryObserversByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllObserverMappers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllObserverMappersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AllObserverMappers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/AllObserverMappers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllObserverMappers(ctx, req.(*QueryAllObserverMappersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_SupportedChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupportedChains)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.NodeAccount) > 0 {
-		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).SupportedChains(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/SupportedChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupportedChains(ctx, req.(*QuerySupportedChains))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetCoreParamsForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCoreParamsForChainRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetCoreParamsForChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetCoreParamsForChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCoreParamsForChain(ctx, req.(*QueryGetCoreParamsForChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetCoreParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCoreParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCoreParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetCoreParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCoreParams(ctx, req.(*QueryGetCoreParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_NodeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNodeAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).NodeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeAccount(ctx, req.(*QueryGetNodeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_NodeAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllNodeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NodeAccountAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccountAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeAccountAll(ctx, req.(*QueryAllNodeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_CrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCrosschainFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CrosschainFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/CrosschainFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrosschainFlags(ctx, req.(*QueryGetCrosschainFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ChainCrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainCrosschainFlagsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ChainCrosschainFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ChainCrosschainFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainCrosschainFlags(ctx, req.(*QueryGetChainCrosschainFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ChainCrosschainFlagsAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChainCrosschainFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainCrosschainFlagsAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ChainCrosschainFlagsAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainCrosschainFlagsAll(ctx, req.(*QueryAllChainCrosschainFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_Keygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetKeygenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Keygen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/Keygen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Keygen(ctx, req.(*QueryGetKeygenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ShowObserverCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowObserverCountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ShowObserverCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ShowObserverCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowObserverCount(ctx, req.(*QueryShowObserverCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BlameByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameByIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlameByIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BlameByIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlameByIdentifier(ctx, req.(*QueryBlameByIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Keygen != nil {
-		{
-			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_GetAllBlameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlameRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllBlameRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlameRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllBlameRecords(ctx, req.(*QueryAllBlameRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BlamesByChainAndNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameByChainAndNonceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).BlamesByChainAndNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlamesByChainAndNonce(ctx, req.(*QueryBlameByChainAndNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetAllBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlockHeaderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllBlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllBlockHeaders(ctx, req.(*QueryAllBlockHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetBlockHeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlockHeaderByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBlockHeaderByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBlockHeaderByHash(ctx, req.(*QueryGetBlockHeaderByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastObserverCount != nil {
-		{
-			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_ChainState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ChainState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ChainState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainState(ctx, req.(*QueryGetChainStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ChainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ChainInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ChainInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainInfo(ctx, req.(*QueryGetChainInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ChainInfoAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChainInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainInfoAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ChainInfoAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainInfoAll(ctx, req.(*QueryAllChainInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlameIdentifier) > 0 {
-		i -= len(m.BlameIdentifier)
-		copy(dAtA[i:], m.BlameIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
-		i--
-		dAtA[i] = 0xa
+func _Query_BallotSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBallotSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).BallotSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BallotSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotSummary(ctx, req.(*QueryGetBallotSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BallotSummaryAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBallotSummaryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BallotSummaryAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BallotSummaryAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotSummaryAll(ctx, req.(*QueryAllBallotSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Prove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Prove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/Prove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Prove(ctx, req.(*QueryProveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlameInfo != nil {
-		{
-			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_AdminProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAdminProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).AdminProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/AdminProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminProposal(ctx, req.(*QueryGetAdminProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PendingAdminProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingAdminProposalsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PendingAdminProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/PendingAdminProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingAdminProposals(ctx, req.(*QueryPendingAdminProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AdminSignerSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminSignerSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminSignerSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/AdminSignerSets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminSignerSets(ctx, req.(*QueryAdminSignerSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_NodeBlameScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNodeBlameScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NodeBlameScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/NodeBlameScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeBlameScore(ctx, req.(*QueryGetNodeBlameScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+func _Query_NodeBlameScoreAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllNodeBlameScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NodeBlameScoreAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/NodeBlameScoreAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeBlameScoreAll(ctx, req.(*QueryAllNodeBlameScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.observer.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BallotByIdentifier",
+			Handler:    _Query_BallotByIdentifier_Handler,
+		},
+		{
+			MethodName: "ObserversByChain",
+			Handler:    _Query_ObserversByChain_Handler,
+		},
+		{
+			MethodName: "AllObserverMappers",
+			Handler:    _Query_AllObserverMappers_Handler,
+		},
+		{
+			MethodName: "SupportedChains",
+			Handler:    _Query_SupportedChains_Handler,
+		},
+		{
+			MethodName: "GetCoreParamsForChain",
+			Handler:    _Query_GetCoreParamsForChain_Handler,
+		},
+		{
+			MethodName: "GetCoreParams",
+			Handler:    _Query_GetCoreParams_Handler,
+		},
+		{
+			MethodName: "NodeAccount",
+			Handler:    _Query_NodeAccount_Handler,
+		},
+		{
+			MethodName: "NodeAccountAll",
+			Handler:    _Query_NodeAccountAll_Handler,
+		},
+		{
+			MethodName: "CrosschainFlags",
+			Handler:    _Query_CrosschainFlags_Handler,
+		},
+		{
+			MethodName: "ChainCrosschainFlags",
+			Handler:    _Query_ChainCrosschainFlags_Handler,
+		},
+		{
+			MethodName: "ChainCrosschainFlagsAll",
+			Handler:    _Query_ChainCrosschainFlagsAll_Handler,
+		},
+		{
+			MethodName: "Keygen",
+			Handler:    _Query_Keygen_Handler,
+		},
+		{
+			MethodName: "ShowObserverCount",
+			Handler:    _Query_ShowObserverCount_Handler,
+		},
+		{
+			MethodName: "BlameByIdentifier",
+			Handler:    _Query_BlameByIdentifier_Handler,
+		},
+		{
+			MethodName: "GetAllBlameRecords",
+			Handler:    _Query_GetAllBlameRecords_Handler,
+		},
+		{
+			MethodName: "BlamesByChainAndNonce",
+			Handler:    _Query_BlamesByChainAndNonce_Handler,
+		},
+		{
+			MethodName: "GetAllBlockHeaders",
+			Handler:    _Query_GetAllBlockHeaders_Handler,
+		},
+		{
+			MethodName: "GetBlockHeaderByHash",
+			Handler:    _Query_GetBlockHeaderByHash_Handler,
+		},
+		{
+			MethodName: "ChainState",
+			Handler:    _Query_ChainState_Handler,
+		},
+		{
+			MethodName: "ChainInfo",
+			Handler:    _Query_ChainInfo_Handler,
+		},
+		{
+			MethodName: "ChainInfoAll",
+			Handler:    _Query_ChainInfoAll_Handler,
+		},
+		{
+			MethodName: "BallotSummary",
+			Handler:    _Query_BallotSummary_Handler,
+		},
+		{
+			MethodName: "BallotSummaryAll",
+			Handler:    _Query_BallotSummaryAll_Handler,
+		},
+		{
+			MethodName: "Prove",
+			Handler:    _Query_Prove_Handler,
+		},
+		{
+			MethodName: "AdminProposal",
+			Handler:    _Query_AdminProposal_Handler,
+		},
+		{
+			MethodName: "PendingAdminProposals",
+			Handler:    _Query_PendingAdminProposals_Handler,
+		},
+		{
+			MethodName: "AdminSignerSets",
+			Handler:    _Query_AdminSignerSets_Handler,
+		},
+		{
+			MethodName: "NodeBlameScore",
+			Handler:    _Query_NodeBlameScore_Handler,
+		},
+		{
+			MethodName: "NodeBlameScoreAll",
+			Handler:    _Query_NodeBlameScoreAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "observer/query.proto",
+}
+
+func (m *QueryProveRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3557,34 +4176,56 @@ func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProveRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryProveResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3594,30 +4235,30 @@ func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProveResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+	if m.Valid {
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3627,34 +4268,20 @@ func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3664,32 +4291,30 @@ func (m *QueryAllBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlockHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlockHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3699,46 +4324,27 @@ func (m *QueryAllBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BlockHeaders) > 0 {
-		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
+func (m *VoterList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3748,27 +4354,32 @@ func (m *QueryGetBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+	if m.VoteType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3778,545 +4389,4348 @@ func (m *QueryGetBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeader != nil {
-		{
-			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if m.BallotStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ObservationType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryObserversByChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryProveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TxIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TxIndex))
-	}
-	return n
+
+func (m *QueryObserversByChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryObserversByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Valid {
-		n += 2
+	if len(m.ObservationChain) > 0 {
+		i -= len(m.ObservationChain)
+		copy(dAtA[i:], m.ObservationChain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObservationChain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryObserversByChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryObserversByChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBallotByIdentifierRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryObserversByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Observers) > 0 {
+		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Observers[iNdEx])
+			copy(dAtA[i:], m.Observers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *VoterList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllObserverMappersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllObserverMappersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllObserverMappersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.VoterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.VoteType != 0 {
-		n += 1 + sovQuery(uint64(m.VoteType))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBallotByIdentifierResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllObserverMappersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllObserverMappersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllObserverMappersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Voters) > 0 {
-		for _, e := range m.Voters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ObserverMappers) > 0 {
+		for iNdEx := len(m.ObserverMappers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverMappers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.ObservationType != 0 {
-		n += 1 + sovQuery(uint64(m.ObservationType))
-	}
-	if m.BallotStatus != 0 {
-		n += 1 + sovQuery(uint64(m.BallotStatus))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryObserversByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ObservationChain)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryObserversByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Observers) > 0 {
-		for _, s := range m.Observers {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllObserverMappersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllObserverMappersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ObserverMappers) > 0 {
-		for _, e := range m.ObserverMappers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySupportedChains) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySupportedChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Chains) > 0 {
-		for _, e := range m.Chains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCoreParamsForChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCoreParamsForChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCoreParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCoreParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCoreParamsForChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CoreParams != nil {
-		l = m.CoreParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetCoreParamsForChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetCoreParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryGetCoreParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCoreParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCoreParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.CoreParams != nil {
-		l = m.CoreParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.CoreParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNodeAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCoreParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCoreParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCoreParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNodeAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCoreParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCoreParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCoreParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NodeAccount != nil {
-		l = m.NodeAccount.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.CoreParams != nil {
+		{
+			size, err := m.CoreParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllNodeAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllNodeAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.NodeAccount) > 0 {
-		for _, e := range m.NodeAccount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.NodeAccount != nil {
+		{
+			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetCrosschainFlagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CrosschainFlags.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetKeygenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Keygen != nil {
-		l = m.Keygen.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LastObserverCount != nil {
-		l = m.LastObserverCount.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.NodeAccount) > 0 {
+		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlameIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByIdentifierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlameInfo != nil {
-		l = m.BlameInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlameRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for _, e := range m.BlameInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetChainCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetChainCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ChainCrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChainCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChainCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChainCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChainCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for _, e := range m.BlameInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainCrosschainFlags) > 0 {
+		for iNdEx := len(m.ChainCrosschainFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainCrosschainFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlockHeaderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlockHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Keygen != nil {
+		{
+			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlockHeaders) > 0 {
-		for _, e := range m.BlockHeaders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastObserverCount != nil {
+		{
+			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlameIdentifier) > 0 {
+		i -= len(m.BlameIdentifier)
+		copy(dAtA[i:], m.BlameIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlameInfo != nil {
+		{
+			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlockHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlockHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockHeaders) > 0 {
+		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockHeaderByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockHeaderByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockHeaderByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockHeaderByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		{
+			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainState != nil {
+		{
+			size, err := m.ChainState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ChainInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChainInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChainInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChainInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChainInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainInfo) > 0 {
+		for iNdEx := len(m.ChainInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBallotSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBallotSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBallotSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBallotSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBallotSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBallotSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BallotSummary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBallotSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBallotSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBallotSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBallotSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBallotSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBallotSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BallotSummary) > 0 {
+		for iNdEx := len(m.BallotSummary) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BallotSummary[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAdminProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAdminProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAdminProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAdminProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAdminProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAdminProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AdminProposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingAdminProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingAdminProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingAdminProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PolicyType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PolicyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FilterPolicyType {
+		i--
+		if m.FilterPolicyType {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingAdminProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingAdminProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingAdminProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AdminProposals) > 0 {
+		for iNdEx := len(m.AdminProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminSignerSetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminSignerSetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminSignerSetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminSignerSetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminSignerSetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminSignerSetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignerSets) > 0 {
+		for iNdEx := len(m.SignerSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNodeBlameScoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNodeBlameScoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNodeBlameScoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNodeBlameScoreResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNodeBlameScoreResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNodeBlameScoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Score != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.NodeBlameScore.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllNodeBlameScoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllNodeBlameScoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllNodeBlameScoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllNodeBlameScoreResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllNodeBlameScoreResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllNodeBlameScoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeBlameScores) > 0 {
+		for iNdEx := len(m.NodeBlameScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeBlameScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *QueryProveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBallotByIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VoterList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VoteType != 0 {
+		n += 1 + sovQuery(uint64(m.VoteType))
+	}
+	return n
+}
+
+func (m *QueryBallotByIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ObservationType != 0 {
+		n += 1 + sovQuery(uint64(m.ObservationType))
+	}
+	if m.BallotStatus != 0 {
+		n += 1 + sovQuery(uint64(m.BallotStatus))
+	}
+	return n
+}
+
+func (m *QueryObserversByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObservationChain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObserversByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Observers) > 0 {
+		for _, s := range m.Observers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllObserverMappersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllObserverMappersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObserverMappers) > 0 {
+		for _, e := range m.ObserverMappers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySupportedChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupportedChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetCoreParamsForChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetCoreParamsForChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoreParams != nil {
+		l = m.CoreParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCoreParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetCoreParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoreParams != nil {
+		l = m.CoreParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNodeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeAccount != nil {
+		l = m.NodeAccount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNodeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeAccount) > 0 {
+		for _, e := range m.NodeAccount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetCrosschainFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CrosschainFlags.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetChainCrosschainFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetChainCrosschainFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainCrosschainFlags.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChainCrosschainFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllChainCrosschainFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainCrosschainFlags) > 0 {
+		for _, e := range m.ChainCrosschainFlags {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetKeygenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetKeygenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keygen != nil {
+		l = m.Keygen.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShowObserverCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryShowObserverCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastObserverCount != nil {
+		l = m.LastObserverCount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlameIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlameInfo != nil {
+		l = m.BlameInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlameRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllBlameRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for _, e := range m.BlameInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryBlameByChainAndNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for _, e := range m.BlameInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllBlockHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockHeaders) > 0 {
+		for _, e := range m.BlockHeaders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlockHeaderByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlockHeaderByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		l = m.BlockHeader.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetChainStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainState != nil {
+		l = m.ChainState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetChainInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChainInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllChainInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainInfo) > 0 {
+		for _, e := range m.ChainInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetBallotSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBallotSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BallotSummary.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllBallotSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBallotSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BallotSummary) > 0 {
+		for _, e := range m.BallotSummary {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAdminProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetAdminProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AdminProposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingAdminProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FilterPolicyType {
+		n += 2
+	}
+	if m.PolicyType != 0 {
+		n += 1 + sovQuery(uint64(m.PolicyType))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingAdminProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AdminProposals) > 0 {
+		for _, e := range m.AdminProposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminSignerSetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAdminSignerSetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignerSets) > 0 {
+		for _, e := range m.SignerSets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetNodeBlameScoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNodeBlameScoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NodeBlameScore.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Score != 0 {
+		n += 1 + sovQuery(uint64(m.Score))
+	}
+	return n
+}
+
+func (m *QueryAllNodeBlameScoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllNodeBlameScoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeBlameScores) > 0 {
+		for _, e := range m.NodeBlameScores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &common.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoterList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoterList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoterList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			m.VoteType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteType |= VoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, &VoterList{})
+			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
+			}
+			m.ObservationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationType |= ObservationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
+			}
+			m.BallotStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotStatus |= BallotStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObserversByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserversByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserversByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObserversByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserversByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserversByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Observers = append(m.Observers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllObserverMappersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllObserverMappersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllObserverMappersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllObserverMappersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllObserverMappersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllObserverMappersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverMappers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverMappers = append(m.ObserverMappers, &ObserverMapper{})
+			if err := m.ObserverMappers[len(m.ObserverMappers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupportedChains: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupportedChains: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupportedChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupportedChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, &common.Chain{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCoreParamsForChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCoreParamsForChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCoreParamsForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCoreParamsForChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCoreParamsForChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCoreParamsForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CoreParams == nil {
+				m.CoreParams = &CoreParams{}
+			}
+			if err := m.CoreParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCoreParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCoreParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCoreParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCoreParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCoreParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCoreParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CoreParams == nil {
+				m.CoreParams = &CoreParamsList{}
+			}
+			if err := m.CoreParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNodeAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNodeAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeAccount == nil {
+				m.NodeAccount = &NodeAccount{}
+			}
+			if err := m.NodeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllNodeAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGetBlockHeaderByHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryGetBlockHeaderByHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeader != nil {
-		l = m.BlockHeader.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4339,36 +8753,17 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllNodeAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4378,27 +8773,29 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.NodeAccount = append(m.NodeAccount, &NodeAccount{})
+			if err := m.NodeAccount[len(m.NodeAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4425,18 +8822,118 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &common.Proof{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrosschainFlags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4446,29 +8943,80 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			if err := m.CrosschainFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainCrosschainFlagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainCrosschainFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.TxIndex = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4478,7 +9026,7 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= int64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4504,7 +9052,7 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4527,17 +9075,17 @@ func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainCrosschainFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainCrosschainFlags", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4547,12 +9095,25 @@ func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Valid = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainCrosschainFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4574,7 +9135,7 @@ func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChainCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4597,10 +9158,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChainCrosschainFlagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChainCrosschainFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4624,7 +9185,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChainCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4647,15 +9208,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChainCrosschainFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChainCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainCrosschainFlags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4682,7 +9243,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainCrosschainFlags = append(m.ChainCrosschainFlags, ChainCrosschainFlags{})
+			if err := m.ChainCrosschainFlags[len(m.ChainCrosschainFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4707,7 +9269,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4730,44 +9292,12 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeygenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeygenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4789,7 +9319,7 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoterList) Unmarshal(dAtA []byte) error {
+func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4812,17 +9342,17 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetKeygenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keygen", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4832,43 +9362,28 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoterAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			if m.Keygen == nil {
+				m.Keygen = &Keygen{}
 			}
-			m.VoteType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VoteType |= VoteType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Keygen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4890,7 +9405,7 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4913,47 +9428,65 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryShowObserverCountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryShowObserverCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShowObserverCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShowObserverCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObserverCount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4977,52 +9510,16 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voters = append(m.Voters, &VoterList{})
-			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
-			}
-			m.ObservationType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservationType |= ObservationType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
-			}
-			m.BallotStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BallotStatus |= BallotStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObserverCount == nil {
+				m.LastObserverCount = &LastObserverCount{}
+			}
+			if err := m.LastObserverCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5044,7 +9541,7 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryObserversByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,15 +9564,15 @@ func (m *QueryObserversByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObserversByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObserversByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5103,7 +9600,7 @@ func (m *QueryObserversByChainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservationChain = string(dAtA[iNdEx:postIndex])
+			m.BlameIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5126,7 +9623,7 @@ func (m *QueryObserversByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryObserversByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5149,17 +9646,17 @@ func (m *QueryObserversByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObserversByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObserversByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5169,23 +9666,27 @@ func (m *QueryObserversByChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Observers = append(m.Observers, string(dAtA[iNdEx:postIndex]))
+			if m.BlameInfo == nil {
+				m.BlameInfo = &Blame{}
+			}
+			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5208,7 +9709,7 @@ func (m *QueryObserversByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllObserverMappersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5231,10 +9732,10 @@ func (m *QueryAllObserverMappersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllObserverMappersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllObserverMappersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5258,7 +9759,7 @@ func (m *QueryAllObserverMappersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllObserverMappersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5281,15 +9782,15 @@ func (m *QueryAllObserverMappersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllObserverMappersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllObserverMappersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverMappers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5316,8 +9817,8 @@ func (m *QueryAllObserverMappersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObserverMappers = append(m.ObserverMappers, &ObserverMapper{})
-			if err := m.ObserverMappers[len(m.ObserverMappers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlameInfo = append(m.BlameInfo, &Blame{})
+			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5342,7 +9843,7 @@ func (m *QueryAllObserverMappersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5365,12 +9866,50 @@ func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupportedChains: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupportedChains: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5392,7 +9931,7 @@ func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5415,15 +9954,15 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupportedChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupportedChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5450,8 +9989,8 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, &common.Chain{})
-			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlameInfo = append(m.BlameInfo, &Blame{})
+			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5476,7 +10015,7 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoreParamsForChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5499,17 +10038,17 @@ func (m *QueryGetCoreParamsForChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoreParamsForChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBlockHeaderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoreParamsForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBlockHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5519,11 +10058,28 @@ func (m *QueryGetCoreParamsForChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5545,7 +10101,7 @@ func (m *QueryGetCoreParamsForChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoreParamsForChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5568,15 +10124,15 @@ func (m *QueryGetCoreParamsForChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoreParamsForChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBlockHeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoreParamsForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5603,10 +10159,44 @@ func (m *QueryGetCoreParamsForChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CoreParams == nil {
-				m.CoreParams = &CoreParams{}
+			m.BlockHeaders = append(m.BlockHeaders, &common.BlockHeader{})
+			if err := m.BlockHeaders[len(m.BlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.CoreParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5631,7 +10221,7 @@ func (m *QueryGetCoreParamsForChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoreParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5654,12 +10244,46 @@ func (m *QueryGetCoreParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoreParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBlockHeaderByHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoreParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBlockHeaderByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5681,7 +10305,7 @@ func (m *QueryGetCoreParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCoreParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5704,15 +10328,15 @@ func (m *QueryGetCoreParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCoreParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBlockHeaderByHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCoreParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBlockHeaderByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5739,10 +10363,10 @@ func (m *QueryGetCoreParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CoreParams == nil {
-				m.CoreParams = &CoreParamsList{}
+			if m.BlockHeader == nil {
+				m.BlockHeader = &common.BlockHeader{}
 			}
-			if err := m.CoreParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5767,7 +10391,7 @@ func (m *QueryGetCoreParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5790,17 +10414,17 @@ func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNodeAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var stringLen uint64
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5810,24 +10434,11 @@ func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5849,7 +10460,7 @@ func (m *QueryGetNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5872,15 +10483,15 @@ func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNodeAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5907,10 +10518,10 @@ func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeAccount == nil {
-				m.NodeAccount = &NodeAccount{}
+			if m.ChainState == nil {
+				m.ChainState = &ChainState{}
 			}
-			if err := m.NodeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChainState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5935,7 +10546,7 @@ func (m *QueryGetNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5958,17 +10569,17 @@ func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllNodeAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllNodeAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5978,28 +10589,11 @@ func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6021,7 +10615,7 @@ func (m *QueryAllNodeAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6044,49 +10638,15 @@ func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllNodeAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllNodeAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAccount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeAccount = append(m.NodeAccount, &NodeAccount{})
-			if err := m.NodeAccount[len(m.NodeAccount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6113,10 +10673,7 @@ func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChainInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6141,7 +10698,7 @@ func (m *QueryAllNodeAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChainInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6164,10 +10721,10 @@ func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChainInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChainInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6191,7 +10748,7 @@ func (m *QueryGetCrosschainFlagsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllChainInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6214,15 +10771,15 @@ func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllChainInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllChainInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrosschainFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6249,7 +10806,8 @@ func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CrosschainFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainInfo = append(m.ChainInfo, common.ChainInfo{})
+			if err := m.ChainInfo[len(m.ChainInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6274,7 +10832,7 @@ func (m *QueryGetCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBallotSummaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6297,12 +10855,44 @@ func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetKeygenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBallotSummaryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetKeygenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBallotSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6324,7 +10914,7 @@ func (m *QueryGetKeygenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetBallotSummaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6347,15 +10937,15 @@ func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetKeygenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetBallotSummaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetBallotSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keygen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotSummary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6382,10 +10972,7 @@ func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Keygen == nil {
-				m.Keygen = &Keygen{}
-			}
-			if err := m.Keygen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BallotSummary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6410,7 +10997,7 @@ func (m *QueryGetKeygenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBallotSummaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6433,12 +11020,48 @@ func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowObserverCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBallotSummaryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowObserverCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBallotSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6460,7 +11083,7 @@ func (m *QueryShowObserverCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllBallotSummaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6483,15 +11106,15 @@ func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowObserverCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllBallotSummaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowObserverCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllBallotSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObserverCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotSummary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6518,10 +11141,44 @@ func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastObserverCount == nil {
-				m.LastObserverCount = &LastObserverCount{}
+			m.BallotSummary = append(m.BallotSummary, BallotSummary{})
+			if err := m.BallotSummary[len(m.BallotSummary)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.LastObserverCount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6546,7 +11203,7 @@ func (m *QueryShowObserverCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAdminProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6569,17 +11226,17 @@ func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAdminProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAdminProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameIdentifier", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6589,24 +11246,11 @@ func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlameIdentifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6628,7 +11272,7 @@ func (m *QueryBlameByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAdminProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6651,15 +11295,15 @@ func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAdminProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAdminProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6684,12 +11328,9 @@ func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BlameInfo == nil {
-				m.BlameInfo = &Blame{}
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AdminProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6714,7 +11355,7 @@ func (m *QueryBlameByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingAdminProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6737,12 +11378,87 @@ func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingAdminProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingAdminProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterPolicyType", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FilterPolicyType = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyType", wireType)
+			}
+			m.PolicyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyType |= Policy_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6764,7 +11480,7 @@ func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingAdminProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6787,15 +11503,15 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingAdminProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingAdminProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6822,8 +11538,44 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlameInfo = append(m.BlameInfo, &Blame{})
-			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AdminProposals = append(m.AdminProposals, AdminProposal{})
+			if err := m.AdminProposals[len(m.AdminProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6848,7 +11600,7 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAdminSignerSetsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6871,50 +11623,12 @@ func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAdminSignerSetsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAdminSignerSetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6936,7 +11650,7 @@ func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAdminSignerSetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6959,15 +11673,15 @@ func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAdminSignerSetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAdminSignerSetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6994,8 +11708,8 @@ func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlameInfo = append(m.BlameInfo, &Blame{})
-			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignerSets = append(m.SignerSets, AdminSignerSet{})
+			if err := m.SignerSets[len(m.SignerSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7020,7 +11734,7 @@ func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlockHeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNodeBlameScoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7043,17 +11757,17 @@ func (m *QueryAllBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlockHeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNodeBlameScoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlockHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNodeBlameScoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7063,27 +11777,23 @@ func (m *QueryAllBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7106,7 +11816,7 @@ func (m *QueryAllBlockHeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNodeBlameScoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7129,15 +11839,15 @@ func (m *QueryAllBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlockHeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNodeBlameScoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNodeBlameScoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeBlameScore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7164,16 +11874,15 @@ func (m *QueryAllBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHeaders = append(m.BlockHeaders, &common.BlockHeader{})
-			if err := m.BlockHeaders[len(m.BlockHeaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NodeBlameScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			var msglen int
+			m.Score = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7183,28 +11892,11 @@ func (m *QueryAllBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Score |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7226,7 +11918,7 @@ func (m *QueryAllBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllNodeBlameScoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7249,46 +11941,12 @@ func (m *QueryGetBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderByHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllNodeBlameScoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllNodeBlameScoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockHash == nil {
-				m.BlockHash = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7310,7 +11968,7 @@ func (m *QueryGetBlockHeaderByHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllNodeBlameScoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7333,15 +11991,15 @@ func (m *QueryGetBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderByHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllNodeBlameScoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBlockHeaderByHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllNodeBlameScoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeBlameScores", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7368,10 +12026,8 @@ func (m *QueryGetBlockHeaderByHashResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockHeader == nil {
-				m.BlockHeader = &common.BlockHeader{}
-			}
-			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NodeBlameScores = append(m.NodeBlameScores, NodeBlameScore{})
+			if err := m.NodeBlameScores[len(m.NodeBlameScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
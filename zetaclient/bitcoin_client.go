@@ -22,11 +22,13 @@ import (
 	"sync/atomic"
 
 	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcutil"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/rs/zerolog"
 	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/common/bitcoin"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/config"
@@ -57,18 +59,22 @@ type BitcoinChainClient struct {
 	lastBlockScanned int64
 	BlockTime        uint64 // block time in seconds
 
+	lastConsolidationHeight int64 // the zeta height of the last UTXO consolidation attempt
+
 	mu                *sync.Mutex                             // lock for pending nonce, all the maps, utxos and core params
 	pendingNonce      uint64                                  // the artificial pending nonce (next nonce to process) for outTx
-	includedTxHashes  map[string]uint64                       // key: tx hash
+	includedTxHashes  map[string]uint64                       // key: tx hash, value: last nonce paid by the outTx
 	includedTxResults map[string]btcjson.GetTransactionResult // key: chain-tss-nonce
 	broadcastedTx     map[string]string                       // key: chain-tss-nonce, value: outTx hash
 	utxos             []btcjson.ListUnspentResult
+	reservedUTXOs     []btcjson.ListUnspentResult // utxos spent by the consolidation tx being signed
 	params            observertypes.CoreParams
 
-	db     *gorm.DB
-	stop   chan struct{}
-	logger BTCLog
-	ts     *TelemetryServer
+	db      *gorm.DB
+	journal *SigningJournal
+	stop    chan struct{}
+	logger  BTCLog
+	ts      *TelemetryServer
 
 	BlockCache *lru.Cache
 }
@@ -78,6 +84,15 @@ const (
 	maxHeightDiff    = 10000
 	btcBlocksPerDay  = 144
 	bytesPerKB       = 1000
+
+	// UTXOs are consolidated at most once every utxoConsolidationInterval zeta blocks
+	// signers of the same interval use the same keysign height
+	utxoConsolidationInterval = 100
+	minConsolidationOutput    = 1000 // the consolidated output must be well above dust (in satoshis)
+
+	// the keysign nonce of the consolidation txs, no outTx is signed with it so the keysign blames of a consolidation are
+	// not attributed to an outTx
+	utxoConsolidationKeysignNonce = math2.MaxUint64
 )
 
 func (ob *BitcoinChainClient) SetCoreParams(params observertypes.CoreParams) {
@@ -147,6 +162,14 @@ func NewBitcoinClient(chain common.Chain, bridge *ZetaCoreBridge, tss TSSSigner,
 	if err != nil {
 		return nil, err
 	}
+	err = ob.RegisterPromGauge(metricsPkg.UTXOCount, "Number of TSS UTXOs")
+	if err != nil {
+		return nil, err
+	}
+	err = ob.RegisterPromGauge(metricsPkg.UTXOFragmentation, "Share of the TSS balance that can't be spent by a single outTx")
+	if err != nil {
+		return nil, err
+	}
 
 	//Load btc chain client DB
 	err = ob.loadDB(dbpath)
@@ -163,6 +186,8 @@ func (ob *BitcoinChainClient) Start() {
 	go ob.observeOutTx()
 	go ob.WatchUTXOS()
 	go ob.WatchGasPrice()
+	go ob.WatchUTXOConsolidation()
+	go ob.recoverSigningJournal()
 }
 
 func (ob *BitcoinChainClient) Stop() {
@@ -270,6 +295,13 @@ func (ob *BitcoinChainClient) observeInTx() error {
 	if !flags.IsInboundEnabled {
 		return errors.New("inbound TXS / Send has been disabled by the protocol")
 	}
+	chainFlags, err := ob.zetaClient.GetChainCrosschainFlags(ob.chain.ChainId)
+	if err != nil {
+		return err
+	}
+	if !chainFlags.IsInboundEnabled {
+		return fmt.Errorf("inbound TXS / Send has been disabled for chain %s", ob.chain.ChainName)
+	}
 
 	lastBN := ob.GetLastBlockHeightScanned()
 
@@ -313,7 +345,11 @@ func (ob *BitcoinChainClient) observeInTx() error {
 
 		tssAddress := ob.Tss.BTCAddress()
 		// #nosec G701 always positive
-		inTxs := FilterAndParseIncomingTx(res.Block.Tx, uint64(res.Block.Height), tssAddress, &ob.logger.WatchInTx)
+		inTxs, err := FilterAndParseIncomingTx(ob.rpcClient, res.Block.Tx, uint64(res.Block.Height), tssAddress, &ob.logger.WatchInTx)
+		if err != nil {
+			ob.logger.WatchInTx.Error().Err(err).Msgf("error filtering incoming txs for block %d", bn)
+			return err
+		}
 
 		for _, inTx := range inTxs {
 			ob.logger.WatchInTx.Debug().Msgf("Processing inTx: %s", inTx.TxHash)
@@ -416,7 +452,14 @@ func (ob *BitcoinChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64
 	}
 
 	// It's safe to use cctx's amount to post confirmation because it has already been verified in observeOutTx()
+	// the amount of a cmd outTx is the payment of its nonce
 	amountInSat := params.Amount.BigInt()
+	if params.CoinType == common.CoinType_Cmd {
+		amountInSat, err = ob.paymentAmount(res, nonce)
+		if err != nil {
+			return true, false, err
+		}
+	}
 	if res.Confirmations < ob.ConfirmationsThreshold(amountInSat) {
 		return true, false, nil
 	}
@@ -434,7 +477,7 @@ func (ob *BitcoinChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64
 		common.ReceiveStatus_Success,
 		ob.chain,
 		nonce,
-		common.CoinType_Gas,
+		params.CoinType,
 	)
 	if err != nil {
 		logger.Error().Err(err).Msgf("error posting to zeta core")
@@ -444,6 +487,32 @@ func (ob *BitcoinChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64
 	return true, true, nil
 }
 
+// paymentAmount returns the amount of the payment of the nonce in the included outTx
+func (ob *BitcoinChainClient) paymentAmount(res btcjson.GetTransactionResult, nonce uint64) (*big.Int, error) {
+	hash, err := chainhash.NewHashFromStr(res.TxID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "paymentAmount: error NewHashFromStr: %s", res.TxID)
+	}
+	rawResult, err := ob.getRawTxResult(hash, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "paymentAmount: error getting raw tx %s", res.TxID)
+	}
+	first, _, err := ob.outTxNonceRange(rawResult.Vout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "paymentAmount: invalid nonce range in outTx %s", res.TxID)
+	}
+	// #nosec G701 always in range
+	paymentIndex := int(1 + nonce - first)
+	if nonce < first || paymentIndex >= len(rawResult.Vout) {
+		return nil, fmt.Errorf("paymentAmount: outTx %s doesn't pay nonce %d", res.TxID, nonce)
+	}
+	amount, err := getSatoshis(rawResult.Vout[paymentIndex].Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "paymentAmount: error getting satoshis")
+	}
+	return big.NewInt(amount), nil
+}
+
 func (ob *BitcoinChainClient) WatchGasPrice() {
 	ticker := NewDynamicTicker("Bitcoin_WatchGasPrice", ob.GetCoreParams().GasPriceTicker)
 	defer ticker.Stop()
@@ -469,7 +538,7 @@ func (ob *BitcoinChainClient) PostGasPrice() error {
 			return err
 		}
 		// #nosec G701 always in range
-		zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, 1000, "100", uint64(bn))
+		zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, 1000, 0, "100", uint64(bn))
 		if err != nil {
 			ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice:")
 			return err
@@ -497,7 +566,7 @@ func (ob *BitcoinChainClient) PostGasPrice() error {
 		return err
 	}
 	// #nosec G701 always positive
-	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, feeRatePerByte.Uint64(), "100", uint64(bn))
+	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, feeRatePerByte.Uint64(), 0, "100", uint64(bn))
 	if err != nil {
 		ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice:")
 		return err
@@ -521,7 +590,13 @@ type BTCInTxEvnet struct {
 // relevant tx must have the following vouts as the first two vouts:
 // vout0: p2wpkh to the TSS address (targetAddress)
 // vout1: OP_RETURN memo, base64 encoded
-func FilterAndParseIncomingTx(txs []btcjson.TxRawResult, blockNumber uint64, targetAddress string, logger *zerolog.Logger) []*BTCInTxEvnet {
+func FilterAndParseIncomingTx(
+	rpcClient BTCRPCClient,
+	txs []btcjson.TxRawResult,
+	blockNumber uint64,
+	targetAddress string,
+	logger *zerolog.Logger,
+) ([]*BTCInTxEvnet, error) {
 	inTxs := make([]*BTCInTxEvnet, 0)
 	for idx, tx := range txs {
 		if idx == 0 {
@@ -578,23 +653,16 @@ func FilterAndParseIncomingTx(txs []btcjson.TxRawResult, blockNumber uint64, tar
 		if found {
 			var fromAddress string
 			if len(tx.Vin) > 0 {
-				vin := tx.Vin[0]
-				//log.Info().Msgf("vin: %v", vin.Witness)
-				if len(vin.Witness) == 2 {
-					pk := vin.Witness[1]
-					pkBytes, err := hex.DecodeString(pk)
-					if err != nil {
-						logger.Warn().Msgf("error decoding pubkey: %s", err)
-						break
-					}
-					hash := btcutil.Hash160(pkBytes)
-					addr, err := btcutil.NewAddressWitnessPubKeyHash(hash, config.BitconNetParams)
-					if err != nil {
-						logger.Warn().Msgf("error decoding pubkey hash: %s", err)
-						break
-					}
-					fromAddress = addr.EncodeAddress()
+				// the block is scanned again if the previous output can't be queried, the deposit is still observed
+				// with an empty sender if the previous output script is non-standard
+				from, err := GetSenderAddressByVin(rpcClient, tx.Vin[0], config.BitconNetParams)
+				if err != nil {
+					return nil, errors.Wrapf(err, "error getting sender address for inbound tx %s", tx.Txid)
 				}
+				if from == "" {
+					logger.Warn().Msgf("cannot derive sender address for inbound tx %s", tx.Txid)
+				}
+				fromAddress = from
 			}
 			inTxs = append(inTxs, &BTCInTxEvnet{
 				FromAddress: fromAddress,
@@ -606,7 +674,61 @@ func FilterAndParseIncomingTx(txs []btcjson.TxRawResult, blockNumber uint64, tar
 			})
 		}
 	}
-	return inTxs
+	return inTxs, nil
+}
+
+// BTCRPCClient is the bitcoin rpc interface used to look up the previous outputs of inbound txs
+type BTCRPCClient interface {
+	GetRawTransaction(txHash *chainhash.Hash) (*btcutil.Tx, error)
+}
+
+// GetSenderAddressByVin returns the address spent by the input
+// the address is derived from the witness for P2WPKH, P2SH-P2WPKH and P2WSH inputs, other inputs (e.g. P2TR)
+// are resolved by querying the previous output
+// an empty address is returned if the previous output script is non-standard, an error is returned if the previous
+// output can't be queried so the inbound tx is observed again
+func GetSenderAddressByVin(rpcClient BTCRPCClient, vin btcjson.Vin, net *chaincfg.Params) (string, error) {
+	witness := make([][]byte, len(vin.Witness))
+	for i, w := range vin.Witness {
+		b, err := hex.DecodeString(w)
+		if err != nil {
+			return "", errors.Wrapf(err, "error decoding witness %s", w)
+		}
+		witness[i] = b
+	}
+	var sigScript []byte
+	if vin.ScriptSig != nil {
+		b, err := hex.DecodeString(vin.ScriptSig.Hex)
+		if err != nil {
+			return "", errors.Wrapf(err, "error decoding signature script %s", vin.ScriptSig.Hex)
+		}
+		sigScript = b
+	}
+	addr, err := bitcoin.SenderAddressFromWitness(witness, sigScript, net)
+	if err != nil {
+		return "", err
+	}
+	if addr != nil {
+		return addr.EncodeAddress(), nil
+	}
+
+	// query the previous output
+	hash, err := chainhash.NewHashFromStr(vin.Txid)
+	if err != nil {
+		return "", err
+	}
+	prevTx, err := rpcClient.GetRawTransaction(hash)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting previous tx %s", vin.Txid)
+	}
+	if int(vin.Vout) >= len(prevTx.MsgTx().TxOut) {
+		return "", fmt.Errorf("vout index %d out of range for previous tx %s", vin.Vout, vin.Txid)
+	}
+	addr, err = bitcoin.AddressFromScript(prevTx.MsgTx().TxOut[vin.Vout].PkScript, net)
+	if err != nil {
+		return "", nil
+	}
+	return addr.EncodeAddress(), nil
 }
 
 func (ob *BitcoinChainClient) WatchUTXOS() {
@@ -671,12 +793,292 @@ func (ob *BitcoinChainClient) FetchUTXOS() error {
 	})
 
 	ob.mu.Lock()
+	// the utxos reserved by a consolidation are listed until the consolidation tx is broadcasted
+	utxos = removeUTXOs(utxos, ob.reservedUTXOs)
 	ob.ts.SetNumberOfUTXOs(len(utxos))
 	ob.utxos = utxos
 	ob.mu.Unlock()
+
+	// update utxo metrics
+	if gauge, err := ob.GetPromGauge(metricsPkg.UTXOCount); err == nil {
+		gauge.Set(float64(len(utxos)))
+	}
+	if gauge, err := ob.GetPromGauge(metricsPkg.UTXOFragmentation); err == nil {
+		gauge.Set(UTXOFragmentation(utxos))
+	}
+	return nil
+}
+
+// UTXOFragmentation returns the share of the total value of the utxos that can't be spent by a single outTx
+// utxos must be sorted by amount in ascending order, an outTx spends at most maxNoOfInputsPerTx utxos
+func UTXOFragmentation(utxos []btcjson.ListUnspentResult) float64 {
+	total, spendable := 0.0, 0.0
+	for i, utxo := range utxos {
+		total += utxo.Amount
+		if i >= len(utxos)-maxNoOfInputsPerTx {
+			spendable += utxo.Amount
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return 1 - spendable/total
+}
+
+// WatchUTXOConsolidation periodically consolidates the small TSS utxos
+func (ob *BitcoinChainClient) WatchUTXOConsolidation() {
+	ticker := NewDynamicTicker("Bitcoin_WatchUTXOConsolidation", ob.GetCoreParams().WatchUtxoTicker)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C():
+			err := ob.TryConsolidateUTXOs()
+			if err != nil {
+				ob.logger.WatchUTXOS.Error().Err(err).Msg("error consolidating btc utxos")
+			}
+			ticker.UpdateInterval(ob.GetCoreParams().WatchUtxoTicker, ob.logger.WatchUTXOS)
+		case <-ob.stop:
+			ob.logger.WatchUTXOS.Info().Msg("WatchUTXOConsolidation stopped")
+			return
+		}
+	}
+}
+
+// TryConsolidateUTXOs signs and broadcasts a self-spend merging the smallest TSS utxos into a single utxo
+// The consolidation happens when:
+//   - the number of TSS utxos exceeds the consolidation threshold of the core params
+//   - the median fee rate doesn't exceed the maximum consolidation fee rate of the core params
+//   - there is no pending outTx, so the consolidation doesn't compete with an outTx for the same utxos
+//
+// The nonce-mark utxo is never spent, the next outTx still spends it as its first input.
+// The consolidated utxos are reserved before the keysign so an outTx scheduled meanwhile selects its utxos among the
+// other utxos, the reservation is released once the consolidation tx is broadcasted or failed.
+func (ob *BitcoinChainClient) TryConsolidateUTXOs() error {
+	params := ob.GetCoreParams()
+	if params.UtxoConsolidationThreshold == 0 {
+		return nil
+	}
+
+	// all signers of the same interval use the same keysign height
+	bn, err := ob.zetaClient.GetZetaBlockHeight()
+	if err != nil {
+		return errors.Wrap(err, "TryConsolidateUTXOs: error getting zeta block height")
+	}
+	keysignHeight := bn - bn%utxoConsolidationInterval
+	if keysignHeight <= ob.lastConsolidationHeight {
+		return nil
+	}
+
+	// consolidate only when fees are low
+	feeRate, err := ob.zetaClient.GetMedianGasPrice(ob.chain.ChainId)
+	if err != nil {
+		return errors.Wrap(err, "TryConsolidateUTXOs: error getting median gas price")
+	}
+	if feeRate > params.UtxoConsolidationMaxFeeRate {
+		ob.logger.WatchUTXOS.Info().Msgf("TryConsolidateUTXOs: fee rate %d above max consolidation fee rate %d", feeRate, params.UtxoConsolidationMaxFeeRate)
+		return nil
+	}
+
+	// consolidate only when all outTxs are processed
+	p, err := ob.zetaClient.GetPendingNoncesByChain(ob.chain.ChainId)
+	if err != nil {
+		return errors.Wrap(err, "TryConsolidateUTXOs: error getting pending nonces")
+	}
+	pendingNonce := ob.GetPendingNonce()
+	nonceMarkTxid := ""
+	if pendingNonce > 0 {
+		nonceMarkTxid, err = ob.getOutTxidByNonce(pendingNonce-1, false)
+		if err != nil {
+			return errors.Wrap(err, "TryConsolidateUTXOs: error getting nonce-mark txid")
+		}
+	}
+
+	prevOuts := ob.reserveConsolidationUTXOs(p, pendingNonce, params.UtxoConsolidationThreshold, feeRate, nonceMarkTxid)
+	if len(prevOuts) == 0 {
+		return nil
+	}
+	defer ob.releaseReservedUTXOs()
+
+	// build the self-spend with a single output to TSS self
+	tx, err := ob.buildConsolidationTx(prevOuts, feeRate)
+	if err != nil {
+		return errors.Wrap(err, "TryConsolidateUTXOs: error building consolidation tx")
+	}
+	// #nosec G701 always positive
+	err = SignTSSInputs(ob.Tss, tx, prevOuts, uint64(keysignHeight), utxoConsolidationKeysignNonce, &ob.chain)
+	if err != nil {
+		return errors.Wrap(err, "TryConsolidateUTXOs: error signing consolidation tx")
+	}
+	// the consolidation is signed at most once per interval, the other signers broadcast the same tx
+	ob.lastConsolidationHeight = keysignHeight
+
+	hash, err := ob.rpcClient.SendRawTransaction(tx, true)
+	if err != nil {
+		return errors.Wrap(err, "TryConsolidateUTXOs: error broadcasting consolidation tx")
+	}
+	ob.logger.WatchUTXOS.Info().Msgf("TryConsolidateUTXOs: consolidated %d utxos in tx %s", len(prevOuts), hash)
 	return nil
 }
 
+// reserveConsolidationUTXOs selects the utxos to consolidate and removes them from the utxos available to the outTxs
+// returns nil if an outTx is pending or if there is nothing to consolidate
+func (ob *BitcoinChainClient) reserveConsolidationUTXOs(
+	p types.PendingNonces,
+	pendingNonce uint64,
+	threshold uint64,
+	feeRate uint64,
+	nonceMarkTxid string,
+) []btcjson.ListUnspentResult {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	// #nosec G701 always non-negative
+	if p.NonceHigh > p.NonceLow || uint64(p.NonceLow) != pendingNonce || ob.pendingNonce != pendingNonce {
+		return nil
+	}
+	prevOuts := SelectConsolidationUTXOs(ob.utxos, threshold, feeRate, nonceMarkTxid)
+	if len(prevOuts) == 0 {
+		return nil
+	}
+	ob.utxos = removeUTXOs(ob.utxos, prevOuts)
+	ob.reservedUTXOs = prevOuts
+	return prevOuts
+}
+
+// releaseReservedUTXOs releases the utxos reserved by the consolidation
+// the utxos of a failed consolidation are available again once the utxos are fetched
+func (ob *BitcoinChainClient) releaseReservedUTXOs() {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	ob.reservedUTXOs = nil
+}
+
+// removeUTXOs returns utxos without the spent utxos, the order of the utxos is kept
+func removeUTXOs(utxos []btcjson.ListUnspentResult, spent []btcjson.ListUnspentResult) []btcjson.ListUnspentResult {
+	spentSet := make(map[string]bool, len(spent))
+	for _, utxo := range spent {
+		spentSet[fmt.Sprintf("%s:%d", utxo.TxID, utxo.Vout)] = true
+	}
+	left := make([]btcjson.ListUnspentResult, 0, len(utxos))
+	for _, utxo := range utxos {
+		if !spentSet[fmt.Sprintf("%s:%d", utxo.TxID, utxo.Vout)] {
+			left = append(left, utxo)
+		}
+	}
+	return left
+}
+
+// ConsolidateUTXOsForMigration consolidates the TSS utxos that don't fit in the migration outTx of nonce, the migration
+// outTx must wait until it can sweep all the TSS utxos so no fund is left to the TSS being migrated
+// returns true if the migration outTx must wait: a consolidation tx has been broadcasted or is still unconfirmed
+// the remaining utxos that can't be consolidated (dust) are left to the TSS
+func (ob *BitcoinChainClient) ConsolidateUTXOsForMigration(nonce uint64, feeRate uint64, height uint64) (bool, error) {
+	nonceMarkTxid := ""
+	if nonce > 0 {
+		txid, err := ob.getOutTxidByNonce(nonce-1, false)
+		if err != nil {
+			return false, errors.Wrap(err, "ConsolidateUTXOsForMigration: error getting nonce-mark txid")
+		}
+		nonceMarkTxid = txid
+	}
+
+	ob.mu.Lock()
+	utxos := make([]btcjson.ListUnspentResult, len(ob.utxos))
+	copy(utxos, ob.utxos)
+	ob.mu.Unlock()
+
+	// the nonce-mark utxo is swept in addition to the largest utxos
+	if len(utxos) <= maxNoOfInputsPerSweepTx+1 {
+		return false, nil
+	}
+	prevOuts := SelectConsolidationUTXOs(utxos, maxNoOfInputsPerSweepTx+1, feeRate, nonceMarkTxid)
+	if len(prevOuts) == 0 {
+		// wait for the previous consolidation to be confirmed before sweeping
+		for _, utxo := range utxos {
+			if utxo.Confirmations == 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	tx, err := ob.buildConsolidationTx(prevOuts, feeRate)
+	if err != nil {
+		return false, errors.Wrap(err, "ConsolidateUTXOsForMigration: error building consolidation tx")
+	}
+	err = SignTSSInputs(ob.Tss, tx, prevOuts, height, nonce, &ob.chain)
+	if err != nil {
+		return false, errors.Wrap(err, "ConsolidateUTXOsForMigration: error signing consolidation tx")
+	}
+	hash, err := ob.rpcClient.SendRawTransaction(tx, true)
+	if err != nil {
+		return false, errors.Wrap(err, "ConsolidateUTXOsForMigration: error broadcasting consolidation tx")
+	}
+	ob.logger.WatchUTXOS.Info().Msgf("ConsolidateUTXOsForMigration: consolidated %d of %d utxos in tx %s before migration nonce %d", len(prevOuts), len(utxos), hash, nonce)
+	return true, nil
+}
+
+// buildConsolidationTx builds the unsigned tx spending prevOuts to TSS self, the fee is paid at feeRate (in satoshis per byte)
+func (ob *BitcoinChainClient) buildConsolidationTx(prevOuts []btcjson.ListUnspentResult, feeRate uint64) (*wire.MsgTx, error) {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	total := int64(0)
+	for _, prevOut := range prevOuts {
+		hash, err := chainhash.NewHashFromStr(prevOut.TxID)
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, prevOut.Vout), nil, nil))
+		sats, err := getSatoshis(prevOut.Amount)
+		if err != nil {
+			return nil, err
+		}
+		total += sats
+	}
+	// #nosec G701 always in range
+	fees := int64(feeRate) * int64(bytesPerInput*len(prevOuts)+bytesPerOutput)
+	if total-fees < minConsolidationOutput {
+		return nil, fmt.Errorf("consolidated amount %d below minimum output %d", total-fees, minConsolidationOutput)
+	}
+	payToSelf, err := payToWitnessPubKeyHashScript(ob.Tss.BTCAddressWitnessPubkeyHash().WitnessProgram())
+	if err != nil {
+		return nil, err
+	}
+	tx.AddTxOut(wire.NewTxOut(total-fees, payToSelf))
+	return tx, nil
+}
+
+// SelectConsolidationUTXOs selects up to maxNoOfInputsPerTx of the smallest utxos to consolidate
+// utxos must be sorted by amount in ascending order, the following utxos are never selected:
+//   - the nonce-mark utxo (1st output of the outTx nonceMarkTxid)
+//   - the unconfirmed utxos, so all signers select the same utxos
+//   - the dust utxos that cost more to spend than their value at feeRate (in satoshis per byte)
+//
+// No utxo is selected if the number of utxos doesn't exceed the threshold or if less than two utxos can be merged.
+func SelectConsolidationUTXOs(utxos []btcjson.ListUnspentResult, threshold uint64, feeRate uint64, nonceMarkTxid string) []btcjson.ListUnspentResult {
+	if uint64(len(utxos)) <= threshold {
+		return nil
+	}
+	// #nosec G701 always in range
+	dust := int64(feeRate) * bytesPerInput
+	selected := make([]btcjson.ListUnspentResult, 0, maxNoOfInputsPerTx)
+	for _, utxo := range utxos {
+		if len(selected) >= maxNoOfInputsPerTx {
+			break
+		}
+		if utxo.Confirmations == 0 || (utxo.TxID == nonceMarkTxid && utxo.Vout == 0) {
+			continue
+		}
+		sats, err := getSatoshis(utxo.Amount)
+		if err != nil || sats <= dust {
+			continue
+		}
+		selected = append(selected, utxo)
+	}
+	if len(selected) < 2 {
+		return nil
+	}
+	return selected
+}
+
 // refreshPendingNonce tries increasing the artificial pending nonce of outTx (if lagged behind).
 // There could be many (unpredictable) reasons for a pending nonce lagging behind, for example:
 // 1. The zetaclient gets restarted.
@@ -765,7 +1167,7 @@ func (ob *BitcoinChainClient) findNonceMarkUTXO(nonce uint64, txid string) (int,
 // Parameters:
 //   - amount: The desired minimum total value of the selected UTXOs.
 //   - utxoCap: The maximum number of UTXOs to be selected.
-//   - nonce: The nonce of the outbound transaction.
+//   - nonce: The nonce of the outbound transaction, the first nonce for a batched outbound transaction.
 //   - test: true for unit test only.
 //
 // Returns: a sublist (includes previous nonce-mark) of UTXOs or an error if the qulifying sublist cannot be found.
@@ -824,6 +1226,58 @@ func (ob *BitcoinChainClient) SelectUTXOs(amount float64, utxoCap uint8, nonce u
 	return results, total, nil
 }
 
+// SelectSweepUTXOs selects the largest utxos to be swept by the outTx migrating the TSS funds.
+//
+// Parameters:
+//   - utxoCap: The maximum number of UTXOs to be selected, the nonce-mark is not counted.
+//   - nonce: The nonce of the outbound transaction.
+//   - test: true for unit test only.
+//
+// Returns: a sublist (includes previous nonce-mark as 1st input) of UTXOs and their total value.
+func (ob *BitcoinChainClient) SelectSweepUTXOs(utxoCap uint8, nonce uint64, test bool) ([]btcjson.ListUnspentResult, float64, error) {
+	idx := -1
+	if nonce == 0 {
+		ob.mu.Lock()
+		defer ob.mu.Unlock()
+	} else {
+		// we proceed only when we see the nonce-mark utxo
+		preTxid, err := ob.getOutTxidByNonce(nonce-1, test)
+		if err != nil {
+			return nil, 0, err
+		}
+		ob.mu.Lock()
+		defer ob.mu.Unlock()
+		idx, err = ob.findNonceMarkUTXO(nonce-1, preTxid)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// the utxos are sorted in ascending order, the largest ones are selected
+	results := make([]btcjson.ListUnspentResult, 0, int(utxoCap)+1)
+	if idx >= 0 {
+		results = append(results, ob.utxos[idx])
+	}
+	total := 0.0
+	for i := len(ob.utxos) - 1; i >= 0 && utxoCap > 0; i-- {
+		if i == idx {
+			continue
+		}
+		results = append(results, ob.utxos[i])
+		utxoCap--
+	}
+	for _, utxo := range results {
+		total += utxo.Amount
+	}
+	if len(results) < len(ob.utxos) {
+		ob.logger.ObserveOutTx.Warn().Msgf("SelectSweepUTXOs: %d of %d utxos selected for nonce %d", len(results), len(ob.utxos), nonce)
+	}
+	if len(results) == 0 {
+		return nil, 0, fmt.Errorf("SelectSweepUTXOs: no utxo to sweep for nonce %d", nonce)
+	}
+	return results, total, nil
+}
+
 // Save successfully broadcasted transaction
 func (ob *BitcoinChainClient) SaveBroadcastedTx(txHash string, nonce uint64) {
 	outTxID := ob.GetTxID(nonce)
@@ -894,6 +1348,7 @@ func (ob *BitcoinChainClient) observeOutTx() {
 }
 
 // checkNSaveIncludedTx either includes a new outTx or update an existing outTx result.
+// A batched outTx is included once for each nonce it pays.
 // Returns inMempool, error
 func (ob *BitcoinChainClient) checkNSaveIncludedTx(txHash string, params types.OutboundTxParams) (bool, error) {
 	outTxID := ob.GetTxID(params.OutboundTxTssNonce)
@@ -902,38 +1357,40 @@ func (ob *BitcoinChainClient) checkNSaveIncludedTx(txHash string, params types.O
 		return false, errors.Wrapf(err, "checkNSaveIncludedTx: error GetTxResultByHash: %s", txHash)
 	}
 	if getTxResult.Confirmations >= 0 { // check included tx only
-		err = ob.checkTssOutTxResult(hash, getTxResult, params, params.OutboundTxTssNonce)
+		last, err := ob.checkTssOutTxResult(hash, getTxResult, params, params.OutboundTxTssNonce)
 		if err != nil {
 			return false, errors.Wrapf(err, "checkNSaveIncludedTx: error verify bitcoin outTx %s outTxID %s", txHash, outTxID)
 		}
 
 		ob.mu.Lock()
 		defer ob.mu.Unlock()
-		nonce, foundHash := ob.includedTxHashes[txHash]
+		lastNonce, foundHash := ob.includedTxHashes[txHash]
 		res, foundRes := ob.includedTxResults[outTxID]
 
-		// include new outTx and enforce rigid 1-to-1 mapping: outTxID(nonce) <===> txHash
-		if !foundHash && !foundRes {
-			ob.includedTxHashes[txHash] = params.OutboundTxTssNonce
+		// include new outTx and enforce rigid mapping: outTxID(nonce) ===> txHash
+		// the hash might have been included already for another nonce of the same batched outTx
+		if !foundRes {
+			if foundHash && lastNonce != last {
+				ob.logger.ObserveOutTx.Error().Msgf("checkNSaveIncludedTx: unreachable code path! outTx %s outTxID %s, prior last nonce %d, current last nonce %d", txHash, outTxID, lastNonce, last)
+				return false, nil
+			}
+			ob.includedTxHashes[txHash] = last
 			ob.includedTxResults[outTxID] = *getTxResult
-			if params.OutboundTxTssNonce >= ob.pendingNonce { // try increasing pending nonce on every newly included outTx
-				ob.pendingNonce = params.OutboundTxTssNonce + 1
+			if last >= ob.pendingNonce { // try increasing pending nonce on every newly included outTx
+				ob.pendingNonce = last + 1
 			}
 			ob.logger.ObserveOutTx.Info().Msgf("checkNSaveIncludedTx: included new bitcoin outTx %s outTxID %s pending nonce %d", txHash, outTxID, ob.pendingNonce)
 		}
 		// update saved tx result as confirmations may increase
-		if foundHash && foundRes {
+		if foundRes && res.TxID == getTxResult.TxID {
 			ob.includedTxResults[outTxID] = *getTxResult
 			if getTxResult.Confirmations > res.Confirmations {
 				ob.logger.ObserveOutTx.Info().Msgf("checkNSaveIncludedTx: bitcoin outTx %s got confirmations %d", txHash, getTxResult.Confirmations)
 			}
 		}
-		if !foundHash && foundRes { // be alert for duplicate payment!!! As we got a new hash paying same cctx. It might happen (e.g. majority of signers get crupted)
+		if foundRes && res.TxID != getTxResult.TxID { // be alert for duplicate payment!!! As we got a new hash paying same cctx. It might happen (e.g. majority of signers get crupted)
 			ob.logger.ObserveOutTx.Error().Msgf("checkNSaveIncludedTx: duplicate payment by bitcoin outTx %s outTxID %s, prior result %v, current result %v", txHash, outTxID, res, *getTxResult)
 		}
-		if foundHash && !foundRes {
-			ob.logger.ObserveOutTx.Error().Msgf("checkNSaveIncludedTx: unreachable code path! outTx %s outTxID %s, prior nonce %d, current nonce %d", txHash, outTxID, nonce, params.OutboundTxTssNonce)
-		}
 		return false, nil
 	}
 	return true, nil // in mempool
@@ -941,23 +1398,31 @@ func (ob *BitcoinChainClient) checkNSaveIncludedTx(txHash string, params types.O
 
 // Basic TSS outTX checks:
 //   - should be able to query the raw tx
+//   - the outTx should pay the nonce
 //   - check if all inputs are segwit && TSS inputs
 //
-// Returns: true if outTx passes basic checks.
-func (ob *BitcoinChainClient) checkTssOutTxResult(hash *chainhash.Hash, res *btcjson.GetTransactionResult, params types.OutboundTxParams, nonce uint64) error {
+// Returns: the last nonce paid by the outTx if outTx passes basic checks.
+func (ob *BitcoinChainClient) checkTssOutTxResult(hash *chainhash.Hash, res *btcjson.GetTransactionResult, params types.OutboundTxParams, nonce uint64) (uint64, error) {
 	rawResult, err := ob.getRawTxResult(hash, res)
 	if err != nil {
-		return errors.Wrapf(err, "checkTssOutTxResult: error GetRawTxResultByHash %s", hash.String())
+		return 0, errors.Wrapf(err, "checkTssOutTxResult: error GetRawTxResultByHash %s", hash.String())
 	}
-	err = ob.checkTSSVin(rawResult.Vin, nonce)
+	first, last, err := ob.outTxNonceRange(rawResult.Vout)
 	if err != nil {
-		return errors.Wrapf(err, "checkTssOutTxResult: invalid TSS Vin in outTx %s nonce %d", hash, nonce)
+		return 0, errors.Wrapf(err, "checkTssOutTxResult: invalid nonce range in outTx %s", hash)
 	}
-	err = ob.checkTSSVout(rawResult.Vout, params, nonce)
+	if nonce < first || nonce > last {
+		return 0, fmt.Errorf("checkTssOutTxResult: outTx %s pays nonces [%d, %d], not nonce %d", hash, first, last, nonce)
+	}
+	err = ob.checkTSSVin(rawResult.Vin, first)
 	if err != nil {
-		return errors.Wrapf(err, "checkTssOutTxResult: invalid TSS Vout in outTx %s nonce %d", hash, nonce)
+		return 0, errors.Wrapf(err, "checkTssOutTxResult: invalid TSS Vin in outTx %s nonce %d", hash, nonce)
 	}
-	return nil
+	err = ob.checkTSSVout(rawResult.Vout, params, first)
+	if err != nil {
+		return 0, errors.Wrapf(err, "checkTssOutTxResult: invalid TSS Vout in outTx %s nonce %d", hash, nonce)
+	}
+	return last, nil
 }
 
 func (ob *BitcoinChainClient) GetTxResultByHash(txID string) (*chainhash.Hash, *btcjson.GetTransactionResult, error) {
@@ -1000,7 +1465,7 @@ func (ob *BitcoinChainClient) getRawTxResult(hash *chainhash.Hash, res *btcjson.
 }
 
 // Vin is valid if:
-//   - The first input is the nonce-mark
+//   - The first input is the nonce-mark of the nonce prior to the first nonce paid by the outTx
 //   - All inputs are from TSS address
 func (ob *BitcoinChainClient) checkTSSVin(vins []btcjson.Vin, nonce uint64) error {
 	// vins: [nonce-mark, UTXO1, UTXO2, ...]
@@ -1031,15 +1496,54 @@ func (ob *BitcoinChainClient) checkTSSVin(vins []btcjson.Vin, nonce uint64) erro
 	return nil
 }
 
+// outTxNonceRange returns the first and the last nonce paid by the outTx
+func (ob *BitcoinChainClient) outTxNonceRange(vouts []btcjson.Vout) (uint64, uint64, error) {
+	if len(vouts) < 2 {
+		return 0, 0, fmt.Errorf("outTxNonceRange: invalid number of vouts: %d", len(vouts))
+	}
+	nonceMark, err := getSatoshis(vouts[0].Value)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "outTxNonceRange: error getting satoshis")
+	}
+	// the last output might be the change to TSS
+	recvAddress, err := ob.voutReceiver(vouts[len(vouts)-1])
+	hasChange := err == nil && recvAddress == ob.Tss.BTCAddress()
+	return common.OutTxNonceRange(nonceMark, len(vouts), hasChange)
+}
+
+// voutReceiver returns the address the output pays to, the receiver can be any supported receiver address type
+func (ob *BitcoinChainClient) voutReceiver(vout btcjson.Vout) (string, error) {
+	scriptPubKey := vout.ScriptPubKey.Hex
+	decodedScriptPubKey, err := hex.DecodeString(scriptPubKey)
+	if err != nil {
+		return "", errors.Wrapf(err, "error decoding scriptPubKey %s", scriptPubKey)
+	}
+	recvAddress, err := ob.chain.BTCAddressFromScript(decodedScriptPubKey)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting receiver from scriptPubKey %s", scriptPubKey)
+	}
+	return recvAddress, nil
+}
+
 // Vout is valid if:
-//   - The first output is the nonce-mark
-//   - The second output is the correct payment to recipient
-//   - The third output is the change to TSS (optional)
-func (ob *BitcoinChainClient) checkTSSVout(vouts []btcjson.Vout, params types.OutboundTxParams, nonce uint64) error {
-	// vouts: [nonce-mark, payment to recipient, change to TSS (optional)]
-	if !(len(vouts) == 2 || len(vouts) == 3) {
-		return fmt.Errorf("checkTSSVout: invalid number of vouts: %d", len(vouts))
+//   - The first output is the nonce-mark of the last nonce paid by the outTx
+//   - The outputs following the nonce-mark are the payments of the nonces in ascending order starting from the first nonce,
+//     the payment of the nonce is the correct payment to recipient
+//   - The last output is the change to TSS (optional)
+func (ob *BitcoinChainClient) checkTSSVout(vouts []btcjson.Vout, params types.OutboundTxParams, first uint64) error {
+	// vouts: [nonce-mark, payment of first nonce, ..., payment of last nonce, change to TSS (optional)]
+	_, last, err := ob.outTxNonceRange(vouts)
+	if err != nil {
+		return errors.Wrap(err, "checkTSSVout: error getting nonce range")
 	}
+	nonce := params.OutboundTxTssNonce
+	if nonce < first || nonce > last {
+		return fmt.Errorf("checkTSSVout: nonce %d not in range [%d, %d]", nonce, first, last)
+	}
+	// #nosec G701 always in range
+	paymentIndex := uint32(1 + nonce - first)
+	// #nosec G701 always in range
+	changeIndex := uint32(2 + last - first)
 
 	tssAddress := ob.Tss.BTCAddress()
 	for _, vout := range vouts {
@@ -1047,23 +1551,9 @@ func (ob *BitcoinChainClient) checkTSSVout(vouts []btcjson.Vout, params types.Ou
 		if err != nil {
 			return errors.Wrap(err, "checkTSSVout: error getting satoshis")
 		}
-		// decode P2WPKH scriptPubKey
-		scriptPubKey := vout.ScriptPubKey.Hex
-		decodedScriptPubKey, err := hex.DecodeString(scriptPubKey)
+		recvAddress, err := ob.voutReceiver(vout)
 		if err != nil {
-			return errors.Wrapf(err, "checkTSSVout: error decoding scriptPubKey %s", scriptPubKey)
-		}
-		if len(decodedScriptPubKey) != 22 { // P2WPKH script
-			return fmt.Errorf("checkTSSVout: unsupported scriptPubKey: %s", scriptPubKey)
-		}
-		witnessVersion := decodedScriptPubKey[0]
-		witnessProgram := decodedScriptPubKey[2:]
-		if witnessVersion != 0 {
-			return fmt.Errorf("checkTSSVout: unsupported witness in scriptPubKey %s", scriptPubKey)
-		}
-		recvAddress, err := ob.chain.BTCAddressFromWitnessProgram(witnessProgram)
-		if err != nil {
-			return errors.Wrapf(err, "checkTSSVout: error getting receiver from witness program %s", witnessProgram)
+			return errors.Wrap(err, "checkTSSVout: error getting receiver")
 		}
 
 		// 1st vout: nonce-mark
@@ -1071,22 +1561,23 @@ func (ob *BitcoinChainClient) checkTSSVout(vouts []btcjson.Vout, params types.Ou
 			if recvAddress != tssAddress {
 				return fmt.Errorf("checkTSSVout: nonce-mark address %s not match TSS address %s", recvAddress, tssAddress)
 			}
-			if amount != common.NonceMarkAmount(nonce) {
-				return fmt.Errorf("checkTSSVout: nonce-mark amount %d not match nonce-mark amount %d", amount, common.NonceMarkAmount(nonce))
+			if amount != common.NonceMarkAmount(last) {
+				return fmt.Errorf("checkTSSVout: nonce-mark amount %d not match nonce-mark amount %d", amount, common.NonceMarkAmount(last))
 			}
 		}
-		// 2nd vout: payment to recipient
-		if vout.N == 1 {
+		// payment to recipient
+		if vout.N == paymentIndex {
 			if recvAddress != params.Receiver {
 				return fmt.Errorf("checkTSSVout: output address %s not match params receiver %s", recvAddress, params.Receiver)
 			}
+			// the amount of a cmd outTx is only known once signed
 			// #nosec G701 always positive
-			if uint64(amount) != params.Amount.Uint64() {
+			if params.CoinType != common.CoinType_Cmd && uint64(amount) != params.Amount.Uint64() {
 				return fmt.Errorf("checkTSSVout: output amount %d not match params amount %d", amount, params.Amount)
 			}
 		}
-		// 3rd vout: change to TSS (optional)
-		if vout.N == 2 {
+		// last vout: change to TSS (optional)
+		if vout.N == changeIndex {
 			if recvAddress != tssAddress {
 				return fmt.Errorf("checkTSSVout: change address %s not match TSS address %s", recvAddress, tssAddress)
 			}
@@ -1165,7 +1656,35 @@ func (ob *BitcoinChainClient) loadDB(dbpath string) error {
 
 	//Load broadcasted transactions
 	err = ob.BuildBroadcastedTxMap()
+	if err != nil {
+		return err
+	}
 
+	ob.journal, err = NewSigningJournal(db)
+	return err
+}
+
+// GetSigningJournal returns the journal of the signed outTxs
+func (ob *BitcoinChainClient) GetSigningJournal() *SigningJournal {
+	return ob.journal
+}
+
+// recoverSigningJournal rebroadcasts the journaled outTxs signed before the restart and missing in the outTx trackers
+// the journaled outTxs are saved as broadcasted so their nonces are not signed again
+func (ob *BitcoinChainClient) recoverSigningJournal() {
+	err := RecoverSigningJournal(ob, ob.chain, ob.zetaClient, ob.broadcastRawTx, ob.SaveBroadcastedTx, ob.logger.ObserveOutTx)
+	if err != nil {
+		ob.logger.ObserveOutTx.Error().Err(err).Msg("error recovering signing journal")
+	}
+}
+
+// broadcastRawTx broadcasts a serialized transaction
+func (ob *BitcoinChainClient) broadcastRawTx(rawTx []byte) error {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if err := tx.Deserialize(bytes.NewReader(rawTx)); err != nil {
+		return err
+	}
+	_, err := ob.rpcClient.SendRawTransaction(tx, true)
 	return err
 }
 
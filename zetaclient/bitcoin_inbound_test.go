@@ -0,0 +1,140 @@
+package zetaclient
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common/bitcoin"
+	"github.com/zeta-chain/node/zetaclient/config"
+)
+
+// mockBTCRPCClient returns the previous txs from a map
+type mockBTCRPCClient struct {
+	txs map[string]*wire.MsgTx
+}
+
+func (m *mockBTCRPCClient) GetRawTransaction(txHash *chainhash.Hash) (*btcutil.Tx, error) {
+	tx, ok := m.txs[txHash.String()]
+	if !ok {
+		return nil, errors.New("tx not found")
+	}
+	return btcutil.NewTx(tx), nil
+}
+
+func TestGetSenderAddressByVin(t *testing.T) {
+	net := &chaincfg.TestNet3Params
+	pubKey, err := hex.DecodeString("02d0fd59f5e5ca3bdbb0e8e0d43d8da21acd0178e3a3cfadfe0c5a5dccd1bf2c6e")
+	require.NoError(t, err)
+
+	// previous tx paying to a taproot address
+	p2tr, err := bitcoin.NewAddressTaproot(pubKey[1:], net)
+	require.NoError(t, err)
+	pkScript, err := bitcoin.PayToAddrScript(p2tr)
+	require.NoError(t, err)
+	prevTx := wire.NewMsgTx(wire.TxVersion)
+	prevTx.AddTxOut(wire.NewTxOut(1000, []byte{0x6a}))
+	prevTx.AddTxOut(wire.NewTxOut(1000, pkScript))
+	rpcClient := &mockBTCRPCClient{txs: map[string]*wire.MsgTx{prevTx.TxHash().String(): prevTx}}
+
+	t.Run("P2WPKH sender is derived from the witness", func(t *testing.T) {
+		vin := btcjson.Vin{
+			Txid:    "unknown",
+			Witness: []string{hex.EncodeToString(make([]byte, 71)), hex.EncodeToString(pubKey)},
+		}
+		expected, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(pubKey), net)
+		require.NoError(t, err)
+
+		from, err := GetSenderAddressByVin(rpcClient, vin, net)
+		require.NoError(t, err)
+		require.Equal(t, expected.EncodeAddress(), from)
+	})
+
+	t.Run("P2TR sender is resolved from the previous output", func(t *testing.T) {
+		vin := btcjson.Vin{
+			Txid:    prevTx.TxHash().String(),
+			Vout:    1,
+			Witness: []string{hex.EncodeToString(make([]byte, 64))},
+		}
+		from, err := GetSenderAddressByVin(rpcClient, vin, net)
+		require.NoError(t, err)
+		require.Equal(t, p2tr.EncodeAddress(), from)
+	})
+
+	t.Run("non-standard previous output returns empty sender", func(t *testing.T) {
+		vin := btcjson.Vin{
+			Txid:    prevTx.TxHash().String(),
+			Vout:    0,
+			Witness: []string{hex.EncodeToString(make([]byte, 64))},
+		}
+		from, err := GetSenderAddressByVin(rpcClient, vin, net)
+		require.NoError(t, err)
+		require.Empty(t, from)
+	})
+
+	t.Run("fail if previous tx can't be queried", func(t *testing.T) {
+		vin := btcjson.Vin{
+			Txid:    chainhash.Hash{}.String(),
+			Witness: []string{hex.EncodeToString(make([]byte, 64))},
+		}
+		_, err := GetSenderAddressByVin(rpcClient, vin, net)
+		require.Error(t, err)
+	})
+
+	t.Run("fail if vout out of range", func(t *testing.T) {
+		vin := btcjson.Vin{
+			Txid:    prevTx.TxHash().String(),
+			Vout:    2,
+			Witness: []string{hex.EncodeToString(make([]byte, 64))},
+		}
+		_, err := GetSenderAddressByVin(rpcClient, vin, net)
+		require.Error(t, err)
+	})
+}
+
+func TestFilterAndParseIncomingTx(t *testing.T) {
+	pubKey, err := hex.DecodeString("02d0fd59f5e5ca3bdbb0e8e0d43d8da21acd0178e3a3cfadfe0c5a5dccd1bf2c6e")
+	require.NoError(t, err)
+	tssAddress, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(pubKey), config.BitconNetParams)
+	require.NoError(t, err)
+
+	// previous tx paying to a non-standard script
+	prevTx := wire.NewMsgTx(wire.TxVersion)
+	prevTx.AddTxOut(wire.NewTxOut(1000, []byte{0x51, 0x51}))
+	rpcClient := &mockBTCRPCClient{txs: map[string]*wire.MsgTx{prevTx.TxHash().String(): prevTx}}
+
+	// deposit to the TSS address spending a taproot input, the sender is resolved from the previous output
+	deposit := func(prevTxid string) []btcjson.TxRawResult {
+		return []btcjson.TxRawResult{
+			{}, // coinbase
+			{
+				Txid: "deposit",
+				Vin:  []btcjson.Vin{{Txid: prevTxid, Witness: []string{hex.EncodeToString(make([]byte, 64))}}},
+				Vout: []btcjson.Vout{
+					{Value: 0.001, ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: "0014" + hex.EncodeToString(tssAddress.ScriptAddress())}},
+					{ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: "6a02abcd"}},
+				},
+			},
+		}
+	}
+
+	t.Run("deposit with a non-standard previous output is observed with an empty sender", func(t *testing.T) {
+		inTxs, err := FilterAndParseIncomingTx(rpcClient, deposit(prevTx.TxHash().String()), 100, tssAddress.EncodeAddress(), &log.Logger)
+		require.NoError(t, err)
+		require.Len(t, inTxs, 1)
+		require.Empty(t, inTxs[0].FromAddress)
+		require.Equal(t, []byte{0xab, 0xcd}, inTxs[0].MemoBytes)
+	})
+
+	t.Run("fail if the previous tx can't be queried so the block is scanned again", func(t *testing.T) {
+		_, err := FilterAndParseIncomingTx(rpcClient, deposit(chainhash.Hash{}.String()), 100, tssAddress.EncodeAddress(), &log.Logger)
+		require.Error(t, err)
+	})
+}
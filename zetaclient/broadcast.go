@@ -5,19 +5,103 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	flag "github.com/spf13/pflag"
 	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
 )
 
+const (
+	// BroadcastGasAdjustment is the multiplier applied to the simulated gas of a tx
+	BroadcastGasAdjustment = 1.3
+
+	// BatchConfirmTimeout is the time waited for a batch tx to be included in a block
+	BatchConfirmTimeout = 30 * time.Second
+
+	// batchConfirmInterval is the interval between the queries of a batch tx
+	batchConfirmInterval = time.Second
+)
+
+var (
+	// msgIndexRegex matches the index of the failing message in the error of a multi-message tx
+	msgIndexRegex = regexp.MustCompile(`message index: ([0-9]+)`)
+
+	// seqMismatchRegex matches the expected and the provided sequence in the error of a tx with a wrong sequence
+	seqMismatchRegex = regexp.MustCompile(`account sequence mismatch, expected ([0-9]*), got ([0-9]*)`)
+)
+
 // Broadcast Broadcasts tx to metachain. Returns txHash and error
+// The gas limit of the tx is estimated by simulating the tx, gaslimit is used if the simulation is unavailable
 func (b *ZetaCoreBridge) Broadcast(gaslimit uint64, authzWrappedMsg sdktypes.Msg, authzSigner AuthZSigner) (string, error) {
-	gaslimit = gaslimit * 3
+	return b.broadcastMsgs(gaslimit*3, []sdktypes.Msg{authzWrappedMsg}, authzSigner)
+}
+
+// BroadcastBatch broadcasts several authz wrapped messages of the same signer in a single tx. Returns txHash and error
+// The gas limit of the tx is estimated by simulating the tx, the simulation fails with the index of the first failing
+// message, see MsgIndexFromError
+func (b *ZetaCoreBridge) BroadcastBatch(authzWrappedMsgs []sdktypes.Msg, authzSigner AuthZSigner) (string, error) {
+	return b.broadcastMsgs(0, authzWrappedMsgs, authzSigner)
+}
+
+// ConfirmBatch waits for the batch tx to be included in a block and returns the error of its execution
+// a multi-message tx is reverted if one of its messages fails, the error contains the index of the failing message,
+// see MsgIndexFromError
+func (b *ZetaCoreBridge) ConfirmBatch(txHash string) error {
+	ctx := b.GetContext()
+	deadline := time.Now().Add(BatchConfirmTimeout)
+	for {
+		res, err := authtx.QueryTx(ctx, txHash)
+		if err == nil {
+			if res.Code != 0 {
+				return fmt.Errorf("tx %s failed, code:%d, log:%s", txHash, res.Code, res.RawLog)
+			}
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("tx %s not confirmed after %s: %s", txHash, BatchConfirmTimeout, err.Error())
+		}
+		select {
+		case <-time.After(batchConfirmInterval):
+		case <-b.stop:
+			return fmt.Errorf("tx %s not confirmed: bridge stopped", txHash)
+		}
+	}
+}
+
+// BroadcastVote broadcasts a vote of the observer, the vote is batched with other votes if batch broadcast is enabled
+func (b *ZetaCoreBridge) BroadcastVote(gaslimit uint64, authzWrappedMsg sdktypes.Msg, authzSigner AuthZSigner) (string, error) {
+	if b.batcher != nil {
+		return b.batcher.Submit(authzWrappedMsg, authzSigner)
+	}
+	return b.Broadcast(gaslimit, authzWrappedMsg, authzSigner)
+}
+
+// MsgIndexFromError returns the index of the failing message of a multi-message tx from the tx error
+func MsgIndexFromError(err error) (int, bool) {
+	if err == nil {
+		return 0, false
+	}
+	matches := msgIndexRegex.FindStringSubmatch(err.Error())
+	if len(matches) != 2 {
+		return 0, false
+	}
+	index, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, false
+	}
+	return index, true
+}
+
+// broadcastMsgs signs and broadcasts a tx of the messages
+// the gas limit is simulated, fallbackGasLimit is used if the simulation fails for a reason unrelated to the messages
+// (0 means no fallback), the fee is priced from the gas price of zetacore and bumped if zetacore rejects it
+func (b *ZetaCoreBridge) broadcastMsgs(fallbackGasLimit uint64, authzWrappedMsgs []sdktypes.Msg, authzSigner AuthZSigner) (string, error) {
 	b.broadcastLock.Lock()
 	defer b.broadcastLock.Unlock()
 	var err error
@@ -38,6 +122,16 @@ func (b *ZetaCoreBridge) Broadcast(gaslimit uint64, authzWrappedMsg sdktypes.Msg
 			b.seqNumber[authzSigner.KeyType] = seqNumber
 		}
 	}
+	// the refresh is retried at the next broadcast if the gas price can't be queried
+	if blockHeight > b.gasPriceHeight {
+		gasPrice, err := b.GetGasPrice()
+		if err != nil {
+			b.logger.Warn().Err(err).Msgf("fail to get gas price, using gas price %s", b.broadcastGasPrice())
+		} else {
+			b.gasPrice = gasPrice
+			b.gasPriceHeight = blockHeight
+		}
+	}
 	//b.logger.Info().Uint64("account_number", b.accountNumber).Uint64("sequence_number", b.seqNumber).Msg("account info")
 
 	flags := flag.NewFlagSet("zetacore", 0)
@@ -47,36 +141,45 @@ func (b *ZetaCoreBridge) Broadcast(gaslimit uint64, authzWrappedMsg sdktypes.Msg
 	factory = factory.WithAccountNumber(b.accountNumber[authzSigner.KeyType])
 	factory = factory.WithSequence(b.seqNumber[authzSigner.KeyType])
 	factory = factory.WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
-	builder, err := factory.BuildUnsignedTx(authzWrappedMsg)
-	if err != nil {
-		return "", err
-	}
-	builder.SetGasLimit(gaslimit)
-	fee := sdktypes.NewCoins(sdktypes.NewCoin("azeta", sdktypes.NewInt(40000)))
-	builder.SetFeeAmount(fee)
-	//fmt.Printf("signing from name: %s\n", ctx.GetFromName())
-	err = clienttx.Sign(factory, ctx.GetFromName(), builder, true)
-	if err != nil {
-		return "", err
-	}
 
-	txBytes, err := ctx.TxConfig.TxEncoder()(builder.GetTx())
+	gaslimit, err := simulateGasLimit(ctx, factory, authzWrappedMsgs)
 	if err != nil {
-		return "", err
+		if _, found := MsgIndexFromError(err); found || fallbackGasLimit == 0 {
+			return "", err
+		}
+		b.logger.Warn().Err(err).Msgf("fail to simulate tx, using gas limit %d", fallbackGasLimit)
+		gaslimit = fallbackGasLimit
 	}
 
-	// broadcast to a Tendermint node
-	commit, err := ctx.BroadcastTxSync(txBytes)
-	if err != nil {
-		b.logger.Error().Err(err).Msgf("fail to broadcast tx %s", err.Error())
-		return "", err
-	}
-	// Code will be the tendermint ABICode , it start at 1 , so if it is an error , code will not be zero
-	if commit.Code > 0 {
+	for bumps := 0; ; bumps++ {
+		fee := FeeForGas(b.broadcastGasPrice(), gaslimit)
+		commit, err := signAndBroadcast(ctx, factory, authzWrappedMsgs, gaslimit, fee)
+		if err != nil {
+			b.logger.Error().Err(err).Msgf("fail to broadcast tx %s", err.Error())
+			return "", err
+		}
+		// Code will be the tendermint ABICode , it start at 1 , so if it is an error , code will not be zero
+		if commit.Code == 0 {
+			//b.logger.Debug().Msgf("Received a TxHash of %v from the metachain, Code %d, log %s", commit.TxHash, commit.Code, commit.Logs)
+
+			// increment seqNum
+			//seq := b.seqNumber[authzSigner.KeyType]
+			//atomic.AddUint64(&seq, 1)
+			b.seqNumber[authzSigner.KeyType] = b.seqNumber[authzSigner.KeyType] + 1
+			//b.logger.Debug().Msgf("b.sequence number increased to %d", b.seqNumber)
+
+			b.recordFee(authzWrappedMsgs, fee)
+			return commit.TxHash, nil
+		}
+		if IsInsufficientFee(commit.Codespace, commit.Code) && bumps < MaxBroadcastFeeBumps {
+			gasPrice := BumpGasPrice(b.broadcastGasPrice(), gaslimit, commit.RawLog)
+			b.logger.Warn().Msgf("insufficient fee %s, bumping gas price from %s to %s: %s", fee, b.broadcastGasPrice(), gasPrice, commit.RawLog)
+			b.gasPrice = gasPrice
+			continue
+		}
 		if commit.Code == 32 {
 			errMsg := commit.RawLog
-			re := regexp.MustCompile(`account sequence mismatch, expected ([0-9]*), got ([0-9]*)`)
-			matches := re.FindStringSubmatch(errMsg)
+			matches := seqMismatchRegex.FindStringSubmatch(errMsg)
 			if len(matches) != 3 {
 				return "", err
 			}
@@ -95,15 +198,47 @@ func (b *ZetaCoreBridge) Broadcast(gaslimit uint64, authzWrappedMsg sdktypes.Msg
 		}
 		return commit.TxHash, fmt.Errorf("fail to broadcast to zetachain,code:%d, log:%s", commit.Code, commit.RawLog)
 	}
-	//b.logger.Debug().Msgf("Received a TxHash of %v from the metachain, Code %d, log %s", commit.TxHash, commit.Code, commit.Logs)
+}
 
-	// increment seqNum
-	//seq := b.seqNumber[authzSigner.KeyType]
-	//atomic.AddUint64(&seq, 1)
-	b.seqNumber[authzSigner.KeyType] = b.seqNumber[authzSigner.KeyType] + 1
-	//b.logger.Debug().Msgf("b.sequence number increased to %d", b.seqNumber)
+// simulateGasLimit returns the gas limit of the tx of the messages estimated by simulating the tx on zetacore
+func simulateGasLimit(ctx client.Context, factory clienttx.Factory, msgs []sdktypes.Msg) (uint64, error) {
+	factory = factory.WithGasAdjustment(BroadcastGasAdjustment)
+	_, gaslimit, err := clienttx.CalculateGas(ctx, factory, msgs...)
+	if err != nil {
+		// the sequence doesn't change the gas used, the simulation is retried with the sequence expected by zetacore
+		matches := seqMismatchRegex.FindStringSubmatch(err.Error())
+		if len(matches) != 3 {
+			return 0, err
+		}
+		expectedSeq, parseErr := strconv.ParseUint(matches[1], 10, 64)
+		if parseErr != nil {
+			return 0, err
+		}
+		_, gaslimit, err = clienttx.CalculateGas(ctx, factory.WithSequence(expectedSeq), msgs...)
+	}
+	return gaslimit, err
+}
+
+// signAndBroadcast signs the tx of the messages and broadcasts it to a Tendermint node
+func signAndBroadcast(ctx client.Context, factory clienttx.Factory, msgs []sdktypes.Msg, gaslimit uint64, fee sdktypes.Coins) (*sdktypes.TxResponse, error) {
+	builder, err := factory.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	builder.SetGasLimit(gaslimit)
+	builder.SetFeeAmount(fee)
+	//fmt.Printf("signing from name: %s\n", ctx.GetFromName())
+	err = clienttx.Sign(factory, ctx.GetFromName(), builder, true)
+	if err != nil {
+		return nil, err
+	}
+
+	txBytes, err := ctx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
 
-	return commit.TxHash, nil
+	return ctx.BroadcastTxSync(txBytes)
 }
 
 // GetContext return a valid context with all relevant values set
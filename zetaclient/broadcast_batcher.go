@@ -0,0 +1,167 @@
+package zetaclient
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/node/common"
+)
+
+// ErrBatchBroadcasterStopped is returned for the messages submitted to a stopped batch broadcaster
+var ErrBatchBroadcasterStopped = errors.New("batch broadcaster stopped")
+
+// BatchBroadcastFunc broadcasts authz wrapped messages of the same signer in a single tx
+type BatchBroadcastFunc func(authzWrappedMsgs []sdk.Msg, authzSigner AuthZSigner) (string, error)
+
+// BatchConfirmFunc waits for a broadcasted tx to be included in a block and returns the error of its execution
+type BatchConfirmFunc func(txHash string) error
+
+type batchResult struct {
+	txHash string
+	err    error
+}
+
+type batchedMsg struct {
+	msg    sdk.Msg
+	signer AuthZSigner
+	result chan batchResult
+}
+
+// BatchBroadcaster accumulates the authz wrapped messages posted to zetacore and broadcasts them as a single tx
+// A batch is broadcasted when the window since its first message is elapsed or when it reaches maxMsgs messages.
+// The messages are grouped by signer key type, each group is broadcasted in its own tx.
+// A message is successful once the tx of the batch is executed in a block. If a message of the batch fails, in the
+// simulation or in the execution of the tx, its error is returned to its submitter and the batch is broadcasted again
+// without the failing message.
+type BatchBroadcaster struct {
+	broadcast BatchBroadcastFunc
+	confirm   BatchConfirmFunc
+	window    time.Duration
+	maxMsgs   int
+	queue     chan batchedMsg
+	stop      chan struct{}
+	logger    zerolog.Logger
+}
+
+// NewBatchBroadcaster creates a batch broadcaster, Start must be called to process the submitted messages
+func NewBatchBroadcaster(
+	broadcast BatchBroadcastFunc,
+	confirm BatchConfirmFunc,
+	window time.Duration,
+	maxMsgs int,
+	logger zerolog.Logger,
+) *BatchBroadcaster {
+	if maxMsgs < 1 {
+		maxMsgs = 1
+	}
+	return &BatchBroadcaster{
+		broadcast: broadcast,
+		confirm:   confirm,
+		window:    window,
+		maxMsgs:   maxMsgs,
+		queue:     make(chan batchedMsg),
+		stop:      make(chan struct{}),
+		logger:    logger.With().Str("module", "BatchBroadcaster").Logger(),
+	}
+}
+
+// Submit adds the message to the next batch and waits for the batch to be executed
+// it returns the hash of the tx including the message or the error of the message
+func (bb *BatchBroadcaster) Submit(authzWrappedMsg sdk.Msg, authzSigner AuthZSigner) (string, error) {
+	item := batchedMsg{
+		msg:    authzWrappedMsg,
+		signer: authzSigner,
+		result: make(chan batchResult, 1),
+	}
+	select {
+	case bb.queue <- item:
+	case <-bb.stop:
+		return "", ErrBatchBroadcasterStopped
+	}
+	res := <-item.result
+	return res.txHash, res.err
+}
+
+// Start processes the submitted messages until Stop is called
+func (bb *BatchBroadcaster) Start() {
+	var pending []batchedMsg
+	var timer <-chan time.Time
+	for {
+		select {
+		case item := <-bb.queue:
+			pending = append(pending, item)
+			if len(pending) == 1 {
+				timer = time.After(bb.window)
+			}
+			if len(pending) >= bb.maxMsgs {
+				bb.flush(pending)
+				pending, timer = nil, nil
+			}
+		case <-timer:
+			bb.flush(pending)
+			pending, timer = nil, nil
+		case <-bb.stop:
+			for _, item := range pending {
+				item.result <- batchResult{err: ErrBatchBroadcasterStopped}
+			}
+			bb.logger.Info().Msg("BatchBroadcaster stopped")
+			return
+		}
+	}
+}
+
+// Stop stops processing the submitted messages, the pending messages fail
+func (bb *BatchBroadcaster) Stop() {
+	close(bb.stop)
+}
+
+// flush broadcasts the pending messages, one tx per signer key type
+func (bb *BatchBroadcaster) flush(pending []batchedMsg) {
+	groups := make(map[common.KeyType][]batchedMsg)
+	var keyTypes []common.KeyType
+	for _, item := range pending {
+		if _, found := groups[item.signer.KeyType]; !found {
+			keyTypes = append(keyTypes, item.signer.KeyType)
+		}
+		groups[item.signer.KeyType] = append(groups[item.signer.KeyType], item)
+	}
+	for _, keyType := range keyTypes {
+		bb.broadcastGroup(groups[keyType])
+	}
+}
+
+// broadcastGroup broadcasts the messages of a signer, the failing messages are removed from the batch one by one
+// a message failing in the execution of the tx reverts the other messages, they are broadcasted again in a new tx
+func (bb *BatchBroadcaster) broadcastGroup(items []batchedMsg) {
+	for len(items) > 0 {
+		msgs := make([]sdk.Msg, len(items))
+		for i, item := range items {
+			msgs[i] = item.msg
+		}
+		txHash, err := bb.broadcast(msgs, items[0].signer)
+		if err == nil {
+			err = bb.confirm(txHash)
+		}
+		if err == nil {
+			bb.logger.Debug().Msgf("broadcasted %d messages in tx %s", len(items), txHash)
+			for _, item := range items {
+				item.result <- batchResult{txHash: txHash}
+			}
+			return
+		}
+
+		// the error can't be attributed to a single message, all the messages fail
+		index, found := MsgIndexFromError(err)
+		if !found || index >= len(items) || len(items) == 1 {
+			for _, item := range items {
+				item.result <- batchResult{err: err}
+			}
+			return
+		}
+		bb.logger.Warn().Err(err).Msgf("message %d of batch failed, broadcasting the %d other messages", index, len(items)-1)
+		items[index].result <- batchResult{err: err}
+		items = append(items[:index:index], items[index+1:]...)
+	}
+}
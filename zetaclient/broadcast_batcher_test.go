@@ -0,0 +1,205 @@
+package zetaclient
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+)
+
+// testBroadcaster records the broadcasted batches, the batches containing the failing message fail with its index
+// in the simulation and the batches containing the reverting message fail with its index in the execution
+type testBroadcaster struct {
+	mu        sync.Mutex
+	batches   [][]sdk.Msg
+	failing   sdk.Msg
+	reverting sdk.Msg
+	reverted  int
+}
+
+func (tb *testBroadcaster) broadcast(msgs []sdk.Msg, _ AuthZSigner) (string, error) {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	for i, msg := range msgs {
+		if msg == tb.failing {
+			return "", fmt.Errorf("failed to execute message; message index: %d: invalid vote", i)
+		}
+	}
+	tb.batches = append(tb.batches, msgs)
+	return fmt.Sprintf("tx%d", len(tb.batches)), nil
+}
+
+func (tb *testBroadcaster) confirm(txHash string) error {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	var index int
+	if _, err := fmt.Sscanf(txHash, "tx%d", &index); err != nil {
+		return err
+	}
+	for i, msg := range tb.batches[index-1] {
+		if msg == tb.reverting {
+			tb.reverted++
+			return fmt.Errorf("tx %s failed, code:1, log:failed to execute message; message index: %d: already voted", txHash, i)
+		}
+	}
+	return nil
+}
+
+// submitAll submits the messages concurrently and returns the tx hashes and errors by message index
+func submitAll(bb *BatchBroadcaster, msgs []sdk.Msg, signers []AuthZSigner) ([]string, []error) {
+	hashes := make([]string, len(msgs))
+	errs := make([]error, len(msgs))
+	var wg sync.WaitGroup
+	for i := range msgs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			hashes[i], errs[i] = bb.Submit(msgs[i], signers[i])
+		}(i)
+	}
+	wg.Wait()
+	return hashes, errs
+}
+
+func newTestMsgs(n int) ([]sdk.Msg, []AuthZSigner) {
+	msgs := make([]sdk.Msg, n)
+	signers := make([]AuthZSigner, n)
+	for i := range msgs {
+		msgs[i] = &banktypes.MsgSend{FromAddress: fmt.Sprintf("sender%d", i)}
+		signers[i] = AuthZSigner{KeyType: common.ZetaClientGranteeKey}
+	}
+	return msgs, signers
+}
+
+func TestBatchBroadcaster(t *testing.T) {
+	t.Run("messages submitted in the window are broadcasted in one tx", func(t *testing.T) {
+		tb := &testBroadcaster{}
+		bb := NewBatchBroadcaster(tb.broadcast, tb.confirm, 100*time.Millisecond, 10, zerolog.Nop())
+		go bb.Start()
+		defer bb.Stop()
+
+		msgs, signers := newTestMsgs(3)
+		hashes, errs := submitAll(bb, msgs, signers)
+		for i := range msgs {
+			require.NoError(t, errs[i])
+			require.Equal(t, "tx1", hashes[i])
+		}
+		require.Len(t, tb.batches, 1)
+		require.ElementsMatch(t, msgs, tb.batches[0])
+	})
+
+	t.Run("a batch is broadcasted when it reaches the max number of messages", func(t *testing.T) {
+		tb := &testBroadcaster{}
+		bb := NewBatchBroadcaster(tb.broadcast, tb.confirm, time.Hour, 2, zerolog.Nop())
+		go bb.Start()
+		defer bb.Stop()
+
+		msgs, signers := newTestMsgs(4)
+		_, errs := submitAll(bb, msgs, signers)
+		for i := range msgs {
+			require.NoError(t, errs[i])
+		}
+		require.Len(t, tb.batches, 2)
+		require.Len(t, tb.batches[0], 2)
+		require.Len(t, tb.batches[1], 2)
+	})
+
+	t.Run("messages of different key types are broadcasted in different txs", func(t *testing.T) {
+		tb := &testBroadcaster{}
+		bb := NewBatchBroadcaster(tb.broadcast, tb.confirm, 100*time.Millisecond, 10, zerolog.Nop())
+		go bb.Start()
+		defer bb.Stop()
+
+		msgs, signers := newTestMsgs(3)
+		signers[1].KeyType = common.TssSignerKey
+		hashes, errs := submitAll(bb, msgs, signers)
+		for i := range msgs {
+			require.NoError(t, errs[i])
+		}
+		require.Len(t, tb.batches, 2)
+		require.Equal(t, hashes[0], hashes[2])
+		require.NotEqual(t, hashes[0], hashes[1])
+	})
+
+	t.Run("the error of a failing message is returned to its submitter only", func(t *testing.T) {
+		msgs, signers := newTestMsgs(3)
+		tb := &testBroadcaster{failing: msgs[1]}
+		bb := NewBatchBroadcaster(tb.broadcast, tb.confirm, 100*time.Millisecond, 10, zerolog.Nop())
+		go bb.Start()
+		defer bb.Stop()
+
+		hashes, errs := submitAll(bb, msgs, signers)
+		require.ErrorContains(t, errs[1], "invalid vote")
+		require.NoError(t, errs[0])
+		require.NoError(t, errs[2])
+		require.Equal(t, "tx1", hashes[0])
+		require.Equal(t, "tx1", hashes[2])
+		require.Len(t, tb.batches, 1)
+		require.ElementsMatch(t, []sdk.Msg{msgs[0], msgs[2]}, tb.batches[0])
+	})
+
+	t.Run("the messages of a reverted tx are broadcasted again without the reverting message", func(t *testing.T) {
+		msgs, signers := newTestMsgs(3)
+		tb := &testBroadcaster{reverting: msgs[0]}
+		bb := NewBatchBroadcaster(tb.broadcast, tb.confirm, 100*time.Millisecond, 10, zerolog.Nop())
+		go bb.Start()
+		defer bb.Stop()
+
+		hashes, errs := submitAll(bb, msgs, signers)
+		require.ErrorContains(t, errs[0], "already voted")
+		require.NoError(t, errs[1])
+		require.NoError(t, errs[2])
+		require.Equal(t, 1, tb.reverted)
+		require.Len(t, tb.batches, 2)
+		require.Len(t, tb.batches[0], 3)
+		require.ElementsMatch(t, []sdk.Msg{msgs[1], msgs[2]}, tb.batches[1])
+		require.Equal(t, "tx2", hashes[1])
+		require.Equal(t, "tx2", hashes[2])
+	})
+
+	t.Run("all the messages fail if the tx is not confirmed", func(t *testing.T) {
+		tb := &testBroadcaster{}
+		unconfirmed := func(txHash string) error {
+			return fmt.Errorf("tx %s not confirmed", txHash)
+		}
+		bb := NewBatchBroadcaster(tb.broadcast, unconfirmed, 100*time.Millisecond, 10, zerolog.Nop())
+		go bb.Start()
+		defer bb.Stop()
+
+		msgs, signers := newTestMsgs(2)
+		_, errs := submitAll(bb, msgs, signers)
+		for i := range msgs {
+			require.ErrorContains(t, errs[i], "not confirmed")
+		}
+		require.Len(t, tb.batches, 1)
+	})
+
+	t.Run("submitting to a stopped broadcaster fails", func(t *testing.T) {
+		tb := &testBroadcaster{}
+		bb := NewBatchBroadcaster(tb.broadcast, tb.confirm, time.Hour, 10, zerolog.Nop())
+		go bb.Start()
+		bb.Stop()
+
+		msgs, signers := newTestMsgs(1)
+		_, err := bb.Submit(msgs[0], signers[0])
+		require.ErrorIs(t, err, ErrBatchBroadcasterStopped)
+	})
+}
+
+func TestMsgIndexFromError(t *testing.T) {
+	index, found := MsgIndexFromError(fmt.Errorf("failed to execute message; message index: 12: invalid vote"))
+	require.True(t, found)
+	require.Equal(t, 12, index)
+
+	_, found = MsgIndexFromError(fmt.Errorf("out of gas"))
+	require.False(t, found)
+
+	_, found = MsgIndexFromError(nil)
+	require.False(t, found)
+}
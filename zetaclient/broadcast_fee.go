@@ -0,0 +1,140 @@
+package zetaclient
+
+import (
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/zetaclient/metrics"
+)
+
+// MaxBroadcastFeeBumps is the number of times a tx rejected for insufficient fee is broadcasted again with a bumped fee
+const MaxBroadcastFeeBumps = 3
+
+var (
+	// BroadcastFeeBumpRatio is the multiplier applied to the gas price when a tx is rejected for insufficient fee
+	BroadcastFeeBumpRatio = sdk.NewDecWithPrec(15, 1)
+
+	// requiredFeeRegex matches the fee required by zetacore in the error of a tx rejected for insufficient fee
+	requiredFeeRegex = regexp.MustCompile(`(?:required: |< )([0-9]+(?:\.[0-9]+)?)` + common.ZETADenom)
+)
+
+// GetGasPrice returns the gas price in azeta of the txs broadcasted to zetacore, it is the highest of the minimum gas
+// price of the zetacore node, the global minimum gas price and the base fee of the feemarket module
+func (b *ZetaCoreBridge) GetGasPrice() (sdk.Dec, error) {
+	feeMarketParams, err := b.GetFeeMarketParams()
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	gasPrice := feeMarketParams.MinGasPrice
+	if !feeMarketParams.NoBaseFee {
+		baseFee, err := b.GetBaseFee()
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+		gasPrice = sdk.MaxDec(gasPrice, sdk.NewDecFromInt(baseFee))
+	}
+
+	// the node service is not available on all zetacore nodes, the node minimum gas price is ignored if unavailable
+	nodeMinGasPrice, err := b.GetNodeMinGasPrice()
+	if err != nil {
+		b.logger.Debug().Err(err).Msg("fail to get node minimum gas price")
+		return gasPrice, nil
+	}
+	return sdk.MaxDec(gasPrice, nodeMinGasPrice), nil
+}
+
+// SetMinGasPrice sets the minimum gas price in azeta of the txs broadcasted to zetacore, an empty gas price means no
+// minimum. The fees are priced from the minimum gas price while the gas price of zetacore is unknown
+func (b *ZetaCoreBridge) SetMinGasPrice(minGasPrice string) error {
+	gasPrice := sdk.ZeroDec()
+	if minGasPrice != "" {
+		var err error
+		gasPrice, err = sdk.NewDecFromStr(minGasPrice)
+		if err != nil {
+			return fmt.Errorf("invalid minimum gas price %s: %w", minGasPrice, err)
+		}
+		if gasPrice.IsNegative() {
+			return fmt.Errorf("invalid minimum gas price %s: negative", minGasPrice)
+		}
+	}
+	b.broadcastLock.Lock()
+	defer b.broadcastLock.Unlock()
+	b.minGasPrice = gasPrice
+	return nil
+}
+
+// broadcastGasPrice returns the gas price of the txs broadcasted to zetacore, the gas price of zetacore is never below
+// the minimum gas price
+func (b *ZetaCoreBridge) broadcastGasPrice() sdk.Dec {
+	return sdk.MaxDec(b.gasPrice, b.minGasPrice)
+}
+
+// FeeForGas returns the fee of a tx with the gas limit at the gas price
+func FeeForGas(gasPrice sdk.Dec, gasLimit uint64) sdk.Coins {
+	// #nosec G701 always in range
+	amount := gasPrice.MulInt64(int64(gasLimit)).Ceil().TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(common.ZETADenom, amount))
+}
+
+// IsInsufficientFee returns true if the code of the tx result is an insufficient fee error
+func IsInsufficientFee(codespace string, code uint32) bool {
+	return codespace == sdkerrors.ErrInsufficientFee.Codespace() && code == sdkerrors.ErrInsufficientFee.ABCICode()
+}
+
+// BumpGasPrice returns the gas price of a tx rejected for insufficient fee, the gas price is bumped by
+// BroadcastFeeBumpRatio and is at least the gas price of the fee required in the rejection log
+func BumpGasPrice(gasPrice sdk.Dec, gasLimit uint64, rawLog string) sdk.Dec {
+	bumped := gasPrice.Mul(BroadcastFeeBumpRatio)
+	matches := requiredFeeRegex.FindStringSubmatch(rawLog)
+	if len(matches) != 2 || gasLimit == 0 {
+		return bumped
+	}
+	requiredFee, err := sdk.NewDecFromStr(matches[1])
+	if err != nil {
+		return bumped
+	}
+	// #nosec G701 always in range
+	return sdk.MaxDec(bumped, requiredFee.QuoInt64(int64(gasLimit)))
+}
+
+// RegisterMetrics registers the fee spent broadcasting txs to zetacore
+func (b *ZetaCoreBridge) RegisterMetrics(m *metrics.Metrics) error {
+	err := m.RegisterCounterVec(metrics.BroadcastFee, "fee in azeta spent broadcasting txs to zetacore", []string{"msg_type"})
+	if err != nil {
+		return err
+	}
+	b.broadcastLock.Lock()
+	defer b.broadcastLock.Unlock()
+	b.feeMetric = metrics.CounterVecs[metrics.BroadcastFee]
+	return nil
+}
+
+// recordFee splits the fee of a tx evenly between its messages and adds it to the fee spent for their message type
+func (b *ZetaCoreBridge) recordFee(authzWrappedMsgs []sdk.Msg, fee sdk.Coins) {
+	if b.feeMetric == nil || len(authzWrappedMsgs) == 0 {
+		return
+	}
+	feePerMsg, err := sdk.NewDecFromInt(fee.AmountOf(common.ZETADenom)).QuoInt64(int64(len(authzWrappedMsgs))).Float64()
+	if err != nil {
+		return
+	}
+	for _, msg := range authzWrappedMsgs {
+		b.feeMetric.With(prometheus.Labels{"msg_type": feeMsgType(msg)}).Add(feePerMsg)
+	}
+}
+
+// feeMsgType returns the type of the message the fee is spent for, the type of the wrapped message for authz messages
+func feeMsgType(msg sdk.Msg) string {
+	if msgExec, ok := msg.(*authz.MsgExec); ok {
+		msgs, err := msgExec.GetMessages()
+		if err == nil && len(msgs) > 0 {
+			return sdk.MsgTypeURL(msgs[0])
+		}
+	}
+	return sdk.MsgTypeURL(msg)
+}
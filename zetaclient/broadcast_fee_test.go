@@ -0,0 +1,87 @@
+package zetaclient
+
+import (
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestFeeForGas(t *testing.T) {
+	fee := FeeForGas(sdk.MustNewDecFromStr("0.5"), 100001)
+	require.Equal(t, sdk.NewInt(50001), fee.AmountOf(common.ZETADenom))
+
+	require.True(t, FeeForGas(sdk.ZeroDec(), 100000).IsZero())
+}
+
+func TestZetaCoreBridge_SetMinGasPrice(t *testing.T) {
+	newBridge := func() *ZetaCoreBridge {
+		return &ZetaCoreBridge{broadcastLock: &sync.RWMutex{}, gasPrice: sdk.ZeroDec(), minGasPrice: sdk.ZeroDec()}
+	}
+
+	t.Run("minimum gas price is used while the gas price of zetacore is unknown", func(t *testing.T) {
+		b := newBridge()
+		require.NoError(t, b.SetMinGasPrice("10000000000"))
+		require.Equal(t, sdk.NewDec(10000000000), b.broadcastGasPrice())
+
+		b.gasPrice = sdk.NewDec(20000000000)
+		require.Equal(t, sdk.NewDec(20000000000), b.broadcastGasPrice())
+		b.gasPrice = sdk.NewDec(5000000000)
+		require.Equal(t, sdk.NewDec(10000000000), b.broadcastGasPrice())
+	})
+
+	t.Run("empty minimum gas price means no minimum", func(t *testing.T) {
+		b := newBridge()
+		require.NoError(t, b.SetMinGasPrice(""))
+		require.True(t, b.broadcastGasPrice().IsZero())
+	})
+
+	t.Run("invalid minimum gas price", func(t *testing.T) {
+		b := newBridge()
+		require.Error(t, b.SetMinGasPrice("10azeta"))
+		require.Error(t, b.SetMinGasPrice("-1"))
+	})
+}
+
+func TestIsInsufficientFee(t *testing.T) {
+	require.True(t, IsInsufficientFee(sdkerrors.RootCodespace, 13))
+	require.False(t, IsInsufficientFee(sdkerrors.RootCodespace, 32))
+	require.False(t, IsInsufficientFee("crosschain", 13))
+}
+
+func TestBumpGasPrice(t *testing.T) {
+	t.Run("gas price is bumped by the bump ratio", func(t *testing.T) {
+		gasPrice := BumpGasPrice(sdk.NewDec(10), 1000, "out of gas")
+		require.Equal(t, sdk.NewDec(15), gasPrice)
+	})
+
+	t.Run("gas price is at least the price of the required fee", func(t *testing.T) {
+		rawLog := "insufficient fees; got: 10000azeta required: 40000azeta: insufficient fee"
+		gasPrice := BumpGasPrice(sdk.NewDec(10), 1000, rawLog)
+		require.Equal(t, sdk.NewDec(40), gasPrice)
+	})
+
+	t.Run("gas price is at least the price of the minimum global fee", func(t *testing.T) {
+		rawLog := "provided fee < minimum global fee (10000azeta < 60000.000000000000000000azeta). Please increase the gas price.: insufficient fee"
+		gasPrice := BumpGasPrice(sdk.NewDec(10), 1000, rawLog)
+		require.Equal(t, sdk.NewDec(60), gasPrice)
+	})
+
+	t.Run("required fee lower than the bumped gas price is ignored", func(t *testing.T) {
+		rawLog := "insufficient fees; got: 10000azeta required: 12000azeta: insufficient fee"
+		gasPrice := BumpGasPrice(sdk.NewDec(10), 1000, rawLog)
+		require.Equal(t, sdk.NewDec(15), gasPrice)
+	})
+}
+
+func TestFeeMsgType(t *testing.T) {
+	msg := &crosschaintypes.MsgGasPriceVoter{}
+	msgExec := authz.NewMsgExec(sdk.AccAddress{}, []sdk.Msg{msg})
+	require.Equal(t, sdk.MsgTypeURL(msg), feeMsgType(&msgExec))
+	require.Equal(t, sdk.MsgTypeURL(msg), feeMsgType(msg))
+}
@@ -0,0 +1,154 @@
+package zetaclient
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/config"
+)
+
+func init() {
+	MustRegisterChainFamily(ChainFamily{
+		VmFamily: common.VmFamily_bitcoin,
+		Chains: func(cfg *config.Config) []common.Chain {
+			btcChain, _, enabled := cfg.GetBTCConfig()
+			if !enabled {
+				return nil
+			}
+			return []common.Chain{btcChain}
+		},
+		CoreParams: func(cfg *config.Config, chainID int64) (observertypes.CoreParams, bool) {
+			btcChain, btcConfig, enabled := cfg.GetBTCConfig()
+			if !enabled || btcChain.ChainId != chainID {
+				return observertypes.CoreParams{}, false
+			}
+			return btcConfig.CoreParams, true
+		},
+		NewChainClient: func(chain common.Chain, deps ChainFamilyDeps) (ChainClient, error) {
+			_, btcConfig, _ := deps.Config.GetBTCConfig()
+			return NewBitcoinClient(chain, deps.Bridge, deps.Tss, deps.DBPath, deps.Metrics, deps.Logger, btcConfig, deps.Telemetry)
+		},
+		NewChainSigner: func(chain common.Chain, deps ChainFamilyDeps) (ChainSigner, error) {
+			_, btcConfig, _ := deps.Config.GetBTCConfig()
+			signer, err := NewBTCSigner(btcConfig, deps.Tss, deps.Logger, deps.Telemetry)
+			if err != nil {
+				return nil, errors.Wrap(err, "NewBTCSigner error")
+			}
+			return signer, nil
+		},
+		Scheduler: BTCOutTxScheduler{},
+	})
+}
+
+// BTCOutTxScheduler schedules the keysigns of the pending cctxs of bitcoin chains
+// bitcoin outTxs are processed sequentially by nonce, see processBitcoinOutTx
+type BTCOutTxScheduler struct{}
+
+var _ OutTxScheduler = BTCOutTxScheduler{}
+
+func (BTCOutTxScheduler) ScheduleOutTxs(
+	bridge *ZetaCoreBridge,
+	outTxMan *OutTxProcessorManager,
+	cctxList []*types.CrossChainTx,
+	signer ChainSigner,
+	ob ChainClient,
+	_ map[uint64]bool,
+	currentHeight uint64,
+	logger zerolog.Logger,
+) {
+	for idx, cctx := range cctxList {
+		params := cctx.GetCurrentOutTxParam()
+		outTxID := fmt.Sprintf("%s-%d-%d", cctx.Index, params.ReceiverChainId, params.OutboundTxTssNonce)
+		if outTxMan.IsOutTxActive(outTxID) {
+			// bitcoun outTx is processed sequencially by nonce
+			// if the current outTx is being processed, there is no need to process outTx with future nonces
+			break
+		}
+		// #nosec G701 positive
+		if stop := processBitcoinOutTx(bridge, outTxMan, uint64(idx), cctxList, signer, ob, currentHeight, logger); stop {
+			break
+		}
+	}
+}
+
+// Bitcoin outtx is processed in a different way
+// 1. schedule one keysign on each ticker
+// 2. schedule keysign only when nonce-mark UTXO is available
+// 3. stop processing when pendingNonce/lookahead is reached
+// 4. if batching is enabled, the outtx of the pending nonce pays a batch of pending cctxs with consecutive nonces
+//
+// Returns whether to stop processing
+func processBitcoinOutTx(bridge *ZetaCoreBridge, outTxMan *OutTxProcessorManager, idx uint64, cctxList []*types.CrossChainTx, signer ChainSigner, ob ChainClient, currentHeight uint64, logger zerolog.Logger) bool {
+	send := cctxList[idx]
+	params := send.GetCurrentOutTxParam()
+	nonce := params.OutboundTxTssNonce
+	lookahead := ob.GetCoreParams().OutboundTxScheduleLookahead
+	outTxID := fmt.Sprintf("%s-%d-%d", send.Index, params.ReceiverChainId, nonce)
+
+	// get bitcoin client and signer
+	btcClient, ok := ob.(*BitcoinChainClient)
+	if !ok { // should never happen
+		logger.Error().Msgf("chain client is not a bitcoin client")
+		return true
+	}
+	btcSigner, ok := signer.(*BTCSigner)
+	if !ok { // should never happen
+		logger.Error().Msgf("chain signer is not a bitcoin signer")
+		return true
+	}
+	pendingNonce := btcClient.GetPendingNonce()
+
+	// start go routine to process outtx
+	outTxMan.StartTryProcess(outTxID)
+	batchSize := ob.GetCoreParams().OutboundTxBatchSize
+	if nonce == pendingNonce && batchSize > 1 {
+		// the following cctxs of the batch are paid by the same outtx, there is no need to process them
+		batch := GetBTCOutTxBatch(cctxList[idx:], batchSize, btcClient.Tss.BTCAddress())
+		logger.Debug().Msgf("Sign bitcoin outtx %s paying %d cctxs\n", outTxID, len(batch))
+		go btcSigner.TryProcessOutTxBatch(batch, outTxMan, outTxID, ob, bridge, currentHeight)
+		return true
+	}
+	logger.Debug().Msgf("Sign bitcoin outtx %s with value %d\n", outTxID, params.Amount)
+	go btcSigner.TryProcessOutTx(send, outTxMan, outTxID, ob, bridge, currentHeight)
+
+	// stop if the nonce being processed reaches the artificial pending nonce
+	if nonce >= pendingNonce {
+		return true
+	}
+	// stop if lookahead is reached. 2 bitcoin confirmations span is 20 minutes on average. We look ahead up to 100 pending cctx to target TPM of 5.
+	// #nosec G701 always in range
+	if int64(idx) >= lookahead-1 {
+		return true
+	}
+	return false // otherwise, continue
+}
+
+// GetBTCOutTxBatch returns the pending cctxs paid by the next bitcoin outtx
+// the batch starts with the first pending cctx and contains up to batchSize cctxs of consecutive nonces
+// Note: all signers must build the same batch to sign the same outtx, the keysign fails otherwise and is retried on next ticker
+func GetBTCOutTxBatch(cctxList []*types.CrossChainTx, batchSize uint64, tssAddress string) []*types.CrossChainTx {
+	if len(cctxList) == 0 {
+		return nil
+	}
+	batch := []*types.CrossChainTx{cctxList[0]}
+	for _, cctx := range cctxList[1:] {
+		if uint64(len(batch)) >= batchSize {
+			break
+		}
+		params := cctx.GetCurrentOutTxParam()
+		prevParams := batch[len(batch)-1].GetCurrentOutTxParam()
+		if params.OutboundTxTssNonce != prevParams.OutboundTxTssNonce+1 || params.CoinType != common.CoinType_Gas {
+			break
+		}
+		// a payment to the TSS address could be mistaken for the change of the outtx
+		if params.Receiver == tssAddress {
+			break
+		}
+		batch = append(batch, cctx)
+	}
+	return batch
+}
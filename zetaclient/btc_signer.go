@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/txscript"
@@ -16,22 +18,32 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/rs/zerolog"
 	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/common/bitcoin"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	zetaObserverModuleTypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/config"
 )
 
 const (
-	maxNoOfInputsPerTx = 20
-	outTxBytesMin      = 400    // 500B is a conservative estimate for a 2-input, 3-output SegWit tx
-	outTxBytesMax      = 4_000  // 4KB is a conservative estimate for a 21-input, 3-output SegWit tx
-	outTxBytesCap      = 10_000 // in case of accident
+	maxNoOfInputsPerTx      = 20
+	maxNoOfInputsPerSweepTx = 50     // the outTx migrating the TSS funds sweeps all the utxos, the others are consolidated first
+	outTxBytesMin           = 400    // 500B is a conservative estimate for a 2-input, 3-output SegWit tx
+	outTxBytesMax           = 4_000  // 4KB is a conservative estimate for a 21-input, 3-output SegWit tx
+	outTxBytesCap           = 10_000 // in case of accident
 
 	// for ZRC20 configuration
-	bytesPerInput = 150                             // each input is about 150 bytes
-	ZRC20GasLimit = outTxBytesMin + bytesPerInput*8 // 1600B a suggested ZRC20 GAS_LIMIT for a 10-input, 3-output SegWit tx
+	bytesPerInput  = 150                             // each input is about 150 bytes
+	bytesPerOutput = 43                              // each P2WPKH output is about 43 bytes, each additional payment of a batched outTx adds an output
+	ZRC20GasLimit  = outTxBytesMin + bytesPerInput*8 // 1600B a suggested ZRC20 GAS_LIMIT for a 10-input, 3-output SegWit tx
 )
 
+// BTCPayment is the payment to the receiver of a pending outbound
+type BTCPayment struct {
+	To     btcutil.Address
+	Amount float64 // in BTC
+	Nonce  uint64
+}
+
 type BTCSigner struct {
 	tssSigner TSSSigner
 	rpcClient *rpcclient.Client
@@ -66,10 +78,24 @@ func NewBTCSigner(cfg config.BTCConfig, tssSigner TSSSigner, logger zerolog.Logg
 }
 
 // SignWithdrawTx receives utxos sorted by value, amount in BTC, feeRate in BTC per Kb
-func (signer *BTCSigner) SignWithdrawTx(to *btcutil.AddressWitnessPubKeyHash, amount float64, gasPrice *big.Int, sizeLimit uint64,
-	btcClient *BitcoinChainClient, height uint64, nonce uint64, chain *common.Chain) (*wire.MsgTx, error) {
-	estimateFee := float64(gasPrice.Uint64()) * outTxBytesMax / 1e8
-	nonceMark := common.NonceMarkAmount(nonce)
+// the payments must have consecutive nonces, the tx pays all of them with a single nonce-mark of the last nonce:
+// [nonce-mark, payment of first nonce, ..., payment of last nonce, change to TSS (optional)]
+func (signer *BTCSigner) SignWithdrawTx(payments []BTCPayment, gasPrice *big.Int, sizeLimit uint64,
+	btcClient *BitcoinChainClient, height uint64, chain *common.Chain) (*wire.MsgTx, error) {
+	if len(payments) == 0 {
+		return nil, fmt.Errorf("no payment to sign")
+	}
+	amount := 0.0
+	for i, payment := range payments {
+		if payment.Nonce != payments[0].Nonce+uint64(i) {
+			return nil, fmt.Errorf("payment nonces are not consecutive: %d after %d", payment.Nonce, payments[0].Nonce)
+		}
+		amount += payment.Amount
+	}
+	nonce := payments[0].Nonce
+	extraBytes := bytesPerOutput * (len(payments) - 1)
+	estimateFee := float64(gasPrice.Uint64()) * float64(outTxBytesMax+extraBytes) / 1e8
+	nonceMark := common.NonceMarkAmount(payments[len(payments)-1].Nonce)
 
 	// refresh unspent UTXOs and continue with keysign regardless of error
 	err := btcClient.FetchUTXOS()
@@ -95,14 +121,9 @@ func (signer *BTCSigner) SignWithdrawTx(to *btcutil.AddressWitnessPubKeyHash, am
 		tx.AddTxIn(txIn)
 	}
 
-	amountSatoshis, err := getSatoshis(amount)
-	if err != nil {
-		return nil, err
-	}
-
 	// size checking
 	// #nosec G701 check as positive
-	txSize := uint64(tx.SerializeSize())
+	txSize := uint64(tx.SerializeSize() + extraBytes)
 	if txSize > sizeLimit { // ZRC20 'withdraw' charged less fee from end user
 		signer.logger.Info().Msgf("sizeLimit %d is less than txSize %d for nonce %d", sizeLimit, txSize, nonce)
 	}
@@ -119,7 +140,7 @@ func (signer *BTCSigner) SignWithdrawTx(to *btcutil.AddressWitnessPubKeyHash, am
 	// #nosec G701 always in range (checked above)
 	fees := new(big.Int).Mul(big.NewInt(int64(txSize)), gasPrice)
 	fees.Div(fees, big.NewInt(bytesPerKB))
-	signer.logger.Info().Msgf("bitcoin outTx nonce %d gasPrice %s size %d fees %s", nonce, gasPrice.String(), txSize, fees.String())
+	signer.logger.Info().Msgf("bitcoin outTx nonce %d payments %d gasPrice %s size %d fees %s", nonce, len(payments), gasPrice.String(), txSize, fees.String())
 
 	// calculate remaining btc to TSS self
 	tssAddrWPKH := signer.tssSigner.BTCAddressWitnessPubkeyHash()
@@ -147,44 +168,136 @@ func (signer *BTCSigner) SignWithdrawTx(to *btcutil.AddressWitnessPubKeyHash, am
 	txOut1 := wire.NewTxOut(nonceMark, payToSelf)
 	tx.AddTxOut(txOut1)
 
-	// 2nd output: the payment to the recipient
-	pkScript, err := payToWitnessPubKeyHashScript(to.WitnessProgram())
+	// next outputs: the payments to the recipients in ascending nonce order
+	for _, payment := range payments {
+		amountSatoshis, err := getSatoshis(payment.Amount)
+		if err != nil {
+			return nil, err
+		}
+		pkScript, err := bitcoin.PayToAddrScript(payment.To)
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxOut(wire.NewTxOut(amountSatoshis, pkScript))
+	}
+
+	// last output: the remaining btc to TSS self
+	if remainingSats > 0 {
+		txOutChange := wire.NewTxOut(remainingSats, payToSelf)
+		tx.AddTxOut(txOutChange)
+	}
+
+	// sign the tx
+	if err := SignTSSInputs(signer.tssSigner, tx, prevOuts, height, nonce, chain); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// SignMigrationTx signs the outTx migrating the TSS funds: the TSS utxos are swept to the new TSS
+// [nonce-mark, payment of the swept btc minus the fees to the new TSS]
+// the utxos that don't fit in the outTx are consolidated first, the outTx is signed once it can sweep all the utxos
+func (signer *BTCSigner) SignMigrationTx(payment BTCPayment, gasPrice *big.Int, btcClient *BitcoinChainClient, height uint64, chain *common.Chain) (*wire.MsgTx, error) {
+	nonce := payment.Nonce
+	nonceMark := common.NonceMarkAmount(nonce)
+
+	// refresh unspent UTXOs and continue with keysign regardless of error
+	err := btcClient.FetchUTXOS()
+	if err != nil {
+		signer.logger.Error().Err(err).Msgf("SignMigrationTx: FetchUTXOS error: nonce %d chain %d", nonce, chain.ChainId)
+	}
+	waiting, err := btcClient.ConsolidateUTXOsForMigration(nonce, gasPrice.Uint64(), height)
+	if err != nil {
+		return nil, err
+	}
+	if waiting {
+		return nil, fmt.Errorf("SignMigrationTx: waiting for the tss utxos to be consolidated: nonce %d chain %d", nonce, chain.ChainId)
+	}
+	prevOuts, total, err := btcClient.SelectSweepUTXOs(maxNoOfInputsPerSweepTx, nonce, false)
 	if err != nil {
 		return nil, err
 	}
-	txOut2 := wire.NewTxOut(amountSatoshis, pkScript)
-	tx.AddTxOut(txOut2)
 
-	// 3rd output: the remaining btc to TSS self
-	if remainingSats > 0 {
-		txOut3 := wire.NewTxOut(remainingSats, payToSelf)
-		tx.AddTxOut(txOut3)
+	// build tx with selected unspents
+	tx := wire.NewMsgTx(wire.TxVersion)
+	for _, prevOut := range prevOuts {
+		hash, err := chainhash.NewHashFromStr(prevOut.TxID)
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, prevOut.Vout), nil, nil))
+	}
+
+	// fee calculation
+	// #nosec G701 always positive
+	txSize := uint64(tx.SerializeSize())
+	if txSize < outTxBytesMin {
+		txSize = outTxBytesMin
+	}
+	if txSize > outTxBytesCap {
+		txSize = outTxBytesCap
+	}
+	// #nosec G701 always in range (checked above)
+	fees := new(big.Int).Mul(big.NewInt(int64(txSize)), gasPrice)
+	fees.Div(fees, big.NewInt(bytesPerKB))
+
+	totalSats, err := getSatoshis(total)
+	if err != nil {
+		return nil, err
+	}
+	migratedSats := totalSats - fees.Int64() - nonceMark
+	if migratedSats <= 0 {
+		return nil, fmt.Errorf("SignMigrationTx: swept value %d doesn't cover fees %s", totalSats, fees)
 	}
+	signer.logger.Info().Msgf("bitcoin migration outTx nonce %d inputs %d gasPrice %s size %d fees %s migrated %d", nonce, len(prevOuts), gasPrice, txSize, fees, migratedSats)
+
+	// 1st output: the nonce-mark btc to TSS self
+	tssAddrWPKH := signer.tssSigner.BTCAddressWitnessPubkeyHash()
+	payToSelf, err := payToWitnessPubKeyHashScript(tssAddrWPKH.WitnessProgram())
+	if err != nil {
+		return nil, err
+	}
+	tx.AddTxOut(wire.NewTxOut(nonceMark, payToSelf))
+
+	// 2nd output: the swept btc to the new TSS
+	pkScript, err := bitcoin.PayToAddrScript(payment.To)
+	if err != nil {
+		return nil, err
+	}
+	tx.AddTxOut(wire.NewTxOut(migratedSats, pkScript))
 
 	// sign the tx
+	if err := SignTSSInputs(signer.tssSigner, tx, prevOuts, height, nonce, chain); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// SignTSSInputs signs the SegWit inputs of the tx spending the TSS UTXOs prevOuts with a single TSS keysign
+func SignTSSInputs(tssSigner TSSSigner, tx *wire.MsgTx, prevOuts []btcjson.ListUnspentResult, height uint64, nonce uint64, chain *common.Chain) error {
 	sigHashes := txscript.NewTxSigHashes(tx)
 	witnessHashes := make([][]byte, len(tx.TxIn))
 	for ix := range tx.TxIn {
 		amt, err := getSatoshis(prevOuts[ix].Amount)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		pkScript, err := hex.DecodeString(prevOuts[ix].ScriptPubKey)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		witnessHashes[ix], err = txscript.CalcWitnessSigHash(pkScript, sigHashes, txscript.SigHashAll, tx, ix, amt)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
-	tss, ok := signer.tssSigner.(*TSS)
+	tss, ok := tssSigner.(*TSS)
 	if !ok {
-		return nil, fmt.Errorf("tssSigner is not a TSS")
+		return fmt.Errorf("tssSigner is not a TSS")
 	}
 	sig65Bs, err := tss.SignBatch(witnessHashes, height, nonce, chain)
 	if err != nil {
-		return nil, fmt.Errorf("SignBatch error: %v", err)
+		return fmt.Errorf("SignBatch error: %v", err)
 	}
 
 	for ix := range tx.TxIn {
@@ -196,12 +309,12 @@ func (signer *BTCSigner) SignWithdrawTx(to *btcutil.AddressWitnessPubKeyHash, am
 			S: S,
 		}
 
-		pkCompressed := signer.tssSigner.PubKeyCompressedBytes()
+		pkCompressed := tssSigner.PubKeyCompressedBytes()
 		hashType := txscript.SigHashAll
 		txWitness := wire.TxWitness{append(sig.Serialize(), byte(hashType)), pkCompressed}
 		tx.TxIn[ix].Witness = txWitness
 	}
-	return tx, nil
+	return nil
 }
 
 func (signer *BTCSigner) Broadcast(signedTx *wire.MsgTx) error {
@@ -224,25 +337,28 @@ func (signer *BTCSigner) Broadcast(signedTx *wire.MsgTx) error {
 }
 
 func (signer *BTCSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *OutTxProcessorManager, outTxID string, chainclient ChainClient, zetaBridge *ZetaCoreBridge, height uint64) {
+	signer.TryProcessOutTxBatch([]*types.CrossChainTx{send}, outTxMan, outTxID, chainclient, zetaBridge, height)
+}
+
+// TryProcessOutTxBatch pays the pending outbounds of consecutive nonces with a single outTx
+// outTxID is the ID of the first outbound of the batch
+func (signer *BTCSigner) TryProcessOutTxBatch(sends []*types.CrossChainTx, outTxMan *OutTxProcessorManager, outTxID string, chainclient ChainClient, zetaBridge *ZetaCoreBridge, height uint64) {
 	defer func() {
 		outTxMan.EndTryProcess(outTxID)
 		if err := recover(); err != nil {
-			signer.logger.Error().Msgf("BTC TryProcessOutTx: %s, caught panic error: %v", send.Index, err)
+			signer.logger.Error().Msgf("BTC TryProcessOutTx: %s, caught panic error: %v", outTxID, err)
 		}
 	}()
+	if len(sends) == 0 {
+		return
+	}
 
 	logger := signer.logger.With().
 		Str("OutTxID", outTxID).
-		Str("SendHash", send.Index).
+		Str("SendHash", sends[0].Index).
+		Int("BatchSize", len(sends)).
 		Logger()
 
-	params := send.GetCurrentOutTxParam()
-	if params.CoinType != common.CoinType_Gas {
-		logger.Error().Msgf("BTC TryProcessOutTx: can only send BTC to a BTC network")
-		return
-	}
-
-	logger.Info().Msgf("BTC TryProcessOutTx: %s, value %d to %s", send.Index, params.Amount.BigInt(), params.Receiver)
 	btcClient, ok := chainclient.(*BitcoinChainClient)
 	if !ok {
 		logger.Error().Msgf("chain client is not a bitcoin client")
@@ -258,55 +374,137 @@ func (signer *BTCSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 		return
 	}
 	myid := zetaBridge.keys.GetAddress()
-	// Early return if the send is already processed
-	// FIXME: handle revert case
-	outboundTxTssNonce := params.OutboundTxTssNonce
-	included, confirmed, err := btcClient.IsSendOutTxProcessed(send.Index, outboundTxTssNonce, common.CoinType_Gas, logger)
-	if err != nil {
-		logger.Error().Err(err).Msgf("cannot check if send %s is processed", send.Index)
-		return
+
+	payments := make([]BTCPayment, 0, len(sends))
+	sizelimit := uint64(0)
+	gasprice := big.NewInt(0)
+	isMigration := false
+	for i, send := range sends {
+		params := send.GetCurrentOutTxParam()
+		isMigrationSend := params.CoinType == common.CoinType_Cmd && strings.HasPrefix(send.RelayedMessage, common.CmdMigrateTssFunds+":")
+		if params.CoinType != common.CoinType_Gas && !isMigrationSend {
+			logger.Error().Msgf("BTC TryProcessOutTx: can only send BTC to a BTC network")
+			break
+		}
+		// the migration of the TSS funds sweeps the TSS utxos with an outTx of its own
+		if isMigrationSend && i > 0 {
+			break
+		}
+		if tss, ok := signer.tssSigner.(*TSS); ok && isMigrationSend && params.TssPubkey != tss.CurrentPubkey {
+			logger.Error().Msgf("BTC TryProcessOutTx: migration of tss %s can't be signed by tss %s", params.TssPubkey, tss.CurrentPubkey)
+			break
+		}
+		logger.Info().Msgf("BTC TryProcessOutTx: %s, value %d to %s", send.Index, params.Amount.BigInt(), params.Receiver)
+
+		// Early return if the send is already processed, the batch stops at the first processed send
+		// FIXME: handle revert case
+		included, confirmed, err := btcClient.IsSendOutTxProcessed(send.Index, params.OutboundTxTssNonce, params.CoinType, logger)
+		if err != nil {
+			logger.Error().Err(err).Msgf("cannot check if send %s is processed", send.Index)
+			break
+		}
+		if included || confirmed {
+			logger.Info().Msgf("CCTX %s nonce %d already processed", send.Index, params.OutboundTxTssNonce)
+			break
+		}
+
+		// the outTx pays the highest gas price of the batch
+		sendGasPrice, ok := new(big.Int).SetString(params.OutboundTxGasPrice, 10)
+		if !ok || sendGasPrice.Cmp(big.NewInt(0)) < 0 {
+			logger.Error().Msgf("cannot convert gas price  %s ", params.OutboundTxGasPrice)
+			break
+		}
+		if sendGasPrice.Cmp(gasprice) > 0 {
+			gasprice = sendGasPrice
+		}
+		sizelimit += params.OutboundTxGasLimit
+
+		// FIXME: config chain params
+		to, err := bitcoin.DecodeReceiverAddress(params.Receiver, config.BitconNetParams)
+		if err != nil {
+			logger.Error().Err(err).Msgf("cannot decode receiver address %s ", params.Receiver)
+			break
+		}
+		payments = append(payments, BTCPayment{
+			To:     to,
+			Amount: float64(params.Amount.Uint64()) / 1e8,
+			Nonce:  params.OutboundTxTssNonce,
+		})
+		if isMigrationSend {
+			isMigration = true
+			break
+		}
 	}
-	if included || confirmed {
-		logger.Info().Msgf("CCTX %s already processed; exit signer", outTxID)
+	if len(payments) == 0 {
+		logger.Info().Msgf("CCTX %s already processed or invalid; exit signer", outTxID)
 		return
 	}
+	firstNonce := payments[0].Nonce
+	lastNonce := payments[len(payments)-1].Nonce
 
-	sizelimit := params.OutboundTxGasLimit
-	gasprice, ok := new(big.Int).SetString(params.OutboundTxGasPrice, 10)
-	if !ok || gasprice.Cmp(big.NewInt(0)) < 0 {
-		logger.Error().Msgf("cannot convert gas price  %s ", params.OutboundTxGasPrice)
+	// the outTx signed before a restart and missing in the tracker is rebroadcasted instead of signing a new outTx
+	journaled, recovered := RecoverJournaledOutTx(btcClient, btcClient.chain, firstNonce, zetaBridge, func(rawTx []byte) error {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		if err := tx.Deserialize(bytes.NewReader(rawTx)); err != nil {
+			return err
+		}
+		return signer.Broadcast(tx)
+	}, logger)
+	if recovered {
+		logger.Info().Msgf("journaled outTx %s of nonce %d recovered; exit signer", journaled.Hash, firstNonce)
+		// the journaled outTx is tracked and saved for each nonce it pays
+		for nonce := firstNonce; ; nonce++ {
+			entry, found := btcClient.GetSigningJournal().Get(btcClient.GetTxID(nonce))
+			if !found || entry.Hash != journaled.Hash {
+				break
+			}
+			if nonce != firstNonce {
+				_, err := zetaBridge.AddTxHashToOutTxTracker(btcClient.chain.ChainId, nonce, journaled.Hash, nil, "", -1)
+				if err != nil {
+					logger.Err(err).Msgf("Unable to add journaled outTx to tracker on ZetaCore: nonce %d chain %s outTxHash %s", nonce, btcClient.chain.ChainName, journaled.Hash)
+				}
+			}
+			btcClient.SaveBroadcastedTx(journaled.Hash, nonce)
+		}
 		return
 	}
 
-	// FIXME: config chain params
-	addr, err := btcutil.DecodeAddress(params.Receiver, config.BitconNetParams)
-	if err != nil {
-		logger.Error().Err(err).Msgf("cannot decode address %s ", params.Receiver)
-		return
-	}
-	to, ok := addr.(*btcutil.AddressWitnessPubKeyHash)
-	if err != nil || !ok {
-		logger.Error().Err(err).Msgf("cannot convert address %s to P2WPKH address", params.Receiver)
-		return
+	for _, payment := range payments {
+		logger.Info().Msgf("SignWithdrawTx: to %s, value %f btc, nonce %d", payment.To.EncodeAddress(), payment.Amount, payment.Nonce)
 	}
-
-	logger.Info().Msgf("SignWithdrawTx: to %s, value %d sats", addr.EncodeAddress(), params.Amount.Uint64())
 	logger.Info().Msgf("using utxos: %v", btcClient.utxos)
-	tx, err := signer.SignWithdrawTx(to, float64(params.Amount.Uint64())/1e8, gasprice, sizelimit, btcClient, height,
-		outboundTxTssNonce, &btcClient.chain)
+	var tx *wire.MsgTx
+	if isMigration {
+		tx, err = signer.SignMigrationTx(payments[0], gasprice, btcClient, height, &btcClient.chain)
+	} else {
+		tx, err = signer.SignWithdrawTx(payments, gasprice, sizelimit, btcClient, height, &btcClient.chain)
+	}
 	if err != nil {
-		logger.Warn().Err(err).Msgf("SignOutboundTx error: nonce %d chain %d", outboundTxTssNonce, params.ReceiverChainId)
+		logger.Warn().Err(err).Msgf("SignOutboundTx error: nonce %d-%d chain %d", firstNonce, lastNonce, btcClient.chain.ChainId)
 		return
 	}
-	logger.Info().Msgf("Key-sign success: %d => %s, nonce %d", send.InboundTxParams.SenderChainId, btcClient.chain.ChainName, outboundTxTssNonce)
+	logger.Info().Msgf("Key-sign success: %d => %s, nonce %d-%d", sends[0].InboundTxParams.SenderChainId, btcClient.chain.ChainName, firstNonce, lastNonce)
 	// FIXME: add prometheus metrics
 	_, err = zetaBridge.GetObserverList(btcClient.chain)
 	if err != nil {
-		logger.Warn().Err(err).Msgf("unable to get observer list: chain %d observation %s", outboundTxTssNonce, zetaObserverModuleTypes.ObservationType_OutBoundTx.String())
+		logger.Warn().Err(err).Msgf("unable to get observer list: chain %d observation %s", firstNonce, zetaObserverModuleTypes.ObservationType_OutBoundTx.String())
 	}
 	if tx != nil {
 		outTxHash := tx.TxHash().String()
-		logger.Info().Msgf("on chain %s nonce %d, outTxHash %s signer %s", btcClient.chain.ChainName, outboundTxTssNonce, outTxHash, myid)
+		logger.Info().Msgf("on chain %s nonce %d-%d, outTxHash %s signer %s", btcClient.chain.ChainName, firstNonce, lastNonce, outTxHash, myid)
+
+		// record the signed outTx for each nonce it pays before broadcasting it
+		var rawTx bytes.Buffer
+		if err := tx.Serialize(&rawTx); err != nil {
+			logger.Error().Err(err).Msgf("error serializing outTx %s", outTxHash)
+		} else {
+			for _, payment := range payments {
+				err := btcClient.GetSigningJournal().Record(btcClient.GetTxID(payment.Nonce), payment.Nonce, outTxHash, rawTx.Bytes())
+				if err != nil {
+					logger.Error().Err(err).Msgf("error recording outTx %s of nonce %d in signing journal", outTxHash, payment.Nonce)
+				}
+			}
+		}
 		// TODO: pick a few broadcasters.
 		//if len(signers) == 0 || myid == signers[send.OutboundTxParams.Broadcaster] || myid == signers[int(send.OutboundTxParams.Broadcaster+1)%len(signers)] {
 		// retry loop: 1s, 2s, 4s, 8s, 16s in case of RPC error
@@ -315,18 +513,22 @@ func (signer *BTCSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 			time.Sleep(time.Duration(rand.Intn(1500)) * time.Millisecond) //random delay to avoid sychronized broadcast
 			err := signer.Broadcast(tx)
 			if err != nil {
-				logger.Warn().Err(err).Msgf("broadcasting tx %s to chain %s: nonce %d, retry %d", outTxHash, btcClient.chain.ChainName, outboundTxTssNonce, i)
+				logger.Warn().Err(err).Msgf("broadcasting tx %s to chain %s: nonce %d-%d, retry %d", outTxHash, btcClient.chain.ChainName, firstNonce, lastNonce, i)
 				continue
 			}
-			logger.Info().Msgf("Broadcast success: nonce %d to chain %s outTxHash %s", outboundTxTssNonce, btcClient.chain.String(), outTxHash)
-			zetaHash, err := zetaBridge.AddTxHashToOutTxTracker(btcClient.chain.ChainId, outboundTxTssNonce, outTxHash, nil, "", -1)
-			if err != nil {
-				logger.Err(err).Msgf("Unable to add to tracker on ZetaCore: nonce %d chain %s outTxHash %s", outboundTxTssNonce, btcClient.chain.ChainName, outTxHash)
+			logger.Info().Msgf("Broadcast success: nonce %d-%d to chain %s outTxHash %s", firstNonce, lastNonce, btcClient.chain.String(), outTxHash)
+
+			// the outTx is tracked for each nonce it pays
+			for _, payment := range payments {
+				zetaHash, err := zetaBridge.AddTxHashToOutTxTracker(btcClient.chain.ChainId, payment.Nonce, outTxHash, nil, "", -1)
+				if err != nil {
+					logger.Err(err).Msgf("Unable to add to tracker on ZetaCore: nonce %d chain %s outTxHash %s", payment.Nonce, btcClient.chain.ChainName, outTxHash)
+				}
+				logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
+
+				// Save successfully broadcasted transaction to btc chain client
+				btcClient.SaveBroadcastedTx(outTxHash, payment.Nonce)
 			}
-			logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
-
-			// Save successfully broadcasted transaction to btc chain client
-			btcClient.SaveBroadcastedTx(outTxHash, outboundTxTssNonce)
 
 			break // successful broadcast; no need to retry
 		}
@@ -1,12 +1,14 @@
 package zetaclient
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"sort"
 	"sync"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -19,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/config"
 	. "gopkg.in/check.v1"
 )
@@ -344,3 +347,247 @@ func TestSelectUTXOs(t *testing.T) {
 	require.Zero(t, amount)
 	require.Equal(t, "SelectUTXOs: not enough btc in reserve - available : 21.63107432 , tx amount : 21.64", err.Error())
 }
+
+// helper function to create the vout paying the amount in satoshis to the address
+func createTestVout(t *testing.T, n uint32, address string, sats int64) btcjson.Vout {
+	addr, err := btcutil.DecodeAddress(address, config.BitconNetParams)
+	require.NoError(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+	return btcjson.Vout{
+		N:            n,
+		Value:        float64(sats) / 1e8,
+		ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: hex.EncodeToString(pkScript)},
+	}
+}
+
+// testBTCSigner is a TestSigner whose BTC address is its P2WPKH address on the bitcoin network in use, like the TSS
+type testBTCSigner struct {
+	TestSigner
+}
+
+func (s testBTCSigner) BTCAddress() string {
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(s.BTCAddressWitnessPubkeyHash().WitnessProgram(), config.BitconNetParams)
+	if err != nil {
+		return ""
+	}
+	return addr.EncodeAddress()
+}
+
+func TestCheckTSSVoutBatch(t *testing.T) {
+	ob := createTestClient(t)
+	ob.Tss = testBTCSigner{ob.Tss.(TestSigner)}
+	ob.chain = *common.GetChainFromChainID(common.BtcChainID())
+	tssAddress := ob.Tss.BTCAddress()
+	receivers := make([]string, 3)
+	for i := range receivers {
+		addr, err := btcutil.NewAddressWitnessPubKeyHash(bytes.Repeat([]byte{byte(i + 1)}, 20), config.BitconNetParams)
+		require.NoError(t, err)
+		receivers[i] = addr.EncodeAddress()
+	}
+
+	// batched outTx paying nonces [5, 7]
+	vouts := []btcjson.Vout{
+		createTestVout(t, 0, tssAddress, common.NonceMarkAmount(7)),
+		createTestVout(t, 1, receivers[0], 10_000),
+		createTestVout(t, 2, receivers[1], 20_000),
+		createTestVout(t, 3, receivers[2], 30_000),
+		createTestVout(t, 4, tssAddress, 1_000_000),
+	}
+	first, last, err := ob.outTxNonceRange(vouts)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, first)
+	require.EqualValues(t, 7, last)
+
+	for i, receiver := range receivers {
+		params := types.OutboundTxParams{
+			Receiver:           receiver,
+			Amount:             math.NewUint(uint64(10_000 * (i + 1))),
+			OutboundTxTssNonce: uint64(5 + i),
+		}
+		require.NoError(t, ob.checkTSSVout(vouts, params, first))
+	}
+
+	// wrong payment
+	params := types.OutboundTxParams{Receiver: receivers[0], Amount: math.NewUint(20_000), OutboundTxTssNonce: 6}
+	require.Error(t, ob.checkTSSVout(vouts, params, first))
+
+	// nonce not paid by the outTx
+	params = types.OutboundTxParams{Receiver: receivers[0], Amount: math.NewUint(10_000), OutboundTxTssNonce: 8}
+	require.Error(t, ob.checkTSSVout(vouts, params, first))
+
+	// single outTx without change
+	vouts = []btcjson.Vout{
+		createTestVout(t, 0, tssAddress, common.NonceMarkAmount(5)),
+		createTestVout(t, 1, receivers[0], 10_000),
+	}
+	first, last, err = ob.outTxNonceRange(vouts)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, first)
+	require.EqualValues(t, 5, last)
+	params = types.OutboundTxParams{Receiver: receivers[0], Amount: math.NewUint(10_000), OutboundTxTssNonce: 5}
+	require.NoError(t, ob.checkTSSVout(vouts, params, first))
+}
+
+func TestGetBTCOutTxBatch(t *testing.T) {
+	tssAddress := "tss"
+	newCctx := func(nonce uint64, coinType common.CoinType, receiver string) *types.CrossChainTx {
+		return &types.CrossChainTx{
+			OutboundTxParams: []*types.OutboundTxParams{{
+				Receiver:           receiver,
+				CoinType:           coinType,
+				OutboundTxTssNonce: nonce,
+			}},
+		}
+	}
+	receiver := "receiver"
+
+	t.Run("empty list", func(t *testing.T) {
+		require.Empty(t, GetBTCOutTxBatch(nil, 5, tssAddress))
+	})
+
+	t.Run("batch is limited by the batch size", func(t *testing.T) {
+		cctxs := []*types.CrossChainTx{
+			newCctx(3, common.CoinType_Gas, receiver),
+			newCctx(4, common.CoinType_Gas, receiver),
+			newCctx(5, common.CoinType_Gas, receiver),
+		}
+		require.Equal(t, cctxs[:2], GetBTCOutTxBatch(cctxs, 2, tssAddress))
+		require.Equal(t, cctxs, GetBTCOutTxBatch(cctxs, 5, tssAddress))
+	})
+
+	t.Run("batch stops at nonce gap", func(t *testing.T) {
+		cctxs := []*types.CrossChainTx{
+			newCctx(3, common.CoinType_Gas, receiver),
+			newCctx(5, common.CoinType_Gas, receiver),
+		}
+		require.Equal(t, cctxs[:1], GetBTCOutTxBatch(cctxs, 5, tssAddress))
+	})
+
+	t.Run("batch stops at non-gas cctx or payment to TSS", func(t *testing.T) {
+		cctxs := []*types.CrossChainTx{
+			newCctx(3, common.CoinType_Gas, receiver),
+			newCctx(4, common.CoinType_Gas, receiver),
+			newCctx(5, common.CoinType_ERC20, receiver),
+		}
+		require.Equal(t, cctxs[:2], GetBTCOutTxBatch(cctxs, 5, tssAddress))
+
+		cctxs[2] = newCctx(5, common.CoinType_Gas, tssAddress)
+		require.Equal(t, cctxs[:2], GetBTCOutTxBatch(cctxs, 5, tssAddress))
+	})
+}
+
+func TestSelectConsolidationUTXOs(t *testing.T) {
+	newUtxos := func(amounts ...float64) []btcjson.ListUnspentResult {
+		utxos := make([]btcjson.ListUnspentResult, 0, len(amounts))
+		for i, amount := range amounts {
+			utxos = append(utxos, btcjson.ListUnspentResult{TxID: fmt.Sprintf("tx%d", i), Amount: amount, Confirmations: 1})
+		}
+		return utxos
+	}
+
+	t.Run("nothing selected below threshold", func(t *testing.T) {
+		utxos := newUtxos(0.001, 0.002, 0.003)
+		require.Empty(t, SelectConsolidationUTXOs(utxos, 3, 10, ""))
+		require.Len(t, SelectConsolidationUTXOs(utxos, 2, 10, ""), 3)
+	})
+
+	t.Run("selection is limited to max number of inputs", func(t *testing.T) {
+		amounts := make([]float64, maxNoOfInputsPerTx+5)
+		for i := range amounts {
+			amounts[i] = 0.001 * float64(i+1)
+		}
+		selected := SelectConsolidationUTXOs(newUtxos(amounts...), 2, 10, "")
+		require.Len(t, selected, maxNoOfInputsPerTx)
+		require.Equal(t, 0.001, selected[0].Amount)
+	})
+
+	t.Run("skip nonce-mark, unconfirmed and dust utxos", func(t *testing.T) {
+		utxos := newUtxos(0.00000500, 0.001, 0.002, 0.003, 0.004)
+		utxos[2].Confirmations = 0
+		selected := SelectConsolidationUTXOs(utxos, 2, 10, "tx1")
+		require.Len(t, selected, 2)
+		require.Equal(t, "tx3", selected[0].TxID)
+		require.Equal(t, "tx4", selected[1].TxID)
+	})
+
+	t.Run("nothing selected if less than two utxos can be merged", func(t *testing.T) {
+		utxos := newUtxos(0.00000500, 0.00000600, 0.001)
+		require.Empty(t, SelectConsolidationUTXOs(utxos, 2, 10, ""))
+	})
+
+	t.Run("consolidated utxos are removed", func(t *testing.T) {
+		utxos := newUtxos(0.001, 0.002, 0.003, 0.004)
+		selected := SelectConsolidationUTXOs(utxos, 2, 10, "tx0")
+		require.Len(t, selected, 3)
+		require.Equal(t, utxos[:1], removeUTXOs(utxos, selected))
+	})
+
+	t.Run("consolidated utxos are reserved until released", func(t *testing.T) {
+		ob := &BitcoinChainClient{mu: &sync.Mutex{}, utxos: newUtxos(0.001, 0.002, 0.003, 0.004)}
+		ob.pendingNonce = 3
+
+		// no reservation while an outTx is pending
+		require.Empty(t, ob.reserveConsolidationUTXOs(types.PendingNonces{NonceLow: 3, NonceHigh: 4}, 3, 2, 10, "tx0"))
+		require.Len(t, ob.utxos, 4)
+
+		selected := ob.reserveConsolidationUTXOs(types.PendingNonces{NonceLow: 3, NonceHigh: 3}, 3, 2, 10, "tx0")
+		require.Len(t, selected, 3)
+		require.Equal(t, newUtxos(0.001), ob.utxos)
+		require.Equal(t, selected, ob.reservedUTXOs)
+
+		ob.releaseReservedUTXOs()
+		require.Empty(t, ob.reservedUTXOs)
+	})
+}
+
+func TestUTXOFragmentation(t *testing.T) {
+	require.Equal(t, float64(0), UTXOFragmentation(nil))
+
+	ob := createTestClient(t)
+	require.Equal(t, float64(0), UTXOFragmentation(ob.utxos))
+
+	amounts := make([]float64, maxNoOfInputsPerTx*2)
+	utxos := make([]btcjson.ListUnspentResult, 0, len(amounts))
+	for range amounts {
+		utxos = append(utxos, btcjson.ListUnspentResult{Amount: 0.5})
+	}
+	require.InDelta(t, 0.5, UTXOFragmentation(utxos), 1e-9)
+}
+
+func TestConsolidateUTXOsForMigration(t *testing.T) {
+	newUtxos := func(n int, confirmations int64) []btcjson.ListUnspentResult {
+		utxos := make([]btcjson.ListUnspentResult, 0, n)
+		for i := 0; i < n; i++ {
+			utxos = append(utxos, btcjson.ListUnspentResult{TxID: fmt.Sprintf("tx%d", i), Amount: 0.001, Confirmations: confirmations})
+		}
+		return utxos
+	}
+
+	t.Run("no consolidation if the utxos fit in the migration outTx", func(t *testing.T) {
+		ob := createTestClient(t)
+		ob.utxos = newUtxos(maxNoOfInputsPerSweepTx+1, 1)
+		waiting, err := ob.ConsolidateUTXOsForMigration(0, 10, 100)
+		require.NoError(t, err)
+		require.False(t, waiting)
+	})
+
+	t.Run("migration waits for the unconfirmed consolidation", func(t *testing.T) {
+		ob := createTestClient(t)
+		ob.utxos = newUtxos(maxNoOfInputsPerSweepTx+2, 0)
+		waiting, err := ob.ConsolidateUTXOsForMigration(0, 10, 100)
+		require.NoError(t, err)
+		require.True(t, waiting)
+	})
+
+	t.Run("dust utxos that can't be consolidated don't block the migration", func(t *testing.T) {
+		ob := createTestClient(t)
+		ob.utxos = newUtxos(maxNoOfInputsPerSweepTx+2, 1)
+		for i := range ob.utxos {
+			ob.utxos[i].Amount = 0.00000500
+		}
+		waiting, err := ob.ConsolidateUTXOsForMigration(0, 10, 100)
+		require.NoError(t, err)
+		require.False(t, waiting)
+	})
+}
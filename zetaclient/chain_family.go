@@ -0,0 +1,166 @@
+package zetaclient
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/config"
+	"github.com/zeta-chain/node/zetaclient/metrics"
+)
+
+// ChainFamilyDeps are the zetaclient components used to create the chain clients and signers of a chain family
+type ChainFamilyDeps struct {
+	Bridge    *ZetaCoreBridge
+	Tss       TSSSigner
+	DBPath    string
+	Metrics   *metrics.Metrics
+	Logger    zerolog.Logger
+	Config    *config.Config
+	Telemetry *TelemetryServer
+}
+
+// OutTxScheduler schedules the keysigns of the pending cctxs of a chain
+// ScheduleOutTxs is called by the core observer on each new zeta block with the pending cctxs of the chain sorted by nonce
+// and the nonces that already have an outTx tracker
+type OutTxScheduler interface {
+	ScheduleOutTxs(
+		bridge *ZetaCoreBridge,
+		outTxMan *OutTxProcessorManager,
+		cctxList []*types.CrossChainTx,
+		signer ChainSigner,
+		ob ChainClient,
+		trackerMap map[uint64]bool,
+		currentHeight uint64,
+		logger zerolog.Logger,
+	)
+}
+
+// ChainFamily describes how zetaclient observes and signs for the chains of a vm family of the chain registry
+// A chain family is added to zetaclient by registering it with RegisterChainFamily, usually from the init function of
+// the package implementing it
+type ChainFamily struct {
+	VmFamily common.VmFamily
+
+	// Chains returns the chains of the family configured in the zetaclient config
+	Chains func(cfg *config.Config) []common.Chain
+
+	// CoreParams returns the core params of a chain of the family from the zetaclient config
+	CoreParams func(cfg *config.Config, chainID int64) (observertypes.CoreParams, bool)
+
+	NewChainClient func(chain common.Chain, deps ChainFamilyDeps) (ChainClient, error)
+	NewChainSigner func(chain common.Chain, deps ChainFamilyDeps) (ChainSigner, error)
+	Scheduler      OutTxScheduler
+}
+
+// Validate checks all the components of the chain family are provided
+func (f ChainFamily) Validate() error {
+	if f.VmFamily == common.VmFamily_no_vm || f.VmFamily == common.VmFamily_zeta_core {
+		return fmt.Errorf("invalid vm family %s", f.VmFamily)
+	}
+	if f.Chains == nil || f.CoreParams == nil || f.NewChainClient == nil || f.NewChainSigner == nil {
+		return fmt.Errorf("chain family %s: missing constructor", f.VmFamily)
+	}
+	if f.Scheduler == nil {
+		return fmt.Errorf("chain family %s: missing outtx scheduler", f.VmFamily)
+	}
+	return nil
+}
+
+var chainFamilies = struct {
+	mu       sync.RWMutex
+	families map[common.VmFamily]ChainFamily
+}{
+	families: make(map[common.VmFamily]ChainFamily),
+}
+
+// RegisterChainFamily adds a chain family to zetaclient, a vm family can only be registered once
+func RegisterChainFamily(family ChainFamily) error {
+	if err := family.Validate(); err != nil {
+		return err
+	}
+	chainFamilies.mu.Lock()
+	defer chainFamilies.mu.Unlock()
+	if _, found := chainFamilies.families[family.VmFamily]; found {
+		return fmt.Errorf("chain family %s already registered", family.VmFamily)
+	}
+	chainFamilies.families[family.VmFamily] = family
+	return nil
+}
+
+// MustRegisterChainFamily adds a chain family to zetaclient and panics on error
+func MustRegisterChainFamily(family ChainFamily) {
+	if err := RegisterChainFamily(family); err != nil {
+		panic(err)
+	}
+}
+
+// GetChainFamily returns the registered chain family of the vm family
+func GetChainFamily(vmFamily common.VmFamily) (ChainFamily, bool) {
+	chainFamilies.mu.RLock()
+	defer chainFamilies.mu.RUnlock()
+	family, found := chainFamilies.families[vmFamily]
+	return family, found
+}
+
+// GetChainFamilyByChainID returns the registered chain family of the chain, the vm family of the chain is looked up
+// in the chain registry of the config
+func GetChainFamilyByChainID(cfg *config.Config, chainID int64) (ChainFamily, bool) {
+	info, found := cfg.GetChainInfo(chainID)
+	if !found {
+		return ChainFamily{}, false
+	}
+	return GetChainFamily(info.VmFamily)
+}
+
+// RegisteredChainFamilies returns the registered chain families sorted by vm family
+func RegisteredChainFamilies() []ChainFamily {
+	chainFamilies.mu.RLock()
+	defer chainFamilies.mu.RUnlock()
+	families := make([]ChainFamily, 0, len(chainFamilies.families))
+	for _, family := range chainFamilies.families {
+		families = append(families, family)
+	}
+	sort.SliceStable(families, func(i, j int) bool {
+		return families[i].VmFamily < families[j].VmFamily
+	})
+	return families
+}
+
+// CreateSignerMap creates the signers of the chains of all registered chain families
+// a chain is skipped if its signer can't be created
+func CreateSignerMap(deps ChainFamilyDeps) map[common.Chain]ChainSigner {
+	signerMap := make(map[common.Chain]ChainSigner)
+	for _, family := range RegisteredChainFamilies() {
+		for _, chain := range family.Chains(deps.Config) {
+			signer, err := family.NewChainSigner(chain, deps)
+			if err != nil {
+				deps.Logger.Error().Err(err).Msgf("NewChainSigner error for chain %s", chain.String())
+				continue
+			}
+			signerMap[chain] = signer
+		}
+	}
+	return signerMap
+}
+
+// CreateChainClientMap creates the chain clients of the chains of all registered chain families
+// a chain is skipped if its client can't be created
+func CreateChainClientMap(deps ChainFamilyDeps) map[common.Chain]ChainClient {
+	clientMap := make(map[common.Chain]ChainClient)
+	for _, family := range RegisteredChainFamilies() {
+		for _, chain := range family.Chains(deps.Config) {
+			client, err := family.NewChainClient(chain, deps)
+			if err != nil {
+				deps.Logger.Error().Err(err).Msgf("NewChainClient error for chain %s", chain.String())
+				continue
+			}
+			clientMap[chain] = client
+		}
+	}
+	return clientMap
+}
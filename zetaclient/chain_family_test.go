@@ -0,0 +1,111 @@
+package zetaclient
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/config"
+)
+
+type testChainClient struct {
+	ChainClient
+	chain common.Chain
+}
+
+type testChainSigner struct {
+	ChainSigner
+	chain common.Chain
+}
+
+type testOutTxScheduler struct{}
+
+func (testOutTxScheduler) ScheduleOutTxs(*ZetaCoreBridge, *OutTxProcessorManager, []*types.CrossChainTx, ChainSigner, ChainClient, map[uint64]bool, uint64, zerolog.Logger) {
+}
+
+// testChainFamily returns a chain family of an unused vm family creating clients and signers for the given chains
+// the client of failingChain can't be created
+func testChainFamily(chains []common.Chain, failingChain int64) ChainFamily {
+	return ChainFamily{
+		VmFamily: common.VmFamily(100),
+		Chains: func(*config.Config) []common.Chain {
+			return chains
+		},
+		CoreParams: func(cfg *config.Config, chainID int64) (observertypes.CoreParams, bool) {
+			return observertypes.CoreParams{ChainId: chainID}, true
+		},
+		NewChainClient: func(chain common.Chain, _ ChainFamilyDeps) (ChainClient, error) {
+			if chain.ChainId == failingChain {
+				return nil, errors.New("failing chain")
+			}
+			return testChainClient{chain: chain}, nil
+		},
+		NewChainSigner: func(chain common.Chain, _ ChainFamilyDeps) (ChainSigner, error) {
+			return testChainSigner{chain: chain}, nil
+		},
+		Scheduler: testOutTxScheduler{},
+	}
+}
+
+func TestChainFamily_Validate(t *testing.T) {
+	require.NoError(t, testChainFamily(nil, 0).Validate())
+
+	family := testChainFamily(nil, 0)
+	family.VmFamily = common.VmFamily_zeta_core
+	require.Error(t, family.Validate())
+
+	family = testChainFamily(nil, 0)
+	family.NewChainSigner = nil
+	require.Error(t, family.Validate())
+
+	family = testChainFamily(nil, 0)
+	family.Scheduler = nil
+	require.Error(t, family.Validate())
+}
+
+func TestRegisterChainFamily(t *testing.T) {
+	t.Run("built-in chain families are registered", func(t *testing.T) {
+		family, found := GetChainFamily(common.VmFamily_evm)
+		require.True(t, found)
+		require.IsType(t, EVMOutTxScheduler{}, family.Scheduler)
+
+		family, found = GetChainFamilyByChainID(config.NewConfig(), common.BtcChainID())
+		require.True(t, found)
+		require.IsType(t, BTCOutTxScheduler{}, family.Scheduler)
+
+		_, found = GetChainFamilyByChainID(config.NewConfig(), common.ZetaChain().ChainId)
+		require.False(t, found)
+	})
+
+	t.Run("can't register a vm family twice", func(t *testing.T) {
+		family := testChainFamily(nil, 0)
+		family.VmFamily = common.VmFamily_evm
+		require.Error(t, RegisterChainFamily(family))
+	})
+
+	t.Run("create clients and signers of a registered chain family", func(t *testing.T) {
+		chainA := common.Chain{ChainName: common.ChainName_empty, ChainId: 1001}
+		chainB := common.Chain{ChainName: common.ChainName_empty, ChainId: 1002}
+		require.NoError(t, RegisterChainFamily(testChainFamily([]common.Chain{chainA, chainB}, chainB.ChainId)))
+		t.Cleanup(func() {
+			chainFamilies.mu.Lock()
+			delete(chainFamilies.families, common.VmFamily(100))
+			chainFamilies.mu.Unlock()
+		})
+		families := RegisteredChainFamilies()
+		require.Equal(t, common.VmFamily(100), families[len(families)-1].VmFamily)
+
+		deps := ChainFamilyDeps{Config: config.NewConfig(), Logger: zerolog.Nop()}
+		clientMap := CreateChainClientMap(deps)
+		require.Equal(t, testChainClient{chain: chainA}, clientMap[chainA])
+		require.NotContains(t, clientMap, chainB)
+
+		signerMap := CreateSignerMap(deps)
+		require.Equal(t, testChainSigner{chain: chainA}, signerMap[chainA])
+		require.Equal(t, testChainSigner{chain: chainB}, signerMap[chainB])
+	})
+}
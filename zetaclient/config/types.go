@@ -58,12 +58,24 @@ type Config struct {
 	CurrentTssPubkey    string `json:"CurrentTssPubkey"`
 	SignerPass          string `json:"SignerPass"`
 
+	// votes are broadcasted to zetacore in batches of up to BroadcastBatchMaxMsgs votes collected during
+	// BroadcastBatchWindow milliseconds, votes are broadcasted one by one if BroadcastBatchWindow is 0
+	BroadcastBatchWindow  uint64 `json:"BroadcastBatchWindow"`
+	BroadcastBatchMaxMsgs uint64 `json:"BroadcastBatchMaxMsgs"`
+
+	// minimum gas price in azeta of the txs broadcasted to zetacore, the fees are priced from it while the gas price of
+	// zetacore is unknown
+	BroadcastMinGasPrice string `json:"BroadcastMinGasPrice"`
+
 	// chain specific fields are updatable at runtime and shared across threads
 	cfgLock         *sync.RWMutex        `json:"-"`
 	Keygen          observertypes.Keygen `json:"Keygen"`
 	ChainsEnabled   []common.Chain       `json:"ChainsEnabled"`
 	EVMChainConfigs map[int64]*EVMConfig `json:"EVMChainConfigs"`
 	BitcoinConfig   *BTCConfig           `json:"BitcoinConfig"`
+
+	// chain registry received from zetacore
+	chainInfos []common.ChainInfo `json:"-"`
 }
 
 func NewConfig() *Config {
@@ -103,6 +115,37 @@ func (c *Config) GetEnabledChains() []common.Chain {
 	return copiedChains
 }
 
+// GetChainInfoList returns the chain registry received from zetacore
+// the default chains of the build are returned until the registry is received
+func (c *Config) GetChainInfoList() []common.ChainInfo {
+	c.cfgLock.RLock()
+	defer c.cfgLock.RUnlock()
+	if len(c.chainInfos) == 0 {
+		return common.DefaultChainInfoList()
+	}
+	copied := make([]common.ChainInfo, len(c.chainInfos))
+	copy(copied, c.chainInfos)
+	return copied
+}
+
+// GetChainInfo returns the entry of the chain in the chain registry received from zetacore
+func (c *Config) GetChainInfo(chainID int64) (common.ChainInfo, bool) {
+	for _, info := range c.GetChainInfoList() {
+		if info.Chain.ChainId == chainID {
+			return info, true
+		}
+	}
+	return common.ChainInfo{}, false
+}
+
+// SetChainInfoList sets the chain registry received from zetacore
+func (c *Config) SetChainInfoList(chainInfos []common.ChainInfo) {
+	c.cfgLock.Lock()
+	defer c.cfgLock.Unlock()
+	c.chainInfos = make([]common.ChainInfo, len(chainInfos))
+	copy(c.chainInfos, chainInfos)
+}
+
 func (c *Config) GetEVMConfig(chainID int64) (EVMConfig, bool) {
 	c.cfgLock.RLock()
 	defer c.cfgLock.RUnlock()
@@ -195,12 +238,18 @@ func (c *Config) Clone() *Config {
 		TssPath:             c.TssPath,
 		TestTssKeysign:      c.TestTssKeysign,
 
+		BroadcastBatchWindow:  c.BroadcastBatchWindow,
+		BroadcastBatchMaxMsgs: c.BroadcastBatchMaxMsgs,
+		BroadcastMinGasPrice:  c.BroadcastMinGasPrice,
+
 		cfgLock:         &sync.RWMutex{},
 		Keygen:          c.GetKeygen(),
 		ChainsEnabled:   c.GetEnabledChains(),
 		EVMChainConfigs: make(map[int64]*EVMConfig, len(c.EVMChainConfigs)),
 		BitcoinConfig:   nil,
+		chainInfos:      make([]common.ChainInfo, len(c.chainInfos)),
 	}
+	copy(copied.chainInfos, c.chainInfos)
 	// deep copy evm & btc configs
 	for chainID, evmConfig := range c.EVMChainConfigs {
 		copied.EVMChainConfigs[chainID] = &EVMConfig{}
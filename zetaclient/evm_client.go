@@ -62,7 +62,7 @@ type EVMLog struct {
 }
 
 const (
-	DonationMessage = "I am rich!"
+	DonationMessage = common.DonationMessage
 )
 
 // Chain configuration struct
@@ -80,6 +80,7 @@ type EVMChainClient struct {
 	txWatchList               map[ethcommon.Hash]string
 	mu                        *sync.Mutex
 	db                        *gorm.DB
+	journal                   *SigningJournal
 	outTXConfirmedReceipts    map[string]*ethtypes.Receipt
 	outTXConfirmedTransaction map[string]*ethtypes.Transaction
 	MinNonce                  int64
@@ -199,9 +200,10 @@ func (ob *EVMChainClient) GetERC20CustodyContract() (*erc20custody.ERC20Custody,
 }
 
 func (ob *EVMChainClient) Start() {
-	go ob.ExternalChainWatcher() // Observes external Chains for incoming trasnactions
-	go ob.WatchGasPrice()        // Observes external Chains for Gas prices and posts to core
-	go ob.observeOutTx()         // Populates receipts and confirmed outbound transactions
+	go ob.ExternalChainWatcher()  // Observes external Chains for incoming trasnactions
+	go ob.WatchGasPrice()         // Observes external Chains for Gas prices and posts to core
+	go ob.observeOutTx()          // Populates receipts and confirmed outbound transactions
+	go ob.recoverSigningJournal() // Rebroadcasts the signed outbound transactions not tracked on zetacore
 }
 
 func (ob *EVMChainClient) Stop() {
@@ -237,8 +239,9 @@ func (ob *EVMChainClient) IsSendOutTxProcessed(sendHash string, nonce uint64, co
 	sendID := fmt.Sprintf("%s-%d", ob.chain.String(), nonce)
 	logger = logger.With().Str("sendID", sendID).Logger()
 	if cointype == common.CoinType_Cmd {
+		// a cancel tx sent by the TSS to itself consumes the nonce without running the command
 		recvStatus := common.ReceiveStatus_Failed
-		if receipt.Status == 1 {
+		if receipt.Status == 1 && !IsCancelTx(transaction, ob.Tss.EVMAddress()) {
 			recvStatus = common.ReceiveStatus_Success
 		}
 		zetaHash, err := ob.zetaClient.PostReceiveConfirmation(
@@ -664,6 +667,13 @@ func (ob *EVMChainClient) observeInTX() error {
 	if !crosschainFlags.IsInboundEnabled {
 		return errors.New("inbound TXS / Send has been disabled by the protocol")
 	}
+	chainFlags, err := ob.zetaClient.GetChainCrosschainFlags(ob.chain.ChainId)
+	if err != nil {
+		return err
+	}
+	if !chainFlags.IsInboundEnabled {
+		return fmt.Errorf("inbound TXS / Send has been disabled for chain %s", ob.chain.ChainName)
+	}
 	counter, err := ob.GetPromCounter("rpc_getBlockByNumber_count")
 	if err != nil {
 		ob.logger.ExternalChainWatcher.Error().Err(err).Msg("GetPromCounter:")
@@ -1022,17 +1032,33 @@ func (ob *EVMChainClient) PostGasPrice() error {
 		ob.logger.WatchGasPrice.Err(err).Msg("Err SuggestGasPrice:")
 		return err
 	}
-	blockNum, err := ob.EvmClient.BlockNumber(context.TODO())
+	header, err := ob.EvmClient.HeaderByNumber(context.TODO(), nil)
 	if err != nil {
 		ob.logger.WatchGasPrice.Err(err).Msg("Err Fetching Most recent Block : ")
 		return err
 	}
+	blockNum := header.Number.Uint64()
+
+	// PRIORITY FEE
+	// only observed for chains supporting EIP-1559
+	var priorityFee uint64
+	if header.BaseFee != nil {
+		tipCap, err := ob.EvmClient.SuggestGasTipCap(context.TODO())
+		if err != nil {
+			ob.logger.WatchGasPrice.Err(err).Msg("Err SuggestGasTipCap:")
+			return err
+		}
+		priorityFee = tipCap.Uint64()
+		if priorityFee > gasPrice.Uint64() {
+			priorityFee = gasPrice.Uint64()
+		}
+	}
 
 	// SUPPLY
 	var supply string // lockedAmount on ETH, totalSupply on other chains
 	supply = "100"
 
-	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, gasPrice.Uint64(), supply, blockNum)
+	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, gasPrice.Uint64(), priorityFee, supply, blockNum)
 	if err != nil {
 		ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice to zetacore failed")
 		return err
@@ -1158,6 +1184,10 @@ func (ob *EVMChainClient) LoadDB(dbPath string, chain common.Chain) error {
 		}
 
 		ob.db = db
+		ob.journal, err = NewSigningJournal(db)
+		if err != nil {
+			return err
+		}
 		err = ob.BuildBlockIndex()
 		if err != nil {
 			return err
@@ -1203,6 +1233,30 @@ func (ob *EVMChainClient) SetMinAndMaxNonce(trackers []types.OutTxTracker) error
 	return nil
 }
 
+// GetSigningJournal returns the journal of the signed outTxs, nil if the chain client has no db
+func (ob *EVMChainClient) GetSigningJournal() *SigningJournal {
+	return ob.journal
+}
+
+// recoverSigningJournal rebroadcasts the journaled outTxs signed before the restart and missing in the outTx trackers
+func (ob *EVMChainClient) recoverSigningJournal() {
+	err := RecoverSigningJournal(ob, ob.chain, ob.zetaClient, ob.broadcastRawTx, nil, ob.logger.ObserveOutTx)
+	if err != nil {
+		ob.logger.ObserveOutTx.Error().Err(err).Msg("error recovering signing journal")
+	}
+}
+
+// broadcastRawTx broadcasts a binary encoded transaction
+func (ob *EVMChainClient) broadcastRawTx(rawTx []byte) error {
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(rawTx); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return ob.EvmClient.SendTransaction(ctx, tx)
+}
+
 func (ob *EVMChainClient) GetTxID(nonce uint64) string {
 	tssAddr := ob.Tss.EVMAddress().String()
 	return fmt.Sprintf("%d-%s-%d", ob.chain.ChainId, tssAddr, nonce)
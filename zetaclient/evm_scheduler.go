@@ -0,0 +1,128 @@
+package zetaclient
+
+import (
+	"fmt"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/config"
+)
+
+func init() {
+	MustRegisterChainFamily(ChainFamily{
+		VmFamily: common.VmFamily_evm,
+		Chains: func(cfg *config.Config) []common.Chain {
+			var chains []common.Chain
+			for _, evmConfig := range cfg.GetAllEVMConfigs() {
+				if evmConfig.Chain.IsZetaChain() {
+					continue
+				}
+				chains = append(chains, evmConfig.Chain)
+			}
+			return chains
+		},
+		CoreParams: func(cfg *config.Config, chainID int64) (observertypes.CoreParams, bool) {
+			evmConfig, found := cfg.GetAllEVMConfigs()[chainID]
+			if !found {
+				return observertypes.CoreParams{}, false
+			}
+			return evmConfig.CoreParams, true
+		},
+		NewChainClient: func(chain common.Chain, deps ChainFamilyDeps) (ChainClient, error) {
+			evmConfig, found := deps.Config.GetAllEVMConfigs()[chain.ChainId]
+			if !found {
+				return nil, fmt.Errorf("evm config not found for chain %s", chain.String())
+			}
+			return NewEVMChainClient(deps.Bridge, deps.Tss, deps.DBPath, deps.Metrics, deps.Logger, deps.Config, *evmConfig, deps.Telemetry)
+		},
+		NewChainSigner: func(chain common.Chain, deps ChainFamilyDeps) (ChainSigner, error) {
+			evmConfig, found := deps.Config.GetAllEVMConfigs()[chain.ChainId]
+			if !found {
+				return nil, fmt.Errorf("evm config not found for chain %s", chain.String())
+			}
+			mpiAddress := ethcommon.HexToAddress(evmConfig.CoreParams.ConnectorContractAddress)
+			erc20CustodyAddress := ethcommon.HexToAddress(evmConfig.CoreParams.Erc20CustodyContractAddress)
+			signer, err := NewEVMSigner(chain, evmConfig.Endpoint, deps.Tss, config.GetConnectorABI(), config.GetERC20CustodyABI(), mpiAddress, erc20CustodyAddress, deps.Logger, deps.Telemetry)
+			if err != nil {
+				return nil, errors.Wrap(err, "NewEVMSigner error")
+			}
+			return signer, nil
+		},
+		Scheduler: EVMOutTxScheduler{},
+	})
+}
+
+// EVMOutTxScheduler schedules the keysigns of the pending cctxs of evm chains
+// outTxs of different nonces are signed concurrently, each pending cctx is retried every OutboundTxScheduleInterval blocks
+type EVMOutTxScheduler struct{}
+
+var _ OutTxScheduler = EVMOutTxScheduler{}
+
+func (EVMOutTxScheduler) ScheduleOutTxs(
+	bridge *ZetaCoreBridge,
+	outTxMan *OutTxProcessorManager,
+	cctxList []*types.CrossChainTx,
+	signer ChainSigner,
+	ob ChainClient,
+	trackerMap map[uint64]bool,
+	currentHeight uint64,
+	logger zerolog.Logger,
+) {
+	for idx, cctx := range cctxList {
+		params := cctx.GetCurrentOutTxParam()
+		nonce := params.OutboundTxTssNonce
+		outTxID := fmt.Sprintf("%s-%d-%d", cctx.Index, params.ReceiverChainId, nonce) // would outTxID a better ID?
+
+		// Monitor Core Logger for OutboundTxTssNonce
+		included, _, err := ob.IsSendOutTxProcessed(cctx.Index, params.OutboundTxTssNonce, params.CoinType, logger)
+		if err != nil {
+			logger.Error().Err(err).Msgf("IsSendOutTxProcessed fail, Chain ID: %d", params.ReceiverChainId)
+			continue
+		}
+		if included {
+			logger.Info().Msgf("send outTx already included; do not schedule")
+			continue
+		}
+
+		// #nosec G701 positive
+		interval := uint64(ob.GetCoreParams().OutboundTxScheduleInterval)
+		lookahead := ob.GetCoreParams().OutboundTxScheduleLookahead
+
+		// determining critical outtx; if it satisfies following criteria
+		// 1. it's the first pending outtx for this chain
+		// 2. the following 5 nonces have been in tracker
+		criticalInterval := uint64(10)      // for critical pending outTx we reduce re-try interval
+		nonCriticalInterval := interval * 2 // for non-critical pending outTx we increase re-try interval
+		if nonce%criticalInterval == currentHeight%criticalInterval {
+			count := 0
+			for i := nonce + 1; i <= nonce+10; i++ {
+				if _, found := trackerMap[i]; found {
+					count++
+				}
+			}
+			if count >= 5 {
+				interval = criticalInterval
+			}
+		}
+		// if it's already in tracker, we increase re-try interval
+		if _, ok := trackerMap[nonce]; ok {
+			interval = nonCriticalInterval
+		}
+
+		// otherwise, the normal interval is used
+		if nonce%interval == currentHeight%interval && !outTxMan.IsOutTxActive(outTxID) {
+			outTxMan.StartTryProcess(outTxID)
+			logger.Debug().Msgf("chain %d: Sign outtx %s with value %d\n", params.ReceiverChainId, outTxID, params.Amount)
+			go signer.TryProcessOutTx(cctx, outTxMan, outTxID, ob, bridge, currentHeight)
+		}
+
+		// #nosec G701 always in range
+		if int64(idx) >= lookahead-1 { // only look at 30 sends per chain
+			break
+		}
+	}
+}
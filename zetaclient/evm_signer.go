@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -36,6 +37,7 @@ type EVMSigner struct {
 	erc20CustodyContractAddress ethcommon.Address
 	logger                      zerolog.Logger
 	ts                          *TelemetryServer
+	londonSupported             bool
 }
 
 var _ ChainSigner = &EVMSigner{}
@@ -77,11 +79,45 @@ func NewEVMSigner(chain common.Chain, endpoint string, tssSigner TSSSigner, abiS
 	}, nil
 }
 
+// newTx builds an unsigned tx
+// a dynamic fee tx (EIP-1559) is built if a priority fee is provided, the gas price is then used as the max fee per gas
+// a legacy tx is built otherwise
+func (signer *EVMSigner) newTx(nonce uint64, to ethcommon.Address, amount *big.Int, gasLimit uint64, gasPrice *big.Int, priorityFee *big.Int, data []byte) *ethtypes.Transaction {
+	if priorityFee == nil {
+		return ethtypes.NewTransaction(nonce, to, amount, gasLimit, gasPrice, data)
+	}
+	return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   signer.chainID,
+		Nonce:     nonce,
+		GasTipCap: priorityFee,
+		GasFeeCap: gasPrice,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     amount,
+		Data:      data,
+	})
+}
+
+// isLondonSupported returns true if the chain has activated the London upgrade (EIP-1559)
+// the result is cached once the upgrade is detected
+func (signer *EVMSigner) isLondonSupported() (bool, error) {
+	if signer.londonSupported {
+		return true, nil
+	}
+	header, err := signer.client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return false, err
+	}
+	signer.londonSupported = header.BaseFee != nil
+	return signer.londonSupported, nil
+}
+
 // given data, and metadata (gas, nonce, etc)
 // returns a signed transaction, sig bytes, hash bytes, and error
-func (signer *EVMSigner) Sign(data []byte, to ethcommon.Address, gasLimit uint64, gasPrice *big.Int, nonce uint64, height uint64) (*ethtypes.Transaction, []byte, []byte, error) {
+// a nil priority fee produces a legacy tx
+func (signer *EVMSigner) Sign(data []byte, to ethcommon.Address, gasLimit uint64, gasPrice *big.Int, priorityFee *big.Int, nonce uint64, height uint64) (*ethtypes.Transaction, []byte, []byte, error) {
 	log.Debug().Msgf("TSS SIGNER: %s", signer.tssSigner.Pubkey())
-	tx := ethtypes.NewTransaction(nonce, to, big.NewInt(0), gasLimit, gasPrice, data)
+	tx := signer.newTx(nonce, to, big.NewInt(0), gasLimit, gasPrice, priorityFee, data)
 	hashBytes := signer.ethSigner.Hash(tx).Bytes()
 
 	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
@@ -128,6 +164,7 @@ func (signer *EVMSigner) SignOutboundTx(sender ethcommon.Address,
 	sendHash [32]byte,
 	nonce uint64,
 	gasPrice *big.Int,
+	priorityFee *big.Int,
 	height uint64) (*ethtypes.Transaction, error) {
 
 	if len(sendHash) < 32 {
@@ -141,7 +178,7 @@ func (signer *EVMSigner) SignOutboundTx(sender ethcommon.Address,
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.metaContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err := signer.Sign(data, signer.metaContractAddress, gasLimit, gasPrice, priorityFee, nonce, height)
 	if err != nil {
 		return nil, fmt.Errorf("Sign error: %w", err)
 	}
@@ -158,7 +195,7 @@ func (signer *EVMSigner) SignOutboundTx(sender ethcommon.Address,
 // bytes calldata message,
 // bytes32 internalSendHash
 // ) external override whenNotPaused onlyTssAddress
-func (signer *EVMSigner) SignRevertTx(sender ethcommon.Address, srcChainID *big.Int, to []byte, toChainID *big.Int, amount *big.Int, gasLimit uint64, message []byte, sendHash [32]byte, nonce uint64, gasPrice *big.Int, height uint64) (*ethtypes.Transaction, error) {
+func (signer *EVMSigner) SignRevertTx(sender ethcommon.Address, srcChainID *big.Int, to []byte, toChainID *big.Int, amount *big.Int, gasLimit uint64, message []byte, sendHash [32]byte, nonce uint64, gasPrice *big.Int, priorityFee *big.Int, height uint64) (*ethtypes.Transaction, error) {
 	var data []byte
 	var err error
 
@@ -167,7 +204,7 @@ func (signer *EVMSigner) SignRevertTx(sender ethcommon.Address, srcChainID *big.
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.metaContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err := signer.Sign(data, signer.metaContractAddress, gasLimit, gasPrice, priorityFee, nonce, height)
 	if err != nil {
 		return nil, fmt.Errorf("Sign error: %w", err)
 	}
@@ -175,8 +212,8 @@ func (signer *EVMSigner) SignRevertTx(sender ethcommon.Address, srcChainID *big.
 	return tx, nil
 }
 
-func (signer *EVMSigner) SignCancelTx(nonce uint64, gasPrice *big.Int, height uint64) (*ethtypes.Transaction, error) {
-	tx := ethtypes.NewTransaction(nonce, signer.tssSigner.EVMAddress(), big.NewInt(0), 21000, gasPrice, nil)
+func (signer *EVMSigner) SignCancelTx(nonce uint64, gasPrice *big.Int, priorityFee *big.Int, height uint64) (*ethtypes.Transaction, error) {
+	tx := signer.newTx(nonce, signer.tssSigner.EVMAddress(), big.NewInt(0), 21000, gasPrice, priorityFee, nil)
 	hashBytes := signer.ethSigner.Hash(tx).Bytes()
 	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
 	if err != nil {
@@ -196,8 +233,13 @@ func (signer *EVMSigner) SignCancelTx(nonce uint64, gasPrice *big.Int, height ui
 	return signedTX, nil
 }
 
-func (signer *EVMSigner) SignWithdrawTx(to ethcommon.Address, amount *big.Int, nonce uint64, gasPrice *big.Int, height uint64) (*ethtypes.Transaction, error) {
-	tx := ethtypes.NewTransaction(nonce, to, amount, 21000, gasPrice, nil)
+// IsCancelTx returns true if the tx is a cancel tx, a transfer of 0 from the TSS to itself
+func IsCancelTx(tx *ethtypes.Transaction, tssAddress ethcommon.Address) bool {
+	return tx.To() != nil && *tx.To() == tssAddress && tx.Value().Sign() == 0 && len(tx.Data()) == 0
+}
+
+func (signer *EVMSigner) SignWithdrawTx(to ethcommon.Address, amount *big.Int, nonce uint64, gasPrice *big.Int, priorityFee *big.Int, height uint64) (*ethtypes.Transaction, error) {
+	tx := signer.newTx(nonce, to, amount, 21000, gasPrice, priorityFee, nil)
 	hashBytes := signer.ethSigner.Hash(tx).Bytes()
 	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
 	if err != nil {
@@ -217,7 +259,7 @@ func (signer *EVMSigner) SignWithdrawTx(to ethcommon.Address, amount *big.Int, n
 	return signedTX, nil
 }
 
-func (signer *EVMSigner) SignCommandTx(cmd string, params string, to ethcommon.Address, nonce uint64, gasLimit uint64, gasPrice *big.Int, height uint64) (*ethtypes.Transaction, error) {
+func (signer *EVMSigner) SignCommandTx(cmd string, params string, to ethcommon.Address, nonce uint64, gasLimit uint64, gasPrice *big.Int, priorityFee *big.Int, height uint64) (*ethtypes.Transaction, error) {
 	if cmd == common.CmdWhitelistERC20 {
 		erc20 := ethcommon.HexToAddress(params)
 		if erc20 == (ethcommon.Address{}) {
@@ -231,16 +273,78 @@ func (signer *EVMSigner) SignCommandTx(cmd string, params string, to ethcommon.A
 		if err != nil {
 			return nil, err
 		}
-		tx, _, _, err := signer.Sign(data, to, gasLimit, gasPrice, nonce, height)
+		tx, _, _, err := signer.Sign(data, to, gasLimit, gasPrice, priorityFee, nonce, height)
+		if err != nil {
+			return nil, fmt.Errorf("sign error: %w", err)
+		}
+		return tx, nil
+	}
+	if cmd == common.CmdMigrateERC20CustodyFunds {
+		newTss := ethcommon.HexToAddress(params)
+		if newTss == (ethcommon.Address{}) {
+			return nil, fmt.Errorf("SignCommandTx: invalid tss address %s", params)
+		}
+		// updateTSSAddress reverts unless the TSS address updater role of the custody has been renounced to the TSS,
+		// the nonce is then consumed by a cancel tx observed as a failed command
+		custody, err := erc20custody.NewERC20CustodyCaller(to, signer.client)
+		if err != nil {
+			return nil, err
+		}
+		updater, err := custody.TSSAddressUpdater(&bind.CallOpts{})
+		if err != nil {
+			return nil, fmt.Errorf("cannot get tss address updater of erc20 custody %s: %w", to.Hex(), err)
+		}
+		if updater != signer.tssSigner.EVMAddress() {
+			signer.logger.Error().Msgf("SignCommandTx: tss address updater %s of erc20 custody %s is not the tss, cancelling the update", updater.Hex(), to.Hex())
+			return signer.SignCancelTx(nonce, gasPrice, priorityFee, height)
+		}
+		data, err := signer.erc20CustodyABI.Pack("updateTSSAddress", newTss)
+		if err != nil {
+			return nil, fmt.Errorf("pack error: %w", err)
+		}
+		tx, _, _, err := signer.Sign(data, to, gasLimit, gasPrice, priorityFee, nonce, height)
 		if err != nil {
 			return nil, fmt.Errorf("sign error: %w", err)
 		}
 		return tx, nil
 	}
+	if cmd == common.CmdMigrateTssFunds {
+		newTss := ethcommon.HexToAddress(params)
+		if newTss == (ethcommon.Address{}) || newTss != to {
+			return nil, fmt.Errorf("SignCommandTx: invalid tss address %s", params)
+		}
+		return signer.SignMigrationTx(newTss, nonce, gasPrice, priorityFee, height)
+	}
 
 	return nil, fmt.Errorf("SignCommandTx: unknown command %s", cmd)
 }
 
+// SignMigrationTx signs a tx transferring the whole balance of the TSS, minus the fee of the tx, to the new TSS
+// the balance is only known once all the outbounds with lower nonces are mined, an error is returned until then
+func (signer *EVMSigner) SignMigrationTx(newTss ethcommon.Address, nonce uint64, gasPrice *big.Int, priorityFee *big.Int, height uint64) (*ethtypes.Transaction, error) {
+	tssAddress := signer.tssSigner.EVMAddress()
+	minedNonce, err := signer.client.NonceAt(context.Background(), tssAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get nonce of tss address %s: %w", tssAddress.Hex(), err)
+	}
+	if minedNonce != nonce {
+		return nil, fmt.Errorf("SignMigrationTx: outbounds with lower nonces pending, mined nonce %d, migration nonce %d", minedNonce, nonce)
+	}
+	balance, err := signer.client.BalanceAt(context.Background(), tssAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get balance of tss address %s: %w", tssAddress.Hex(), err)
+	}
+
+	// with a dynamic fee tx the gas price is the max fee per gas, the whole fee must be covered by the balance
+	fee := new(big.Int).Mul(gasPrice, big.NewInt(21000))
+	amount := new(big.Int).Sub(balance, fee)
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("SignMigrationTx: balance %s of tss address %s doesn't cover the fee %s", balance, tssAddress.Hex(), fee)
+	}
+	signer.logger.Info().Msgf("SignMigrationTx: migrating %s to new tss %s, nonce %d", amount, newTss.Hex(), nonce)
+	return signer.SignWithdrawTx(newTss, amount, nonce, gasPrice, priorityFee, height)
+}
+
 func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *OutTxProcessorManager, outTxID string, evmClient ChainClient, zetaBridge *ZetaCoreBridge, height uint64) {
 	logger := signer.logger.With().
 		Str("outTxID", outTxID).
@@ -291,6 +395,19 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 		return
 	}
 
+	// the outTx signed before a restart and missing in the tracker is rebroadcasted instead of signing a new outTx
+	_, recovered := RecoverJournaledOutTx(evmClient, *toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, zetaBridge, func(rawTx []byte) error {
+		tx := new(ethtypes.Transaction)
+		if err := tx.UnmarshalBinary(rawTx); err != nil {
+			return err
+		}
+		return signer.Broadcast(tx)
+	}, logger)
+	if recovered {
+		logger.Info().Msgf("journaled outTx recovered; exit signer")
+		return
+	}
+
 	message, err := base64.StdEncoding.DecodeString(send.RelayedMessage)
 	if err != nil {
 		logger.Err(err).Msgf("decode CCTX.Message %s error", send.RelayedMessage)
@@ -315,43 +432,11 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 	var sendhash [32]byte
 	copy(sendhash[:32], sendHash[:32])
 
-	// use dynamic gas price for ethereum chains
-	var gasprice *big.Int
-
-	// The code below is a fix for https://github.com/zeta-chain/node/issues/1085
-	// doesn't close directly the issue because we should determine if we want to keep using SuggestGasPrice if no OutboundTxGasPrice
-	// we should possibly remove it completely and return an error if no OutboundTxGasPrice is provided because it means no fee is processed on ZetaChain
-	specified, ok := new(big.Int).SetString(send.GetCurrentOutTxParam().OutboundTxGasPrice, 10)
-	if !ok {
-		if common.IsEthereumChain(toChain.ChainId) {
-			suggested, err := signer.client.SuggestGasPrice(context.Background())
-			if err != nil {
-				logger.Error().Err(err).Msgf("cannot get gas price from chain %s ", toChain)
-				return
-			}
-			gasprice = roundUpToNearestGwei(suggested)
-		} else {
-			logger.Error().Err(err).Msgf("cannot convert gas price  %s ", send.GetCurrentOutTxParam().OutboundTxGasPrice)
-			return
-		}
-	} else {
-		gasprice = specified
-	}
-	//if common.IsEthereumChain(toChain.ChainId) {
-	//	suggested, err := signer.client.SuggestGasPrice(context.Background())
-	//	if err != nil {
-	//		logger.Error().Err(err).Msgf("cannot get gas price from chain %s ", toChain)
-	//		return
-	//	}
-	//	gasprice = roundUpToNearestGwei(suggested)
-	//} else {
-	//	specified, ok := new(big.Int).SetString(send.GetCurrentOutTxParam().OutboundTxGasPrice, 10)
-	//	if !ok {
-	//		logger.Error().Err(err).Msgf("cannot convert gas price  %s ", send.GetCurrentOutTxParam().OutboundTxGasPrice)
-	//		return
-	//	}
-	//	gasprice = specified
-	//}
+	gasprice, priorityFee, err := signer.outTxGasFees(send.GetCurrentOutTxParam(), toChain)
+	if err != nil {
+		logger.Error().Err(err).Msgf("cannot get gas fees for chain %s", toChain)
+		return
+	}
 
 	flags, err := zetaBridge.GetCrosschainFlags()
 	if err != nil {
@@ -373,7 +458,7 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 			logger.Error().Msgf("invalid message %s", msg)
 			return
 		}
-		tx, err = signer.SignCommandTx(msg[0], msg[1], to, send.GetCurrentOutTxParam().OutboundTxTssNonce, gasLimit, gasprice, height)
+		tx, err = signer.SignCommandTx(msg[0], msg[1], to, send.GetCurrentOutTxParam().OutboundTxTssNonce, gasLimit, gasprice, priorityFee, height)
 	} else if send.InboundTxParams.SenderChainId == common.ZetaChain().ChainId && send.CctxStatus.Status == types.CctxStatus_PendingOutbound && flags.IsOutboundEnabled {
 		if send.GetCurrentOutTxParam().CoinType == common.CoinType_Gas {
 			logger.Info().Msgf("SignWithdrawTx: %d => %s, nonce %d, gasprice %d", send.InboundTxParams.SenderChainId, toChain, send.GetCurrentOutTxParam().OutboundTxTssNonce, gasprice)
@@ -382,6 +467,7 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 				send.GetCurrentOutTxParam().Amount.BigInt(),
 				send.GetCurrentOutTxParam().OutboundTxTssNonce,
 				gasprice,
+				priorityFee,
 				height,
 			)
 		}
@@ -395,6 +481,7 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 				gasLimit,
 				send.GetCurrentOutTxParam().OutboundTxTssNonce,
 				gasprice,
+				priorityFee,
 				height,
 			)
 		}
@@ -410,6 +497,7 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 				sendhash,
 				send.GetCurrentOutTxParam().OutboundTxTssNonce,
 				gasprice,
+				priorityFee,
 				height,
 			)
 		}
@@ -421,6 +509,7 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 				send.GetCurrentOutTxParam().Amount.BigInt(),
 				send.GetCurrentOutTxParam().OutboundTxTssNonce,
 				gasprice,
+				priorityFee,
 				height,
 			)
 		}
@@ -434,6 +523,7 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 				gasLimit,
 				send.GetCurrentOutTxParam().OutboundTxTssNonce,
 				gasprice,
+				priorityFee,
 				height,
 			)
 		}
@@ -450,6 +540,7 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 			sendhash,
 			send.GetCurrentOutTxParam().OutboundTxTssNonce,
 			gasprice,
+			priorityFee,
 			height,
 		)
 	} else if send.CctxStatus.Status == types.CctxStatus_PendingOutbound {
@@ -464,6 +555,7 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 			sendhash,
 			send.GetCurrentOutTxParam().OutboundTxTssNonce,
 			gasprice,
+			priorityFee,
 			height,
 		)
 	}
@@ -482,6 +574,15 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 	if tx != nil {
 		outTxHash := tx.Hash().Hex()
 		logger.Info().Msgf("on chain %s nonce %d, outTxHash %s signer %s", signer.chain, send.GetCurrentOutTxParam().OutboundTxTssNonce, outTxHash, myid)
+
+		// record the signed outTx before broadcasting it
+		rawTx, err := tx.MarshalBinary()
+		if err == nil {
+			err = evmClient.GetSigningJournal().Record(evmClient.GetTxID(tx.Nonce()), tx.Nonce(), outTxHash, rawTx)
+		}
+		if err != nil {
+			logger.Error().Err(err).Msgf("error recording outTx %s in signing journal", outTxHash)
+		}
 		//if len(signers) == 0 || myid == signers[send.OutboundTxParams.Broadcaster] || myid == signers[int(send.OutboundTxParams.Broadcaster+1)%len(signers)] {
 		backOff := 1000 * time.Millisecond
 		// retry loop: 1s, 2s, 4s, 8s, 16s in case of RPC error
@@ -525,7 +626,7 @@ func (signer *EVMSigner) TryProcessOutTx(send *types.CrossChainTx, outTxMan *Out
 // address asset,
 // uint256 amount,
 // ) external onlyTssAddress
-func (signer *EVMSigner) SignERC20WithdrawTx(recipient ethcommon.Address, asset ethcommon.Address, amount *big.Int, gasLimit uint64, nonce uint64, gasPrice *big.Int, height uint64) (*ethtypes.Transaction, error) {
+func (signer *EVMSigner) SignERC20WithdrawTx(recipient ethcommon.Address, asset ethcommon.Address, amount *big.Int, gasLimit uint64, nonce uint64, gasPrice *big.Int, priorityFee *big.Int, height uint64) (*ethtypes.Transaction, error) {
 	var data []byte
 	var err error
 	data, err = signer.erc20CustodyABI.Pack("withdraw", recipient, asset, amount)
@@ -533,7 +634,7 @@ func (signer *EVMSigner) SignERC20WithdrawTx(recipient ethcommon.Address, asset
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, priorityFee, nonce, height)
 	if err != nil {
 		return nil, fmt.Errorf("sign error: %w", err)
 	}
@@ -547,7 +648,7 @@ func (signer *EVMSigner) SignERC20WithdrawTx(recipient ethcommon.Address, asset
 // function unwhitelist(
 // address asset,
 // ) external onlyTssAddress
-func (signer *EVMSigner) SignWhitelistTx(action string, _ ethcommon.Address, asset ethcommon.Address, gasLimit uint64, nonce uint64, gasPrice *big.Int, height uint64) (*ethtypes.Transaction, error) {
+func (signer *EVMSigner) SignWhitelistTx(action string, _ ethcommon.Address, asset ethcommon.Address, gasLimit uint64, nonce uint64, gasPrice *big.Int, priorityFee *big.Int, height uint64) (*ethtypes.Transaction, error) {
 	var data []byte
 
 	var err error
@@ -557,7 +658,7 @@ func (signer *EVMSigner) SignWhitelistTx(action string, _ ethcommon.Address, ass
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, priorityFee, nonce, height)
 	if err != nil {
 		return nil, fmt.Errorf("Sign error: %w", err)
 	}
@@ -565,6 +666,49 @@ func (signer *EVMSigner) SignWhitelistTx(action string, _ ethcommon.Address, ass
 	return tx, nil
 }
 
+// outTxGasFees returns the gas price and the priority fee to use for the outbound tx
+// the priority fee is nil if the chain doesn't support EIP-1559, a legacy tx is then used
+func (signer *EVMSigner) outTxGasFees(params *types.OutboundTxParams, toChain *common.Chain) (*big.Int, *big.Int, error) {
+	london, err := signer.isLondonSupported()
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot get latest header: %w", err)
+	}
+
+	// The code below is a fix for https://github.com/zeta-chain/node/issues/1085
+	// doesn't close directly the issue because we should determine if we want to keep using SuggestGasPrice if no OutboundTxGasPrice
+	// we should possibly remove it completely and return an error if no OutboundTxGasPrice is provided because it means no fee is processed on ZetaChain
+	gasPrice, ok := new(big.Int).SetString(params.OutboundTxGasPrice, 10)
+	if !ok {
+		if !common.IsEthereumChain(toChain.ChainId) {
+			return nil, nil, fmt.Errorf("cannot convert gas price %s", params.OutboundTxGasPrice)
+		}
+		suggested, err := signer.client.SuggestGasPrice(context.Background())
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot suggest gas price: %w", err)
+		}
+		if !london {
+			return roundUpToNearestGwei(suggested), nil, nil
+		}
+		gasPrice = suggested
+	}
+	if !london {
+		return gasPrice, nil, nil
+	}
+
+	// use the priority fee voted on zetacore, cctxs created before the priority fee was voted don't have one
+	priorityFee, ok := new(big.Int).SetString(params.OutboundTxGasPriorityFee, 10)
+	if !ok || priorityFee.Sign() == 0 {
+		priorityFee, err = signer.client.SuggestGasTipCap(context.Background())
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot suggest priority fee: %w", err)
+		}
+	}
+	if priorityFee.Cmp(gasPrice) > 0 {
+		priorityFee = new(big.Int).Set(gasPrice)
+	}
+	return gasPrice, priorityFee, nil
+}
+
 func roundUpToNearestGwei(gasPrice *big.Int) *big.Int {
 	oneGwei := big.NewInt(1_000_000_000) // 1 Gwei
 	mod := new(big.Int)
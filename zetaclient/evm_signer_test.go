@@ -0,0 +1,46 @@
+package zetaclient
+
+import (
+	"math/big"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEVMSigner_newTx(t *testing.T) {
+	signer := &EVMSigner{chainID: big.NewInt(5)}
+	to := ethcommon.HexToAddress("0x8531a5aB847ff5B22D855633C25ED1DA3255247e")
+
+	t.Run("legacy tx without priority fee", func(t *testing.T) {
+		tx := signer.newTx(1, to, big.NewInt(100), 21000, big.NewInt(20), nil, nil)
+		require.Equal(t, uint8(ethtypes.LegacyTxType), tx.Type())
+		require.Equal(t, big.NewInt(20), tx.GasPrice())
+	})
+
+	t.Run("dynamic fee tx with priority fee", func(t *testing.T) {
+		tx := signer.newTx(1, to, big.NewInt(100), 21000, big.NewInt(20), big.NewInt(2), []byte{0x01})
+		require.Equal(t, uint8(ethtypes.DynamicFeeTxType), tx.Type())
+		require.Equal(t, big.NewInt(20), tx.GasFeeCap())
+		require.Equal(t, big.NewInt(2), tx.GasTipCap())
+		require.Equal(t, big.NewInt(5), tx.ChainId())
+		require.Equal(t, uint64(21000), tx.Gas())
+		require.Equal(t, to, *tx.To())
+		require.Equal(t, big.NewInt(100), tx.Value())
+
+		// can be signed by the latest signer for the chain
+		hash := ethtypes.LatestSignerForChainID(signer.chainID).Hash(tx)
+		require.NotEqual(t, ethcommon.Hash{}, hash)
+	})
+}
+
+func TestIsCancelTx(t *testing.T) {
+	signer := &EVMSigner{chainID: big.NewInt(5)}
+	tss := ethcommon.HexToAddress("0x8531a5aB847ff5B22D855633C25ED1DA3255247e")
+	custody := ethcommon.HexToAddress("0x7c125C1d515b8945841b3d5144a060115C58725F")
+
+	require.True(t, IsCancelTx(signer.newTx(1, tss, big.NewInt(0), 21000, big.NewInt(20), nil, nil), tss))
+	require.False(t, IsCancelTx(signer.newTx(1, custody, big.NewInt(0), 21000, big.NewInt(20), nil, []byte{0x01}), tss))
+	require.False(t, IsCancelTx(signer.newTx(1, tss, big.NewInt(100), 21000, big.NewInt(20), nil, nil), tss))
+}
@@ -21,13 +21,18 @@ const (
 	//GAUGE_PENDING_TX MetricName = iota
 	//
 	//COUNTER_NUM_RPCS
-	PendingTxs = "pending_txs"
+	PendingTxs        = "pending_txs"
+	UTXOCount         = "utxo_count"
+	UTXOFragmentation = "utxo_fragmentation"
+	BroadcastFee      = "zetaclient_broadcast_fee_azeta"
 )
 
 var (
 	Counters = map[string]prometheus.Counter{}
 
 	Gauges = map[string]prometheus.Gauge{}
+
+	CounterVecs = map[string]*prometheus.CounterVec{}
 )
 
 func NewMetrics() (*Metrics, error) {
@@ -81,6 +86,19 @@ func (m *Metrics) RegisterGauge(name string, help string) error {
 	return nil
 }
 
+func (m *Metrics) RegisterCounterVec(name string, help string, labels []string) error {
+	if _, found := CounterVecs[name]; found {
+		return fmt.Errorf("counter vec %s already registered", name)
+	}
+	counterVec := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: name,
+		Help: help,
+	}, labels)
+	prometheus.MustRegister(counterVec)
+	CounterVecs[name] = counterVec
+	return nil
+}
+
 func (m *Metrics) Start() {
 	log.Info().Msg("metrics server starting")
 	go func() {
@@ -34,3 +34,11 @@ func (ms *MetricsSuite) TestMetrics(c *C) {
 	//out, err := ioutil.ReadAll(res.Body)
 	//fmt.Println(string(out))
 }
+
+func (ms *MetricsSuite) TestCounterVec(c *C) {
+	err := ms.m.RegisterCounterVec("cntvec1", "help to cntvec1", []string{"label"})
+	c.Assert(err, IsNil)
+	CounterVecs["cntvec1"].WithLabelValues("value").Add(2)
+	err = ms.m.RegisterCounterVec("cntvec1", "help to cntvec1", []string{"label"})
+	c.Assert(err, NotNil)
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/zeta-chain/node/common"
 	"github.com/zeta-chain/node/x/crosschain/types"
@@ -34,6 +38,16 @@ func (b *ZetaCoreBridge) GetCrosschainFlags() (zetaObserverTypes.CrosschainFlags
 	return resp.CrosschainFlags, nil
 }
 
+// GetChainCrosschainFlags returns the crosschain flags of a chain, the flags are enabled unless the chain is paused
+func (b *ZetaCoreBridge) GetChainCrosschainFlags(chainID int64) (zetaObserverTypes.ChainCrosschainFlags, error) {
+	client := zetaObserverTypes.NewQueryClient(b.grpcConn)
+	resp, err := client.ChainCrosschainFlags(context.Background(), &zetaObserverTypes.QueryGetChainCrosschainFlagsRequest{ChainId: chainID})
+	if err != nil {
+		return zetaObserverTypes.ChainCrosschainFlags{}, err
+	}
+	return resp.ChainCrosschainFlags, nil
+}
+
 func (b *ZetaCoreBridge) GetCoreParamsForChainID(externalChainID int64) (*zetaObserverTypes.CoreParams, error) {
 	client := zetaObserverTypes.NewQueryClient(b.grpcConn)
 	resp, err := client.GetCoreParamsForChain(context.Background(), &zetaObserverTypes.QueryGetCoreParamsForChainRequest{ChainId: externalChainID})
@@ -196,6 +210,43 @@ func (b *ZetaCoreBridge) GetZetaBlockHeight() (int64, error) {
 	return resp.Height, nil
 }
 
+// GetNodeMinGasPrice returns the minimum gas price in azeta the zetacore node accepts in its mempool
+func (b *ZetaCoreBridge) GetNodeMinGasPrice() (sdk.Dec, error) {
+	client := node.NewServiceClient(b.grpcConn)
+	resp, err := client.Config(context.Background(), &node.ConfigRequest{})
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	minGasPrices, err := sdk.ParseDecCoins(resp.MinimumGasPrice)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return minGasPrices.AmountOf(common.ZETADenom), nil
+}
+
+// GetFeeMarketParams returns the params of the feemarket module, MinGasPrice is the global minimum gas price
+func (b *ZetaCoreBridge) GetFeeMarketParams() (feemarkettypes.Params, error) {
+	client := feemarkettypes.NewQueryClient(b.grpcConn)
+	resp, err := client.Params(context.Background(), &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return feemarkettypes.Params{}, err
+	}
+	return resp.Params, nil
+}
+
+// GetBaseFee returns the base fee of the feemarket module, zero if the base fee is disabled
+func (b *ZetaCoreBridge) GetBaseFee() (sdk.Int, error) {
+	client := feemarkettypes.NewQueryClient(b.grpcConn)
+	resp, err := client.BaseFee(context.Background(), &feemarkettypes.QueryBaseFeeRequest{})
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if resp.BaseFee == nil {
+		return sdk.ZeroInt(), nil
+	}
+	return *resp.BaseFee, nil
+}
+
 func (b *ZetaCoreBridge) GetNonceByChain(chain common.Chain) (*types.ChainNonces, error) {
 	client := types.NewQueryClient(b.grpcConn)
 	resp, err := client.ChainNonces(context.Background(), &types.QueryGetChainNoncesRequest{Index: chain.ChainName.String()})
@@ -306,6 +357,20 @@ func (b *ZetaCoreBridge) GetPendingNoncesByChain(chainID int64) (types.PendingNo
 	return resp.PendingNonces, nil
 }
 
+// GetMedianGasPrice returns the median of the gas prices posted by the observers for the chain
+func (b *ZetaCoreBridge) GetMedianGasPrice(chainID int64) (uint64, error) {
+	client := types.NewQueryClient(b.grpcConn)
+	resp, err := client.GasPrice(context.Background(), &types.QueryGetGasPriceRequest{Index: strconv.FormatInt(chainID, 10)})
+	if err != nil {
+		return 0, err
+	}
+	gasPrice := resp.GasPrice
+	if gasPrice == nil || gasPrice.MedianIndex >= uint64(len(gasPrice.Prices)) {
+		return 0, fmt.Errorf("invalid gas price for chain %d", chainID)
+	}
+	return gasPrice.Prices[gasPrice.MedianIndex], nil
+}
+
 func (b *ZetaCoreBridge) GetSupportedChains() ([]*common.Chain, error) {
 	client := zetaObserverTypes.NewQueryClient(b.grpcConn)
 	resp, err := client.SupportedChains(context.Background(), &zetaObserverTypes.QuerySupportedChains{})
@@ -315,6 +380,15 @@ func (b *ZetaCoreBridge) GetSupportedChains() ([]*common.Chain, error) {
 	return resp.GetChains(), nil
 }
 
+func (b *ZetaCoreBridge) GetChainInfoList() ([]common.ChainInfo, error) {
+	client := zetaObserverTypes.NewQueryClient(b.grpcConn)
+	resp, err := client.ChainInfoAll(context.Background(), &zetaObserverTypes.QueryAllChainInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetChainInfo(), nil
+}
+
 func (b *ZetaCoreBridge) GetPendingNonces() (*types.QueryAllPendingNoncesResponse, error) {
 	client := types.NewQueryClient(b.grpcConn)
 	resp, err := client.PendingNoncesAll(context.Background(), &types.QueryAllPendingNoncesRequest{})
@@ -0,0 +1,161 @@
+package zetaclient
+
+import (
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/node/common"
+	clienttypes "github.com/zeta-chain/node/zetaclient/types"
+	"gorm.io/gorm"
+)
+
+// SigningJournal is the local journal of the outTxs signed by the TSS
+// An outTx is recorded right after its keysign and before its broadcast, so a signed outTx is not lost if zetaclientd
+// stops before the outTx is added to the outTx tracker on zetacore. A nil journal records nothing.
+type SigningJournal struct {
+	db *gorm.DB
+}
+
+// JournaledTxBroadcaster broadcasts the raw tx of a signing journal entry
+type JournaledTxBroadcaster func(rawTx []byte) error
+
+// NewSigningJournal creates the signing journal in the chain client db
+func NewSigningJournal(db *gorm.DB) (*SigningJournal, error) {
+	if err := db.AutoMigrate(&clienttypes.SignedOutTxSQLType{}); err != nil {
+		return nil, err
+	}
+	return &SigningJournal{db: db}, nil
+}
+
+// Record records the outTx signed for a nonce, it replaces the outTx previously signed for the same key
+func (j *SigningJournal) Record(key string, nonce uint64, hash string, rawTx []byte) error {
+	if j == nil {
+		return nil
+	}
+	entry := clienttypes.ToSignedOutTxSQLType(key, nonce, hash, rawTx)
+	return j.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where(&clienttypes.SignedOutTxSQLType{Key: key}).Delete(&clienttypes.SignedOutTxSQLType{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&entry).Error
+	})
+}
+
+// Get returns the outTx signed for the key
+func (j *SigningJournal) Get(key string) (clienttypes.SignedOutTxSQLType, bool) {
+	var entry clienttypes.SignedOutTxSQLType
+	if j == nil {
+		return entry, false
+	}
+	if err := j.db.Where(&clienttypes.SignedOutTxSQLType{Key: key}).First(&entry).Error; err != nil {
+		return entry, false
+	}
+	return entry, true
+}
+
+// List returns all the outTxs of the journal sorted by nonce
+func (j *SigningJournal) List() ([]clienttypes.SignedOutTxSQLType, error) {
+	var entries []clienttypes.SignedOutTxSQLType
+	if j == nil {
+		return entries, nil
+	}
+	err := j.db.Order("nonce").Find(&entries).Error
+	return entries, err
+}
+
+// Prune removes the outTxs of the nonces lower than nonce, these outTxs are processed
+func (j *SigningJournal) Prune(nonce uint64) error {
+	if j == nil {
+		return nil
+	}
+	return j.db.Unscoped().Where("nonce < ?", nonce).Delete(&clienttypes.SignedOutTxSQLType{}).Error
+}
+
+// RecoverJournaledOutTx rebroadcasts the journaled outTx of the nonce if it's missing in the outTx tracker and adds
+// it to the tracker. It returns the journaled outTx, nil if none, and whether the outTx is recovered. A new keysign
+// for the nonce is not needed if the outTx is recovered, the outTx is not recovered if it can't be added to the tracker.
+func RecoverJournaledOutTx(
+	ob ChainClient,
+	chain common.Chain,
+	nonce uint64,
+	bridge *ZetaCoreBridge,
+	broadcast JournaledTxBroadcaster,
+	logger zerolog.Logger,
+) (*clienttypes.SignedOutTxSQLType, bool) {
+	entry, found := ob.GetSigningJournal().Get(ob.GetTxID(nonce))
+	if !found {
+		return nil, false
+	}
+	return &entry, recoverSignedOutTx(entry, chain, bridge, broadcast, logger)
+}
+
+// RecoverSigningJournal prunes the journaled outTxs of the processed nonces and recovers the other journaled outTxs
+// onJournaled is called with the hash and the nonce of each journaled outTx of the current TSS
+func RecoverSigningJournal(
+	ob ChainClient,
+	chain common.Chain,
+	bridge *ZetaCoreBridge,
+	broadcast JournaledTxBroadcaster,
+	onJournaled func(hash string, nonce uint64),
+	logger zerolog.Logger,
+) error {
+	journal := ob.GetSigningJournal()
+	if journal == nil {
+		return nil
+	}
+	pendingNonces, err := bridge.GetPendingNoncesByChain(chain.ChainId)
+	if err != nil {
+		return errors.Wrap(err, "RecoverSigningJournal: error getting pending nonces")
+	}
+	// #nosec G701 always non-negative
+	if err := journal.Prune(uint64(pendingNonces.NonceLow)); err != nil {
+		return errors.Wrap(err, "RecoverSigningJournal: error pruning signing journal")
+	}
+	entries, err := journal.List()
+	if err != nil {
+		return errors.Wrap(err, "RecoverSigningJournal: error listing signing journal")
+	}
+	for _, entry := range entries {
+		// the journal entries of a previous TSS are not recovered
+		if entry.Key != ob.GetTxID(entry.Nonce) {
+			continue
+		}
+		recoverSignedOutTx(entry, chain, bridge, broadcast, logger)
+		if onJournaled != nil {
+			onJournaled(entry.Hash, entry.Nonce)
+		}
+	}
+	return nil
+}
+
+// recoverSignedOutTx rebroadcasts a journaled outTx and adds it to the outTx tracker if the tracker of its nonce
+// doesn't contain its hash, it returns true once the outTx has been added to the tracker
+// it returns false if the outTx is already tracked or can't be added to the tracker
+func recoverSignedOutTx(
+	entry clienttypes.SignedOutTxSQLType,
+	chain common.Chain,
+	bridge *ZetaCoreBridge,
+	broadcast JournaledTxBroadcaster,
+	logger zerolog.Logger,
+) bool {
+	tracker, err := bridge.GetOutTxTracker(chain, entry.Nonce)
+	if err == nil {
+		for _, hash := range tracker.HashList {
+			if hash.TxHash == entry.Hash {
+				return false
+			}
+		}
+	}
+
+	// the outTx might have been broadcasted before zetaclientd stopped, the broadcast error is only logged
+	logger.Info().Msgf("recovering journaled outTx %s: chain %d nonce %d", entry.Hash, chain.ChainId, entry.Nonce)
+	if err := broadcast(entry.RawTx); err != nil {
+		logger.Warn().Err(err).Msgf("error broadcasting journaled outTx %s: chain %d nonce %d", entry.Hash, chain.ChainId, entry.Nonce)
+	}
+	zetaHash, err := bridge.AddTxHashToOutTxTracker(chain.ChainId, entry.Nonce, entry.Hash, nil, "", -1)
+	if err != nil {
+		logger.Err(err).Msgf("Unable to add journaled outTx to tracker on ZetaCore: nonce %d chain %d outTxHash %s", entry.Nonce, chain.ChainId, entry.Hash)
+		return false
+	}
+	logger.Info().Msgf("journaled outTx added to tracker %s", zetaHash)
+	return true
+}
@@ -0,0 +1,63 @@
+package zetaclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestSigningJournal(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file:signing_journal?mode=memory&cache=shared"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	journal, err := NewSigningJournal(db)
+	require.NoError(t, err)
+
+	t.Run("record and get signed outTxs", func(t *testing.T) {
+		require.NoError(t, journal.Record("5-tss-1", 1, "hash1", []byte{1}))
+		require.NoError(t, journal.Record("5-tss-2", 2, "hash2", []byte{2}))
+
+		entry, found := journal.Get("5-tss-1")
+		require.True(t, found)
+		require.Equal(t, uint64(1), entry.Nonce)
+		require.Equal(t, "hash1", entry.Hash)
+		require.Equal(t, []byte{1}, entry.RawTx)
+
+		_, found = journal.Get("5-tss-3")
+		require.False(t, found)
+	})
+
+	t.Run("a new outTx replaces the outTx of the same key", func(t *testing.T) {
+		require.NoError(t, journal.Record("5-tss-2", 2, "hash2-replacement", []byte{2, 2}))
+		entry, found := journal.Get("5-tss-2")
+		require.True(t, found)
+		require.Equal(t, "hash2-replacement", entry.Hash)
+
+		entries, err := journal.List()
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, uint64(1), entries[0].Nonce)
+		require.Equal(t, uint64(2), entries[1].Nonce)
+	})
+
+	t.Run("prune the outTxs of processed nonces", func(t *testing.T) {
+		require.NoError(t, journal.Prune(2))
+		entries, err := journal.List()
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "5-tss-2", entries[0].Key)
+	})
+
+	t.Run("nil journal records nothing", func(t *testing.T) {
+		var nilJournal *SigningJournal
+		require.NoError(t, nilJournal.Record("5-tss-1", 1, "hash1", []byte{1}))
+		_, found := nilJournal.Get("5-tss-1")
+		require.False(t, found)
+		entries, err := nilJournal.List()
+		require.NoError(t, err)
+		require.Empty(t, entries)
+		require.NoError(t, nilJournal.Prune(10))
+	})
+}
@@ -2,9 +2,16 @@ package zetaclient
 
 import (
 	"github.com/zeta-chain/node/common"
+	"github.com/zeta-chain/node/zetaclient/config"
 )
 
-// Modify to update this from the core later
-func GetSupportedChains() []*common.Chain {
-	return common.DefaultChainsList()
+// GetSupportedChains returns the chains of the chain registry, the registry is updated from zetacore
+// in UpdateConfigFromCore
+func GetSupportedChains(cfg *config.Config) []*common.Chain {
+	infos := cfg.GetChainInfoList()
+	chains := make([]*common.Chain, len(infos))
+	for i := range infos {
+		chains[i] = &infos[i].Chain
+	}
+	return chains
 }
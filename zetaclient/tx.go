@@ -22,7 +22,7 @@ const (
 	PostNonceGasLimit               = 200_000
 	PostSendEVMGasLimit             = 1_500_000 // likely emit a lot of logs, so costly
 	PostSendNonEVMGasLimit          = 1_000_000
-	PostReceiveConfirmationGasLimit = 200_000
+	PostReceiveConfirmationGasLimit = 400_000 // the finalizing vote indexes the cctx and funds the gas stability pool, tested in x/crosschain/keeper
 	PostBlameDataGasLimit           = 200_000
 	DefaultGasLimit                 = 200_000
 	PostProveOutboundTxGasLimit     = 400_000
@@ -38,13 +38,13 @@ func (b *ZetaCoreBridge) WrapMessageWithAuthz(msg sdk.Msg) (sdk.Msg, AuthZSigner
 	return &authzMessage, authzSigner
 }
 
-func (b *ZetaCoreBridge) PostGasPrice(chain common.Chain, gasPrice uint64, supply string, blockNum uint64) (string, error) {
+func (b *ZetaCoreBridge) PostGasPrice(chain common.Chain, gasPrice uint64, priorityFee uint64, supply string, blockNum uint64) (string, error) {
 	signerAddress := b.keys.GetOperatorAddress().String()
-	msg := types.NewMsgGasPriceVoter(signerAddress, chain.ChainId, gasPrice, supply, blockNum)
+	msg := types.NewMsgGasPriceVoter(signerAddress, chain.ChainId, gasPrice, priorityFee, supply, blockNum)
 	authzMsg, authzSigner := b.WrapMessageWithAuthz(msg)
 
 	for i := 0; i < DefaultRetryCount; i++ {
-		zetaTxHash, err := b.Broadcast(PostGasPriceGasLimit, authzMsg, authzSigner)
+		zetaTxHash, err := b.BroadcastVote(PostGasPriceGasLimit, authzMsg, authzSigner)
 		if err == nil {
 			return zetaTxHash, nil
 		}
@@ -72,7 +72,7 @@ func (b *ZetaCoreBridge) PostSend(sender string, senderChain int64, txOrigin str
 	authzMsg, authzSigner := b.WrapMessageWithAuthz(msg)
 
 	for i := 0; i < DefaultRetryCount; i++ {
-		zetaTxHash, err := b.Broadcast(zetaGasLimit, authzMsg, authzSigner)
+		zetaTxHash, err := b.BroadcastVote(zetaGasLimit, authzMsg, authzSigner)
 		if err == nil {
 			return zetaTxHash, nil
 		}
@@ -124,7 +124,7 @@ func (b *ZetaCoreBridge) PostReceiveConfirmation(
 		gasLimit = PostSendEVMGasLimit
 	}
 	for i := 0; i < DefaultRetryCount; i++ {
-		zetaTxHash, err := b.Broadcast(gasLimit, authzMsg, authzSigner)
+		zetaTxHash, err := b.BroadcastVote(gasLimit, authzMsg, authzSigner)
 		if err == nil {
 			b.lastOutTxReportTime[outTxHash] = time.Now() // update last report time when bcast succeeds
 			return zetaTxHash, nil
@@ -201,7 +201,7 @@ func (b *ZetaCoreBridge) PostAddBlockHeader(chainID int64, blockHash []byte, hei
 
 	var gasLimit uint64 = DefaultGasLimit
 	for i := 0; i < DefaultRetryCount; i++ {
-		zetaTxHash, err := b.Broadcast(gasLimit, authzMsg, authzSigner)
+		zetaTxHash, err := b.BroadcastVote(gasLimit, authzMsg, authzSigner)
 		if err == nil {
 			return zetaTxHash, nil
 		}
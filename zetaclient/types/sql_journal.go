@@ -0,0 +1,21 @@
+package types
+
+import "gorm.io/gorm"
+
+// SignedOutTxSQLType is an entry of the signing journal, the raw outTx signed by the TSS for a nonce
+type SignedOutTxSQLType struct {
+	gorm.Model
+	Key   string `gorm:"uniqueIndex"` // chain-tss-nonce
+	Nonce uint64
+	Hash  string
+	RawTx []byte
+}
+
+func ToSignedOutTxSQLType(key string, nonce uint64, hash string, rawTx []byte) SignedOutTxSQLType {
+	return SignedOutTxSQLType{
+		Key:   key,
+		Nonce: nonce,
+		Hash:  hash,
+		RawTx: rawTx,
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"sync"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 
 	//"fmt"
@@ -18,6 +19,7 @@ import (
 	//"github.com/armon/go-metrics"
 	//"github.com/cosmos/cosmos-sdk/Client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	//"github.com/cosmos/cosmos-sdk/std"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -55,6 +57,11 @@ type ZetaCoreBridge struct {
 	zetaChainID   string
 	//ChainNonces         map[string]uint64 // FIXME: Remove this?
 	lastOutTxReportTime map[string]time.Time
+	batcher             *BatchBroadcaster // nil if the votes are broadcasted one by one
+	gasPrice            sdk.Dec           // gas price of the txs broadcasted to zetacore, refreshed at every zeta block
+	gasPriceHeight      int64             // zeta block height of the last gas price refresh
+	minGasPrice         sdk.Dec           // minimum gas price of the txs broadcasted to zetacore
+	feeMetric           *prometheus.CounterVec
 	stop                chan struct{}
 
 	pause chan struct{}
@@ -99,6 +106,8 @@ func NewZetaCoreBridge(k *Keys, chainIP string, signerName string, chainID strin
 		encodingCfg:         app.MakeEncodingConfig(),
 		keys:                k,
 		broadcastLock:       &sync.RWMutex{},
+		gasPrice:            sdk.ZeroDec(),
+		minGasPrice:         sdk.ZeroDec(),
 		lastOutTxReportTime: map[string]time.Time{},
 		stop:                make(chan struct{}),
 		zetaChainID:         chainID,
@@ -125,6 +134,18 @@ func (b *ZetaCoreBridge) UpdateChainID(chainID string) {
 func (b *ZetaCoreBridge) Stop() {
 	b.logger.Info().Msgf("ZetaBridge is stopping")
 	close(b.stop) // this notifies all configupdater to stop
+	if b.batcher != nil {
+		b.batcher.Stop()
+	}
+}
+
+// EnableBatchBroadcast broadcasts the votes posted to zetacore in batches, a batch is broadcasted when the window since
+// its first vote is elapsed or when it reaches maxMsgs votes
+// it must be called before starting the goroutines using the bridge, the batcher is not guarded by a lock
+func (b *ZetaCoreBridge) EnableBatchBroadcast(window time.Duration, maxMsgs int) {
+	b.batcher = NewBatchBroadcaster(b.BroadcastBatch, b.ConfirmBatch, window, maxMsgs, b.logger)
+	go b.batcher.Start()
+	b.logger.Info().Msgf("batch broadcast enabled: window %s, max %d messages", window, maxMsgs)
 }
 
 // GetAccountNumberAndSequenceNumber We do not use multiple KeyType for now , but this can be optionally used in the future to seprate TSS signer from Zetaclient GRantee
@@ -182,6 +203,13 @@ func (b *ZetaCoreBridge) UpdateConfigFromCore(cfg *config.Config, init bool) err
 		b.pause <- struct{}{} // notify CoreObserver to stop ChainClients, Signers, and CoreObservder itself
 	}
 
+	// update the chain registry before the core params so chains added by governance are recognized
+	chainInfoList, err := b.GetChainInfoList()
+	if err != nil {
+		return err
+	}
+	cfg.SetChainInfoList(chainInfoList)
+
 	coreParams, err := b.GetCoreParams()
 	if err != nil {
 		return err
@@ -221,6 +249,11 @@ func (b *ZetaCoreBridge) UpdateConfigFromCore(cfg *config.Config, init bool) err
 	if err != nil {
 		b.logger.Debug().Err(err).Msg("Unable to fetch TSS from zetacore")
 	} else {
+		// the TSS signing the outTxs is loaded at startup, the zetaclient is restarted to sign with the new TSS
+		if !init && cfg.CurrentTssPubkey != "" && cfg.CurrentTssPubkey != tss.GetTssPubkey() {
+			b.logger.Warn().Msgf("Current TSS updated from %s to %s; ZetaClient is stopped; please restart zetaclientd to sign with the new TSS", cfg.CurrentTssPubkey, tss.GetTssPubkey())
+			b.pause <- struct{}{} // notify CoreObserver to stop ChainClients, Signers, and CoreObservder itself
+		}
 		cfg.CurrentTssPubkey = tss.GetTssPubkey()
 	}
 	return nil
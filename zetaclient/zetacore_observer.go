@@ -121,12 +121,21 @@ func (co *CoreObserver) startSendScheduler() {
 					}
 					//logger.Info().Dur("elapsed", time.Since(tStart)).Msgf("GetAllPendingCctx %d", len(sendList))
 
-					supportedChains := GetSupportedChains()
+					supportedChains := GetSupportedChains(co.cfg)
 					for _, c := range supportedChains {
 						if c == nil || c.ChainId == common.ZetaChain().ChainId {
 							continue
 						}
-						signer := co.signerMap[*c]
+						family, found := GetChainFamilyByChainID(co.cfg, c.ChainId)
+						if !found {
+							co.logger.ZetaChainWatcher.Error().Msgf("no chain family registered for chain %s", c.ChainName)
+							continue
+						}
+						signer, found := co.signerMap[*c]
+						if !found {
+							co.logger.ZetaChainWatcher.Error().Msgf("signer not found for chain %s", c.ChainName)
+							continue
+						}
 
 						cctxList, err := co.bridge.GetAllPendingCctx(c.ChainId)
 						if err != nil {
@@ -138,11 +147,6 @@ func (co *CoreObserver) startSendScheduler() {
 							co.logger.ZetaChainWatcher.Error().Err(err).Msgf("getTargetChainOb fail, Chain ID: %s", c.ChainName)
 							continue
 						}
-						chain, err := common.GetChainNameFromChainID(c.ChainId)
-						if err != nil {
-							co.logger.ZetaChainWatcher.Error().Err(err).Msgf("GetTargetChain fail, Chain ID: %s", c.ChainName)
-							continue
-						}
 						res, err := co.bridge.GetAllOutTxTrackerByChain(*c, Ascending)
 						if err != nil {
 							co.logger.ZetaChainWatcher.Warn().Err(err).Msgf("failed to GetAllOutTxTrackerByChain for chain %s", c.ChainName.String())
@@ -160,79 +164,35 @@ func (co *CoreObserver) startSendScheduler() {
 						}
 						gauge.Set(float64(len(cctxList)))
 
-						for idx, cctx := range cctxList {
+						// the outbounds of a paused chain are not scheduled, they remain pending until the chain is resumed
+						chainFlags, err := co.bridge.GetChainCrosschainFlags(c.ChainId)
+						if err != nil {
+							co.logger.ZetaChainWatcher.Error().Err(err).Msgf("failed to GetChainCrosschainFlags for chain %s", c.ChainName)
+							continue
+						}
+						if !chainFlags.IsOutboundEnabled {
+							co.logger.ZetaChainWatcher.Debug().Msgf("outbound is disabled for chain %s", c.ChainName)
+							continue
+						}
+
+						scheduled := make([]*types.CrossChainTx, 0, len(cctxList))
+						for _, cctx := range cctxList {
 							params := cctx.GetCurrentOutTxParam()
 							if params.ReceiverChainId != c.ChainId {
 								co.logger.ZetaChainWatcher.Error().Msgf("mismatch chainid: want %d, got %d", c.ChainId, params.ReceiverChainId)
 								continue
 							}
-							// #nosec G701 range is verified
-							currentHeight := uint64(bn)
-							nonce := params.OutboundTxTssNonce
-							outTxID := fmt.Sprintf("%s-%d-%d", cctx.Index, params.ReceiverChainId, nonce) // would outTxID a better ID?
-
-							// Process Bitcoin OutTx
-							if common.IsBitcoinChain(c.ChainId) {
-								if outTxMan.IsOutTxActive(outTxID) {
-									// bitcoun outTx is processed sequencially by nonce
-									// if the current outTx is being processed, there is no need to process outTx with future nonces
-									break
-								}
-								// #nosec G701 positive
-								if stop := co.processBitcoinOutTx(outTxMan, uint64(idx), cctx, signer, ob, currentHeight); stop {
-									break
-								}
-								continue
-							}
-
-							// Monitor Core Logger for OutboundTxTssNonce
-							included, _, err := ob.IsSendOutTxProcessed(cctx.Index, params.OutboundTxTssNonce, params.CoinType, co.logger.ZetaChainWatcher)
+							scheduled = append(scheduled, cctx)
+						}
+						// the journaled outTxs of the nonces lower than the first pending nonce are processed
+						if bn%10 == 0 && len(scheduled) > 0 {
+							err := ob.GetSigningJournal().Prune(scheduled[0].GetCurrentOutTxParam().OutboundTxTssNonce)
 							if err != nil {
-								co.logger.ZetaChainWatcher.Error().Err(err).Msgf("IsSendOutTxProcessed fail, Chain ID: %s", c.ChainName)
-								continue
-							}
-							if included {
-								co.logger.ZetaChainWatcher.Info().Msgf("send outTx already included; do not schedule")
-								continue
-							}
-
-							// #nosec G701 positive
-							interval := uint64(ob.GetCoreParams().OutboundTxScheduleInterval)
-							lookahead := ob.GetCoreParams().OutboundTxScheduleLookahead
-
-							// determining critical outtx; if it satisfies following criteria
-							// 1. it's the first pending outtx for this chain
-							// 2. the following 5 nonces have been in tracker
-							criticalInterval := uint64(10)      // for critical pending outTx we reduce re-try interval
-							nonCriticalInterval := interval * 2 // for non-critical pending outTx we increase re-try interval
-							if nonce%criticalInterval == currentHeight%criticalInterval {
-								count := 0
-								for i := nonce + 1; i <= nonce+10; i++ {
-									if _, found := trackerMap[i]; found {
-										count++
-									}
-								}
-								if count >= 5 {
-									interval = criticalInterval
-								}
-							}
-							// if it's already in tracker, we increase re-try interval
-							if _, ok := trackerMap[nonce]; ok {
-								interval = nonCriticalInterval
-							}
-
-							// otherwise, the normal interval is used
-							if nonce%interval == currentHeight%interval && !outTxMan.IsOutTxActive(outTxID) {
-								outTxMan.StartTryProcess(outTxID)
-								co.logger.ZetaChainWatcher.Debug().Msgf("chain %s: Sign outtx %s with value %d\n", chain, outTxID, cctx.GetCurrentOutTxParam().Amount)
-								go signer.TryProcessOutTx(cctx, outTxMan, outTxID, ob, co.bridge, currentHeight)
-							}
-
-							// #nosec G701 always in range
-							if int64(idx) >= lookahead-1 { // only look at 30 sends per chain
-								break
+								co.logger.ZetaChainWatcher.Error().Err(err).Msgf("failed to prune signing journal for chain %s", c.ChainName)
 							}
 						}
+						// #nosec G701 range is verified
+						family.Scheduler.ScheduleOutTxs(co.bridge, outTxMan, scheduled, signer, ob, trackerMap, uint64(bn), co.logger.ZetaChainWatcher)
 					}
 					// update last processed block number
 					lastBlockNum = bn
@@ -244,60 +204,21 @@ func (co *CoreObserver) startSendScheduler() {
 	}
 }
 
-// Bitcoin outtx is processed in a different way
-// 1. schedule one keysign on each ticker
-// 2. schedule keysign only when nonce-mark UTXO is available
-// 3. stop processing when pendingNonce/lookahead is reached
-//
-// Returns whether to stop processing
-func (co *CoreObserver) processBitcoinOutTx(outTxMan *OutTxProcessorManager, idx uint64, send *types.CrossChainTx, signer ChainSigner, ob ChainClient, currentHeight uint64) bool {
-	params := send.GetCurrentOutTxParam()
-	nonce := params.OutboundTxTssNonce
-	lookahead := ob.GetCoreParams().OutboundTxScheduleLookahead
-	outTxID := fmt.Sprintf("%s-%d-%d", send.Index, params.ReceiverChainId, nonce)
-
-	// start go routine to process outtx
-	outTxMan.StartTryProcess(outTxID)
-	co.logger.ZetaChainWatcher.Debug().Msgf("Sign bitcoin outtx %s with value %d\n", outTxID, params.Amount)
-	go signer.TryProcessOutTx(send, outTxMan, outTxID, ob, co.bridge, currentHeight)
-
-	// get bitcoin client
-	btcClient, ok := ob.(*BitcoinChainClient)
-	if !ok { // should never happen
-		co.logger.ZetaChainWatcher.Error().Msgf("chain client is not a bitcoin client")
-		return true
-	}
-	// stop if the nonce being processed reaches the artificial pending nonce
-	if nonce >= btcClient.GetPendingNonce() {
-		return true
-	}
-	// stop if lookahead is reached. 2 bitcoin confirmations span is 20 minutes on average. We look ahead up to 100 pending cctx to target TPM of 5.
-	// #nosec G701 always in range
-	if int64(idx) >= lookahead-1 {
-		return true
-	}
-	return false // otherwise, continue
-}
-
 func (co *CoreObserver) getUpdatedChainOb(chainID int64) (ChainClient, error) {
 	chainOb, err := co.getTargetChainOb(chainID)
 	if err != nil {
 		return nil, err
 	}
 	// update chain client core parameters
+	family, found := GetChainFamilyByChainID(co.cfg, chainID)
+	if !found {
+		return nil, fmt.Errorf("chain family not found for chainID %d", chainID)
+	}
 	curParams := chainOb.GetCoreParams()
-	if common.IsEVMChain(chainID) {
-		evmCfg, found := co.cfg.GetEVMConfig(chainID)
-		if found && curParams != evmCfg.CoreParams {
-			chainOb.SetCoreParams(evmCfg.CoreParams)
-			co.logger.ZetaChainWatcher.Info().Msgf("updated core params for chainID %d, new params: %v", chainID, evmCfg.CoreParams)
-		}
-	} else if common.IsBitcoinChain(chainID) {
-		_, btcCfg, found := co.cfg.GetBTCConfig()
-		if found && curParams != btcCfg.CoreParams {
-			chainOb.SetCoreParams(btcCfg.CoreParams)
-			co.logger.ZetaChainWatcher.Info().Msgf("updated core params for Bitcoin, new params: %v", btcCfg.CoreParams)
-		}
+	newParams, found := family.CoreParams(co.cfg, chainID)
+	if found && curParams != newParams {
+		chainOb.SetCoreParams(newParams)
+		co.logger.ZetaChainWatcher.Info().Msgf("updated core params for chainID %d, new params: %v", chainID, newParams)
 	}
 	return chainOb, nil
 }